@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -176,7 +177,7 @@ func (c *RemoteIndex) BatchAddReferences(ctx context.Context, hostName, indexNam
 
 func (c *RemoteIndex) GetObject(ctx context.Context, hostName, indexName,
 	shardName string, id strfmt.UUID, selectProps search.SelectProperties,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) (*storobj.Object, error) {
 	selectPropsBytes, err := json.Marshal(selectProps)
 	if err != nil {
@@ -232,7 +233,7 @@ func (c *RemoteIndex) GetObject(ctx context.Context, hostName, indexName,
 		return nil, errors.Wrap(err, "read body")
 	}
 
-	obj, err := clusterapi.IndicesPayloads.SingleObject.Unmarshal(objBytes)
+	obj, err := clusterapi.IndicesPayloads.SingleObject.Unmarshal(objBytes, class)
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal body")
 	}
@@ -345,7 +346,7 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 }
 
 func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
-	shardName string, ids []strfmt.UUID,
+	shardName string, ids []strfmt.UUID, class *models.Class,
 ) ([]*storobj.Object, error) {
 	idsBytes, err := json.Marshal(ids)
 	if err != nil {
@@ -394,7 +395,7 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 		return nil, errors.Wrap(err, "read response body")
 	}
 
-	objs, err := clusterapi.IndicesPayloads.ObjectList.Unmarshal(bodyBytes)
+	objs, err := clusterapi.IndicesPayloads.ObjectList.Unmarshal(bodyBytes, class)
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal objects")
 	}
@@ -403,13 +404,14 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 }
 
 func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
+	shardName string, vector []float32, targetVector string, indexParams *searchparams.VectorIndex,
+	limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*storobj.Object, []float32, error) {
 	paramsBytes, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+		Marshal(vector, targetVector, indexParams, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "marshal request payload")
 	}
@@ -447,7 +449,7 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
 		return nil, nil, errors.Errorf("unexpected content type: %s", ct)
 	}
 
-	objs, dists, err := clusterapi.IndicesPayloads.SearchResults.Unmarshal(resBytes)
+	objs, dists, err := clusterapi.IndicesPayloads.SearchResults.Unmarshal(resBytes, class)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "unmarshal body")
 	}
@@ -23,6 +23,12 @@ const (
 	Certainty            = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
 	Distance             = "The required degree of similarity between an object's characteristics and the provided filter values"
 	Vector               = "Target vector to be used in kNN search"
+	TargetVectors        = "Names of the named vectors of the class to search, the class vector is searched if not set"
+	CombinationMethod    = "How the distances to multiple target vectors are joined, defaults to the minimum distance"
+	TargetWeights        = "Weight of each target vector in the order of targetVectors, required for the manualWeights combination method"
+	SearchParams         = "Settings of the vector index which are overridden for this query"
+	SearchParamsEf       = "Size of the dynamic candidate list of an hnsw index, higher values improve recall at the cost of latency"
+	SearchParamsRescore  = "Whether the results of a compressed index are rescored with the uncompressed vectors"
 	Force                = "The force to apply for a particular movements. Must be between 0 and 1 where 0 is equivalent to no movement and 1 is equivalent to largest movement possible"
 	ClassName            = "Name of the Class"
 	ID                   = "Concept identifier in the uuid format"
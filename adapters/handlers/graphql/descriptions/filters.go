@@ -68,6 +68,19 @@ const (
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
+	WhereValueIntArray                     = "Specify a list of Integer values that the target property will be compared to, used by ContainsAny and ContainsAll"
+	WhereValueNumberArray                  = "Specify a list of Float values that the target property will be compared to, used by ContainsAny and ContainsAll"
+	WhereValueBooleanArray                 = "Specify a list of Boolean values that the target property will be compared to, used by ContainsAny and ContainsAll"
+	WhereValueStringArray                  = "Specify a list of String values that the target property will be compared to, used by ContainsAny and ContainsAll"
+	WhereValueTextArray                    = "Specify a list of Text values that the target property will be compared to, used by ContainsAny and ContainsAll"
+	WhereValueDateArray                    = "Specify a list of Date values that the target property will be compared to, used by ContainsAny and ContainsAll"
+	WhereValueGeoPolygon                   = "Specify the corners of a polygon (latitude and longitude as decimals), at least three. The search will return any result which is located inside the polygon, used by WithinGeoPolygon"
+	WhereValueGeoPolygonCoordinates        = "The geoCoordinates of the corners of the polygon in order, the last corner is connected to the first one."
+	WhereValueGeoBoundingBox               = "Specify the top left (north-west) and bottom right (south-east) corners of a box (latitude and longitude as decimals). The search will return any result which is located inside the box, used by WithinGeoBoundingBox"
+	WhereValueGeoBoundingBoxTopLeft        = "The geoCoordinates of the top left (north-west) corner of the box."
+	WhereValueGeoBoundingBoxBottomRight    = "The geoCoordinates of the bottom right (south-east) corner of the box."
+	WhereValueGeoCoordinatesLatitude       = "The latitude (in decimal format) of the geoCoordinates."
+	WhereValueGeoCoordinatesLongitude      = "The longitude (in decimal format) of the geoCoordinates."
 )
 
 // Properties and Classes filter elements (used by Fetch and Introspect Where filters)
@@ -40,7 +40,8 @@ func Build(dbSchema *schema.Schema, config config.Config,
 	var err error
 	var localAggregateObjects *graphql.Object
 	if len(dbSchema.Objects.Classes) > 0 {
-		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, config, modulesProvider)
+		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, dbSchema.Aliases,
+			config, modulesProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -56,7 +57,7 @@ func Build(dbSchema *schema.Schema, config config.Config,
 	return &field, nil
 }
 
-func classFields(databaseSchema []*models.Class,
+func classFields(databaseSchema []*models.Class, aliases map[string]string,
 	config config.Config, modulesProvider ModulesProvider,
 ) (*graphql.Object, error) {
 	fields := graphql.Fields{}
@@ -70,6 +71,13 @@ func classFields(databaseSchema []*models.Class,
 		fields[class.Class] = field
 	}
 
+	// an alias is queried exactly like the class it points to
+	for alias, class := range aliases {
+		if field, ok := fields[class]; ok {
+			fields[alias] = field
+		}
+	}
+
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name:        "AggregateObjectsObj",
 		Fields:      fields,
@@ -240,6 +248,9 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
 		// not aggregatable
 		return nil, nil
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		// nested properties are not aggregatable (yet)
+		return nil, nil
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype+": %s", dataType)
 	}
@@ -31,6 +31,11 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	autocorrect, ok := source["autocorrect"]
+	if ok {
+		args.Autocorrect = autocorrect.(bool)
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
@@ -26,18 +26,23 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type: graphql.NewEnum(graphql.EnumConfig{
 				Name: fmt.Sprintf("%sWhereOperatorEnum", path),
 				Values: graphql.EnumValueConfigMap{
-					"And":              &graphql.EnumValueConfig{},
-					"Like":             &graphql.EnumValueConfig{},
-					"Or":               &graphql.EnumValueConfig{},
-					"Equal":            &graphql.EnumValueConfig{},
-					"Not":              &graphql.EnumValueConfig{},
-					"NotEqual":         &graphql.EnumValueConfig{},
-					"GreaterThan":      &graphql.EnumValueConfig{},
-					"GreaterThanEqual": &graphql.EnumValueConfig{},
-					"LessThan":         &graphql.EnumValueConfig{},
-					"LessThanEqual":    &graphql.EnumValueConfig{},
-					"WithinGeoRange":   &graphql.EnumValueConfig{},
-					"IsNull":           &graphql.EnumValueConfig{},
+					"And":                  &graphql.EnumValueConfig{},
+					"Like":                 &graphql.EnumValueConfig{},
+					"Regex":                &graphql.EnumValueConfig{},
+					"Or":                   &graphql.EnumValueConfig{},
+					"Equal":                &graphql.EnumValueConfig{},
+					"Not":                  &graphql.EnumValueConfig{},
+					"NotEqual":             &graphql.EnumValueConfig{},
+					"GreaterThan":          &graphql.EnumValueConfig{},
+					"GreaterThanEqual":     &graphql.EnumValueConfig{},
+					"LessThan":             &graphql.EnumValueConfig{},
+					"LessThanEqual":        &graphql.EnumValueConfig{},
+					"WithinGeoRange":       &graphql.EnumValueConfig{},
+					"WithinGeoPolygon":     &graphql.EnumValueConfig{},
+					"WithinGeoBoundingBox": &graphql.EnumValueConfig{},
+					"IsNull":               &graphql.EnumValueConfig{},
+					"ContainsAny":          &graphql.EnumValueConfig{},
+					"ContainsAll":          &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
@@ -75,6 +80,38 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type:        newGeoRangeInputObject(path),
 			Description: descriptions.WhereValueRange,
 		},
+		"valueGeoPolygon": &graphql.InputObjectFieldConfig{
+			Type:        newGeoPolygonInputObject(path),
+			Description: descriptions.WhereValueGeoPolygon,
+		},
+		"valueGeoBoundingBox": &graphql.InputObjectFieldConfig{
+			Type:        newGeoBoundingBoxInputObject(path),
+			Description: descriptions.WhereValueGeoBoundingBox,
+		},
+		"valueIntArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Int),
+			Description: descriptions.WhereValueIntArray,
+		},
+		"valueNumberArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Float),
+			Description: descriptions.WhereValueNumberArray,
+		},
+		"valueBooleanArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Boolean),
+			Description: descriptions.WhereValueBooleanArray,
+		},
+		"valueStringArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueStringArray,
+		},
+		"valueTextArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueTextArray,
+		},
+		"valueDateArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueDateArray,
+		},
 	}
 
 	// Recurse into the same time.
@@ -137,3 +174,49 @@ func newGeoRangeDistanceInputObject(path string) *graphql.InputObject {
 		},
 	})
 }
+
+func newGeoPolygonInputObject(path string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoPolygonInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"coordinates": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(
+					newGeoCoordinatesInputObject(fmt.Sprintf("%sWhereGeoPolygonCoordinatesInpObj", path))))),
+				Description: descriptions.WhereValueGeoPolygonCoordinates,
+			},
+		},
+	})
+}
+
+func newGeoBoundingBoxInputObject(path string) *graphql.InputObject {
+	corner := newGeoCoordinatesInputObject(fmt.Sprintf("%sWhereGeoBoundingBoxCoordinatesInpObj", path))
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoBoundingBoxInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"topLeft": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(corner),
+				Description: descriptions.WhereValueGeoBoundingBoxTopLeft,
+			},
+			"bottomRight": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(corner),
+				Description: descriptions.WhereValueGeoBoundingBoxBottomRight,
+			},
+		},
+	})
+}
+
+func newGeoCoordinatesInputObject(name string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: name,
+		Fields: graphql.InputObjectConfigFieldMap{
+			"latitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoCoordinatesLatitude,
+			},
+			"longitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoCoordinatesLongitude,
+			},
+		},
+	})
+}
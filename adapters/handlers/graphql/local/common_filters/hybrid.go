@@ -107,6 +107,11 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	autocorrect, ok := source["autocorrect"]
+	if ok {
+		args.Autocorrect = autocorrect.(bool)
+	}
+
 	args.Type = "hybrid"
 	return &args, nil
 }
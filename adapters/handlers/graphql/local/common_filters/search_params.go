@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// AddSearchParamsField adds the "searchParams" field to the input object of a
+// vector search argument, which overrides settings of the vector index for a
+// single query
+func AddSearchParamsField(argument *graphql.ArgumentConfig) {
+	if argument == nil {
+		return
+	}
+	inputObject, ok := argument.Type.(*graphql.InputObject)
+	if !ok {
+		return
+	}
+
+	inputObject.AddFieldConfig("searchParams", &graphql.InputObjectFieldConfig{
+		Description: descriptions.SearchParams,
+		Type: graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sSearchParamsInpObj", inputObject.Name()),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"ef": &graphql.InputObjectFieldConfig{
+					Description: descriptions.SearchParamsEf,
+					Type:        graphql.Int,
+				},
+				"rescore": &graphql.InputObjectFieldConfig{
+					Description: descriptions.SearchParamsRescore,
+					Type:        graphql.Boolean,
+				},
+			},
+		}),
+	})
+}
+
+// ExtractSearchParams returns the vector index settings the search arguments
+// override, it is nil if no argument sets "searchParams"
+func ExtractSearchParams(args map[string]interface{}) (*searchparams.VectorIndex, error) {
+	var params *searchparams.VectorIndex
+	for name, arg := range args {
+		source, ok := arg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		searchParams, ok := source["searchParams"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var p searchparams.VectorIndex
+		if ef, ok := searchParams["ef"].(int); ok {
+			if ef < 1 {
+				return nil, fmt.Errorf("%s: ef must be a positive number, got %d", name, ef)
+			}
+			p.Ef = ef
+		}
+		if rescore, ok := searchParams["rescore"].(bool); ok {
+			p.Rescore = &rescore
+		}
+
+		if params != nil && !sameSearchParams(*params, p) {
+			return nil, fmt.Errorf("%s: conflicting search params", name)
+		}
+		params = &p
+	}
+
+	return params, nil
+}
+
+func sameSearchParams(a, b searchparams.VectorIndex) bool {
+	if a.Ef != b.Ef {
+		return false
+	}
+	if a.Rescore == nil || b.Rescore == nil {
+		return a.Rescore == b.Rescore
+	}
+	return *a.Rescore == *b.Rescore
+}
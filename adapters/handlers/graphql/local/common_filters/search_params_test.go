@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractSearchParams(t *testing.T) {
+	t.Run("without search params", func(t *testing.T) {
+		params, err := ExtractSearchParams(map[string]interface{}{
+			"nearVector": map[string]interface{}{"vector": []interface{}{0.1}},
+			"limit":      10,
+		})
+		require.Nil(t, err)
+		assert.Nil(t, params)
+	})
+
+	t.Run("with ef", func(t *testing.T) {
+		params, err := ExtractSearchParams(map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"vector":       []interface{}{0.1},
+				"searchParams": map[string]interface{}{"ef": 256},
+			},
+		})
+		require.Nil(t, err)
+		require.NotNil(t, params)
+		assert.Equal(t, 256, params.Ef)
+		assert.Nil(t, params.Rescore)
+	})
+
+	t.Run("with rescore", func(t *testing.T) {
+		params, err := ExtractSearchParams(map[string]interface{}{
+			"nearText": map[string]interface{}{
+				"concepts":     []interface{}{"foo"},
+				"searchParams": map[string]interface{}{"rescore": false},
+			},
+		})
+		require.Nil(t, err)
+		require.NotNil(t, params)
+		assert.Equal(t, 0, params.Ef)
+		require.NotNil(t, params.Rescore)
+		assert.False(t, *params.Rescore)
+	})
+
+	t.Run("with invalid ef", func(t *testing.T) {
+		_, err := ExtractSearchParams(map[string]interface{}{
+			"hybrid": map[string]interface{}{
+				"searchParams": map[string]interface{}{"ef": 0},
+			},
+		})
+		assert.EqualError(t, err, "hybrid: ef must be a positive number, got 0")
+	})
+
+	t.Run("with conflicting search params", func(t *testing.T) {
+		_, err := ExtractSearchParams(map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"searchParams": map[string]interface{}{"ef": 64},
+			},
+			"hybrid": map[string]interface{}{
+				"searchParams": map[string]interface{}{"ef": 128},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "conflicting search params")
+	})
+}
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+// targetCombinationMethodEnum is shared by the search arguments of all
+// classes, GraphQL requires named types to be unique within the schema
+var targetCombinationMethodEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "TargetCombinationMethod",
+	Values: graphql.EnumValueConfigMap{
+		"minimum":       &graphql.EnumValueConfig{Value: dto.TargetCombinationMinimum},
+		"sum":           &graphql.EnumValueConfig{Value: dto.TargetCombinationSum},
+		"average":       &graphql.EnumValueConfig{Value: dto.TargetCombinationAverage},
+		"manualWeights": &graphql.EnumValueConfig{Value: dto.TargetCombinationManualWeights},
+	},
+})
+
+// AddTargetVectorsField adds the "targetVectors" field along with the fields
+// to join multiple target vectors to the input object of a vector search
+// argument. It is only added for classes with named vectors.
+func AddTargetVectorsField(argument *graphql.ArgumentConfig) {
+	if argument == nil {
+		return
+	}
+	if inputObject, ok := argument.Type.(*graphql.InputObject); ok {
+		inputObject.AddFieldConfig("targetVectors", &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVectors,
+			Type:        graphql.NewList(graphql.String),
+		})
+		inputObject.AddFieldConfig("combinationMethod", &graphql.InputObjectFieldConfig{
+			Description: descriptions.CombinationMethod,
+			Type:        targetCombinationMethodEnum,
+		})
+		inputObject.AddFieldConfig("targetWeights", &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetWeights,
+			Type:        graphql.NewList(graphql.Float),
+		})
+	}
+}
+
+// ExtractTargetVectors returns the named vectors the search arguments are
+// targeting, they are empty if no argument sets "targetVectors". If more than
+// one target vector is set, the returned combination defines how the
+// distances to each of them are joined.
+func ExtractTargetVectors(args map[string]interface{}) ([]string, *dto.TargetCombination, error) {
+	var (
+		targetVectors []string
+		combination   *dto.TargetCombination
+		source        string
+	)
+	for name, arg := range args {
+		argMap, ok := arg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		targets, ok := argMap["targetVectors"].([]interface{})
+		if !ok || len(targets) == 0 {
+			continue
+		}
+
+		parsed := make([]string, len(targets))
+		for i := range targets {
+			target, ok := targets[i].(string)
+			if !ok || target == "" {
+				return nil, nil, fmt.Errorf("%s: target vector must be a non-empty string", name)
+			}
+			parsed[i] = target
+		}
+		if err := validateUniqueTargetVectors(parsed); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if targetVectors != nil && !equalTargetVectors(targetVectors, parsed) {
+			return nil, nil, fmt.Errorf("%s: conflicting target vectors %v and %v set in %s",
+				name, parsed, targetVectors, source)
+		}
+
+		parsedCombination, err := extractTargetCombination(argMap, parsed)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		targetVectors = parsed
+		source = name
+		if parsedCombination != nil {
+			combination = parsedCombination
+		}
+	}
+
+	return targetVectors, combination, nil
+}
+
+func extractTargetCombination(source map[string]interface{},
+	targetVectors []string,
+) (*dto.TargetCombination, error) {
+	method, hasMethod := source["combinationMethod"]
+	weights, hasWeights := source["targetWeights"].([]interface{})
+	if len(targetVectors) == 1 {
+		if hasMethod || hasWeights {
+			return nil, fmt.Errorf("combinationMethod and targetWeights require multiple target vectors")
+		}
+		return nil, nil
+	}
+
+	combination := &dto.TargetCombination{Type: dto.TargetCombinationMinimum}
+	if hasMethod {
+		combinationType, ok := method.(dto.TargetCombinationType)
+		if !ok {
+			return nil, fmt.Errorf("unrecognized combinationMethod %v", method)
+		}
+		combination.Type = combinationType
+	}
+
+	if combination.Type != dto.TargetCombinationManualWeights {
+		if hasWeights {
+			return nil, fmt.Errorf("targetWeights can only be set with the manualWeights combination method")
+		}
+		return combination, nil
+	}
+
+	if len(weights) != len(targetVectors) {
+		return nil, fmt.Errorf("manualWeights combination method requires one weight per "+
+			"target vector, got %d weights for %d target vectors", len(weights), len(targetVectors))
+	}
+	combination.Weights = make([]float32, len(weights))
+	for i := range weights {
+		weight, ok := weights[i].(float64)
+		if !ok {
+			return nil, fmt.Errorf("target weight must be a number, got %T", weights[i])
+		}
+		combination.Weights[i] = float32(weight)
+	}
+
+	return combination, nil
+}
+
+func validateUniqueTargetVectors(targetVectors []string) error {
+	seen := make(map[string]struct{}, len(targetVectors))
+	for _, target := range targetVectors {
+		if _, ok := seen[target]; ok {
+			return fmt.Errorf("target vector %q is set more than once", target)
+		}
+		seen[target] = struct{}{}
+	}
+	return nil
+}
+
+func equalTargetVectors(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
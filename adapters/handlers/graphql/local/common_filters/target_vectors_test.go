@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+func TestExtractTargetVectors(t *testing.T) {
+	t.Run("without target vectors", func(t *testing.T) {
+		targets, combination, err := ExtractTargetVectors(map[string]interface{}{
+			"nearVector": map[string]interface{}{"vector": []interface{}{0.1}},
+			"limit":      10,
+		})
+		require.Nil(t, err)
+		assert.Empty(t, targets)
+		assert.Nil(t, combination)
+	})
+
+	t.Run("with a single target vector", func(t *testing.T) {
+		targets, combination, err := ExtractTargetVectors(map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"vector":        []interface{}{0.1},
+				"targetVectors": []interface{}{"title"},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"title"}, targets)
+		assert.Nil(t, combination)
+	})
+
+	t.Run("with multiple target vectors", func(t *testing.T) {
+		targets, combination, err := ExtractTargetVectors(map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"vector":        []interface{}{0.1},
+				"targetVectors": []interface{}{"title", "body"},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"title", "body"}, targets)
+		assert.Equal(t, &dto.TargetCombination{Type: dto.TargetCombinationMinimum}, combination)
+	})
+
+	t.Run("with a combination method", func(t *testing.T) {
+		_, combination, err := ExtractTargetVectors(map[string]interface{}{
+			"hybrid": map[string]interface{}{
+				"targetVectors":     []interface{}{"title", "body"},
+				"combinationMethod": dto.TargetCombinationAverage,
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, &dto.TargetCombination{Type: dto.TargetCombinationAverage}, combination)
+	})
+
+	t.Run("with manual weights", func(t *testing.T) {
+		_, combination, err := ExtractTargetVectors(map[string]interface{}{
+			"nearText": map[string]interface{}{
+				"targetVectors":     []interface{}{"title", "body"},
+				"combinationMethod": dto.TargetCombinationManualWeights,
+				"targetWeights":     []interface{}{0.75, 0.25},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, &dto.TargetCombination{
+			Type:    dto.TargetCombinationManualWeights,
+			Weights: []float32{0.75, 0.25},
+		}, combination)
+	})
+
+	t.Run("with manual weights not matching the target vectors", func(t *testing.T) {
+		_, _, err := ExtractTargetVectors(map[string]interface{}{
+			"nearText": map[string]interface{}{
+				"targetVectors":     []interface{}{"title", "body"},
+				"combinationMethod": dto.TargetCombinationManualWeights,
+				"targetWeights":     []interface{}{0.75},
+			},
+		})
+		assert.EqualError(t, err, "nearText: manualWeights combination method requires one weight "+
+			"per target vector, got 1 weights for 2 target vectors")
+	})
+
+	t.Run("with weights but without manual weights", func(t *testing.T) {
+		_, _, err := ExtractTargetVectors(map[string]interface{}{
+			"nearText": map[string]interface{}{
+				"targetVectors": []interface{}{"title", "body"},
+				"targetWeights": []interface{}{0.75, 0.25},
+			},
+		})
+		assert.EqualError(t, err, "nearText: targetWeights can only be set with the "+
+			"manualWeights combination method")
+	})
+
+	t.Run("with a duplicate target vector", func(t *testing.T) {
+		_, _, err := ExtractTargetVectors(map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"targetVectors": []interface{}{"title", "title"},
+			},
+		})
+		assert.EqualError(t, err, "nearVector: target vector \"title\" is set more than once")
+	})
+
+	t.Run("with conflicting target vectors", func(t *testing.T) {
+		_, _, err := ExtractTargetVectors(map[string]interface{}{
+			"nearVector": map[string]interface{}{"targetVectors": []interface{}{"title"}},
+			"hybrid":     map[string]interface{}{"targetVectors": []interface{}{"body"}},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "conflicting target vectors")
+	})
+}
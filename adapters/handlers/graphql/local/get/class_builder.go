@@ -86,6 +86,13 @@ func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error)
 		classFields[class.Class] = classField
 	}
 
+	// an alias is queried exactly like the class it points to
+	for alias, class := range b.schema.Aliases {
+		if classField, ok := classFields[class]; ok {
+			classFields[alias] = classField
+		}
+	}
+
 	classes := graphql.NewObject(graphql.ObjectConfig{
 		Name:        "GetObjectsObj",
 		Fields:      classFields,
@@ -130,6 +137,9 @@ func (b *classBuilder) classObject(class *models.Class) *graphql.Object {
 				if propertyType.IsPrimitive() {
 					classProperties[property.Name] = b.primitiveField(propertyType, property,
 						class.Class)
+				} else if propertyType.IsNested() {
+					classProperties[property.Name] = b.nestedField(propertyType, property.Name,
+						property.Description, property.NestedProperties, class.Class)
 				} else {
 					classProperties[property.Name] = b.referenceField(propertyType, property,
 						class.Class)
@@ -253,6 +263,7 @@ func (b *classBuilder) additionalGroupField(classProperties graphql.Fields, clas
 						"id":       &graphql.Field{Type: graphql.String},
 						"vector":   &graphql.Field{Type: graphql.NewList(graphql.Float)},
 						"distance": &graphql.Field{Type: graphql.Float},
+						"score":    &graphql.Field{Type: graphql.Float},
 					},
 				},
 			),
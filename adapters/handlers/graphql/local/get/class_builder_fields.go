@@ -138,6 +138,51 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 	}
 }
 
+// nestedField builds the field of an object or object[] property. Every
+// nested property becomes a field of a dedicated object type, whose name is
+// derived from the class and the path of the property to keep it unique.
+func (b *classBuilder) nestedField(propertyType schema.PropertyDataType,
+	propertyName, description string, nestedProperties []*models.NestedProperty,
+	typePrefix string,
+) *graphql.Field {
+	typePrefix = typePrefix + propertyName
+	fields := graphql.Fields{}
+	for _, nested := range nestedProperties {
+		nestedType, err := b.schema.FindPropertyDataType(nested.DataType)
+		if err != nil {
+			// We can't return an error in this FieldsThunk function, so we need to panic
+			panic(fmt.Sprintf("buildGetClass: wrong propertyType for nested property %s.%s; %s",
+				typePrefix, nested.Name, err.Error()))
+		}
+
+		if nestedType.IsNested() {
+			fields[nested.Name] = b.nestedField(nestedType, nested.Name,
+				nested.Description, nested.NestedProperties, typePrefix)
+			continue
+		}
+
+		fields[nested.Name] = b.primitiveField(nestedType, &models.Property{
+			Name:        nested.Name,
+			Description: nested.Description,
+			DataType:    nested.DataType,
+		}, typePrefix)
+	}
+
+	var fieldType graphql.Output = graphql.NewObject(graphql.ObjectConfig{
+		Name:   fmt.Sprintf("%sObject", typePrefix),
+		Fields: fields,
+	})
+	if propertyType.AsNested() == schema.DataTypeObjectArray {
+		fieldType = graphql.NewList(fieldType)
+	}
+
+	return &graphql.Field{
+		Description: description,
+		Name:        propertyName,
+		Type:        fieldType,
+	}
+}
+
 func newGeoCoordinatesObject(className string, propertyName string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Description: "GeoCoordinates as latitude and longitude in decimal form",
@@ -241,9 +286,23 @@ func buildGetClassField(classObject *graphql.Object,
 	if modulesProvider != nil {
 		for name, argument := range modulesProvider.GetArguments(class) {
 			field.Args[name] = argument
+			common_filters.AddSearchParamsField(argument)
+			if schema.HasTargetVectors(class) {
+				common_filters.AddTargetVectorsField(argument)
+			}
 		}
 	}
 
+	common_filters.AddSearchParamsField(field.Args["nearVector"])
+	common_filters.AddSearchParamsField(field.Args["nearObject"])
+	common_filters.AddSearchParamsField(field.Args["hybrid"])
+
+	if schema.HasTargetVectors(class) {
+		common_filters.AddTargetVectorsField(field.Args["nearVector"])
+		common_filters.AddTargetVectorsField(field.Args["nearObject"])
+		common_filters.AddTargetVectorsField(field.Args["hybrid"])
+	}
+
 	if replicationEnabled(class) {
 		field.Args["consistencyLevel"] = consistencyLevelArgument(class)
 	}
@@ -364,7 +423,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		sort = filters.ExtractSortFromArgs(sortArg.([]interface{}))
 	}
 
-	filters, err := common_filters.ExtractFilters(p.Args, p.Info.FieldName)
+	filters, err := common_filters.ExtractFilters(p.Args, className)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract filters: %s", err)
 	}
@@ -440,6 +499,20 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		tenant = tk.(string)
 	}
 
+	targetVectors, targetCombination, err := common_filters.ExtractTargetVectors(p.Args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract targetVectors: %w", err)
+	}
+	var targetVector string
+	if len(targetVectors) == 1 {
+		targetVector, targetVectors = targetVectors[0], nil
+	}
+
+	vectorIndexParams, err := common_filters.ExtractSearchParams(p.Args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract searchParams: %w", err)
+	}
+
 	params := dto.GetParams{
 		Filters:               filters,
 		ClassName:             className,
@@ -457,6 +530,10 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		ReplicationProperties: replProps,
 		GroupBy:               groupByParams,
 		Tenant:                tenant,
+		TargetVector:          targetVector,
+		TargetVectors:         targetVectors,
+		TargetCombination:     targetCombination,
+		VectorIndexParams:     vectorIndexParams,
 	}
 
 	// need to perform vector search by distance
@@ -537,18 +614,25 @@ func principalFromContext(ctx context.Context) *models.Principal {
 	return principal.(*models.Principal)
 }
 
-func isPrimitive(selectionSet *ast.SelectionSet) bool {
+func isPrimitive(selectionSet *ast.SelectionSet, additionalCheck *additionalCheck) bool {
 	if selectionSet == nil {
 		return true
 	}
 
-	// if there is a selection set it could either be a cross-ref or a map-type
-	// field like GeoCoordinates or PhoneNumber
+	// if there is a selection set it could either be a cross-ref, a map-type
+	// field like GeoCoordinates or PhoneNumber or an object property. The
+	// properties of a cross-ref are always selected through fragments, so
+	// any other field points to a non-reference type
 	for _, subSelection := range selectionSet.Selections {
 		if subsectionField, ok := subSelection.(*ast.Field); ok {
 			if fieldNameIsOfObjectButNonReferenceType(subsectionField.Name.Value) {
 				return true
 			}
+			if subsectionField.Name.Value != "__typename" &&
+				!additionalCheck.isAdditional(subsectionField.Name.Value) {
+				// must be an object prop
+				return true
+			}
 		}
 	}
 
@@ -614,7 +698,7 @@ func extractProperties(className string, selections *ast.SelectionSet,
 		name := field.Name.Value
 		property := search.SelectProperty{Name: name}
 
-		property.IsPrimitive = isPrimitive(field.SelectionSet)
+		property.IsPrimitive = isPrimitive(field.SelectionSet, additionalCheck)
 		if !property.IsPrimitive {
 			// We can interpret this property in different ways
 			for _, subSelection := range field.SelectionSet.Selections {
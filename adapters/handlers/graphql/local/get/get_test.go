@@ -96,6 +96,47 @@ func TestExtractGeoCoordinatesField(t *testing.T) {
 	assert.Equal(t, expectedLocation, result.Get("Get", "SomeAction").Result.([]interface{})[0])
 }
 
+func TestExtractObjectField(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "author", IsPrimitive: true}},
+	}
+
+	resolverReturn := []interface{}{
+		map[string]interface{}{
+			"author": map[string]interface{}{
+				"name": "Jane",
+				"books": []interface{}{
+					map[string]interface{}{"title": "First", "year": float64(1999)},
+					map[string]interface{}{"title": "Second", "year": float64(2005)},
+				},
+			},
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(resolverReturn, nil).Once()
+
+	query := "{ Get { SomeAction { author { name books { title year } } } } }"
+	result := resolver.AssertResolve(t, query)
+
+	expectedAuthor := map[string]interface{}{
+		"author": map[string]interface{}{
+			"name": "Jane",
+			"books": []interface{}{
+				map[string]interface{}{"title": "First", "year": 1999},
+				map[string]interface{}{"title": "Second", "year": 2005},
+			},
+		},
+	}
+
+	assert.Equal(t, expectedAuthor, result.Get("Get", "SomeAction").Result.([]interface{})[0])
+}
+
 func TestExtractUUIDField(t *testing.T) {
 	t.Parallel()
 
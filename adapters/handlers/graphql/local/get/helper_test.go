@@ -518,7 +518,7 @@ func (fmp *fakeModulesProvider) GetAll() []modulecapabilities.Module {
 	panic("implement me")
 }
 
-func (fmp *fakeModulesProvider) VectorFromInput(ctx context.Context, className string, input string) ([]float32, error) {
+func (fmp *fakeModulesProvider) VectorFromInput(ctx context.Context, className, targetVector string, input string) ([]float32, error) {
 	panic("not implemented")
 }
 
@@ -58,11 +58,11 @@ func hybridOperands(classObject *graphql.Object,
 			Type:        graphql.Float,
 		},
 		"vector": &graphql.InputObjectFieldConfig{
-			Description: "Vector search",
+			Description: "Vector used for the vector search instead of vectorizing the query, required if the class has no vectorizer",
 			Type:        graphql.NewList(graphql.Float),
 		},
 		"properties": &graphql.InputObjectFieldConfig{
-			Description: "Which properties should be included in the sparse search",
+			Description: "Which properties should be included in the sparse search, matches in a property can be boosted like title^3",
 			Type:        graphql.NewList(graphql.String),
 		},
 		"fusionType": &graphql.InputObjectFieldConfig{
@@ -70,6 +70,7 @@ func hybridOperands(classObject *graphql.Object,
 			DefaultValue: fusionEnum.Values()[HybridRankedFusion].Value,
 			Type:         fusionEnum,
 		},
+		"autocorrect": autocorrectField(),
 	}
 
 	if os.Getenv("ENABLE_EXPERIMENTAL_HYBRID_OPERANDS") != "" {
@@ -23,20 +23,33 @@ func bm25Argument(className string) *graphql.ArgumentConfig {
 		Type: graphql.NewInputObject(
 			graphql.InputObjectConfig{
 				Name:   fmt.Sprintf("%sHybridGetBm25InpObj", prefix),
-				Fields: bm25Fields(prefix),
+				Fields: bm25ArgumentFields(prefix),
 			},
 		),
 	}
 }
 
+func bm25ArgumentFields(prefix string) graphql.InputObjectConfigFieldMap {
+	fields := bm25Fields(prefix)
+	fields["autocorrect"] = autocorrectField()
+	return fields
+}
+
+func autocorrectField() *graphql.InputObjectFieldConfig {
+	return &graphql.InputObjectFieldConfig{
+		Description: "Autocorrect the query with the text transformer of a module, e.g. text-spellcheck",
+		Type:        graphql.Boolean,
+	}
+}
+
 func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 	return graphql.InputObjectConfigFieldMap{
 		"query": &graphql.InputObjectFieldConfig{
-			Description: "The query to search for",
+			Description: "The query to search for. Quoted phrases boost results containing them exactly, phrases prefixed with + are required",
 			Type:        graphql.String,
 		},
 		"properties": &graphql.InputObjectFieldConfig{
-			Description: "The properties to search in",
+			Description: "The properties to search in, matches in a property can be boosted like title^3",
 			Type:        graphql.NewList(graphql.String),
 		},
 	}
@@ -67,6 +67,30 @@ func CreateSimpleSchema(vectorizer string) schema.Schema {
 							Name:     "phone",
 							DataType: []string{"phoneNumber"},
 						},
+						{
+							Name:     "author",
+							DataType: []string{"object"},
+							NestedProperties: []*models.NestedProperty{
+								{
+									Name:     "name",
+									DataType: []string{"text"},
+								},
+								{
+									Name:     "books",
+									DataType: []string{"object[]"},
+									NestedProperties: []*models.NestedProperty{
+										{
+											Name:     "title",
+											DataType: []string{"text"},
+										},
+										{
+											Name:     "year",
+											DataType: []string{"int"},
+										},
+									},
+								},
+							},
+						},
 						{
 							Name:     "hasAction",
 							DataType: []string{"SomeAction"},
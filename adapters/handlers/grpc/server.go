@@ -15,6 +15,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/schema"
@@ -27,14 +29,18 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/get"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	pb "github.com/weaviate/weaviate/grpc"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
 	"github.com/weaviate/weaviate/usecases/traverser"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func CreateGRPCServer(state *state.State) *GRPCServer {
@@ -80,6 +86,33 @@ type Server struct {
 }
 
 func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
+	return s.search(ctx, req)
+}
+
+// SearchStream runs the same search as Search, but sends the text of the
+// generated answers while it is produced, followed by the complete results
+func (s *Server) SearchStream(req *pb.SearchRequest, stream pb.Weaviate_SearchStreamServer) error {
+	ctx := generate.WithStream(stream.Context(), func(index int, grouped bool, text string) error {
+		return stream.Send(&pb.SearchStreamReply{
+			Reply: &pb.SearchStreamReply_GenerativeChunk{GenerativeChunk: &pb.GenerativeChunk{
+				ResultIndex: uint32(index),
+				Grouped:     grouped,
+				Text:        text,
+			}},
+		})
+	})
+
+	reply, err := s.search(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.SearchStreamReply{
+		Reply: &pb.SearchStreamReply_SearchReply{SearchReply: reply},
+	})
+}
+
+func (s *Server) search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
 	before := time.Now()
 
 	principal, err := s.principalFromContext(ctx)
@@ -96,7 +129,7 @@ func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchR
 		return nil, err
 	}
 
-	res, err := s.traverser.GetClass(ctx, principal, searchParams)
+	res, err := s.traverser.GetClass(injectHeadersIntoContext(ctx), principal, searchParams)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +137,25 @@ func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchR
 	return searchResultsToProto(res, before, searchParams)
 }
 
+// injectHeadersIntoContext makes the X- headers available to the modules the
+// same way the REST API does, e.g. to pass the API keys of third-party
+// providers with the request
+func injectHeadersIntoContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	for k, v := range md {
+		// the grpc library lowercases all md keys, the modules look them up
+		// in their canonical form
+		if strings.HasPrefix(k, "x-") {
+			ctx = context.WithValue(ctx, http.CanonicalHeaderKey(k), v)
+		}
+	}
+	return ctx
+}
+
 func (s *Server) validateClassAndProperty(searchParams dto.GetParams) error {
 	scheme := s.schemaManager.GetSchemaSkipAuth()
 	class, err := schema.GetClassByName(scheme.Objects, searchParams.ClassName)
@@ -152,9 +204,32 @@ func searchResultsToProto(res []any, start time.Time, searchParams dto.GetParams
 		out.Results[i] = result
 	}
 
+	out.GenerativeGroupedResult = extractGroupedResult(res)
+
 	return out, nil
 }
 
+// extractGroupedResult returns the grouped generative result, which is set
+// on the first search result only
+func extractGroupedResult(res []any) *string {
+	if len(res) == 0 {
+		return nil
+	}
+	asMap, ok := res[0].(map[string]any)
+	if !ok {
+		return nil
+	}
+	additionalPropertiesMap, ok := asMap["_additional"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	generateResult, ok := additionalPropertiesMap["generate"].(*generativemodels.GenerateResult)
+	if !ok {
+		return nil
+	}
+	return generateResult.GroupedResult
+}
+
 func extractAdditionalProps(asMap map[string]any, searchParams dto.GetParams) (*pb.ResultAdditionalProps, error) {
 	err := errors.New("could not extract additional prop")
 	additionalProps := &pb.ResultAdditionalProps{}
@@ -248,6 +323,18 @@ func extractAdditionalProps(asMap map[string]any, searchParams dto.GetParams) (*
 		}
 	}
 
+	if _, ok := searchParams.AdditionalProperties.ModuleParams["generate"]; ok {
+		additionalProps.GenerativePresent = false
+		generateResult, ok := additionalPropertiesMap["generate"]
+		if ok {
+			generateResultfmt, ok2 := generateResult.(*generativemodels.GenerateResult)
+			if ok2 && generateResultfmt.SingleResult != nil {
+				additionalProps.Generative = *generateResultfmt.SingleResult
+				additionalProps.GenerativePresent = true
+			}
+		}
+	}
+
 	if searchParams.AdditionalProperties.Score {
 		additionalProps.ScorePresent = false
 		score, ok := additionalPropertiesMap["score"]
@@ -366,8 +453,23 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 		explainScore = req.AdditionalProperties.ExplainScore
 	}
 
+	if gs := req.Generative; gs != nil {
+		params := &generate.Params{Properties: gs.GroupedProperties}
+		if gs.SingleResponsePrompt != "" {
+			params.Prompt = &gs.SingleResponsePrompt
+		}
+		if gs.GroupedResponseTask != "" {
+			params.Task = &gs.GroupedResponseTask
+		}
+		out.AdditionalProperties.ModuleParams = map[string]interface{}{"generate": params}
+	}
+
 	if hs := req.HybridSearch; hs != nil {
-		out.HybridSearch = &searchparams.HybridSearch{Query: hs.Query, Properties: hs.Properties, Vector: hs.Vector, Alpha: float64(hs.Alpha)}
+		fusionType := get.HybridRankedFusion
+		if hs.FusionType == pb.HybridSearchParams_FUSION_TYPE_RELATIVE_SCORE {
+			fusionType = get.HybridRelativeScoreFusion
+		}
+		out.HybridSearch = &searchparams.HybridSearch{Query: hs.Query, Properties: hs.Properties, Vector: hs.Vector, Alpha: float64(hs.Alpha), FusionAlgorithm: fusionType}
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
@@ -425,6 +527,7 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 		// TODO: align default with other APIs
 		out.Pagination.Limit = 10
 	}
+	out.Pagination.Autocut = int(req.Autocut)
 
 	return out, nil
 }
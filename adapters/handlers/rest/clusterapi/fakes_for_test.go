@@ -60,6 +60,10 @@ func (f *fakeRepo) DeleteShards(ctx context.Context, class string, shards []stri
 	return nil
 }
 
+func (f *fakeRepo) SaveAliases(ctx context.Context, aliases map[string]string) error {
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -221,7 +225,11 @@ func (n *NilMigrator) UpdatePropertyAddDataType(ctx context.Context, className s
 	return nil
 }
 
-func (n *NilMigrator) DropProperty(ctx context.Context, className string, propName string) error {
+func (n *NilMigrator) DropProperty(ctx context.Context, className string, propName string, changedAt int64) error {
+	return nil
+}
+
+func (n *NilMigrator) RenameProperty(ctx context.Context, className string, propName string, newName string, changedAt int64) error {
 	return nil
 }
 
@@ -233,6 +241,10 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) UpdateVectorIndexConfigs(ctx context.Context, className string, updated map[string]schemaent.VectorIndexConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateInvertedIndexConfigUpdate(ctx context.Context, old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
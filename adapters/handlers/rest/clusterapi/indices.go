@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	entschema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -48,6 +49,7 @@ type indices struct {
 	regexpShardFiles          *regexp.Regexp
 	regexpShard               *regexp.Regexp
 	regexpShardReinit         *regexp.Regexp
+	schema                    schemaGetter
 }
 
 const (
@@ -100,7 +102,8 @@ type shards interface {
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
 		id []strfmt.UUID) ([]*storobj.Object, error)
 	Search(ctx context.Context, indexName, shardName string,
-		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
+		vector []float32, targetVector string, indexParams *searchparams.VectorIndex,
+		distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties,
@@ -132,7 +135,18 @@ type db interface {
 	StartupComplete() bool
 }
 
-func NewIndices(shards shards, db db) *indices {
+// schemaGetter provides the classes of the indices, so that the objects
+// received for an index are typed by the data types declared in its class
+type schemaGetter interface {
+	GetSchemaSkipAuth() entschema.Schema
+}
+
+func getClass(sg schemaGetter, index string) *models.Class {
+	sch := sg.GetSchemaSkipAuth()
+	return sch.GetClass(entschema.ClassName(index))
+}
+
+func NewIndices(shards shards, db db, schema schemaGetter) *indices {
 	return &indices{
 		regexpObjects:             regexp.MustCompile(urlPatternObjects),
 		regexpObjectsOverwrite:    regexp.MustCompile(urlPatternObjectsOverwrite),
@@ -148,6 +162,7 @@ func NewIndices(shards shards, db db) *indices {
 		regexpShardReinit:         regexp.MustCompile(urlPatternShardReinit),
 		shards:                    shards,
 		db:                        db,
+		schema:                    schema,
 	}
 }
 
@@ -314,7 +329,7 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	obj, err := IndicesPayloads.SingleObject.Unmarshal(bodyBytes)
+	obj, err := IndicesPayloads.SingleObject.Unmarshal(bodyBytes, getClass(i.schema, index))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -337,7 +352,7 @@ func (i *indices) postObjectBatch(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	objs, err := IndicesPayloads.ObjectList.Unmarshal(bodyBytes)
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(bodyBytes, getClass(i.schema, index))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -588,7 +603,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, err := IndicesPayloads.SearchParams.
+		vector, targetVector, indexParams, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -597,7 +612,7 @@ func (i *indices) postSearchObjects() http.Handler {
 		}
 
 		results, dists, err := i.shards.Search(r.Context(), index, shard,
-			vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+			vector, targetVector, indexParams, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -147,8 +148,10 @@ func (p singleObjectPayload) Marshal(in *storobj.Object) ([]byte, error) {
 	return in.MarshalBinary()
 }
 
-func (p singleObjectPayload) Unmarshal(in []byte) (*storobj.Object, error) {
-	return storobj.FromBinary(in)
+// Unmarshal parses the object, the property values are typed by the data
+// types declared in the class
+func (p singleObjectPayload) Unmarshal(in []byte, class *models.Class) (*storobj.Object, error) {
+	return storobj.FromBinaryWithClass(in, class)
 }
 
 type objectListPayload struct{}
@@ -194,7 +197,9 @@ func (p objectListPayload) Marshal(in []*storobj.Object) ([]byte, error) {
 	return out, nil
 }
 
-func (p objectListPayload) Unmarshal(in []byte) ([]*storobj.Object, error) {
+// Unmarshal parses the objects, the property values are typed by the data
+// types declared in the class
+func (p objectListPayload) Unmarshal(in []byte, class *models.Class) ([]*storobj.Object, error) {
 	var out []*storobj.Object
 
 	reusableLengthBuf := make([]byte, 8)
@@ -215,7 +220,7 @@ func (p objectListPayload) Unmarshal(in []byte) ([]*storobj.Object, error) {
 			return nil, err
 		}
 
-		obj, err := storobj.FromBinary(payloadBytes)
+		obj, err := storobj.FromBinaryWithClass(payloadBytes, class)
 		if err != nil {
 			return nil, err
 		}
@@ -340,13 +345,16 @@ func (p mergeDocPayload) Unmarshal(in []byte) (objects.MergeDocument, error) {
 
 type searchParamsPayload struct{}
 
-func (p searchParamsPayload) Marshal(vector []float32, limit int,
+func (p searchParamsPayload) Marshal(vector []float32, targetVector string,
+	indexParams *searchparams.VectorIndex, limit int,
 	filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	addP additional.Properties,
 ) ([]byte, error) {
 	type params struct {
 		SearchVector   []float32                    `json:"searchVector"`
+		TargetVector   string                       `json:"targetVector"`
+		IndexParams    *searchparams.VectorIndex    `json:"indexParams"`
 		Limit          int                          `json:"limit"`
 		Filters        *filters.LocalFilter         `json:"filters"`
 		KeywordRanking *searchparams.KeywordRanking `json:"keywordRanking"`
@@ -356,16 +364,18 @@ func (p searchParamsPayload) Marshal(vector []float32, limit int,
 		Additional     additional.Properties        `json:"additional"`
 	}
 
-	par := params{vector, limit, filter, keywordRanking, sort, cursor, groupBy, addP}
+	par := params{vector, targetVector, indexParams, limit, filter, keywordRanking, sort, cursor, groupBy, addP}
 	return json.Marshal(par)
 }
 
-func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, float32, int,
-	*filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort,
+func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, string, *searchparams.VectorIndex,
+	float32, int, *filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort,
 	*filters.Cursor, *searchparams.GroupBy, additional.Properties, error,
 ) {
 	type searchParametersPayload struct {
 		SearchVector   []float32                    `json:"searchVector"`
+		TargetVector   string                       `json:"targetVector"`
+		IndexParams    *searchparams.VectorIndex    `json:"indexParams"`
 		Distance       float32                      `json:"distance"`
 		Limit          int                          `json:"limit"`
 		Filters        *filters.LocalFilter         `json:"filters"`
@@ -377,7 +387,7 @@ func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, float32, int,
 	}
 	var par searchParametersPayload
 	err := json.Unmarshal(in, &par)
-	return par.SearchVector, par.Distance, par.Limit,
+	return par.SearchVector, par.TargetVector, par.IndexParams, par.Distance, par.Limit,
 		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.GroupBy, par.Additional, err
 }
 
@@ -396,13 +406,13 @@ func (p searchParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
 
 type searchResultsPayload struct{}
 
-func (p searchResultsPayload) Unmarshal(in []byte) ([]*storobj.Object, []float32, error) {
+func (p searchResultsPayload) Unmarshal(in []byte, class *models.Class) ([]*storobj.Object, []float32, error) {
 	read := uint64(0)
 
 	objsLength := binary.LittleEndian.Uint64(in[read : read+8])
 	read += 8
 
-	objs, err := IndicesPayloads.ObjectList.Unmarshal(in[read : read+objsLength])
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(in[read:read+objsLength], class)
 	if err != nil {
 		return nil, nil, err
 	}
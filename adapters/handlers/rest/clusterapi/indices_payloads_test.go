@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	entschema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -86,7 +87,7 @@ func Test_objectListPayload_Marshal(t *testing.T) {
 	b, err := payload.Marshal(objs)
 	require.Nil(t, err)
 
-	received, err := payload.Unmarshal(b)
+	received, err := payload.Unmarshal(b, nil)
 	require.Nil(t, err)
 	assert.Len(t, received, 2)
 	assert.EqualValues(t, objs[0].Object, received[0].Object)
@@ -94,3 +95,62 @@ func Test_objectListPayload_Marshal(t *testing.T) {
 	assert.EqualValues(t, objs[2].Object, received[1].Object)
 	assert.EqualValues(t, objs[2].ID(), received[1].ID())
 }
+
+func Test_objectPayloads_NestedObjects(t *testing.T) {
+	class := &models.Class{
+		Class: "Place",
+		Properties: []*models.Property{
+			{Name: "area", DataType: entschema.DataTypeObject.PropString()},
+			{Name: "contact", DataType: entschema.DataTypeObject.PropString()},
+			{Name: "links", DataType: entschema.DataTypeObjectArray.PropString()},
+		},
+	}
+
+	// nested values whose keys look like geo coordinates, a phone number and
+	// a cross-reference
+	properties := map[string]interface{}{
+		"area": map[string]interface{}{
+			"latitude":  float64(52.37),
+			"longitude": float64(4.89),
+			"name":      "Amsterdam",
+		},
+		"contact": map[string]interface{}{
+			"input":          "020 1234567",
+			"defaultCountry": "nl",
+			"department":     "sales",
+		},
+		"links": []interface{}{
+			map[string]interface{}{
+				"beacon": "https://example.com/place",
+				"label":  "website",
+			},
+		},
+	}
+
+	obj := storobj.FromObject(&models.Object{
+		Class:      "Place",
+		ID:         strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+		Properties: properties,
+	}, []float32{1, 2, 3})
+
+	t.Run("single object", func(t *testing.T) {
+		payload := singleObjectPayload{}
+		b, err := payload.Marshal(obj)
+		require.Nil(t, err)
+
+		received, err := payload.Unmarshal(b, class)
+		require.Nil(t, err)
+		assert.Equal(t, properties, received.Properties())
+	})
+
+	t.Run("object list", func(t *testing.T) {
+		payload := objectListPayload{}
+		b, err := payload.Marshal([]*storobj.Object{obj})
+		require.Nil(t, err)
+
+		received, err := payload.Unmarshal(b, class)
+		require.Nil(t, err)
+		require.Len(t, received, 1)
+		assert.Equal(t, properties, received[0].Properties())
+	})
+}
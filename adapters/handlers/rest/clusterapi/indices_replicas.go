@@ -64,6 +64,7 @@ type localScaler interface {
 type replicatedIndices struct {
 	shards replicator
 	scaler localScaler
+	schema schemaGetter
 }
 
 var (
@@ -83,10 +84,13 @@ var (
 		`\/shards\/(` + sh + `):(commit|abort)`)
 )
 
-func NewReplicatedIndices(shards replicator, scaler localScaler) *replicatedIndices {
+func NewReplicatedIndices(shards replicator, scaler localScaler,
+	schema schemaGetter,
+) *replicatedIndices {
 	return &replicatedIndices{
 		shards: shards,
 		scaler: scaler,
+		schema: schema,
 	}
 }
 
@@ -502,7 +506,7 @@ func (i *replicatedIndices) postObjectSingle(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	obj, err := IndicesPayloads.SingleObject.Unmarshal(bodyBytes)
+	obj, err := IndicesPayloads.SingleObject.Unmarshal(bodyBytes, getClass(i.schema, index))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -533,7 +537,7 @@ func (i *replicatedIndices) postObjectBatch(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	objs, err := IndicesPayloads.ObjectList.Unmarshal(bodyBytes)
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(bodyBytes, getClass(i.schema, index))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -27,8 +27,9 @@ func Serve(appState *state.State) {
 		Debugf("serving cluster api on port %d", port)
 
 	schema := NewSchema(appState.SchemaManager.TxManager())
-	indices := NewIndices(appState.RemoteIndexIncoming, appState.DB)
-	replicatedIndices := NewReplicatedIndices(appState.RemoteReplicaIncoming, appState.Scaler)
+	indices := NewIndices(appState.RemoteIndexIncoming, appState.DB, appState.SchemaManager)
+	replicatedIndices := NewReplicatedIndices(appState.RemoteReplicaIncoming,
+		appState.Scaler, appState.SchemaManager)
 	classifications := NewClassifications(appState.ClassificationRepo.TxManager())
 	nodes := NewNodes(appState.RemoteNodeIncoming)
 	backups := NewBackups(appState.BackupManager)
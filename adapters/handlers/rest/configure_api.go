@@ -37,6 +37,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
+	"github.com/weaviate/weaviate/adapters/repos/revectorizations"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
@@ -44,25 +45,41 @@ import (
 	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
 	modstggcs "github.com/weaviate/weaviate/modules/backup-gcs"
 	modstgs3 "github.com/weaviate/weaviate/modules/backup-s3"
+	modgenerativeanthropic "github.com/weaviate/weaviate/modules/generative-anthropic"
+	modgenerativeaws "github.com/weaviate/weaviate/modules/generative-aws"
 	modgenerativecohere "github.com/weaviate/weaviate/modules/generative-cohere"
+	modgenerativegoogle "github.com/weaviate/weaviate/modules/generative-google"
 	modgenerativeopenai "github.com/weaviate/weaviate/modules/generative-openai"
 	modgenerativepalm "github.com/weaviate/weaviate/modules/generative-palm"
 	modimage "github.com/weaviate/weaviate/modules/img2vec-neural"
 	modclip "github.com/weaviate/weaviate/modules/multi2vec-clip"
+	modmulti2veccohere "github.com/weaviate/weaviate/modules/multi2vec-cohere"
+	modmulti2vecgoogle "github.com/weaviate/weaviate/modules/multi2vec-google"
 	modner "github.com/weaviate/weaviate/modules/ner-transformers"
 	modqnaopenai "github.com/weaviate/weaviate/modules/qna-openai"
 	modqna "github.com/weaviate/weaviate/modules/qna-transformers"
 	modcentroid "github.com/weaviate/weaviate/modules/ref2vec-centroid"
 	modrerankercohere "github.com/weaviate/weaviate/modules/reranker-cohere"
+	modrerankerjinaai "github.com/weaviate/weaviate/modules/reranker-jinaai"
 	modrerankertransformers "github.com/weaviate/weaviate/modules/reranker-transformers"
+	modrerankervoyageai "github.com/weaviate/weaviate/modules/reranker-voyageai"
 	modsum "github.com/weaviate/weaviate/modules/sum-transformers"
 	modspellcheck "github.com/weaviate/weaviate/modules/text-spellcheck"
+	modcolbert "github.com/weaviate/weaviate/modules/text2colbert-transformers"
+	modtext2vecaws "github.com/weaviate/weaviate/modules/text2vec-aws"
 	modcohere "github.com/weaviate/weaviate/modules/text2vec-cohere"
 	modcontextionary "github.com/weaviate/weaviate/modules/text2vec-contextionary"
+	modtext2veccustom "github.com/weaviate/weaviate/modules/text2vec-custom"
+	modtext2vecgoogle "github.com/weaviate/weaviate/modules/text2vec-google"
+	modtext2vecgpt4all "github.com/weaviate/weaviate/modules/text2vec-gpt4all"
 	modhuggingface "github.com/weaviate/weaviate/modules/text2vec-huggingface"
+	modjinaai "github.com/weaviate/weaviate/modules/text2vec-jinaai"
+	modtext2vecmock "github.com/weaviate/weaviate/modules/text2vec-mock"
+	modtext2vecollama "github.com/weaviate/weaviate/modules/text2vec-ollama"
 	modopenai "github.com/weaviate/weaviate/modules/text2vec-openai"
 	modtext2vecpalm "github.com/weaviate/weaviate/modules/text2vec-palm"
 	modtransformers "github.com/weaviate/weaviate/modules/text2vec-transformers"
+	modvoyageai "github.com/weaviate/weaviate/modules/text2vec-voyageai"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/classification"
@@ -72,6 +89,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/revectorization"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
@@ -173,6 +191,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		QueryMaximumResults:       appState.ServerConfig.Config.QueryMaximumResults,
 		MaxImportGoroutinesFactor: appState.ServerConfig.Config.MaxImportGoroutinesFactor,
 		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
+		AsyncIndexing:             appState.ServerConfig.Config.AsyncIndexing,
+		TombstoneCleanup:          appState.ServerConfig.Config.TombstoneCleanup,
+		HNSWSnapshots:             appState.ServerConfig.Config.Persistence.HNSWSnapshots,
 		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics) // TODO client
 	if err != nil {
@@ -203,6 +224,15 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		os.Exit(1)
 	}
 
+	revectorizationRepo, err := revectorizations.NewRepo(
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize revectorizations repo")
+		os.Exit(1)
+	}
+
 	// TODO: configure http transport for efficient intra-cluster comm
 	classificationsTxClient := clients.NewClusterClassifications(clusterHttpClient)
 	classifierRepo := classifications.NewDistributeRepo(classificationsTxClient,
@@ -276,6 +306,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	classifier := classification.New(schemaManager, classifierRepo, vectorRepo, appState.Authorizer,
 		appState.Logger, appState.Modules)
 
+	revectorizer := revectorization.New(schemaManager, revectorizationRepo, repo,
+		appState.Authorizer, appState.Logger, appState.Modules)
+
 	updateSchemaCallback := makeUpdateSchemaCall(appState.Logger, appState, objectsTraverser)
 	schemaManager.RegisterSchemaUpdateCallback(updateSchemaCallback)
 
@@ -288,6 +321,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupMiscHandlers(api, appState.ServerConfig, schemaManager, appState.Modules,
 		appState.Metrics, appState.Logger)
 	setupClassificationHandlers(api, classifier, appState.Metrics, appState.Logger)
+	setupRevectorizationHandlers(api, revectorizer, appState.Metrics, appState.Logger)
 	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
 	setupNodesHandlers(api, schemaManager, repo, appState)
 
@@ -532,6 +566,22 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modrerankervoyageai.Name]; ok {
+		appState.Modules.Register(modrerankervoyageai.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modrerankervoyageai.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modrerankerjinaai.Name]; ok {
+		appState.Modules.Register(modrerankerjinaai.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modrerankerjinaai.Name).
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules["qna-transformers"]; ok {
 		appState.Modules.Register(modqna.New())
 		appState.Logger.
@@ -620,6 +670,30 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modgenerativeanthropic.Name]; ok {
+		appState.Modules.Register(modgenerativeanthropic.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modgenerativeanthropic.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modgenerativeaws.Name]; ok {
+		appState.Modules.Register(modgenerativeaws.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modgenerativeaws.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modgenerativegoogle.Name]; ok {
+		appState.Modules.Register(modgenerativegoogle.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modgenerativegoogle.Name).
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules[modgenerativepalm.Name]; ok {
 		appState.Modules.Register(modgenerativepalm.New())
 		appState.Logger.
@@ -636,6 +710,94 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modtext2vecollama.Name]; ok {
+		appState.Modules.Register(modtext2vecollama.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtext2vecollama.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modtext2veccustom.Name]; ok {
+		appState.Modules.Register(modtext2veccustom.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtext2veccustom.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modtext2vecgpt4all.Name]; ok {
+		appState.Modules.Register(modtext2vecgpt4all.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtext2vecgpt4all.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modtext2vecmock.Name]; ok {
+		appState.Modules.Register(modtext2vecmock.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtext2vecmock.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modcolbert.Name]; ok {
+		appState.Modules.Register(modcolbert.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modcolbert.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modvoyageai.Name]; ok {
+		appState.Modules.Register(modvoyageai.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modvoyageai.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modjinaai.Name]; ok {
+		appState.Modules.Register(modjinaai.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modjinaai.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modtext2vecaws.Name]; ok {
+		appState.Modules.Register(modtext2vecaws.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtext2vecaws.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modtext2vecgoogle.Name]; ok {
+		appState.Modules.Register(modtext2vecgoogle.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtext2vecgoogle.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modmulti2vecgoogle.Name]; ok {
+		appState.Modules.Register(modmulti2vecgoogle.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modmulti2vecgoogle.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modmulti2veccohere.Name]; ok {
+		appState.Modules.Register(modmulti2veccohere.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modmulti2veccohere.Name).
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules[modstgfs.Name]; ok {
 		appState.Modules.Register(modstgfs.New())
 		appState.Logger.
@@ -147,6 +147,201 @@ func init() {
         }
       }
     },
+    "/aliases": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "List all aliases.",
+        "description": "Lists all aliases together with the classes they point to.",
+        "operationId": "aliases.get",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the aliases.",
+            "schema": {
+              "$ref": "#/definitions/AliasResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create an alias for a class.",
+        "description": "Creates an alias which can be used instead of the name of the class it points to in the object and GraphQL APIs. The alias must not collide with the name of an existing class or alias.",
+        "operationId": "aliases.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Created the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias, e.g. the name is taken or the class does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/aliases/{aliasName}": {
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete an alias.",
+        "description": "Deletes the alias, the class it pointed to is not affected.",
+        "operationId": "aliases.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted the alias."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Alias does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "put": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Point an alias to another class.",
+        "description": "Points an existing alias to another class. Requests using the alias are served by the new class right away, which allows to swap in a reindexed copy of a class without downtime.",
+        "operationId": "aliases.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Alias does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias, e.g. the class does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/backups/{backend}": {
       "post": {
         "description": "Starts a process of creating a backup for a set of classes",
@@ -549,6 +744,73 @@ func init() {
         ]
       }
     },
+    "/batch/ref2vec": {
+      "post": {
+        "description": "Recompute the vectors of objects that match a certain filter from the vectors of their references. Only applies to classes vectorized by a ref2vec module.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Recomputes the vectors of ref2vec vectorized Objects based on a match filter as a batch.",
+        "operationId": "batch.ref2vec.recompute",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchRef2VecRecompute"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about the recomputed objects.",
+            "schema": {
+              "$ref": "#/definitions/BatchRef2VecRecomputeResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file and vectorized by a ref2vec module?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
     "/batch/references": {
       "post": {
         "description": "Register cross-references between any class items (objects or objects) in bulk.",
@@ -721,30 +983,32 @@ func init() {
         ]
       }
     },
-    "/graphql": {
-      "post": {
-        "description": "Get an object based on GraphQL",
+    "/debug/index/{className}/{shardName}": {
+      "get": {
+        "description": "Returns stats of the vector index of a shard on this node, such as the number of nodes per layer, tombstones, cache hit rate and a memory estimate.",
         "tags": [
-          "graphql"
+          "nodes"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "operationId": "nodes.index.stats",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Index stats successfully returned",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/IndexStats"
             }
           },
           "401": {
@@ -756,13 +1020,72 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "404": {
+            "description": "Not Found - Class or shard does not exist on this node",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "500": {
+          "422": {
+            "description": "The vector index of the shard does not provide stats, e.g. because it is not an hnsw index.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.index.stats"
+        ]
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
+        "parameters": [
+          {
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
@@ -2611,27 +2934,31 @@ func init() {
         ]
       }
     },
-    "/schema/{className}/shards": {
-      "get": {
+    "/schema/{className}/properties/{propertyName}": {
+      "delete": {
         "tags": [
           "schema"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Delete a property of an Object class.",
+        "description": "Deletes a top level property. Its inverted indexes are dropped right away, its values are hidden from all results and removed from the stored objects in the background. Vectors are not recomputed: objects whose vector was built from the property keep that vector until they are vectorized again, i.e. when they are updated or the class is revectorized.",
+        "operationId": "schema.objects.properties.delete",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
-            "schema": {
-              "$ref": "#/definitions/ShardStatusList"
-            }
+            "description": "Deleted the property."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2643,7 +2970,7 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist",
+            "description": "Class or property does not exist.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2656,17 +2983,16 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
+      },
       "put": {
-        "description": "Update shard status of an Object Class",
         "tags": [
           "schema"
         ],
-        "operationId": "schema.objects.shards.update",
+        "summary": "Rename a property of an Object class.",
+        "description": "Renames a property, only the name in the body is used. The inverted indexes are renamed right away, the values stored in the objects are moved to the new name in the background. Vectors are not recomputed: vectorizers which include property names only pick up the new name once an object is vectorized again, i.e. when it is updated or the class is revectorized. Renaming geoCoordinates properties is not supported.",
+        "operationId": "schema.objects.properties.update",
         "parameters": [
           {
             "type": "string",
@@ -2676,7 +3002,7 @@ func init() {
           },
           {
             "type": "string",
-            "name": "shardName",
+            "name": "propertyName",
             "in": "path",
             "required": true
           },
@@ -2685,15 +3011,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "Renamed the property.",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -2706,13 +3032,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Shard to be updated does not exist",
+            "description": "Class or property does not exist.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid new name, e.g. a property with that name exists already.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2729,13 +3055,13 @@ func init() {
         ]
       }
     },
-    "/schema/{className}/tenants": {
+    "/schema/{className}/revectorize": {
       "get": {
-        "description": "get all tenants from a specific class",
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.get",
+        "summary": "Get the status of the revectorization of an Object class",
+        "operationId": "schema.objects.revectorize.status",
         "parameters": [
           {
             "type": "string",
@@ -2746,12 +3072,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "tenants from specified class.",
+            "description": "Found the revectorization of the class, returned as body",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Revectorization"
             }
           },
           "401": {
@@ -2763,8 +3086,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
+          "404": {
+            "description": "This class does not exist or has never been revectorized",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2775,14 +3098,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       },
       "post": {
-        "description": "Create a new tenant for a specific class",
+        "description": "Re-runs the vectorizer over all existing objects of an Object Class and swaps in the newly built vector index once all objects are done. The class stays readable, but rejects writes until the revectorization has completed. An interrupted or failed revectorization is resumed by sending the request again.",
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.create",
+        "summary": "Revectorize all objects of an Object class",
+        "operationId": "schema.objects.revectorize",
         "parameters": [
           {
             "type": "string",
@@ -2795,21 +3122,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Revectorization"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added new tenants to the specified class",
+          "202": {
+            "description": "Started or resumed the revectorization of the class, the job runs in the background",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Revectorization"
             }
           },
           "401": {
@@ -2821,8 +3142,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2833,14 +3160,68 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "delete": {
-        "description": "delete tenants from a specific class",
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards": {
+      "get": {
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.delete",
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the status of the shards, returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.shards.update",
         "parameters": [
           {
             "type": "string",
@@ -2849,20 +3230,26 @@ func init() {
             "required": true
           },
           {
-            "name": "tenants",
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/ShardStatus"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Deleted tenants from specified class."
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2873,8 +3260,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2885,45 +3278,291 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "calibrates term-weight scaling based on the document length",
-          "type": "number",
-          "format": "float"
         },
-        "k1": {
-          "description": "calibrates term-weight scaling based on the term frequency within a document",
-          "type": "number",
-          "format": "float"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup creation process",
-          "type": "array",
-          "items": {
+    "/schema/{className}/synonyms": {
+      "put": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Replace the synonym dictionary of an Object class.",
+        "description": "Replaces the synonyms which are used to expand the terms of bm25 and hybrid queries on the class, e.g. 'tv' with 'television'. Synonyms are applied at query time, so no reindexing is required.",
+        "operationId": "schema.objects.synonyms.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SynonymConfig"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Replaced the synonyms.",
+            "schema": {
+              "$ref": "#/definitions/SynonymConfig"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid synonyms.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a new tenant for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "delete tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from specified class."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "Alias": {
+      "description": "An alternative name of a class, which can be used instead of the name of the class in all APIs.",
+      "type": "object",
+      "properties": {
+        "alias": {
+          "description": "The name of the alias.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The name of the class the alias points to.",
+          "type": "string"
+        }
+      }
+    },
+    "AliasResponse": {
+      "description": "The aliases of the schema.",
+      "type": "object",
+      "properties": {
+        "aliases": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Alias"
+          }
+        }
+      }
+    },
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
+      "properties": {
+        "b": {
+          "description": "calibrates term-weight scaling based on the document length",
+          "type": "number",
+          "format": "float"
+        },
+        "k1": {
+          "description": "calibrates term-weight scaling based on the term frequency within a document",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
+      "properties": {
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup creation process",
+          "type": "array",
+          "items": {
             "type": "string"
           }
         },
@@ -3228,6 +3867,78 @@ func init() {
         }
       }
     },
+    "BatchRef2VecRecompute": {
+      "type": "object",
+      "properties": {
+        "match": {
+          "description": "Outlines how to find the objects whose vectors will be recomputed.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) of the objects, must be vectorized by a ref2vec module.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects whose vectors will be recomputed.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        }
+      }
+    },
+    "BatchRef2VecRecomputeResponse": {
+      "description": "Recompute ref2vec vectors response.",
+      "type": "object",
+      "properties": {
+        "match": {
+          "description": "Outlines how to find the objects whose vectors will be recomputed.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) of the objects, must be vectorized by a ref2vec module.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects whose vectors will be recomputed.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been recomputed but could not be recomputed.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be recomputed in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "successful": {
+              "description": "How many objects had their vector successfully recomputed in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
     "BatchReference": {
       "properties": {
         "from": {
@@ -3453,6 +4164,13 @@ func init() {
           "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
           "type": "object"
         },
+        "vectorConfig": {
+          "description": "Configure named vectors. Each named vector has its own vectorizer and vector index.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
         "vectorIndexType": {
           "description": "Name of the vector index to use, eg. (HNSW)",
           "type": "string"
@@ -3753,6 +4471,91 @@ func init() {
         "$ref": "#/definitions/GraphQLResponse"
       }
     },
+    "IndexStats": {
+      "description": "Statistics of the vector index of a single shard, meant for capacity planning and debugging.",
+      "properties": {
+        "cacheHitRate": {
+          "description": "The share of vector cache lookups which were served from the cache.",
+          "type": "number",
+          "x-omitempty": false
+        },
+        "cacheHits": {
+          "description": "The number of vector cache lookups which were served from the cache.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "cacheMaxSize": {
+          "description": "The maximum number of vectors in the vector cache.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "cacheMisses": {
+          "description": "The number of vector cache lookups which had to read the vector from disk.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "cachedVectors": {
+          "description": "The number of vectors currently held in the vector cache.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "class": {
+          "description": "The name of the class.",
+          "type": "string"
+        },
+        "compression": {
+          "description": "The compression of the vectors, one of none, pq, bq or sq.",
+          "type": "string"
+        },
+        "entryPoint": {
+          "description": "The id of the entry point of the graph.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "maxLayer": {
+          "description": "The highest layer of the graph.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "memoryEstimate": {
+          "description": "A rough estimate of the memory used by the graph and the vector cache in bytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "nodeCount": {
+          "description": "The number of nodes in the graph, including deleted ones which have not been cleaned up yet.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "nodesPerLayer": {
+          "description": "The number of nodes on each layer of the graph, starting with the lowest layer.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "int64"
+          },
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "tombstoneCount": {
+          "description": "The number of deleted nodes which have not been cleaned up yet.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "InvertedIndexConfig": {
       "description": "Configure the inverted index built into Weaviate",
       "type": "object",
@@ -3779,6 +4582,9 @@ func init() {
         },
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "$ref": "#/definitions/SynonymConfig"
         }
       }
     },
@@ -3843,6 +4649,56 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Data type of the nested property. Can be any primitive data type except geoCoordinates, phoneNumber and the deprecated string and string[], or ` + "`" + `object` + "`" + ` and ` + "`" + `object[]` + "`" + ` to nest further",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the nested property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index, so that it can be used in where filters with a dot path, e.g. ` + "`" + `address.city` + "`" + `. Defaults to true",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index for bm25 and hybrid search. Defaults to true. Applicable only to nested properties of data type text and text[]",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the nested property, unique among the nested properties of its parent.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The nested properties of a nested property of data type ` + "`" + `object` + "`" + ` or ` + "`" + `object[]` + "`" + `.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          }
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the nested property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default), ` + "`" + `lowercase` + "`" + `, ` + "`" + `whitespace` + "`" + `, ` + "`" + `field` + "`" + `, ` + "`" + `trigram` + "`" + `, ` + "`" + `gse` + "`" + ` and ` + "`" + `kagome_ja` + "`" + `, see the tokenization of properties for details. Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "gse",
+            "kagome_ja"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -3861,6 +4717,15 @@ func init() {
           "type": "number",
           "format": "int64",
           "x-omitempty": false
+        },
+        "vectorIndexingStatus": {
+          "description": "The status of the vector indexing process.",
+          "type": "string"
+        },
+        "vectorQueueLength": {
+          "description": "The length of the vector indexing queue.",
+          "type": "number",
+          "format": "int64"
         }
       }
     },
@@ -3970,6 +4835,10 @@ func init() {
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "description": "This object's named vectors, keyed by the name of the target vector as configured in the class' vectorConfig.",
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
@@ -4212,6 +5081,31 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexNullState": {
+          "description": "Optional. Should the null state of this property be indexed, so that objects without a value for it can be found with the ` + "`" + `IsNull` + "`" + ` filter operator. Defaults to the class-wide ` + "`" + `invertedIndexConfig.indexNullState` + "`" + ` setting. Applicable only to properties with indexFilterable or indexSearchable enabled",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the terms of this property be stored in the inverted index. Defaults to false. Applicable only to properties of data type text and text[] with indexSearchable enabled. Required to search for quoted phrases in bm25 or hybrid search, e.g. ` + "`" + `\"climate change\"` + "`" + `",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexPropertyLength": {
+          "description": "Optional. Should the length of this property be indexed, so that objects can be filtered by it with ` + "`" + `len(property)` + "`" + ` where filters. Defaults to the class-wide ` + "`" + `invertedIndexConfig.indexPropertyLength` + "`" + ` setting. Applicable only to properties with indexFilterable or indexSearchable enabled and a data type that has a length, i.e. not int, number, boolean, date, geoCoordinates, phoneNumber and blob",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexRangeFilters": {
+          "description": "Optional. Should the values of this property be stored in an index optimized for range filters. Defaults to false. Applicable only to properties of data type int, number and date. Serves filters like ` + "`" + `valueDate > X` + "`" + ` without expanding them to all matching values, which is considerably faster for large classes",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexReversedTerms": {
+          "description": "Optional. Should the terms of this property also be stored reversed in the inverted index. Defaults to false. Applicable only to properties of data type text and text[] with indexFilterable enabled. Allows like filters starting with a wildcard, e.g. ` + "`" + `*base` + "`" + `, to seek the matching terms instead of scanning all of them",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "indexSearchable": {
           "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
           "type": "boolean",
@@ -4225,14 +5119,28 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "nestedProperties": {
+          "description": "The nested properties of a property of data type ` + "`" + `object` + "`" + ` or ` + "`" + `object[]` + "`" + `. Required for these data types and not supported for any other",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          }
+        },
+        "stemmer": {
+          "description": "Optional. Reduces the words of text and text[] properties to their stem, so that e.g. ` + "`" + `running` + "`" + ` and ` + "`" + `runs` + "`" + ` both match ` + "`" + `run` + "`" + ` in bm25 and where filters. Applied when indexing and querying, cannot be changed afterwards. Allowed values are ` + "`" + `en` + "`" + ` and ` + "`" + `de` + "`" + `, stemming is disabled if not set. Not supported for ` + "`" + `field` + "`" + `, ` + "`" + `trigram` + "`" + `, ` + "`" + `gse` + "`" + ` and ` + "`" + `kagome_ja` + "`" + ` tokenization",
+          "type": "string"
+        },
         "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `trigram` + "`" + ` (splits on any non-alphanumerical, lowercases and indexes all sequences of three characters of each word, to match misspellings and partial words, not supported by the Like and Regex operators), ` + "`" + `gse` + "`" + ` (splits Chinese and Japanese text into all dictionary words it contains), ` + "`" + `kagome_ja` + "`" + ` (splits Japanese text into words by morphological analysis). Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
             "lowercase",
             "whitespace",
-            "field"
+            "field",
+            "trigram",
+            "gse",
+            "kagome_ja"
           ]
         }
       }
@@ -4287,25 +5195,96 @@ func init() {
           "type": "number",
           "format": "int64"
         },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
+      "properties": {
+        "factor": {
+          "description": "Number of times a class is replicated",
+          "type": "integer"
+        }
+      }
+    },
+    "Revectorization": {
+      "description": "Re-run a vectorizer over all existing objects of a class and swap in the newly built vector index once all objects are done.",
+      "type": "object",
+      "properties": {
+        "after": {
+          "description": "id of the last object that was revectorized, a resumed revectorization continues after this object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "class": {
+          "description": "class (name) which is revectorized",
+          "type": "string",
+          "example": "City"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "vectorize object: connection refused"
+        },
+        "meta": {
+          "description": "additional meta information about the revectorization",
+          "type": "object",
+          "$ref": "#/definitions/RevectorizationMeta"
+        },
+        "moduleConfig": {
+          "description": "the module config to vectorize the objects with, it replaces the module config of the class once all objects are revectorized. Defaults to the current module config of the class",
+          "type": "object"
+        },
+        "objectsPerSecond": {
+          "description": "maximum number of objects to vectorize per second, 0 means unlimited",
+          "type": "integer",
+          "format": "int64",
+          "example": 100
         },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
+        "status": {
+          "description": "status of this revectorization",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed",
+            "interrupted"
+          ],
+          "example": "running"
         }
       }
     },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
+    "RevectorizationMeta": {
+      "description": "Additional information to a specific revectorization",
       "type": "object",
       "properties": {
-        "factor": {
-          "description": "Number of times a class is replicated",
-          "type": "integer"
+        "completed": {
+          "description": "time when this revectorization finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were revectorized so far",
+          "type": "integer",
+          "example": 147
+        },
+        "started": {
+          "description": "time when this revectorization was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         }
       }
     },
@@ -4434,7 +5413,7 @@ func init() {
           }
         },
         "preset": {
-          "description": "pre-existing list of common words by language",
+          "description": "pre-existing list of common words by language, one of en, de, fr or none",
           "type": "string"
         },
         "removals": {
@@ -4446,6 +5425,37 @@ func init() {
         }
       }
     },
+    "SynonymConfig": {
+      "description": "synonym dictionary used to expand bm25 and hybrid query terms",
+      "type": "object",
+      "properties": {
+        "groups": {
+          "description": "groups of equivalent terms",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymGroup"
+          }
+        },
+        "weight": {
+          "description": "weight applied to matches on synonyms relative to the original query terms, between 0 (exclusive) and 1. Defaults to 1",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "SynonymGroup": {
+      "description": "a set of terms which are considered equivalent at query time",
+      "type": "object",
+      "properties": {
+        "terms": {
+          "description": "terms which expand to each other, e.g. [\"tv\", \"television\"]",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
@@ -4456,10 +5466,43 @@ func init() {
         }
       }
     },
+    "Vector": {
+      "description": "A single vector embedding",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "VectorConfig": {
+      "description": "Configuration of a single named vector",
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of the vectorizer used for this vector. Must contain exactly one key, which is the name of the vectorizer module (or 'none'), mapping to its module-specific settings.",
+          "type": "object"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "Vectors": {
+      "description": "A map of named vectors, keyed by the name of the target vector",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/Vector"
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -4479,6 +5522,7 @@ func init() {
             "Or",
             "Equal",
             "Like",
+            "Regex",
             "Not",
             "NotEqual",
             "GreaterThan",
@@ -4486,7 +5530,11 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
-            "IsNull"
+            "WithinGeoPolygon",
+            "WithinGeoBoundingBox",
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll"
           ],
           "example": "GreaterThanEqual"
         },
@@ -4508,12 +5556,47 @@ func init() {
           "x-nullable": true,
           "example": false
         },
+        "valueBooleanArray": {
+          "description": "value as boolean array",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
         "valueDate": {
           "description": "value as date (as string)",
           "type": "string",
           "x-nullable": true,
           "example": "TODO"
         },
+        "valueDateArray": {
+          "description": "value as date (as string) array",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "TODO"
+        },
+        "valueGeoBoundingBox": {
+          "description": "value as bounding box of geo coordinates",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoBoundingBox"
+        },
+        "valueGeoPolygon": {
+          "description": "value as polygon of geo coordinates",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoPolygon"
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -4527,6 +5610,20 @@ func init() {
           "x-nullable": true,
           "example": 2000
         },
+        "valueIntArray": {
+          "description": "value as integer array",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            100,
+            200
+          ]
+        },
         "valueNumber": {
           "description": "value as number/float",
           "type": "number",
@@ -4534,17 +5631,84 @@ func init() {
           "x-nullable": true,
           "example": 3.14
         },
+        "valueNumberArray": {
+          "description": "value as number/float array",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            100,
+            200
+          ]
+        },
         "valueString": {
           "description": "value as text (deprecated as of v1.19; alias for valueText)",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
         },
+        "valueStringArray": {
+          "description": "value as text array (deprecated as of v1.19; alias for valueTextArray)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        },
         "valueText": {
           "description": "value as text",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text array",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        }
+      }
+    },
+    "WhereFilterGeoBoundingBox": {
+      "description": "filter within a bounding box of geo coordinates",
+      "type": "object",
+      "properties": {
+        "bottomRight": {
+          "description": "the south-east corner of the bounding box",
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        },
+        "topLeft": {
+          "description": "the north-west corner of the bounding box",
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    },
+    "WhereFilterGeoPolygon": {
+      "description": "filter within a polygon of geo coordinates",
+      "type": "object",
+      "properties": {
+        "coordinates": {
+          "description": "the corners of the polygon in order, at least three. The last corner is connected to the first one",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
         }
       }
     },
@@ -4729,47 +5893,193 @@ func init() {
         "operationId": "weaviate.wellknown.liveness",
         "responses": {
           "200": {
-            "description": "The application is able to respond to HTTP requests"
+            "description": "The application is able to respond to HTTP requests"
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/aliases": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "List all aliases.",
+        "description": "Lists all aliases together with the classes they point to.",
+        "operationId": "aliases.get",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the aliases.",
+            "schema": {
+              "$ref": "#/definitions/AliasResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create an alias for a class.",
+        "description": "Creates an alias which can be used instead of the name of the class it points to in the object and GraphQL APIs. The alias must not collide with the name of an existing class or alias.",
+        "operationId": "aliases.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Created the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias, e.g. the name is taken or the class does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+    "/aliases/{aliasName}": {
+      "delete": {
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "schema"
+        ],
+        "summary": "Delete an alias.",
+        "description": "Deletes the alias, the class it pointed to is not affected.",
+        "operationId": "aliases.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
         "responses": {
           "200": {
-            "description": "Successful response, inspect body",
+            "description": "Deleted the alias."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
-                }
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Not found, no oidc provider present"
+            "description": "Alias does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4778,22 +6088,71 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "put": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Point an alias to another class.",
+        "description": "Points an existing alias to another class. Requests using the alias are served by the new class right away, which allows to swap in a reindexed copy of a class without downtime.",
+        "operationId": "aliases.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
+            "description": "Updated the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
           },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Alias does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias, e.g. the class does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
     "/backups/{backend}": {
@@ -5207,6 +6566,79 @@ func init() {
         ]
       }
     },
+    "/batch/ref2vec": {
+      "post": {
+        "description": "Recompute the vectors of objects that match a certain filter from the vectors of their references. Only applies to classes vectorized by a ref2vec module.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Recomputes the vectors of ref2vec vectorized Objects based on a match filter as a batch.",
+        "operationId": "batch.ref2vec.recompute",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchRef2VecRecompute"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about the recomputed objects.",
+            "schema": {
+              "$ref": "#/definitions/BatchRef2VecRecomputeResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file and vectorized by a ref2vec module?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
     "/batch/references": {
       "post": {
         "description": "Register cross-references between any class items (objects or objects) in bulk.",
@@ -5301,18 +6733,63 @@ func init() {
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.post"
+        ]
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
             "schema": {
               "$ref": "#/definitions/Classification"
             }
           },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -5322,6 +6799,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5330,32 +6810,36 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.classifications.get"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/debug/index/{className}/{shardName}": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        "description": "Returns stats of the vector index of a shard on this node, such as the number of nodes per layer, tombstones, cache hit rate and a memory estimate.",
         "tags": [
-          "classifications"
+          "nodes"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "operationId": "nodes.index.stats",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
-            "name": "id",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Index stats successfully returned",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/IndexStats"
             }
           },
           "401": {
@@ -5368,7 +6852,16 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "Not Found - Class or shard does not exist on this node",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The vector index of the shard does not provide stats, e.g. because it is not an hnsw index.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5378,7 +6871,7 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.nodes.index.stats"
         ]
       }
     },
@@ -7315,15 +8808,241 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/schema/{className}/properties": {
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties/{propertyName}": {
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete a property of an Object class.",
+        "description": "Deletes a top level property. Its inverted indexes are dropped right away, its values are hidden from all results and removed from the stored objects in the background. Vectors are not recomputed: objects whose vector was built from the property keep that vector until they are vectorized again, i.e. when they are updated or the class is revectorized.",
+        "operationId": "schema.objects.properties.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted the property."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "put": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Rename a property of an Object class.",
+        "description": "Renames a property, only the name in the body is used. The inverted indexes are renamed right away, the values stored in the objects are moved to the new name in the background. Vectors are not recomputed: vectorizers which include property names only pick up the new name once an object is vectorized again, i.e. when it is updated or the class is revectorized. Renaming geoCoordinates properties is not supported.",
+        "operationId": "schema.objects.properties.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Renamed the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid new name, e.g. a property with that name exists already.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/revectorize": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the status of the revectorization of an Object class",
+        "operationId": "schema.objects.revectorize.status",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the revectorization of the class, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist or has never been revectorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
       "post": {
+        "description": "Re-runs the vectorizer over all existing objects of an Object Class and swaps in the newly built vector index once all objects are done. The class stays readable, but rejects writes until the revectorization has completed. An interrupted or failed revectorization is resumed by sending the request again.",
         "tags": [
           "schema"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Revectorize all objects of an Object class",
+        "operationId": "schema.objects.revectorize",
         "parameters": [
           {
             "type": "string",
@@ -7336,15 +9055,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Revectorization"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added the property.",
+          "202": {
+            "description": "Started or resumed the revectorization of the class, the job runs in the background",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Revectorization"
             }
           },
           "401": {
@@ -7356,8 +9075,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7492,6 +9217,64 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/synonyms": {
+      "put": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Replace the synonym dictionary of an Object class.",
+        "description": "Replaces the synonyms which are used to expand the terms of bm25 and hybrid queries on the class, e.g. 'tv' with 'television'. Synonyms are applied at query time, so no reindexing is required.",
+        "operationId": "schema.objects.synonyms.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SynonymConfig"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Replaced the synonyms.",
+            "schema": {
+              "$ref": "#/definitions/SynonymConfig"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid synonyms.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/{className}/tenants": {
       "get": {
         "description": "get all tenants from a specific class",
@@ -7660,6 +9443,32 @@ func init() {
         "type": "object"
       }
     },
+    "Alias": {
+      "description": "An alternative name of a class, which can be used instead of the name of the class in all APIs.",
+      "type": "object",
+      "properties": {
+        "alias": {
+          "description": "The name of the alias.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The name of the class the alias points to.",
+          "type": "string"
+        }
+      }
+    },
+    "AliasResponse": {
+      "description": "The aliases of the schema.",
+      "type": "object",
+      "properties": {
+        "aliases": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Alias"
+          }
+        }
+      }
+    },
     "BM25Config": {
       "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
@@ -8062,6 +9871,139 @@ func init() {
         }
       }
     },
+    "BatchRef2VecRecompute": {
+      "type": "object",
+      "properties": {
+        "match": {
+          "description": "Outlines how to find the objects whose vectors will be recomputed.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) of the objects, must be vectorized by a ref2vec module.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects whose vectors will be recomputed.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        }
+      }
+    },
+    "BatchRef2VecRecomputeMatch": {
+      "description": "Outlines how to find the objects whose vectors will be recomputed.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) of the objects, must be vectorized by a ref2vec module.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects whose vectors will be recomputed.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchRef2VecRecomputeResponse": {
+      "description": "Recompute ref2vec vectors response.",
+      "type": "object",
+      "properties": {
+        "match": {
+          "description": "Outlines how to find the objects whose vectors will be recomputed.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) of the objects, must be vectorized by a ref2vec module.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects whose vectors will be recomputed.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been recomputed but could not be recomputed.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be recomputed in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "successful": {
+              "description": "How many objects had their vector successfully recomputed in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchRef2VecRecomputeResponseMatch": {
+      "description": "Outlines how to find the objects whose vectors will be recomputed.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) of the objects, must be vectorized by a ref2vec module.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects whose vectors will be recomputed.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchRef2VecRecomputeResponseResults": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "How many objects should have been recomputed but could not be recomputed.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "limit": {
+          "description": "The most amount of objects that can be recomputed in a single query, equals QUERY_MAXIMUM_RESULTS.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "matches": {
+          "description": "How many objects were matched by the filter.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "successful": {
+          "description": "How many objects had their vector successfully recomputed in this round.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "BatchReference": {
       "properties": {
         "from": {
@@ -8349,6 +10291,13 @@ func init() {
           "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
           "type": "object"
         },
+        "vectorConfig": {
+          "description": "Configure named vectors. Each named vector has its own vectorizer and vector index.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
         "vectorIndexType": {
           "description": "Name of the vector index to use, eg. (HNSW)",
           "type": "string"
@@ -8658,23 +10607,108 @@ func init() {
             "$ref": "#/definitions/JsonObject"
           }
         },
-        "errors": {
-          "description": "Array with errors.",
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "IndexStats": {
+      "description": "Statistics of the vector index of a single shard, meant for capacity planning and debugging.",
+      "properties": {
+        "cacheHitRate": {
+          "description": "The share of vector cache lookups which were served from the cache.",
+          "type": "number",
+          "x-omitempty": false
+        },
+        "cacheHits": {
+          "description": "The number of vector cache lookups which were served from the cache.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "cacheMaxSize": {
+          "description": "The maximum number of vectors in the vector cache.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "cacheMisses": {
+          "description": "The number of vector cache lookups which had to read the vector from disk.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "cachedVectors": {
+          "description": "The number of vectors currently held in the vector cache.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "class": {
+          "description": "The name of the class.",
+          "type": "string"
+        },
+        "compression": {
+          "description": "The compression of the vectors, one of none, pq, bq or sq.",
+          "type": "string"
+        },
+        "entryPoint": {
+          "description": "The id of the entry point of the graph.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "maxLayer": {
+          "description": "The highest layer of the graph.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "memoryEstimate": {
+          "description": "A rough estimate of the memory used by the graph and the vector cache in bytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "nodeCount": {
+          "description": "The number of nodes in the graph, including deleted ones which have not been cleaned up yet.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "nodesPerLayer": {
+          "description": "The number of nodes on each layer of the graph, starting with the lowest layer.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/GraphQLError"
+            "type": "number",
+            "format": "int64"
           },
-          "x-omitempty": true
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "tombstoneCount": {
+          "description": "The number of deleted nodes which have not been cleaned up yet.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
     "InvertedIndexConfig": {
       "description": "Configure the inverted index built into Weaviate",
       "type": "object",
@@ -8701,6 +10735,9 @@ func init() {
         },
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "$ref": "#/definitions/SynonymConfig"
         }
       }
     },
@@ -8765,6 +10802,56 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Data type of the nested property. Can be any primitive data type except geoCoordinates, phoneNumber and the deprecated string and string[], or ` + "`" + `object` + "`" + ` and ` + "`" + `object[]` + "`" + ` to nest further",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the nested property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index, so that it can be used in where filters with a dot path, e.g. ` + "`" + `address.city` + "`" + `. Defaults to true",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index for bm25 and hybrid search. Defaults to true. Applicable only to nested properties of data type text and text[]",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the nested property, unique among the nested properties of its parent.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The nested properties of a nested property of data type ` + "`" + `object` + "`" + ` or ` + "`" + `object[]` + "`" + `.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          }
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the nested property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default), ` + "`" + `lowercase` + "`" + `, ` + "`" + `whitespace` + "`" + `, ` + "`" + `field` + "`" + `, ` + "`" + `trigram` + "`" + `, ` + "`" + `gse` + "`" + ` and ` + "`" + `kagome_ja` + "`" + `, see the tokenization of properties for details. Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "gse",
+            "kagome_ja"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -8783,6 +10870,15 @@ func init() {
           "type": "number",
           "format": "int64",
           "x-omitempty": false
+        },
+        "vectorIndexingStatus": {
+          "description": "The status of the vector indexing process.",
+          "type": "string"
+        },
+        "vectorQueueLength": {
+          "description": "The length of the vector indexing queue.",
+          "type": "number",
+          "format": "int64"
         }
       }
     },
@@ -8892,6 +10988,10 @@ func init() {
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "description": "This object's named vectors, keyed by the name of the target vector as configured in the class' vectorConfig.",
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
@@ -9152,6 +11252,31 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexNullState": {
+          "description": "Optional. Should the null state of this property be indexed, so that objects without a value for it can be found with the ` + "`" + `IsNull` + "`" + ` filter operator. Defaults to the class-wide ` + "`" + `invertedIndexConfig.indexNullState` + "`" + ` setting. Applicable only to properties with indexFilterable or indexSearchable enabled",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the terms of this property be stored in the inverted index. Defaults to false. Applicable only to properties of data type text and text[] with indexSearchable enabled. Required to search for quoted phrases in bm25 or hybrid search, e.g. ` + "`" + `\"climate change\"` + "`" + `",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexPropertyLength": {
+          "description": "Optional. Should the length of this property be indexed, so that objects can be filtered by it with ` + "`" + `len(property)` + "`" + ` where filters. Defaults to the class-wide ` + "`" + `invertedIndexConfig.indexPropertyLength` + "`" + ` setting. Applicable only to properties with indexFilterable or indexSearchable enabled and a data type that has a length, i.e. not int, number, boolean, date, geoCoordinates, phoneNumber and blob",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexRangeFilters": {
+          "description": "Optional. Should the values of this property be stored in an index optimized for range filters. Defaults to false. Applicable only to properties of data type int, number and date. Serves filters like ` + "`" + `valueDate > X` + "`" + ` without expanding them to all matching values, which is considerably faster for large classes",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexReversedTerms": {
+          "description": "Optional. Should the terms of this property also be stored reversed in the inverted index. Defaults to false. Applicable only to properties of data type text and text[] with indexFilterable enabled. Allows like filters starting with a wildcard, e.g. ` + "`" + `*base` + "`" + `, to seek the matching terms instead of scanning all of them",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "indexSearchable": {
           "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
           "type": "boolean",
@@ -9165,14 +11290,28 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "nestedProperties": {
+          "description": "The nested properties of a property of data type ` + "`" + `object` + "`" + ` or ` + "`" + `object[]` + "`" + `. Required for these data types and not supported for any other",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          }
+        },
+        "stemmer": {
+          "description": "Optional. Reduces the words of text and text[] properties to their stem, so that e.g. ` + "`" + `running` + "`" + ` and ` + "`" + `runs` + "`" + ` both match ` + "`" + `run` + "`" + ` in bm25 and where filters. Applied when indexing and querying, cannot be changed afterwards. Allowed values are ` + "`" + `en` + "`" + ` and ` + "`" + `de` + "`" + `, stemming is disabled if not set. Not supported for ` + "`" + `field` + "`" + `, ` + "`" + `trigram` + "`" + `, ` + "`" + `gse` + "`" + ` and ` + "`" + `kagome_ja` + "`" + ` tokenization",
+          "type": "string"
+        },
         "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `trigram` + "`" + ` (splits on any non-alphanumerical, lowercases and indexes all sequences of three characters of each word, to match misspellings and partial words, not supported by the Like and Regex operators), ` + "`" + `gse` + "`" + ` (splits Chinese and Japanese text into all dictionary words it contains), ` + "`" + `kagome_ja` + "`" + ` (splits Japanese text into words by morphological analysis). Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
             "lowercase",
             "whitespace",
-            "field"
+            "field",
+            "trigram",
+            "gse",
+            "kagome_ja"
           ]
         }
       }
@@ -9249,6 +11388,77 @@ func init() {
         }
       }
     },
+    "Revectorization": {
+      "description": "Re-run a vectorizer over all existing objects of a class and swap in the newly built vector index once all objects are done.",
+      "type": "object",
+      "properties": {
+        "after": {
+          "description": "id of the last object that was revectorized, a resumed revectorization continues after this object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "class": {
+          "description": "class (name) which is revectorized",
+          "type": "string",
+          "example": "City"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "vectorize object: connection refused"
+        },
+        "meta": {
+          "description": "additional meta information about the revectorization",
+          "type": "object",
+          "$ref": "#/definitions/RevectorizationMeta"
+        },
+        "moduleConfig": {
+          "description": "the module config to vectorize the objects with, it replaces the module config of the class once all objects are revectorized. Defaults to the current module config of the class",
+          "type": "object"
+        },
+        "objectsPerSecond": {
+          "description": "maximum number of objects to vectorize per second, 0 means unlimited",
+          "type": "integer",
+          "format": "int64",
+          "example": 100
+        },
+        "status": {
+          "description": "status of this revectorization",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed",
+            "interrupted"
+          ],
+          "example": "running"
+        }
+      }
+    },
+    "RevectorizationMeta": {
+      "description": "Additional information to a specific revectorization",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this revectorization finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were revectorized so far",
+          "type": "integer",
+          "example": 147
+        },
+        "started": {
+          "description": "time when this revectorization was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
     "Schema": {
       "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
       "type": "object",
@@ -9374,7 +11584,7 @@ func init() {
           }
         },
         "preset": {
-          "description": "pre-existing list of common words by language",
+          "description": "pre-existing list of common words by language, one of en, de, fr or none",
           "type": "string"
         },
         "removals": {
@@ -9386,6 +11596,37 @@ func init() {
         }
       }
     },
+    "SynonymConfig": {
+      "description": "synonym dictionary used to expand bm25 and hybrid query terms",
+      "type": "object",
+      "properties": {
+        "groups": {
+          "description": "groups of equivalent terms",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymGroup"
+          }
+        },
+        "weight": {
+          "description": "weight applied to matches on synonyms relative to the original query terms, between 0 (exclusive) and 1. Defaults to 1",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "SynonymGroup": {
+      "description": "a set of terms which are considered equivalent at query time",
+      "type": "object",
+      "properties": {
+        "terms": {
+          "description": "terms which expand to each other, e.g. [\"tv\", \"television\"]",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
@@ -9396,10 +11637,43 @@ func init() {
         }
       }
     },
+    "Vector": {
+      "description": "A single vector embedding",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "VectorConfig": {
+      "description": "Configuration of a single named vector",
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of the vectorizer used for this vector. Must contain exactly one key, which is the name of the vectorizer module (or 'none'), mapping to its module-specific settings.",
+          "type": "object"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "Vectors": {
+      "description": "A map of named vectors, keyed by the name of the target vector",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/Vector"
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -9419,6 +11693,7 @@ func init() {
             "Or",
             "Equal",
             "Like",
+            "Regex",
             "Not",
             "NotEqual",
             "GreaterThan",
@@ -9426,7 +11701,11 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
-            "IsNull"
+            "WithinGeoPolygon",
+            "WithinGeoBoundingBox",
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll"
           ],
           "example": "GreaterThanEqual"
         },
@@ -9448,12 +11727,47 @@ func init() {
           "x-nullable": true,
           "example": false
         },
+        "valueBooleanArray": {
+          "description": "value as boolean array",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
         "valueDate": {
           "description": "value as date (as string)",
           "type": "string",
           "x-nullable": true,
           "example": "TODO"
         },
+        "valueDateArray": {
+          "description": "value as date (as string) array",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "TODO"
+        },
+        "valueGeoBoundingBox": {
+          "description": "value as bounding box of geo coordinates",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoBoundingBox"
+        },
+        "valueGeoPolygon": {
+          "description": "value as polygon of geo coordinates",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoPolygon"
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -9467,6 +11781,20 @@ func init() {
           "x-nullable": true,
           "example": 2000
         },
+        "valueIntArray": {
+          "description": "value as integer array",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            100,
+            200
+          ]
+        },
         "valueNumber": {
           "description": "value as number/float",
           "type": "number",
@@ -9474,17 +11802,84 @@ func init() {
           "x-nullable": true,
           "example": 3.14
         },
+        "valueNumberArray": {
+          "description": "value as number/float array",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            100,
+            200
+          ]
+        },
         "valueString": {
           "description": "value as text (deprecated as of v1.19; alias for valueText)",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
         },
+        "valueStringArray": {
+          "description": "value as text array (deprecated as of v1.19; alias for valueTextArray)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        },
         "valueText": {
           "description": "value as text",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text array",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        }
+      }
+    },
+    "WhereFilterGeoBoundingBox": {
+      "description": "filter within a bounding box of geo coordinates",
+      "type": "object",
+      "properties": {
+        "bottomRight": {
+          "description": "the south-east corner of the bounding box",
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        },
+        "topLeft": {
+          "description": "the north-west corner of the bounding box",
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    },
+    "WhereFilterGeoPolygon": {
+      "description": "filter within a polygon of geo coordinates",
+      "type": "object",
+      "properties": {
+        "coordinates": {
+          "description": "the corners of the polygon in order, at least three. The last corner is connected to the first one",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
         }
       }
     },
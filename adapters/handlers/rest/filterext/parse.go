@@ -123,6 +123,8 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorEqual, nil
 	case models.WhereFilterOperatorLike:
 		return filters.OperatorLike, nil
+	case models.WhereFilterOperatorRegex:
+		return filters.OperatorRegex, nil
 	case models.WhereFilterOperatorLessThan:
 		return filters.OperatorLessThan, nil
 	case models.WhereFilterOperatorLessThanEqual:
@@ -135,6 +137,10 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNotEqual, nil
 	case models.WhereFilterOperatorWithinGeoRange:
 		return filters.OperatorWithinGeoRange, nil
+	case models.WhereFilterOperatorWithinGeoPolygon:
+		return filters.OperatorWithinGeoPolygon, nil
+	case models.WhereFilterOperatorWithinGeoBoundingBox:
+		return filters.OperatorWithinGeoBoundingBox, nil
 	case models.WhereFilterOperatorAnd:
 		return filters.OperatorAnd, nil
 	case models.WhereFilterOperatorOr:
@@ -143,6 +149,10 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNot, nil
 	case models.WhereFilterOperatorIsNull:
 		return filters.OperatorIsNull, nil
+	case models.WhereFilterOperatorContainsAny:
+		return filters.OperatorContainsAny, nil
+	case models.WhereFilterOperatorContainsAll:
+		return filters.OperatorContainsAll, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
@@ -168,5 +178,13 @@ func allValuesNil(in *models.WhereFilter) bool {
 		in.ValueText == nil &&
 		in.ValueInt == nil &&
 		in.ValueNumber == nil &&
-		in.ValueGeoRange == nil
+		in.ValueGeoRange == nil &&
+		in.ValueGeoPolygon == nil &&
+		in.ValueGeoBoundingBox == nil &&
+		in.ValueBooleanArray == nil &&
+		in.ValueDateArray == nil &&
+		in.ValueStringArray == nil &&
+		in.ValueTextArray == nil &&
+		in.ValueIntArray == nil &&
+		in.ValueNumberArray == nil
 }
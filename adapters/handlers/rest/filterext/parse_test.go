@@ -156,6 +156,119 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid geo polygon filter",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoPolygon",
+					ValueGeoPolygon: &models.WhereFilterGeoPolygon{
+						Coordinates: []*models.GeoCoordinates{
+							{Latitude: ptFloat32(0.5), Longitude: ptFloat32(0.6)},
+							{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.6)},
+							{Latitude: ptFloat32(1.0), Longitude: ptFloat32(1.6)},
+						},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoPolygon,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoPolygon{
+							Coordinates: []models.GeoCoordinates{
+								{Latitude: ptFloat32(0.5), Longitude: ptFloat32(0.6)},
+								{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.6)},
+								{Latitude: ptFloat32(1.0), Longitude: ptFloat32(1.6)},
+							},
+						},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
+			{
+				name: "valid geo bounding box filter",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBoundingBox",
+					ValueGeoBoundingBox: &models.WhereFilterGeoBoundingBox{
+						TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.5)},
+						BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(0.5), Longitude: ptFloat32(1.5)},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoBoundingBox,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoBoundingBox{
+							TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.5)},
+							BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(0.5), Longitude: ptFloat32(1.5)},
+						},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
+			{
+				name: "valid int array filter",
+				input: &models.WhereFilter{
+					Operator:      "ContainsAny",
+					ValueIntArray: []int64{1, 2},
+					Path:          []string{"intField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("intField"),
+					},
+					Value: &filters.Value{
+						Value: []int{1, 2},
+						Type:  schema.DataTypeIntArray,
+					},
+				}},
+			},
+			{
+				name: "valid text array filter",
+				input: &models.WhereFilter{
+					Operator:       "ContainsAll",
+					ValueTextArray: []string{"foo", "bar"},
+					Path:           []string{"textField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAll,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("textField"),
+					},
+					Value: &filters.Value{
+						Value: []string{"foo", "bar"},
+						Type:  schema.DataTypeTextArray,
+					},
+				}},
+			},
+			{
+				name: "valid number array filter",
+				input: &models.WhereFilter{
+					Operator:         "ContainsAny",
+					ValueNumberArray: []float64{1.5},
+					Path:             []string{"numberField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("numberField"),
+					},
+					Value: &filters.Value{
+						Value: []float64{1.5},
+						Type:  schema.DataTypeNumberArray,
+					},
+				}},
+			},
 			{
 				name: "[deprected string] valid string filter",
 				input: &models.WhereFilter{
@@ -235,6 +348,18 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: valueGeoRange: " +
 					"field 'distance.max' must be a positive number"),
 			},
+			{
+				name: "geo bounding box missing corner",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBoundingBox",
+					ValueGeoBoundingBox: &models.WhereFilterGeoBoundingBox{
+						TopLeft: &models.GeoCoordinates{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.5)},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoBoundingBox: " +
+					"field 'bottomRight' must be set"),
+			},
 			{
 				name: "and operator and path set",
 				input: &models.WhereFilter{
@@ -305,6 +430,11 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				input:          inputIntFilterWithOp("Like"),
 				expectedFilter: intFilterWithOp(filters.OperatorLike),
 			},
+			{
+				name:           "regex",
+				input:          inputIntFilterWithOp("Regex"),
+				expectedFilter: intFilterWithOp(filters.OperatorRegex),
+			},
 			{
 				name:           "not equal",
 				input:          inputIntFilterWithOp("NotEqual"),
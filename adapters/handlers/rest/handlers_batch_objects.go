@@ -235,6 +235,53 @@ func (h *batchObjectHandlers) objectsDeleteResponse(input *objects.BatchDeleteRe
 	return response
 }
 
+func (h *batchObjectHandlers) recomputeRefVectors(params batch.BatchRef2vecRecomputeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return batch.NewBatchRef2vecRecomputeBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	tenant := getTenant(params.Tenant)
+
+	res, err := h.manager.RecomputeRefVectors(params.HTTPRequest.Context(), principal,
+		params.Body.Match, repl, tenant)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		if errors.As(err, &objects.ErrInvalidUserInput{}) {
+			return batch.NewBatchRef2vecRecomputeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &objects.ErrMultiTenancy{}) {
+			return batch.NewBatchRef2vecRecomputeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &autherrs.Forbidden{}) {
+			return batch.NewBatchRef2vecRecomputeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else {
+			return batch.NewBatchRef2vecRecomputeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return batch.NewBatchRef2vecRecomputeOK().
+		WithPayload(&models.BatchRef2VecRecomputeResponse{
+			Match: &models.BatchRef2VecRecomputeResponseMatch{
+				Class: params.Body.Match.Class,
+				Where: params.Body.Match.Where,
+			},
+			Results: &models.BatchRef2VecRecomputeResponseResults{
+				Matches:    res.Matches,
+				Limit:      res.Limit,
+				Successful: res.Successful,
+				Failed:     res.Failed,
+			},
+		})
+}
+
 func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
 	h := &batchObjectHandlers{manager, newBatchRequestsTotal(metrics, logger)}
 
@@ -244,6 +291,8 @@ func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.Batc
 		BatchReferencesCreateHandlerFunc(h.addReferences)
 	api.BatchBatchObjectsDeleteHandler = batch.
 		BatchObjectsDeleteHandlerFunc(h.deleteObjects)
+	api.BatchBatchRef2vecRecomputeHandler = batch.
+		BatchRef2vecRecomputeHandlerFunc(h.recomputeRefVectors)
 }
 
 type batchRequestsTotal struct {
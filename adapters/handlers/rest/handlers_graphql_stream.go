@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+)
+
+const eventStreamMime = "text/event-stream"
+
+// graphQLStreamChunk is the payload of a "generate" event
+type graphQLStreamChunk struct {
+	Index   int    `json:"index"`
+	Grouped bool   `json:"grouped"`
+	Text    string `json:"text"`
+}
+
+// makeAddGraphQLStream serves GraphQL queries which accept text/event-stream
+// as server-sent events. The text of generated answers is sent in "generate"
+// events while it is produced, the complete response is sent in a "result"
+// event last. The swagger API can't produce event streams, so these requests
+// are handled before they are routed.
+func makeAddGraphQLStream(appState *state.State) func(http.Handler) http.Handler {
+	authComposer := composer.New(appState.ServerConfig.Config.Authentication,
+		appState.APIKey, appState.OIDC)
+	metricRequestsTotal := newGraphqlRequestsTotal(appState.Metrics, appState.Logger)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/v1/graphql" ||
+				!strings.Contains(r.Header.Get("Accept"), eventStreamMime) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal, err := graphQLStreamPrincipal(r, authComposer,
+				appState.ServerConfig.Config.Authentication.AnonymousAccess.Enabled)
+			if err != nil {
+				metricRequestsTotal.logUserError()
+				writeGraphQLStreamError(w, http.StatusUnauthorized, err)
+				return
+			}
+
+			if err := appState.Authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+				metricRequestsTotal.logUserError()
+				status := http.StatusUnprocessableEntity
+				if _, ok := err.(autherrs.Forbidden); ok {
+					status = http.StatusForbidden
+				}
+				writeGraphQLStreamError(w, status, err)
+				return
+			}
+
+			if appState.ServerConfig.Config.DisableGraphQL {
+				metricRequestsTotal.logUserError()
+				writeGraphQLStreamError(w, http.StatusUnprocessableEntity,
+					fmt.Errorf("graphql api is disabled"))
+				return
+			}
+
+			var query models.GraphQLQuery
+			if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+				metricRequestsTotal.logUserError()
+				writeGraphQLStreamError(w, http.StatusUnprocessableEntity,
+					fmt.Errorf("decode query: %w", err))
+				return
+			}
+			if query.Query == "" {
+				metricRequestsTotal.logUserError()
+				writeGraphQLStreamError(w, http.StatusUnprocessableEntity,
+					fmt.Errorf("query cannot be empty"))
+				return
+			}
+
+			graphQL := appState.GetGraphQL()
+			if graphQL == nil {
+				metricRequestsTotal.logUserError()
+				writeGraphQLStreamError(w, http.StatusUnprocessableEntity,
+					fmt.Errorf("no graphql provider present, this is most likely "+
+						"because no schema is present. Import a schema first!"))
+				return
+			}
+
+			var variables map[string]interface{}
+			if query.Variables != nil {
+				variables, _ = query.Variables.(map[string]interface{})
+			}
+
+			flusher, _ := w.(http.Flusher)
+			w.Header().Set("Content-Type", eventStreamMime)
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+
+			// the answers of different classes of a query are generated
+			// concurrently
+			var lock sync.Mutex
+			writeEvent := func(event string, payload interface{}) error {
+				data, err := json.Marshal(payload)
+				if err != nil {
+					return err
+				}
+
+				lock.Lock()
+				defer lock.Unlock()
+				if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+					return err
+				}
+				if flusher != nil {
+					flusher.Flush()
+				}
+				return nil
+			}
+
+			ctx := context.WithValue(r.Context(), "principal", principal)
+			ctx = generate.WithStream(ctx, func(index int, grouped bool, text string) error {
+				return writeEvent("generate", graphQLStreamChunk{
+					Index:   index,
+					Grouped: grouped,
+					Text:    text,
+				})
+			})
+
+			result := graphQL.Resolve(ctx, query.Query, query.OperationName, variables)
+			metricRequestsTotal.log(result)
+			if err := writeEvent("result", result); err != nil {
+				appState.Logger.WithField("action", "graphql_stream").
+					WithError(err).Debug("could not send result")
+			}
+		})
+	}
+}
+
+func graphQLStreamPrincipal(r *http.Request, authComposer composer.TokenFunc,
+	allowAnonymousAccess bool,
+) (*models.Principal, error) {
+	authValue := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authValue, "Bearer ") {
+		if allowAnonymousAccess {
+			return nil, nil
+		}
+		return authComposer("", nil)
+	}
+
+	return authComposer(strings.TrimPrefix(authValue, "Bearer "), nil)
+}
+
+func writeGraphQLStreamError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errPayloadFromSingleErr(err))
+}
@@ -79,6 +79,31 @@ func (s *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 		WithPayload(errPayloadFromSingleErr(err))
 }
 
+func (s *nodesHandlers) getIndexStats(params nodes.NodesIndexStatsParams, principal *models.Principal) middleware.Responder {
+	stats, err := s.manager.GetIndexStats(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ShardName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return nodes.NewNodesIndexStatsNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return nodes.NewNodesIndexStatsForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return nodes.NewNodesIndexStatsUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesIndexStatsInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return nodes.NewNodesIndexStatsOK().WithPayload(stats)
+}
+
 func setupNodesHandlers(api *operations.WeaviateAPI,
 	schemaManger *schemaUC.Manager, repo *db.DB, appState *state.State,
 ) {
@@ -90,6 +115,8 @@ func setupNodesHandlers(api *operations.WeaviateAPI,
 		NodesGetHandlerFunc(h.getNodesStatus)
 	api.NodesNodesGetClassHandler = nodes.
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
+	api.NodesNodesIndexStatsHandler = nodes.
+		NodesIndexStatsHandlerFunc(h.getIndexStats)
 }
 
 type nodesRequestsTotal struct {
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/revectorization"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+func setupRevectorizationHandlers(api *operations.WeaviateAPI,
+	revectorizer *revectorization.Revectorizer, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	metricRequestsTotal := newRevectorizationRequestsTotal(metrics, logger)
+	api.SchemaSchemaObjectsRevectorizeHandler = schema.SchemaObjectsRevectorizeHandlerFunc(
+		func(params schema.SchemaObjectsRevectorizeParams, principal *models.Principal) middleware.Responder {
+			body := models.Revectorization{}
+			if params.Body != nil {
+				body = *params.Body
+			}
+
+			res, err := revectorizer.Schedule(params.HTTPRequest.Context(), principal,
+				params.ClassName, body)
+			if err != nil {
+				metricRequestsTotal.logUserError(params.ClassName)
+				if err == schemaUC.ErrNotFound {
+					return schema.NewSchemaObjectsRevectorizeNotFound()
+				}
+
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaObjectsRevectorizeForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return schema.NewSchemaObjectsRevectorizeUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			metricRequestsTotal.logOk(params.ClassName)
+			return schema.NewSchemaObjectsRevectorizeAccepted().WithPayload(res)
+		},
+	)
+
+	api.SchemaSchemaObjectsRevectorizeStatusHandler = schema.SchemaObjectsRevectorizeStatusHandlerFunc(
+		func(params schema.SchemaObjectsRevectorizeStatusParams, principal *models.Principal) middleware.Responder {
+			res, err := revectorizer.Get(params.HTTPRequest.Context(), principal, params.ClassName)
+			if err != nil {
+				metricRequestsTotal.logError(params.ClassName, err)
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaObjectsRevectorizeStatusForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return schema.NewSchemaObjectsRevectorizeStatusInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			if res == nil {
+				metricRequestsTotal.logUserError(params.ClassName)
+				return schema.NewSchemaObjectsRevectorizeStatusNotFound().
+					WithPayload(errPayloadFromSingleErr(
+						fmt.Errorf("class %s has not been revectorized", params.ClassName)))
+			}
+
+			metricRequestsTotal.logOk(params.ClassName)
+			return schema.NewSchemaObjectsRevectorizeStatusOK().WithPayload(res)
+		},
+	)
+}
+
+type revectorizationRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newRevectorizationRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &revectorizationRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "revectorization", logger},
+	}
+}
+
+func (e *revectorizationRequestsTotal) logError(className string, err error) {
+	switch err.(type) {
+	case errors.Forbidden:
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
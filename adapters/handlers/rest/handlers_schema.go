@@ -12,6 +12,8 @@
 package rest
 
 import (
+	stderrors "errors"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
@@ -135,6 +137,79 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	return schema.NewSchemaObjectsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) deleteClassProperty(params schema.SchemaObjectsPropertiesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.DeleteClassProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewSchemaObjectsPropertiesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesDeleteOK()
+}
+
+func (s *schemaHandlers) renameClassProperty(params schema.SchemaObjectsPropertiesUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.RenameClassProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, params.Body.Name)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewSchemaObjectsPropertiesUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesUpdateOK().WithPayload(params.Body)
+}
+
+func (s *schemaHandlers) updateClassSynonyms(params schema.SchemaObjectsSynonymsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.UpdateClassSynonyms(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsSynonymsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsSynonymsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsSynonymsUpdateOK().WithPayload(params.Body)
+}
+
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 	dbSchema, err := s.manager.GetSchema(principal)
 	if err != nil {
@@ -274,6 +349,104 @@ func (s *schemaHandlers) getTenants(params schema.TenantsGetParams,
 	return schema.NewTenantsGetOK().WithPayload(tenants)
 }
 
+func (s *schemaHandlers) getAliases(params schema.AliasesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	aliases, err := s.manager.GetAliases(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewAliasesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewAliasesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewAliasesGetOK().WithPayload(&models.AliasResponse{Aliases: aliases})
+}
+
+func (s *schemaHandlers) createAlias(params schema.AliasesCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.AddAlias(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.Body.Class, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewAliasesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewAliasesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.Body.Class)
+	return schema.NewAliasesCreateOK().WithPayload(params.Body)
+}
+
+func (s *schemaHandlers) updateAlias(params schema.AliasesUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	alias := params.Body
+	if alias.Alias == "" {
+		alias.Alias = params.AliasName
+	} else if alias.Alias != params.AliasName {
+		return schema.NewAliasesUpdateUnprocessableEntity().
+			WithPayload(createErrorResponseObject("alias in path and body must match"))
+	}
+
+	err := s.manager.UpdateAlias(params.HTTPRequest.Context(), principal, alias)
+	if err != nil {
+		s.metricRequestsTotal.logError(alias.Class, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewAliasesUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewAliasesUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewAliasesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(alias.Class)
+	return schema.NewAliasesUpdateOK().WithPayload(alias)
+}
+
+func (s *schemaHandlers) deleteAlias(params schema.AliasesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.DeleteAlias(params.HTTPRequest.Context(), principal, params.AliasName)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewAliasesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewAliasesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewAliasesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewAliasesDeleteOK()
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
 	h := &schemaHandlers{manager, newSchemaRequestsTotal(metrics, logger)}
 
@@ -283,6 +456,10 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		SchemaObjectsDeleteHandlerFunc(h.deleteClass)
 	api.SchemaSchemaObjectsPropertiesAddHandler = schema.
 		SchemaObjectsPropertiesAddHandlerFunc(h.addClassProperty)
+	api.SchemaSchemaObjectsPropertiesDeleteHandler = schema.
+		SchemaObjectsPropertiesDeleteHandlerFunc(h.deleteClassProperty)
+	api.SchemaSchemaObjectsPropertiesUpdateHandler = schema.
+		SchemaObjectsPropertiesUpdateHandlerFunc(h.renameClassProperty)
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
@@ -298,6 +475,8 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		SchemaObjectsShardsGetHandlerFunc(h.getShardsStatus)
 	api.SchemaSchemaObjectsShardsUpdateHandler = schema.
 		SchemaObjectsShardsUpdateHandlerFunc(h.updateShardStatus)
+	api.SchemaSchemaObjectsSynonymsUpdateHandler = schema.
+		SchemaObjectsSynonymsUpdateHandlerFunc(h.updateClassSynonyms)
 
 	api.SchemaTenantsCreateHandler = schema.
 		TenantsCreateHandlerFunc(h.createTenants)
@@ -305,6 +484,14 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		TenantsDeleteHandlerFunc(h.deleteTenants)
 
 	api.SchemaTenantsGetHandler = schema.TenantsGetHandlerFunc(h.getTenants)
+
+	api.SchemaAliasesGetHandler = schema.AliasesGetHandlerFunc(h.getAliases)
+	api.SchemaAliasesCreateHandler = schema.
+		AliasesCreateHandlerFunc(h.createAlias)
+	api.SchemaAliasesUpdateHandler = schema.
+		AliasesUpdateHandlerFunc(h.updateAlias)
+	api.SchemaAliasesDeleteHandler = schema.
+		AliasesDeleteHandlerFunc(h.deleteAlias)
 }
 
 type schemaRequestsTotal struct {
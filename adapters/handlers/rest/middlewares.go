@@ -89,6 +89,7 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 			OptionsPassthrough: true,
 			AllowedMethods:     []string{"POST", "PUT", "DELETE", "GET", "PATCH"},
 		}).Handler
+		handler = makeAddGraphQLStream(appState)(handler)
 		handler = handleCORS(handler)
 		handler = swagger_middleware.AddMiddleware([]byte(SwaggerJSON), handler)
 		handler = makeAddLogging(appState.Logger)(handler)
@@ -146,7 +147,7 @@ func addPreflight(next http.Handler) http.Handler {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "*")
 			w.Header().Set("Access-Control-Allow-Headers",
-				"Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Cohere-Api-Key, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Palm-Api-Key")
+				"Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Cohere-Api-Key, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Palm-Api-Key, X-VoyageAI-Api-Key, X-JinaAI-Api-Key, X-Aws-Access-Key, X-Aws-Secret-Key, X-Aws-Session-Token, X-Google-Api-Key, X-Anthropic-Api-Key, X-Google-Studio-Api-Key, X-Google-Model, X-Custom-Api-Key")
 			return
 		}
 
@@ -87,6 +87,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BatchBatchObjectsDeleteHandler: batch.BatchObjectsDeleteHandlerFunc(func(params batch.BatchObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsDelete has not yet been implemented")
 		}),
+		BatchBatchRef2vecRecomputeHandler: batch.BatchRef2vecRecomputeHandlerFunc(func(params batch.BatchRef2vecRecomputeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchRef2vecRecompute has not yet been implemented")
+		}),
 		BatchBatchReferencesCreateHandler: batch.BatchReferencesCreateHandlerFunc(func(params batch.BatchReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchReferencesCreate has not yet been implemented")
 		}),
@@ -111,6 +114,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		NodesNodesGetClassHandler: nodes.NodesGetClassHandlerFunc(func(params nodes.NodesGetClassParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGetClass has not yet been implemented")
 		}),
+		NodesNodesIndexStatsHandler: nodes.NodesIndexStatsHandlerFunc(func(params nodes.NodesIndexStatsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesIndexStats has not yet been implemented")
+		}),
 		ObjectsObjectsClassDeleteHandler: objects.ObjectsClassDeleteHandlerFunc(func(params objects.ObjectsClassDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassDelete has not yet been implemented")
 		}),
@@ -168,6 +174,18 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsValidateHandler: objects.ObjectsValidateHandlerFunc(func(params objects.ObjectsValidateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsValidate has not yet been implemented")
 		}),
+		SchemaAliasesCreateHandler: schema.AliasesCreateHandlerFunc(func(params schema.AliasesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.AliasesCreate has not yet been implemented")
+		}),
+		SchemaAliasesDeleteHandler: schema.AliasesDeleteHandlerFunc(func(params schema.AliasesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.AliasesDelete has not yet been implemented")
+		}),
+		SchemaAliasesGetHandler: schema.AliasesGetHandlerFunc(func(params schema.AliasesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.AliasesGet has not yet been implemented")
+		}),
+		SchemaAliasesUpdateHandler: schema.AliasesUpdateHandlerFunc(func(params schema.AliasesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.AliasesUpdate has not yet been implemented")
+		}),
 		SchemaSchemaClusterStatusHandler: schema.SchemaClusterStatusHandlerFunc(func(params schema.SchemaClusterStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaClusterStatus has not yet been implemented")
 		}),
@@ -186,12 +204,27 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesDeleteHandler: schema.SchemaObjectsPropertiesDeleteHandlerFunc(func(params schema.SchemaObjectsPropertiesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesDelete has not yet been implemented")
+		}),
+		SchemaSchemaObjectsPropertiesUpdateHandler: schema.SchemaObjectsPropertiesUpdateHandlerFunc(func(params schema.SchemaObjectsPropertiesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesUpdate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsRevectorizeHandler: schema.SchemaObjectsRevectorizeHandlerFunc(func(params schema.SchemaObjectsRevectorizeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsRevectorize has not yet been implemented")
+		}),
+		SchemaSchemaObjectsRevectorizeStatusHandler: schema.SchemaObjectsRevectorizeStatusHandlerFunc(func(params schema.SchemaObjectsRevectorizeStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsRevectorizeStatus has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
 		SchemaSchemaObjectsShardsUpdateHandler: schema.SchemaObjectsShardsUpdateHandlerFunc(func(params schema.SchemaObjectsShardsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaObjectsSynonymsUpdateHandler: schema.SchemaObjectsSynonymsUpdateHandlerFunc(func(params schema.SchemaObjectsSynonymsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsSynonymsUpdate has not yet been implemented")
+		}),
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
@@ -279,6 +312,8 @@ type WeaviateAPI struct {
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
 	BatchBatchObjectsDeleteHandler batch.BatchObjectsDeleteHandler
+	// BatchBatchRef2vecRecomputeHandler sets the operation handler for the batch ref2vec recompute operation
+	BatchBatchRef2vecRecomputeHandler batch.BatchRef2vecRecomputeHandler
 	// BatchBatchReferencesCreateHandler sets the operation handler for the batch references create operation
 	BatchBatchReferencesCreateHandler batch.BatchReferencesCreateHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
@@ -295,6 +330,8 @@ type WeaviateAPI struct {
 	NodesNodesGetHandler nodes.NodesGetHandler
 	// NodesNodesGetClassHandler sets the operation handler for the nodes get class operation
 	NodesNodesGetClassHandler nodes.NodesGetClassHandler
+	// NodesNodesIndexStatsHandler sets the operation handler for the nodes index stats operation
+	NodesNodesIndexStatsHandler nodes.NodesIndexStatsHandler
 	// ObjectsObjectsClassDeleteHandler sets the operation handler for the objects class delete operation
 	ObjectsObjectsClassDeleteHandler objects.ObjectsClassDeleteHandler
 	// ObjectsObjectsClassGetHandler sets the operation handler for the objects class get operation
@@ -333,6 +370,14 @@ type WeaviateAPI struct {
 	ObjectsObjectsUpdateHandler objects.ObjectsUpdateHandler
 	// ObjectsObjectsValidateHandler sets the operation handler for the objects validate operation
 	ObjectsObjectsValidateHandler objects.ObjectsValidateHandler
+	// SchemaAliasesCreateHandler sets the operation handler for the aliases create operation
+	SchemaAliasesCreateHandler schema.AliasesCreateHandler
+	// SchemaAliasesDeleteHandler sets the operation handler for the aliases delete operation
+	SchemaAliasesDeleteHandler schema.AliasesDeleteHandler
+	// SchemaAliasesGetHandler sets the operation handler for the aliases get operation
+	SchemaAliasesGetHandler schema.AliasesGetHandler
+	// SchemaAliasesUpdateHandler sets the operation handler for the aliases update operation
+	SchemaAliasesUpdateHandler schema.AliasesUpdateHandler
 	// SchemaSchemaClusterStatusHandler sets the operation handler for the schema cluster status operation
 	SchemaSchemaClusterStatusHandler schema.SchemaClusterStatusHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
@@ -345,10 +390,20 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesDeleteHandler sets the operation handler for the schema objects properties delete operation
+	SchemaSchemaObjectsPropertiesDeleteHandler schema.SchemaObjectsPropertiesDeleteHandler
+	// SchemaSchemaObjectsPropertiesUpdateHandler sets the operation handler for the schema objects properties update operation
+	SchemaSchemaObjectsPropertiesUpdateHandler schema.SchemaObjectsPropertiesUpdateHandler
+	// SchemaSchemaObjectsRevectorizeHandler sets the operation handler for the schema objects revectorize operation
+	SchemaSchemaObjectsRevectorizeHandler schema.SchemaObjectsRevectorizeHandler
+	// SchemaSchemaObjectsRevectorizeStatusHandler sets the operation handler for the schema objects revectorize status operation
+	SchemaSchemaObjectsRevectorizeStatusHandler schema.SchemaObjectsRevectorizeStatusHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
+	// SchemaSchemaObjectsSynonymsUpdateHandler sets the operation handler for the schema objects synonyms update operation
+	SchemaSchemaObjectsSynonymsUpdateHandler schema.SchemaObjectsSynonymsUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
 	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
@@ -468,6 +523,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BatchBatchObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsDeleteHandler")
 	}
+	if o.BatchBatchRef2vecRecomputeHandler == nil {
+		unregistered = append(unregistered, "batch.BatchRef2vecRecomputeHandler")
+	}
 	if o.BatchBatchReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchReferencesCreateHandler")
 	}
@@ -492,6 +550,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.NodesNodesGetClassHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetClassHandler")
 	}
+	if o.NodesNodesIndexStatsHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesIndexStatsHandler")
+	}
 	if o.ObjectsObjectsClassDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassDeleteHandler")
 	}
@@ -549,6 +610,18 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsValidateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsValidateHandler")
 	}
+	if o.SchemaAliasesCreateHandler == nil {
+		unregistered = append(unregistered, "schema.AliasesCreateHandler")
+	}
+	if o.SchemaAliasesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.AliasesDeleteHandler")
+	}
+	if o.SchemaAliasesGetHandler == nil {
+		unregistered = append(unregistered, "schema.AliasesGetHandler")
+	}
+	if o.SchemaAliasesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.AliasesUpdateHandler")
+	}
 	if o.SchemaSchemaClusterStatusHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaClusterStatusHandler")
 	}
@@ -567,12 +640,27 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesDeleteHandler")
+	}
+	if o.SchemaSchemaObjectsPropertiesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesUpdateHandler")
+	}
+	if o.SchemaSchemaObjectsRevectorizeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsRevectorizeHandler")
+	}
+	if o.SchemaSchemaObjectsRevectorizeStatusHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsRevectorizeStatusHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
 	if o.SchemaSchemaObjectsShardsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsUpdateHandler")
 	}
+	if o.SchemaSchemaObjectsSynonymsUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsSynonymsUpdateHandler")
+	}
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
@@ -725,6 +813,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/batch/ref2vec"] = batch.NewBatchRef2vecRecompute(o.context, o.BatchBatchRef2vecRecomputeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/batch/references"] = batch.NewBatchReferencesCreate(o.context, o.BatchBatchReferencesCreateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -754,6 +846,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/nodes/{className}"] = nodes.NewNodesGetClass(o.context, o.NodesNodesGetClassHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/debug/index/{className}/{shardName}"] = nodes.NewNodesIndexStats(o.context, o.NodesNodesIndexStatsHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -830,6 +926,22 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/objects/validate"] = objects.NewObjectsValidate(o.context, o.ObjectsObjectsValidateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/aliases"] = schema.NewAliasesCreate(o.context, o.SchemaAliasesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/aliases/{aliasName}"] = schema.NewAliasesDelete(o.context, o.SchemaAliasesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/aliases"] = schema.NewAliasesGet(o.context, o.SchemaAliasesGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/aliases/{aliasName}"] = schema.NewAliasesUpdate(o.context, o.SchemaAliasesUpdateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -854,6 +966,22 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/properties/{propertyName}"] = schema.NewSchemaObjectsPropertiesDelete(o.context, o.SchemaSchemaObjectsPropertiesDeleteHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/properties/{propertyName}"] = schema.NewSchemaObjectsPropertiesUpdate(o.context, o.SchemaSchemaObjectsPropertiesUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/revectorize"] = schema.NewSchemaObjectsRevectorize(o.context, o.SchemaSchemaObjectsRevectorizeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/revectorize"] = schema.NewSchemaObjectsRevectorizeStatus(o.context, o.SchemaSchemaObjectsRevectorizeStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -865,6 +993,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/schema/{className}/synonyms"] = schema.NewSchemaObjectsSynonymsUpdate(o.context, o.SchemaSchemaObjectsSynonymsUpdateHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
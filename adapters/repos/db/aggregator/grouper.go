@@ -57,7 +57,9 @@ func (g *grouper) Do(ctx context.Context) ([]group, error) {
 }
 
 func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
-	err := ScanAllLSM(g.store, func(prop *models.PropertySchema, docID uint64) (bool, error) {
+	s := g.getSchema.GetSchemaSkipAuth()
+	class := s.GetClass(g.params.ClassName)
+	err := ScanAllLSM(g.store, class, func(prop *models.PropertySchema, docID uint64) (bool, error) {
 		return true, g.addElementById(prop, docID)
 	})
 	if err != nil {
@@ -262,14 +264,14 @@ func (g *grouper) insertOrdered(elem group) {
 
 // ScanAll iterates over every row in the object buckets
 // TODO: where should this live?
-func ScanAll(tx *bolt.Tx, scan docid.ObjectScanFn) error {
+func ScanAll(tx *bolt.Tx, class *models.Class, scan docid.ObjectScanFn) error {
 	b := tx.Bucket(helpers.ObjectsBucket)
 	if b == nil {
 		return fmt.Errorf("objects bucket not found")
 	}
 
 	b.ForEach(func(_, v []byte) error {
-		elem, err := storobj.FromBinary(v)
+		elem, err := storobj.FromBinaryWithClass(v, class)
 		if err != nil {
 			return errors.Wrapf(err, "unmarshal data object")
 		}
@@ -283,8 +285,9 @@ func ScanAll(tx *bolt.Tx, scan docid.ObjectScanFn) error {
 	return nil
 }
 
-// ScanAllLSM iterates over every row in the object buckets
-func ScanAllLSM(store *lsmkv.Store, scan docid.ObjectScanFn) error {
+// ScanAllLSM iterates over every row in the object buckets, the property
+// values are typed by the data types declared in the class
+func ScanAllLSM(store *lsmkv.Store, class *models.Class, scan docid.ObjectScanFn) error {
 	b := store.Bucket(helpers.ObjectsBucketLSM)
 	if b == nil {
 		return fmt.Errorf("objects bucket not found")
@@ -294,7 +297,7 @@ func ScanAllLSM(store *lsmkv.Store, scan docid.ObjectScanFn) error {
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
-		elem, err := storobj.FromBinary(v)
+		elem, err := storobj.FromBinaryWithClass(v, class)
 		if err != nil {
 			return errors.Wrapf(err, "unmarshal data object")
 		}
@@ -74,8 +74,10 @@ func (a *Aggregator) objectVectorSearch(searchVector []float32,
 		return nil, nil, err
 	}
 
+	s := a.getSchema.GetSchemaSkipAuth()
 	bucket := a.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional.Properties{})
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional.Properties{},
+		s.GetClass(a.params.ClassName))
 	if err != nil {
 		return nil, nil, fmt.Errorf("get objects by doc id: %w", err)
 	}
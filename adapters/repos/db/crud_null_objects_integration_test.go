@@ -71,6 +71,162 @@ func TestFilterNullStateError(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+// The null state can be enabled for single properties only
+func TestFilterNullStatePerProperty(t *testing.T) {
+	class := createClassWithEverything(false, false)
+	vTrue := true
+	for _, prop := range class.Properties {
+		if prop.Name == "int" {
+			prop.IndexNullState = &vTrue
+		}
+	}
+	migrator, repo, schemaGetter := createRepo(t)
+	defer repo.Shutdown(context.Background())
+	err := migrator.AddClass(context.Background(), class, schemaGetter.shardState)
+	require.Nil(t, err)
+	// update schema getter so it's in sync with class
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	withInt := &models.Object{
+		ID:         strfmt.UUID(uuid.New().String()),
+		Class:      class.Class,
+		Properties: map[string]interface{}{"int": int64(7)},
+	}
+	withoutInt := &models.Object{
+		ID:         strfmt.UUID(uuid.New().String()),
+		Class:      class.Class,
+		Properties: map[string]interface{}{"number": 1.5},
+	}
+	require.Nil(t, repo.PutObject(context.Background(), withInt, []float32{1}, nil))
+	require.Nil(t, repo.PutObject(context.Background(), withoutInt, []float32{1}, nil))
+
+	search := func(propName string, isNull bool) ([]strfmt.UUID, error) {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 5},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorIsNull,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: schema.PropertyName(propName),
+					},
+					Value: &filters.Value{
+						Value: isNull,
+						Type:  schema.DataTypeBoolean,
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids, nil
+	}
+
+	ids, err := search("int", true)
+	require.Nil(t, err)
+	assert.Equal(t, []strfmt.UUID{withoutInt.ID}, ids)
+
+	ids, err = search("int", false)
+	require.Nil(t, err)
+	assert.Equal(t, []strfmt.UUID{withInt.ID}, ids)
+
+	_, err = search("number", true)
+	require.NotNil(t, err)
+}
+
+func TestFilterPropertyLengthPerProperty(t *testing.T) {
+	class := createClassWithEverything(false, false)
+	vTrue := true
+	for _, prop := range class.Properties {
+		if prop.Name == "text" {
+			prop.IndexPropertyLength = &vTrue
+		}
+	}
+	migrator, repo, schemaGetter := createRepo(t)
+	defer repo.Shutdown(context.Background())
+	err := migrator.AddClass(context.Background(), class, schemaGetter.shardState)
+	require.Nil(t, err)
+	// update schema getter so it's in sync with class
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	long := &models.Object{
+		ID:         strfmt.UUID(uuid.New().String()),
+		Class:      class.Class,
+		Properties: map[string]interface{}{"text": "a rather long description", "string": "long"},
+	}
+	short := &models.Object{
+		ID:         strfmt.UUID(uuid.New().String()),
+		Class:      class.Class,
+		Properties: map[string]interface{}{"text": "short", "string": "short"},
+	}
+	missing := &models.Object{
+		ID:         strfmt.UUID(uuid.New().String()),
+		Class:      class.Class,
+		Properties: map[string]interface{}{"string": "missing"},
+	}
+	for _, obj := range []*models.Object{long, short, missing} {
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1}, nil))
+	}
+
+	search := func(propName string, operator filters.Operator, length int) ([]strfmt.UUID, error) {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 5},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: operator,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: schema.PropertyName("len(" + propName + ")"),
+					},
+					Value: &filters.Value{
+						Value: length,
+						Type:  schema.DataTypeInt,
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids, nil
+	}
+
+	// objects without the property count as empty
+	ids, err := search("text", filters.OperatorLessThan, 10)
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []strfmt.UUID{short.ID, missing.ID}, ids)
+
+	ids, err = search("text", filters.OperatorEqual, 0)
+	require.Nil(t, err)
+	assert.Equal(t, []strfmt.UUID{missing.ID}, ids)
+
+	ids, err = search("text", filters.OperatorGreaterThanEqual, 10)
+	require.Nil(t, err)
+	assert.Equal(t, []strfmt.UUID{long.ID}, ids)
+
+	_, err = search("string", filters.OperatorEqual, 0)
+	require.NotNil(t, err)
+}
+
 func TestNullArrayClass(t *testing.T) {
 	arrayClass := createClassWithEverything(true, false)
 
@@ -157,7 +157,7 @@ func (f *fakeRemoteClient) PutObject(ctx context.Context, hostName, indexName,
 
 func (f *fakeRemoteClient) GetObject(ctx context.Context, hostName, indexName,
 	shardName string, id strfmt.UUID, props search.SelectProperties,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) (*storobj.Object, error) {
 	return nil, nil
 }
@@ -181,15 +181,16 @@ func (f *fakeRemoteClient) MergeObject(ctx context.Context, hostName, indexName,
 }
 
 func (f *fakeRemoteClient) MultiGetObjects(ctx context.Context, hostName, indexName,
-	shardName string, ids []strfmt.UUID,
+	shardName string, ids []strfmt.UUID, class *models.Class,
 ) ([]*storobj.Object, error) {
 	return nil, nil
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int,
-	filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
+	shardName string, vector []float32, targetVector string, _ *searchparams.VectorIndex,
+	limit int, filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties,
+	class *models.Class,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
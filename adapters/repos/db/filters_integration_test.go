@@ -66,16 +66,22 @@ func TestFilters(t *testing.T) {
 
 var (
 	// operators
-	eq   = filters.OperatorEqual
-	neq  = filters.OperatorNotEqual
-	lt   = filters.OperatorLessThan
-	lte  = filters.OperatorLessThanEqual
-	like = filters.OperatorLike
-	gt   = filters.OperatorGreaterThan
-	gte  = filters.OperatorGreaterThanEqual
-	wgr  = filters.OperatorWithinGeoRange
-	and  = filters.OperatorAnd
-	null = filters.OperatorIsNull
+	eq    = filters.OperatorEqual
+	neq   = filters.OperatorNotEqual
+	lt    = filters.OperatorLessThan
+	lte   = filters.OperatorLessThanEqual
+	like  = filters.OperatorLike
+	regex = filters.OperatorRegex
+	gt    = filters.OperatorGreaterThan
+	gte   = filters.OperatorGreaterThanEqual
+	wgr   = filters.OperatorWithinGeoRange
+	wgp   = filters.OperatorWithinGeoPolygon
+	wgb   = filters.OperatorWithinGeoBoundingBox
+	and   = filters.OperatorAnd
+	null  = filters.OperatorIsNull
+
+	containsAny = filters.OperatorContainsAny
+	containsAll = filters.OperatorContainsAll
 
 	// datatypes
 	dtInt            = schema.DataTypeInt
@@ -84,6 +90,8 @@ var (
 	dtText           = schema.DataTypeText
 	dtDate           = schema.DataTypeDate
 	dtGeoCoordinates = schema.DataTypeGeoCoordinates
+	dtTextArray      = schema.DataTypeTextArray
+	dtIntArray       = schema.DataTypeIntArray
 )
 
 func prepareCarTestSchemaAndData(repo *DB,
@@ -170,6 +178,21 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("modelName", "*rinte?", like, dtText),
 				expectedIDs: []strfmt.UUID{carSprinterID},
 			},
+			{
+				name:        "modelName regex spr.*er (optimizable) dtText",
+				filter:      buildFilter("modelName", "spr.*er", regex, dtText),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name:        "modelName regex .*rinte. (non-optimizable) dtText",
+				filter:      buildFilter("modelName", ".*rinte.", regex, dtText),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name:        "modelName regex (polo|e63s) dtText",
+				filter:      buildFilter("modelName", "(polo|e63s)", regex, dtText),
+				expectedIDs: []strfmt.UUID{carE63sID, carPoloID},
+			},
 			{
 				name:        "weight == 3499.90",
 				filter:      buildFilter("weight", 3499.90, eq, dtNumber),
@@ -311,6 +334,32 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				}, wgr, dtGeoCoordinates),
 				expectedIDs: []strfmt.UUID{carSprinterID},
 			},
+			{
+				name: "within a polygon around California",
+				filter: buildFilter("parkedAt", filters.GeoPolygon{
+					Coordinates: []models.GeoCoordinates{
+						{Latitude: ptFloat32(42.0), Longitude: ptFloat32(-124.4)},
+						{Latitude: ptFloat32(42.0), Longitude: ptFloat32(-120.0)},
+						{Latitude: ptFloat32(35.0), Longitude: ptFloat32(-114.6)},
+						{Latitude: ptFloat32(32.5), Longitude: ptFloat32(-117.1)},
+					},
+				}, wgp, dtGeoCoordinates),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name: "within a bounding box around the east coast",
+				filter: buildFilter("parkedAt", filters.GeoBoundingBox{
+					TopLeft: &models.GeoCoordinates{
+						Latitude:  ptFloat32(45.0),
+						Longitude: ptFloat32(-80.0),
+					},
+					BottomRight: &models.GeoCoordinates{
+						Latitude:  ptFloat32(38.0),
+						Longitude: ptFloat32(-70.0),
+					},
+				}, wgb, dtGeoCoordinates),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
 			// {
 			// 	name:        "by id like",
 			// 	filter:      buildFilter("id", carPoloID.String(), like, dtText),
@@ -479,6 +528,38 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("availableAtDealerships", dealershipSouth.String(), eq, dtText),
 				expectedIDs: []strfmt.UUID{carPoloID, carSprinterID},
 			},
+			{
+				name:        "contains any of the colors",
+				filter:      buildFilter("colorArrayField", []string{"light grey", "dark"}, containsAny, dtTextArray),
+				expectedIDs: []strfmt.UUID{carSprinterID, carPoloID},
+			},
+			{
+				name:        "contains all of the colors",
+				filter:      buildFilter("colorArrayField", []string{"dark", "grey"}, containsAll, dtTextArray),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			{
+				name:        "contains all of the colors with duplicates",
+				filter:      buildFilter("colorArrayField", []string{"grey", "grey"}, containsAll, dtTextArray),
+				expectedIDs: []strfmt.UUID{carE63sID, carPoloID},
+			},
+			{
+				name:        "contains any of the horsepowers",
+				filter:      buildFilter("horsepower", []int{130, 612}, containsAny, dtIntArray),
+				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
+			},
+			{
+				name: "available at any of the dealerships",
+				filter: buildFilter("availableAtDealerships",
+					[]string{dealershipNorth.String(), dealershipSouth.String()}, containsAny, dtTextArray),
+				expectedIDs: []strfmt.UUID{carE63sID, carSprinterID, carPoloID},
+			},
+			{
+				name: "available at all of the dealerships",
+				filter: buildFilter("availableAtDealerships",
+					[]string{dealershipNorth.String(), dealershipSouth.String()}, containsAll, dtTextArray),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
 		}
 
 		for _, test := range tests {
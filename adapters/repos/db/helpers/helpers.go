@@ -18,11 +18,13 @@ import (
 )
 
 var (
-	ObjectsBucket              = []byte("objects")
-	ObjectsBucketLSM           = "objects"
-	CompressedObjectsBucketLSM = "compressed_objects"
-	DimensionsBucketLSM        = "dimensions"
-	DocIDBucket                = []byte("doc_ids")
+	ObjectsBucket                = []byte("objects")
+	ObjectsBucketLSM             = "objects"
+	CompressedObjectsBucketLSM   = "compressed_objects"
+	DimensionsBucketLSM          = "dimensions"
+	RevectorizedVectorsBucketLSM = "revectorized_vectors"
+	PropertyChangesBucketLSM     = "prop_changes"
+	DocIDBucket                  = []byte("doc_ids")
 )
 
 // BucketFromPropName creates the byte-representation used as the bucket name
@@ -71,3 +73,15 @@ func TempBucketFromBucketName(bucketName string) string {
 func BucketSearchableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_searchable")
 }
+
+func BucketPositionsFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_positions")
+}
+
+func BucketReversedFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_reversed")
+}
+
+func BucketRangeableFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_rangeable")
+}
@@ -23,6 +23,9 @@ var Tokenizations []string = []string{
 	models.PropertyTokenizationLowercase,
 	models.PropertyTokenizationWhitespace,
 	models.PropertyTokenizationField,
+	models.PropertyTokenizationTrigram,
+	models.PropertyTokenizationGse,
+	models.PropertyTokenizationKagomeJa,
 }
 
 func Tokenize(tokenization string, in string) []string {
@@ -35,6 +38,12 @@ func Tokenize(tokenization string, in string) []string {
 		return tokenizeWhitespace(in)
 	case models.PropertyTokenizationField:
 		return tokenizeField(in)
+	case models.PropertyTokenizationTrigram:
+		return tokenizeTrigram(in)
+	case models.PropertyTokenizationGse:
+		return tokenizeGse(in)
+	case models.PropertyTokenizationKagomeJa:
+		return tokenizeKagomeJa(in)
 	default:
 		return []string{}
 	}
@@ -50,6 +59,12 @@ func TokenizeWithWildcards(tokenization string, in string) []string {
 		return tokenizeWhitespace(in)
 	case models.PropertyTokenizationField:
 		return tokenizeField(in)
+	case models.PropertyTokenizationTrigram:
+		return tokenizeTrigram(in)
+	case models.PropertyTokenizationGse:
+		return tokenizeGse(in)
+	case models.PropertyTokenizationKagomeJa:
+		return tokenizeKagomeJa(in)
 	default:
 		return []string{}
 	}
@@ -91,6 +106,26 @@ func tokenizeWordWithWildcards(in string) []string {
 	return lowercase(terms)
 }
 
+// tokenizeTrigram splits on any non-alphanumerical, lowercases the words and
+// returns all sequences of three characters of each word. Words shorter
+// than three characters are kept as they are. Like and Regex filters are
+// rejected on trigram properties, as a pattern can't be matched against the
+// trigrams of a word.
+func tokenizeTrigram(in string) []string {
+	var trigrams []string
+	for _, word := range tokenizeWord(in) {
+		runes := []rune(word)
+		if len(runes) < 3 {
+			trigrams = append(trigrams, word)
+			continue
+		}
+		for i := 0; i+3 <= len(runes); i++ {
+			trigrams = append(trigrams, string(runes[i:i+3]))
+		}
+	}
+	return trigrams
+}
+
 func lowercase(terms []string) []string {
 	for i := range terms {
 		terms[i] = strings.ToLower(terms[i])
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/go-ego/gse"
+	"github.com/ikawaha/kagome-dict/ipa"
+	kagome "github.com/ikawaha/kagome/v2/tokenizer"
+)
+
+// The dictionaries of the CJK tokenizers take several seconds to load and
+// occupy a considerable amount of memory, so they are only loaded once a
+// property with the respective tokenization is indexed or searched.
+var (
+	gseOnce      sync.Once
+	gseSegmenter *gse.Segmenter
+	// the segmenter is not safe for concurrent use
+	gseLock sync.Mutex
+
+	kagomeOnce      sync.Once
+	kagomeTokenizer *kagome.Tokenizer
+)
+
+func loadGse() {
+	// the embedded dictionaries cover Chinese and Japanese
+	seg, err := gse.NewEmbed("ja")
+	if err != nil {
+		return
+	}
+	if err := seg.LoadDictEmbed("zh"); err != nil {
+		return
+	}
+	gseSegmenter = &seg
+}
+
+func loadKagome() {
+	t, err := kagome.New(ipa.Dict(), kagome.OmitBosEos())
+	if err != nil {
+		return
+	}
+	kagomeTokenizer = t
+}
+
+// tokenizeGse splits Chinese and Japanese text into all words of the
+// dictionary which occur in it, so that a query matches no matter which
+// segmentation of an ambiguous sequence was intended. Words are lowercased,
+// whitespace and punctuation are removed.
+func tokenizeGse(in string) []string {
+	gseOnce.Do(loadGse)
+	if gseSegmenter == nil {
+		return []string{}
+	}
+
+	gseLock.Lock()
+	segments := gseSegmenter.CutAll(in)
+	gseLock.Unlock()
+
+	return cjkTerms(segments)
+}
+
+// tokenizeKagomeJa splits Japanese text into words by morphological analysis.
+// The search mode additionally splits compound nouns into their parts.
+// Words are lowercased, whitespace and punctuation are removed.
+func tokenizeKagomeJa(in string) []string {
+	kagomeOnce.Do(loadKagome)
+	if kagomeTokenizer == nil {
+		return []string{}
+	}
+
+	tokens := kagomeTokenizer.Analyze(in, kagome.Search)
+	segments := make([]string, len(tokens))
+	for i := range tokens {
+		segments[i] = tokens[i].Surface
+	}
+
+	return cjkTerms(segments)
+}
+
+// cjkTerms lowercases the segments and drops those without any letter or
+// number
+func cjkTerms(segments []string) []string {
+	terms := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		if strings.IndexFunc(segment, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsNumber(r)
+		}) == -1 {
+			continue
+		}
+		terms = append(terms, strings.ToLower(strings.TrimFunc(segment, unicode.IsSpace)))
+	}
+	return terms
+}
@@ -44,6 +44,13 @@ func TestTokenize(t *testing.T) {
 				tokenization: models.PropertyTokenizationWord,
 				expected:     []string{"hello", "you", "beautiful", "world"},
 			},
+			{
+				tokenization: models.PropertyTokenizationTrigram,
+				expected: []string{
+					"hel", "ell", "llo", "you", "bea", "eau", "aut", "uti", "tif", "ifu", "ful",
+					"wor", "orl", "rld",
+				},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -70,6 +77,13 @@ func TestTokenize(t *testing.T) {
 				tokenization: models.PropertyTokenizationWord,
 				expected:     []string{"hello", "you*", "beautiful", "world?"},
 			},
+			{
+				tokenization: models.PropertyTokenizationTrigram,
+				expected: []string{
+					"hel", "ell", "llo", "you", "bea", "eau", "aut", "uti", "tif", "ifu", "ful",
+					"wor", "orl", "rld",
+				},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -77,6 +91,24 @@ func TestTokenize(t *testing.T) {
 			assert.ElementsMatch(t, tc.expected, terms)
 		}
 	})
+
+	t.Run("tokenize trigram short and non-ascii words", func(t *testing.T) {
+		terms := Tokenize(models.PropertyTokenizationTrigram, "go Straße")
+		assert.Equal(t, []string{"go", "str", "tra", "raß", "aße"}, terms)
+	})
+
+	t.Run("tokenize japanese with kagome", func(t *testing.T) {
+		terms := Tokenize(models.PropertyTokenizationKagomeJa, "すもももももももものうち。")
+		assert.Equal(t, []string{"すもも", "も", "もも", "も", "もも", "の", "うち"}, terms)
+	})
+
+	t.Run("tokenize chinese with gse", func(t *testing.T) {
+		terms := Tokenize(models.PropertyTokenizationGse, "我来到北京清华大学。")
+		assert.Contains(t, terms, "北京")
+		assert.Contains(t, terms, "清华大学")
+		assert.Contains(t, terms, "大学")
+		assert.NotContains(t, terms, "。")
+	})
 }
 
 func TestTokenizeAndCountDuplicates(t *testing.T) {
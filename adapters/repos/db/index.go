@@ -114,11 +114,13 @@ type Index struct {
 	shards                shardMap
 	Config                IndexConfig
 	vectorIndexUserConfig schema.VectorIndexConfig
-	getSchema             schemaUC.SchemaGetter
-	logger                logrus.FieldLogger
-	remote                *sharding.RemoteIndex
-	stopwords             *stopwords.Detector
-	replicator            *replica.Replicator
+	// the vector index configs of the named vectors of the class
+	vectorIndexUserConfigs map[string]schema.VectorIndexConfig
+	getSchema              schemaUC.SchemaGetter
+	logger                 logrus.FieldLogger
+	remote                 *sharding.RemoteIndex
+	stopwords              *stopwords.Detector
+	replicator             *replica.Replicator
 
 	backupState     BackupState
 	backupStateLock sync.RWMutex
@@ -173,14 +175,15 @@ func NewIndex(ctx context.Context, config IndexConfig,
 		sg, nodeResolver, replicaClient, logger)
 
 	index := &Index{
-		Config:                config,
-		getSchema:             sg,
-		logger:                logger,
-		classSearcher:         cs,
-		vectorIndexUserConfig: vectorIndexUserConfig,
-		invertedIndexConfig:   invertedIndexConfig,
-		stopwords:             sd,
-		replicator:            repl,
+		Config:                 config,
+		getSchema:              sg,
+		logger:                 logger,
+		classSearcher:          cs,
+		vectorIndexUserConfig:  vectorIndexUserConfig,
+		vectorIndexUserConfigs: targetVectorIndexConfigs(class),
+		invertedIndexConfig:    invertedIndexConfig,
+		stopwords:              sd,
+		replicator:             repl,
 		remote: sharding.NewRemoteIndex(config.ClassName.String(), sg,
 			nodeResolver, remoteClient),
 		metrics:             NewMetrics(logger, promMetrics, config.ClassName.String(), "n/a"),
@@ -217,7 +220,7 @@ func (i *Index) IterateObjects(ctx context.Context, cb func(index *Index, shard
 			return cb(i, shard, object)
 		}
 		bucket := shard.store.Bucket(helpers.ObjectsBucketLSM)
-		return bucket.IterateObjects(ctx, wrapper)
+		return bucket.IterateObjects(ctx, shard.class(), wrapper)
 	})
 }
 
@@ -246,6 +249,28 @@ func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
 	return nil
 }
 
+func (i *Index) dropProperty(ctx context.Context, prop *models.Property,
+	changedAt int64,
+) error {
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		if err := shard.dropProperty(ctx, prop, changedAt); err != nil {
+			return errors.Wrapf(err, "drop property %q from shard %q", prop.Name, name)
+		}
+		return nil
+	})
+}
+
+func (i *Index) renameProperty(ctx context.Context, prop *models.Property,
+	newName string, changedAt int64,
+) error {
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		if err := shard.renameProperty(ctx, prop, newName, changedAt); err != nil {
+			return errors.Wrapf(err, "rename property %q of shard %q", prop.Name, name)
+		}
+		return nil
+	})
+}
+
 func (i *Index) addUUIDProperty(ctx context.Context) error {
 	return i.ForEachShard(func(name string, shard *Shard) error {
 		err := shard.addIDProperty(ctx)
@@ -290,6 +315,17 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	})
 }
 
+func (i *Index) updateTargetVectorIndexConfigs(ctx context.Context,
+	updated map[string]schema.VectorIndexConfig,
+) error {
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		if err := shard.updateTargetVectorIndexConfigs(ctx, updated); err != nil {
+			return errors.Wrapf(err, "shard %s", name)
+		}
+		return nil
+	})
+}
+
 func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
@@ -303,6 +339,12 @@ func (i *Index) updateInvertedIndexConfig(ctx context.Context,
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
 
+	if i.stopwords != nil {
+		if err := i.stopwords.SetConfig(updated.Stopwords); err != nil {
+			return errors.Wrap(err, "update stopwords")
+		}
+	}
+
 	i.invertedIndexConfig = updated
 
 	return nil
@@ -321,6 +363,9 @@ type IndexConfig struct {
 	ReplicationFactor         int64
 
 	TrackVectorDimensions bool
+	AsyncIndexing         bool
+	TombstoneCleanup      config.TombstoneCleanup
+	HNSWSnapshots         config.HNSWSnapshots
 }
 
 func indexID(class schema.ClassName) string {
@@ -937,19 +982,6 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 					oo.Object.Additional = make(map[string]interface{})
 				}
 				oo.Object.Additional["score"] = os
-
-				// Collect all keys starting with "BM25F" and add them to the Additional
-				if keywordRanking.AdditionalExplanations {
-					explainScore := ""
-					for k, v := range oo.Object.Additional {
-						if strings.HasPrefix(k, "BM25F") {
-
-							explainScore = fmt.Sprintf("%v, %v:%v", explainScore, k, v)
-							delete(oo.Object.Additional, k)
-						}
-					}
-					oo.Object.Additional["explainScore"] = explainScore
-				}
 			}
 		}
 	}
@@ -1035,7 +1067,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				}
 			} else {
 				objs, scores, err = i.remote.SearchShard(
-					ctx, shardName, nil, limit, filters, keywordRanking,
+					ctx, shardName, nil, "", nil, limit, filters, keywordRanking,
 					sort, cursor, nil, addlProps, i.replicationEnabled())
 				if err != nil {
 					return fmt.Errorf(
@@ -1120,13 +1152,14 @@ func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 }
 
 func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter,
+	targetVector string, indexParams *searchparams.VectorIndex, dist float32, limit int,
+	filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
 	shardName string,
 ) ([]*storobj.Object, []float32, error) {
 	shard := i.shards.Load(shardName)
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, targetVector, indexParams, dist, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1150,7 +1183,8 @@ func (i *Index) targetShardNames(tenant string) ([]string, error) {
 }
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter,
+	targetVector string, indexParams *searchparams.VectorIndex, dist float32, limit int,
+	filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy,
 	additional additional.Properties, tenant string,
 ) ([]*storobj.Object, []float32, error) {
@@ -1164,7 +1198,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 	if len(shardNames) == 1 {
 		if i.localShard(shardNames[0]) != nil {
-			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, dist, limit, filters,
+			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, targetVector, indexParams, dist, limit, filters,
 				sort, groupBy, additional, shardNames[0])
 		}
 	}
@@ -1193,13 +1227,13 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 			if shard := i.localShard(shardName); shard != nil {
 				res, resDists, err = shard.objectVectorSearch(
-					ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+					ctx, searchVector, targetVector, indexParams, dist, limit, filters, sort, groupBy, additional)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
 			} else {
 				res, resDists, err = i.remote.SearchShard(ctx,
-					shardName, searchVector, limit, filters,
+					shardName, searchVector, targetVector, indexParams, limit, filters,
 					nil, sort, nil, groupBy, additional, i.replicationEnabled())
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
@@ -1241,7 +1275,8 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 }
 
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
-	searchVector []float32, distance float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, indexParams *searchparams.VectorIndex,
+	distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	additional additional.Properties,
@@ -1261,7 +1296,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	}
 
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, distance, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, targetVector, indexParams, distance, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -0,0 +1,501 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+const (
+	// indexQueueBatchSize is the number of vectors a worker inserts into the
+	// vector index before it gives other queues a chance
+	indexQueueBatchSize = 1000
+
+	// indexQueueCheckpointInterval limits how often the checkpoint is written
+	indexQueueCheckpointInterval = time.Second
+
+	IndexingStatusReady    = "READY"
+	IndexingStatusIndexing = "INDEXING"
+)
+
+// asyncIndexingWorkers limits the number of queues of all shards which insert
+// into their vector index at the same time
+var asyncIndexingWorkers = make(chan struct{}, runtime.GOMAXPROCS(0))
+
+// nodeContainer is implemented by vector indexes which can tell whether they
+// already contain a vector, so that vectors aren't inserted twice when the
+// queue is restored
+type nodeContainer interface {
+	ContainsNode(id uint64) bool
+}
+
+// IndexQueue decouples imports from the construction of the vector index.
+// Objects and their vectors are persisted right away, the insertion into the
+// vector index is queued and done by background workers. Vectors which are
+// still queued are searched by brute force, so that they are part of the
+// results right away.
+//
+// The queue itself lives in memory. A checkpoint on disk records the doc id
+// below which all vectors have been indexed, the vectors after the checkpoint
+// are read from the object store again on startup.
+type IndexQueue struct {
+	VectorIndex
+
+	shardID     string
+	logger      logrus.FieldLogger
+	distancer   distancer.Provider
+	vectorForID func(ctx context.Context, id uint64) ([]float32, error)
+	maxDocID    func() uint64
+
+	sync.Mutex
+	pending map[uint64][]float32
+	order   []uint64
+	// next is the doc id after the highest queued one
+	next uint64
+
+	// batchLock makes sure that a delete can't overtake the insertion of a
+	// vector which has already been taken from the queue
+	batchLock      sync.Mutex
+	scheduled      atomic.Bool
+	wg             sync.WaitGroup
+	closed         atomic.Bool
+	checkpointPath string
+	lastCheckpoint time.Time
+}
+
+func NewIndexQueue(shardID, rootPath string, index VectorIndex,
+	distProv distancer.Provider, logger logrus.FieldLogger,
+	vectorForID func(ctx context.Context, id uint64) ([]float32, error),
+	maxDocID func() uint64,
+) *IndexQueue {
+	return &IndexQueue{
+		VectorIndex:    index,
+		shardID:        shardID,
+		logger:         logger,
+		distancer:      distProv,
+		vectorForID:    vectorForID,
+		maxDocID:       maxDocID,
+		pending:        map[uint64][]float32{},
+		checkpointPath: filepath.Join(rootPath, fmt.Sprintf("%s.indexqueue.checkpoint", shardID)),
+	}
+}
+
+// Add queues the vector, the caller has already persisted it with the object
+func (q *IndexQueue) Add(id uint64, vector []float32) error {
+	if err := q.VectorIndex.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+
+	q.Lock()
+	q.push(id, vector)
+	q.Unlock()
+
+	q.schedule()
+	return nil
+}
+
+func (q *IndexQueue) push(id uint64, vector []float32) {
+	if _, ok := q.pending[id]; ok {
+		return
+	}
+
+	q.pending[id] = vector
+	q.order = append(q.order, id)
+	if id >= q.next {
+		q.next = id + 1
+	}
+}
+
+func (q *IndexQueue) Delete(ids ...uint64) error {
+	q.Lock()
+	for _, id := range ids {
+		delete(q.pending, id)
+	}
+	q.Unlock()
+
+	q.batchLock.Lock()
+	defer q.batchLock.Unlock()
+
+	return q.VectorIndex.Delete(ids...)
+}
+
+// Size returns the number of vectors which haven't been indexed yet
+func (q *IndexQueue) Size() int64 {
+	q.Lock()
+	defer q.Unlock()
+
+	return int64(len(q.pending))
+}
+
+// Status is INDEXING as long as there are queued vectors and READY otherwise
+func (q *IndexQueue) Status() string {
+	if q.Size() > 0 {
+		return IndexingStatusIndexing
+	}
+	return IndexingStatusReady
+}
+
+func (q *IndexQueue) schedule() {
+	if q.closed.Load() || !q.scheduled.CompareAndSwap(false, true) {
+		return
+	}
+
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+
+		asyncIndexingWorkers <- struct{}{}
+		defer func() { <-asyncIndexingWorkers }()
+
+		q.indexBatch()
+		q.scheduled.Store(false)
+
+		// a vector might have been queued after the batch was taken
+		if q.Size() > 0 {
+			q.schedule()
+		}
+	}()
+}
+
+// indexBatch inserts the oldest queued vectors into the vector index
+func (q *IndexQueue) indexBatch() {
+	q.batchLock.Lock()
+	defer q.batchLock.Unlock()
+
+	if q.closed.Load() {
+		return
+	}
+
+	q.Lock()
+	ids := make([]uint64, 0, indexQueueBatchSize)
+	vectors := make([][]float32, 0, indexQueueBatchSize)
+	consumed := 0
+	for _, id := range q.order {
+		if len(ids) == indexQueueBatchSize {
+			break
+		}
+		consumed++
+
+		// deleted in the meantime
+		if vec, ok := q.pending[id]; ok {
+			ids = append(ids, id)
+			vectors = append(vectors, vec)
+		}
+	}
+	q.order = q.order[consumed:]
+	q.Unlock()
+
+	indexed := ids[:0]
+	for i, id := range ids {
+		if err := q.VectorIndex.Add(id, vectors[i]); err != nil {
+			q.logger.WithField("action", "index_queue").WithField("shard", q.shardID).
+				WithError(err).Errorf("insert doc id %d into vector index", id)
+		}
+		indexed = append(indexed, id)
+	}
+
+	q.Lock()
+	for _, id := range indexed {
+		delete(q.pending, id)
+	}
+	q.Unlock()
+
+	if time.Since(q.lastCheckpoint) > indexQueueCheckpointInterval {
+		q.saveCheckpoint()
+	}
+}
+
+// checkpoint returns the doc id below which all vectors have been indexed
+func (q *IndexQueue) checkpoint() uint64 {
+	q.Lock()
+	defer q.Unlock()
+
+	checkpoint := q.next
+	for id := range q.pending {
+		if id < checkpoint {
+			checkpoint = id
+		}
+	}
+	return checkpoint
+}
+
+func (q *IndexQueue) saveCheckpoint() {
+	q.lastCheckpoint = time.Now()
+
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, q.checkpoint())
+	if err := os.WriteFile(q.checkpointPath, buf, 0o666); err != nil {
+		q.logger.WithField("action", "index_queue").WithField("shard", q.shardID).
+			WithError(err).Error("write index queue checkpoint")
+	}
+}
+
+func (q *IndexQueue) readCheckpoint() (uint64, bool, error) {
+	buf, err := os.ReadFile(q.checkpointPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	if len(buf) != 8 {
+		return 0, false, errors.Errorf("invalid checkpoint of length %d", len(buf))
+	}
+	return binary.LittleEndian.Uint64(buf), true, nil
+}
+
+// PostStartup queues the vectors which were persisted after the last
+// checkpoint, but which the vector index doesn't contain yet
+func (q *IndexQueue) PostStartup() {
+	q.VectorIndex.PostStartup()
+
+	checkpoint, ok, err := q.readCheckpoint()
+	if err != nil {
+		q.logger.WithField("action", "index_queue").WithField("shard", q.shardID).
+			WithError(err).Error("read index queue checkpoint")
+		return
+	}
+	if !ok {
+		// the shard has never been imported into asynchronously, all existing
+		// vectors are part of the vector index
+		q.Lock()
+		q.next = q.maxDocID()
+		q.Unlock()
+		q.saveCheckpoint()
+		return
+	}
+
+	container, canCheck := q.VectorIndex.(nodeContainer)
+
+	q.Lock()
+	q.next = checkpoint
+	maxDocID := q.maxDocID()
+	for id := checkpoint; id < maxDocID; id++ {
+		if canCheck && container.ContainsNode(id) {
+			continue
+		}
+
+		vec, err := q.vectorForID(context.Background(), id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if !errors.As(err, &e) {
+				q.logger.WithField("action", "index_queue").WithField("shard", q.shardID).
+					WithError(err).Errorf("restore doc id %d", id)
+			}
+			continue
+		}
+		if len(vec) > 0 {
+			q.push(id, vec)
+		}
+	}
+	if q.next < maxDocID {
+		q.next = maxDocID
+	}
+	q.Unlock()
+
+	q.schedule()
+}
+
+// SearchByVector combines the results of the vector index with the closest
+// queued vectors
+func (q *IndexQueue) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return q.SearchByVectorWithParams(vector, k, allow, searchparams.VectorIndex{})
+}
+
+// SearchByVectorWithParams passes the search params on to the vector index,
+// they don't affect the search of the queued vectors
+func (q *IndexQueue) SearchByVectorWithParams(vector []float32, k int,
+	allow helpers.AllowList, params searchparams.VectorIndex,
+) ([]uint64, []float32, error) {
+	ids, dists, err := searchByVector(q.VectorIndex, vector, k, allow, &params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queuedIDs, queuedDists, err := q.searchQueued(vector, allow, float32(math.Inf(1)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists = mergeQueuedResults(ids, dists, queuedIDs, queuedDists)
+	if k >= 0 && len(ids) > k {
+		ids, dists = ids[:k], dists[:k]
+	}
+	return ids, dists, nil
+}
+
+func (q *IndexQueue) SearchByVectorDistance(vector []float32, dist float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return q.SearchByVectorDistanceWithParams(vector, dist, maxLimit, allow,
+		searchparams.VectorIndex{})
+}
+
+func (q *IndexQueue) SearchByVectorDistanceWithParams(vector []float32, dist float32,
+	maxLimit int64, allow helpers.AllowList, params searchparams.VectorIndex,
+) ([]uint64, []float32, error) {
+	ids, dists, err := searchByVectorDistance(q.VectorIndex, vector, dist, maxLimit,
+		allow, &params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queuedIDs, queuedDists, err := q.searchQueued(vector, allow, dist)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists = mergeQueuedResults(ids, dists, queuedIDs, queuedDists)
+	if maxLimit > 0 && int64(len(ids)) > maxLimit {
+		ids, dists = ids[:maxLimit], dists[:maxLimit]
+	}
+	return ids, dists, nil
+}
+
+// searchQueued compares the query to all queued vectors by brute force
+func (q *IndexQueue) searchQueued(vector []float32, allow helpers.AllowList,
+	maxDist float32,
+) ([]uint64, []float32, error) {
+	if q.distancer.Type() == "cosine-dot" {
+		vector = distancer.Normalize(vector)
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	var ids []uint64
+	var dists []float32
+	for id, vec := range q.pending {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+
+		if q.distancer.Type() == "cosine-dot" {
+			vec = distancer.Normalize(vec)
+		}
+
+		dist, _, err := q.distancer.SingleDist(vector, vec)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "calculate distance to queued doc id %d", id)
+		}
+		if dist <= maxDist {
+			ids = append(ids, id)
+			dists = append(dists, dist)
+		}
+	}
+	return ids, dists, nil
+}
+
+// mergeQueuedResults orders the results of both searches by distance. A vector
+// can be part of both while it is inserted, it is only returned once.
+func mergeQueuedResults(ids []uint64, dists []float32,
+	queuedIDs []uint64, queuedDists []float32,
+) ([]uint64, []float32) {
+	if len(queuedIDs) == 0 {
+		return ids, dists
+	}
+
+	seen := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+
+	for i, id := range queuedIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		ids = append(ids, id)
+		dists = append(dists, queuedDists[i])
+	}
+
+	sort.Sort(&resultsByDistance{ids: ids, dists: dists})
+	return ids, dists
+}
+
+type resultsByDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (r *resultsByDistance) Len() int {
+	return len(r.ids)
+}
+
+func (r *resultsByDistance) Less(a, b int) bool {
+	return r.dists[a] < r.dists[b]
+}
+
+func (r *resultsByDistance) Swap(a, b int) {
+	r.ids[a], r.ids[b] = r.ids[b], r.ids[a]
+	r.dists[a], r.dists[b] = r.dists[b], r.dists[a]
+}
+
+// close stops the queue and waits for the batch which is in progress
+func (q *IndexQueue) close() {
+	q.closed.Store(true)
+	q.wg.Wait()
+}
+
+func (q *IndexQueue) Shutdown(ctx context.Context) error {
+	q.close()
+	q.saveCheckpoint()
+
+	return q.VectorIndex.Shutdown(ctx)
+}
+
+func (q *IndexQueue) Drop(ctx context.Context) error {
+	q.close()
+
+	if err := os.Remove(q.checkpointPath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove index queue checkpoint")
+	}
+
+	return q.VectorIndex.Drop(ctx)
+}
+
+func (q *IndexQueue) ListFiles(ctx context.Context) ([]string, error) {
+	files, err := q.VectorIndex.ListFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q.batchLock.Lock()
+	q.saveCheckpoint()
+	q.batchLock.Unlock()
+
+	return append(files, filepath.Base(q.checkpointPath)), nil
+}
+
+func (q *IndexQueue) Dump(labels ...string) {
+	fmt.Printf("Index queue: %d vectors\n", q.Size())
+	q.VectorIndex.Dump(labels...)
+}
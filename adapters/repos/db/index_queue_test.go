@@ -0,0 +1,222 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// fakeQueueIndex records the inserted vectors and doesn't find anything
+type fakeQueueIndex struct {
+	*noop.Index
+
+	sync.Mutex
+	added map[uint64][]float32
+	block chan struct{}
+}
+
+func newFakeQueueIndex() *fakeQueueIndex {
+	return &fakeQueueIndex{Index: noop.NewIndex(), added: map[uint64][]float32{}}
+}
+
+func (f *fakeQueueIndex) Add(id uint64, vector []float32) error {
+	if f.block != nil {
+		<-f.block
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.added[id] = vector
+	return nil
+}
+
+func (f *fakeQueueIndex) Delete(ids ...uint64) error {
+	f.Lock()
+	defer f.Unlock()
+	for _, id := range ids {
+		delete(f.added, id)
+	}
+	return nil
+}
+
+func (f *fakeQueueIndex) ContainsNode(id uint64) bool {
+	f.Lock()
+	defer f.Unlock()
+	_, ok := f.added[id]
+	return ok
+}
+
+func (f *fakeQueueIndex) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeQueueIndex) SearchByVectorDistance(vector []float32, dist float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeQueueIndex) ListFiles(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (f *fakeQueueIndex) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func (f *fakeQueueIndex) size() int {
+	f.Lock()
+	defer f.Unlock()
+	return len(f.added)
+}
+
+func newTestIndexQueue(t *testing.T, rootPath string, index VectorIndex,
+	vectors map[uint64][]float32,
+) *IndexQueue {
+	logger, _ := test.NewNullLogger()
+	maxDocID := func() uint64 {
+		max := uint64(0)
+		for id := range vectors {
+			if id+1 > max {
+				max = id + 1
+			}
+		}
+		return max
+	}
+	vectorForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		vec, ok := vectors[id]
+		if !ok {
+			return nil, storobj.NewErrNotFoundf(id, "not found")
+		}
+		return vec, nil
+	}
+
+	return NewIndexQueue("shard", rootPath, index, distancer.NewL2SquaredProvider(),
+		logger, vectorForID, maxDocID)
+}
+
+func TestIndexQueue(t *testing.T) {
+	vectors := map[uint64][]float32{
+		0: {1, 0},
+		1: {2, 0},
+		2: {3, 0},
+	}
+
+	t.Run("vectors are indexed in the background", func(t *testing.T) {
+		index := newFakeQueueIndex()
+		q := newTestIndexQueue(t, t.TempDir(), index, vectors)
+
+		for id, vec := range vectors {
+			require.Nil(t, q.Add(id, vec))
+		}
+
+		assert.Eventually(t, func() bool {
+			return index.size() == len(vectors) && q.Size() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, IndexingStatusReady, q.Status())
+		require.Nil(t, q.Shutdown(context.Background()))
+	})
+
+	t.Run("queued vectors are searchable", func(t *testing.T) {
+		index := newFakeQueueIndex()
+		index.block = make(chan struct{})
+		q := newTestIndexQueue(t, t.TempDir(), index, vectors)
+
+		for id, vec := range vectors {
+			require.Nil(t, q.Add(id, vec))
+		}
+		assert.Equal(t, IndexingStatusIndexing, q.Status())
+
+		ids, dists, err := q.SearchByVector([]float32{2.9, 0}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1}, ids)
+		assert.InDeltaSlice(t, []float32{0.01, 0.81}, dists, 0.0001)
+
+		ids, _, err = q.SearchByVectorDistance([]float32{0, 0}, 4, -1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1}, ids)
+
+		ids, _, err = q.SearchByVector([]float32{0, 0}, 3, helpers.NewAllowList(2))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2}, ids)
+
+		close(index.block)
+		require.Nil(t, q.Shutdown(context.Background()))
+	})
+
+	t.Run("deleted vectors are not indexed", func(t *testing.T) {
+		index := newFakeQueueIndex()
+		index.block = make(chan struct{})
+		q := newTestIndexQueue(t, t.TempDir(), index, vectors)
+
+		require.Nil(t, q.Add(0, vectors[0]))
+		require.Nil(t, q.Add(1, vectors[1]))
+		require.Nil(t, q.Delete(1))
+
+		close(index.block)
+		assert.Eventually(t, func() bool {
+			return q.Size() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Nil(t, q.Shutdown(context.Background()))
+
+		assert.True(t, index.ContainsNode(0))
+		assert.False(t, index.ContainsNode(1))
+	})
+
+	t.Run("vectors after the checkpoint are queued on startup", func(t *testing.T) {
+		dir := t.TempDir()
+
+		index := newFakeQueueIndex()
+		q := newTestIndexQueue(t, dir, index, map[uint64][]float32{})
+		q.PostStartup()
+		require.Nil(t, q.Shutdown(context.Background()))
+
+		files, err := q.ListFiles(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, []string{"shard.indexqueue.checkpoint"}, files)
+
+		// the objects were written, but the vector index was shut down before
+		// any of them were inserted
+		index = newFakeQueueIndex()
+		index.added[1] = vectors[1]
+		q = newTestIndexQueue(t, dir, index, vectors)
+		q.PostStartup()
+
+		assert.Eventually(t, func() bool {
+			return index.size() == len(vectors) && q.Size() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Nil(t, q.Shutdown(context.Background()))
+	})
+
+	t.Run("drop removes the checkpoint", func(t *testing.T) {
+		dir := t.TempDir()
+		q := newTestIndexQueue(t, dir, newFakeQueueIndex(), vectors)
+		q.PostStartup()
+		require.FileExists(t, q.checkpointPath)
+
+		require.Nil(t, q.Drop(context.Background()))
+		assert.NoFileExists(t, q.checkpointPath)
+	})
+}
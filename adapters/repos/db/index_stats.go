@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// hnswStatsProvider is implemented by the hnsw index
+type hnswStatsProvider interface {
+	Stats() hnsw.Stats
+}
+
+// GetIndexStats returns the stats of the vector index of a shard of this
+// node. Shards which live on other nodes are reported as not found.
+func (db *DB) GetIndexStats(ctx context.Context, className,
+	shardName string,
+) (*models.IndexStats, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(
+			fmt.Errorf("class %q not found", className))
+	}
+
+	shard := idx.localShard(shardName)
+	if shard == nil {
+		return nil, enterrors.NewErrNotFound(
+			fmt.Errorf("shard %q of class %q not found on this node", shardName, className))
+	}
+
+	stats, ok := vectorIndexStats(shard.vectorIndex)
+	if !ok {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("vector index of shard %q does not provide stats", shardName))
+	}
+
+	nodesPerLayer := make([]int64, len(stats.NodesPerLayer))
+	for i, count := range stats.NodesPerLayer {
+		nodesPerLayer[i] = int64(count)
+	}
+
+	var hitRate float64
+	if lookups := stats.CacheHits + stats.CacheMisses; lookups > 0 {
+		hitRate = float64(stats.CacheHits) / float64(lookups)
+	}
+
+	return &models.IndexStats{
+		Class:          className,
+		Shard:          shardName,
+		NodeCount:      int64(stats.Nodes),
+		NodesPerLayer:  nodesPerLayer,
+		TombstoneCount: int64(stats.Tombstones),
+		EntryPoint:     int64(stats.EntryPoint),
+		MaxLayer:       int64(stats.MaxLayer),
+		Compression:    stats.Compression,
+		CachedVectors:  stats.CachedVectors,
+		CacheMaxSize:   stats.CacheMaxSize,
+		CacheHits:      stats.CacheHits,
+		CacheMisses:    stats.CacheMisses,
+		CacheHitRate:   hitRate,
+		MemoryEstimate: stats.MemoryEstimate,
+	}, nil
+}
+
+// vectorIndexStats returns the stats of the graph of the vector index, ok is
+// false for indexes without a graph, such as the flat index
+func vectorIndexStats(vi VectorIndex) (stats hnsw.Stats, ok bool) {
+	switch index := vi.(type) {
+	case *IndexQueue:
+		return vectorIndexStats(index.VectorIndex)
+	case *dynamic.Index:
+		return index.Stats()
+	case hnswStatsProvider:
+		return index.Stats(), true
+	default:
+		return hnsw.Stats{}, false
+	}
+}
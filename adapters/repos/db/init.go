@@ -65,6 +65,9 @@ func (db *DB) init(ctx context.Context) error {
 				MemtablesMinActiveSeconds: db.config.MemtablesMinActiveSeconds,
 				MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
 				TrackVectorDimensions:     db.config.TrackVectorDimensions,
+				AsyncIndexing:             db.config.AsyncIndexing,
+				TombstoneCleanup:          db.config.TombstoneCleanup,
+				HNSWSnapshots:             db.config.HNSWSnapshots,
 				ReplicationFactor:         class.ReplicationConfig.Factor,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
@@ -17,6 +17,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stemmer"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -25,6 +26,9 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	// Positions of the term in the analyzed text, only set for properties
+	// with a position index
+	Positions []uint32
 }
 
 type Property struct {
@@ -33,6 +37,11 @@ type Property struct {
 	Length             int
 	HasFilterableIndex bool // roaring set index
 	HasSearchableIndex bool // map index (with frequencies)
+	HasPositionIndex   bool // map index (with term positions)
+	HasReversedIndex   bool // roaring set index (of reversed terms)
+	HasRangeIndex      bool // roaring set index (of bit slices of numeric values)
+	HasNullStateIndex  bool // roaring set index (of null state), set by the shard
+	HasLengthIndex     bool // roaring set index (of property length), set by the shard
 }
 
 type Analyzer struct {
@@ -48,24 +57,55 @@ func (a *Analyzer) Text(tokenization, in string) []Countable {
 // TextArray tokenizes given input according to selected tokenization,
 // then aggregates duplicates
 func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
+	return a.StemmedTextArray(tokenization, "", inArr)
+}
+
+// StemmedTextArray tokenizes given input according to selected tokenization,
+// reduces the terms to their stems with the stemmer of the given language
+// and then aggregates duplicates. An empty language disables stemming.
+func (a *Analyzer) StemmedTextArray(tokenization, language string, inArr []string) []Countable {
+	return a.textArray(tokenization, language, inArr, false)
+}
+
+// PositionedTextArray analyzes given input like StemmedTextArray, but also
+// records the positions of each term. Consecutive elements of the array are
+// separated by a gap, so that phrases can not match across elements.
+func (a *Analyzer) PositionedTextArray(tokenization, language string, inArr []string) []Countable {
+	return a.textArray(tokenization, language, inArr, true)
+}
+
+func (a *Analyzer) textArray(tokenization, language string, inArr []string,
+	withPositions bool,
+) []Countable {
 	var terms []string
+	var positions []uint32
+	position := uint32(0)
 	for _, in := range inArr {
-		terms = append(terms, helpers.Tokenize(tokenization, in)...)
-	}
-
-	counts := map[string]uint64{}
-	for _, term := range terms {
-		counts[term]++
+		tokens := helpers.Tokenize(tokenization, in)
+		terms = append(terms, tokens...)
+		if withPositions {
+			for range tokens {
+				positions = append(positions, position)
+				position++
+			}
+			position++
+		}
 	}
-
-	countable := make([]Countable, len(counts))
-	i := 0
-	for term, count := range counts {
-		countable[i] = Countable{
-			Data:          []byte(term),
-			TermFrequency: float32(count),
+	terms = stemmer.StemAll(language, terms)
+
+	countableByTerm := map[string]int{}
+	countable := make([]Countable, 0, len(terms))
+	for i, term := range terms {
+		j, ok := countableByTerm[term]
+		if !ok {
+			j = len(countable)
+			countableByTerm[term] = j
+			countable = append(countable, Countable{Data: []byte(term)})
+		}
+		countable[j].TermFrequency++
+		if withPositions {
+			countable[j].Positions = append(countable[j].Positions, positions[i])
 		}
-		i++
 	}
 	return countable
 }
@@ -212,6 +212,28 @@ func TestAnalyzer(t *testing.T) {
 		}
 	})
 
+	t.Run("with text array and stemmer", func(t *testing.T) {
+		countable := a.StemmedTextArray(models.PropertyTokenizationWord, "en",
+			[]string{"Running runs.", "He likes to run"})
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("run"), TermFrequency: 3},
+			{Data: []byte("he"), TermFrequency: 1},
+			{Data: []byte("like"), TermFrequency: 1},
+			{Data: []byte("to"), TermFrequency: 1},
+		}, countable)
+	})
+
+	t.Run("with text array and positions", func(t *testing.T) {
+		countable := a.PositionedTextArray(models.PropertyTokenizationWord, "en",
+			[]string{"Running runs.", "He likes to run"})
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("run"), TermFrequency: 3, Positions: []uint32{0, 1, 6}},
+			{Data: []byte("he"), TermFrequency: 1, Positions: []uint32{3}},
+			{Data: []byte("like"), TermFrequency: 1, Positions: []uint32{4}},
+			{Data: []byte("to"), TermFrequency: 1, Positions: []uint32{5}},
+		}, countable)
+	})
+
 	t.Run("with int it stays sortable", func(t *testing.T) {
 		getData := func(in []Countable, err error) []byte {
 			require.Nil(t, err)
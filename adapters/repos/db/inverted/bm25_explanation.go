@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// termFrequency is the saturated and length normalized frequency of a term
+// in a document, its product with the idf is the score of the term
+func termFrequency(pair docPointerWithScore, averagePropLength float64,
+	config schema.BM25Config,
+) float64 {
+	freq := float64(pair.frequency)
+	return freq / (freq + config.K1*(1-config.B+config.B*float64(pair.propLength)/averagePropLength))
+}
+
+// explainScore breaks the score of a document down into the contributions of
+// the matched query terms and the properties they were found in
+func (b *BM25Searcher) explainScore(docID uint64, score float32, results terms,
+	indices []map[uint64]int, averagePropLength float64, propertyBoosts map[string]float32,
+) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "BM25F score %v is the sum of the matched terms "+
+		"(k1 %v, b %v, average propLength %v)", score, b.config.K1, b.config.B, averagePropLength)
+
+	for j, result := range results {
+		termIndex, ok := indices[j][docID]
+		if !ok {
+			continue
+		}
+		pair := result.data[termIndex]
+		tf := termFrequency(pair, averagePropLength, b.config)
+		fmt.Fprintf(&sb, "\n- term '%s' contributed %v = idf %v * tf %v "+
+			"(boosted frequency %v, propLength %v)", result.queryTerm, tf*result.idf,
+			result.idf, tf, pair.frequency, pair.propLength)
+
+		for _, prop := range result.propPairs {
+			frequency, propLength, ok := prop.find(docID)
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&sb, "\n  - property '%s' (boost %v): frequency %v, propLength %v",
+				prop.propname, propertyBoosts[prop.propname], frequency, propLength)
+		}
+	}
+
+	return sb.String()
+}
+
+// find returns the frequency and length of the property of the given
+// document, the pairs are sorted by doc id
+func (m MapPairsAndPropName) find(docID uint64) (float32, float32, bool) {
+	i := sort.Search(len(m.MapPairs), func(i int) bool {
+		return binary.BigEndian.Uint64(m.MapPairs[i].Key) >= docID
+	})
+	if i == len(m.MapPairs) || binary.BigEndian.Uint64(m.MapPairs[i].Key) != docID {
+		return 0, 0, false
+	}
+
+	value := m.MapPairs[i].Value
+	return math.Float32frombits(binary.LittleEndian.Uint32(value[0:4])),
+		math.Float32frombits(binary.LittleEndian.Uint32(value[4:8])), true
+}
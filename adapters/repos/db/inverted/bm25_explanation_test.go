@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestBM25ExplainScore(t *testing.T) {
+	pair := func(docID uint64, frequency, propLength float32) lsmkv.MapPair {
+		buf := make([]byte, 16)
+		binary.BigEndian.PutUint64(buf[0:8], docID)
+		binary.LittleEndian.PutUint32(buf[8:12], math.Float32bits(frequency))
+		binary.LittleEndian.PutUint32(buf[12:16], math.Float32bits(propLength))
+		return lsmkv.MapPair{Key: buf[:8], Value: buf[8:]}
+	}
+
+	b := &BM25Searcher{config: schema.BM25Config{K1: 1.2, B: 0.75}}
+	results := terms{
+		{
+			queryTerm: "climate",
+			idf:       2,
+			data: []docPointerWithScore{
+				{id: 3, frequency: 4, propLength: 10},
+				{id: 7, frequency: 1, propLength: 20},
+			},
+			propPairs: AllMapPairsAndPropName{
+				{propname: "title", MapPairs: []lsmkv.MapPair{pair(3, 1, 2)}},
+				{propname: "body", MapPairs: []lsmkv.MapPair{pair(3, 1, 8), pair(7, 1, 20)}},
+			},
+		},
+		{
+			queryTerm: "policy",
+			idf:       1,
+			data:      []docPointerWithScore{{id: 7, frequency: 1, propLength: 20}},
+		},
+	}
+	indices := []map[uint64]int{{3: 0, 7: 1}, {7: 0}}
+	boosts := map[string]float32{"title": 3, "body": 1}
+
+	explanation := b.explainScore(3, 1.5, results, indices, 10, boosts)
+
+	assert.Contains(t, explanation, "BM25F score 1.5 is the sum of the matched terms (k1 1.2, b 0.75, average propLength 10)")
+	assert.Contains(t, explanation, "- term 'climate' contributed")
+	assert.Contains(t, explanation, "(boosted frequency 4, propLength 10)")
+	assert.Contains(t, explanation, "  - property 'title' (boost 3): frequency 1, propLength 2")
+	assert.Contains(t, explanation, "  - property 'body' (boost 1): frequency 1, propLength 8")
+	assert.NotContains(t, explanation, "policy")
+}
@@ -0,0 +1,294 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stemmer"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// phrase is a quoted part of a bm25 query that has to be matched exactly,
+// i.e. all of its terms in the same order without any other terms in between
+type phrase struct {
+	text     string
+	required bool
+}
+
+// parsePhrases extracts the quoted phrases of a bm25 query. Phrases prefixed
+// with a '+' have to be contained in all results, the others boost the score
+// of the results containing them. The returned query holds the text of the
+// phrases without quotes, so that their terms are also scored individually.
+// An unterminated quote is ignored.
+func parsePhrases(query string) ([]phrase, string) {
+	var phrases []phrase
+	var rest strings.Builder
+
+	for {
+		start := strings.IndexByte(query, '"')
+		if start < 0 {
+			break
+		}
+		length := strings.IndexByte(query[start+1:], '"')
+		if length < 0 {
+			query = query[:start] + query[start+1:]
+			break
+		}
+
+		required := false
+		prefix := query[:start]
+		if strings.HasSuffix(prefix, "+") {
+			required = true
+			prefix = prefix[:len(prefix)-1]
+		}
+		text := query[start+1 : start+1+length]
+		rest.WriteString(prefix)
+		rest.WriteString(" ")
+		rest.WriteString(text)
+		rest.WriteString(" ")
+		query = query[start+length+2:]
+
+		if strings.TrimSpace(text) != "" {
+			phrases = append(phrases, phrase{text: text, required: required})
+		}
+	}
+
+	rest.WriteString(query)
+	return phrases, rest.String()
+}
+
+// PairPropertyWithPositions creates the map pair of the position index. The
+// doc id is stored big endian, so that the pairs are sorted by doc id.
+func PairPropertyWithPositions(docID uint64, positions []uint32) lsmkv.MapPair {
+	// 8 bytes for doc id, 4 bytes for each position
+	buf := make([]byte, 8+4*len(positions))
+	binary.BigEndian.PutUint64(buf[0:8], docID)
+	for i, position := range positions {
+		binary.LittleEndian.PutUint32(buf[8+4*i:12+4*i], position)
+	}
+
+	return lsmkv.MapPair{
+		Key:   buf[:8],
+		Value: buf[8:],
+	}
+}
+
+func positionsFromValue(value []byte) []uint32 {
+	positions := make([]uint32, len(value)/4)
+	for i := range positions {
+		positions[i] = binary.LittleEndian.Uint32(value[4*i : 4*i+4])
+	}
+	return positions
+}
+
+// phraseTerms creates one term per analysis group for the given phrase. The
+// frequency of the terms is the number of exact occurrences of the phrase,
+// which allows them to be scored like any other term. Only properties with
+// a position index are searched.
+func (b *BM25Searcher) phraseTerms(N float64, filterDocIds helpers.AllowList, p phrase,
+	groups []analysisGroup, propNamesByGroup map[analysisGroup][]string,
+	propertyBoosts map[string]float32,
+) (terms, []map[uint64]int, error) {
+	var results terms
+	var indices []map[uint64]int
+	searched := false
+
+	for _, group := range groups {
+		propNames := propNamesByGroup[group]
+		if len(propNames) == 0 {
+			continue
+		}
+		phraseTerms := stemmer.StemAll(group.stemmer, helpers.Tokenize(group.tokenization, p.text))
+		if len(phraseTerms) == 0 {
+			continue
+		}
+		searched = true
+
+		termResult, docIndices, err := b.createPhraseTerm(N, filterDocIds, p, phraseTerms,
+			propNames, propertyBoosts)
+		if err != nil {
+			return nil, nil, err
+		}
+		results = append(results, termResult)
+		indices = append(indices, docIndices)
+	}
+
+	if !searched {
+		return nil, nil, fmt.Errorf("cannot search for phrase %q: none of the searched "+
+			"properties has indexPositions enabled", p.text)
+	}
+	return results, indices, nil
+}
+
+// phraseDocIDs returns the ids of all documents containing the phrase in at
+// least one of the searched properties
+func (b *BM25Searcher) phraseDocIDs(N float64, filterDocIds helpers.AllowList, p phrase,
+	groups []analysisGroup, propNamesByGroup map[analysisGroup][]string,
+	propertyBoosts map[string]float32,
+) (*sroar.Bitmap, error) {
+	phraseTerms, _, err := b.phraseTerms(N, filterDocIds, p, groups, propNamesByGroup, propertyBoosts)
+	if err != nil {
+		return nil, err
+	}
+
+	docIDs := sroar.NewBitmap()
+	for _, t := range phraseTerms {
+		for _, doc := range t.data {
+			docIDs.Set(doc.id)
+		}
+	}
+	return docIDs, nil
+}
+
+func (b *BM25Searcher) createPhraseTerm(N float64, filterDocIds helpers.AllowList, p phrase,
+	phraseTerms []string, propertyNames []string, propertyBoosts map[string]float32,
+) (term, map[uint64]int, error) {
+	termResult := term{queryTerm: fmt.Sprintf("%q", p.text)}
+	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
+
+	var docMapPairs []docPointerWithScore
+	docMapPairsIndices := map[uint64]int{}
+	for _, propName := range propertyNames {
+		occurrences, err := b.phraseOccurrences(propName, phraseTerms)
+		if err != nil {
+			return termResult, nil, err
+		}
+		if len(occurrences) == 0 {
+			continue
+		}
+
+		// the length of the property is only stored in the searchable index
+		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+		if bucket == nil {
+			return termResult, nil, fmt.Errorf("could not find bucket for property %v", propName)
+		}
+		pairs, err := bucket.MapList([]byte(phraseTerms[0]))
+		if err != nil {
+			return termResult, nil, err
+		}
+
+		for _, pair := range pairs {
+			docID := binary.BigEndian.Uint64(pair.Key)
+			count, ok := occurrences[docID]
+			if !ok {
+				continue
+			}
+			if filterDocIds != nil && !filterDocIds.Contains(docID) {
+				filteredDocIDs.Set(docID)
+				continue
+			}
+
+			frequency := count * propertyBoosts[propName]
+			propLength := math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[4:8]))
+			if ind, ok := docMapPairsIndices[docID]; ok {
+				docMapPairs[ind].frequency += frequency
+				docMapPairs[ind].propLength += propLength
+				continue
+			}
+			docMapPairsIndices[docID] = len(docMapPairs)
+			docMapPairs = append(docMapPairs, docPointerWithScore{
+				id:         docID,
+				frequency:  frequency,
+				propLength: propLength,
+			})
+		}
+	}
+
+	if len(docMapPairs) == 0 {
+		termResult.exhausted = true
+		return termResult, docMapPairsIndices, nil
+	}
+
+	// the terms are advanced by doc id, so the pairs need to be sorted
+	sort.Slice(docMapPairs, func(i, j int) bool {
+		return docMapPairs[i].id < docMapPairs[j].id
+	})
+	for i, pair := range docMapPairs {
+		docMapPairsIndices[pair.id] = i
+	}
+	termResult.data = docMapPairs
+
+	n := float64(len(docMapPairs)) + float64(filteredDocIDs.GetCardinality())
+	termResult.idf = math.Log(float64(1) + (N-n+0.5)/(n+0.5))
+
+	termResult.posPointer = 0
+	termResult.idPointer = termResult.data[0].id
+	return termResult, docMapPairsIndices, nil
+}
+
+// phraseOccurrences counts how often the terms appear consecutively in the
+// property of each document
+func (b *BM25Searcher) phraseOccurrences(propName string, phraseTerms []string,
+) (map[uint64]float32, error) {
+	bucket := b.store.Bucket(helpers.BucketPositionsFromPropNameLSM(propName))
+	if bucket == nil {
+		return nil, fmt.Errorf("could not find positions bucket for property %v", propName)
+	}
+
+	positionsByTerm := make([]map[uint64][]uint32, len(phraseTerms))
+	for i, phraseTerm := range phraseTerms {
+		pairs, err := bucket.MapList([]byte(phraseTerm))
+		if err != nil {
+			return nil, err
+		}
+
+		positions := make(map[uint64][]uint32, len(pairs))
+		for _, pair := range pairs {
+			docID := binary.BigEndian.Uint64(pair.Key)
+			// only documents containing all previous terms can match
+			if i > 0 {
+				if _, ok := positionsByTerm[i-1][docID]; !ok {
+					continue
+				}
+			}
+			positions[docID] = positionsFromValue(pair.Value)
+		}
+		if len(positions) == 0 {
+			return nil, nil
+		}
+		positionsByTerm[i] = positions
+	}
+
+	last := positionsByTerm[len(phraseTerms)-1]
+	occurrences := make(map[uint64]float32, len(last))
+	for docID := range last {
+		count := 0
+	Starts:
+		for _, start := range positionsByTerm[0][docID] {
+			for i := 1; i < len(phraseTerms); i++ {
+				if !containsPosition(positionsByTerm[i][docID], start+uint32(i)) {
+					continue Starts
+				}
+			}
+			count++
+		}
+		if count > 0 {
+			occurrences[docID] = float32(count)
+		}
+	}
+	return occurrences, nil
+}
+
+// containsPosition checks the ascending positions for the given one
+func containsPosition(positions []uint32, position uint32) bool {
+	i := sort.Search(len(positions), func(i int) bool {
+		return positions[i] >= position
+	})
+	return i < len(positions) && positions[i] == position
+}
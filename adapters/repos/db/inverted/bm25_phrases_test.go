@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePhrases(t *testing.T) {
+	testCases := []struct {
+		name            string
+		query           string
+		expectedPhrases []phrase
+		expectedQuery   string
+	}{
+		{
+			name:          "without phrases",
+			query:         "climate change policy",
+			expectedQuery: "climate change policy",
+		},
+		{
+			name:            "boosting phrase",
+			query:           `"climate change" policy`,
+			expectedPhrases: []phrase{{text: "climate change"}},
+			expectedQuery:   " climate change  policy",
+		},
+		{
+			name:            "required phrase",
+			query:           `policy +"climate change"`,
+			expectedPhrases: []phrase{{text: "climate change", required: true}},
+			expectedQuery:   "policy  climate change ",
+		},
+		{
+			name:  "multiple phrases",
+			query: `+"climate change" or "global warming"`,
+			expectedPhrases: []phrase{
+				{text: "climate change", required: true},
+				{text: "global warming"},
+			},
+			expectedQuery: " climate change  or  global warming ",
+		},
+		{
+			name:          "empty phrase",
+			query:         `policy ""`,
+			expectedQuery: "policy   ",
+		},
+		{
+			name:          "unterminated quote",
+			query:         `"climate change`,
+			expectedQuery: "climate change",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			phrases, query := parsePhrases(tc.query)
+			assert.Equal(t, tc.expectedPhrases, phrases)
+			assert.Equal(t, tc.expectedQuery, query)
+		})
+	}
+}
+
+func TestPositionsPair(t *testing.T) {
+	pair := PairPropertyWithPositions(42, []uint32{1, 7, 300})
+	require.Len(t, pair.Key, 8)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 42}, pair.Key)
+
+	positions := positionsFromValue(pair.Value)
+	assert.Equal(t, []uint32{1, 7, 300}, positions)
+
+	assert.True(t, containsPosition(positions, 7))
+	assert.False(t, containsPosition(positions, 8))
+	assert.False(t, containsPosition(positions, 301))
+}
@@ -17,9 +17,8 @@ import (
 	"fmt"
 	"math"
 	"sort"
-	"strconv"
-	"strings"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stemmer"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"golang.org/x/sync/errgroup"
 
@@ -109,43 +108,48 @@ func (b *BM25Searcher) wand(
 		}
 	}
 
+	var synonyms *synonymDictionary
+	if class.InvertedIndexConfig != nil {
+		synonyms = newSynonymDictionary(class.InvertedIndexConfig.Synonyms)
+	}
+
 	// There are currently cases, for different tokenization:
-	// word, lowercase, whitespace and field.
+	// word, lowercase, whitespace, field, trigram, gse and kagome_ja.
 	// Query is tokenized and respective properties are then searched for the search terms,
 	// results at the end are combined using WAND
-	tokenizationsOrdered := []string{
-		models.PropertyTokenizationWord,
-		models.PropertyTokenizationLowercase,
-		models.PropertyTokenizationWhitespace,
-		models.PropertyTokenizationField,
-	}
-
-	queryTermsByTokenization := map[string][]string{}
-	duplicateBoostsByTokenization := map[string][]int{}
-	propNamesByTokenization := map[string][]string{}
+	supportedTokenizations := map[string]struct{}{
+		models.PropertyTokenizationWord:       {},
+		models.PropertyTokenizationLowercase:  {},
+		models.PropertyTokenizationWhitespace: {},
+		models.PropertyTokenizationField:      {},
+		models.PropertyTokenizationTrigram:    {},
+		models.PropertyTokenizationGse:        {},
+		models.PropertyTokenizationKagomeJa:   {},
+	}
+
+	// quoted phrases are searched as a whole in addition to their terms
+	phrases, query := parsePhrases(params.Query)
+
+	// properties are searched in groups of the same tokenization and stemmer,
+	// as both determine the terms of the query
+	var groupsOrdered []analysisGroup
+	queryTermsByGroup := map[analysisGroup][]string{}
+	duplicateBoostsByGroup := map[analysisGroup][]float64{}
+	propNamesByGroup := map[analysisGroup][]string{}
+	positionPropNamesByGroup := map[analysisGroup][]string{}
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
-	for _, tokenization := range tokenizationsOrdered {
-		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
-
-		// stopword filtering for word tokenization
-		if tokenization == models.PropertyTokenizationWord {
-			queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = b.removeStopwordsFromQueryTerms(queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization], stopWordDetector)
-		}
-
-		propNamesByTokenization[tokenization] = make([]string, 0)
-	}
-
 	averagePropLength := 0.
 	for _, propertyWithBoost := range params.Properties {
-		property := propertyWithBoost
-		propBoost := 1
-		if strings.Contains(propertyWithBoost, "^") {
-			property = strings.Split(propertyWithBoost, "^")[0]
-			boostStr := strings.Split(propertyWithBoost, "^")[1]
-			propBoost, _ = strconv.Atoi(boostStr)
+		// matches in boosted properties count as multiple matches, e.g. title^3
+		property, propBoost, err := searchparams.ParsePropertyBoost(propertyWithBoost)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, exists := propertyBoosts[property]; exists {
+			return nil, nil, fmt.Errorf("property '%s' is searched multiple times", property)
 		}
-		propertyBoosts[property] = float32(propBoost)
+		propertyBoosts[property] = propBoost
 
 		propMean, err := b.propLengths.PropertyMean(property)
 		if err != nil {
@@ -160,11 +164,20 @@ func (b *BM25Searcher) wand(
 
 		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
 		case schema.DataTypeText, schema.DataTypeTextArray:
-			if _, exists := propNamesByTokenization[prop.Tokenization]; !exists {
+			if _, exists := supportedTokenizations[prop.Tokenization]; !exists {
 				return nil, nil, fmt.Errorf("cannot handle tokenization '%v' of property '%s'",
 					prop.Tokenization, prop.Name)
 			}
-			propNamesByTokenization[prop.Tokenization] = append(propNamesByTokenization[prop.Tokenization], property)
+			group := analysisGroup{tokenization: prop.Tokenization, stemmer: prop.Stemmer}
+			if _, exists := propNamesByGroup[group]; !exists {
+				groupsOrdered = append(groupsOrdered, group)
+				queryTerms, duplicateBoosts := b.queryTerms(group, query, stopWordDetector)
+				queryTermsByGroup[group], duplicateBoostsByGroup[group] = synonyms.expand(group, queryTerms, duplicateBoosts)
+			}
+			propNamesByGroup[group] = append(propNamesByGroup[group], property)
+			if HasPositionIndex(prop) {
+				positionPropNamesByGroup[group] = append(positionPropNamesByGroup[group], property)
+			}
 		default:
 			return nil, nil, fmt.Errorf("cannot handle datatype '%v' of property '%s'", dt, prop.Name)
 		}
@@ -172,25 +185,61 @@ func (b *BM25Searcher) wand(
 
 	averagePropLength = averagePropLength / float64(len(params.Properties))
 
+	// required phrases restrict the results to the documents containing them
+	var requiredDocIDs *sroar.Bitmap
+	for _, p := range phrases {
+		if !p.required {
+			continue
+		}
+		docIDs, err := b.phraseDocIDs(N, filterDocIds, p, groupsOrdered,
+			positionPropNamesByGroup, propertyBoosts)
+		if err != nil {
+			return nil, nil, err
+		}
+		if requiredDocIDs == nil {
+			requiredDocIDs = docIDs
+		} else {
+			requiredDocIDs.And(docIDs)
+		}
+	}
+	if requiredDocIDs != nil {
+		if requiredDocIDs.IsEmpty() {
+			return []*storobj.Object{}, []float32{}, nil
+		}
+		filterDocIds = helpers.NewAllowListFromBitmap(requiredDocIDs)
+	}
+
+	// phrases are scored as additional terms, counting their exact occurrences
+	var phraseResults terms
+	var phraseIndices []map[uint64]int
+	for _, p := range phrases {
+		phraseTerms, docIndices, err := b.phraseTerms(N, filterDocIds, p, groupsOrdered,
+			positionPropNamesByGroup, propertyBoosts)
+		if err != nil {
+			return nil, nil, err
+		}
+		phraseResults = append(phraseResults, phraseTerms...)
+		phraseIndices = append(phraseIndices, docIndices...)
+	}
+
 	// preallocate the results
 	lengthAllResults := 0
-	for tokenization, propNames := range propNamesByTokenization {
-		if len(propNames) > 0 {
-			lengthAllResults += len(queryTermsByTokenization[tokenization])
-		}
+	for _, group := range groupsOrdered {
+		lengthAllResults += len(queryTermsByGroup[group])
 	}
-	results := make(terms, lengthAllResults)
-	indices := make([]map[uint64]int, lengthAllResults)
+	results := make(terms, lengthAllResults, lengthAllResults+len(phraseResults))
+	indices := make([]map[uint64]int, lengthAllResults, lengthAllResults+len(phraseIndices))
 
 	var eg errgroup.Group
 	eg.SetLimit(_NUMCPU)
 	offset := 0
 
-	for _, tokenization := range tokenizationsOrdered {
-		propNames := propNamesByTokenization[tokenization]
+	for _, group := range groupsOrdered {
+		propNames := propNamesByGroup[group]
 		if len(propNames) > 0 {
-			queryTerms := queryTermsByTokenization[tokenization]
-			duplicateBoosts := duplicateBoostsByTokenization[tokenization]
+			queryTerms := queryTermsByGroup[group]
+			duplicateBoosts := duplicateBoostsByGroup[group]
+			weight := termWeight(group, query, len(queryTerms))
 
 			for i := range queryTerms {
 				j := i
@@ -198,7 +247,7 @@ func (b *BM25Searcher) wand(
 
 				eg.Go(func() error {
 					termResult, docIndices, err := b.createTerm(N, filterDocIds, queryTerms[j], propNames,
-						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
+						propertyBoosts, duplicateBoosts[j]*weight, params.AdditionalExplanations)
 					if err != nil {
 						return err
 					}
@@ -214,6 +263,9 @@ func (b *BM25Searcher) wand(
 	if err := eg.Wait(); err != nil {
 		return nil, nil, err
 	}
+	results = append(results, phraseResults...)
+	indices = append(indices, phraseIndices...)
+
 	// all results. Sum up the length of the results from all terms to get an upper bound of how many results there are
 	if limit == 0 {
 		for _, ind := range indices {
@@ -226,7 +278,66 @@ func (b *BM25Searcher) wand(
 	copy(resultsOriginalOrder, results)
 
 	topKHeap := b.getTopKHeap(limit, results, averagePropLength)
-	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, averagePropLength,
+		propertyBoosts, params.AdditionalExplanations, class)
+}
+
+// analysisGroup identifies the properties whose values have been analyzed the
+// same way, so that they can be searched with the same query terms
+type analysisGroup struct {
+	tokenization string
+	stemmer      string
+}
+
+// termWeight returns the factor applied to the idf of each query term of the
+// group. A single word yields many trigram terms, which would otherwise
+// outweigh the terms of word-based groups and inflate the score of long
+// words. Trigram terms are therefore weighted so that all trigrams of the
+// query together count as much as its words.
+func termWeight(group analysisGroup, query string, termCount int) float64 {
+	if group.tokenization != models.PropertyTokenizationTrigram || termCount == 0 {
+		return 1
+	}
+	words := len(helpers.Tokenize(models.PropertyTokenizationWord, query))
+	if words == 0 || words >= termCount {
+		return 1
+	}
+	return float64(words) / float64(termCount)
+}
+
+// queryTerms returns the unique terms of the query and how often each of
+// them occurs for the tokenization and stemmer of the group. Stopwords are
+// removed before stemming, as the stopword lists contain unstemmed words.
+func (b *BM25Searcher) queryTerms(group analysisGroup, query string,
+	detector *stopwords.Detector,
+) ([]string, []int) {
+	queryTerms, duplicateBoosts := helpers.TokenizeAndCountDuplicates(group.tokenization, query)
+
+	// stopword filtering for word tokenization
+	if group.tokenization == models.PropertyTokenizationWord {
+		queryTerms, duplicateBoosts = b.removeStopwordsFromQueryTerms(queryTerms, duplicateBoosts, detector)
+	}
+
+	if group.stemmer == "" {
+		return queryTerms, duplicateBoosts
+	}
+
+	// different words can have the same stem, their boosts are combined
+	boostsByStem := make(map[string]int, len(queryTerms))
+	stems := make([]string, 0, len(queryTerms))
+	for i, term := range queryTerms {
+		stem := stemmer.Stem(group.stemmer, term)
+		if _, exists := boostsByStem[stem]; !exists {
+			stems = append(stems, stem)
+		}
+		boostsByStem[stem] += duplicateBoosts[i]
+	}
+
+	boosts := make([]int, len(stems))
+	for i, stem := range stems {
+		boosts[i] = boostsByStem[stem]
+	}
+	return stems, boosts
 }
 
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string, duplicateBoost []int, detector *stopwords.Detector) ([]string, []int) {
@@ -254,7 +365,10 @@ WordLoop:
 	}
 }
 
-func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool) ([]*storobj.Object, []float32, error) {
+func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms,
+	indices []map[uint64]int, averagePropLength float64, propertyBoosts map[string]float32,
+	additionalExplanations bool, class *models.Class,
+) ([]*storobj.Object, []float32, error) {
 	objectsBucket := b.store.Bucket(helpers.ObjectsBucketLSM)
 	if objectsBucket == nil {
 		return nil, nil, errors.Errorf("objects bucket not found")
@@ -277,7 +391,7 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results ter
 			continue
 		}
 
-		obj, err := storobj.FromBinary(objectByte)
+		obj, err := storobj.FromBinaryWithClass(objectByte, class)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -287,13 +401,8 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results ter
 			if obj.AdditionalProperties() == nil {
 				obj.Object.Additional = make(map[string]interface{})
 			}
-			for j, result := range results {
-				if termIndice, ok := indices[j][res.ID]; ok {
-					queryTerm := result.queryTerm
-					obj.Object.Additional["BM25F_"+queryTerm+"_frequency"] = result.data[termIndice].frequency
-					obj.Object.Additional["BM25F_"+queryTerm+"_propLength"] = result.data[termIndice].propLength
-				}
-			}
+			obj.Object.Additional["explainScore"] = b.explainScore(res.ID, res.Dist, results, indices,
+				averagePropLength, propertyBoosts)
 		}
 		objects = append(objects, obj)
 		scores = append(scores, res.Dist)
@@ -327,7 +436,7 @@ func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength f
 	}
 }
 
-func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, additionalExplanations bool) (term, map[uint64]int, error) {
+func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost float64, additionalExplanations bool) (term, map[uint64]int, error) {
 	termResult := term{queryTerm: query}
 	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
 
@@ -373,6 +482,9 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 	if len(allMsAndProps) > 2 {
 		allMsAndProps[len(allMsAndProps)-2], allMsAndProps[0] = allMsAndProps[0], allMsAndProps[len(allMsAndProps)-2]
 	}
+	if additionalExplanations {
+		termResult.propPairs = allMsAndProps
+	}
 
 	var docMapPairs []docPointerWithScore = nil
 	var docMapPairsIndices map[uint64]int = nil
@@ -442,7 +554,7 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 	if filterDocIds != nil {
 		n += float64(filteredDocIDs.GetCardinality())
 	}
-	termResult.idf = math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * float64(duplicateTextBoost)
+	termResult.idf = math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * duplicateTextBoost
 
 	termResult.posPointer = 0
 	termResult.idPointer = termResult.data[0].id
@@ -459,13 +571,14 @@ type term struct {
 	data       []docPointerWithScore
 	exhausted  bool
 	queryTerm  string
+
+	// the postings of each property, only kept to explain the score
+	propPairs AllMapPairsAndPropName
 }
 
 func (t *term) scoreAndAdvance(averagePropLength float64, config schema.BM25Config) (uint64, float64) {
 	id := t.idPointer
-	pair := t.data[t.posPointer]
-	freq := float64(pair.frequency)
-	tf := freq / (freq + config.K1*(1-config.B+config.B*float64(pair.propLength)/averagePropLength))
+	tf := termFrequency(t.data[t.posPointer], averagePropLength, config)
 
 	// advance
 	t.posPointer++
@@ -602,7 +715,10 @@ func (m AllMapPairsAndPropName) Swap(i, j int) {
 }
 
 func PropertyHasSearchableIndex(schemaDefinition *models.Schema, className, tentativePropertyName string) bool {
-	propertyName := strings.Split(tentativePropertyName, "^")[0]
+	propertyName, _, err := searchparams.ParsePropertyBoost(tentativePropertyName)
+	if err != nil {
+		return false
+	}
 	c, err := schema.GetClassByName(schemaDefinition, string(className))
 	if err != nil {
 		return false
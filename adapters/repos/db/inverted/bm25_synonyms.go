@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stemmer"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// synonymDictionary expands query terms with the equivalent terms configured
+// for the class, e.g. "tv" with "television"
+type synonymDictionary struct {
+	// weight of synonym matches relative to matches on the query terms
+	weight float64
+	groups [][]string
+}
+
+func newSynonymDictionary(config *models.SynonymConfig) *synonymDictionary {
+	if config == nil || len(config.Groups) == 0 {
+		return nil
+	}
+
+	weight := float64(config.Weight)
+	if weight <= 0 {
+		weight = 1
+	}
+
+	groups := make([][]string, 0, len(config.Groups))
+	for _, group := range config.Groups {
+		if group != nil && len(group.Terms) > 1 {
+			groups = append(groups, group.Terms)
+		}
+	}
+	return &synonymDictionary{weight: weight, groups: groups}
+}
+
+// expand adds the synonyms of the query terms of an analysis group. The
+// synonyms are analyzed like the query, so that they match the indexed terms
+// of the group. A synonym scores with the boost of the query term it was
+// derived from, multiplied by the weight of the dictionary. Terms which are
+// part of the query themselves keep their original boost. Trigram groups are
+// not expanded, as single trigrams carry no meaning of their own.
+func (d *synonymDictionary) expand(group analysisGroup, queryTerms []string,
+	boosts []int,
+) ([]string, []float64) {
+	expandedBoosts := make([]float64, len(boosts))
+	for i := range boosts {
+		expandedBoosts[i] = float64(boosts[i])
+	}
+	if d == nil || group.tokenization == models.PropertyTokenizationTrigram {
+		return queryTerms, expandedBoosts
+	}
+
+	positions := make(map[string]int, len(queryTerms))
+	for i, term := range queryTerms {
+		positions[term] = i
+	}
+	numQueryTerms := len(queryTerms)
+
+	expandedTerms := make([]string, len(queryTerms), len(queryTerms)*2)
+	copy(expandedTerms, queryTerms)
+	for _, synonyms := range d.groups {
+		analyzed := d.analyze(group, synonyms)
+
+		// the strongest query term of the group determines the boost of its synonyms
+		boost := 0.
+		for _, term := range analyzed {
+			if i, ok := positions[term]; ok && i < numQueryTerms && expandedBoosts[i] > boost {
+				boost = expandedBoosts[i]
+			}
+		}
+		if boost == 0 {
+			continue
+		}
+
+		boost *= d.weight
+		for _, term := range analyzed {
+			i, ok := positions[term]
+			if !ok {
+				positions[term] = len(expandedTerms)
+				expandedTerms = append(expandedTerms, term)
+				expandedBoosts = append(expandedBoosts, boost)
+			} else if i >= numQueryTerms && expandedBoosts[i] < boost {
+				expandedBoosts[i] = boost
+			}
+		}
+	}
+
+	return expandedTerms, expandedBoosts
+}
+
+// analyze returns the unique terms of the synonyms for the tokenization and
+// stemmer of the group
+func (d *synonymDictionary) analyze(group analysisGroup, synonyms []string) []string {
+	seen := map[string]struct{}{}
+	var terms []string
+	for _, synonym := range synonyms {
+		for _, term := range helpers.Tokenize(group.tokenization, synonym) {
+			if group.stemmer != "" {
+				term = stemmer.Stem(group.stemmer, term)
+			}
+			if _, ok := seen[term]; !ok {
+				seen[term] = struct{}{}
+				terms = append(terms, term)
+			}
+		}
+	}
+	return terms
+}
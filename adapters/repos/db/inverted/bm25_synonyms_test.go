@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestSynonymDictionaryExpand(t *testing.T) {
+	dict := newSynonymDictionary(&models.SynonymConfig{
+		Weight: 0.5,
+		Groups: []*models.SynonymGroup{
+			{Terms: []string{"TV", "television"}},
+			{Terms: []string{"couch", "sofa", "settee"}},
+		},
+	})
+	wordGroup := analysisGroup{tokenization: models.PropertyTokenizationWord}
+
+	t.Run("adds weighted synonyms", func(t *testing.T) {
+		terms, boosts := dict.expand(wordGroup, []string{"tv", "stand"}, []int{2, 1})
+		assert.Equal(t, []string{"tv", "stand", "television"}, terms)
+		assert.Equal(t, []float64{2, 1, 1}, boosts)
+	})
+
+	t.Run("keeps the boost of query terms", func(t *testing.T) {
+		terms, boosts := dict.expand(wordGroup, []string{"sofa", "couch"}, []int{1, 1})
+		assert.Equal(t, []string{"sofa", "couch", "settee"}, terms)
+		assert.Equal(t, []float64{1, 1, 0.5}, boosts)
+	})
+
+	t.Run("stems synonyms", func(t *testing.T) {
+		group := analysisGroup{tokenization: models.PropertyTokenizationWord, stemmer: "en"}
+		terms, _ := dict.expand(group, []string{"couch"}, []int{1})
+		assert.Equal(t, []string{"couch", "sofa", "sette"}, terms)
+	})
+
+	t.Run("does not expand trigrams", func(t *testing.T) {
+		group := analysisGroup{tokenization: models.PropertyTokenizationTrigram}
+		terms, boosts := dict.expand(group, []string{"sof", "ofa"}, []int{1, 1})
+		assert.Equal(t, []string{"sof", "ofa"}, terms)
+		assert.Equal(t, []float64{1, 1}, boosts)
+	})
+
+	t.Run("without dictionary", func(t *testing.T) {
+		var dict *synonymDictionary
+		terms, boosts := dict.expand(wordGroup, []string{"tv"}, []int{1})
+		assert.Equal(t, []string{"tv"}, terms)
+		assert.Equal(t, []float64{1}, boosts)
+	})
+}
@@ -39,6 +39,11 @@ func ValidateConfig(conf *models.InvertedIndexConfig) error {
 		return err
 	}
 
+	err = ValidateSynonymConfig(conf.Synonyms)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -70,6 +75,32 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	return conf
 }
 
+// ValidateSynonymConfig checks that every synonym group relates at least two
+// terms and that the weight of synonym matches is within (0, 1]. A weight of
+// 0 is treated as unset.
+func ValidateSynonymConfig(conf *models.SynonymConfig) error {
+	if conf == nil {
+		return nil
+	}
+
+	if conf.Weight < 0 || conf.Weight > 1 {
+		return errors.Errorf("synonyms.weight must be > 0 and <= 1")
+	}
+
+	for i, group := range conf.Groups {
+		if group == nil || len(group.Terms) < 2 {
+			return errors.Errorf("synonyms.groups[%d] must contain at least two terms", i)
+		}
+		for _, term := range group.Terms {
+			if strings.TrimSpace(term) == "" {
+				return errors.Errorf("cannot use whitespace in synonyms.groups[%d]", i)
+			}
+		}
+	}
+
+	return nil
+}
+
 func validateBM25Config(conf *models.BM25Config) error {
 	if conf == nil {
 		return nil
@@ -69,6 +69,19 @@ func TestValidateConfig(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("with language stopword presets", func(t *testing.T) {
+		for _, preset := range []string{"en", "de", "fr", "none"} {
+			in := &models.InvertedIndexConfig{
+				Stopwords: &models.StopwordConfig{
+					Preset: preset,
+				},
+			}
+
+			err := ValidateConfig(in)
+			assert.Nil(t, err, preset)
+		}
+	})
+
 	t.Run("with nonexistent stopword preset", func(t *testing.T) {
 		in := &models.InvertedIndexConfig{
 			Stopwords: &models.StopwordConfig{
@@ -163,6 +176,55 @@ func TestValidateConfig(t *testing.T) {
 			assert.Equal(t, test.expectedLength, len(in.Stopwords.Additions))
 		}
 	})
+
+	t.Run("with synonyms", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			synonyms    *models.SynonymConfig
+			expectedErr string
+		}{
+			{
+				name: "valid",
+				synonyms: &models.SynonymConfig{
+					Weight: 0.5,
+					Groups: []*models.SynonymGroup{{Terms: []string{"tv", "television"}}},
+				},
+			},
+			{
+				name: "weight out of range",
+				synonyms: &models.SynonymConfig{
+					Weight: 1.5,
+					Groups: []*models.SynonymGroup{{Terms: []string{"tv", "television"}}},
+				},
+				expectedErr: "synonyms.weight must be > 0 and <= 1",
+			},
+			{
+				name: "single term",
+				synonyms: &models.SynonymConfig{
+					Groups: []*models.SynonymGroup{{Terms: []string{"tv"}}},
+				},
+				expectedErr: "synonyms.groups[0] must contain at least two terms",
+			},
+			{
+				name: "whitespace term",
+				synonyms: &models.SynonymConfig{
+					Groups: []*models.SynonymGroup{{Terms: []string{"tv", " "}}},
+				},
+				expectedErr: "cannot use whitespace in synonyms.groups[0]",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				err := ValidateConfig(&models.InvertedIndexConfig{Synonyms: test.synonyms})
+				if test.expectedErr == "" {
+					assert.Nil(t, err)
+				} else {
+					assert.EqualError(t, err, test.expectedErr)
+				}
+			})
+		}
+	})
 }
 
 func TestConfigFromModel(t *testing.T) {
@@ -36,6 +36,11 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 		return err
 	}
 
+	err = validateSynonymsConfigUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -85,3 +90,12 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 
 	return nil
 }
+
+func validateSynonymsConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
+	if updated.Synonyms == nil {
+		updated.Synonyms = initial.Synonyms
+		return nil
+	}
+
+	return ValidateSynonymConfig(updated.Synonyms)
+}
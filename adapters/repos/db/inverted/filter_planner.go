@@ -0,0 +1,270 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/entities/filters"
+	"golang.org/x/sync/errgroup"
+)
+
+// filterSampleSize is the number of doc ids read from the inverted index to
+// estimate how many objects an operand of an And or Or matches. Operands
+// matching fewer doc ids are read completely while estimating, so they are
+// not read again when the doc ids are fetched.
+const filterSampleSize = 1000
+
+// filterCost is a rough estimate of how expensive it is to fetch the doc ids
+// of a prop/value pair. It orders operands matching more doc ids than
+// sampled, whose counts aren't known.
+type filterCost int
+
+const (
+	// a single key of the inverted index, e.g. Equal or IsNull
+	filterCostLookup filterCost = iota
+	// a range of keys of the inverted index, e.g. GreaterThan, Like or NotEqual
+	filterCostScan
+	// served outside of the inverted index, e.g. WithinGeoRange
+	filterCostSecondary
+)
+
+// estimateCost of fetching the doc ids. Nested operands are as expensive as
+// their most expensive child.
+func (pv *propValuePair) estimateCost() filterCost {
+	if !pv.operator.OnValue() {
+		cost := filterCostLookup
+		for _, child := range pv.children {
+			if childCost := child.estimateCost(); childCost > cost {
+				cost = childCost
+			}
+		}
+		return cost
+	}
+
+	switch pv.operator {
+	case filters.OperatorEqual, filters.OperatorIsNull:
+		return filterCostLookup
+	case filters.OperatorWithinGeoRange, filters.OperatorWithinGeoPolygon,
+		filters.OperatorWithinGeoBoundingBox:
+		return filterCostSecondary
+	default:
+		return filterCostScan
+	}
+}
+
+// filterEstimate is the estimated number of doc ids an operand matches
+type filterEstimate struct {
+	// count of the matching doc ids, it is exact unless sampled is set
+	count int
+	// sampled is set if not all matching doc ids have been read, the operand
+	// matches at least count doc ids
+	sampled bool
+	cost    filterCost
+}
+
+// less orders the estimates by the number of matching doc ids. Operands of
+// which all doc ids were counted come first, sampled ones follow by cost.
+func (e filterEstimate) less(other filterEstimate) bool {
+	if e.sampled != other.sampled {
+		return !e.sampled
+	}
+	if e.sampled && e.cost != other.cost {
+		return e.cost < other.cost
+	}
+	return e.count < other.count
+}
+
+// estimateCount estimates the number of doc ids the pair matches from the
+// postings in the inverted index. Up to filterSampleSize doc ids are read,
+// pairs matching fewer are fetched completely by doing so. The estimate is
+// kept, so that it is computed only once.
+func (pv *propValuePair) estimateCount(s *Searcher) (filterEstimate, error) {
+	if pv.estimate != nil {
+		return *pv.estimate, nil
+	}
+
+	var (
+		est filterEstimate
+		err error
+	)
+	if pv.operator.OnValue() {
+		est, err = pv.estimateValueCount(s)
+	} else {
+		est, err = pv.estimateNestedCount(s)
+	}
+	if err != nil {
+		return filterEstimate{}, err
+	}
+	pv.estimate = &est
+	return est, nil
+}
+
+func (pv *propValuePair) estimateValueCount(s *Searcher) (filterEstimate, error) {
+	cost := pv.estimateCost()
+	if pv.operator.OnGeo() {
+		// served by the geo index, which has no postings to count
+		return filterEstimate{sampled: true, cost: cost}, nil
+	}
+
+	limit := filterSampleSize
+	if pv.operator == filters.OperatorEqual || pv.operator == filters.OperatorIsNull ||
+		(pv.hasRangeIndex && prefersRangeIndex(pv.operator, pv.hasFilterableIndex)) {
+		// a single key and the range index are always read completely
+		limit = 0
+	}
+	if err := pv.fetchDocIDs(s, limit); err != nil {
+		return filterEstimate{}, err
+	}
+
+	count := pv.docIDs.count()
+	return filterEstimate{
+		count:   count,
+		sampled: limit > 0 && count >= limit,
+		cost:    cost,
+	}, nil
+}
+
+// estimateNestedCount estimates the matches of an And by its most selective
+// operand and those of an Or by the sum of its operands
+func (pv *propValuePair) estimateNestedCount(s *Searcher) (filterEstimate, error) {
+	if err := pv.estimateChildren(s); err != nil {
+		return filterEstimate{}, err
+	}
+	if len(pv.children) == 0 {
+		return filterEstimate{}, fmt.Errorf("no children for operator: %s", pv.operator.Name())
+	}
+
+	est := *pv.children[0].estimate
+	for _, child := range pv.children[1:] {
+		childEst := *child.estimate
+		if pv.operator == filters.OperatorAnd {
+			if childEst.less(est) {
+				est = childEst
+			}
+			continue
+		}
+		est.count += childEst.count
+		est.sampled = est.sampled || childEst.sampled
+		if childEst.cost > est.cost {
+			est.cost = childEst.cost
+		}
+	}
+	return est, nil
+}
+
+// estimateChildren estimates the counts of the children concurrently
+func (pv *propValuePair) estimateChildren(s *Searcher) error {
+	eg := errgroup.Group{}
+	eg.SetLimit(2 * _NUMCPU)
+	for i, child := range pv.children {
+		i, child := i, child
+		eg.Go(func() error {
+			if _, err := child.estimateCount(s); err != nil {
+				return errors.Wrapf(err, "estimate nested child %d", i)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return fmt.Errorf("nested query: %w", err)
+	}
+	return nil
+}
+
+// childrenByEstimate groups the positions of the estimated children in the
+// order they are fetched. The children which have been read completely while
+// estimating come first, ordered by their counts. The sampled ones follow,
+// grouped by their cost.
+func (pv *propValuePair) childrenByEstimate() [][]int {
+	positions := make([]int, len(pv.children))
+	for i := range positions {
+		positions[i] = i
+	}
+	sort.SliceStable(positions, func(i, j int) bool {
+		return pv.children[positions[i]].estimate.less(*pv.children[positions[j]].estimate)
+	})
+
+	var out [][]int
+	for i, pos := range positions {
+		est := pv.children[pos].estimate
+		if i > 0 {
+			prev := pv.children[positions[i-1]].estimate
+			if est.sampled == prev.sampled && (!est.sampled || est.cost == prev.cost) {
+				out[len(out)-1] = append(out[len(out)-1], pos)
+				continue
+			}
+		}
+		out = append(out, []int{pos})
+	}
+	return out
+}
+
+// resetDocIDs marks the pair and all of its children as matching no
+// documents without fetching anything
+func (pv *propValuePair) resetDocIDs() {
+	pv.docIDs = newDocBitmap()
+	for _, child := range pv.children {
+		child.resetDocIDs()
+	}
+}
+
+// andDocBitmaps intersects the bitmaps starting with the smallest one, which
+// keeps the intermediate results small. It stops as soon as the intersection
+// is empty. The given bitmaps are not modified.
+func andDocBitmaps(dbms []*docBitmap) *sroar.Bitmap {
+	sorted := sortedByCount(dbms)
+
+	res := sorted[0].docIDs.Clone()
+	for _, dbm := range sorted[1:] {
+		if res.IsEmpty() {
+			break
+		}
+		res.And(dbm.docIDs)
+	}
+	return res
+}
+
+// orDocBitmaps unites the bitmaps into a copy of the largest one, so that the
+// fewest values need to be added. The given bitmaps are not modified.
+func orDocBitmaps(dbms []*docBitmap) *sroar.Bitmap {
+	sorted := sortedByCount(dbms)
+
+	res := sorted[len(sorted)-1].docIDs.Clone()
+	for _, dbm := range sorted[:len(sorted)-1] {
+		res.Or(dbm.docIDs)
+	}
+	return res
+}
+
+func sortedByCount(dbms []*docBitmap) []*docBitmap {
+	type counted struct {
+		dbm   *docBitmap
+		count int
+	}
+	byCount := make([]counted, len(dbms))
+	for i, dbm := range dbms {
+		byCount[i] = counted{dbm: dbm, count: dbm.count()}
+	}
+	sort.SliceStable(byCount, func(i, j int) bool {
+		return byCount[i].count < byCount[j].count
+	})
+
+	sorted := make([]*docBitmap, len(byCount))
+	for i := range byCount {
+		sorted[i] = byCount[i].dbm
+	}
+	return sorted
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestFilterPlanner_EstimateCost(t *testing.T) {
+	equal := &propValuePair{operator: filters.OperatorEqual}
+	like := &propValuePair{operator: filters.OperatorLike}
+	geo := &propValuePair{operator: filters.OperatorWithinGeoRange}
+
+	assert.Equal(t, filterCostLookup, equal.estimateCost())
+	assert.Equal(t, filterCostScan, like.estimateCost())
+	assert.Equal(t, filterCostSecondary, geo.estimateCost())
+
+	t.Run("nested operands are as expensive as their most expensive child", func(t *testing.T) {
+		or := &propValuePair{
+			operator: filters.OperatorOr,
+			children: []*propValuePair{equal, like},
+		}
+		assert.Equal(t, filterCostScan, or.estimateCost())
+	})
+}
+
+func TestFilterPlanner_ChildrenByEstimate(t *testing.T) {
+	estimated := func(est filterEstimate) *propValuePair {
+		return &propValuePair{operator: filters.OperatorEqual, estimate: &est}
+	}
+
+	and := &propValuePair{
+		operator: filters.OperatorAnd,
+		children: []*propValuePair{
+			estimated(filterEstimate{sampled: true, cost: filterCostSecondary}),
+			estimated(filterEstimate{count: 1000, sampled: true, cost: filterCostScan}),
+			estimated(filterEstimate{count: 40, cost: filterCostScan}),
+			estimated(filterEstimate{count: 1000, sampled: true, cost: filterCostScan}),
+			estimated(filterEstimate{count: 3, cost: filterCostLookup}),
+		},
+	}
+
+	// the children read completely come first by count, the sampled ones
+	// follow grouped by cost
+	assert.Equal(t, [][]int{{4, 2}, {1, 3}, {0}}, and.childrenByEstimate())
+}
+
+func TestFilterPlanner_EstimateNestedCount(t *testing.T) {
+	children := func() []*propValuePair {
+		return []*propValuePair{
+			{operator: filters.OperatorEqual, estimate: &filterEstimate{count: 30}},
+			{operator: filters.OperatorEqual, estimate: &filterEstimate{count: 5}},
+			{operator: filters.OperatorEqual, estimate: &filterEstimate{count: 200}},
+		}
+	}
+
+	t.Run("and matches at most its most selective operand", func(t *testing.T) {
+		and := &propValuePair{operator: filters.OperatorAnd, children: children()}
+
+		est, err := and.estimateCount(nil)
+		require.Nil(t, err)
+		assert.Equal(t, filterEstimate{count: 5}, est)
+	})
+
+	t.Run("or matches at most all of its operands", func(t *testing.T) {
+		or := &propValuePair{operator: filters.OperatorOr, children: children()}
+		or.children[2].estimate.sampled = true
+
+		est, err := or.estimateCount(nil)
+		require.Nil(t, err)
+		assert.Equal(t, filterEstimate{count: 235, sampled: true}, est)
+	})
+}
+
+func TestFilterPlanner_MergeDocBitmaps(t *testing.T) {
+	dbms := func(bitmaps ...*sroar.Bitmap) []*docBitmap {
+		out := make([]*docBitmap, len(bitmaps))
+		for i := range bitmaps {
+			out[i] = &docBitmap{docIDs: bitmaps[i]}
+		}
+		return out
+	}
+
+	t.Run("and", func(t *testing.T) {
+		in := dbms(
+			roaringset.NewBitmap(1, 2, 3, 4, 5, 6, 7, 8),
+			roaringset.NewBitmap(2, 4, 6, 8),
+			roaringset.NewBitmap(4, 5, 6, 7, 8),
+		)
+
+		assert.ElementsMatch(t, []uint64{4, 6, 8}, andDocBitmaps(in).ToArray())
+		// inputs are not modified
+		assert.Equal(t, 8, in[0].count())
+		assert.Equal(t, 4, in[1].count())
+		assert.Equal(t, 5, in[2].count())
+	})
+
+	t.Run("and with an empty intersection", func(t *testing.T) {
+		in := dbms(
+			roaringset.NewBitmap(1, 2, 3),
+			roaringset.NewBitmap(4, 5),
+			roaringset.NewBitmap(1, 2, 3, 4, 5),
+		)
+
+		assert.True(t, andDocBitmaps(in).IsEmpty())
+	})
+
+	t.Run("or", func(t *testing.T) {
+		in := dbms(
+			roaringset.NewBitmap(1, 2),
+			roaringset.NewBitmap(2, 4, 6, 8),
+			roaringset.NewBitmap(9),
+		)
+
+		assert.ElementsMatch(t, []uint64{1, 2, 4, 6, 8, 9}, orDocBitmaps(in).ToArray())
+		// inputs are not modified
+		assert.Equal(t, 2, in[0].count())
+		assert.Equal(t, 4, in[1].count())
+		assert.Equal(t, 1, in[2].count())
+	})
+}
+
+func TestFilterPlanner_ResetDocIDs(t *testing.T) {
+	leaf := &propValuePair{operator: filters.OperatorEqual}
+	nested := &propValuePair{
+		operator: filters.OperatorOr,
+		children: []*propValuePair{leaf},
+	}
+
+	nested.resetDocIDs()
+
+	dbm, err := nested.mergeDocIDs()
+	assert.Nil(t, err)
+	assert.True(t, dbm.docIDs.IsEmpty())
+}
@@ -612,6 +612,78 @@ func Test_Filters_String_DuplicateEntriesInAnd(t *testing.T) {
 	}
 }
 
+func Test_Filters_EstimateCount(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dirName, "", logger, nil)
+	require.Nil(t, err)
+
+	propName := "inverted-without-frequency"
+	bucketName := helpers.BucketFromPropNameLSM(propName)
+	require.Nil(t, store.CreateOrLoadBucket(context.Background(),
+		bucketName, lsmkv.WithStrategy(lsmkv.StrategySetCollection)))
+	bucket := store.Bucket(bucketName)
+
+	defer store.Shutdown(context.Background())
+
+	valueBytes := func(value int64) []byte {
+		b, err := LexicographicallySortableInt64(value)
+		require.Nil(t, err)
+		return b
+	}
+
+	common := make([]uint64, 2*filterSampleSize)
+	for i := range common {
+		common[i] = uint64(i)
+	}
+	require.Nil(t, bucket.SetAdd(valueBytes(1), idsToBinaryList(common)))
+	require.Nil(t, bucket.SetAdd(valueBytes(2), idsToBinaryList([]uint64{5, 7})))
+	require.Nil(t, bucket.FlushAndSwitch())
+
+	searcher := NewSearcher(logger, store, createSchema(),
+		nil, nil, nil, fakeStopwordDetector{}, 2, func() bool { return false })
+
+	rare := &propValuePair{
+		prop:               propName,
+		operator:           filters.OperatorEqual,
+		value:              valueBytes(2),
+		hasFilterableIndex: true,
+		docIDs:             newDocBitmap(),
+	}
+	permissive := &propValuePair{
+		prop:               propName,
+		operator:           filters.OperatorGreaterThanEqual,
+		value:              valueBytes(1),
+		hasFilterableIndex: true,
+		docIDs:             newDocBitmap(),
+	}
+	and := &propValuePair{
+		operator: filters.OperatorAnd,
+		children: []*propValuePair{permissive, rare},
+	}
+
+	require.Nil(t, and.fetchDocIDs(searcher, 0))
+
+	t.Run("the postings of a single key are counted", func(t *testing.T) {
+		assert.Equal(t, filterEstimate{count: 2, cost: filterCostLookup}, *rare.estimate)
+	})
+
+	t.Run("a scan is sampled", func(t *testing.T) {
+		assert.True(t, permissive.estimate.sampled)
+		assert.GreaterOrEqual(t, permissive.estimate.count, filterSampleSize)
+		assert.Equal(t, [][]int{{1}, {0}}, and.childrenByEstimate())
+	})
+
+	t.Run("sampled operands are fetched completely", func(t *testing.T) {
+		assert.Equal(t, 2*filterSampleSize, permissive.docIDs.count())
+
+		dbm, err := and.mergeDocIDs()
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{5, 7}, dbm.IDs())
+	})
+}
+
 func idsToBinaryList(ids []uint64) [][]byte {
 	out := make([][]byte, len(ids))
 	for i, id := range ids {
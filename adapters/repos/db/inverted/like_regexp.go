@@ -12,16 +12,57 @@
 package inverted
 
 import (
-	"bytes"
 	"regexp"
+	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/filters"
 )
 
+// regexMaxScannedTerms limits the number of terms a Regex filter reads from
+// the term dictionary of a property. Patterns without a literal prefix have
+// to be matched against every term of the property, which must not turn into
+// an unbounded scan of large dictionaries.
+const regexMaxScannedTerms = 1_000_000
+
 type likeRegexp struct {
 	optimizable bool
-	min         []byte
-	regexp      *regexp.Regexp
+	// prefixOnly is set for a literal prefix followed by a single trailing
+	// '*'. All terms starting with the prefix match, so that the regexp
+	// does not need to be evaluated
+	prefixOnly bool
+	min        []byte
+	regexp     *regexp.Regexp
+	// maxScannedTerms limits the number of terms read, 0 means unlimited
+	maxScannedTerms int
+	scannedTerms    int
+}
+
+// parseTermMatcher parses the value of a Like or Regex filter, both are
+// served by iterating over the terms of the property starting at the fixed
+// prefix of the pattern
+func parseTermMatcher(operator filters.Operator, in []byte) (*likeRegexp, error) {
+	if operator == filters.OperatorRegex {
+		return parseRegexFilter(in)
+	}
+	return parseLikeRegexp(in)
+}
+
+func parseRegexFilter(in []byte) (*likeRegexp, error) {
+	r, err := filters.CompileRegexPattern(string(in))
+	if err != nil {
+		return nil, err
+	}
+
+	// every matching term starts with the literal prefix of the pattern, if
+	// there is none, all terms have to be checked
+	prefix, _ := r.LiteralPrefix()
+	return &likeRegexp{
+		regexp:          r,
+		min:             []byte(prefix),
+		optimizable:     len(prefix) > 0,
+		maxScannedTerms: regexMaxScannedTerms,
+	}, nil
 }
 
 func parseLikeRegexp(in []byte) (*likeRegexp, error) {
@@ -35,13 +76,52 @@ func parseLikeRegexp(in []byte) (*likeRegexp, error) {
 		regexp:      r,
 		min:         min,
 		optimizable: ok,
+		prefixOnly:  ok && len(min) == len(in)-1 && in[len(in)-1] == '*',
 	}, nil
 }
 
+// matches checks a term that is known to start with the fixed characters
+// of an optimizable pattern
+func (l *likeRegexp) matches(term []byte) bool {
+	return l.prefixOnly || l.regexp.Match(term)
+}
+
+// scan counts a term read from the term dictionary and errors once more terms
+// were read than allowed
+func (l *likeRegexp) scan() error {
+	if l.maxScannedTerms <= 0 {
+		return nil
+	}
+
+	l.scannedTerms++
+	if l.scannedTerms > l.maxScannedTerms {
+		return errors.Errorf("pattern %q had to be matched against more than %d terms, "+
+			"start the pattern with fixed characters to narrow down the terms to match",
+			l.regexp.String(), l.maxScannedTerms)
+	}
+	return nil
+}
+
 func transformLikeStringToRegexp(in []byte) string {
-	in = bytes.ReplaceAll(in, []byte("?"), []byte("."))
-	in = bytes.ReplaceAll(in, []byte("*"), []byte(".*"))
-	return "^" + string(in) + "$"
+	var sb strings.Builder
+	sb.WriteString("^")
+	literalStart := 0
+	for i, char := range in {
+		if !isWildcardCharacter(char) {
+			continue
+		}
+		// everything except the wildcards is matched literally
+		sb.WriteString(regexp.QuoteMeta(string(in[literalStart:i])))
+		if char == '?' {
+			sb.WriteString(".")
+		} else {
+			sb.WriteString(".*")
+		}
+		literalStart = i + 1
+	}
+	sb.WriteString(regexp.QuoteMeta(string(in[literalStart:])))
+	sb.WriteString("$")
+	return sb.String()
 }
 
 func optimizable(in []byte) ([]byte, bool) {
@@ -59,3 +139,21 @@ func optimizable(in []byte) ([]byte, bool) {
 func isWildcardCharacter(in byte) bool {
 	return in == '?' || in == '*'
 }
+
+// ReverseTerm reverses the characters of a term for the reversed term
+// dictionary, in which terms with the same suffix share a common prefix
+func ReverseTerm(term []byte) []byte {
+	runes := []rune(string(term))
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return []byte(string(runes))
+}
+
+// prefersReversedIndex checks whether a like pattern starts with a wildcard
+// but ends with fixed characters. Such a pattern would require a scan of all
+// terms, while the reversed pattern can seek in the reversed term dictionary.
+func prefersReversedIndex(pattern []byte) bool {
+	return len(pattern) > 0 && isWildcardCharacter(pattern[0]) &&
+		!isWildcardCharacter(pattern[len(pattern)-1])
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
 )
 
 func TestLikeRegexp(t *testing.T) {
@@ -80,6 +81,17 @@ func TestLikeRegexp(t *testing.T) {
 		run(t, tests)
 	})
 
+	t.Run("with regexp special characters", func(t *testing.T) {
+		input := []byte("v1.2*")
+		tests := []test{
+			{input: input, subject: []byte("v1.2"), shouldMatch: true},
+			{input: input, subject: []byte("v1.23"), shouldMatch: true},
+			{input: input, subject: []byte("v1x2"), shouldMatch: false},
+		}
+
+		run(t, tests)
+	})
+
 	t.Run("with several wildcards", func(t *testing.T) {
 		input := []byte("*c?r*")
 		tests := []test{
@@ -125,3 +137,89 @@ func TestLikeRegexp_ForOptimizability(t *testing.T) {
 
 	run(t, tests)
 }
+
+func TestLikeRegexp_PrefixOnly(t *testing.T) {
+	for input, expected := range map[string]bool{
+		"car*":  true,
+		"car":   false,
+		"car?":  false,
+		"car**": false,
+		"c*r*":  false,
+		"*car":  false,
+	} {
+		t.Run(input, func(t *testing.T) {
+			res, err := parseLikeRegexp([]byte(input))
+			require.Nil(t, err)
+			assert.Equal(t, expected, res.prefixOnly)
+		})
+	}
+}
+
+func TestReverseTerm(t *testing.T) {
+	assert.Equal(t, []byte("esabatad"), ReverseTerm([]byte("database")))
+	assert.Equal(t, []byte("eßartS"), ReverseTerm([]byte("Straße")))
+	assert.Equal(t, []byte("esab*"), ReverseTerm([]byte("*base")))
+
+	assert.True(t, prefersReversedIndex([]byte("*base")))
+	assert.True(t, prefersReversedIndex([]byte("?ase")))
+	assert.False(t, prefersReversedIndex([]byte("base*")))
+	assert.False(t, prefersReversedIndex([]byte("*bas*")))
+	assert.False(t, prefersReversedIndex([]byte("")))
+}
+
+func TestRegexFilter(t *testing.T) {
+	t.Run("matching", func(t *testing.T) {
+		res, err := parseTermMatcher(filters.OperatorRegex, []byte("car(e|s)?"))
+		require.Nil(t, err)
+
+		assert.True(t, res.regexp.MatchString("car"))
+		assert.True(t, res.regexp.MatchString("cars"))
+		assert.False(t, res.regexp.MatchString("carer"))
+		assert.False(t, res.regexp.MatchString("supercar"))
+		assert.False(t, res.prefixOnly)
+	})
+
+	t.Run("literal prefix", func(t *testing.T) {
+		for input, expectedMin := range map[string]string{
+			"car.*":   "car",
+			"ca[rt]":  "ca",
+			".*car":   "",
+			"(?i)car": "",
+			"car|cat": "ca",
+		} {
+			t.Run(input, func(t *testing.T) {
+				res, err := parseTermMatcher(filters.OperatorRegex, []byte(input))
+				require.Nil(t, err)
+				assert.Equal(t, []byte(expectedMin), res.min)
+				assert.Equal(t, len(expectedMin) > 0, res.optimizable)
+			})
+		}
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := parseTermMatcher(filters.OperatorRegex, []byte("car("))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("scan limit", func(t *testing.T) {
+		res, err := parseTermMatcher(filters.OperatorRegex, []byte(".*car"))
+		require.Nil(t, err)
+		assert.Equal(t, regexMaxScannedTerms, res.maxScannedTerms)
+
+		res.maxScannedTerms = 3
+		for i := 0; i < 3; i++ {
+			require.Nil(t, res.scan())
+		}
+		err = res.scan()
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "more than 3 terms")
+	})
+
+	t.Run("like filters are not limited", func(t *testing.T) {
+		res, err := parseTermMatcher(filters.OperatorLike, []byte("*car"))
+		require.Nil(t, err)
+		for i := 0; i < 10; i++ {
+			require.Nil(t, res.scan())
+		}
+	})
+}
@@ -209,6 +209,42 @@ func (t *JsonPropertyLengthTracker) UnTrackProperty(propName string, value float
 }
 
 // Returns the bucket that the given value belongs to
+// RenameProperty moves the tracked lengths of a property to a new name.
+// Nothing happens if the property isn't tracked, so a rename can be repeated.
+func (t *JsonPropertyLengthTracker) RenameProperty(propName, newName string) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.data == nil {
+		return
+	}
+	if bucketed, ok := t.data.BucketedData[propName]; ok {
+		t.data.BucketedData[newName] = bucketed
+		delete(t.data.BucketedData, propName)
+	}
+	if sum, ok := t.data.SumData[propName]; ok {
+		t.data.SumData[newName] = sum
+		delete(t.data.SumData, propName)
+	}
+	if count, ok := t.data.CountData[propName]; ok {
+		t.data.CountData[newName] = count
+		delete(t.data.CountData, propName)
+	}
+}
+
+// DropProperty removes the tracked lengths of a property
+func (t *JsonPropertyLengthTracker) DropProperty(propName string) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.data == nil {
+		return
+	}
+	delete(t.data.BucketedData, propName)
+	delete(t.data.SumData, propName)
+	delete(t.data.CountData, propName)
+}
+
 func (t *JsonPropertyLengthTracker) bucketFromValue(value float32) int {
 	if t.UnlimitedBuckets {
 		return int(value)
@@ -14,6 +14,7 @@ package inverted
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -68,6 +69,13 @@ func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 			return nil, fmt.Errorf("prop %q has no datatype", prop.Name)
 		}
 
+		if schema.IsNestedDataType(prop.DataType) {
+			if err := a.extendPropertiesWithNested(&out, prop, input, key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		if !HasInvertedIndex(prop) {
 			continue
 		}
@@ -178,6 +186,49 @@ func (a *Analyzer) extendPropertiesWithArrayType(properties *[]Property,
 	return nil
 }
 
+// extendPropertiesWithNested mutates the passed in properties, by extending
+// it with a property for every indexed nested property of an object or
+// object[] property, named by its dot path (e.g. address.city)
+func (a *Analyzer) extendPropertiesWithNested(properties *[]Property,
+	prop *models.Property, input map[string]any, propName string,
+) error {
+	value, ok := input[propName]
+	if !ok {
+		// skip any nested prop that's not set
+		return nil
+	}
+
+	for _, nestedProp := range schema.FlattenNestedProperties(prop) {
+		if !HasInvertedIndex(nestedProp) {
+			continue
+		}
+
+		path := strings.Split(nestedProp.Name, schema.NestedPropertySeparator)[1:]
+		values := schema.GetNestedPropertyValues(value, path)
+		if len(values) == 0 {
+			continue
+		}
+
+		var property *Property
+		var err error
+		if schema.IsArrayDataType(nestedProp.DataType) {
+			property, err = a.analyzeArrayProp(nestedProp, values)
+		} else {
+			property, err = a.analyzePrimitiveProp(nestedProp, values[0])
+		}
+		if err != nil {
+			return fmt.Errorf("analyze nested prop: %w", err)
+		}
+		if property == nil {
+			continue
+		}
+
+		*properties = append(*properties, *property)
+	}
+
+	return nil
+}
+
 // extendPropertiesWithPrimitive mutates the passed in properties, by extending
 // it with an additional property - if applicable
 func (a *Analyzer) extendPropertiesWithPrimitive(properties *[]Property,
@@ -207,15 +258,22 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 	var items []Countable
 	hasFilterableIndex := HasFilterableIndex(prop)
 	hasSearchableIndex := HasSearchableIndex(prop)
+	hasPositionIndex := HasPositionIndex(prop)
+	hasReversedIndex := HasReversedIndex(prop)
 
 	switch dt := schema.DataType(prop.DataType[0]); dt {
 	case schema.DataTypeTextArray:
 		hasFilterableIndex = hasFilterableIndex && !a.isFallbackToSearchable()
+		hasReversedIndex = hasReversedIndex && hasFilterableIndex
 		in, err := stringsFromValues(prop, values)
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		if hasPositionIndex {
+			items = a.PositionedTextArray(prop.Tokenization, prop.Stemmer, in)
+		} else {
+			items = a.StemmedTextArray(prop.Tokenization, prop.Stemmer, in)
+		}
 	case schema.DataTypeIntArray:
 		in := make([]int64, len(values))
 		for i, value := range values {
@@ -327,6 +385,8 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		Length:             len(values),
 		HasFilterableIndex: hasFilterableIndex,
 		HasSearchableIndex: hasSearchableIndex,
+		HasPositionIndex:   hasPositionIndex,
+		HasReversedIndex:   hasReversedIndex,
 	}, nil
 }
 
@@ -347,15 +407,23 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 	propertyLength := -1 // will be overwritten for string/text, signals not to add the other types.
 	hasFilterableIndex := HasFilterableIndex(prop)
 	hasSearchableIndex := HasSearchableIndex(prop)
+	hasPositionIndex := HasPositionIndex(prop)
+	hasReversedIndex := HasReversedIndex(prop)
+	hasRangeIndex := HasRangeIndex(prop)
 
 	switch dt := schema.DataType(prop.DataType[0]); dt {
 	case schema.DataTypeText:
 		hasFilterableIndex = hasFilterableIndex && !a.isFallbackToSearchable()
+		hasReversedIndex = hasReversedIndex && hasFilterableIndex
 		asString, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		if hasPositionIndex {
+			items = a.PositionedTextArray(prop.Tokenization, prop.Stemmer, []string{asString})
+		} else {
+			items = a.StemmedTextArray(prop.Tokenization, prop.Stemmer, []string{asString})
+		}
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeInt:
 		if asFloat, ok := value.(float64); ok {
@@ -450,6 +518,9 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		Length:             propertyLength,
 		HasFilterableIndex: hasFilterableIndex,
 		HasSearchableIndex: hasSearchableIndex,
+		HasPositionIndex:   hasPositionIndex,
+		HasReversedIndex:   hasReversedIndex,
+		HasRangeIndex:      hasRangeIndex,
 	}, nil
 }
 
@@ -582,6 +653,85 @@ func HasSearchableIndex(prop *models.Property) bool {
 	}
 }
 
+// Indicates whether positions of the terms of the property should be indexed
+// Index holds document ids with property containing particular value
+// and the positions of the value within the property, required for phrase search
+// (index created using bucket of StrategyMapCollection)
+func HasPositionIndex(prop *models.Property) bool {
+	// positions are disabled by default and only complement the searchable index
+	if prop.IndexPositions == nil || !*prop.IndexPositions {
+		return false
+	}
+	return HasSearchableIndex(prop)
+}
+
+// Indicates whether reversed terms of the property should be indexed
+// Index holds document ids with property containing particular value,
+// keyed by the reversed value to seek values by their suffix
+// (index created using bucket of StrategyRoaringSet)
+func HasReversedIndex(prop *models.Property) bool {
+	// reversed terms are disabled by default and only complement the filterable index
+	if prop.IndexReversedTerms == nil || !*prop.IndexReversedTerms {
+		return false
+	}
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		return HasFilterableIndex(prop)
+	default:
+		return false
+	}
+}
+
+// Indicates whether property should be indexed for range filters
+// Index holds document ids by the bits of their property value
+// (index created using bucket of StrategyRoaringSet)
+func HasRangeIndex(prop *models.Property) bool {
+	// range index is disabled by default
+	if prop.IndexRangeFilters == nil || !*prop.IndexRangeFilters {
+		return false
+	}
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeDate:
+		return true
+	default:
+		return false
+	}
+}
+
+// Indicates whether the null state of property should be indexed
+// Index holds document ids with property being null or not null
+// (index created using bucket of StrategyRoaringSet)
+func HasNullStateIndex(prop *models.Property, classIndexNullState bool) bool {
+	if !HasInvertedIndex(prop) || schema.IsNestedDataType(prop.DataType) {
+		return false
+	}
+	// by default the class wide setting applies
+	if prop.IndexNullState == nil {
+		return classIndexNullState
+	}
+	return *prop.IndexNullState
+}
+
+// Indicates whether the length of property should be indexed
+// Index holds document ids with property of particular length
+// (index created using bucket of StrategyRoaringSet)
+func HasPropertyLengthIndex(prop *models.Property, classIndexPropertyLength bool) bool {
+	if !HasInvertedIndex(prop) || schema.IsNestedDataType(prop.DataType) {
+		return false
+	}
+	// defining a length does not make sense for some datatypes
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeBlob,
+		schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate:
+		return false
+	}
+	// by default the class wide setting applies
+	if prop.IndexPropertyLength == nil {
+		return classIndexPropertyLength
+	}
+	return *prop.IndexPropertyLength
+}
+
 // Indicates whether property should be indexed
 // Index holds document ids with property of/containing particular value
 // (index created using bucket of StrategyRoaringSet)
@@ -610,13 +760,13 @@ const (
 	HasFilterableIndexMetaCount = true
 	HasSearchableIndexMetaCount = false
 
-	// only if index.invertedIndexConfig.IndexNullState set
-	// and either property.indexFilterable or property.indexSearchable set
+	// only if property.indexNullState (or index.invertedIndexConfig.IndexNullState
+	// if not set) and either property.indexFilterable or property.indexSearchable set
 	HasFilterableIndexPropNull = true
 	HasSearchableIndexPropNull = false
 
-	// only if index.invertedIndexConfig.IndexPropertyLength set
-	// and either property.indexFilterable or property.indexSearchable set
+	// only if property.indexPropertyLength (or index.invertedIndexConfig.IndexPropertyLength
+	// if not set) and either property.indexFilterable or property.indexSearchable set
 	HasFilterableIndexPropLength = true
 	HasSearchableIndexPropLength = false
 )
@@ -276,6 +276,51 @@ func Test_PropertyLengthTracker_Persistence(t *testing.T) {
 	})
 }
 
+func Test_PropertyLengthTracker_RenameAndDrop(t *testing.T) {
+	tracker, err := NewJsonPropertyLengthTracker(path.Join(t.TempDir(), "my_test_shard"), logrus.New())
+	require.Nil(t, err)
+
+	for _, v := range []float32{2, 4} {
+		require.Nil(t, tracker.TrackProperty("title", v))
+		require.Nil(t, tracker.TrackProperty("body", v+10))
+	}
+
+	t.Run("renaming a property", func(t *testing.T) {
+		tracker.RenameProperty("title", "headline")
+
+		sum, count, mean, err := tracker.PropertyTally("headline")
+		require.Nil(t, err)
+		assert.Equal(t, 6, sum)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, float64(3), mean)
+
+		sum, count, _, err = tracker.PropertyTally("title")
+		require.Nil(t, err)
+		assert.Equal(t, 0, sum)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("repeating the rename keeps the data", func(t *testing.T) {
+		tracker.RenameProperty("title", "headline")
+
+		_, count, _, err := tracker.PropertyTally("headline")
+		require.Nil(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("dropping a property", func(t *testing.T) {
+		tracker.DropProperty("body")
+
+		_, count, _, err := tracker.PropertyTally("body")
+		require.Nil(t, err)
+		assert.Equal(t, 0, count)
+
+		_, count, _, err = tracker.PropertyTally("headline")
+		require.Nil(t, err)
+		assert.Equal(t, 2, count)
+	})
+}
+
 // Testing the switch from the old property length tracker to the new one
 func TestFormatConversion(t *testing.T) {
 	dirName := t.TempDir()
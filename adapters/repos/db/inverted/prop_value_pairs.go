@@ -17,6 +17,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -33,11 +34,21 @@ type propValuePair struct {
 
 	// only set if operator=OperatorWithinGeoRange, as that cannot be served by a
 	// byte value from an inverted index
-	valueGeoRange      *filters.GeoRange
-	docIDs             docBitmap
-	children           []*propValuePair
-	hasFilterableIndex bool
-	hasSearchableIndex bool
+	valueGeoRange *filters.GeoRange
+	// only set if operator=OperatorWithinGeoPolygon
+	valueGeoPolygon *filters.GeoPolygon
+	// only set if operator=OperatorWithinGeoBoundingBox
+	valueGeoBoundingBox *filters.GeoBoundingBox
+	docIDs              docBitmap
+	children            []*propValuePair
+	hasFilterableIndex  bool
+	hasSearchableIndex  bool
+	hasReversedIndex    bool
+	hasRangeIndex       bool
+
+	// estimate of the matching doc ids, set when the pair is an operand of an
+	// And or Or. Pairs read completely while estimating are not read again.
+	estimate *filterEstimate
 }
 
 func newPropValuePair() propValuePair {
@@ -46,6 +57,16 @@ func newPropValuePair() propValuePair {
 
 func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 	if pv.operator.OnValue() {
+		if pv.estimate != nil && !pv.estimate.sampled {
+			return nil
+		}
+		if pv.operator == filters.OperatorLike && pv.hasReversedIndex && prefersReversedIndex(pv.value) {
+			return pv.fetchDocIDsReversed(s, limit)
+		}
+		if pv.hasRangeIndex && prefersRangeIndex(pv.operator, pv.hasFilterableIndex) {
+			return pv.fetchDocIDsRange(s)
+		}
+
 		var bucketName string
 		if pv.hasFilterableIndex {
 			bucketName = helpers.BucketFromPropNameLSM(pv.prop)
@@ -60,13 +81,13 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 		// TODO text_rbm_inverted_index find better way check whether prop len
 		if b == nil && strings.HasSuffix(bucketName, filters.InternalPropertyLength) {
 			return errors.Errorf("Property length must be indexed to be filterable! " +
-				"add `IndexPropertyLength: true` to the invertedIndexConfig." +
+				"add `IndexPropertyLength: true` to the invertedIndexConfig or `indexPropertyLength: true` to the property." +
 				"Geo-coordinates, phone numbers and data blobs are not supported by property length.")
 		}
 
 		if b == nil && pv.operator == filters.OperatorIsNull {
 			return errors.Errorf("Nullstate must be indexed to be filterable! " +
-				"add `indexNullState: true` to the invertedIndexConfig")
+				"add `indexNullState: true` to the invertedIndexConfig or to the property")
 		}
 
 		if b == nil && (pv.prop == filters.InternalPropCreationTimeUnix ||
@@ -75,9 +96,9 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 				"add `indexTimestamps: true` to the invertedIndexConfig")
 		}
 
-		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
-			// a nil bucket is ok for a WithinGeoRange filter, as this query is not
-			// served by the inverted index, but propagated to a secondary index in
+		if b == nil && !pv.operator.OnGeo() {
+			// a nil bucket is ok for a geo filter, as this query is not served by
+			// the inverted index, but propagated to a secondary index in
 			// .docPointers()
 			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
 		}
@@ -88,34 +109,120 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 			return err
 		}
 		pv.docIDs = dbm
-	} else {
-		eg := errgroup.Group{}
-		// prevent unbounded concurrency, see
-		// https://github.com/weaviate/weaviate/issues/3179 for details
-		eg.SetLimit(2 * _NUMCPU)
-		for i, child := range pv.children {
-			i, child := i, child
-			eg.Go(func() error {
-				// Explicitly set the limit to 0 (=unlimited) as this is a nested filter,
-				// otherwise we run into situations where each subfilter on their own
-				// runs into the limit, possibly yielding in "less than limit" results
-				// after merging.
-				err := child.fetchDocIDs(s, 0)
-				if err != nil {
-					return errors.Wrapf(err, "nested child %d", i)
-				}
+		return nil
+	}
+
+	if pv.operator == filters.OperatorAnd {
+		return pv.fetchDocIDsAnd(s)
+	}
+	return pv.fetchDocIDsOr(s)
+}
 
-				return nil
-			})
+// fetchDocIDsAnd fetches the operands of an And from the one matching the
+// fewest doc ids to the one matching the most, see childrenByEstimate. Once
+// the intersection of the operands fetched so far is empty, the remaining
+// ones cannot add any matches and are skipped.
+func (pv *propValuePair) fetchDocIDsAnd(s *Searcher) error {
+	if err := pv.estimateChildren(s); err != nil {
+		return err
+	}
+
+	groups := pv.childrenByEstimate()
+	fetched := make([]*docBitmap, 0, len(pv.children))
+	for g, positions := range groups {
+		if err := pv.fetchChildrenDocIDs(s, positions); err != nil {
+			return err
 		}
-		if err := eg.Wait(); err != nil {
-			return fmt.Errorf("nested query: %w", err)
+		if g == len(groups)-1 {
+			break
+		}
+
+		for _, pos := range positions {
+			dbm, err := pv.children[pos].mergeDocIDs()
+			if err != nil {
+				return errors.Wrapf(err, "retrieve doc bitmap of child %d", pos)
+			}
+			fetched = append(fetched, dbm)
+		}
+		if andDocBitmaps(fetched).IsEmpty() {
+			for _, remaining := range groups[g+1:] {
+				for _, pos := range remaining {
+					pv.children[pos].resetDocIDs()
+				}
+			}
+			return nil
 		}
 	}
 
 	return nil
 }
 
+// fetchDocIDsOr fetches the operands of an Or in the same order as those of
+// an And. All of them are needed, but the ones matching few doc ids are read
+// completely while estimating.
+func (pv *propValuePair) fetchDocIDsOr(s *Searcher) error {
+	if err := pv.estimateChildren(s); err != nil {
+		return err
+	}
+
+	for _, positions := range pv.childrenByEstimate() {
+		if err := pv.fetchChildrenDocIDs(s, positions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchChildrenDocIDs fetches the children at the given positions
+// concurrently
+func (pv *propValuePair) fetchChildrenDocIDs(s *Searcher, positions []int) error {
+	eg := errgroup.Group{}
+	// prevent unbounded concurrency, see
+	// https://github.com/weaviate/weaviate/issues/3179 for details
+	eg.SetLimit(2 * _NUMCPU)
+	for _, i := range positions {
+		i, child := i, pv.children[i]
+		eg.Go(func() error {
+			// Explicitly set the limit to 0 (=unlimited) as this is a nested filter,
+			// otherwise we run into situations where each subfilter on their own
+			// runs into the limit, possibly yielding in "less than limit" results
+			// after merging.
+			err := child.fetchDocIDs(s, 0)
+			if err != nil {
+				return errors.Wrapf(err, "nested child %d", i)
+			}
+
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return fmt.Errorf("nested query: %w", err)
+	}
+
+	return nil
+}
+
+// fetchDocIDsReversed serves a like pattern starting with a wildcard from
+// the reversed term dictionary, where the reversed pattern starts with the
+// fixed characters and can seek to the matching terms
+func (pv *propValuePair) fetchDocIDsReversed(s *Searcher, limit int) error {
+	b := s.store.Bucket(helpers.BucketReversedFromPropNameLSM(pv.prop))
+	if b == nil {
+		return errors.Errorf("bucket reversed for prop %s not found - is it indexed?", pv.prop)
+	}
+
+	reversed := *pv
+	reversed.value = ReverseTerm(pv.value)
+
+	ctx := context.TODO() // TODO: pass through instead of spawning new
+	dbm, err := s.docBitmap(ctx, b, limit, &reversed)
+	if err != nil {
+		return err
+	}
+	pv.docIDs = dbm
+	return nil
+}
+
 func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 	if pv.operator.OnValue() {
 		return &pv.docIDs, nil
@@ -137,14 +244,11 @@ func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 		dbms[i] = dbm
 	}
 
-	mergeRes := dbms[0].docIDs.Clone()
-	mergeFn := mergeRes.And
-	if pv.operator == filters.OperatorOr {
-		mergeFn = mergeRes.Or
-	}
-
-	for i := 1; i < len(dbms); i++ {
-		mergeFn(dbms[i].docIDs)
+	var mergeRes *sroar.Bitmap
+	if pv.operator == filters.OperatorAnd {
+		mergeRes = andDocBitmaps(dbms)
+	} else {
+		mergeRes = orDocBitmaps(dbms)
 	}
 
 	return &docBitmap{
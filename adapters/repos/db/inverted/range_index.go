@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// The range index is a bit-sliced index over the lexicographically sortable
+// 8 byte representation of int, number and date values. For every bit of the
+// representation it holds the bitmap of all documents whose value has that
+// bit set, plus the bitmap of all documents with a value. A range filter is
+// then resolved with a fixed number of bitmap operations, no matter how many
+// distinct values fall into the range.
+const rangeIndexBits = 64
+
+// key of the bitmap holding all documents with a value
+var rangeIndexKeyAll = []byte{rangeIndexBits}
+
+func rangeIndexKeyBit(bit int) []byte {
+	return []byte{byte(bit)}
+}
+
+// RangeIndexKeys returns the keys of the range index a document with the given
+// value is added to
+func RangeIndexKeys(value []byte) ([][]byte, error) {
+	if len(value) != 8 {
+		return nil, fmt.Errorf("range index requires 8 byte values, got %d bytes", len(value))
+	}
+
+	asUint := binary.BigEndian.Uint64(value)
+	keys := [][]byte{rangeIndexKeyAll}
+	for bit := 0; bit < rangeIndexBits; bit++ {
+		if asUint&(1<<bit) != 0 {
+			keys = append(keys, rangeIndexKeyBit(bit))
+		}
+	}
+	return keys, nil
+}
+
+// prefersRangeIndex indicates whether a filter with the given operator is
+// served from the range index. Equality is looked up faster in the filterable
+// index, if there is one.
+func prefersRangeIndex(operator filters.Operator, hasFilterableIndex bool) bool {
+	switch operator {
+	case filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual,
+		filters.OperatorLessThan, filters.OperatorLessThanEqual:
+		return true
+	case filters.OperatorEqual, filters.OperatorNotEqual:
+		return !hasFilterableIndex
+	default:
+		return false
+	}
+}
+
+func (pv *propValuePair) fetchDocIDsRange(s *Searcher) error {
+	b := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(pv.prop))
+	if b == nil {
+		return errors.Errorf("bucket rangeable for prop %s not found - is it indexed?", pv.prop)
+	}
+
+	if len(pv.value) != 8 {
+		return errors.Errorf("range index requires 8 byte values, got %d bytes", len(pv.value))
+	}
+
+	all, err := b.RoaringSetGet(rangeIndexKeyAll)
+	if err != nil {
+		return errors.Wrap(err, "read range index")
+	}
+
+	slices := make([]*sroar.Bitmap, rangeIndexBits)
+	for bit := range slices {
+		slices[bit], err = b.RoaringSetGet(rangeIndexKeyBit(bit))
+		if err != nil {
+			return errors.Wrapf(err, "read range index bit %d", bit)
+		}
+	}
+
+	docIDs, err := rangeFilter(all, slices, binary.BigEndian.Uint64(pv.value), pv.operator)
+	if err != nil {
+		return err
+	}
+	pv.docIDs = docBitmap{docIDs: roaringset.Condense(docIDs)}
+	return nil
+}
+
+// rangeFilter compares the values of all documents against the given value,
+// starting with the most significant bit. While the bits are equal, a
+// document remains a candidate for equality. At the first differing bit it
+// is decided whether its value is greater or less than the given one.
+func rangeFilter(all *sroar.Bitmap, slices []*sroar.Bitmap, value uint64,
+	operator filters.Operator,
+) (*sroar.Bitmap, error) {
+	gt := sroar.NewBitmap()
+	lt := sroar.NewBitmap()
+	eq := all.Clone()
+
+	for bit := rangeIndexBits - 1; bit >= 0; bit-- {
+		if eq.IsEmpty() {
+			break
+		}
+
+		diff := eq.Clone()
+		if value&(1<<bit) != 0 {
+			// candidates without the bit are less
+			diff.AndNot(slices[bit])
+			lt.Or(diff)
+			eq.And(slices[bit])
+		} else {
+			// candidates with the bit are greater
+			diff.And(slices[bit])
+			gt.Or(diff)
+			eq.AndNot(slices[bit])
+		}
+	}
+
+	switch operator {
+	case filters.OperatorEqual:
+		return eq, nil
+	case filters.OperatorNotEqual:
+		notEq := all.Clone()
+		notEq.AndNot(eq)
+		return notEq, nil
+	case filters.OperatorGreaterThan:
+		return gt, nil
+	case filters.OperatorGreaterThanEqual:
+		gt.Or(eq)
+		return gt, nil
+	case filters.OperatorLessThan:
+		return lt, nil
+	case filters.OperatorLessThanEqual:
+		lt.Or(eq)
+		return lt, nil
+	default:
+		return nil, fmt.Errorf("operator %s is not supported by the range index", operator.Name())
+	}
+}
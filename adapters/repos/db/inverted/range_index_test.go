@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestRangeIndex(t *testing.T) {
+	values := []float64{-1e9, -42.5, -1, 0, 0.25, 1, 7, 7, 42.5, 1e9}
+
+	// build the bit slices the same way documents are written to the bucket
+	bitmaps := map[byte]*sroar.Bitmap{}
+	encoded := make([][]byte, len(values))
+	for docID, value := range values {
+		data, err := LexicographicallySortableFloat64(value)
+		require.Nil(t, err)
+		encoded[docID] = data
+
+		keys, err := RangeIndexKeys(data)
+		require.Nil(t, err)
+		for _, key := range keys {
+			if _, ok := bitmaps[key[0]]; !ok {
+				bitmaps[key[0]] = sroar.NewBitmap()
+			}
+			bitmaps[key[0]].Set(uint64(docID))
+		}
+	}
+
+	all := bitmaps[rangeIndexKeyAll[0]]
+	slices := make([]*sroar.Bitmap, rangeIndexBits)
+	for bit := range slices {
+		slices[bit] = sroar.NewBitmap()
+		if bm, ok := bitmaps[byte(bit)]; ok {
+			slices[bit] = bm
+		}
+	}
+
+	operators := []filters.Operator{
+		filters.OperatorEqual,
+		filters.OperatorNotEqual,
+		filters.OperatorGreaterThan,
+		filters.OperatorGreaterThanEqual,
+		filters.OperatorLessThan,
+		filters.OperatorLessThanEqual,
+	}
+
+	for _, queryValue := range []float64{-1e10, -42.5, -2, 0, 0.5, 7, 42.5, 1e10} {
+		query, err := LexicographicallySortableFloat64(queryValue)
+		require.Nil(t, err)
+
+		for _, operator := range operators {
+			t.Run(operator.Name(), func(t *testing.T) {
+				var expected []uint64
+				for docID, data := range encoded {
+					if compareWithOperator(bytes.Compare(data, query), operator) {
+						expected = append(expected, uint64(docID))
+					}
+				}
+
+				res, err := rangeFilter(all, slices, binary.BigEndian.Uint64(query), operator)
+				require.Nil(t, err)
+				assert.ElementsMatch(t, expected, res.ToArray(), "value %v", queryValue)
+			})
+		}
+	}
+
+	t.Run("does not modify the index", func(t *testing.T) {
+		cardinality := all.GetCardinality()
+		_, err := rangeFilter(all, slices, 0, filters.OperatorEqual)
+		require.Nil(t, err)
+		assert.Equal(t, cardinality, all.GetCardinality())
+	})
+
+	t.Run("unsupported operator", func(t *testing.T) {
+		_, err := rangeFilter(all, slices, 0, filters.OperatorLike)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid value length", func(t *testing.T) {
+		_, err := RangeIndexKeys([]byte("abc"))
+		assert.NotNil(t, err)
+	})
+}
+
+func compareWithOperator(cmp int, operator filters.Operator) bool {
+	switch operator {
+	case filters.OperatorEqual:
+		return cmp == 0
+	case filters.OperatorNotEqual:
+		return cmp != 0
+	case filters.OperatorGreaterThan:
+		return cmp > 0
+	case filters.OperatorGreaterThanEqual:
+		return cmp >= 0
+	case filters.OperatorLessThan:
+		return cmp < 0
+	default:
+		return cmp <= 0
+	}
+}
@@ -77,7 +77,7 @@ func (rr *RowReader) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, false)
 	case filters.OperatorLessThanEqual:
 		return rr.lessThan(ctx, readFn, true)
-	case filters.OperatorLike:
+	case filters.OperatorLike, filters.OperatorRegex:
 		return rr.like(ctx, readFn)
 	case filters.OperatorIsNull: // we need to fetch a row with a given value (there is only nil and !nil) and can reuse equal to get the correct row
 		return rr.equal(ctx, readFn)
@@ -192,9 +192,9 @@ func (rr *RowReader) notEqual(ctx context.Context, readFn ReadFn) error {
 }
 
 func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
-	like, err := parseLikeRegexp(rr.value)
+	like, err := parseTermMatcher(rr.operator, rr.value)
 	if err != nil {
-		return errors.Wrapf(err, "parse like value")
+		return errors.Wrapf(err, "parse %s value", rr.operator.Name())
 	}
 
 	c := rr.newCursor()
@@ -215,6 +215,9 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
+		if err := like.scan(); err != nil {
+			return err
+		}
 
 		if like.optimizable {
 			// if the query is optimizable, i.e. it doesn't start with a wildcard, we
@@ -229,7 +232,7 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 			}
 		}
 
-		if !like.regexp.Match(k) {
+		if !like.matches(k) {
 			continue
 		}
 
@@ -72,7 +72,7 @@ func (rr *RowReaderFrequency) Read(ctx context.Context, readFn ReadFnFrequency)
 		return rr.lessThan(ctx, readFn, false)
 	case filters.OperatorLessThanEqual:
 		return rr.lessThan(ctx, readFn, true)
-	case filters.OperatorLike:
+	case filters.OperatorLike, filters.OperatorRegex:
 		return rr.like(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v supported", rr.operator)
@@ -197,9 +197,9 @@ func (rr *RowReaderFrequency) notEqual(ctx context.Context, readFn ReadFnFrequen
 }
 
 func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency) error {
-	like, err := parseLikeRegexp(rr.value)
+	like, err := parseTermMatcher(rr.operator, rr.value)
 	if err != nil {
-		return errors.Wrapf(err, "parse like value")
+		return errors.Wrapf(err, "parse %s value", rr.operator.Name())
 	}
 
 	// TODO: don't we need to check here if this is a doc id vs a object search?
@@ -222,6 +222,9 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 		if err := ctx.Err(); err != nil {
 			return err
 		}
+		if err := like.scan(); err != nil {
+			return err
+		}
 
 		if like.optimizable {
 			// if the query is optimizable, i.e. it doesn't start with a wildcard, we
@@ -236,7 +239,7 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 			}
 		}
 
-		if !like.regexp.Match(k) {
+		if !like.matches(k) {
 			continue
 		}
 
@@ -83,7 +83,7 @@ func (rr *RowReaderRoaringSet) Read(ctx context.Context, readFn RoaringSetReadFn
 		return rr.lessThan(ctx, readFn, false)
 	case filters.OperatorLessThanEqual:
 		return rr.lessThan(ctx, readFn, true)
-	case filters.OperatorLike:
+	case filters.OperatorLike, filters.OperatorRegex:
 		return rr.like(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v not supported", rr.operator)
@@ -193,9 +193,9 @@ func (rr *RowReaderRoaringSet) notEqual(ctx context.Context,
 func (rr *RowReaderRoaringSet) like(ctx context.Context,
 	readFn RoaringSetReadFn,
 ) error {
-	like, err := parseLikeRegexp(rr.value)
+	like, err := parseTermMatcher(rr.operator, rr.value)
 	if err != nil {
-		return errors.Wrapf(err, "parse like value")
+		return errors.Wrapf(err, "parse %s value", rr.operator.Name())
 	}
 
 	c := rr.newCursor()
@@ -218,6 +218,9 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 		if err := ctx.Err(); err != nil {
 			return err
 		}
+		if err := like.scan(); err != nil {
+			return err
+		}
 
 		if like.optimizable {
 			// if the query is optimizable, i.e. it doesn't start with a wildcard, we
@@ -231,7 +234,7 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 			}
 		}
 
-		if !like.regexp.Match(k) {
+		if !like.matches(k) {
 			continue
 		}
 
@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stemmer"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
@@ -92,7 +93,7 @@ func (s *Searcher) Objects(ctx context.Context, limit int,
 		it = allowList.LimitedIterator(limit)
 	}
 
-	return s.objectsByDocID(it, additional)
+	return s.objectsByDocID(it, additional, s.schema.GetClass(className))
 }
 
 func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, docIDs helpers.AllowList,
@@ -106,7 +107,7 @@ func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, doc
 }
 
 func (s *Searcher) objectsByDocID(it docIDsIterator,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*storobj.Object, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	if bucket == nil {
@@ -132,7 +133,7 @@ func (s *Searcher) objectsByDocID(it docIDsIterator,
 		if additional.ReferenceQuery {
 			unmarshalled, err = storobj.FromBinaryUUIDOnly(res)
 		} else {
-			unmarshalled, err = storobj.FromBinaryOptional(res, additional)
+			unmarshalled, err = storobj.FromBinaryOptional(res, additional, class)
 		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
@@ -214,6 +215,10 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		return &out, nil
 	}
 
+	if filter.Operator.OnArray() {
+		return s.extractContains(filter, className)
+	}
+
 	// on value or non-nested filter
 	props := filter.On.Slice()
 	propName := props[0]
@@ -268,6 +273,85 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		filter.Operator)
 }
 
+// extractContains serves ContainsAny and ContainsAll by matching each of the
+// values on its own. The allow lists of the single values are then merged the
+// same way the operands of an Or (ContainsAny) or And (ContainsAll) are.
+func (s *Searcher) extractContains(filter *filters.Clause,
+	className schema.ClassName,
+) (*propValuePair, error) {
+	baseType, ok := schema.IsArrayType(filter.Value.Type)
+	if !ok {
+		return nil, fmt.Errorf("operator %s requires an array value, got %q",
+			filter.Operator.Name(), filter.Value.Type)
+	}
+
+	values, err := containsValues(filter.Value.Value)
+	if err != nil {
+		return nil, fmt.Errorf("operator %s: %w", filter.Operator.Name(), err)
+	}
+
+	out := newPropValuePair()
+	out.children = make([]*propValuePair, len(values))
+	for i, value := range values {
+		child, err := s.extractPropValuePair(&filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       filter.On,
+			Value:    &filters.Value{Value: value, Type: baseType},
+		}, className)
+		if err != nil {
+			return nil, errors.Wrapf(err, "value at pos %d", i)
+		}
+		out.children[i] = child
+	}
+
+	out.operator = filters.OperatorOr
+	if filter.Operator == filters.OperatorContainsAll {
+		out.operator = filters.OperatorAnd
+	}
+	return &out, nil
+}
+
+// containsValues returns the distinct values of an array value, duplicates
+// would only cause the same allow list to be fetched and merged again
+func containsValues(in interface{}) ([]interface{}, error) {
+	var values []interface{}
+	switch typed := in.(type) {
+	case []string:
+		for _, v := range typed {
+			values = append(values, v)
+		}
+	case []int:
+		for _, v := range typed {
+			values = append(values, v)
+		}
+	case []float64:
+		for _, v := range typed {
+			values = append(values, v)
+		}
+	case []bool:
+		for _, v := range typed {
+			values = append(values, v)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported array value of type %T", in)
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("array value must not be empty")
+	}
+
+	seen := make(map[interface{}]struct{}, len(values))
+	distinct := values[:0:0]
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		distinct = append(distinct, v)
+	}
+	return distinct, nil
+}
+
 func (s *Searcher) extractReferenceFilter(prop *models.Property,
 	filter *filters.Clause,
 ) (*propValuePair, error) {
@@ -302,8 +386,9 @@ func (s *Searcher) extractPrimitiveProp(prop *models.Property, propType schema.D
 
 	hasFilterableIndex := HasFilterableIndex(prop)
 	hasSearchableIndex := HasSearchableIndex(prop)
+	hasRangeIndex := HasRangeIndex(prop)
 
-	if !hasFilterableIndex && !hasSearchableIndex {
+	if !hasFilterableIndex && !hasSearchableIndex && !hasRangeIndex {
 		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
 	}
 
@@ -313,6 +398,7 @@ func (s *Searcher) extractPrimitiveProp(prop *models.Property, propType schema.D
 		operator:           operator,
 		hasFilterableIndex: hasFilterableIndex,
 		hasSearchableIndex: hasSearchableIndex,
+		hasRangeIndex:      hasRangeIndex,
 	}, nil
 }
 
@@ -344,20 +430,30 @@ func (s *Searcher) extractGeoFilter(prop *models.Property, value interface{},
 	valueType schema.DataType, operator filters.Operator,
 ) (*propValuePair, error) {
 	if valueType != schema.DataTypeGeoCoordinates {
-		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only"+
-			"be used with geoRange filters", prop.Name)
+		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only "+
+			"be used with geoRange, geoPolygon or geoBoundingBox filters", prop.Name)
 	}
 
-	parsed := value.(filters.GeoRange)
-
-	return &propValuePair{
+	out := &propValuePair{
 		value:              nil, // not going to be served by an inverted index
-		valueGeoRange:      &parsed,
 		prop:               prop.Name,
 		operator:           operator,
 		hasFilterableIndex: HasFilterableIndex(prop),
 		hasSearchableIndex: HasSearchableIndex(prop),
-	}, nil
+	}
+
+	switch parsed := value.(type) {
+	case filters.GeoRange:
+		out.valueGeoRange = &parsed
+	case filters.GeoPolygon:
+		out.valueGeoPolygon = &parsed
+	case filters.GeoBoundingBox:
+		out.valueGeoBoundingBox = &parsed
+	default:
+		return nil, fmt.Errorf("prop %q: unsupported geo filter value %T", prop.Name, value)
+	}
+
+	return out, nil
 }
 
 func (s *Searcher) extractUUIDFilter(prop *models.Property, value interface{},
@@ -488,6 +584,11 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 
 	switch propType {
 	case schema.DataTypeText:
+		// a regex pattern is matched against the terms as a whole, splitting
+		// it would break the pattern
+		if operator == filters.OperatorRegex {
+			return s.extractRegexProp(prop, value.(string))
+		}
 		// if the operator is like, we cannot apply the regular text-splitting
 		// logic as it would remove all wildcard symbols
 		if operator == filters.OperatorLike {
@@ -511,12 +612,18 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 		if s.stopwords.IsStopword(term) {
 			continue
 		}
+		// wildcard patterns are matched against the stems as they are, as
+		// the stem of a partial word is meaningless
+		if operator != filters.OperatorLike {
+			term = stemmer.Stem(prop.Stemmer, term)
+		}
 		propValuePairs = append(propValuePairs, &propValuePair{
 			value:              []byte(term),
 			prop:               prop.Name,
 			operator:           operator,
 			hasFilterableIndex: hasFilterableIndex,
 			hasSearchableIndex: hasSearchableIndex,
+			hasReversedIndex:   hasFilterableIndex && HasReversedIndex(prop),
 		})
 	}
 
@@ -529,6 +636,27 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 	return nil, errors.Errorf("invalid search term, only stopwords provided. Stopwords can be configured in class.invertedIndexConfig.stopwords")
 }
 
+// extractRegexProp matches the pattern against every term of the property
+// starting with the literal prefix of the pattern. The terms are the tokens of
+// the values, so the pattern needs to match lowercased words for the word
+// tokenization, whole values for the field tokenization and so on.
+func (s *Searcher) extractRegexProp(prop *models.Property, pattern string) (*propValuePair, error) {
+	hasFilterableIndex := HasFilterableIndex(prop) && !s.isFallbackToSearchable()
+	hasSearchableIndex := HasSearchableIndex(prop)
+
+	if !hasFilterableIndex && !hasSearchableIndex {
+		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
+	}
+
+	return &propValuePair{
+		value:              []byte(pattern),
+		prop:               prop.Name,
+		operator:           filters.OperatorRegex,
+		hasFilterableIndex: hasFilterableIndex,
+		hasSearchableIndex: hasSearchableIndex,
+	}, nil
+}
+
 func (s *Searcher) extractPropertyLength(prop *models.Property, propType schema.DataType,
 	value interface{}, operator filters.Operator,
 ) (*propValuePair, error) {
@@ -0,0 +1,318 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+import (
+	"bytes"
+	"strings"
+)
+
+// stemEnglish implements the Snowball English (Porter2) stemmer, see
+// https://snowballstem.org/algorithms/english/stemmer.html
+
+var englishExceptions = map[string]string{
+	"skis":   "ski",
+	"skies":  "sky",
+	"dying":  "die",
+	"lying":  "lie",
+	"tying":  "tie",
+	"idly":   "idl",
+	"gently": "gentl",
+	"ugly":   "ugli",
+	"early":  "earli",
+	"only":   "onli",
+	"singly": "singl",
+	"sky":    "sky",
+	"news":   "news",
+	"howe":   "howe",
+	"atlas":  "atlas",
+	"cosmos": "cosmos",
+	"bias":   "bias",
+	"andes":  "andes",
+}
+
+// words which are left as they are after step 1a
+var englishExceptionsAfterStep1a = map[string]struct{}{
+	"inning":  {},
+	"outing":  {},
+	"canning": {},
+	"herring": {},
+	"earring": {},
+	"proceed": {},
+	"exceed":  {},
+	"succeed": {},
+}
+
+var englishStep2 = map[string]string{
+	"tional":  "tion",
+	"enci":    "ence",
+	"anci":    "ance",
+	"abli":    "able",
+	"entli":   "ent",
+	"izer":    "ize",
+	"ization": "ize",
+	"ational": "ate",
+	"ation":   "ate",
+	"ator":    "ate",
+	"alism":   "al",
+	"aliti":   "al",
+	"alli":    "al",
+	"fulness": "ful",
+	"ousli":   "ous",
+	"ousness": "ous",
+	"iveness": "ive",
+	"iviti":   "ive",
+	"biliti":  "ble",
+	"bli":     "ble",
+	"ogi":     "og",
+	"fulli":   "ful",
+	"lessli":  "less",
+	"li":      "",
+}
+
+var englishStep3 = map[string]string{
+	"tional":  "tion",
+	"ational": "ate",
+	"alize":   "al",
+	"icate":   "ic",
+	"iciti":   "ic",
+	"ical":    "ic",
+	"ful":     "",
+	"ness":    "",
+	"ative":   "",
+}
+
+var englishStep4 = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment",
+	"ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion",
+}
+
+func stemEnglish(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	if stem, ok := englishExceptions[word]; ok {
+		return stem
+	}
+
+	w := []byte(strings.TrimPrefix(word, "'"))
+	for i := range w {
+		if w[i] == 'y' && (i == 0 || isEnglishVowel(w[i-1])) {
+			w[i] = 'Y'
+		}
+	}
+	r1, r2 := englishRegions(w)
+
+	// step 0
+	if suffix := longestSuffix(w, "'s'", "'s", "'"); suffix != "" {
+		w = w[:len(w)-len(suffix)]
+	}
+
+	// step 1a
+	switch suffix := longestSuffix(w, "sses", "ied", "ies", "us", "ss", "s"); suffix {
+	case "sses":
+		w = w[:len(w)-2]
+	case "ied", "ies":
+		if len(w) > 4 {
+			w = w[:len(w)-2]
+		} else {
+			w = w[:len(w)-1]
+		}
+	case "s":
+		if len(w) > 2 && containsEnglishVowel(w[:len(w)-2]) {
+			w = w[:len(w)-1]
+		}
+	}
+	if _, ok := englishExceptionsAfterStep1a[string(w)]; ok {
+		return string(w)
+	}
+
+	// step 1b
+	switch suffix := longestSuffix(w, "eedly", "eed", "ingly", "edly", "ing", "ed"); suffix {
+	case "":
+	case "eedly", "eed":
+		if len(w)-len(suffix) >= r1 {
+			w = append(w[:len(w)-len(suffix)], "ee"...)
+		}
+	default:
+		stem := w[:len(w)-len(suffix)]
+		if containsEnglishVowel(stem) {
+			w = stem
+			switch {
+			case bytes.HasSuffix(w, []byte("at")), bytes.HasSuffix(w, []byte("bl")),
+				bytes.HasSuffix(w, []byte("iz")):
+				w = append(w, 'e')
+			case endsWithEnglishDouble(w):
+				w = w[:len(w)-1]
+			case r1 >= len(w) && endsWithEnglishShortSyllable(w):
+				w = append(w, 'e')
+			}
+		}
+	}
+
+	// step 1c
+	if n := len(w); n > 2 && (w[n-1] == 'y' || w[n-1] == 'Y') && !isEnglishVowel(w[n-2]) {
+		w[n-1] = 'i'
+	}
+
+	// step 2
+	if suffix := longestSuffixOf(w, englishStep2); suffix != "" && len(w)-len(suffix) >= r1 {
+		stem := w[:len(w)-len(suffix)]
+		switch suffix {
+		case "ogi":
+			if bytes.HasSuffix(stem, []byte("l")) {
+				w = append(stem, englishStep2[suffix]...)
+			}
+		case "li":
+			if len(stem) > 0 && isEnglishLiEnding(stem[len(stem)-1]) {
+				w = stem
+			}
+		default:
+			w = append(stem, englishStep2[suffix]...)
+		}
+	}
+
+	// step 3
+	if suffix := longestSuffixOf(w, englishStep3); suffix != "" && len(w)-len(suffix) >= r1 {
+		if suffix != "ative" || len(w)-len(suffix) >= r2 {
+			w = append(w[:len(w)-len(suffix)], englishStep3[suffix]...)
+		}
+	}
+
+	// step 4
+	if suffix := longestSuffix(w, englishStep4...); suffix != "" && len(w)-len(suffix) >= r2 {
+		stem := w[:len(w)-len(suffix)]
+		if suffix != "ion" || bytes.HasSuffix(stem, []byte("s")) || bytes.HasSuffix(stem, []byte("t")) {
+			w = stem
+		}
+	}
+
+	// step 5
+	if n := len(w); n > 0 {
+		switch {
+		case w[n-1] == 'e' && (n-1 >= r2 || (n-1 >= r1 && !endsWithEnglishShortSyllable(w[:n-1]))):
+			w = w[:n-1]
+		case w[n-1] == 'l' && n-1 >= r2 && n > 1 && w[n-2] == 'l':
+			w = w[:n-1]
+		}
+	}
+
+	for i := range w {
+		if w[i] == 'Y' {
+			w[i] = 'y'
+		}
+	}
+	return string(w)
+}
+
+func isEnglishVowel(c byte) bool {
+	switch c {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	default:
+		return false
+	}
+}
+
+func containsEnglishVowel(w []byte) bool {
+	for _, c := range w {
+		if isEnglishVowel(c) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEnglishLiEnding(c byte) bool {
+	switch c {
+	case 'c', 'd', 'e', 'g', 'h', 'k', 'm', 'n', 'r', 't':
+		return true
+	default:
+		return false
+	}
+}
+
+func endsWithEnglishDouble(w []byte) bool {
+	n := len(w)
+	if n < 2 || w[n-1] != w[n-2] {
+		return false
+	}
+	switch w[n-1] {
+	case 'b', 'd', 'f', 'g', 'm', 'n', 'p', 'r', 't':
+		return true
+	default:
+		return false
+	}
+}
+
+// endsWithEnglishShortSyllable is true for words ending in a vowel followed
+// by a non-vowel other than w, x or Y and preceded by a non-vowel, or for
+// words consisting of a vowel followed by a non-vowel
+func endsWithEnglishShortSyllable(w []byte) bool {
+	n := len(w)
+	switch {
+	case n == 2:
+		return isEnglishVowel(w[0]) && !isEnglishVowel(w[1])
+	case n > 2:
+		last := w[n-1]
+		return !isEnglishVowel(w[n-3]) && isEnglishVowel(w[n-2]) &&
+			!isEnglishVowel(last) && last != 'w' && last != 'x' && last != 'Y'
+	default:
+		return false
+	}
+}
+
+// englishRegions returns the start of the regions R1 and R2
+func englishRegions(w []byte) (r1, r2 int) {
+	r1 = -1
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if bytes.HasPrefix(w, []byte(prefix)) {
+			r1 = len(prefix)
+			break
+		}
+	}
+	if r1 < 0 {
+		r1 = regionStart(w, 0, isEnglishVowel)
+	}
+	return r1, regionStart(w, r1, isEnglishVowel)
+}
+
+// regionStart returns the position after the first non-vowel following a
+// vowel, starting the search at from
+func regionStart[T byte | rune](w []T, from int, isVowel func(T) bool) int {
+	for i := from + 1; i < len(w); i++ {
+		if isVowel(w[i-1]) && !isVowel(w[i]) {
+			return i + 1
+		}
+	}
+	return len(w)
+}
+
+func longestSuffix(w []byte, suffixes ...string) string {
+	longest := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(longest) && bytes.HasSuffix(w, []byte(suffix)) {
+			longest = suffix
+		}
+	}
+	return longest
+}
+
+func longestSuffixOf(w []byte, replacements map[string]string) string {
+	longest := ""
+	for suffix := range replacements {
+		if len(suffix) > len(longest) && bytes.HasSuffix(w, []byte(suffix)) {
+			longest = suffix
+		}
+	}
+	return longest
+}
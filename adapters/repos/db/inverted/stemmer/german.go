@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+import "strings"
+
+// stemGerman implements the Snowball German stemmer, see
+// https://snowballstem.org/algorithms/german/stemmer.html
+func stemGerman(word string) string {
+	w := []rune(strings.ReplaceAll(word, "ß", "ss"))
+	for i := 1; i < len(w)-1; i++ {
+		if (w[i] == 'u' || w[i] == 'y') && isGermanVowel(w[i-1]) && isGermanVowel(w[i+1]) {
+			w[i] = w[i] - 'a' + 'A'
+		}
+	}
+
+	r1 := regionStart(w, 0, isGermanVowel)
+	if r1 < 3 {
+		r1 = 3
+	}
+	r2 := regionStart(w, r1, isGermanVowel)
+
+	// step 1
+	switch suffix := longestRuneSuffix(w, "em", "ern", "er", "e", "en", "es", "s"); suffix {
+	case "":
+	case "em", "ern", "er":
+		if inRegion(w, suffix, r1) {
+			w = trimRuneSuffix(w, suffix)
+		}
+	case "e", "en", "es":
+		if inRegion(w, suffix, r1) {
+			w = trimRuneSuffix(w, suffix)
+			if hasRuneSuffix(w, "niss") {
+				w = w[:len(w)-1]
+			}
+		}
+	case "s":
+		if inRegion(w, suffix, r1) && len(w) > 1 && isGermanSEnding(w[len(w)-2]) {
+			w = w[:len(w)-1]
+		}
+	}
+
+	// step 2
+	switch suffix := longestRuneSuffix(w, "en", "er", "est", "st"); suffix {
+	case "":
+	case "st":
+		if inRegion(w, suffix, r1) && len(w) > 5 && isGermanStEnding(w[len(w)-3]) {
+			w = w[:len(w)-2]
+		}
+	default:
+		if inRegion(w, suffix, r1) {
+			w = trimRuneSuffix(w, suffix)
+		}
+	}
+
+	// step 3
+	switch suffix := longestRuneSuffix(w, "end", "ung", "ig", "ik", "isch", "lich", "heit", "keit"); suffix {
+	case "":
+	case "end", "ung":
+		if inRegion(w, suffix, r2) {
+			w = trimRuneSuffix(w, suffix)
+			if hasRuneSuffix(w, "ig") && inRegion(w, "ig", r2) && !hasRuneSuffix(w, "eig") {
+				w = trimRuneSuffix(w, "ig")
+			}
+		}
+	case "ig", "ik", "isch":
+		if inRegion(w, suffix, r2) && !hasRuneSuffix(trimRuneSuffix(w, suffix), "e") {
+			w = trimRuneSuffix(w, suffix)
+		}
+	case "lich", "heit":
+		if inRegion(w, suffix, r2) {
+			w = trimRuneSuffix(w, suffix)
+			for _, preceding := range []string{"er", "en"} {
+				if hasRuneSuffix(w, preceding) && inRegion(w, preceding, r1) {
+					w = trimRuneSuffix(w, preceding)
+					break
+				}
+			}
+		}
+	case "keit":
+		if inRegion(w, suffix, r2) {
+			w = trimRuneSuffix(w, suffix)
+			for _, preceding := range []string{"lich", "ig"} {
+				if hasRuneSuffix(w, preceding) && inRegion(w, preceding, r2) {
+					w = trimRuneSuffix(w, preceding)
+					break
+				}
+			}
+		}
+	}
+
+	for i, r := range w {
+		switch r {
+		case 'U':
+			w[i] = 'u'
+		case 'Y':
+			w[i] = 'y'
+		case 'ä':
+			w[i] = 'a'
+		case 'ö':
+			w[i] = 'o'
+		case 'ü':
+			w[i] = 'u'
+		}
+	}
+	return string(w)
+}
+
+func isGermanVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'ä', 'ö', 'ü':
+		return true
+	default:
+		return false
+	}
+}
+
+func isGermanSEnding(r rune) bool {
+	switch r {
+	case 'b', 'd', 'f', 'g', 'h', 'k', 'l', 'm', 'n', 'r', 't':
+		return true
+	default:
+		return false
+	}
+}
+
+func isGermanStEnding(r rune) bool {
+	return r != 'r' && isGermanSEnding(r)
+}
+
+func hasRuneSuffix(w []rune, suffix string) bool {
+	return strings.HasSuffix(string(w), suffix)
+}
+
+func trimRuneSuffix(w []rune, suffix string) []rune {
+	if !hasRuneSuffix(w, suffix) {
+		return w
+	}
+	return w[:len(w)-len([]rune(suffix))]
+}
+
+// inRegion is true if the suffix of w starts within the region starting at
+// the given position
+func inRegion(w []rune, suffix string, region int) bool {
+	return len(w)-len([]rune(suffix)) >= region
+}
+
+func longestRuneSuffix(w []rune, suffixes ...string) string {
+	longest := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(longest) && hasRuneSuffix(w, suffix) {
+			longest = suffix
+		}
+	}
+	return longest
+}
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+import "github.com/pkg/errors"
+
+const (
+	English = "en"
+	German  = "de"
+)
+
+// Languages contains the languages which have a stemmer
+var Languages = []string{English, German}
+
+var stemmers = map[string]func(word string) string{
+	English: stemEnglish,
+	German:  stemGerman,
+}
+
+// Validate returns an error if there is no stemmer for the language, an empty
+// language disables stemming and is always valid
+func Validate(language string) error {
+	if language == "" {
+		return nil
+	}
+	if _, ok := stemmers[language]; !ok {
+		return errors.Errorf("stemmer %q does not exist, supported are %v",
+			language, Languages)
+	}
+	return nil
+}
+
+// Stem reduces the lowercased word to its stem with the Snowball stemmer of
+// the language. Words are returned unchanged for an empty or unknown language.
+func Stem(language, word string) string {
+	stem, ok := stemmers[language]
+	if !ok {
+		return word
+	}
+	return stem(word)
+}
+
+// StemAll stems all terms in place
+func StemAll(language string, terms []string) []string {
+	stem, ok := stemmers[language]
+	if !ok {
+		return terms
+	}
+	for i := range terms {
+		terms[i] = stem(terms[i])
+	}
+	return terms
+}
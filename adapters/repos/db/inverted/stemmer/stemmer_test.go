@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemEnglish(t *testing.T) {
+	// taken from the vocabulary of the Snowball English stemmer
+	tests := map[string]string{
+		"running":       "run",
+		"runs":          "run",
+		"run":           "run",
+		"caresses":      "caress",
+		"ponies":        "poni",
+		"ties":          "tie",
+		"cats":          "cat",
+		"hopping":       "hop",
+		"hoped":         "hope",
+		"agreed":        "agre",
+		"happy":         "happi",
+		"generously":    "generous",
+		"consign":       "consign",
+		"consigned":     "consign",
+		"consignment":   "consign",
+		"consistency":   "consist",
+		"consistently":  "consist",
+		"consolation":   "consol",
+		"consolatory":   "consolatori",
+		"consolidating": "consolid",
+		"consolingly":   "consol",
+		"conspicuously": "conspicu",
+		"conspiracy":    "conspiraci",
+		"conspirators":  "conspir",
+		"constable":     "constabl",
+		"constancy":     "constanc",
+		"knackeries":    "knackeri",
+		"kneeling":      "kneel",
+		"knees":         "knee",
+		"knightly":      "knight",
+		"knitting":      "knit",
+		"knives":        "knive",
+		"knocker":       "knocker",
+		"skies":         "sky",
+		"news":          "news",
+		"succeeding":    "succeed",
+		"an":            "an",
+	}
+
+	for word, expected := range tests {
+		assert.Equal(t, expected, Stem(English, word), word)
+	}
+}
+
+func TestStemGerman(t *testing.T) {
+	// taken from the vocabulary of the Snowball German stemmer
+	tests := map[string]string{
+		"aufeinanderfolgenden": "aufeinanderfolg",
+		"häuser":               "haus",
+		"katzen":               "katz",
+		"laufen":               "lauf",
+		"läuft":                "lauft",
+		"zeitung":              "zeitung",
+		"zeitungen":            "zeitung",
+		"freundlichkeit":       "freundlich",
+		"straße":               "strass",
+	}
+
+	for word, expected := range tests {
+		assert.Equal(t, expected, Stem(German, word), word)
+	}
+}
+
+func TestStemUnknownLanguage(t *testing.T) {
+	assert.Equal(t, "running", Stem("", "running"))
+	assert.Equal(t, []string{"running"}, StemAll("xx", []string{"running"}))
+	assert.Nil(t, Validate(""))
+	assert.Nil(t, Validate(English))
+	assert.NotNil(t, Validate("xx"))
+}
@@ -41,6 +41,37 @@ func NewDetectorFromConfig(config models.StopwordConfig) (*Detector, error) {
 }
 
 func NewDetectorFromPreset(preset string) (*Detector, error) {
+	stopwords, err := presetStopwords(preset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Detector{stopwords: stopwords}, nil
+}
+
+// SetConfig replaces the stopwords of the detector with the ones of the
+// config, so that an updated config applies to all following queries
+func (d *Detector) SetConfig(config models.StopwordConfig) error {
+	stopwords, err := presetStopwords(config.Preset)
+	if err != nil {
+		return errors.Wrap(err, "failed to update detector from config")
+	}
+
+	for _, add := range config.Additions {
+		stopwords[add] = struct{}{}
+	}
+	for _, rem := range config.Removals {
+		delete(stopwords, rem)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.stopwords = stopwords
+	return nil
+}
+
+func presetStopwords(preset string) (map[string]struct{}, error) {
 	var list []string
 	var ok bool
 
@@ -51,15 +82,12 @@ func NewDetectorFromPreset(preset string) (*Detector, error) {
 		}
 	}
 
-	d := &Detector{
-		stopwords: map[string]struct{}{},
-	}
-
+	stopwords := make(map[string]struct{}, len(list))
 	for _, word := range list {
-		d.stopwords[word] = struct{}{}
+		stopwords[word] = struct{}{}
 	}
 
-	return d, nil
+	return stopwords, nil
 }
 
 func (d *Detector) SetAdditions(additions []string) {
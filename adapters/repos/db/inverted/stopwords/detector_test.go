@@ -156,4 +156,48 @@ func TestStopwordDetector(t *testing.T) {
 
 		runTest(t, tests)
 	})
+
+	t.Run("with de and fr presets", func(t *testing.T) {
+		tests := []testcase{
+			{
+				cfg: models.StopwordConfig{
+					Preset: "de",
+				},
+				input:             []string{"der", "hund", "ist", "für", "die", "katze"},
+				expectedCountable: 2,
+			},
+			{
+				cfg: models.StopwordConfig{
+					Preset:   "fr",
+					Removals: []string{"le"},
+				},
+				input:             []string{"l", "homme", "et", "le", "chien"},
+				expectedCountable: 3,
+			},
+		}
+
+		runTest(t, tests)
+	})
+}
+
+func TestStopwordDetectorSetConfig(t *testing.T) {
+	sd, err := NewDetectorFromConfig(models.StopwordConfig{Preset: "en"})
+	require.Nil(t, err)
+	require.True(t, sd.IsStopword("the"))
+
+	err = sd.SetConfig(models.StopwordConfig{
+		Preset:    "de",
+		Additions: []string{"hund"},
+		Removals:  []string{"und"},
+	})
+	require.Nil(t, err)
+
+	require.False(t, sd.IsStopword("the"))
+	require.True(t, sd.IsStopword("der"))
+	require.True(t, sd.IsStopword("hund"))
+	require.False(t, sd.IsStopword("und"))
+
+	err = sd.SetConfig(models.StopwordConfig{Preset: "xx"})
+	require.NotNil(t, err)
+	require.True(t, sd.IsStopword("der"), "detector is unchanged on error")
 }
@@ -13,6 +13,8 @@ package stopwords
 
 const (
 	EnglishPreset = "en"
+	GermanPreset  = "de"
+	FrenchPreset  = "fr"
 	NoPreset      = "none"
 )
 
@@ -23,5 +25,27 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"aber", "alle", "als", "also", "am", "an", "auch", "auf", "aus", "bei",
+		"bin", "bis", "bist", "da", "damit", "dann", "das", "dass", "dem", "den",
+		"denn", "der", "des", "die", "dies", "diese", "diesem", "diesen", "dieser",
+		"dieses", "doch", "du", "durch", "ein", "eine", "einem", "einen", "einer",
+		"eines", "er", "es", "für", "hat", "hatte", "ich", "ihr", "im", "in", "ist",
+		"ja", "kein", "keine", "man", "mit", "nach", "nicht", "noch", "nur", "ob",
+		"oder", "ohne", "sein", "sich", "sie", "sind", "so", "über", "um", "und",
+		"uns", "unter", "vom", "von", "vor", "war", "waren", "was", "weil", "wenn",
+		"wer", "wie", "wir", "wird", "wo", "zu", "zum", "zur",
+	},
+	// the single letters are the elided forms (l'homme, qu'il, ...) which
+	// the word tokenization splits off
+	FrenchPreset: {
+		"à", "au", "aux", "avec", "c", "ce", "ces", "d", "dans", "de", "des", "du",
+		"elle", "elles", "en", "est", "et", "été", "être", "eux", "il", "ils", "j",
+		"je", "l", "la", "le", "les", "leur", "leurs", "lui", "m", "ma", "mais",
+		"me", "mes", "moi", "mon", "n", "ne", "nos", "notre", "nous", "on", "ont",
+		"ou", "par", "pas", "pour", "qu", "que", "qui", "s", "sa", "se", "ses",
+		"son", "sont", "sur", "t", "ta", "te", "tes", "toi", "ton", "tu", "un",
+		"une", "vos", "votre", "vous", "y",
+	},
 	NoPreset: {},
 }
@@ -242,7 +242,7 @@ func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexa
 		Debug("starting populating indexes")
 
 	i := 0
-	if err := objectsBucket.IterateObjects(ctx, func(object *storobj.Object) error {
+	if err := objectsBucket.IterateObjects(ctx, r.shard.class(), func(object *storobj.Object) error {
 		// check context expired every 100k objects
 		if i%100_000 == 0 && i != 0 {
 			if err := r.checkContextExpired(ctx, "iterating through objects stopped due to context canceled"); err != nil {
@@ -332,7 +332,7 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 	}
 
 	// properties where defining a length does not make sense (floats etc.) have a negative entry as length
-	if r.shard.index.invertedIndexConfig.IndexPropertyLength && property.Length >= 0 {
+	if property.HasLengthIndex && property.Length >= 0 {
 		key, err := r.shard.keyPropertyLength(property.Length)
 		if err != nil {
 			return errors.Wrapf(err, "failed creating key for prop '%s' length", property.Name)
@@ -348,7 +348,7 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 		}
 	}
 
-	if r.shard.index.invertedIndexConfig.IndexNullState {
+	if property.HasNullStateIndex {
 		key, err := r.shard.keyPropertyNull(property.Length == 0)
 		if err != nil {
 			return errors.Wrapf(err, "failed creating key for prop '%s' null", property.Name)
@@ -370,7 +370,7 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 func (r *ShardInvertedReindexer) handleNilProperty(ctx context.Context, checker *reindexablePropertyChecker,
 	docID uint64, nilProperty nilProp,
 ) error {
-	if r.shard.index.invertedIndexConfig.IndexPropertyLength && nilProperty.AddToPropertyLength {
+	if nilProperty.AddToPropertyLength {
 		key, err := r.shard.keyPropertyLength(0)
 		if err != nil {
 			return errors.Wrapf(err, "failed creating key for prop '%s' length", nilProperty.Name)
@@ -386,7 +386,7 @@ func (r *ShardInvertedReindexer) handleNilProperty(ctx context.Context, checker
 		}
 	}
 
-	if r.shard.index.invertedIndexConfig.IndexNullState {
+	if nilProperty.AddToNullState {
 		key, err := r.shard.keyPropertyNull(true)
 		if err != nil {
 			return errors.Wrapf(err, "failed creating key for prop '%s' null", nilProperty.Name)
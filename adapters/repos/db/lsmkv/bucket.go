@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -164,13 +165,17 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 	return b, nil
 }
 
-func (b *Bucket) IterateObjects(ctx context.Context, f func(object *storobj.Object) error) error {
+// IterateObjects calls f for every object stored in the bucket. The property
+// values are typed by the data types declared in the class.
+func (b *Bucket) IterateObjects(ctx context.Context, class *models.Class,
+	f func(object *storobj.Object) error,
+) error {
 	i := 0
 	cursor := b.Cursor()
 	defer cursor.Close()
 
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
-		obj, err := storobj.FromBinary(v)
+		obj, err := storobj.FromBinaryWithClass(v, class)
 		if err != nil {
 			return fmt.Errorf("cannot unmarshal object %d, %v", i, err)
 		}
@@ -367,6 +367,27 @@ func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName stri
 	return nil
 }
 
+// Shuts the bucket down and deletes its files. The bucket is removed from
+// bucketsByName first, so it can not be handed out anymore while shutting down
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.bucketAccessLock.Lock()
+	bucket := s.bucketsByName[bucketName]
+	delete(s.bucketsByName, bucketName)
+	s.bucketAccessLock.Unlock()
+
+	if bucket == nil {
+		return fmt.Errorf("bucket '%s' not found", bucketName)
+	}
+	if err := bucket.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+	}
+	if err := os.RemoveAll(bucket.dir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucket.dir)
+	}
+
+	return nil
+}
+
 func (s *Store) updateBucketDir(bucket *Bucket, bucketDir, newBucketDir string) {
 	updatePath := func(src string) string {
 		return strings.Replace(src, bucketDir, newBucketDir, 1)
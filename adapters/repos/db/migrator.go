@@ -19,11 +19,17 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	multivectorent "github.com/weaviate/weaviate/entities/vectorindex/multivector"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
@@ -53,6 +59,9 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			MemtablesMinActiveSeconds: m.db.config.MemtablesMinActiveSeconds,
 			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
 			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
+			AsyncIndexing:             m.db.config.AsyncIndexing,
+			TombstoneCleanup:          m.db.config.TombstoneCleanup,
+			HNSWSnapshots:             m.db.config.HNSWSnapshots,
 			ReplicationFactor:         class.ReplicationConfig.Factor,
 		},
 		shardState,
@@ -113,20 +122,60 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop *mode
 	return idx.addProperty(ctx, prop)
 }
 
-// DropProperty is ignored, API compliant change
-func (m *Migrator) DropProperty(ctx context.Context, className string, propertyName string) error {
-	// ignore but don't error
-	return nil
-}
-
 func (m *Migrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	if newName != nil {
-		return errors.New("weaviate does not support renaming of properties")
+		return errors.New("properties are renamed with RenameProperty")
 	}
 
 	return nil
 }
 
+// DropProperty drops the indexes of a property right away, its values are
+// removed in the background from the objects last updated before changedAt
+// (unix milliseconds). It needs to be called before the property is removed
+// from the schema.
+func (m *Migrator) DropProperty(ctx context.Context, className string,
+	propName string, changedAt int64,
+) error {
+	idx, prop, err := m.indexAndProperty(className, propName)
+	if err != nil {
+		return errors.Wrap(err, "drop property")
+	}
+
+	return idx.dropProperty(ctx, prop, changedAt)
+}
+
+// RenameProperty renames the indexes of a property right away, its values
+// are moved to the new name in the background in the objects last updated
+// before changedAt (unix milliseconds). It needs to be called before the
+// property is renamed in the schema.
+func (m *Migrator) RenameProperty(ctx context.Context, className string,
+	propName string, newName string, changedAt int64,
+) error {
+	idx, prop, err := m.indexAndProperty(className, propName)
+	if err != nil {
+		return errors.Wrap(err, "rename property")
+	}
+
+	return idx.renameProperty(ctx, prop, newName, changedAt)
+}
+
+func (m *Migrator) indexAndProperty(className, propName string,
+) (*Index, *models.Property, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, nil, errors.Errorf("non-existing index for %s", className)
+	}
+
+	sch := idx.getSchema.GetSchemaSkipAuth()
+	prop, err := sch.GetProperty(schema.ClassName(className), schema.PropertyName(propName))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return idx, prop, nil
+}
+
 func (m *Migrator) GetShardsStatus(ctx context.Context, className string) (map[string]string, error) {
 	idx := m.db.GetIndex(schema.ClassName(className))
 	if idx == nil {
@@ -218,12 +267,32 @@ func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 	return idx.updateVectorIndexConfig(ctx, updated)
 }
 
+func (m *Migrator) UpdateVectorIndexConfigs(ctx context.Context,
+	className string, updated map[string]schema.VectorIndexConfig,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update vector index config of non-existing index for %s", className)
+	}
+
+	return idx.updateTargetVectorIndexConfigs(ctx, updated)
+}
+
 func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	old, updated schema.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
+	if _, ok := old.(multivectorent.UserConfig); ok {
+		return multivector.ValidateUserConfigUpdate(old, updated)
+	}
+
+	if _, ok := old.(flatent.UserConfig); ok {
+		return flat.ValidateUserConfigUpdate(old, updated)
+	}
+
+	if _, ok := old.(dynament.UserConfig); ok {
+		return dynamic.ValidateUserConfigUpdate(old, updated)
+	}
+
 	return hnsw.ValidateUserConfigUpdate(old, updated)
 }
 
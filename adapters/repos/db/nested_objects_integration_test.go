@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFilterNestedProperties(t *testing.T) {
+	vFalse := false
+	class := &models.Class{
+		Class:               "Person",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: schema.DataTypeText.PropString(),
+			},
+			{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "city",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationField,
+					},
+					{
+						Name:     "zip",
+						DataType: schema.DataTypeInt.PropString(),
+					},
+					{
+						Name:            "note",
+						DataType:        schema.DataTypeText.PropString(),
+						IndexFilterable: &vFalse,
+						IndexSearchable: &vFalse,
+					},
+				},
+			},
+			{
+				Name:     "pets",
+				DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "kind",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWord,
+					},
+					{
+						Name:     "age",
+						DataType: schema.DataTypeNumber.PropString(),
+					},
+				},
+			},
+		},
+	}
+
+	migrator, repo, schemaGetter := createRepo(t)
+	defer repo.Shutdown(context.Background())
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	alice := &models.Object{
+		ID:    strfmt.UUID(uuid.New().String()),
+		Class: class.Class,
+		Properties: map[string]interface{}{
+			"name": "alice",
+			"address": map[string]interface{}{
+				"city": "Berlin",
+				"zip":  float64(10115),
+				"note": "second floor",
+			},
+			"pets": []interface{}{
+				map[string]interface{}{"kind": "cat", "age": 3.5},
+				map[string]interface{}{"kind": "dog", "age": 7.0},
+			},
+		},
+	}
+	bob := &models.Object{
+		ID:    strfmt.UUID(uuid.New().String()),
+		Class: class.Class,
+		Properties: map[string]interface{}{
+			"name": "bob",
+			"address": map[string]interface{}{
+				"city": "Amsterdam",
+				"zip":  float64(1011),
+			},
+			"pets": []interface{}{
+				map[string]interface{}{"kind": "cat", "age": 12.0},
+			},
+		},
+	}
+	carol := &models.Object{
+		ID:    strfmt.UUID(uuid.New().String()),
+		Class: class.Class,
+		Properties: map[string]interface{}{
+			"name": "carol",
+		},
+	}
+	for _, obj := range []*models.Object{alice, bob, carol} {
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1}, nil))
+	}
+
+	filterBy := func(path string, operator filters.Operator, value interface{},
+		dataType schema.DataType,
+	) ([]strfmt.UUID, error) {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: operator,
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: schema.PropertyName(path),
+				},
+				Value: &filters.Value{Value: value, Type: dataType},
+			}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids, nil
+	}
+
+	t.Run("text in object", func(t *testing.T) {
+		ids, err := filterBy("address.city", filters.OperatorEqual, "Berlin", schema.DataTypeText)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{alice.ID}, ids)
+	})
+
+	t.Run("int in object", func(t *testing.T) {
+		ids, err := filterBy("address.zip", filters.OperatorLessThan, 5000, schema.DataTypeInt)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{bob.ID}, ids)
+	})
+
+	t.Run("text in array of objects", func(t *testing.T) {
+		ids, err := filterBy("pets.kind", filters.OperatorEqual, "cat", schema.DataTypeText)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{alice.ID, bob.ID}, ids)
+
+		ids, err = filterBy("pets.kind", filters.OperatorEqual, "dog", schema.DataTypeText)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{alice.ID}, ids)
+	})
+
+	t.Run("number in array of objects", func(t *testing.T) {
+		ids, err := filterBy("pets.age", filters.OperatorGreaterThan, 10.0, schema.DataTypeNumber)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{bob.ID}, ids)
+	})
+
+	t.Run("nested property without index", func(t *testing.T) {
+		_, err := filterBy("address.note", filters.OperatorEqual, "second floor", schema.DataTypeText)
+		require.NotNil(t, err)
+	})
+
+	t.Run("nested property which does not exist", func(t *testing.T) {
+		_, err := filterBy("address.street", filters.OperatorEqual, "main", schema.DataTypeText)
+		require.NotNil(t, err)
+	})
+
+	t.Run("updating an object updates its nested properties", func(t *testing.T) {
+		bob.Properties.(map[string]interface{})["address"] = map[string]interface{}{
+			"city": "Berlin",
+			"zip":  float64(10117),
+		}
+		require.Nil(t, repo.PutObject(context.Background(), bob, []float32{1}, nil))
+
+		ids, err := filterBy("address.city", filters.OperatorEqual, "Berlin", schema.DataTypeText)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{alice.ID, bob.ID}, ids)
+
+		ids, err = filterBy("address.city", filters.OperatorEqual, "Amsterdam", schema.DataTypeText)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("nested values are returned as stored", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), alice.ID,
+			search.SelectProperties{}, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		props := res.Schema.(map[string]interface{})
+		assert.Equal(t, alice.Properties.(map[string]interface{})["address"], props["address"])
+		assert.Equal(t, alice.Properties.(map[string]interface{})["pets"], props["pets"])
+	})
+}
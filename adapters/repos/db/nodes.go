@@ -129,9 +129,14 @@ func (i *Index) getShardsNodeStatus(status *[]*models.NodeShardStatus) (totalCou
 	i.ForEachShard(func(name string, shard *Shard) error {
 		objectCount := int64(shard.objectCount())
 		shardStatus := &models.NodeShardStatus{
-			Name:        name,
-			Class:       shard.index.Config.ClassName.String(),
-			ObjectCount: objectCount,
+			Name:                 name,
+			Class:                shard.index.Config.ClassName.String(),
+			ObjectCount:          objectCount,
+			VectorIndexingStatus: IndexingStatusReady,
+		}
+		if queue, ok := shard.vectorIndexQueue(); ok {
+			shardStatus.VectorQueueLength = queue.Size()
+			shardStatus.VectorIndexingStatus = queue.Status()
 		}
 		totalCount += objectCount
 		*status = append(*status, shardStatus)
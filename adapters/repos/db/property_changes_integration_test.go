@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestPropertyChangesJourney(t *testing.T) {
+	dirName := t.TempDir()
+	logger := logrus.New()
+	className := "PropertyChangesTest"
+	id := strfmt.UUID("c5b5e1b4-6b2a-4b7f-9a51-6f4a56a5d1a2")
+
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+			{Name: "color", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+			{Name: "size", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		shardState: singleShardState(),
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+	}
+
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+	repo := newRepo()
+	migrator := NewMigrator(repo, logger)
+
+	getShard := func() *Shard {
+		var shard *Shard
+		repo.GetIndex(schema.ClassName(className)).ForEachShard(func(_ string, s *Shard) error {
+			shard = s
+			return nil
+		})
+		return shard
+	}
+	getProps := func(t *testing.T) map[string]interface{} {
+		res, err := repo.ObjectByID(context.Background(), id, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		return res.Schema.(map[string]interface{})
+	}
+	searchSize := func(t *testing.T, propName string, value int) int {
+		res, err := repo.ObjectSearch(context.Background(), 0, 10, &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: schema.ClassName(className), Property: schema.PropertyName(propName)},
+				Value:    &filters.Value{Type: schema.DataTypeInt, Value: value},
+			},
+		}, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		return len(res)
+	}
+
+	t.Run("import an object", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:                 id,
+			Class:              className,
+			LastUpdateTimeUnix: time.Now().Add(-time.Minute).UnixMilli(),
+			Properties: map[string]interface{}{
+				"name":  "car",
+				"color": "red",
+				"size":  int64(3),
+			},
+		}, []float32{1, 2, 3}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 1, searchSize(t, "size", 3))
+	})
+
+	t.Run("delete a property", func(t *testing.T) {
+		require.Nil(t, migrator.DropProperty(context.Background(), className, "color",
+			time.Now().UnixMilli()))
+		class.Properties = []*models.Property{class.Properties[0], class.Properties[2]}
+
+		assert.Nil(t, getShard().store.Bucket(helpers.BucketFromPropNameLSM("color")))
+		assert.NotContains(t, getProps(t), "color")
+	})
+
+	t.Run("rename a property", func(t *testing.T) {
+		require.Nil(t, migrator.RenameProperty(context.Background(), className, "size", "weight",
+			time.Now().UnixMilli()))
+		class.Properties = []*models.Property{class.Properties[0], {Name: "weight", DataType: schema.DataTypeInt.PropString()}}
+
+		shard := getShard()
+		assert.Nil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM("size")))
+		assert.NotNil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM("weight")))
+
+		props := getProps(t)
+		assert.NotContains(t, props, "size")
+		assert.Equal(t, float64(3), props["weight"])
+		assert.Equal(t, 1, searchSize(t, "weight", 3))
+	})
+
+	t.Run("the stored objects are cleaned up in the background", func(t *testing.T) {
+		shard := getShard()
+		assert.Eventually(t, func() bool {
+			shard.propertyChangesLock.RLock()
+			defer shard.propertyChangesLock.RUnlock()
+			return len(shard.propertyChanges) == 0
+		}, 10*time.Second, 10*time.Millisecond)
+
+		idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+		require.Nil(t, err)
+		data, err := shard.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+		require.Nil(t, err)
+		obj, err := storobj.FromBinary(data)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"name": "car", "weight": float64(3)},
+			obj.Properties())
+	})
+
+	t.Run("merging doesn't bring back deleted values", func(t *testing.T) {
+		err := repo.Merge(context.Background(), objects.MergeDocument{
+			Class:           className,
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"name": "truck"},
+			UpdateTime:      time.Now().UnixMilli(),
+		}, nil, "")
+		require.Nil(t, err)
+
+		props := getProps(t)
+		assert.Equal(t, "truck", props["name"])
+		assert.NotContains(t, props, "color")
+		assert.Equal(t, 1, searchSize(t, "weight", 3))
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo = newRepo()
+
+		_, err := os.Stat(path.Join(getShard().DBPathLSM(), helpers.BucketFromPropNameLSM("size")))
+		assert.True(t, os.IsNotExist(err))
+
+		props := getProps(t)
+		assert.Equal(t, "truck", props["name"])
+		assert.Equal(t, float64(3), props["weight"])
+		assert.Equal(t, 1, searchSize(t, "weight", 3))
+	})
+
+	require.Nil(t, repo.Shutdown(context.Background()))
+}
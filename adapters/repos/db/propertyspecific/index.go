@@ -39,6 +39,23 @@ func (i Indices) ByProp(propName string) (Index, bool) {
 	return index, ok
 }
 
+// Drop removes the property-specific index of a single prop, if it exists
+func (i Indices) Drop(ctx context.Context, propName string) error {
+	index, ok := i[propName]
+	if !ok {
+		return nil
+	}
+
+	if index.GeoIndex != nil {
+		if err := index.GeoIndex.Drop(ctx); err != nil {
+			return errors.Wrapf(err, "drop property %s", propName)
+		}
+	}
+
+	delete(i, propName)
+	return nil
+}
+
 func (i Indices) DropAll(ctx context.Context) error {
 	for propName, index := range i {
 		if index.Type != schema.DataTypeGeoCoordinates {
@@ -25,7 +25,10 @@ import (
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	multivectorent "github.com/weaviate/weaviate/entities/vectorindex/multivector"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
@@ -288,21 +291,41 @@ func (s *Shard) reinit(ctx context.Context) error {
 		return fmt.Errorf("shutdown shard: %w", err)
 	}
 
-	hnswUserConfig, ok := s.index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return fmt.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
-			s.index.vectorIndexUserConfig)
-	}
+	switch vectorIndexUserConfig := s.index.vectorIndexUserConfig.(type) {
+	case hnswent.UserConfig:
+		if vectorIndexUserConfig.Skip {
+			s.vectorIndex = noop.NewIndex()
+		} else {
+			if err := s.initVectorIndex(ctx, vectorIndexUserConfig); err != nil {
+				return fmt.Errorf("init vector index: %w", err)
+			}
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
+			defer s.vectorIndex.PostStartup()
+		}
+	case multivectorent.UserConfig:
+		s.initMultiVectorIndex(vectorIndexUserConfig)
+		defer s.vectorIndex.PostStartup()
+	case flatent.UserConfig:
+		if err := s.initFlatVectorIndex(vectorIndexUserConfig); err != nil {
 			return fmt.Errorf("init vector index: %w", err)
 		}
 
 		defer s.vectorIndex.PostStartup()
+	case dynament.UserConfig:
+		if err := s.initDynamicVectorIndex(vectorIndexUserConfig); err != nil {
+			return fmt.Errorf("init vector index: %w", err)
+		}
+
+		defer s.vectorIndex.PostStartup()
+	default:
+		return fmt.Errorf("vector index: unsupported config type: %T",
+			s.index.vectorIndexUserConfig)
+	}
+
+	if err := s.initTargetVectors(); err != nil {
+		return fmt.Errorf("init named vector indexes: %w", err)
 	}
+	defer s.targetVectorsPostStartup()
 
 	if err := s.initNonVector(ctx, nil); err != nil {
 		return fmt.Errorf("init non-vector: %w", err)
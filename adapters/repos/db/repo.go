@@ -133,6 +133,9 @@ type Config struct {
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
 	TrackVectorDimensions     bool
+	AsyncIndexing             bool
+	TombstoneCleanup          config.TombstoneCleanup
+	HNSWSnapshots             config.HNSWSnapshots
 	ServerVersion             string
 	GitHash                   string
 }
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// BeginRevectorize rejects writes to the class from now on and prepares all
+// of its shards to collect new vectors. Calling it again for a class which is
+// already being revectorized resumes the revectorization.
+func (db *DB) BeginRevectorize(ctx context.Context, class string) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("class %s not found", class)
+	}
+
+	return index.beginRevectorize(ctx)
+}
+
+// PutRevectorized sets the new vector of an object of a class which is being
+// revectorized. The vector is not visible to searches until the
+// revectorization is finished.
+func (db *DB) PutRevectorized(ctx context.Context, class string,
+	id strfmt.UUID, vector []float32,
+) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("class %s not found", class)
+	}
+
+	return index.putRevectorized(ctx, id, vector)
+}
+
+// FinishRevectorize swaps in the new vectors and the vector index built from
+// them and accepts writes to the class again
+func (db *DB) FinishRevectorize(ctx context.Context, class string) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("class %s not found", class)
+	}
+
+	return index.finishRevectorize(ctx)
+}
+
+// revectorizeShards returns all shards of the index. A revectorization
+// rewrites the shards in place, which is only supported if all of them are
+// hosted on this node and not replicated.
+func (i *Index) revectorizeShards() ([]*Shard, error) {
+	if i.partitioningEnabled {
+		return nil, errors.Errorf("class %s has multi-tenancy enabled, which is not supported by revectorization",
+			i.Config.ClassName)
+	}
+	if i.replicationEnabled() {
+		return nil, errors.Errorf("class %s is replicated, which is not supported by revectorization",
+			i.Config.ClassName)
+	}
+
+	shardState := i.getSchema.CopyShardingState(i.Config.ClassName.String())
+	shardNames := shardState.AllPhysicalShards()
+	shards := make([]*Shard, 0, len(shardNames))
+	for _, shardName := range shardNames {
+		if !shardState.IsLocalShard(shardName) {
+			return nil, errors.Errorf("shard %s of class %s is hosted on another node, "+
+				"which is not supported by revectorization", shardName, i.Config.ClassName)
+		}
+		shard := i.localShard(shardName)
+		if shard == nil {
+			return nil, errors.Errorf("shard %s does not exist", shardName)
+		}
+		shards = append(shards, shard)
+	}
+
+	return shards, nil
+}
+
+func (i *Index) beginRevectorize(ctx context.Context) error {
+	shards, err := i.revectorizeShards()
+	if err != nil {
+		return err
+	}
+
+	for _, shard := range shards {
+		if err := shard.beginRevectorize(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) putRevectorized(ctx context.Context, id strfmt.UUID,
+	vector []float32,
+) error {
+	shardName, err := i.determineObjectShard(id, "")
+	if err != nil {
+		return err
+	}
+
+	shard := i.localShard(shardName)
+	if shard == nil {
+		return errors.Errorf("shard %s does not exist locally", shardName)
+	}
+
+	return shard.putRevectorized(ctx, id, vector)
+}
+
+func (i *Index) finishRevectorize(ctx context.Context) error {
+	shards, err := i.revectorizeShards()
+	if err != nil {
+		return err
+	}
+
+	for _, shard := range shards {
+		if err := shard.finishRevectorize(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -111,7 +111,7 @@ func (db *DB) VectorSearch(ctx context.Context,
 
 	targetDist := extractDistanceFromParams(params)
 	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector,
-		targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
+		params.TargetVector, params.VectorIndexParams, targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
 		params.AdditionalProperties, params.Tenant)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
@@ -143,8 +143,8 @@ func extractDistanceFromParams(params dto.GetParams) float32 {
 // Class VectorSearch method fit this need. Later on, other use cases presented the need
 // for the raw storage objects, such as hybrid search.
 func (db *DB) DenseObjectSearch(ctx context.Context, class string, vector []float32,
-	offset int, limit int, filters *filters.LocalFilter, addl additional.Properties,
-	tenant string,
+	targetVector string, indexParams *searchparams.VectorIndex, offset int, limit int,
+	filters *filters.LocalFilter, addl additional.Properties, tenant string,
 ) ([]*storobj.Object, []float32, error) {
 	totalLimit := offset + limit
 
@@ -155,7 +155,7 @@ func (db *DB) DenseObjectSearch(ctx context.Context, class string, vector []floa
 
 	// TODO: groupBy think of this
 	objs, dist, err := index.objectVectorSearch(
-		ctx, vector, 0, totalLimit, filters, nil, nil, addl, tenant)
+		ctx, vector, targetVector, indexParams, 0, totalLimit, filters, nil, nil, addl, tenant)
 	if err != nil {
 		return nil, nil, fmt.Errorf("search index %s: %w", index.ID(), err)
 	}
@@ -180,7 +180,7 @@ func (db *DB) CrossClassVectorSearch(ctx context.Context, vector []float32, offs
 			defer wg.Done()
 
 			objs, dist, err := index.objectVectorSearch(
-				ctx, vector, 0, totalLimit, filters, nil, nil, additional.Properties{}, "")
+				ctx, vector, "", nil, 0, totalLimit, filters, nil, nil, additional.Properties{}, "")
 			if err != nil {
 				mutex.Lock()
 				searchErrors = append(searchErrors, errors.Wrapf(err, "search index %s", index.ID()))
@@ -17,6 +17,7 @@ import (
 	"os"
 	"path"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,15 +28,21 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	multivectorent "github.com/weaviate/weaviate/entities/vectorindex/multivector"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/sync/errgroup"
 )
@@ -51,6 +58,7 @@ type Shard struct {
 	store           *lsmkv.Store
 	counter         *indexcounter.Counter
 	vectorIndex     VectorIndex
+	vectorIndexes   map[string]VectorIndex // one per named vector of the class
 	metrics         *Metrics
 	promMetrics     *monitoring.PrometheusMetrics
 	propertyIndices propertyspecific.Indices
@@ -82,6 +90,20 @@ type Shard struct {
 
 	vectorCycles   *hnsw.MaintenanceCycles
 	geoPropsCycles *hnsw.MaintenanceCycles
+
+	// set while the vectors of all objects are replaced, see
+	// shard_revectorize.go
+	revectorizing    atomic.Bool
+	revectorizeIndex VectorIndex
+	revectorizeLock  sync.Mutex
+
+	// deleted and renamed properties which are still present in some of the
+	// stored objects, see shard_property_changes.go
+	propertyChanges       []propertyChange
+	propertyChangesSeq    uint64
+	propertyChangesLock   sync.RWMutex
+	propertyChangesCancel context.CancelFunc
+	propertyChangesWg     sync.WaitGroup
 }
 
 func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
@@ -107,22 +129,47 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 
 	defer s.metrics.ShardStartup(before)
 
-	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return nil, errors.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
-			index.vectorIndexUserConfig)
-	}
+	switch vectorIndexUserConfig := index.vectorIndexUserConfig.(type) {
+	case hnswent.UserConfig:
+		if vectorIndexUserConfig.Skip {
+			s.vectorIndex = noop.NewIndex()
+		} else {
+			if err := s.initVectorIndex(ctx, vectorIndexUserConfig); err != nil {
+				return nil, fmt.Errorf("init vector index: %w", err)
+			}
+
+			defer s.vectorIndex.PostStartup()
+		}
+	case multivectorent.UserConfig:
+		s.initMultiVectorIndex(vectorIndexUserConfig)
+
+		// the vectors are read from the object store, which is only
+		// initialized below
+		defer s.vectorIndex.PostStartup()
+	case flatent.UserConfig:
+		if err := s.initFlatVectorIndex(vectorIndexUserConfig); err != nil {
+			return nil, fmt.Errorf("init vector index: %w", err)
+		}
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
+		defer s.vectorIndex.PostStartup()
+	case dynament.UserConfig:
+		if err := s.initDynamicVectorIndex(vectorIndexUserConfig); err != nil {
 			return nil, fmt.Errorf("init vector index: %w", err)
 		}
 
 		defer s.vectorIndex.PostStartup()
+	default:
+		return nil, errors.Errorf("vector index: unsupported config type: %T",
+			index.vectorIndexUserConfig)
 	}
 
+	if err := s.initTargetVectors(); err != nil {
+		return nil, fmt.Errorf("init named vector indexes: %w", err)
+	}
+	// like the main vector index, the named vector indexes can only be
+	// started up once the object store is initialized below
+	defer s.targetVectorsPostStartup()
+
 	if err := s.initNonVector(ctx, class); err != nil {
 		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
@@ -133,22 +180,59 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 func (s *Shard) initVectorIndex(
 	ctx context.Context, hnswUserConfig hnswent.UserConfig,
 ) error {
-	var distProv distancer.Provider
+	vi, err := s.newHNSWIndex(hnswUserConfig)
+	if err != nil {
+		return err
+	}
+	s.vectorIndex = vi
 
-	switch hnswUserConfig.Distance {
-	case "", hnswent.DistanceCosine:
-		distProv = distancer.NewCosineDistanceProvider()
-	case hnswent.DistanceDot:
-		distProv = distancer.NewDotProductProvider()
-	case hnswent.DistanceL2Squared:
-		distProv = distancer.NewL2SquaredProvider()
-	case hnswent.DistanceManhattan:
-		distProv = distancer.NewManhattanProvider()
-	case hnswent.DistanceHamming:
-		distProv = distancer.NewHammingProvider()
-	default:
-		return errors.Errorf("unrecognized distance metric %q,"+
-			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", hnswUserConfig.Distance)
+	return s.initIndexQueue(hnswUserConfig.Distance)
+}
+
+// initIndexQueue puts a queue in front of the vector index if async indexing
+// is enabled, so that imports don't wait for the vector index
+func (s *Shard) initIndexQueue(distance string) error {
+	if !s.index.Config.AsyncIndexing {
+		return nil
+	}
+
+	distProv, err := distanceProvider(distance)
+	if err != nil {
+		return err
+	}
+
+	s.vectorIndex = NewIndexQueue(s.ID(), s.index.Config.RootPath, s.vectorIndex,
+		distProv, s.index.logger, s.vectorByIndexID,
+		func() uint64 { return s.counter.Get() })
+
+	return nil
+}
+
+// vectorIndexQueue returns the queue of the vector index, if there is one
+func (s *Shard) vectorIndexQueue() (*IndexQueue, bool) {
+	q, ok := s.vectorIndex.(*IndexQueue)
+	return q, ok
+}
+
+func (s *Shard) newHNSWIndex(hnswUserConfig hnswent.UserConfig) (VectorIndex, error) {
+	return s.newHNSWIndexFor(s.ID(), hnswUserConfig, s.vectorByIndexID,
+		s.readVectorByIndexIDIntoSlice)
+}
+
+// newHNSWIndexFor creates an hnsw index with the given id, which reads the
+// vectors using the given thunks. This allows the same shard to host indexes
+// for the class vector as well as for each named vector.
+func (s *Shard) newHNSWIndexFor(id string, hnswUserConfig hnswent.UserConfig,
+	vectorForID hnsw.VectorForID, tempVectorForID hnsw.TempVectorForID,
+) (VectorIndex, error) {
+	distProv, err := distanceProvider(hnswUserConfig.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	cleanupInterval := time.Duration(hnswUserConfig.CleanupIntervalSeconds) * time.Second
+	if seconds := s.index.Config.TombstoneCleanup.IntervalSeconds; seconds > 0 {
+		cleanupInterval = time.Duration(seconds) * time.Second
 	}
 
 	s.vectorCycles.Init(
@@ -169,30 +253,117 @@ func (s *Shard) initVectorIndex(
 		// update: switched to dynamic intervals with values between 500ms and 10s
 		// introduced to address https://github.com/weaviate/weaviate/issues/2783
 		cyclemanager.HnswCommitLoggerCycleTicker(),
-		cyclemanager.NewFixedIntervalTicker(time.Duration(hnswUserConfig.CleanupIntervalSeconds)*time.Second))
+		cyclemanager.NewFixedIntervalTicker(cleanupInterval))
 
 	vi, err := hnsw.New(hnsw.Config{
 		Logger:               s.index.logger,
 		RootPath:             s.index.Config.RootPath,
-		ID:                   s.ID(),
+		ID:                   id,
 		ShardName:            s.name,
 		ClassName:            s.index.Config.ClassName.String(),
 		PrometheusMetrics:    s.promMetrics,
-		VectorForIDThunk:     s.vectorByIndexID,
-		TempVectorForIDThunk: s.readVectorByIndexIDIntoSlice,
+		VectorForIDThunk:     vectorForID,
+		TempVectorForIDThunk: tempVectorForID,
 		DistanceProvider:     distProv,
 		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), s.index.logger, s.vectorCycles.CommitLogMaintenance())
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, id, s.index.logger,
+				s.vectorCycles.CommitLogMaintenance(),
+				hnsw.WithSnapshotsEnabled(s.index.Config.HNSWSnapshots.Enabled),
+				hnsw.WithSnapshotMinDeltaSizePercentage(s.index.Config.HNSWSnapshots.MinDeltaSizePercentage))
 		},
+		TombstoneCleanupConcurrency: s.index.Config.TombstoneCleanup.Concurrency,
+		TombstoneCleanupMaxPerCycle: s.index.Config.TombstoneCleanup.MaxPerCycle,
 	}, hnswUserConfig, s.vectorCycles.TombstoneCleanup())
 	if err != nil {
-		return errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+		return nil, errors.Wrapf(err, "init shard %q: hnsw index %q", s.ID(), id)
+	}
+
+	return vi, nil
+}
+
+func distanceProvider(distance string) (distancer.Provider, error) {
+	switch distance {
+	case "", hnswent.DistanceCosine:
+		return distancer.NewCosineDistanceProvider(), nil
+	case hnswent.DistanceDot:
+		return distancer.NewDotProductProvider(), nil
+	case hnswent.DistanceL2Squared:
+		return distancer.NewL2SquaredProvider(), nil
+	case hnswent.DistanceManhattan:
+		return distancer.NewManhattanProvider(), nil
+	case hnswent.DistanceHamming:
+		return distancer.NewHammingProvider(), nil
+	default:
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", distance)
+	}
+}
+
+func (s *Shard) initFlatVectorIndex(userConfig flatent.UserConfig) error {
+	vi, err := s.newFlatIndex(userConfig)
+	if err != nil {
+		return err
 	}
 	s.vectorIndex = vi
 
 	return nil
 }
 
+func (s *Shard) newFlatIndex(userConfig flatent.UserConfig) (VectorIndex, error) {
+	return s.newFlatIndexFor(s.ID(), userConfig, s.vectorByIndexID, s.iterateVectors)
+}
+
+// newFlatIndexFor creates a flat index with the given id, which reads the
+// vectors using the given functions
+func (s *Shard) newFlatIndexFor(id string, userConfig flatent.UserConfig,
+	vectorForID flat.VectorForID, iterateVectors flat.IterateVectorsFn,
+) (VectorIndex, error) {
+	distProv, err := distanceProvider(userConfig.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	vi, err := flat.New(flat.Config{
+		ID:               id,
+		Logger:           s.index.logger,
+		DistanceProvider: distProv,
+		VectorForIDThunk: vectorForID,
+		IterateVectors:   iterateVectors,
+	}, userConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: flat index %q", s.ID(), id)
+	}
+
+	return vi, nil
+}
+
+// initDynamicVectorIndex starts the shard with a flat index, which is
+// upgraded to hnsw once the shard holds more objects than the threshold
+func (s *Shard) initDynamicVectorIndex(userConfig dynament.UserConfig) error {
+	vi, err := dynamic.New(dynamic.Config{
+		ID:       s.ID(),
+		RootPath: s.index.Config.RootPath,
+		Logger:   s.index.logger,
+		MakeFlat: func(uc dynament.UserConfig) (dynamic.VectorIndex, error) {
+			return s.newFlatIndex(uc.Flat)
+		},
+		MakeHNSW: func(uc dynament.UserConfig) (dynamic.VectorIndex, error) {
+			return s.newHNSWIndex(uc.HNSW)
+		},
+		IterateVectors: s.iterateVectors,
+	}, userConfig)
+	if err != nil {
+		return errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
+	}
+	s.vectorIndex = vi
+
+	return s.initIndexQueue(userConfig.Distance)
+}
+
+func (s *Shard) initMultiVectorIndex(userConfig multivectorent.UserConfig) {
+	s.vectorIndex = multivector.New(userConfig, s.iterateVectors, s.index.logger)
+}
+
 func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 	err := s.initLSMStore(ctx)
 	if err != nil {
@@ -220,12 +391,22 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 	}
 	s.propLengths = propLengths
 
+	if err := s.initPropertyChanges(ctx, class); err != nil {
+		return errors.Wrapf(err, "init shard %q: property changes", s.ID())
+	}
+
 	if err := s.initProperties(class); err != nil {
 		return errors.Wrapf(err, "init shard %q: init per property indices", s.ID())
 	}
 
 	s.initDimensionTracking()
 
+	if err := s.initRevectorize(); err != nil {
+		return errors.Wrapf(err, "init shard %q: revectorization", s.ID())
+	}
+
+	s.startPropertyChangesCleanup()
+
 	return nil
 }
 
@@ -277,6 +458,7 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 
 func (s *Shard) drop() error {
 	s.replicationMap.clear()
+	s.stopPropertyChangesCleanup()
 
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
@@ -324,6 +506,16 @@ func (s *Shard) drop() error {
 	if err != nil {
 		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
 	}
+	for target, vi := range s.vectorIndexes {
+		if err := vi.Drop(ctx); err != nil {
+			return errors.Wrapf(err, "remove vector index of %q at %s", target, s.DBPathLSM())
+		}
+	}
+	if s.revectorizeIndex != nil {
+		if err := s.revectorizeIndex.Drop(ctx); err != nil {
+			return errors.Wrapf(err, "remove revectorize vector index at %s", s.DBPathLSM())
+		}
+	}
 
 	// delete indexcount
 	err = s.propLengths.Drop()
@@ -415,6 +607,14 @@ func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 }
 
 func (s *Shard) createPropertyIndex(ctx context.Context, prop *models.Property, eg *errgroup.Group) {
+	if schema.IsNestedDataType(prop.DataType) {
+		// objects are not indexed themselves, but their nested properties are
+		for _, nestedProp := range schema.FlattenNestedProperties(prop) {
+			s.createPropertyIndex(ctx, nestedProp, eg)
+		}
+		return
+	}
+
 	if !inverted.HasInvertedIndex(prop) {
 		return
 	}
@@ -424,7 +624,7 @@ func (s *Shard) createPropertyIndex(ctx context.Context, prop *models.Property,
 			return errors.Wrapf(err, "create property '%s' value index on shard '%s'", prop.Name, s.ID())
 		}
 
-		if s.index.invertedIndexConfig.IndexNullState {
+		if inverted.HasNullStateIndex(prop, s.index.invertedIndexConfig.IndexNullState) {
 			eg.Go(func() error {
 				if err := s.createPropertyNullIndex(ctx, prop); err != nil {
 					return errors.Wrapf(err, "create property '%s' null index on shard '%s'", prop.Name, s.ID())
@@ -433,7 +633,7 @@ func (s *Shard) createPropertyIndex(ctx context.Context, prop *models.Property,
 			})
 		}
 
-		if s.index.invertedIndexConfig.IndexPropertyLength {
+		if inverted.HasPropertyLengthIndex(prop, s.index.invertedIndexConfig.IndexPropertyLength) {
 			eg.Go(func() error {
 				if err := s.createPropertyLengthIndex(ctx, prop); err != nil {
 					return errors.Wrapf(err, "create property '%s' length index on shard '%s'", prop.Name, s.ID())
@@ -492,6 +692,33 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		}
 	}
 
+	if inverted.HasReversedIndex(prop) {
+		if err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketReversedFromPropNameLSM(prop.Name),
+			append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))...,
+		); err != nil {
+			return err
+		}
+	}
+
+	if inverted.HasRangeIndex(prop) {
+		if err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketRangeableFromPropNameLSM(prop.Name),
+			append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))...,
+		); err != nil {
+			return err
+		}
+	}
+
+	if inverted.HasPositionIndex(prop) {
+		if err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketPositionsFromPropNameLSM(prop.Name),
+			append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyMapCollection))...,
+		); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -539,7 +766,41 @@ func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 	})
 }
 
+func (s *Shard) updateTargetVectorIndexConfigs(ctx context.Context,
+	updated map[string]schema.VectorIndexConfig,
+) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	for target, cfg := range updated {
+		vi, err := s.targetVectorIndex(target)
+		if err != nil {
+			return err
+		}
+
+		// the updates of the named vectors are applied one after another, the
+		// shard is marked ready once the last one completed
+		if err := s.updateStatus(storagestate.StatusReadOnly.String()); err != nil {
+			return fmt.Errorf("attempt to mark read-only: %w", err)
+		}
+		done := make(chan struct{})
+		if err := vi.UpdateUserConfig(cfg, func() { close(done) }); err != nil {
+			return errors.Wrapf(err, "named vector %q", target)
+		}
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return s.updateStatus(storagestate.StatusReady.String())
+}
+
 func (s *Shard) shutdown(ctx context.Context) error {
+	s.stopPropertyChangesCleanup()
+
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
 		// that's why we are trying to stop it only in this case
@@ -555,7 +816,7 @@ func (s *Shard) shutdown(ctx context.Context) error {
 	// 'RemoveTombstone' entry is not picked up on restarts
 	// resulting in perpetually attempting to remove a tombstone
 	// which doesn't actually exist anymore
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush vector index commitlog")
 	}
 
@@ -563,6 +824,22 @@ func (s *Shard) shutdown(ctx context.Context) error {
 		return errors.Wrap(err, "shut down vector index")
 	}
 
+	for target, vi := range s.vectorIndexes {
+		if err := vi.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "shut down vector index of %q", target)
+		}
+	}
+
+	if s.revectorizeIndex != nil {
+		if err := s.revectorizeIndex.Flush(); err != nil {
+			return errors.Wrap(err, "flush revectorize vector index commitlog")
+		}
+		if err := s.revectorizeIndex.Shutdown(ctx); err != nil {
+			return errors.Wrap(err, "shut down revectorize vector index")
+		}
+		s.revectorizeIndex = nil
+	}
+
 	if err := s.vectorCycles.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "shutdown vector cycles")
 	}
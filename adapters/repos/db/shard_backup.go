@@ -44,6 +44,11 @@ func (s *Shard) beginBackup(ctx context.Context) (err error) {
 	if err = s.vectorIndex.SwitchCommitLogs(ctx); err != nil {
 		return errors.Wrap(err, "switch commit logs")
 	}
+	for target, vi := range s.vectorIndexes {
+		if err = vi.SwitchCommitLogs(ctx); err != nil {
+			return errors.Wrapf(err, "switch commit logs of %q", target)
+		}
+	}
 	return nil
 }
 
@@ -61,6 +66,13 @@ func (s *Shard) listBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 		return err
 	}
 	ret.Files = append(ret.Files, files2...)
+	for _, vi := range s.vectorIndexes {
+		files, err := vi.ListFiles(ctx)
+		if err != nil {
+			return err
+		}
+		ret.Files = append(ret.Files, files...)
+	}
 	return nil
 }
 
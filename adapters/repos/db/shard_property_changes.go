@@ -0,0 +1,542 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// Deleting or renaming a property takes effect on the inverted indexes right
+// away, their buckets are dropped or renamed. The values stored in the objects
+// are changed by a background job, which rewrites every object of the shard.
+// Until it is done, the changes are applied to the objects whenever they are
+// read, so a deleted property is never returned and a renamed property is
+// always returned under its new name.
+//
+// Each change is persisted in a bucket keyed by a sequence number, so that
+// the job is resumed after a restart. A change only applies to objects which
+// were last updated before it was made, objects written later already use the
+// current schema. This also keeps a property which is added again under the
+// name of a deleted one intact.
+
+// propertyChangesBatchSize is the number of objects which are read at once
+// by the cleanup job. The cursor of the objects bucket is closed before the
+// objects are written.
+const propertyChangesBatchSize = 1000
+
+type propertyChange struct {
+	seq uint64
+
+	// From is the name of the changed property
+	From string `json:"from"`
+	// To is the new name of a renamed property, it is empty if the property
+	// was deleted
+	To string `json:"to,omitempty"`
+	// Names of the inverted indexes of the property, which includes the
+	// flattened nested properties of objects
+	Names []string `json:"names"`
+	// Before is the time of the change in unix milliseconds
+	Before int64 `json:"before"`
+}
+
+func newPropertyChange(prop *models.Property, newName string, changedAt int64) propertyChange {
+	names := []string{prop.Name}
+	for _, nested := range schema.FlattenNestedProperties(prop) {
+		names = append(names, nested.Name)
+	}
+	return propertyChange{
+		From:   prop.Name,
+		To:     newName,
+		Names:  names,
+		Before: changedAt,
+	}
+}
+
+// renamed returns the name of an inverted index after the change
+func (c propertyChange) renamed(name string) string {
+	return c.To + name[len(c.From):]
+}
+
+// propertyBucketNames lists every bucket which may exist for a property,
+// depending on its data type and index settings
+var propertyBucketNames = []func(string) string{
+	helpers.BucketFromPropNameLSM,
+	helpers.BucketSearchableFromPropNameLSM,
+	helpers.BucketPositionsFromPropNameLSM,
+	helpers.BucketReversedFromPropNameLSM,
+	helpers.BucketRangeableFromPropNameLSM,
+	helpers.BucketFromPropNameMetaCountLSM,
+	helpers.BucketFromPropNameLengthLSM,
+	helpers.BucketFromPropNameNullLSM,
+}
+
+// initPropertyChanges loads the changes which have not been cleaned up
+// before the shard was shut down. Their indexes are changed again in case the
+// shard was shut down in the middle of a change, so it needs to run before the
+// property indexes are loaded. Changes of properties which exist in the
+// schema again are not repeated.
+func (s *Shard) initPropertyChanges(ctx context.Context, class *models.Class) error {
+	_, err := os.Stat(path.Join(s.DBPathLSM(), helpers.PropertyChangesBucketLSM))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "check for property changes")
+	}
+
+	if err := s.createPropertyChangesBucket(ctx); err != nil {
+		return err
+	}
+
+	changes, err := s.loadPropertyChanges()
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		if class != nil && propertyExists(class, change.From) {
+			continue
+		}
+		if err := s.changePropertyIndexes(ctx, change); err != nil {
+			return errors.Wrapf(err, "property %q", change.From)
+		}
+	}
+
+	s.propertyChangesLock.Lock()
+	s.propertyChanges = changes
+	if len(changes) > 0 {
+		s.propertyChangesSeq = changes[len(changes)-1].seq + 1
+	}
+	s.propertyChangesLock.Unlock()
+
+	return nil
+}
+
+func (s *Shard) createPropertyChangesBucket(ctx context.Context) error {
+	err := s.store.CreateOrLoadBucket(ctx, helpers.PropertyChangesBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace))
+	return errors.Wrap(err, "create property changes bucket")
+}
+
+func (s *Shard) loadPropertyChanges() ([]propertyChange, error) {
+	cursor := s.store.Bucket(helpers.PropertyChangesBucketLSM).Cursor()
+	defer cursor.Close()
+
+	var changes []propertyChange
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		var change propertyChange
+		if err := json.Unmarshal(v, &change); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal property change %x", k)
+		}
+		change.seq = binary.BigEndian.Uint64(k)
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func propertyExists(class *models.Class, propName string) bool {
+	for _, prop := range class.Properties {
+		if prop.Name == propName {
+			return true
+		}
+	}
+	return false
+}
+
+// dropProperty removes the indexes of a property and starts to remove its
+// values from the objects last updated before changedAt
+func (s *Shard) dropProperty(ctx context.Context, prop *models.Property,
+	changedAt int64,
+) error {
+	if err := s.addPropertyChange(ctx, newPropertyChange(prop, "", changedAt)); err != nil {
+		return err
+	}
+
+	s.propertyIndicesLock.Lock()
+	err := s.propertyIndices.Drop(ctx, prop.Name)
+	s.propertyIndicesLock.Unlock()
+	if err != nil {
+		return errors.Wrapf(err, "drop property specific index of %q", prop.Name)
+	}
+
+	return nil
+}
+
+// renameProperty renames the indexes of a property and starts to move its
+// values to the new name in the objects last updated before changedAt
+func (s *Shard) renameProperty(ctx context.Context, prop *models.Property,
+	newName string, changedAt int64,
+) error {
+	return s.addPropertyChange(ctx, newPropertyChange(prop, newName, changedAt))
+}
+
+func (s *Shard) addPropertyChange(ctx context.Context, change propertyChange) error {
+	s.propertyChangesLock.Lock()
+	if s.store.Bucket(helpers.PropertyChangesBucketLSM) == nil {
+		if err := s.createPropertyChangesBucket(ctx); err != nil {
+			s.propertyChangesLock.Unlock()
+			return err
+		}
+	}
+
+	value, err := json.Marshal(change)
+	if err != nil {
+		s.propertyChangesLock.Unlock()
+		return errors.Wrap(err, "marshal property change")
+	}
+	change.seq = s.propertyChangesSeq
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, change.seq)
+	if err := s.store.Bucket(helpers.PropertyChangesBucketLSM).Put(key, value); err != nil {
+		s.propertyChangesLock.Unlock()
+		return errors.Wrap(err, "store property change")
+	}
+	s.propertyChangesSeq++
+	s.propertyChanges = append(s.propertyChanges, change)
+	s.propertyChangesLock.Unlock()
+
+	if err := s.changePropertyIndexes(ctx, change); err != nil {
+		return errors.Wrapf(err, "property %q", change.From)
+	}
+
+	s.startPropertyChangesCleanup()
+	return nil
+}
+
+// changePropertyIndexes drops or renames the buckets of a property and its
+// tracked lengths. Every step can be repeated.
+func (s *Shard) changePropertyIndexes(ctx context.Context, change propertyChange) error {
+	for _, name := range change.Names {
+		for _, bucketName := range propertyBucketNames {
+			var err error
+			if change.To == "" {
+				err = s.dropPropertyBucket(ctx, bucketName(name))
+			} else {
+				err = s.renamePropertyBucket(ctx, bucketName(name), bucketName(change.renamed(name)))
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if change.To == "" {
+			s.propLengths.DropProperty(name)
+		} else {
+			s.propLengths.RenameProperty(name, change.renamed(name))
+		}
+	}
+
+	return s.propLengths.Flush(false)
+}
+
+// dropPropertyBucket drops a bucket, which doesn't need to be loaded or to
+// exist at all
+func (s *Shard) dropPropertyBucket(ctx context.Context, bucketName string) error {
+	if s.store.Bucket(bucketName) != nil {
+		return errors.Wrapf(s.store.DropBucket(ctx, bucketName), "drop bucket %q", bucketName)
+	}
+	err := os.RemoveAll(path.Join(s.DBPathLSM(), bucketName))
+	return errors.Wrapf(err, "remove bucket %q", bucketName)
+}
+
+// renamePropertyBucket renames a bucket, which doesn't need to be loaded or to
+// exist at all. A bucket is never renamed onto an existing one.
+func (s *Shard) renamePropertyBucket(ctx context.Context, bucketName, newBucketName string) error {
+	if s.store.Bucket(bucketName) != nil {
+		err := s.store.RenameBucket(ctx, bucketName, newBucketName)
+		return errors.Wrapf(err, "rename bucket %q", bucketName)
+	}
+
+	dir := path.Join(s.DBPathLSM(), bucketName)
+	newDir := path.Join(s.DBPathLSM(), newBucketName)
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "rename bucket %q", bucketName)
+	}
+	if _, err := os.Stat(newDir); err == nil {
+		return nil
+	}
+	return errors.Wrapf(os.Rename(dir, newDir), "rename bucket %q", bucketName)
+}
+
+// applyPropertyChanges removes deleted properties from an object and moves
+// renamed ones to their new name. It returns whether the object changed.
+func (s *Shard) applyPropertyChanges(obj *storobj.Object) bool {
+	if obj == nil {
+		return false
+	}
+
+	s.propertyChangesLock.RLock()
+	defer s.propertyChangesLock.RUnlock()
+
+	if len(s.propertyChanges) == 0 {
+		return false
+	}
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok || props == nil {
+		return false
+	}
+
+	changed := false
+	for _, change := range s.propertyChanges {
+		if obj.LastUpdateTimeUnix() > change.Before {
+			continue
+		}
+		value, ok := props[change.From]
+		if !ok {
+			continue
+		}
+		delete(props, change.From)
+		if _, ok := props[change.To]; change.To != "" && !ok {
+			props[change.To] = value
+		}
+		changed = true
+	}
+
+	return changed
+}
+
+func (s *Shard) applyPropertyChangesToAll(objs []*storobj.Object) {
+	for _, obj := range objs {
+		s.applyPropertyChanges(obj)
+	}
+}
+
+// class returns the schema of the shard's class. Objects are unmarshalled
+// with it, so that their property values are typed by the declared data types.
+// Until the cleanup is done renamed properties are stored under their previous
+// name, which is declared with the data type of the renamed property.
+func (s *Shard) class() *models.Class {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	if class == nil {
+		return nil
+	}
+
+	s.propertyChangesLock.RLock()
+	defer s.propertyChangesLock.RUnlock()
+
+	var renamed []*models.Property
+	for i, change := range s.propertyChanges {
+		if change.To == "" || propertyExists(class, change.From) {
+			continue
+		}
+		// the property may have been renamed again since
+		name := change.To
+		for _, later := range s.propertyChanges[i+1:] {
+			if later.From == name {
+				name = later.To
+			}
+		}
+		for _, prop := range class.Properties {
+			if name != "" && prop.Name == name {
+				renamed = append(renamed, &models.Property{
+					Name:     change.From,
+					DataType: prop.DataType,
+				})
+			}
+		}
+	}
+	if len(renamed) == 0 {
+		return class
+	}
+
+	withRenamed := *class
+	withRenamed.Properties = make([]*models.Property, 0, len(class.Properties)+len(renamed))
+	withRenamed.Properties = append(withRenamed.Properties, class.Properties...)
+	withRenamed.Properties = append(withRenamed.Properties, renamed...)
+	return &withRenamed
+}
+
+// startPropertyChangesCleanup starts the cleanup job unless it is running
+// already or there is nothing to clean up
+func (s *Shard) startPropertyChangesCleanup() {
+	s.propertyChangesLock.Lock()
+	defer s.propertyChangesLock.Unlock()
+
+	if s.propertyChangesCancel != nil || len(s.propertyChanges) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.propertyChangesCancel = cancel
+	s.propertyChangesWg.Add(1)
+	go func() {
+		defer s.propertyChangesWg.Done()
+
+		if err := s.cleanupPropertyChanges(ctx); err != nil {
+			s.propertyChangesLock.Lock()
+			s.propertyChangesCancel = nil
+			s.propertyChangesLock.Unlock()
+			cancel()
+
+			if !errors.Is(err, context.Canceled) {
+				s.index.logger.WithField("action", "cleanup_property_changes").
+					WithField("shard", s.name).
+					WithError(err).
+					Error("cleanup of deleted and renamed properties failed")
+			}
+		}
+	}()
+}
+
+// stopPropertyChangesCleanup stops the cleanup job and waits for it to
+// return. It is resumed when the shard is loaded again.
+func (s *Shard) stopPropertyChangesCleanup() {
+	s.propertyChangesLock.Lock()
+	if s.propertyChangesCancel != nil {
+		s.propertyChangesCancel()
+	}
+	s.propertyChangesLock.Unlock()
+
+	s.propertyChangesWg.Wait()
+}
+
+// cleanupPropertyChanges rewrites the objects until all changes have been
+// applied to every object. Changes made in the meantime are picked up by
+// another pass.
+func (s *Shard) cleanupPropertyChanges(ctx context.Context) error {
+	for {
+		s.propertyChangesLock.Lock()
+		if len(s.propertyChanges) == 0 {
+			s.propertyChangesCancel()
+			s.propertyChangesCancel = nil
+			s.propertyChangesLock.Unlock()
+			return nil
+		}
+		last := s.propertyChanges[len(s.propertyChanges)-1].seq
+		s.propertyChangesLock.Unlock()
+
+		if err := s.rewriteChangedObjects(ctx); err != nil {
+			return err
+		}
+		if err := s.removePropertyChanges(last); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Shard) rewriteChangedObjects(ctx context.Context) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+
+	var after []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		ids := nextObjectIDs(bucket, after, propertyChangesBatchSize)
+		for _, id := range ids {
+			if err := s.rewriteChangedObject(bucket, id); err != nil {
+				return errors.Wrapf(err, "rewrite object %x", id)
+			}
+		}
+		if len(ids) < propertyChangesBatchSize {
+			return nil
+		}
+		after = ids[len(ids)-1]
+	}
+}
+
+// nextObjectIDs returns up to limit ids of objects following the given one
+func nextObjectIDs(bucket *lsmkv.Bucket, after []byte, limit int) [][]byte {
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	var k []byte
+	if after == nil {
+		k, _ = cursor.First()
+	} else {
+		k, _ = cursor.Seek(after)
+		if bytes.Equal(k, after) {
+			k, _ = cursor.Next()
+		}
+	}
+
+	ids := make([][]byte, 0, limit)
+	for ; k != nil && len(ids) < limit; k, _ = cursor.Next() {
+		id := make([]byte, len(k))
+		copy(id, k)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// rewriteChangedObject applies the property changes to an object in place.
+// The doc id is kept, the inverted indexes have been changed already.
+func (s *Shard) rewriteChangedObject(bucket *lsmkv.Bucket, id []byte) error {
+	// see comment in shard_write_put.go::putObjectLSM
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(id)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := bucket.Get(id)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return nil
+	}
+
+	obj, err := storobj.FromBinaryWithClass(data, s.class())
+	if err != nil {
+		return errors.Wrap(err, "unmarshal object")
+	}
+	if !s.applyPropertyChanges(obj) {
+		return nil
+	}
+
+	data, err = obj.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal object")
+	}
+	return s.upsertObjectDataLSM(bucket, id, data, obj.DocID())
+}
+
+// removePropertyChanges removes the changes up to the given sequence number,
+// once they have been applied to every object
+func (s *Shard) removePropertyChanges(last uint64) error {
+	s.propertyChangesLock.Lock()
+	defer s.propertyChangesLock.Unlock()
+
+	bucket := s.store.Bucket(helpers.PropertyChangesBucketLSM)
+	remaining := make([]propertyChange, 0, len(s.propertyChanges))
+	for _, change := range s.propertyChanges {
+		if change.seq > last {
+			remaining = append(remaining, change)
+			continue
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, change.seq)
+		if err := bucket.Delete(key); err != nil {
+			return errors.Wrapf(err, "remove property change of %q", change.From)
+		}
+	}
+	s.propertyChanges = remaining
+
+	return nil
+}
@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestApplyPropertyChanges(t *testing.T) {
+	s := &Shard{propertyChanges: []propertyChange{
+		{seq: 0, From: "color", Before: 100},
+		{seq: 1, From: "size", To: "weight", Before: 200},
+		{seq: 2, From: "name", To: "title", Before: 300},
+	}}
+
+	object := func(lastUpdate int64, props map[string]interface{}) *storobj.Object {
+		return &storobj.Object{Object: models.Object{
+			LastUpdateTimeUnix: lastUpdate,
+			Properties:         props,
+		}}
+	}
+
+	t.Run("object older than all changes", func(t *testing.T) {
+		obj := object(50, map[string]interface{}{"color": "red", "size": 3.0, "name": "a"})
+
+		assert.True(t, s.applyPropertyChanges(obj))
+		assert.Equal(t, map[string]interface{}{"weight": 3.0, "title": "a"}, obj.Properties())
+	})
+
+	t.Run("object updated in between changes", func(t *testing.T) {
+		obj := object(250, map[string]interface{}{"color": "red", "weight": 3.0, "name": "a"})
+
+		assert.True(t, s.applyPropertyChanges(obj))
+		assert.Equal(t, map[string]interface{}{"color": "red", "weight": 3.0, "title": "a"},
+			obj.Properties())
+	})
+
+	t.Run("object newer than all changes", func(t *testing.T) {
+		obj := object(350, map[string]interface{}{"color": "red", "title": "a"})
+
+		assert.False(t, s.applyPropertyChanges(obj))
+		assert.Equal(t, map[string]interface{}{"color": "red", "title": "a"}, obj.Properties())
+	})
+
+	t.Run("renamed value doesn't replace an existing one", func(t *testing.T) {
+		obj := object(50, map[string]interface{}{"size": 3.0, "weight": 4.0})
+
+		assert.True(t, s.applyPropertyChanges(obj))
+		assert.Equal(t, map[string]interface{}{"weight": 4.0}, obj.Properties())
+	})
+
+	t.Run("object without properties", func(t *testing.T) {
+		assert.False(t, s.applyPropertyChanges(object(50, nil)))
+	})
+}
+
+func TestPropertyChangeRenamed(t *testing.T) {
+	change := propertyChange{From: "address", To: "location"}
+
+	assert.Equal(t, "location", change.renamed("address"))
+	assert.Equal(t, "location.city", change.renamed("address.city"))
+}
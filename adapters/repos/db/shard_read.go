@@ -51,10 +51,11 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, nil
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinaryWithClass(bytes, s.class())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal object")
 	}
+	s.applyPropertyChanges(obj)
 
 	return obj, nil
 }
@@ -75,6 +76,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 	}
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	class := s.class()
 	for i, id := range ids {
 		bytes, err := bucket.Get(id)
 		if err != nil {
@@ -85,10 +87,11 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 			continue
 		}
 
-		obj, err := storobj.FromBinary(bytes)
+		obj, err := storobj.FromBinaryWithClass(bytes, class)
 		if err != nil {
 			return nil, errors.Wrap(err, "unmarshal kind object")
 		}
+		s.applyPropertyChanges(obj)
 		objects[i] = obj
 	}
 
@@ -135,10 +138,11 @@ func (s *Shard) objectByIndexID(ctx context.Context,
 			"uuid found for docID, but object is nil")
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinaryWithClass(bytes, s.class())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal kind object")
 	}
+	s.applyPropertyChanges(obj)
 
 	return obj, nil
 }
@@ -166,6 +170,32 @@ func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64
 	return storobj.VectorFromBinary(bytes, container.Slice)
 }
 
+// iterateVectors calls fn with the doc id and vector of every object in the
+// shard, so that vector indexes which don't persist the vectors themselves
+// can be built on startup
+func (s *Shard) iterateVectors(fn func(id uint64, vector []float32) error) error {
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	for key, val := cursor.First(); key != nil; key, val = cursor.Next() {
+		docID, err := storobj.DocIDFromBinary(val)
+		if err != nil {
+			return errors.Wrapf(err, "read doc id of object %x", key)
+		}
+
+		vector, err := storobj.VectorFromBinary(val, nil)
+		if err != nil {
+			return errors.Wrapf(err, "read vector of doc id %d", docID)
+		}
+
+		if err := fn(docID, vector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties) ([]*storobj.Object, []float32, error) {
 	if keywordRanking != nil {
 		if v := s.versioner.Version(); v < 2 {
@@ -200,6 +230,7 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		if err != nil {
 			return nil, nil, err
 		}
+		s.applyPropertyChangesToAll(bm25objs)
 
 		return bm25objs, bm25count, nil
 	}
@@ -214,11 +245,13 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
 		s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable).
 		Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName)
+	s.applyPropertyChangesToAll(objs)
 	return objs, nil, err
 }
 
 func (s *Shard) objectVectorSearch(ctx context.Context,
-	searchVector []float32, targetDist float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, indexParams *searchparams.VectorIndex,
+	targetDist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	var (
@@ -238,15 +271,21 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		s.metrics.FilteredVectorFilter(time.Since(beforeFilter))
 	}
 
+	vectorIndex, err := s.targetVectorIndex(targetVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	beforeVector := time.Now()
 	if limit < 0 {
-		ids, dists, err = s.vectorIndex.SearchByVectorDistance(
-			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
+		ids, dists, err = searchByVectorDistance(vectorIndex,
+			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList, indexParams)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search by distance")
 		}
 	} else {
-		ids, dists, err = s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+		ids, dists, err = searchByVectorFiltered(vectorIndex, searchVector, limit, allowList,
+			filterSelectivity(allowList, uint64(s.objectCount())), indexParams)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search")
 		}
@@ -278,10 +317,11 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	beforeObjects := time.Now()
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional)
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional, s.class())
 	if err != nil {
 		return nil, nil, err
 	}
+	s.applyPropertyChangesToAll(objs)
 
 	if filters != nil {
 		s.metrics.FilteredVectorObjects(time.Since(beforeObjects))
@@ -300,7 +340,9 @@ func (s *Shard) objectList(ctx context.Context, limit int,
 			return nil, err
 		}
 		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-		return storobj.ObjectsByDocID(bucket, docIDs, additional)
+		objs, err := storobj.ObjectsByDocID(bucket, docIDs, additional, s.class())
+		s.applyPropertyChangesToAll(objs)
+		return objs, err
 	}
 
 	if cursor == nil {
@@ -333,12 +375,14 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 
 	i := 0
 	out := make([]*storobj.Object, c.Limit)
+	class := s.class()
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
-		obj, err := storobj.FromBinary(val)
+		obj, err := storobj.FromBinaryWithClass(val, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
 		}
+		s.applyPropertyChanges(obj)
 
 		out[i] = obj
 		i++
@@ -451,7 +495,7 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
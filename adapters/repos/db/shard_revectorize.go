@@ -0,0 +1,357 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// A revectorization replaces the vectors of all objects of a shard. The new
+// vectors are collected in a separate bucket and indexed by a separate vector
+// index, so the shard keeps serving searches with its current vectors until
+// all objects are done. Writes are rejected in the meantime, otherwise they
+// would be lost once the new vectors are swapped in.
+//
+// The bucket is keyed by doc id, each value holds the uuid of the object
+// followed by the new vector. Its presence on disk marks the shard as being
+// revectorized, which survives restarts.
+
+// revectorizeIndexID is the id of the vector index which is built during a
+// revectorization, it is used to name the files of the index
+func (s *Shard) revectorizeIndexID() string {
+	return s.ID() + "_revectorize"
+}
+
+// initRevectorize keeps rejecting writes if the shard was shut down in the
+// middle of a revectorization. The revectorization itself needs to be resumed
+// by the caller.
+func (s *Shard) initRevectorize() error {
+	_, err := os.Stat(path.Join(s.DBPathLSM(), helpers.RevectorizedVectorsBucketLSM))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "check for revectorized vectors")
+	}
+
+	s.revectorizing.Store(true)
+	return nil
+}
+
+// beginRevectorize starts to reject writes and creates the vector index for
+// the new vectors. If the shard is already being revectorized, the vectors
+// which were collected so far are kept, so that an interrupted
+// revectorization can be resumed.
+func (s *Shard) beginRevectorize(ctx context.Context) error {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	if s.revectorizeIndex != nil {
+		return nil
+	}
+
+	hnswUserConfig, ok := s.index.vectorIndexUserConfig.(hnswent.UserConfig)
+	if !ok || hnswUserConfig.Skip {
+		return errors.Errorf("revectorize shard %q: only hnsw vector indexes are supported", s.name)
+	}
+	if hnswUserConfig.PQ.Enabled || hnswUserConfig.BQ.Enabled || hnswUserConfig.SQ.Enabled {
+		return errors.Errorf("revectorize shard %q: compressed vector indexes are not supported", s.name)
+	}
+	if len(s.vectorIndexes) > 0 {
+		return errors.Errorf("revectorize shard %q: named vectors are not supported", s.name)
+	}
+
+	resumed := s.revectorizing.Load()
+	s.revectorizing.Store(true)
+
+	indexExists, err := hnsw.IndexFilesExist(s.index.Config.RootPath, s.revectorizeIndexID())
+	if err != nil {
+		return errors.Wrapf(err, "revectorize shard %q", s.name)
+	}
+
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.RevectorizedVectorsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return errors.Wrapf(err, "revectorize shard %q: create bucket", s.name)
+	}
+
+	if resumed && !indexExists {
+		// the new index has already taken the place of the old one, only the
+		// bucket of the new vectors is left to be removed
+		return nil
+	}
+
+	vi, err := s.newRevectorizeIndex(hnswUserConfig)
+	if err != nil {
+		return err
+	}
+	if !resumed && indexExists {
+		// files left behind by a revectorization which never got to collect
+		// any vectors
+		if err := vi.Drop(ctx); err != nil {
+			return errors.Wrapf(err, "revectorize shard %q: drop leftover index", s.name)
+		}
+		if vi, err = s.newRevectorizeIndex(hnswUserConfig); err != nil {
+			return err
+		}
+	}
+
+	if resumed {
+		vi.PostStartup()
+		if err := s.restoreRevectorizeIndex(vi); err != nil {
+			return errors.Wrapf(err, "revectorize shard %q", s.name)
+		}
+	}
+
+	s.revectorizeIndex = vi
+	return nil
+}
+
+func (s *Shard) newRevectorizeIndex(hnswUserConfig hnswent.UserConfig) (VectorIndex, error) {
+	return s.newHNSWIndexFor(s.revectorizeIndexID(), hnswUserConfig,
+		s.revectorizedVectorByIndexID, s.readRevectorizedVectorIntoSlice)
+}
+
+// restoreRevectorizeIndex adds the vectors which made it into the bucket, but
+// not into the commit log of the index before a crash
+func (s *Shard) restoreRevectorizeIndex(vi VectorIndex) error {
+	container, ok := vi.(nodeContainer)
+	if !ok {
+		return nil
+	}
+
+	cursor := s.store.Bucket(helpers.RevectorizedVectorsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		docID := binary.LittleEndian.Uint64(k)
+		if container.ContainsNode(docID) {
+			continue
+		}
+		if err := vi.Add(docID, revectorizedVectorFromBinary(v, nil)); err != nil {
+			return errors.Wrapf(err, "restore doc id %d", docID)
+		}
+	}
+
+	return nil
+}
+
+// putRevectorized stores the new vector of an object and adds it to the vector
+// index which is being built
+func (s *Shard) putRevectorized(ctx context.Context, id strfmt.UUID,
+	vector []float32,
+) error {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	if s.revectorizeIndex == nil {
+		return errors.Errorf("shard %q is not being revectorized", s.name)
+	}
+	if err := s.revectorizeIndex.ValidateBeforeInsert(vector); err != nil {
+		return errors.Wrapf(err, "validate vector of object %s", id)
+	}
+
+	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	data, err := s.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+	if err != nil {
+		return errors.Wrapf(err, "get object %s", id)
+	}
+	if data == nil {
+		return errors.Errorf("object %s does not exist in shard %q", id, s.name)
+	}
+	docID, err := storobj.DocIDFromBinary(data)
+	if err != nil {
+		return errors.Wrapf(err, "read doc id of object %s", id)
+	}
+
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, docID)
+	value := make([]byte, len(idBytes)+4*len(vector))
+	copy(value, idBytes)
+	for i, x := range vector {
+		binary.LittleEndian.PutUint32(value[len(idBytes)+4*i:], math.Float32bits(x))
+	}
+
+	if err := s.store.Bucket(helpers.RevectorizedVectorsBucketLSM).Put(key, value); err != nil {
+		return errors.Wrapf(err, "store vector of object %s", id)
+	}
+
+	return s.revectorizeIndex.Add(docID, vector)
+}
+
+// finishRevectorize swaps in the vector index which was built from the new
+// vectors, writes the new vectors to the objects and accepts writes again.
+// Every step can be repeated, so a revectorization which was interrupted while
+// finishing can simply be finished again.
+func (s *Shard) finishRevectorize(ctx context.Context) error {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	if !s.revectorizing.Load() {
+		return nil
+	}
+
+	bucket := s.store.Bucket(helpers.RevectorizedVectorsBucketLSM)
+	if bucket == nil {
+		return errors.Errorf("revectorization of shard %q has not been started", s.name)
+	}
+
+	if s.revectorizeIndex != nil {
+		if err := s.revectorizeIndex.Flush(); err != nil {
+			return errors.Wrapf(err, "revectorize shard %q: flush index", s.name)
+		}
+
+		// until the objects are updated, the new index reads the new vectors
+		// from the bucket
+		previous := s.vectorIndex
+		s.vectorIndex = s.revectorizeIndex
+		if err := previous.Drop(ctx); err != nil {
+			return errors.Wrapf(err, "revectorize shard %q: drop previous index", s.name)
+		}
+
+		if err := s.writeRevectorizedVectors(bucket); err != nil {
+			return errors.Wrapf(err, "revectorize shard %q", s.name)
+		}
+
+		if err := s.vectorIndex.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "revectorize shard %q: shutdown index", s.name)
+		}
+		if err := hnsw.RenameIndexFiles(s.index.Config.RootPath,
+			s.revectorizeIndexID(), s.ID()); err != nil {
+			return errors.Wrapf(err, "revectorize shard %q", s.name)
+		}
+		s.revectorizeIndex = nil
+
+		if err := s.initVectorIndex(ctx, s.index.vectorIndexUserConfig.(hnswent.UserConfig)); err != nil {
+			return errors.Wrapf(err, "revectorize shard %q: init vector index", s.name)
+		}
+		s.vectorIndex.PostStartup()
+	}
+
+	if err := s.store.DropBucket(ctx, helpers.RevectorizedVectorsBucketLSM); err != nil {
+		return errors.Wrapf(err, "revectorize shard %q", s.name)
+	}
+
+	s.revectorizing.Store(false)
+	return nil
+}
+
+// writeRevectorizedVectors replaces the vector of every object which has been
+// revectorized. The doc ids don't change, so the inverted indexes and the new
+// vector index stay valid.
+func (s *Shard) writeRevectorizedVectors(revectorized *lsmkv.Bucket) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+
+	cursor := revectorized.Cursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		docID := binary.LittleEndian.Uint64(k)
+		id := make([]byte, 16)
+		copy(id, v[:16])
+
+		data, err := bucket.Get(id)
+		if err != nil {
+			return errors.Wrapf(err, "get object of doc id %d", docID)
+		}
+		if data == nil {
+			continue
+		}
+
+		obj, err := storobj.FromBinaryWithClass(data, s.class())
+		if err != nil {
+			return errors.Wrapf(err, "unmarshal object of doc id %d", docID)
+		}
+		previousDims := len(obj.Vector)
+		obj.Vector = revectorizedVectorFromBinary(v, nil)
+
+		data, err = obj.MarshalBinary()
+		if err != nil {
+			return errors.Wrapf(err, "marshal object of doc id %d", docID)
+		}
+		if err := s.upsertObjectDataLSM(bucket, id, data, docID); err != nil {
+			return errors.Wrapf(err, "update object of doc id %d", docID)
+		}
+
+		if s.index.Config.TrackVectorDimensions && previousDims != len(obj.Vector) {
+			if err := s.removeDimensionsLSM(previousDims, docID); err != nil {
+				return errors.Wrap(err, "track dimensions (delete)")
+			}
+			if err := s.extendDimensionTrackerLSM(len(obj.Vector), docID); err != nil {
+				return errors.Wrap(err, "track dimensions")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) revectorizedVectorByIndexID(ctx context.Context, indexID uint64) ([]float32, error) {
+	return s.readRevectorizedVectorIntoSlice(ctx, indexID, &hnsw.VectorSlice{})
+}
+
+func (s *Shard) readRevectorizedVectorIntoSlice(ctx context.Context, indexID uint64,
+	container *hnsw.VectorSlice,
+) ([]float32, error) {
+	bucket := s.store.Bucket(helpers.RevectorizedVectorsBucketLSM)
+	if bucket == nil {
+		return nil, fmt.Errorf("shard %q is not being revectorized", s.name)
+	}
+
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, indexID)
+	v, err := bucket.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, storobj.NewErrNotFoundf(indexID,
+			"no revectorized vector for doc id")
+	}
+
+	return revectorizedVectorFromBinary(v, container.Slice), nil
+}
+
+// revectorizedVectorFromBinary decodes the vector of a value of the
+// revectorized vectors bucket, which is prefixed with the uuid of the object
+func revectorizedVectorFromBinary(in []byte, buffer []float32) []float32 {
+	in = in[16:]
+	dims := len(in) / 4
+
+	var out []float32
+	if cap(buffer) >= dims {
+		out = buffer[:dims]
+	} else {
+		out = make([]float32, dims)
+	}
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[4*i:]))
+	}
+	return out
+}
@@ -32,8 +32,10 @@ func (s *Shard) getStatus() storagestate.Status {
 	return s.status
 }
 
+// isReadOnly is also true while the shard is revectorized, writes would be
+// lost once the new vectors are swapped in
 func (s *Shard) isReadOnly() bool {
-	return s.getStatus() == storagestate.StatusReadOnly
+	return s.getStatus() == storagestate.StatusReadOnly || s.revectorizing.Load()
 }
 
 func (s *Shard) updateStatus(in string) error {
@@ -0,0 +1,265 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// targetVectorIndexConfigs returns the parsed vector index config of every
+// named vector of the class
+func targetVectorIndexConfigs(class *models.Class) map[string]schema.VectorIndexConfig {
+	if class == nil || len(class.VectorConfig) == 0 {
+		return nil
+	}
+
+	configs := make(map[string]schema.VectorIndexConfig, len(class.VectorConfig))
+	for target, cfg := range class.VectorConfig {
+		if parsed, ok := cfg.VectorIndexConfig.(schema.VectorIndexConfig); ok {
+			configs[target] = parsed
+		}
+	}
+	return configs
+}
+
+// targetVectorIndexID is the id of the vector index of a named vector, it is
+// used to name the files of the index, e.g. the hnsw commit logs
+func (s *Shard) targetVectorIndexID(target string) string {
+	return fmt.Sprintf("%s_vectors_%s", s.ID(), target)
+}
+
+// initTargetVectors creates a vector index for each named vector of the
+// class. Named vectors support the hnsw and flat index types.
+func (s *Shard) initTargetVectors() error {
+	configs := s.index.vectorIndexUserConfigs
+	s.vectorIndexes = make(map[string]VectorIndex, len(configs))
+	for target, cfg := range configs {
+		vi, err := s.newTargetVectorIndex(target, cfg)
+		if err != nil {
+			return fmt.Errorf("named vector %q: %w", target, err)
+		}
+		s.vectorIndexes[target] = vi
+	}
+
+	return nil
+}
+
+func (s *Shard) newTargetVectorIndex(target string,
+	cfg schema.VectorIndexConfig,
+) (VectorIndex, error) {
+	id := s.targetVectorIndexID(target)
+
+	switch userConfig := cfg.(type) {
+	case hnswent.UserConfig:
+		if userConfig.Skip {
+			return noop.NewIndex(), nil
+		}
+		return s.newHNSWIndexFor(id, userConfig,
+			func(ctx context.Context, indexID uint64) ([]float32, error) {
+				return s.readTargetVectorByIndexIDIntoSlice(ctx, target, indexID,
+					&hnsw.VectorSlice{Buff8: make([]byte, 8)})
+			},
+			func(ctx context.Context, indexID uint64, container *hnsw.VectorSlice) ([]float32, error) {
+				return s.readTargetVectorByIndexIDIntoSlice(ctx, target, indexID, container)
+			})
+	case flatent.UserConfig:
+		return s.newFlatIndexFor(id, userConfig,
+			func(ctx context.Context, indexID uint64) ([]float32, error) {
+				return s.readTargetVectorByIndexIDIntoSlice(ctx, target, indexID,
+					&hnsw.VectorSlice{Buff8: make([]byte, 8)})
+			},
+			func(fn func(id uint64, vector []float32) error) error {
+				return s.iterateTargetVectors(target, fn)
+			})
+	default:
+		return nil, errors.Errorf("unsupported vector index type for named vectors: %q",
+			cfg.IndexType())
+	}
+}
+
+func (s *Shard) targetVectorsPostStartup() {
+	for _, vi := range s.vectorIndexes {
+		vi.PostStartup()
+	}
+}
+
+func (s *Shard) readTargetVectorByIndexIDIntoSlice(ctx context.Context, target string,
+	indexID uint64, container *hnsw.VectorSlice,
+) ([]float32, error) {
+	binary.LittleEndian.PutUint64(container.Buff8, indexID)
+
+	bytes, newBuff, err := s.store.Bucket(helpers.ObjectsBucketLSM).
+		GetBySecondaryIntoMemory(0, container.Buff8, container.Buff)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes == nil {
+		return nil, storobj.NewErrNotFoundf(indexID,
+			"no object for doc id, it could have been deleted")
+	}
+
+	container.Buff = newBuff
+	return storobj.TargetVectorFromBinary(bytes, container.Slice, target)
+}
+
+// iterateTargetVectors calls fn with the doc id and named vector of every
+// object in the shard which has a vector for the target
+func (s *Shard) iterateTargetVectors(target string,
+	fn func(id uint64, vector []float32) error,
+) error {
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	for key, val := cursor.First(); key != nil; key, val = cursor.Next() {
+		docID, err := storobj.DocIDFromBinary(val)
+		if err != nil {
+			return errors.Wrapf(err, "read doc id of object %x", key)
+		}
+
+		vector, err := storobj.TargetVectorFromBinary(val, nil, target)
+		if err != nil {
+			return errors.Wrapf(err, "read vector %q of doc id %d", target, docID)
+		}
+		if len(vector) == 0 {
+			continue
+		}
+
+		if err := fn(docID, vector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// targetVectorIndex returns the vector index of the named vector, or the
+// index of the class vector if no target vector is given
+func (s *Shard) targetVectorIndex(target string) (VectorIndex, error) {
+	if target == "" {
+		return s.vectorIndex, nil
+	}
+
+	vi, ok := s.vectorIndexes[target]
+	if !ok {
+		return nil, errors.Errorf("shard %s: no vector index for named vector %q",
+			s.ID(), target)
+	}
+	return vi, nil
+}
+
+func (s *Shard) validateTargetVectors(vectors map[string][]float32) error {
+	for target, vector := range vectors {
+		if err := s.validateTargetVector(target, vector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) validateTargetVector(target string, vector []float32) error {
+	vi, err := s.targetVectorIndex(target)
+	if err != nil {
+		return err
+	}
+	if err := vi.ValidateBeforeInsert(vector); err != nil {
+		return errors.Wrapf(err, "named vector %q", target)
+	}
+
+	return nil
+}
+
+// updateTargetVectorIndexes is the named vector equivalent of
+// updateVectorIndex
+func (s *Shard) updateTargetVectorIndexes(vectors map[string][]float32,
+	status objectInsertStatus,
+) error {
+	if status.docIDChanged {
+		if err := s.deleteFromTargetVectorIndexes(status.oldDocID); err != nil {
+			return err
+		}
+	}
+
+	return s.updateTargetVectorIndexesIgnoreDelete(vectors, status)
+}
+
+// updateTargetVectorIndexesIgnoreDelete is the named vector equivalent of
+// updateVectorIndexIgnoreDelete
+func (s *Shard) updateTargetVectorIndexesIgnoreDelete(vectors map[string][]float32,
+	status objectInsertStatus,
+) error {
+	for target, vector := range vectors {
+		if len(vector) == 0 {
+			continue
+		}
+
+		vi, err := s.targetVectorIndex(target)
+		if err != nil {
+			return err
+		}
+		if err := vi.Add(status.docID, vector); err != nil {
+			return errors.Wrapf(err, "insert doc id %d to vector index of %q",
+				status.docID, target)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) deleteFromTargetVectorIndexes(docIDs ...uint64) error {
+	for target, vi := range s.vectorIndexes {
+		if err := vi.Delete(docIDs...); err != nil {
+			return errors.Wrapf(err, "delete from vector index of %q", target)
+		}
+	}
+
+	return nil
+}
+
+// deleteFromVectorIndexes removes the doc ids from the vector index of the
+// class vector as well as from the indexes of all named vectors
+func (s *Shard) deleteFromVectorIndexes(docIDs ...uint64) error {
+	if err := s.vectorIndex.Delete(docIDs...); err != nil {
+		return err
+	}
+
+	return s.deleteFromTargetVectorIndexes(docIDs...)
+}
+
+// flushVectorIndexes flushes the buffered WALs of the vector index of the
+// class vector as well as of the indexes of all named vectors
+func (s *Shard) flushVectorIndexes() error {
+	if err := s.vectorIndex.Flush(); err != nil {
+		return err
+	}
+
+	for target, vi := range s.vectorIndexes {
+		if err := vi.Flush(); err != nil {
+			return errors.Wrapf(err, "vector index of %q", target)
+		}
+	}
+
+	return nil
+}
@@ -193,7 +193,7 @@ func (ob *objectsBatcher) markDeletedInVectorStorage(ctx context.Context) {
 		return
 	}
 
-	if err := ob.shard.vectorIndex.Delete(docIDsToDelete...); err != nil {
+	if err := ob.shard.deleteFromVectorIndexes(docIDsToDelete...); err != nil {
 		for _, pos := range positions {
 			ob.setErrorAtIndex(err, pos)
 		}
@@ -279,6 +279,11 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 		}
 	}
 
+	if err := ob.shard.updateTargetVectorIndexesIgnoreDelete(object.Vectors, status); err != nil {
+		ob.setErrorAtIndex(errors.Wrap(err, "insert to named vector indexes"), index)
+		return
+	}
+
 	if err := ob.shard.updatePropertySpecificIndices(object, status); err != nil {
 		ob.setErrorAtIndex(errors.Wrap(err, "update prop-specific indices"), index)
 		return
@@ -329,7 +334,7 @@ func (ob *objectsBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
-	if err := ob.shard.vectorIndex.Flush(); err != nil {
+	if err := ob.shard.flushVectorIndexes(); err != nil {
 		for i := range ob.objects {
 			ob.setErrorAtIndex(err, i)
 		}
@@ -338,7 +338,7 @@ func (b *referencesBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
-	if err := b.shard.vectorIndex.Flush(); err != nil {
+	if err := b.shard.flushVectorIndexes(); err != nil {
 		for i := range b.refs {
 			b.setErrorAtIndex(err, i)
 		}
@@ -22,18 +22,10 @@ import (
 
 type nilProp struct {
 	Name                string
+	AddToNullState      bool
 	AddToPropertyLength bool
 }
 
-func isPropertyForLength(dt schema.DataType) bool {
-	switch dt {
-	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate:
-		return false
-	default:
-		return true
-	}
-}
-
 func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []nilProp, error) {
 	schemaModel := s.index.getSchema.GetSchemaSkipAuth().Objects
 	c, err := schema.GetClassByName(schemaModel, object.Class().String())
@@ -41,6 +33,10 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 		return nil, nil, err
 	}
 
+	// the inverted indexes of deleted and renamed properties have been changed
+	// already, so the object needs to match them
+	s.applyPropertyChanges(object)
+
 	var schemaMap map[string]interface{}
 
 	if object.Properties() == nil {
@@ -54,26 +50,38 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 	}
 
 	// add nil for all properties that are not part of the object so that they can be added to the inverted index for
-	// the null state (if enabled)
+	// the null state and property length (if enabled)
 	var nilProps []nilProp
-	if s.index.invertedIndexConfig.IndexNullState {
-		for _, prop := range c.Properties {
-			dt := schema.DataType(prop.DataType[0])
-			// some datatypes are not added to the inverted index, so we can skip them here
-			if dt == schema.DataTypeGeoCoordinates || dt == schema.DataTypePhoneNumber || dt == schema.DataTypeBlob {
-				continue
-			}
+	nullStateProps := map[string]struct{}{}
+	lengthProps := map[string]struct{}{}
+	for _, prop := range c.Properties {
+		// the null state and property length can be enabled for the whole class or per property,
+		// both imply an enabled inverted index
+		hasNullState := inverted.HasNullStateIndex(prop, s.index.invertedIndexConfig.IndexNullState)
+		hasLength := inverted.HasPropertyLengthIndex(prop, s.index.invertedIndexConfig.IndexPropertyLength)
+		if hasNullState {
+			nullStateProps[prop.Name] = struct{}{}
+		}
+		if hasLength {
+			lengthProps[prop.Name] = struct{}{}
+		}
+		if !hasNullState && !hasLength {
+			continue
+		}
 
-			// Add props as nil props if
-			// 1. They are not in the schema map ( == nil)
-			// 2. Their inverted index is enabled
-			_, ok := schemaMap[prop.Name]
-			if !ok && inverted.HasInvertedIndex(prop) {
-				nilProps = append(nilProps, nilProp{
-					Name:                prop.Name,
-					AddToPropertyLength: isPropertyForLength(dt),
-				})
-			}
+		dt := schema.DataType(prop.DataType[0])
+		// some datatypes are not added to the inverted index, so we can skip them here
+		if dt == schema.DataTypeGeoCoordinates || dt == schema.DataTypePhoneNumber || dt == schema.DataTypeBlob {
+			continue
+		}
+
+		// Add props as nil props if they are not in the schema map ( == nil)
+		if _, ok := schemaMap[prop.Name]; !ok {
+			nilProps = append(nilProps, nilProp{
+				Name:                prop.Name,
+				AddToNullState:      hasNullState,
+				AddToPropertyLength: hasLength,
+			})
 		}
 	}
 
@@ -86,5 +94,12 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 	}
 
 	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable).Object(schemaMap, c.Properties, object.ID())
-	return props, nilProps, err
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range props {
+		_, props[i].HasNullStateIndex = nullStateProps[props[i].Name]
+		_, props[i].HasLengthIndex = lengthProps[props[i].Name]
+	}
+	return props, nilProps, nil
 }
@@ -37,13 +37,13 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []n
 		}
 
 		// properties where defining a length does not make sense (floats etc.) have a negative entry as length
-		if s.index.invertedIndexConfig.IndexPropertyLength && prop.Length >= 0 {
+		if prop.HasLengthIndex && prop.Length >= 0 {
 			if err := s.addToPropertyLengthIndex(prop.Name, docID, prop.Length); err != nil {
 				return errors.Wrap(err, "add indexed property length")
 			}
 		}
 
-		if s.index.invertedIndexConfig.IndexNullState {
+		if prop.HasNullStateIndex {
 			if err := s.addToPropertyNullIndex(prop.Name, docID, prop.Length == 0); err != nil {
 				return errors.Wrap(err, "add indexed null state")
 			}
@@ -52,13 +52,13 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []n
 
 	// add nil properties to the nullstate and property length inverted index
 	for _, nilProperty := range nilProps {
-		if s.index.invertedIndexConfig.IndexPropertyLength && nilProperty.AddToPropertyLength {
+		if nilProperty.AddToPropertyLength {
 			if err := s.addToPropertyLengthIndex(nilProperty.Name, docID, 0); err != nil {
 				return errors.Wrap(err, "add indexed property length")
 			}
 		}
 
-		if s.index.invertedIndexConfig.IndexNullState {
+		if nilProperty.AddToNullState {
 			if err := s.addToPropertyNullIndex(nilProperty.Name, docID, true); err != nil {
 				return errors.Wrap(err, "add indexed null state")
 			}
@@ -99,6 +99,53 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 		}
 	}
 
+	if property.HasReversedIndex {
+		bucketReversed := s.store.Bucket(helpers.BucketReversedFromPropNameLSM(property.Name))
+		if bucketReversed == nil {
+			return errors.Errorf("no bucket reversed for prop '%s' found", property.Name)
+		}
+
+		for _, item := range property.Items {
+			key := inverted.ReverseTerm(item.Data)
+			if err := s.addToPropertySetBucket(bucketReversed, docID, key); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' reversed bucket", property.Name)
+			}
+		}
+	}
+
+	if property.HasRangeIndex {
+		bucketRangeable := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(property.Name))
+		if bucketRangeable == nil {
+			return errors.Errorf("no bucket rangeable for prop '%s' found", property.Name)
+		}
+
+		for _, item := range property.Items {
+			keys, err := inverted.RangeIndexKeys(item.Data)
+			if err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' rangeable bucket", property.Name)
+			}
+			for _, key := range keys {
+				if err := s.addToPropertySetBucket(bucketRangeable, docID, key); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' rangeable bucket", property.Name)
+				}
+			}
+		}
+	}
+
+	if property.HasPositionIndex {
+		bucketPositions := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(property.Name))
+		if bucketPositions == nil {
+			return errors.Errorf("no bucket positions for prop '%s' found", property.Name)
+		}
+
+		for _, item := range property.Items {
+			pair := inverted.PairPropertyWithPositions(docID, item.Positions)
+			if err := s.addToPropertyMapBucket(bucketPositions, pair, item.Data); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", property.Name)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -54,6 +54,60 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 				}
 			}
 		}
+
+		if prop.HasReversedIndex {
+			bucket := s.store.Bucket(helpers.BucketReversedFromPropNameLSM(prop.Name))
+			if bucket == nil {
+				return fmt.Errorf("no bucket reversed for prop '%s' found", prop.Name)
+			}
+
+			for _, item := range prop.Items {
+				reversed := inverted.Countable{Data: inverted.ReverseTerm(item.Data)}
+				if err := s.deleteInvertedIndexItemLSM(bucket, reversed,
+					docID); err != nil {
+					return errors.Wrapf(err, "delete item '%s' from reversed index",
+						string(item.Data))
+				}
+			}
+		}
+
+		if prop.HasRangeIndex {
+			bucket := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(prop.Name))
+			if bucket == nil {
+				return fmt.Errorf("no bucket rangeable for prop '%s' found", prop.Name)
+			}
+
+			for _, item := range prop.Items {
+				keys, err := inverted.RangeIndexKeys(item.Data)
+				if err != nil {
+					return errors.Wrapf(err, "delete item '%s' from rangeable index",
+						string(item.Data))
+				}
+				for _, key := range keys {
+					if err := s.deleteInvertedIndexItemLSM(bucket, inverted.Countable{Data: key},
+						docID); err != nil {
+						return errors.Wrapf(err, "delete item '%s' from rangeable index",
+							string(item.Data))
+					}
+				}
+			}
+		}
+
+		if prop.HasPositionIndex {
+			bucket := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(prop.Name))
+			if bucket == nil {
+				return fmt.Errorf("no bucket positions for prop '%s' found", prop.Name)
+			}
+
+			docIDBytes := make([]byte, 8)
+			binary.BigEndian.PutUint64(docIDBytes, docID)
+			for _, item := range prop.Items {
+				if err := bucket.MapDeleteKey(item.Data, docIDBytes); err != nil {
+					return errors.Wrapf(err, "delete item '%s' from positions index",
+						string(item.Data))
+				}
+			}
+		}
 	}
 
 	return nil
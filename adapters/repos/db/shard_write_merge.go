@@ -35,6 +35,11 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 			return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
 		}
 	}
+	for target, vector := range merge.Vectors {
+		if err := s.validateTargetVector(target, vector); err != nil {
+			return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
+		}
+	}
 
 	idBytes, err := uuid.MustParse(merge.ID.String()).MarshalBinary()
 	if err != nil {
@@ -54,6 +59,10 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateTargetVectorIndexes(next.Vectors, status); err != nil {
+		return errors.Wrap(err, "update named vector indexes")
+	}
+
 	if err := s.updatePropertySpecificIndices(next, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
@@ -62,7 +71,7 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
@@ -197,10 +206,13 @@ func (s *Shard) mergeObjectData(previous []byte,
 		previousObj.SetClass(merge.Class)
 		previousObj.SetID(merge.ID)
 	} else {
-		p, err := storobj.FromBinary(previous)
+		p, err := storobj.FromBinaryWithClass(previous, s.class())
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "unmarshal previous")
 		}
+		// values of deleted properties must not be carried over into the
+		// merged object, which is newer than the deletion
+		s.applyPropertyChanges(p)
 
 		previousObj = p
 	}
@@ -244,6 +256,13 @@ func mergeProps(previous *storobj.Object,
 		next.Vector = merge.Vector
 	}
 
+	for target, vector := range merge.Vectors {
+		if next.Vectors == nil {
+			next.Vectors = map[string][]float32{}
+		}
+		next.Vectors[target] = vector
+	}
+
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
 	next.SetProperties(properties)
 
@@ -47,6 +47,9 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 			return errors.Wrapf(err, "Validate vector index for %v", uuid)
 		}
 	}
+	if err := s.validateTargetVectors(object.Vectors); err != nil {
+		return errors.Wrapf(err, "Validate vector index for %v", uuid)
+	}
 
 	status, err := s.putObjectLSM(object, uuid)
 	if err != nil {
@@ -57,6 +60,10 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateTargetVectorIndexes(object.Vectors, status); err != nil {
+		return errors.Wrap(err, "update named vector indexes")
+	}
+
 	if err := s.updatePropertySpecificIndices(object, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
@@ -69,7 +76,7 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "flush prop length tracker to disk")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
@@ -260,7 +267,7 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	}
 
 	if status.docIDChanged {
-		oldObject, err := storobj.FromBinary(previous)
+		oldObject, err := storobj.FromBinaryWithClass(previous, s.class())
 		if err == nil {
 
 			oldProps, _, err := s.analyzeObject(oldObject)
@@ -352,7 +359,7 @@ func (s *Shard) updateInvertedIndexCleanupOldLSM(status objectInsertStatus,
 	// NOTE: Since Doc IDs are immutable, there is no need to use a
 	// DeltaAnalyzer. docIDChanged==true, therefore the old docID is
 	// "worthless" and can be cleaned up in the inverted index fully.
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := storobj.FromBinaryWithClass(previous, s.class())
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
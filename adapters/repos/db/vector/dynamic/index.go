@@ -0,0 +1,601 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+)
+
+// VectorIndex is implemented by the flat and the hnsw index, which the
+// dynamic index delegates to
+type VectorIndex interface {
+	Dump(labels ...string)
+	Add(id uint64, vector []float32) error
+	Delete(id ...uint64) error
+	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorDistance(vector []float32, dist float32,
+		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+	UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error
+	Drop(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+	Flush() error
+	SwitchCommitLogs(ctx context.Context) error
+	ListFiles(ctx context.Context) ([]string, error)
+	PostStartup()
+	ValidateBeforeInsert(vector []float32) error
+}
+
+// paramsSearcher is implemented by indexes which accept search params that
+// override their user config for a single query, currently only by hnsw
+type paramsSearcher interface {
+	SearchByVectorWithParams(vector []float32, k int, allow helpers.AllowList,
+		params searchparams.VectorIndex) ([]uint64, []float32, error)
+	SearchByVectorDistanceWithParams(vector []float32, dist float32, maxLimit int64,
+		allow helpers.AllowList, params searchparams.VectorIndex) ([]uint64, []float32, error)
+}
+
+// statsProvider is implemented by the hnsw index
+type statsProvider interface {
+	Stats() hnsw.Stats
+}
+
+// IterateVectorsFn calls fn with the doc id and vector of every object which is
+// persisted in the shard
+type IterateVectorsFn func(fn func(id uint64, vector []float32) error) error
+
+type Config struct {
+	ID             string
+	RootPath       string
+	Logger         logrus.FieldLogger
+	MakeFlat       func(ent.UserConfig) (VectorIndex, error)
+	MakeHNSW       func(ent.UserConfig) (VectorIndex, error)
+	IterateVectors IterateVectorsFn
+}
+
+func (c Config) Validate() error {
+	if c.ID == "" {
+		return errors.Errorf("id cannot be empty")
+	}
+
+	if c.RootPath == "" {
+		return errors.Errorf("rootPath cannot be empty")
+	}
+
+	if c.MakeFlat == nil || c.MakeHNSW == nil {
+		return errors.Errorf("makeFlat and makeHNSW cannot be nil")
+	}
+
+	if c.IterateVectors == nil {
+		return errors.Errorf("iterateVectors cannot be nil")
+	}
+
+	return nil
+}
+
+// Index starts out as a flat index and upgrades itself to hnsw once the
+// number of objects crosses the threshold. The upgrade builds the hnsw index
+// in the background from the vectors on disk, imports and deletes which
+// happen in the meantime are applied to both indexes. Once the upgrade is
+// complete, a marker file makes sure the shard starts as hnsw from then on.
+type Index struct {
+	sync.RWMutex
+	id             string
+	rootPath       string
+	logger         logrus.FieldLogger
+	makeHNSW       func(ent.UserConfig) (VectorIndex, error)
+	iterateVectors IterateVectorsFn
+
+	config    ent.UserConfig
+	threshold atomic.Int64
+	count     atomic.Int64
+	index     VectorIndex
+	upgraded  bool
+
+	upgrading     atomic.Bool
+	pendingLock   sync.Mutex
+	pending       []pendingOp
+	upgradeCtx    context.Context
+	cancelUpgrade context.CancelFunc
+	upgradeWg     sync.WaitGroup
+}
+
+// pendingOp is an import or delete which happened while the hnsw index was
+// built
+type pendingOp struct {
+	id     uint64
+	vector []float32
+	delete bool
+}
+
+func New(cfg Config, uc ent.UserConfig) (*Index, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	upgradeCtx, cancelUpgrade := context.WithCancel(context.Background())
+	index := &Index{
+		id:             cfg.ID,
+		rootPath:       cfg.RootPath,
+		logger:         cfg.Logger,
+		makeHNSW:       cfg.MakeHNSW,
+		iterateVectors: cfg.IterateVectors,
+		config:         uc,
+		upgradeCtx:     upgradeCtx,
+		cancelUpgrade:  cancelUpgrade,
+	}
+	index.threshold.Store(int64(uc.Threshold))
+
+	upgraded, err := index.hasUpgradeMarker()
+	if err != nil {
+		return nil, err
+	}
+
+	if upgraded {
+		index.index, err = cfg.MakeHNSW(uc)
+		index.upgraded = true
+	} else {
+		index.index, err = cfg.MakeFlat(uc)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+func (i *Index) markerPath() string {
+	return filepath.Join(i.rootPath, fmt.Sprintf("%s.dynamic.upgraded", i.id))
+}
+
+func (i *Index) hasUpgradeMarker() (bool, error) {
+	_, err := os.Stat(i.markerPath())
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, errors.Wrap(err, "check upgrade marker of dynamic index")
+}
+
+// Upgraded returns whether the index has been upgraded to hnsw
+func (i *Index) Upgraded() bool {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.upgraded
+}
+
+func (i *Index) Add(id uint64, vector []float32) error {
+	i.RLock()
+	err := i.index.Add(id, vector)
+	if err == nil {
+		i.addPending(pendingOp{id: id, vector: vector})
+	}
+	upgraded := i.upgraded
+	i.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if !upgraded && i.count.Add(1) >= i.threshold.Load() {
+		i.startUpgrade()
+	}
+	return nil
+}
+
+func (i *Index) Delete(ids ...uint64) error {
+	i.RLock()
+	defer i.RUnlock()
+
+	if err := i.index.Delete(ids...); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		i.addPending(pendingOp{id: id, delete: true})
+	}
+	if !i.upgraded {
+		i.count.Add(-int64(len(ids)))
+	}
+	return nil
+}
+
+// addPending keeps track of the changes which happen while the hnsw index is
+// built, the caller must hold the read lock
+func (i *Index) addPending(op pendingOp) {
+	if i.upgraded || !i.upgrading.Load() {
+		return
+	}
+
+	i.pendingLock.Lock()
+	defer i.pendingLock.Unlock()
+	i.pending = append(i.pending, op)
+}
+
+func (i *Index) startUpgrade() {
+	if !i.upgrading.CompareAndSwap(false, true) {
+		return
+	}
+
+	i.upgradeWg.Add(1)
+	go func() {
+		defer i.upgradeWg.Done()
+
+		if err := i.upgrade(i.upgradeCtx); err != nil {
+			i.logger.WithField("action", "dynamic_upgrade").WithField("id", i.id).
+				WithError(err).Error("failed to upgrade flat index to hnsw")
+
+			i.pendingLock.Lock()
+			i.pending = nil
+			i.pendingLock.Unlock()
+			i.upgrading.Store(false)
+			return
+		}
+
+		i.logger.WithField("action", "dynamic_upgrade").WithField("id", i.id).
+			Info("upgraded flat index to hnsw")
+	}()
+}
+
+func (i *Index) upgrade(ctx context.Context) error {
+	// a previous upgrade might have been interrupted before it was complete,
+	// so any hnsw files which exist at this point are left overs
+	leftover, err := i.makeHNSW(i.currentConfig())
+	if err != nil {
+		return errors.Wrap(err, "init hnsw index")
+	}
+	if err := leftover.Drop(ctx); err != nil {
+		return errors.Wrap(err, "drop previous hnsw index")
+	}
+
+	index, err := i.makeHNSW(i.currentConfig())
+	if err != nil {
+		return errors.Wrap(err, "init hnsw index")
+	}
+	index.PostStartup()
+
+	added := map[uint64]struct{}{}
+	err = i.iterateVectors(func(id uint64, vector []float32) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if len(vector) == 0 {
+			return nil
+		}
+
+		if err := index.Add(id, vector); err != nil {
+			return errors.Wrapf(err, "add doc id %d", id)
+		}
+		added[id] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return i.abortUpgrade(index, err)
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	// the imports and deletes which happened in the meantime are applied in
+	// order, objects which have already been read from disk are skipped
+	i.pendingLock.Lock()
+	pending := i.pending
+	i.pending = nil
+	i.pendingLock.Unlock()
+
+	for _, op := range pending {
+		_, exists := added[op.id]
+		if op.delete {
+			if exists {
+				if err := index.Delete(op.id); err != nil {
+					return i.abortUpgrade(index, errors.Wrapf(err, "delete doc id %d", op.id))
+				}
+				delete(added, op.id)
+			}
+			continue
+		}
+
+		if !exists {
+			if err := index.Add(op.id, op.vector); err != nil {
+				return i.abortUpgrade(index, errors.Wrapf(err, "add doc id %d", op.id))
+			}
+			added[op.id] = struct{}{}
+		}
+	}
+
+	if err := index.Flush(); err != nil {
+		return i.abortUpgrade(index, errors.Wrap(err, "flush hnsw index"))
+	}
+
+	f, err := os.Create(i.markerPath())
+	if err != nil {
+		return i.abortUpgrade(index, errors.Wrap(err, "create upgrade marker"))
+	}
+	if err := f.Close(); err != nil {
+		return i.abortUpgrade(index, errors.Wrap(err, "create upgrade marker"))
+	}
+
+	previous := i.index
+	i.index = index
+	i.upgraded = true
+
+	if err := previous.Drop(ctx); err != nil {
+		i.logger.WithField("action", "dynamic_upgrade").WithField("id", i.id).
+			WithError(err).Warn("failed to drop flat index after upgrade")
+	}
+	return nil
+}
+
+func (i *Index) abortUpgrade(index VectorIndex, err error) error {
+	if dropErr := index.Drop(context.Background()); dropErr != nil {
+		i.logger.WithField("action", "dynamic_upgrade").WithField("id", i.id).
+			WithError(dropErr).Warn("failed to drop incomplete hnsw index")
+	}
+	return err
+}
+
+func (i *Index) currentConfig() ent.UserConfig {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.config
+}
+
+func (i *Index) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.index.SearchByVector(vector, k, allow)
+}
+
+func (i *Index) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.index.SearchByVectorDistance(vector, targetDistance, maxLimit, allow)
+}
+
+// SearchByVectorWithParams passes the search params on to the hnsw index,
+// the flat index ignores them
+func (i *Index) SearchByVectorWithParams(vector []float32, k int,
+	allow helpers.AllowList, params searchparams.VectorIndex,
+) ([]uint64, []float32, error) {
+	i.RLock()
+	defer i.RUnlock()
+
+	if searcher, ok := i.index.(paramsSearcher); ok {
+		return searcher.SearchByVectorWithParams(vector, k, allow, params)
+	}
+	return i.index.SearchByVector(vector, k, allow)
+}
+
+func (i *Index) SearchByVectorDistanceWithParams(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList, params searchparams.VectorIndex,
+) ([]uint64, []float32, error) {
+	i.RLock()
+	defer i.RUnlock()
+
+	if searcher, ok := i.index.(paramsSearcher); ok {
+		return searcher.SearchByVectorDistanceWithParams(vector, targetDistance,
+			maxLimit, allow, params)
+	}
+	return i.index.SearchByVectorDistance(vector, targetDistance, maxLimit, allow)
+}
+
+func (i *Index) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	i.Lock()
+	i.config = parsed
+	i.threshold.Store(int64(parsed.Threshold))
+	index, upgraded := i.index, i.upgraded
+	i.Unlock()
+
+	if upgraded {
+		return index.UpdateUserConfig(parsed.HNSW, callback)
+	}
+
+	if err := index.UpdateUserConfig(parsed.Flat, callback); err != nil {
+		return err
+	}
+
+	// a lower threshold might already have been crossed
+	if i.count.Load() >= int64(parsed.Threshold) {
+		i.startUpgrade()
+	}
+	return nil
+}
+
+// ValidateUserConfigUpdate validates the update against both the flat and
+// the hnsw index, as the shards of a class can be in different states
+func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
+	initialParsed, ok := initial.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from \"%s\" to \"%s\"",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+
+	if err := flat.ValidateUserConfigUpdate(initialParsed.Flat, updatedParsed.Flat); err != nil {
+		return errors.Wrap(err, "flat")
+	}
+
+	if err := hnsw.ValidateUserConfigUpdate(initialParsed.HNSW, updatedParsed.HNSW); err != nil {
+		return errors.Wrap(err, "hnsw")
+	}
+
+	return nil
+}
+
+func (i *Index) Drop(ctx context.Context) error {
+	i.cancelUpgrade()
+	i.upgradeWg.Wait()
+
+	i.Lock()
+	defer i.Unlock()
+
+	if err := i.index.Drop(ctx); err != nil {
+		return err
+	}
+
+	if err := os.Remove(i.markerPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove upgrade marker of dynamic index")
+	}
+	return nil
+}
+
+func (i *Index) Flush() error {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.index.Flush()
+}
+
+func (i *Index) Shutdown(ctx context.Context) error {
+	i.cancelUpgrade()
+	i.upgradeWg.Wait()
+
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.index.Shutdown(ctx)
+}
+
+func (i *Index) SwitchCommitLogs(ctx context.Context) error {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.index.SwitchCommitLogs(ctx)
+}
+
+func (i *Index) ListFiles(ctx context.Context) ([]string, error) {
+	i.RLock()
+	defer i.RUnlock()
+
+	files, err := i.index.ListFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if i.upgraded {
+		rel, err := filepath.Rel(i.rootPath, i.markerPath())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, rel)
+	}
+	return files, nil
+}
+
+// PostStartup counts the objects of a shard which hasn't been upgraded yet,
+// so that the threshold also applies to objects imported before a restart
+func (i *Index) PostStartup() {
+	i.RLock()
+	index, upgraded := i.index, i.upgraded
+	i.RUnlock()
+
+	index.PostStartup()
+	if upgraded {
+		return
+	}
+
+	var count int64
+	err := i.iterateVectors(func(id uint64, vector []float32) error {
+		if len(vector) > 0 {
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		i.logger.WithField("action", "dynamic_startup").WithField("id", i.id).
+			WithError(err).Error("failed to count objects of dynamic index")
+		return
+	}
+
+	if i.count.Add(count) >= i.threshold.Load() {
+		i.startUpgrade()
+	}
+}
+
+// Stats returns the stats of the hnsw index, ok is false as long as the index
+// has not been upgraded
+func (i *Index) Stats() (stats hnsw.Stats, ok bool) {
+	i.RLock()
+	defer i.RUnlock()
+
+	if index, isHnsw := i.index.(statsProvider); isHnsw {
+		return index.Stats(), true
+	}
+	return hnsw.Stats{}, false
+}
+
+func (i *Index) ValidateBeforeInsert(vector []float32) error {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.index.ValidateBeforeInsert(vector)
+}
+
+func (i *Index) Dump(labels ...string) {
+	i.RLock()
+	defer i.RUnlock()
+
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", i.id)
+	fmt.Printf("Upgraded: %t\n", i.upgraded)
+	fmt.Printf("Threshold: %d\n", i.threshold.Load())
+	fmt.Printf("--------------------------------------------------\n")
+	i.index.Dump(labels...)
+}
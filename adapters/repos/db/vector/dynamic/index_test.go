@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+)
+
+// testStore mimics the object store of a shard, which both indexes read the
+// vectors from
+type testStore struct {
+	sync.RWMutex
+	vectors map[uint64][]float32
+}
+
+func (s *testStore) put(id uint64, vec []float32) {
+	s.Lock()
+	defer s.Unlock()
+	s.vectors[id] = vec
+}
+
+func (s *testStore) vectorForID(ctx context.Context, id uint64) ([]float32, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	vec, ok := s.vectors[id]
+	if !ok {
+		return nil, storobj.NewErrNotFoundf(id, "not found")
+	}
+	return vec, nil
+}
+
+func (s *testStore) iterateVectors(fn func(id uint64, vector []float32) error) error {
+	s.RLock()
+	defer s.RUnlock()
+
+	for id, vec := range s.vectors {
+		if err := fn(id, vec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testIndex(t *testing.T, rootPath string, store *testStore, uc ent.UserConfig) *Index {
+	index, err := New(Config{
+		ID:             "dynamic-test",
+		RootPath:       rootPath,
+		IterateVectors: store.iterateVectors,
+		MakeFlat: func(uc ent.UserConfig) (VectorIndex, error) {
+			return flat.New(flat.Config{
+				ID:               "dynamic-test",
+				DistanceProvider: distancer.NewL2SquaredProvider(),
+				VectorForIDThunk: store.vectorForID,
+				IterateVectors:   store.iterateVectors,
+			}, uc.Flat)
+		},
+		MakeHNSW: func(uc ent.UserConfig) (VectorIndex, error) {
+			return hnsw.New(hnsw.Config{
+				RootPath:              rootPath,
+				ID:                    "dynamic-test",
+				MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+				DistanceProvider:      distancer.NewL2SquaredProvider(),
+				VectorForIDThunk:      store.vectorForID,
+			}, uc.HNSW, cyclemanager.NewNoop())
+		},
+	}, uc)
+	require.Nil(t, err)
+	index.PostStartup()
+	return index
+}
+
+func TestIndex_Upgrade(t *testing.T) {
+	rootPath := t.TempDir()
+	vectors, _ := testinghelpers.RandomVecs(200, 0, 16)
+	store := &testStore{vectors: map[uint64][]float32{}}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+	uc.HNSW.Distance = "l2-squared"
+	uc.Flat.Distance = "l2-squared"
+	uc.Threshold = 100
+
+	index := testIndex(t, rootPath, store, uc)
+
+	t.Run("stays flat below the threshold", func(t *testing.T) {
+		for i := 0; i < 99; i++ {
+			store.put(uint64(i), vectors[i])
+			require.Nil(t, index.Add(uint64(i), vectors[i]))
+		}
+		assert.False(t, index.Upgraded())
+
+		ids, _, err := index.SearchByVector(vectors[10], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{10}, ids)
+	})
+
+	t.Run("upgrades to hnsw once the threshold is crossed", func(t *testing.T) {
+		for i := 99; i < len(vectors); i++ {
+			store.put(uint64(i), vectors[i])
+			require.Nil(t, index.Add(uint64(i), vectors[i]))
+		}
+
+		assert.Eventually(t, index.Upgraded, 5*time.Second, 10*time.Millisecond)
+		_, err := os.Stat(filepath.Join(rootPath, "dynamic-test.dynamic.upgraded"))
+		assert.Nil(t, err)
+
+		for _, id := range []uint64{10, 120, 199} {
+			ids, _, err := index.SearchByVector(vectors[id], 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{id}, ids)
+		}
+	})
+
+	t.Run("starts as hnsw after a restart", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(context.Background()))
+
+		restarted := testIndex(t, rootPath, store, uc)
+		assert.True(t, restarted.Upgraded())
+	})
+}
+
+func TestIndex_UpgradeOnStartup(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecs(50, 0, 16)
+	store := &testStore{vectors: map[uint64][]float32{}}
+	for i, vec := range vectors {
+		store.put(uint64(i), vec)
+	}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.Threshold = 20
+
+	// the objects have been imported before the restart, they count towards
+	// the threshold nonetheless
+	index := testIndex(t, t.TempDir(), store, uc)
+	assert.Eventually(t, index.Upgraded, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestValidateUserConfigUpdate(t *testing.T) {
+	initial := ent.NewDefaultUserConfig()
+
+	updated := ent.NewDefaultUserConfig()
+	updated.Threshold = 50
+	updated.HNSW.EF = 200
+	assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+
+	updated = ent.NewDefaultUserConfig()
+	updated.Distance = "dot"
+	err := ValidateUserConfigUpdate(initial, updated)
+	require.NotNil(t, err)
+	assert.Equal(t, "distance is immutable: attempted change from \"cosine\" to \"dot\"", err.Error())
+
+	updated = ent.NewDefaultUserConfig()
+	updated.Flat.BQ.Enabled = true
+	err = ValidateUserConfigUpdate(initial, updated)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "flat: bq.enabled is immutable")
+}
@@ -0,0 +1,474 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+// VectorForID reads the vector of a single object from disk
+type VectorForID func(ctx context.Context, id uint64) ([]float32, error)
+
+// IterateVectorsFn calls fn with the doc id and vector of every object which is
+// persisted in the shard
+type IterateVectorsFn func(fn func(id uint64, vector []float32) error) error
+
+type Config struct {
+	ID               string
+	Logger           logrus.FieldLogger
+	DistanceProvider distancer.Provider
+	VectorForIDThunk VectorForID
+	IterateVectors   IterateVectorsFn
+}
+
+func (c Config) Validate() error {
+	if c.ID == "" {
+		return errors.Errorf("id cannot be empty")
+	}
+
+	if c.DistanceProvider == nil {
+		return errors.Errorf("distancerProvider cannot be nil")
+	}
+
+	if c.VectorForIDThunk == nil {
+		return errors.Errorf("vectorForID cannot be nil")
+	}
+
+	if c.IterateVectors == nil {
+		return errors.Errorf("iterateVectors cannot be nil")
+	}
+
+	return nil
+}
+
+// Index searches by brute force. Without compression it keeps nothing in
+// memory and scans the vectors in the object store, so it has no memory
+// footprint at all. With binary quantization enabled only the one bit per
+// dimension codes are kept in memory and scanned, the closest candidates are
+// then rescored with the full-precision vectors read from disk.
+type Index struct {
+	sync.RWMutex
+	id             string
+	logger         logrus.FieldLogger
+	distancer      distancer.Provider
+	vectorForID    VectorForID
+	iterateVectors IterateVectorsFn
+	dims           atomic.Int32
+	rescoreLimit   atomic.Int64
+
+	// bq and codes are only set if binary quantization is enabled
+	bq    *ssdhelpers.BinaryQuantizer
+	codes map[uint64][]byte
+}
+
+func New(cfg Config, uc ent.UserConfig) (*Index, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	index := &Index{
+		id:             cfg.ID,
+		logger:         cfg.Logger,
+		distancer:      cfg.DistanceProvider,
+		vectorForID:    cfg.VectorForIDThunk,
+		iterateVectors: cfg.IterateVectors,
+	}
+	index.rescoreLimit.Store(int64(uc.BQ.RescoreLimit))
+
+	if uc.BQ.Enabled {
+		index.bq = ssdhelpers.NewBinaryQuantizer(cfg.DistanceProvider)
+		index.codes = map[uint64][]byte{}
+	}
+
+	return index, nil
+}
+
+func (i *Index) compressed() bool {
+	return i.bq != nil
+}
+
+// normalize prepares vectors for the cosine distance, which expects them to
+// be normalized. The vectors in the object store are kept as imported.
+func (i *Index) normalize(vector []float32) []float32 {
+	if i.distancer.Type() == "cosine-dot" {
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func (i *Index) Add(id uint64, vector []float32) error {
+	if err := i.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+	i.dims.CompareAndSwap(0, int32(len(vector)))
+
+	if !i.compressed() {
+		// the vector is already persisted with the object, there is nothing
+		// else to keep track of
+		return nil
+	}
+
+	code := i.bq.Encode(i.normalize(vector))
+
+	i.Lock()
+	defer i.Unlock()
+	i.codes[id] = code
+	return nil
+}
+
+func (i *Index) Delete(ids ...uint64) error {
+	if !i.compressed() {
+		return nil
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	for _, id := range ids {
+		delete(i.codes, id)
+	}
+	return nil
+}
+
+func (i *Index) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	vector = i.normalize(vector)
+	if i.compressed() {
+		return i.searchCompressed(vector, k, allow)
+	}
+
+	heap := priorityqueue.NewMax(k)
+	err := i.scan(allow, func(id uint64, candidate []float32) error {
+		dist, _, err := i.distancer.SingleDist(vector, i.normalize(candidate))
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance to doc id %d", id)
+		}
+		insertBounded(heap, id, dist, k)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := drain(heap)
+	return ids, dists, nil
+}
+
+// searchCompressed finds the candidates by their hamming distance and rescores
+// them with the full-precision vectors
+func (i *Index) searchCompressed(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	limit := int(i.rescoreLimit.Load())
+	if limit < k {
+		limit = k
+	}
+
+	code := i.bq.Encode(vector)
+	candidates := priorityqueue.NewMax(limit)
+
+	i.RLock()
+	for id, candidate := range i.codes {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		insertBounded(candidates, id, i.bq.DistanceBetweenCompressedVectors(code, candidate), limit)
+	}
+	i.RUnlock()
+
+	heap := priorityqueue.NewMax(k)
+	for candidates.Len() > 0 {
+		id := candidates.Pop().ID
+		candidate, err := i.vectorForID(context.Background(), id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				// the object was deleted in the meantime
+				continue
+			}
+			return nil, nil, errors.Wrapf(err, "rescore doc id %d", id)
+		}
+
+		dist, _, err := i.distancer.SingleDist(vector, i.normalize(candidate))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "calculate distance to doc id %d", id)
+		}
+		insertBounded(heap, id, dist, k)
+	}
+
+	ids, dists := drain(heap)
+	return ids, dists, nil
+}
+
+// SearchByVectorDistance always compares the full-precision vectors, as the
+// hamming distance of compressed vectors can't be translated into a distance
+// threshold
+func (i *Index) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	vector = i.normalize(vector)
+
+	var ids []uint64
+	var dists []float32
+	err := i.scan(allow, func(id uint64, candidate []float32) error {
+		dist, _, err := i.distancer.SingleDist(vector, i.normalize(candidate))
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance to doc id %d", id)
+		}
+
+		if dist <= targetDistance ||
+			floatcomp.InDelta(float64(dist), float64(targetDistance), 1e-6) {
+			ids = append(ids, id)
+			dists = append(dists, dist)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Sort(byDistance{ids: ids, dists: dists})
+	if maxLimit > 0 && int64(len(ids)) > maxLimit {
+		ids, dists = ids[:maxLimit], dists[:maxLimit]
+	}
+	return ids, dists, nil
+}
+
+// scan calls fn with the vector of every object on disk. If there is an allow
+// list, only the allowed objects are read, so that restrictive filters don't
+// pay for reading the entire shard.
+func (i *Index) scan(allow helpers.AllowList, fn func(id uint64, vector []float32) error) error {
+	if allow == nil {
+		return i.iterateVectors(func(id uint64, vector []float32) error {
+			if len(vector) == 0 {
+				return nil
+			}
+			return fn(id, vector)
+		})
+	}
+
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		vector, err := i.vectorForID(context.Background(), id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				continue
+			}
+			return errors.Wrapf(err, "read vector of doc id %d", id)
+		}
+		if len(vector) == 0 {
+			continue
+		}
+
+		if err := fn(id, vector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *Index) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	defer callback()
+
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	i.rescoreLimit.Store(int64(parsed.BQ.RescoreLimit))
+	return nil
+}
+
+// ValidateUserConfigUpdate rejects changes to the settings which determine
+// how the already imported vectors are compared
+func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
+	initialParsed, ok := initial.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from \"%s\" to \"%s\"",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+
+	if initialParsed.BQ.Enabled != updatedParsed.BQ.Enabled {
+		return errors.Errorf("bq.enabled is immutable: attempted change from \"%t\" to \"%t\"",
+			initialParsed.BQ.Enabled, updatedParsed.BQ.Enabled)
+	}
+
+	return nil
+}
+
+func (i *Index) Drop(context.Context) error {
+	i.Lock()
+	defer i.Unlock()
+
+	if i.compressed() {
+		i.codes = map[uint64][]byte{}
+	}
+	return nil
+}
+
+func (i *Index) Flush() error {
+	return nil
+}
+
+func (i *Index) Shutdown(context.Context) error {
+	return nil
+}
+
+func (i *Index) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (i *Index) ListFiles(context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (i *Index) ValidateBeforeInsert(vector []float32) error {
+	if len(vector) == 0 {
+		return fmt.Errorf("insert called with nil-vector")
+	}
+
+	dims := int(i.dims.Load())
+	if dims != 0 && dims != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+	return nil
+}
+
+// PostStartup restores the dimensions and, if compression is enabled, the
+// codes of all objects which are already persisted
+func (i *Index) PostStartup() {
+	i.Lock()
+	defer i.Unlock()
+
+	err := i.iterateVectors(func(id uint64, vector []float32) error {
+		if len(vector) == 0 {
+			return nil
+		}
+
+		i.dims.CompareAndSwap(0, int32(len(vector)))
+		if !i.compressed() {
+			// only the dimensions are needed, which the first vector is
+			// enough for
+			return errStopIteration
+		}
+
+		i.codes[id] = i.bq.Encode(i.normalize(vector))
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStopIteration) {
+		i.logger.WithField("action", "flat_startup").WithField("id", i.id).
+			WithError(err).Error("failed to load vectors into flat index")
+	}
+}
+
+var errStopIteration = errors.New("stop iteration")
+
+func (i *Index) Dump(labels ...string) {
+	i.RLock()
+	defer i.RUnlock()
+
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", i.id)
+	fmt.Printf("Dimensions: %d\n", i.dims.Load())
+	fmt.Printf("Compressed objects: %d\n", len(i.codes))
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+// insertBounded keeps the k closest elements in a max heap
+func insertBounded(heap *priorityqueue.Queue, id uint64, dist float32, k int) {
+	if heap.Len() < k {
+		heap.Insert(id, dist)
+		return
+	}
+
+	if dist < heap.Top().Dist {
+		heap.Pop()
+		heap.Insert(id, dist)
+	}
+}
+
+// drain empties a max heap into ids and distances ordered from the closest to
+// the most distant element
+func drain(heap *priorityqueue.Queue) ([]uint64, []float32) {
+	ids := make([]uint64, heap.Len())
+	dists := make([]float32, heap.Len())
+	for pos := len(ids) - 1; pos >= 0; pos-- {
+		item := heap.Pop()
+		ids[pos] = item.ID
+		dists[pos] = item.Dist
+	}
+	return ids, dists
+}
+
+type byDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (s byDistance) Len() int {
+	return len(s.ids)
+}
+
+func (s byDistance) Less(a, b int) bool {
+	if s.dists[a] == s.dists[b] {
+		return s.ids[a] < s.ids[b]
+	}
+	return s.dists[a] < s.dists[b]
+}
+
+func (s byDistance) Swap(a, b int) {
+	s.ids[a], s.ids[b] = s.ids[b], s.ids[a]
+	s.dists[a], s.dists[b] = s.dists[b], s.dists[a]
+}
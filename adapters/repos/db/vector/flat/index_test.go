@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+// testStore mimics the object store of a shard, which the flat index reads
+// the vectors from
+type testStore map[uint64][]float32
+
+func (s testStore) vectorForID(ctx context.Context, id uint64) ([]float32, error) {
+	vec, ok := s[id]
+	if !ok {
+		return nil, storobj.NewErrNotFoundf(id, "not found")
+	}
+	return vec, nil
+}
+
+func (s testStore) iterateVectors(fn func(id uint64, vector []float32) error) error {
+	for id, vec := range s {
+		if err := fn(id, vec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testIndex(t *testing.T, store testStore, uc ent.UserConfig) *Index {
+	index, err := New(Config{
+		ID:               "flat-test",
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: store.vectorForID,
+		IterateVectors:   store.iterateVectors,
+	}, uc)
+	require.Nil(t, err)
+
+	for id, vec := range store {
+		require.Nil(t, index.Add(id, vec))
+	}
+	return index
+}
+
+// bruteForce returns the ids of the k closest vectors
+func bruteForce(store testStore, query []float32, k int) []uint64 {
+	d := distancer.NewL2SquaredProvider()
+	ids := make([]uint64, 0, len(store))
+	for id := range store {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(a, b int) bool {
+		da, _, _ := d.SingleDist(query, store[ids[a]])
+		db, _, _ := d.SingleDist(query, store[ids[b]])
+		return da < db
+	})
+	return ids[:k]
+}
+
+func TestIndex_SearchByVector(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(300, 10, 32)
+	store := testStore{}
+	for i, vec := range vectors {
+		store[uint64(i)] = vec
+	}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+
+	t.Run("uncompressed results are exact", func(t *testing.T) {
+		index := testIndex(t, store, uc)
+		for _, query := range queries {
+			ids, dists, err := index.SearchByVector(query, 10, nil)
+			require.Nil(t, err)
+			assert.Equal(t, bruteForce(store, query, 10), ids)
+			assert.True(t, sort.Float64sAreSorted(toFloat64(dists)))
+		}
+	})
+
+	t.Run("with an allow list", func(t *testing.T) {
+		index := testIndex(t, store, uc)
+		allow := helpers.NewAllowList(3, 7, 11, 500)
+
+		ids, _, err := index.SearchByVector(vectors[7], 10, allow)
+		require.Nil(t, err)
+		require.Len(t, ids, 3)
+		assert.Equal(t, uint64(7), ids[0])
+		assert.ElementsMatch(t, []uint64{3, 7, 11}, ids)
+	})
+
+	t.Run("bq candidates are rescored", func(t *testing.T) {
+		bqConfig := uc
+		bqConfig.BQ = ent.BQConfig{Enabled: true, RescoreLimit: 300}
+		index := testIndex(t, store, bqConfig)
+
+		// all objects are rescored, so the results must be exact
+		for _, query := range queries {
+			ids, _, err := index.SearchByVector(query, 10, nil)
+			require.Nil(t, err)
+			assert.Equal(t, bruteForce(store, query, 10), ids)
+		}
+	})
+
+	t.Run("bq finds the object itself", func(t *testing.T) {
+		bqConfig := uc
+		bqConfig.BQ = ent.BQConfig{Enabled: true, RescoreLimit: 20}
+		index := testIndex(t, store, bqConfig)
+
+		for _, id := range []uint64{5, 50, 150} {
+			ids, dists, err := index.SearchByVector(vectors[id], 5, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, 5)
+			assert.Equal(t, id, ids[0])
+			assert.InDelta(t, 0, dists[0], 0.0001)
+		}
+	})
+
+	t.Run("deleted objects are not returned with bq", func(t *testing.T) {
+		bqConfig := uc
+		bqConfig.BQ = ent.BQConfig{Enabled: true, RescoreLimit: 20}
+		index := testIndex(t, store, bqConfig)
+
+		require.Nil(t, index.Delete(42))
+		ids, _, err := index.SearchByVector(vectors[42], 5, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, ids, uint64(42))
+	})
+}
+
+func TestIndex_SearchByVectorDistance(t *testing.T) {
+	store := testStore{
+		1: {0, 0},
+		2: {1, 0},
+		3: {0, 2},
+		4: {3, 3},
+	}
+	uc := ent.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+	index := testIndex(t, store, uc)
+
+	ids, dists, err := index.SearchByVectorDistance([]float32{0, 0}, 4, -1, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, ids)
+	assert.Equal(t, []float32{0, 1, 4}, dists)
+
+	ids, _, err = index.SearchByVectorDistance([]float32{0, 0}, 4, 2, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2}, ids)
+}
+
+func TestIndex_ValidateBeforeInsert(t *testing.T) {
+	index := testIndex(t, testStore{1: {1, 2, 3}}, ent.NewDefaultUserConfig())
+
+	assert.Nil(t, index.ValidateBeforeInsert([]float32{3, 2, 1}))
+	assert.NotNil(t, index.ValidateBeforeInsert([]float32{1, 2}))
+	assert.NotNil(t, index.ValidateBeforeInsert(nil))
+}
+
+func TestIndex_PostStartup(t *testing.T) {
+	store := testStore{1: {1, 1}, 2: {-1, -1}}
+	uc := ent.NewDefaultUserConfig()
+	uc.BQ.Enabled = true
+
+	index, err := New(Config{
+		ID:               "flat-test",
+		DistanceProvider: distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk: store.vectorForID,
+		IterateVectors:   store.iterateVectors,
+	}, uc)
+	require.Nil(t, err)
+	index.PostStartup()
+
+	ids, _, err := index.SearchByVector([]float32{2, 2}, 1, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1}, ids)
+	assert.NotNil(t, index.ValidateBeforeInsert([]float32{1, 2, 3}))
+}
+
+func TestValidateUserConfigUpdate(t *testing.T) {
+	initial := ent.NewDefaultUserConfig()
+
+	updated := initial
+	updated.BQ.RescoreLimit = 500
+	assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+
+	updated = initial
+	updated.BQ.Enabled = true
+	err := ValidateUserConfigUpdate(initial, updated)
+	require.NotNil(t, err)
+	assert.Equal(t, "bq.enabled is immutable: attempted change from \"false\" to \"true\"", err.Error())
+
+	updated = initial
+	updated.Distance = "dot"
+	err = ValidateUserConfigUpdate(initial, updated)
+	require.NotNil(t, err)
+	assert.Equal(t, "distance is immutable: attempted change from \"cosine\" to \"dot\"", err.Error())
+}
+
+func toFloat64(in []float32) []float64 {
+	out := make([]float64, len(in))
+	for i := range in {
+		out[i] = float64(in[i])
+	}
+	return out
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package geo
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// areaOutlineSamples is the number of points per edge of an area which are
+// used to find the circle enclosing it
+const areaOutlineSamples = 16
+
+// WithinPolygon searches the index for coordinates inside the specified
+// polygon. The edges of the polygon are straight lines between the
+// latitudes and longitudes of its corners. It is thread-safe and can be
+// called concurrently.
+func (i *Index) WithinPolygon(ctx context.Context,
+	polygon filters.GeoPolygon,
+) ([]uint64, error) {
+	if len(polygon.Coordinates) < 3 {
+		return nil, fmt.Errorf("invalid arguments: polygon must have at least 3 coordinates, got %d",
+			len(polygon.Coordinates))
+	}
+
+	corners := make([][]float32, len(polygon.Coordinates))
+	for c := range polygon.Coordinates {
+		corner, err := geoCoordiantesToVector(&polygon.Coordinates[c])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid arguments: coordinates %d", c)
+		}
+		corners[c] = corner
+	}
+
+	return i.withinArea(ctx, corners, func(point []float32) bool {
+		return polygonContains(corners, point)
+	})
+}
+
+// WithinBoundingBox searches the index for coordinates inside the specified
+// box. A box whose top left corner lies east of its bottom right corner
+// spans the antimeridian. It is thread-safe and can be called concurrently.
+func (i *Index) WithinBoundingBox(ctx context.Context,
+	box filters.GeoBoundingBox,
+) ([]uint64, error) {
+	if box.TopLeft == nil || box.BottomRight == nil {
+		return nil, fmt.Errorf("invalid arguments: topLeft and bottomRight of box must be set")
+	}
+
+	topLeft, err := geoCoordiantesToVector(box.TopLeft)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid arguments: topLeft")
+	}
+	bottomRight, err := geoCoordiantesToVector(box.BottomRight)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid arguments: bottomRight")
+	}
+
+	north, south := topLeft[0], bottomRight[0]
+	west, east := topLeft[1], bottomRight[1]
+	if east < west {
+		// spanning the antimeridian, continue the longitudes beyond 180
+		east += 360
+	}
+
+	corners := [][]float32{{north, west}, {north, east}, {south, east}, {south, west}}
+	return i.withinArea(ctx, corners, func(point []float32) bool {
+		lon := point[1]
+		if lon < west {
+			lon += 360
+		}
+		return point[0] <= north && point[0] >= south && lon >= west && lon <= east
+	})
+}
+
+// withinArea searches the circle enclosing the outline of the area and
+// keeps the results which are contained in the area
+func (i *Index) withinArea(ctx context.Context, outline [][]float32,
+	contains func(point []float32) bool,
+) ([]uint64, error) {
+	center, radius, err := enclosingCircle(outline)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := i.vectorIndex.KnnSearchByVectorMaxDist(center, radius, 800, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := candidates[:0]
+	for _, id := range candidates {
+		point, err := i.config.CoordinatesForID.VectorForID(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "coordinates of candidate %d", id)
+		}
+		if contains(point) {
+			out = append(out, id)
+		}
+	}
+
+	return out, nil
+}
+
+// enclosingCircle returns the center of the bounds of the outline and the
+// largest distance from it to any point sampled along the outline
+func enclosingCircle(outline [][]float32) ([]float32, float32, error) {
+	minLat, maxLat := outline[0][0], outline[0][0]
+	minLon, maxLon := outline[0][1], outline[0][1]
+	for _, point := range outline[1:] {
+		minLat = float32(math.Min(float64(minLat), float64(point[0])))
+		maxLat = float32(math.Max(float64(maxLat), float64(point[0])))
+		minLon = float32(math.Min(float64(minLon), float64(point[1])))
+		maxLon = float32(math.Max(float64(maxLon), float64(point[1])))
+	}
+	center := []float32{(minLat + maxLat) / 2, (minLon + maxLon) / 2}
+
+	dist := distancer.NewGeoProvider().New(center)
+	radius := float32(0)
+	for p := range outline {
+		from, to := outline[p], outline[(p+1)%len(outline)]
+		for s := 0; s < areaOutlineSamples; s++ {
+			share := float32(s) / areaOutlineSamples
+			d, _, err := dist.Distance([]float32{
+				from[0] + (to[0]-from[0])*share,
+				from[1] + (to[1]-from[1])*share,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			if d > radius {
+				radius = d
+			}
+		}
+	}
+
+	// the outline runs straight between the samples, the margin makes sure
+	// the circle also encloses the points in between
+	return center, radius * 1.01, nil
+}
+
+// polygonContains casts a ray from the point and counts how often it crosses
+// the edges of the polygon, an odd count means the point is inside
+func polygonContains(corners [][]float32, point []float32) bool {
+	lat, lon := point[0], point[1]
+	inside := false
+	for c, prev := 0, len(corners)-1; c < len(corners); prev, c = c, c+1 {
+		latC, lonC := corners[c][0], corners[c][1]
+		latP, lonP := corners[prev][0], corners[prev][1]
+		if (latC > lat) != (latP > lat) &&
+			lon < (lonP-lonC)*(lat-latC)/(latP-latC)+lonC {
+			inside = !inside
+		}
+	}
+	return inside
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package geo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestGeoAreas(t *testing.T) {
+	elements := []models.GeoCoordinates{
+		{ // coordinates of munich
+			Latitude:  ptFloat32(48.13743),
+			Longitude: ptFloat32(11.57549),
+		},
+		{ // coordinates of stuttgart
+			Latitude:  ptFloat32(48.78232),
+			Longitude: ptFloat32(9.17702),
+		},
+		{ // coordinates of suva, fiji
+			Latitude:  ptFloat32(-18.12482),
+			Longitude: ptFloat32(178.45003),
+		},
+	}
+
+	getCoordinates := func(ctx context.Context, id uint64) (*models.GeoCoordinates, error) {
+		return &elements[id], nil
+	}
+
+	geoIndex, err := NewIndex(Config{
+		ID:                 "unit-test",
+		CoordinatesForID:   getCoordinates,
+		DisablePersistence: true,
+		RootPath:           "doesnt-matter-persistence-is-off",
+	}, cyclemanager.NewNoop(), cyclemanager.NewNoop())
+	require.Nil(t, err)
+
+	for id, coordinates := range elements {
+		err := geoIndex.Add(uint64(id), &coordinates)
+		require.Nil(t, err)
+	}
+
+	coords := func(lat, lon float32) models.GeoCoordinates {
+		return models.GeoCoordinates{Latitude: ptFloat32(lat), Longitude: ptFloat32(lon)}
+	}
+
+	t.Run("polygon around bavaria", func(t *testing.T) {
+		results, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Coordinates: []models.GeoCoordinates{
+				coords(50.5, 10), coords(50, 13.8), coords(47.4, 13), coords(47.5, 10),
+			},
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0}, results)
+	})
+
+	t.Run("triangle containing both cities", func(t *testing.T) {
+		results, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Coordinates: []models.GeoCoordinates{
+				coords(50, 8), coords(50, 13), coords(46, 11),
+			},
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1}, results)
+	})
+
+	t.Run("polygon with too few corners", func(t *testing.T) {
+		_, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Coordinates: []models.GeoCoordinates{coords(50, 8), coords(50, 13)},
+		})
+		assert.EqualError(t, err, "invalid arguments: polygon must have at least 3 coordinates, got 2")
+	})
+
+	t.Run("box around stuttgart", func(t *testing.T) {
+		topLeft, bottomRight := coords(49, 9), coords(48.5, 10)
+		results, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     &topLeft,
+			BottomRight: &bottomRight,
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{1}, results)
+	})
+
+	t.Run("box spanning the antimeridian", func(t *testing.T) {
+		topLeft, bottomRight := coords(-15, 175), coords(-20, -175)
+		results, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     &topLeft,
+			BottomRight: &bottomRight,
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{2}, results)
+	})
+
+	t.Run("box missing a corner", func(t *testing.T) {
+		topLeft := coords(49, 9)
+		_, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft: &topLeft,
+		})
+		assert.EqualError(t, err, "invalid arguments: topLeft and bottomRight of box must be set")
+	})
+}
@@ -82,6 +82,21 @@ func (h *hnsw) ListFiles(ctx context.Context) ([]string, error) {
 	}
 	delete(found, path)
 
+	// snapshots replace the commit logs they were created from, so they must
+	// be part of the backup as well
+	snapshots, err := filepath.Glob(filepath.Join(
+		snapshotDirectory(h.commitLog.RootPath(), h.commitLog.ID()), "*.snapshot"))
+	if err != nil {
+		return nil, errors.Wrap(err, "list snapshot files")
+	}
+	for _, snapshot := range snapshots {
+		rel, err := filepath.Rel(h.commitLog.RootPath(), snapshot)
+		if err != nil {
+			return nil, errors.Wrap(err, "snapshot file path")
+		}
+		found[rel] = struct{}{}
+	}
+
 	files, i := make([]string, len(found)), 0
 	for file := range found {
 		files[i] = file
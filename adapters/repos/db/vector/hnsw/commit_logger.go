@@ -40,6 +40,51 @@ func commitLogDirectory(rootPath, name string) string {
 	return fmt.Sprintf("%s/%s.hnsw.commitlog.d", rootPath, name)
 }
 
+// IndexFilesExist reports whether the commit logs of the index with the given
+// id exist on disk
+func IndexFilesExist(rootPath, id string) (bool, error) {
+	if _, err := os.Stat(commitLogDirectory(rootPath, id)); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "stat commit log directory")
+	}
+	return true, nil
+}
+
+// RenameIndexFiles moves the commit logs and snapshots of the index with id
+// "from" to id "to", so that an index which was built under a temporary id
+// can take the place of another one. Both indexes need to be shut down.
+func RenameIndexFiles(rootPath, from, to string) error {
+	renames := [][2]string{
+		{commitLogDirectory(rootPath, from), commitLogDirectory(rootPath, to)},
+		{snapshotDirectory(rootPath, from), snapshotDirectory(rootPath, to)},
+	}
+	for _, r := range renames {
+		if _, err := os.Stat(r[0]); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return errors.Wrapf(err, "stat %q", r[0])
+		}
+		if err := os.RemoveAll(r[1]); err != nil {
+			return errors.Wrapf(err, "remove %q", r[1])
+		}
+		if err := os.Rename(r[0], r[1]); err != nil {
+			return errors.Wrapf(err, "rename %q", r[0])
+		}
+	}
+
+	// the mmap vector cache is truncated on every startup, so there is nothing
+	// worth keeping
+	vectors := filepath.Join(rootPath, fmt.Sprintf("%s.hnsw.vectors", from))
+	if err := os.Remove(vectors); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove %q", vectors)
+	}
+
+	return nil
+}
+
 func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 	maintenanceCycle cyclemanager.CycleManager, opts ...CommitlogOption,
 ) (*hnswCommitLogger, error) {
@@ -52,6 +97,8 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		// both can be overwritten using functional options
 		maxSizeIndividual: defaultCommitLogSize / 5,
 		maxSizeCombining:  defaultCommitLogSize,
+
+		snapshotMinDeltaSizePercentage: defaultSnapshotMinDeltaSizePercentage,
 	}
 
 	for _, o := range opts {
@@ -60,6 +107,12 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		}
 	}
 
+	// a previous snapshot might have been completed without its commit logs
+	// being cleaned up
+	if err := removeCommitLogsCoveredBySnapshot(rootPath, name); err != nil {
+		return nil, errors.Wrap(err, "remove commit logs covered by snapshot")
+	}
+
 	fd, err := getLatestCommitFileOrCreate(rootPath, name)
 	if err != nil {
 		return nil, err
@@ -250,6 +303,9 @@ type hnswCommitLogger struct {
 	maxSizeCombining  int64
 	commitLogger      *commitlog.Logger
 
+	snapshotsEnabled               bool
+	snapshotMinDeltaSizePercentage int
+
 	unregisterSwitchLogs   cyclemanager.UnregisterFunc
 	unregisterCondenseLogs cyclemanager.UnregisterFunc
 }
@@ -269,6 +325,7 @@ const (
 	ClearLinksAtLevel // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1701
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
+	AddSQ
 )
 
 func (t HnswCommitType) String() string {
@@ -297,6 +354,8 @@ func (t HnswCommitType) String() string {
 		return "ClearLinksAtLevel"
 	case AddPQ:
 		return "AddProductQuantizer"
+	case AddSQ:
+		return "AddScalarQuantizer"
 	}
 	return "unknown commit type"
 }
@@ -312,6 +371,13 @@ func (l *hnswCommitLogger) AddPQ(data ssdhelpers.PQData) error {
 	return l.commitLogger.AddPQ(data)
 }
 
+func (l *hnswCommitLogger) AddSQ(data ssdhelpers.SQData) error {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.commitLogger.AddSQ(data)
+}
+
 // AddNode adds an empty node
 func (l *hnswCommitLogger) AddNode(node *vertex) error {
 	l.Lock()
@@ -426,7 +492,14 @@ func (l *hnswCommitLogger) startCombineAndCondenseLogs(shouldBreak cyclemanager.
 			WithField("action", "hnsw_commit_log_condensing").
 			Error("hnsw commit log maintenance (condensing) failed")
 	}
-	return executed1 || executed2
+
+	executed3, err := l.createSnapshot()
+	if err != nil {
+		l.logger.WithError(err).
+			WithField("action", "hnsw_create_snapshot").
+			Error("hnsw commit log maintenance (snapshot) failed")
+	}
+	return executed1 || executed2 || executed3
 }
 
 func (l *hnswCommitLogger) SwitchCommitLogs(force bool) error {
@@ -541,6 +614,11 @@ func (l *hnswCommitLogger) Drop(ctx context.Context) error {
 			return errors.Wrap(err, "delete commit files directory")
 		}
 	}
+
+	// remove snapshot directory if exists
+	if err := os.RemoveAll(snapshotDirectory(l.rootPath, l.id)); err != nil {
+		return errors.Wrap(err, "delete snapshot directory")
+	}
 	return nil
 }
 
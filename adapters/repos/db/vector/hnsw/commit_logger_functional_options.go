@@ -11,6 +11,8 @@
 
 package hnsw
 
+import "github.com/pkg/errors"
+
 type CommitlogOption func(l *hnswCommitLogger) error
 
 func WithCommitlogThreshold(size int64) CommitlogOption {
@@ -26,3 +28,26 @@ func WithCommitlogThresholdForCombining(size int64) CommitlogOption {
 		return nil
 	}
 }
+
+// WithSnapshotsEnabled makes the commit logger periodically write the
+// condensed state to a snapshot, so that startup no longer needs to replay
+// all commit logs
+func WithSnapshotsEnabled(enabled bool) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.snapshotsEnabled = enabled
+		return nil
+	}
+}
+
+// WithSnapshotMinDeltaSizePercentage sets how large the commit logs written
+// since the last snapshot need to be, relative to that snapshot, before a new
+// snapshot is created
+func WithSnapshotMinDeltaSizePercentage(percentage int) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		if percentage < 0 {
+			return errors.Errorf("snapshot min delta size percentage must not be negative, got %d", percentage)
+		}
+		l.snapshotMinDeltaSizePercentage = percentage
+		return nil
+	}
+}
@@ -29,6 +29,10 @@ func (n *NoopCommitLogger) AddPQ(data ssdhelpers.PQData) error {
 	return nil
 }
 
+func (n *NoopCommitLogger) AddSQ(data ssdhelpers.SQData) error {
+	return nil
+}
+
 func (n *NoopCommitLogger) AddNode(node *vertex) error {
 	return nil
 }
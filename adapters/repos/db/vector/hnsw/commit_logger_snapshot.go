@@ -0,0 +1,631 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// snapshotVersion is written as the first byte of every snapshot, so that the
+// format can evolve without breaking existing files
+const snapshotVersion uint8 = 1
+
+// defaultSnapshotMinDeltaSizePercentage is the size of the commit logs
+// written since the last snapshot, relative to that snapshot, which is
+// required before a new snapshot is created.
+const defaultSnapshotMinDeltaSizePercentage = 5
+
+func snapshotDirectory(rootPath, name string) string {
+	return fmt.Sprintf("%s/%s.hnsw.snapshot.d", rootPath, name)
+}
+
+// snapshotFileName is named after the last commit log it contains, so that
+// the commit logs which still need to be replayed on top of it can be
+// identified by their timestamps alone
+func snapshotFileName(rootPath, name string, lastCommitLog int64) string {
+	return fmt.Sprintf("%s/%d.snapshot", snapshotDirectory(rootPath, name), lastCommitLog)
+}
+
+// getLatestSnapshot returns the path and commit log timestamp of the most
+// recent snapshot. If no snapshot is present, the third arg is false.
+// Leftovers of snapshots that were never completed are removed.
+func getLatestSnapshot(rootPath, name string) (string, int64, bool, error) {
+	dir := snapshotDirectory(rootPath, name)
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", 0, false, nil
+		}
+		return "", 0, false, errors.Wrap(err, "browse snapshot directory")
+	}
+
+	var (
+		latest   string
+		latestTS int64
+		found    bool
+	)
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), ".snapshot.tmp") {
+			// the snapshot was never completed, the commit logs it would have
+			// contained still exist
+			if err := os.Remove(filepath.Join(dir, file.Name())); err != nil {
+				return "", 0, false, errors.Wrap(err, "remove tmp snapshot file")
+			}
+			continue
+		}
+
+		if !strings.HasSuffix(file.Name(), ".snapshot") {
+			continue
+		}
+
+		ts, err := strconv.ParseInt(strings.TrimSuffix(file.Name(), ".snapshot"), 10, 64)
+		if err != nil {
+			return "", 0, false, errors.Wrapf(err, "parse snapshot name %q", file.Name())
+		}
+
+		if !found || ts > latestTS {
+			latest = filepath.Join(dir, file.Name())
+			latestTS = ts
+			found = true
+		}
+	}
+
+	return latest, latestTS, found, nil
+}
+
+// commitLogsAfter removes all commit logs which are already contained in the
+// snapshot with the given timestamp
+func commitLogsAfter(fileNames []string, snapshotTS int64) ([]string, error) {
+	out := make([]string, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		ts, err := asTimeStamp(filepath.Base(fileName))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse commit log name %q", fileName)
+		}
+
+		if ts <= snapshotTS {
+			continue
+		}
+
+		out = append(out, fileName)
+	}
+
+	return out, nil
+}
+
+// removeCommitLogsCoveredBySnapshot deletes commit logs which are contained in
+// the latest snapshot as well as any older snapshots. Once a snapshot is
+// complete those files are never read again. Removing them also makes sure
+// that the combiner can never merge a covered log with one that is not
+// covered yet.
+func removeCommitLogsCoveredBySnapshot(rootPath, name string) error {
+	latest, latestTS, ok, err := getLatestSnapshot(rootPath, name)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return nil
+	}
+
+	fileNames, err := getCommitFileNames(rootPath, name)
+	if err != nil {
+		return err
+	}
+
+	if len(fileNames) > 0 {
+		// never touch the last file, it might still be in use
+		fileNames = fileNames[:len(fileNames)-1]
+	}
+
+	for _, fileName := range fileNames {
+		ts, err := asTimeStamp(filepath.Base(fileName))
+		if err != nil {
+			return errors.Wrapf(err, "parse commit log name %q", fileName)
+		}
+
+		if ts > latestTS {
+			continue
+		}
+
+		if err := os.Remove(fileName); err != nil {
+			return errors.Wrapf(err, "remove commit log %q covered by snapshot", fileName)
+		}
+	}
+
+	snapshots, err := filepath.Glob(filepath.Join(snapshotDirectory(rootPath, name), "*.snapshot"))
+	if err != nil {
+		return errors.Wrap(err, "list snapshots")
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot == latest {
+			continue
+		}
+
+		if err := os.Remove(snapshot); err != nil {
+			return errors.Wrapf(err, "remove outdated snapshot %q", snapshot)
+		}
+	}
+
+	return nil
+}
+
+// loadLatestSnapshot reads the most recent snapshot into memory. It returns
+// a nil state if there is no snapshot. The second return value is the
+// timestamp of the last commit log contained in the snapshot.
+func loadLatestSnapshot(rootPath, name string,
+	logger logrus.FieldLogger,
+) (*DeserializationResult, int64, error) {
+	path, ts, ok, err := getLatestSnapshot(rootPath, name)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !ok {
+		return nil, 0, nil
+	}
+
+	before := time.Now()
+	state, err := readSnapshot(path, logger)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "read snapshot %q", path)
+	}
+
+	logger.WithField("action", "hnsw_load_snapshot").
+		WithField("id", name).
+		WithField("path", path).
+		WithField("took", time.Since(before)).
+		Debug("loaded hnsw snapshot")
+
+	return state, ts, nil
+}
+
+// writeSnapshot serializes the state into a new snapshot file. The file is
+// written under a temporary name first and only renamed once it is complete
+// and synced, so a crash can never leave a partial snapshot behind.
+//
+// The layout is:
+//
+//	version (uint8)
+//	entrypoint (uint64), level (uint16)
+//	compressed (uint8), followed by an AddPQ or AddSQ commit log entry
+//	tombstone count (uint64), followed by the tombstone ids
+//	length of the nodes slice (uint64), number of nodes (uint64)
+//	per node: id (uint64), level (uint16), number of levels (uint16),
+//	  per level: number of connections (uint32), followed by the ids
+//	crc32 checksum of all previous bytes (uint32)
+func writeSnapshot(path string, state *DeserializationResult) error {
+	tmpPath := path + ".tmp"
+	fd, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return errors.Wrap(err, "create snapshot file")
+	}
+	defer fd.Close()
+
+	checksum := crc32.NewIEEE()
+	w := bufio.NewWriterSize(io.MultiWriter(fd, checksum), 1024*1024)
+
+	if err := encodeSnapshot(w, state); err != nil {
+		return errors.Wrap(err, "write snapshot")
+	}
+
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "flush snapshot")
+	}
+
+	if err := binary.Write(fd, binary.LittleEndian, checksum.Sum32()); err != nil {
+		return errors.Wrap(err, "write snapshot checksum")
+	}
+
+	if err := fd.Sync(); err != nil {
+		return errors.Wrap(err, "sync snapshot")
+	}
+
+	if err := fd.Close(); err != nil {
+		return errors.Wrap(err, "close snapshot")
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.Wrapf(err, "rename tmp (%q) to final (%q)", tmpPath, path)
+	}
+
+	return nil
+}
+
+func encodeSnapshot(w *bufio.Writer, state *DeserializationResult) error {
+	buf := make([]byte, 18)
+
+	buf[0] = snapshotVersion
+	binary.LittleEndian.PutUint64(buf[1:9], state.Entrypoint)
+	binary.LittleEndian.PutUint16(buf[9:11], state.Level)
+	if _, err := w.Write(buf[:11]); err != nil {
+		return err
+	}
+
+	if !state.Compressed {
+		if err := w.WriteByte(0); err != nil {
+			return err
+		}
+	} else {
+		if err := w.WriteByte(1); err != nil {
+			return err
+		}
+
+		record := pqCommitRecord(state.PQData)
+		if state.SQData != nil {
+			record = sqCommitRecord(*state.SQData)
+		}
+		if _, err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	// sort the tombstones, so that the same state always leads to the same file
+	tombstones := make([]uint64, 0, len(state.Tombstones))
+	for id := range state.Tombstones {
+		tombstones = append(tombstones, id)
+	}
+	sort.Slice(tombstones, func(a, b int) bool { return tombstones[a] < tombstones[b] })
+
+	if err := writeSnapshotUint64(w, buf, uint64(len(tombstones))); err != nil {
+		return err
+	}
+	for _, id := range tombstones {
+		if err := writeSnapshotUint64(w, buf, id); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	for _, node := range state.Nodes {
+		if node != nil {
+			count++
+		}
+	}
+
+	binary.LittleEndian.PutUint64(buf[0:8], uint64(len(state.Nodes)))
+	binary.LittleEndian.PutUint64(buf[8:16], uint64(count))
+	if _, err := w.Write(buf[:16]); err != nil {
+		return err
+	}
+
+	for _, node := range state.Nodes {
+		if node == nil {
+			// nil nodes occur when we've grown, but not inserted anything yet
+			continue
+		}
+
+		binary.LittleEndian.PutUint64(buf[0:8], node.id)
+		binary.LittleEndian.PutUint16(buf[8:10], uint16(node.level))
+		binary.LittleEndian.PutUint16(buf[10:12], uint16(len(node.connections)))
+		if _, err := w.Write(buf[:12]); err != nil {
+			return err
+		}
+
+		for _, links := range node.connections {
+			binary.LittleEndian.PutUint32(buf[0:4], uint32(len(links)))
+			if _, err := w.Write(buf[:4]); err != nil {
+				return err
+			}
+
+			for _, link := range links {
+				if err := writeSnapshotUint64(w, buf, link); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func writeSnapshotUint64(w *bufio.Writer, buf []byte, in uint64) error {
+	binary.LittleEndian.PutUint64(buf[0:8], in)
+	_, err := w.Write(buf[:8])
+	return err
+}
+
+func readSnapshot(path string, logger logrus.FieldLogger) (*DeserializationResult, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open snapshot")
+	}
+	defer fd.Close()
+
+	fdBuf := bufio.NewReaderSize(fd, 256*1024)
+	checksum := crc32.NewIEEE()
+
+	state, err := decodeSnapshot(io.TeeReader(fdBuf, checksum), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var expected uint32
+	if err := binary.Read(fdBuf, binary.LittleEndian, &expected); err != nil {
+		return nil, errors.Wrap(err, "read snapshot checksum")
+	}
+
+	if actual := checksum.Sum32(); actual != expected {
+		return nil, errors.Errorf("snapshot checksum mismatch: expected %d, got %d",
+			expected, actual)
+	}
+
+	return state, nil
+}
+
+func decodeSnapshot(r io.Reader, logger logrus.FieldLogger) (*DeserializationResult, error) {
+	d := NewDeserializer(logger)
+
+	version, err := d.readByte(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != snapshotVersion {
+		return nil, errors.Errorf("unsupported snapshot version %d", version)
+	}
+
+	res := &DeserializationResult{
+		Tombstones:        make(map[uint64]struct{}),
+		LinksReplaced:     make(map[uint64]map[uint16]struct{}),
+		EntrypointChanged: true,
+	}
+
+	res.Entrypoint, err = d.readUint64(r)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Level, err = d.readUint16(r)
+	if err != nil {
+		return nil, err
+	}
+
+	compressed, err := d.readByte(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if compressed != 0 {
+		ct, err := d.ReadCommitType(r)
+		if err != nil {
+			return nil, err
+		}
+
+		switch ct {
+		case AddPQ:
+			err = d.ReadPQ(r, res)
+		case AddSQ:
+			_, err = d.ReadSQ(r, res)
+		default:
+			err = errors.Errorf("unrecognized compression commit type %d", ct)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "read compression data")
+		}
+	}
+
+	tombstones, err := d.readUint64(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint64(0); i < tombstones; i++ {
+		if err := d.ReadAddTombstone(r, res.Tombstones); err != nil {
+			return nil, err
+		}
+	}
+
+	length, err := d.readUint64(r)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := d.readUint64(r)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Nodes = make([]*vertex, length)
+	for i := uint64(0); i < count; i++ {
+		id, err := d.readUint64(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if id >= length {
+			return nil, errors.Errorf("node id %d exceeds snapshot length %d", id, length)
+		}
+
+		level, err := d.readUint16(r)
+		if err != nil {
+			return nil, err
+		}
+
+		levels, err := d.readUint16(r)
+		if err != nil {
+			return nil, err
+		}
+
+		node := &vertex{id: id, level: int(level), connections: make([][]uint64, levels)}
+		for l := range node.connections {
+			var size uint32
+			if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+				return nil, errors.Wrap(err, "failed to read connections length")
+			}
+
+			if size == 0 {
+				continue
+			}
+
+			links, err := d.readUint64Slice(r, int(size))
+			if err != nil {
+				return nil, err
+			}
+
+			// the deserializer reuses its slice, so the links must be copied
+			node.connections[l] = make([]uint64, size)
+			copy(node.connections[l], links)
+		}
+
+		res.Nodes[id] = node
+	}
+
+	return res, nil
+}
+
+// createSnapshot combines the latest snapshot with all immutable commit logs
+// written since into a new snapshot. The state is rebuilt from disk rather
+// than taken from the live index, so no index locks are needed. A new
+// snapshot is only created once the commit logs that would have to be
+// replayed on top of the previous one have grown large enough.
+func (l *hnswCommitLogger) createSnapshot() (bool, error) {
+	if !l.snapshotsEnabled {
+		return false, nil
+	}
+
+	fileNames, err := getCommitFileNames(l.rootPath, l.id)
+	if err != nil {
+		return false, err
+	}
+
+	if len(fileNames) <= 1 {
+		// the only file is still in use, there is nothing to snapshot yet
+		return false, nil
+	}
+
+	// cut off last element, as that's still being written to
+	candidates := fileNames[:len(fileNames)-1]
+
+	prevPath, prevTS, ok, err := getLatestSnapshot(l.rootPath, l.id)
+	if err != nil {
+		return false, err
+	}
+
+	if ok {
+		candidates, err = commitLogsAfter(candidates, prevTS)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if len(candidates) == 0 {
+		return false, nil
+	}
+
+	if ok {
+		large, err := l.deltaLargeEnough(prevPath, candidates)
+		if err != nil {
+			return false, err
+		}
+		if !large {
+			return false, nil
+		}
+	}
+
+	before := time.Now()
+
+	var state *DeserializationResult
+	if ok {
+		state, err = readSnapshot(prevPath, l.logger)
+		if err != nil {
+			return false, errors.Wrapf(err, "read snapshot %q", prevPath)
+		}
+	}
+
+	for _, fileName := range candidates {
+		state, err = l.readCommitLogForSnapshot(fileName, state)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	lastTS, err := asTimeStamp(filepath.Base(candidates[len(candidates)-1]))
+	if err != nil {
+		return false, err
+	}
+
+	if err := os.MkdirAll(snapshotDirectory(l.rootPath, l.id), os.ModePerm); err != nil {
+		return false, errors.Wrap(err, "create snapshot directory")
+	}
+
+	path := snapshotFileName(l.rootPath, l.id, lastTS)
+	if err := writeSnapshot(path, state); err != nil {
+		return false, err
+	}
+
+	if err := removeCommitLogsCoveredBySnapshot(l.rootPath, l.id); err != nil {
+		return true, errors.Wrap(err, "clean up after snapshot")
+	}
+
+	l.logger.WithField("action", "hnsw_create_snapshot").
+		WithField("id", l.id).
+		WithField("path", path).
+		WithField("commit_logs", len(candidates)).
+		WithField("took", time.Since(before)).
+		Info("created hnsw snapshot")
+
+	return true, nil
+}
+
+func (l *hnswCommitLogger) deltaLargeEnough(snapshot string, commitLogs []string) (bool, error) {
+	stat, err := os.Stat(snapshot)
+	if err != nil {
+		return false, errors.Wrapf(err, "stat snapshot %q", snapshot)
+	}
+
+	var delta int64
+	for _, fileName := range commitLogs {
+		stat, err := os.Stat(fileName)
+		if err != nil {
+			return false, errors.Wrapf(err, "stat commit log %q", fileName)
+		}
+		delta += stat.Size()
+	}
+
+	return delta*100 >= stat.Size()*int64(l.snapshotMinDeltaSizePercentage), nil
+}
+
+func (l *hnswCommitLogger) readCommitLogForSnapshot(fileName string,
+	state *DeserializationResult,
+) (*DeserializationResult, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open commit log %q for reading", fileName)
+	}
+	defer fd.Close()
+
+	fdBuf := bufio.NewReaderSize(fd, 256*1024)
+
+	// unlike at startup, a corrupt log is not truncated here. It is left for
+	// the next restart to deal with and no snapshot is created in the meantime.
+	state, _, err = NewDeserializer(l.logger).Do(fdBuf, state, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "deserialize commit log %q", fileName)
+	}
+
+	return state, nil
+}
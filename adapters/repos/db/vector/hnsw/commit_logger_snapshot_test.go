@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	path := filepath.Join(t.TempDir(), "1.snapshot")
+
+	state := &DeserializationResult{
+		Nodes: []*vertex{
+			{id: 0, level: 1, connections: [][]uint64{{1, 3}, {3}}},
+			{id: 1, level: 0, connections: [][]uint64{{0}}},
+			nil,
+			{id: 3, level: 1, connections: [][]uint64{{0, 1}, {0}}},
+			nil,
+		},
+		Entrypoint: 3,
+		Level:      1,
+		Tombstones: map[uint64]struct{}{1: {}},
+		Compressed: true,
+		SQData: &ssdhelpers.SQData{
+			Dimensions: 2,
+			Min:        []float32{-1, -2},
+			Max:        []float32{1, 2},
+		},
+	}
+
+	require.Nil(t, writeSnapshot(path, state))
+
+	res, err := readSnapshot(path, logger)
+	require.Nil(t, err)
+
+	assert.Equal(t, state.Entrypoint, res.Entrypoint)
+	assert.Equal(t, state.Level, res.Level)
+	assert.Equal(t, state.Tombstones, res.Tombstones)
+	assert.True(t, res.Compressed)
+	assert.Equal(t, state.SQData, res.SQData)
+	assertSameNodes(t, state.Nodes, res.Nodes)
+
+	t.Run("a corrupt snapshot is detected", func(t *testing.T) {
+		content, err := os.ReadFile(path)
+		require.Nil(t, err)
+
+		content[len(content)/2] ^= 0xff
+		require.Nil(t, os.WriteFile(path, content, 0o666))
+
+		_, err = readSnapshot(path, logger)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestCommitLoggerCreateSnapshot(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	rootPath := t.TempDir()
+	id := "main"
+
+	require.Nil(t, os.MkdirAll(commitLogDirectory(rootPath, id), os.ModePerm))
+
+	writeTestCommitLog(t, rootPath, id, "1000.condensed", func(c *MemoryCondensor) {
+		require.Nil(t, c.AddNode(&vertex{id: 0, level: 1}))
+		require.Nil(t, c.SetLinksAtLevel(0, 0, []uint64{1}))
+		require.Nil(t, c.SetLinksAtLevel(1, 0, []uint64{0}))
+		require.Nil(t, c.SetEntryPointWithMaxLayer(0, 1))
+	})
+	writeTestCommitLog(t, rootPath, id, "1001", func(c *MemoryCondensor) {
+		require.Nil(t, c.AddLinkAtLevel(0, 0, 2))
+		require.Nil(t, c.SetLinksAtLevel(2, 0, []uint64{0, 1}))
+		require.Nil(t, c.AddTombstone(1))
+	})
+	writeTestCommitLog(t, rootPath, id, "1002", func(c *MemoryCondensor) {
+		// still being written to, must never be part of a snapshot
+		require.Nil(t, c.AddLinkAtLevel(2, 0, 3))
+	})
+
+	expected := replayCommitLogs(t, rootPath, id, nil)
+
+	l := &hnswCommitLogger{
+		rootPath:                       rootPath,
+		id:                             id,
+		logger:                         logger,
+		snapshotsEnabled:               true,
+		snapshotMinDeltaSizePercentage: defaultSnapshotMinDeltaSizePercentage,
+	}
+
+	executed, err := l.createSnapshot()
+	require.Nil(t, err)
+	assert.True(t, executed)
+
+	t.Run("covered commit logs are removed", func(t *testing.T) {
+		fileNames, err := getCommitFileNames(rootPath, id)
+		require.Nil(t, err)
+		assert.Equal(t, []string{commitLogFileName(rootPath, id, "1002")}, fileNames)
+
+		_, err = os.Stat(snapshotFileName(rootPath, id, 1001))
+		assert.Nil(t, err)
+	})
+
+	t.Run("snapshot and remaining logs restore the same state", func(t *testing.T) {
+		state, ts, err := loadLatestSnapshot(rootPath, id, logger)
+		require.Nil(t, err)
+		require.NotNil(t, state)
+		assert.Equal(t, int64(1001), ts)
+
+		actual := replayCommitLogs(t, rootPath, id, state)
+		assert.Equal(t, expected.Entrypoint, actual.Entrypoint)
+		assert.Equal(t, expected.Level, actual.Level)
+		assert.Equal(t, expected.Tombstones, actual.Tombstones)
+		assertSameNodes(t, expected.Nodes, actual.Nodes)
+	})
+
+	t.Run("no new snapshot without new immutable commit logs", func(t *testing.T) {
+		executed, err := l.createSnapshot()
+		require.Nil(t, err)
+		assert.False(t, executed)
+	})
+
+	t.Run("disabled snapshots are never created", func(t *testing.T) {
+		writeTestCommitLog(t, rootPath, id, "1003", func(c *MemoryCondensor) {
+			require.Nil(t, c.AddTombstone(2))
+		})
+
+		l.snapshotsEnabled = false
+		executed, err := l.createSnapshot()
+		require.Nil(t, err)
+		assert.False(t, executed)
+	})
+}
+
+func writeTestCommitLog(t *testing.T, rootPath, id, fileName string,
+	write func(c *MemoryCondensor),
+) {
+	fd, err := os.Create(commitLogFileName(rootPath, id, fileName))
+	require.Nil(t, err)
+
+	logger, _ := test.NewNullLogger()
+	c := NewMemoryCondensor(logger)
+	c.newLogFile = fd
+	c.newLog = NewWriter(fd)
+	write(c)
+
+	require.Nil(t, c.newLog.Flush())
+	require.Nil(t, fd.Close())
+}
+
+func replayCommitLogs(t *testing.T, rootPath, id string,
+	state *DeserializationResult,
+) *DeserializationResult {
+	fileNames, err := getCommitFileNames(rootPath, id)
+	require.Nil(t, err)
+
+	for _, fileName := range fileNames {
+		fd, err := os.Open(fileName)
+		require.Nil(t, err)
+
+		state, _, err = NewDeserializer(logrus.New()).Do(bufio.NewReader(fd), state, false)
+		require.Nil(t, err)
+		require.Nil(t, fd.Close())
+	}
+
+	return state
+}
+
+func assertSameNodes(t *testing.T, expected, actual []*vertex) {
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		var exp, act *vertex
+		if i < len(expected) {
+			exp = expected[i]
+		}
+		if i < len(actual) {
+			act = actual[i]
+		}
+
+		if exp == nil || act == nil {
+			assert.True(t, exp == nil && act == nil, fmt.Sprintf("node %d", i))
+			continue
+		}
+
+		assert.Equal(t, exp.id, act.id)
+		assert.Equal(t, exp.level, act.level)
+		assert.Equal(t, exp.connections, act.connections, fmt.Sprintf("node %d", i))
+	}
+}
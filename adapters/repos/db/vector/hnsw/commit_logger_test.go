@@ -14,7 +14,11 @@ package hnsw
 import (
 	_ "fmt"
 	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type MockDirEntry struct {
@@ -64,3 +68,30 @@ func TestRemoveTmpScratchOrHiddenFiles(t *testing.T) {
 		}
 	}
 }
+
+func TestRenameIndexFiles(t *testing.T) {
+	rootPath := t.TempDir()
+
+	require.Nil(t, os.MkdirAll(commitLogDirectory(rootPath, "shadow"), 0o755))
+	require.Nil(t, os.WriteFile(commitLogFileName(rootPath, "shadow", "1000"), []byte("log"), 0o644))
+	require.Nil(t, os.MkdirAll(snapshotDirectory(rootPath, "shadow"), 0o755))
+	require.Nil(t, os.WriteFile(filepath.Join(rootPath, "shadow.hnsw.vectors"), nil, 0o644))
+	// an empty leftover of the index that is replaced
+	require.Nil(t, os.MkdirAll(commitLogDirectory(rootPath, "main"), 0o755))
+
+	exists, err := IndexFilesExist(rootPath, "shadow")
+	require.Nil(t, err)
+	assert.True(t, exists)
+
+	require.Nil(t, RenameIndexFiles(rootPath, "shadow", "main"))
+
+	exists, err = IndexFilesExist(rootPath, "shadow")
+	require.Nil(t, err)
+	assert.False(t, exists)
+
+	content, err := os.ReadFile(commitLogFileName(rootPath, "main", "1000"))
+	require.Nil(t, err)
+	assert.Equal(t, "log", string(content))
+	assert.DirExists(t, snapshotDirectory(rootPath, "main"))
+	assert.NoFileExists(t, filepath.Join(rootPath, "shadow.hnsw.vectors"))
+}
@@ -13,6 +13,7 @@ package commitlog
 
 import (
 	"encoding/binary"
+	"math"
 	"os"
 
 	"github.com/pkg/errors"
@@ -41,6 +42,7 @@ const (
 	ClearLinksAtLevel // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1701
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
+	AddSQ
 )
 
 func NewLogger(fileName string) *Logger {
@@ -95,6 +97,18 @@ func (l *Logger) AddPQ(data ssdhelpers.PQData) error {
 	return err
 }
 
+func (l *Logger) AddSQ(data ssdhelpers.SQData) error {
+	toWrite := make([]byte, 3+8*int(data.Dimensions))
+	toWrite[0] = byte(AddSQ)
+	binary.LittleEndian.PutUint16(toWrite[1:3], data.Dimensions)
+	for i := 0; i < int(data.Dimensions); i++ {
+		binary.LittleEndian.PutUint32(toWrite[3+4*i:], math.Float32bits(data.Min[i]))
+		binary.LittleEndian.PutUint32(toWrite[3+4*(int(data.Dimensions)+i):], math.Float32bits(data.Max[i]))
+	}
+	_, err := l.bufw.Write(toWrite)
+	return err
+}
+
 func (l *Logger) AddLinkAtLevel(id uint64, level int, target uint64) error {
 	toWrite := make([]byte, 19)
 	toWrite[0] = byte(AddLinkAtLevel)
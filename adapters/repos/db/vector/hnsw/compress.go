@@ -15,11 +15,13 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"math/rand"
 
 	"github.com/pkg/errors"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
@@ -63,24 +65,12 @@ func (h *hnsw) Compress(cfg ent.PQConfig) error {
 	}
 
 	data := h.cache.all()
-	cleanData := make([][]float32, 0, len(data))
-	for _, point := range data {
-		if point == nil {
-			continue
-		}
-		cleanData = append(cleanData, point)
-	}
-	h.compressedVectorsCache.grow(uint64(len(data)))
-	h.pq.Fit(cleanData)
+	h.pq.Fit(h.compressionTrainingData(data, cfg.TrainingLimit))
+	h.compressor = h.pq
 
 	h.compressActionLock.Lock()
 	defer h.compressActionLock.Unlock()
-	ssdhelpers.Concurrently(uint64(len(cleanData)),
-		func(index uint64) {
-			encoded := h.pq.Encode(cleanData[index])
-			h.storeCompressedVector(index, encoded)
-			h.compressedVectorsCache.preload(index, encoded)
-		})
+	h.encodeAll(data)
 	if err := h.commitLog.AddPQ(h.pq.ExposeFields()); err != nil {
 		return errors.Wrap(err, "Adding PQ to the commit logger")
 	}
@@ -90,6 +80,189 @@ func (h *hnsw) Compress(cfg ent.PQConfig) error {
 	return nil
 }
 
+// encodeAll compresses all vectors of the index with the current compressor
+// and stores the codes both on disk and in the compressed vector cache
+func (h *hnsw) encodeAll(data [][]float32) {
+	h.compressedVectorsCache.grow(uint64(len(data)))
+	ssdhelpers.Concurrently(uint64(len(data)),
+		func(id uint64) {
+			vec, ok := h.uncompressedVector(data, id)
+			if !ok {
+				return
+			}
+			encoded := h.compressor.Encode(vec)
+			h.storeCompressedVector(id, encoded)
+			h.compressedVectorsCache.preload(id, encoded)
+		})
+}
+
+// initBinaryQuantization switches the index to binary quantized vectors. BQ
+// needs no training, so unlike PQ this happens right on creation and again on
+// every restart, as there is no quantizer state to restore from the commit log.
+func (h *hnsw) initBinaryQuantization() error {
+	if err := h.initCompressedStore(); err != nil {
+		return errors.Wrap(err, "Initializing compressed vector store")
+	}
+
+	h.compressor = ssdhelpers.NewBinaryQuantizer(h.distancerProvider)
+	h.compressedVectorsCache.grow(uint64(len(h.nodes)))
+	h.compressed.Store(true)
+	h.cache.drop()
+	return nil
+}
+
+// startScalarQuantizerTraining makes every following import extend the
+// per-dimension bounds of the scalar quantizer, until the limit is reached and
+// the index switches to the compressed codes.
+func (h *hnsw) startScalarQuantizerTraining(limit int) {
+	h.sqTrainingLimit.Store(int64(limit))
+	h.sqTrainer.CompareAndSwap(nil, ssdhelpers.NewSQTrainer())
+}
+
+func (h *hnsw) trainScalarQuantizer(vec []float32) {
+	trainer := h.sqTrainer.Load()
+	if trainer == nil || h.compressed.Load() {
+		return
+	}
+
+	if int64(trainer.Add(vec)) < h.sqTrainingLimit.Load() {
+		return
+	}
+
+	h.sqTrainOnce.Do(func() {
+		// the import which completes the training must not wait for all vectors
+		// to be compressed
+		go func() {
+			if err := h.compressWithScalarQuantizer(trainer); err != nil {
+				h.logger.WithField("action", "compress").WithError(err).
+					Error("switching to scalar quantized vectors")
+			}
+		}()
+	})
+}
+
+// CompressSQ trains a scalar quantizer on a sample of the existing vectors
+// right away, this is used when sq is enabled on an index which already has
+// data.
+func (h *hnsw) CompressSQ(cfg ent.SQConfig) error {
+	if h.isEmpty() {
+		h.startScalarQuantizerTraining(cfg.TrainingLimit)
+		return nil
+	}
+
+	trainer := ssdhelpers.NewSQTrainer()
+	for _, vec := range h.compressionTrainingData(h.cache.all(), cfg.TrainingLimit) {
+		trainer.Add(vec)
+	}
+
+	return h.compressWithScalarQuantizer(trainer)
+}
+
+func (h *hnsw) compressWithScalarQuantizer(trainer *ssdhelpers.SQTrainer) error {
+	sq, err := trainer.Quantizer(h.distancerProvider)
+	if err != nil {
+		return errors.Wrap(err, "Training scalar quantizer")
+	}
+
+	h.compressActionLock.Lock()
+	defer h.compressActionLock.Unlock()
+
+	if h.compressed.Load() {
+		return nil
+	}
+
+	if err := h.initCompressedStore(); err != nil {
+		return errors.Wrap(err, "Initializing compressed vector store")
+	}
+
+	h.compressor = sq
+	h.encodeAll(h.cache.all())
+	if err := h.commitLog.AddSQ(sq.ExposeFields()); err != nil {
+		return errors.Wrap(err, "Adding SQ to the commit logger")
+	}
+
+	h.compressed.Store(true)
+	h.cache.drop()
+	return nil
+}
+
+func skipRescore(uc ent.UserConfig) bool {
+	if uc.BQ.Enabled {
+		return uc.BQ.SkipRescore
+	}
+	if uc.SQ.Enabled {
+		return uc.SQ.SkipRescore
+	}
+	return uc.PQ.SkipRescore
+}
+
+// compressionTrainingData draws a uniform random sample of at most limit
+// vectors to fit the quantizer on. A limit <= 0 uses all vectors. Only the ids
+// are sampled up front, so that memory stays bounded by the limit rather than
+// the size of the index.
+func (h *hnsw) compressionTrainingData(data [][]float32, limit int) [][]float32 {
+	var sample []uint64
+	seen := 0
+
+	h.RLock()
+	for id := range data {
+		if id >= len(h.nodes) || h.nodes[id] == nil {
+			continue
+		}
+
+		seen++
+		if limit <= 0 || len(sample) < limit {
+			sample = append(sample, uint64(id))
+		} else if j := rand.Intn(seen); j < limit {
+			sample[j] = uint64(id)
+		}
+	}
+	h.RUnlock()
+
+	training := make([][]float32, len(sample))
+	ssdhelpers.Concurrently(uint64(len(sample)), func(i uint64) {
+		training[i], _ = h.uncompressedVector(data, sample[i])
+	})
+
+	cleanData := make([][]float32, 0, len(training))
+	for _, vec := range training {
+		if vec == nil {
+			continue
+		}
+		cleanData = append(cleanData, vec)
+	}
+
+	return cleanData
+}
+
+// uncompressedVector returns the full-precision vector for the given id.
+// Vectors which are no longer (or never were) in the cache are read from disk
+// without populating the cache, as it is dropped once compression completes.
+func (h *hnsw) uncompressedVector(data [][]float32, id uint64) ([]float32, bool) {
+	if vec := data[id]; vec != nil {
+		return vec, true
+	}
+
+	h.RLock()
+	exists := id < uint64(len(h.nodes)) && h.nodes[id] != nil
+	h.RUnlock()
+	if !exists {
+		return nil, false
+	}
+
+	vec, err := h.VectorForIDThunk(context.Background(), id)
+	if err != nil || len(vec) == 0 {
+		// most likely deleted in the meantime, nothing to compress
+		return nil, false
+	}
+
+	if h.distancerProvider.Type() == "cosine-dot" {
+		vec = distancer.Normalize(vec)
+	}
+
+	return vec, true
+}
+
 //nolint:unused
 func (h *hnsw) encodedVector(id uint64) ([]byte, error) {
 	return h.compressedVectorsCache.get(context.Background(), id)
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBinaryQuantizedIndex(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecs(500, 0, 64)
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "bq-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        128,
+		EF:                    100,
+		VectorCacheMaxObjects: 100000,
+		BQ:                    ent.BQConfig{Enabled: true},
+	}, cyclemanager.NewNoop())
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	require.True(t, index.compressed.Load())
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	t.Run("vectors are kept compressed", func(t *testing.T) {
+		code, err := index.compressedVectorsCache.get(context.Background(), 7)
+		require.Nil(t, err)
+		assert.Len(t, code, 8)
+	})
+
+	t.Run("candidates are rescored with full precision", func(t *testing.T) {
+		for _, id := range []uint64{3, 42, 123} {
+			ids, dists, err := index.SearchByVector(vectors[id], 5, nil)
+			require.Nil(t, err)
+			require.NotEmpty(t, ids)
+			assert.Equal(t, id, ids[0])
+			assert.InDelta(t, 0, dists[0], 0.0001)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestScalarQuantizedIndex(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecs(501, 0, 64)
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "sq-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        128,
+		EF:                    100,
+		VectorCacheMaxObjects: 100000,
+		SQ:                    ent.SQConfig{Enabled: true, TrainingLimit: 200},
+	}, cyclemanager.NewNoop())
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	require.False(t, index.compressed.Load())
+
+	for i, vec := range vectors[:500] {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	t.Run("the index switches to compressed vectors after training", func(t *testing.T) {
+		assert.Eventually(t, index.compressed.Load, 5*time.Second, 10*time.Millisecond)
+
+		code, err := index.compressedVectorsCache.get(context.Background(), 7)
+		require.Nil(t, err)
+		assert.Len(t, code, 64)
+	})
+
+	t.Run("imports after training are compressed right away", func(t *testing.T) {
+		id := uint64(500)
+		require.Nil(t, index.Add(id, vectors[id]))
+
+		code, err := index.compressedVectorsCache.get(context.Background(), id)
+		require.Nil(t, err)
+		assert.Len(t, code, 64)
+	})
+
+	t.Run("candidates are rescored with full precision", func(t *testing.T) {
+		for _, id := range []uint64{3, 42, 123} {
+			ids, dists, err := index.SearchByVector(vectors[id], 5, nil)
+			require.Nil(t, err)
+			require.NotEmpty(t, ids)
+			assert.Equal(t, id, ids[0])
+			assert.InDelta(t, 0, dists[0], 0.0001)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCompressionTrainingData(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecs(200, 0, 8)
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "compression-training-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		VectorCacheMaxObjects: 100000,
+	}, cyclemanager.NewNoop())
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	// evict half of the vectors, they must be read from disk instead
+	for i := 0; i < len(vectors); i += 2 {
+		index.cache.delete(context.Background(), uint64(i))
+	}
+
+	t.Run("without a limit all vectors are used", func(t *testing.T) {
+		data := index.compressionTrainingData(index.cache.all(), 0)
+		assert.Len(t, data, len(vectors))
+	})
+
+	t.Run("the sample is bounded by the limit", func(t *testing.T) {
+		data := index.compressionTrainingData(index.cache.all(), 50)
+		require.Len(t, data, 50)
+		for _, vec := range data {
+			assert.Len(t, vec, 8)
+		}
+	})
+
+	t.Run("evicted vectors are not put back into the cache", func(t *testing.T) {
+		vec, ok := index.uncompressedVector(index.cache.all(), 0)
+		require.True(t, ok)
+		assert.Equal(t, vectors[0], vec)
+		assert.Nil(t, index.cache.all()[0])
+	})
+}
@@ -27,6 +27,8 @@ type compressedShardedLockCache struct {
 	cache        [][]byte
 	maxSize      int64
 	count        int64
+	hits         int64
+	misses       int64
 	cancel       chan bool
 	logger       logrus.FieldLogger
 	//nolint:unused
@@ -64,6 +66,7 @@ func (c *compressedShardedLockCache) get(ctx context.Context, id uint64) ([]byte
 	c.shardedLocks[id%shardFactor].RUnlock()
 
 	if vec != nil {
+		atomic.AddInt64(&c.hits, 1)
 		return vec, nil
 	}
 
@@ -90,6 +93,7 @@ func (c *compressedShardedLockCache) delete(ctx context.Context, id uint64) {
 
 //nolint:unused
 func (c *compressedShardedLockCache) handleCacheMiss(ctx context.Context, id uint64) ([]byte, error) {
+	atomic.AddInt64(&c.misses, 1)
 	return nil, errors.New("Not implemented")
 }
 
@@ -107,6 +111,8 @@ func (c *compressedShardedLockCache) multiGet(ctx context.Context, ids []uint64)
 			vecFromDisk, err := c.handleCacheMiss(ctx, id)
 			errs[i] = err
 			vec = vecFromDisk
+		} else {
+			atomic.AddInt64(&c.hits, 1)
 		}
 
 		out[i] = vec
@@ -165,6 +171,14 @@ func (c *compressedShardedLockCache) countVectors() int64 {
 	return atomic.LoadInt64(&c.count)
 }
 
+func (c *compressedShardedLockCache) stats() cacheStats {
+	return cacheStats{
+		hits:           atomic.LoadInt64(&c.hits),
+		misses:         atomic.LoadInt64(&c.misses),
+		bytesPerVector: int(atomic.LoadInt32(&c.dims)),
+	}
+}
+
 //nolint:unused
 func (c *compressedShardedLockCache) drop() {
 	c.deleteAllVectors()
@@ -54,7 +54,11 @@ func (c *MemoryCondensor) Do(fileName string) error {
 	c.newLog = NewWriterSize(c.newLogFile, 1*1024*1024)
 
 	if res.Compressed {
-		if err := c.AddPQ(res.PQData); err != nil {
+		if res.SQData != nil {
+			if err := c.AddSQ(*res.SQData); err != nil {
+				return fmt.Errorf("write sq data: %w", err)
+			}
+		} else if err := c.AddPQ(res.PQData); err != nil {
 			return fmt.Errorf("write pq data: %w", err)
 		}
 	}
@@ -236,6 +240,17 @@ func (c *MemoryCondensor) AddTombstone(nodeid uint64) error {
 }
 
 func (c *MemoryCondensor) AddPQ(data ssdhelpers.PQData) error {
+	_, err := c.newLog.Write(pqCommitRecord(data))
+	return err
+}
+
+func (c *MemoryCondensor) AddSQ(data ssdhelpers.SQData) error {
+	_, err := c.newLog.Write(sqCommitRecord(data))
+	return err
+}
+
+// pqCommitRecord encodes the product quantizer as an AddPQ commit log entry
+func pqCommitRecord(data ssdhelpers.PQData) []byte {
 	toWrite := make([]byte, 10)
 	toWrite[0] = byte(AddPQ)
 	binary.LittleEndian.PutUint16(toWrite[1:3], data.Dimensions)
@@ -252,8 +267,19 @@ func (c *MemoryCondensor) AddPQ(data ssdhelpers.PQData) error {
 	for _, encoder := range data.Encoders {
 		toWrite = append(toWrite, encoder.ExposeDataForRestore()...)
 	}
-	_, err := c.newLog.Write(toWrite)
-	return err
+	return toWrite
+}
+
+// sqCommitRecord encodes the scalar quantizer as an AddSQ commit log entry
+func sqCommitRecord(data ssdhelpers.SQData) []byte {
+	toWrite := make([]byte, 3+8*int(data.Dimensions))
+	toWrite[0] = byte(AddSQ)
+	binary.LittleEndian.PutUint16(toWrite[1:3], data.Dimensions)
+	for i := 0; i < int(data.Dimensions); i++ {
+		binary.LittleEndian.PutUint32(toWrite[3+4*i:], math.Float32bits(data.Min[i]))
+		binary.LittleEndian.PutUint32(toWrite[3+4*(int(data.Dimensions)+i):], math.Float32bits(data.Max[i]))
+	}
+	return toWrite
 }
 
 func NewMemoryCondensor(logger logrus.FieldLogger) *MemoryCondensor {
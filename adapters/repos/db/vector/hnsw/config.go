@@ -32,6 +32,14 @@ type Config struct {
 	DistanceProvider      distancer.Provider
 	PrometheusMetrics     *monitoring.PrometheusMetrics
 
+	// TombstoneCleanupConcurrency is the number of goroutines which reassign
+	// the edges of tombstoned nodes, it defaults to 1
+	TombstoneCleanupConcurrency int
+	// TombstoneCleanupMaxPerCycle limits the number of tombstones which are
+	// removed in a single cleanup cycle, 0 means no limit. The remaining
+	// tombstones are removed in the following cycles.
+	TombstoneCleanupMaxPerCycle int
+
 	// metadata for monitoring
 	ShardName string
 	ClassName string
@@ -60,5 +68,13 @@ func (c Config) Validate() error {
 		ec.Addf("distancerProvider cannot be nil")
 	}
 
+	if c.TombstoneCleanupConcurrency < 0 {
+		ec.Addf("tombstoneCleanupConcurrency cannot be negative")
+	}
+
+	if c.TombstoneCleanupMaxPerCycle < 0 {
+		ec.Addf("tombstoneCleanupMaxPerCycle cannot be negative")
+	}
+
 	return ec.ToError()
 }
@@ -55,6 +55,35 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		}
 	}
 
+	// the graph was built using the original distance, changing it would
+	// require a reindex
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from \"%s\" to \"%s\"",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+
+	// pq and sq can be turned on for an existing index, but the original
+	// vectors are no longer used once the index is compressed
+	if initialParsed.PQ.Enabled && !updatedParsed.PQ.Enabled {
+		return errors.Errorf("pq.enabled cannot be changed from \"true\" to \"false\"")
+	}
+	if initialParsed.SQ.Enabled && !updatedParsed.SQ.Enabled {
+		return errors.Errorf("sq.enabled cannot be changed from \"true\" to \"false\"")
+	}
+
+	// binary quantization is applied on import, there is no way to compress
+	// or decompress the vectors of an existing index
+	if initialParsed.BQ.Enabled != updatedParsed.BQ.Enabled {
+		return errors.Errorf("bq.enabled is immutable: attempted change from \"%t\" to \"%t\"",
+			initialParsed.BQ.Enabled, updatedParsed.BQ.Enabled)
+	}
+
+	// the vector cache is chosen when the index is created
+	if initialParsed.VectorCacheMmap != updatedParsed.VectorCacheMmap {
+		return errors.Errorf("vectorCacheMmap is immutable: attempted change from \"%t\" to \"%t\"",
+			initialParsed.VectorCacheMmap, updatedParsed.VectorCacheMmap)
+	}
+
 	return nil
 }
 
@@ -90,8 +119,10 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	h.doNotRescore.Store(skipRescore(parsed))
 
-	if !parsed.PQ.Enabled {
+	if !parsed.PQ.Enabled && !parsed.SQ.Enabled {
+		h.updateCacheMaxSize(int64(parsed.VectorCacheMaxObjects))
 		callback()
 		return nil
 	}
@@ -100,11 +131,7 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	if h.compressedVectorsCache == (*compressedShardedLockCache)(nil) {
 		h.compressedVectorsCache = newCompressedShardedLockCache(parsed.VectorCacheMaxObjects, h.logger)
 	} else {
-		if h.compressed.Load() {
-			h.compressedVectorsCache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
-		} else {
-			h.cache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
-		}
+		h.updateCacheMaxSize(int64(parsed.VectorCacheMaxObjects))
 	}
 
 	// ToDo: check atomic operation
@@ -119,13 +146,24 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	return nil
 }
 
+// updateCacheMaxSize resizes whichever vector cache is currently in use, so
+// that vectorCacheMaxObjects can be changed without a restart
+func (h *hnsw) updateCacheMaxSize(size int64) {
+	if h.compressed.Load() {
+		h.compressedVectorsCache.updateMaxSize(size)
+	} else {
+		h.cache.updateMaxSize(size)
+	}
+}
+
 func (h *hnsw) turnOnCompression(cfg ent.UserConfig, callback func()) error {
 	h.logger.WithField("action", "compress").Info("switching to compressed vectors")
 
-	err := ent.ValidatePQConfig(cfg.PQ)
-	if err != nil {
-		callback()
-		return err
+	if !cfg.SQ.Enabled {
+		if err := ent.ValidatePQConfig(cfg.PQ); err != nil {
+			callback()
+			return err
+		}
 	}
 
 	go h.compressThenCallback(cfg, callback)
@@ -136,7 +174,12 @@ func (h *hnsw) turnOnCompression(cfg ent.UserConfig, callback func()) error {
 func (h *hnsw) compressThenCallback(cfg ent.UserConfig, callback func()) {
 	defer callback()
 
-	if err := h.Compress(cfg.PQ); err != nil {
+	compress := func() error { return h.Compress(cfg.PQ) }
+	if cfg.SQ.Enabled {
+		compress = func() error { return h.CompressSQ(cfg.SQ) }
+	}
+
+	if err := compress(); err != nil {
 		h.logger.Error(err)
 		return
 	}
@@ -12,11 +12,15 @@
 package hnsw
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/schema"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
@@ -55,6 +59,49 @@ func TestUserConfigUpdates(t *testing.T) {
 					"cleanupIntervalSeconds is immutable: " +
 						"attempted change from \"60\" to \"90\""),
 			},
+			{
+				name:    "attempting to enable bq",
+				initial: ent.UserConfig{},
+				update:  ent.UserConfig{BQ: ent.BQConfig{Enabled: true}},
+				expectedError: errors.Errorf(
+					"bq.enabled is immutable: " +
+						"attempted change from \"false\" to \"true\""),
+			},
+			{
+				name:    "attempting to memory-map the vector cache",
+				initial: ent.UserConfig{},
+				update:  ent.UserConfig{VectorCacheMmap: true},
+				expectedError: errors.Errorf(
+					"vectorCacheMmap is immutable: " +
+						"attempted change from \"false\" to \"true\""),
+			},
+			{
+				name:    "attempting to change the distance",
+				initial: ent.UserConfig{Distance: "cosine"},
+				update:  ent.UserConfig{Distance: "l2-squared"},
+				expectedError: errors.Errorf(
+					"distance is immutable: " +
+						"attempted change from \"cosine\" to \"l2-squared\""),
+			},
+			{
+				name:    "attempting to disable pq",
+				initial: ent.UserConfig{PQ: ent.PQConfig{Enabled: true}},
+				update:  ent.UserConfig{},
+				expectedError: errors.Errorf(
+					"pq.enabled cannot be changed from \"true\" to \"false\""),
+			},
+			{
+				name:          "enabling pq",
+				initial:       ent.UserConfig{},
+				update:        ent.UserConfig{PQ: ent.PQConfig{Enabled: true}},
+				expectedError: nil,
+			},
+			{
+				name:          "changing bq rescoring",
+				initial:       ent.UserConfig{BQ: ent.BQConfig{Enabled: true}},
+				update:        ent.UserConfig{BQ: ent.BQConfig{Enabled: true, SkipRescore: true}},
+				expectedError: nil,
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
@@ -101,4 +148,49 @@ func TestUserConfigUpdates(t *testing.T) {
 			})
 		}
 	})
+	t.Run("mutable fields are applied to a live index", func(t *testing.T) {
+		initial := ent.UserConfig{
+			VectorCacheMaxObjects: 10,
+			EF:                    -1,
+			DynamicEFMin:          100,
+			DynamicEFMax:          500,
+			DynamicEFFactor:       8,
+			FlatSearchCutoff:      1000,
+		}
+
+		index, err := New(Config{
+			RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+			ID:                    "config-update-test",
+			MakeCommitLoggerThunk: MakeNoopCommitLogger,
+			DistanceProvider:      distancer.NewCosineDistanceProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return nil, errors.Errorf("not implemented")
+			},
+		}, initial, cyclemanager.NewNoop())
+		require.Nil(t, err)
+		defer index.Drop(context.Background())
+
+		assert.Equal(t, 184, index.searchTimeEF(23))
+
+		updated := initial
+		updated.DynamicEFMin = 50
+		updated.DynamicEFMax = 150
+		updated.DynamicEFFactor = 4
+		updated.FlatSearchCutoff = 2000
+		updated.VectorCacheMaxObjects = 20
+		require.Nil(t, ValidateUserConfigUpdate(initial, updated))
+
+		called := false
+		require.Nil(t, index.UpdateUserConfig(updated, func() { called = true }))
+		assert.True(t, called)
+
+		assert.Equal(t, 92, index.searchTimeEF(23))
+		assert.Equal(t, 150, index.searchTimeEF(100))
+		assert.Equal(t, int64(2000), atomic.LoadInt64(&index.flatSearchCutoff))
+		assert.Equal(t, int64(20), index.cache.copyMaxSize())
+
+		updated.EF = 78
+		require.Nil(t, index.UpdateUserConfig(updated, func() {}))
+		assert.Equal(t, 78, index.searchTimeEF(23))
+	})
 }
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -164,6 +165,13 @@ func (h *hnsw) copyTombstonesToAllowList(breakCleanUpTombstonedNodes breakCleanU
 		}
 
 		deleteList.Insert(id)
+
+		// the remaining tombstones are picked up by one of the next cycles, so
+		// that a single cycle doesn't keep the index busy for too long
+		if h.tombstoneCleanupMaxPerCycle > 0 &&
+			deleteList.Len() >= h.tombstoneCleanupMaxPerCycle {
+			break
+		}
 	}
 
 	if deleteList.IsEmpty() {
@@ -181,8 +189,8 @@ func (h *hnsw) CleanUpTombstonedNodes(shouldBreak cyclemanager.ShouldBreakFunc)
 }
 
 func (h *hnsw) cleanUpTombstonedNodes(shouldBreak cyclemanager.ShouldBreakFunc) (bool, error) {
-	h.metrics.StartCleanup(1)
-	defer h.metrics.EndCleanup(1)
+	h.metrics.StartCleanup(h.tombstoneCleanupConcurrency)
+	defer h.metrics.EndCleanup(h.tombstoneCleanupConcurrency)
 
 	h.resetLock.Lock()
 	resetCtx := h.resetCtx
@@ -199,6 +207,9 @@ func (h *hnsw) cleanUpTombstonedNodes(shouldBreak cyclemanager.ShouldBreakFunc)
 	}
 
 	executed = true
+	h.metrics.SetTombstoneCleanupPending(deleteList.Len())
+	defer h.metrics.SetTombstoneCleanupPending(0)
+
 	if ok, err := h.reassignNeighborsOf(deleteList, breakCleanUpTombstonedNodes); err != nil {
 		return executed, err
 	} else if !ok {
@@ -253,25 +264,64 @@ func (h *hnsw) replaceDeletedEntrypoint(deleteList helpers.AllowList, breakClean
 	return true, nil
 }
 
+// reassignNeighborsOf visits all nodes with tombstoneCleanupConcurrency
+// goroutines and reconnects the ones pointing to a deleted node
 func (h *hnsw) reassignNeighborsOf(deleteList helpers.AllowList, breakCleanUpTombstonedNodes breakCleanUpTombstonedNodesFunc) (ok bool, err error) {
 	h.RLock()
 	size := len(h.nodes)
 	h.RUnlock()
 
-	for n := 0; n < size; n++ {
-		if ok, err := h.reassignNeighbor(uint64(n), deleteList, breakCleanUpTombstonedNodes); err != nil {
-			return false, errors.Wrap(err, "reassign neighbor edges")
-		} else if !ok {
-			return false, nil
-		}
+	var (
+		next      atomic.Uint64
+		processed atomic.Int64
+		stopped   atomic.Bool
+		errOnce   sync.Once
+		firstErr  error
+		wg        sync.WaitGroup
+	)
+
+	for i := 0; i < h.tombstoneCleanupConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for !stopped.Load() {
+				n := next.Add(1) - 1
+				if n >= uint64(size) {
+					return
+				}
+
+				ok, err := h.reassignNeighbor(n, deleteList, breakCleanUpTombstonedNodes)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = errors.Wrap(err, "reassign neighbor edges")
+					})
+					stopped.Store(true)
+					return
+				} else if !ok {
+					stopped.Store(true)
+					return
+				}
+
+				if done := processed.Add(1); done%1000 == 0 {
+					h.metrics.TombstoneCleanupProgress(float64(done) / float64(size))
+				}
+			}
+		}()
 	}
+	wg.Wait()
+	h.metrics.TombstoneCleanupProgress(1)
 
-	return true, nil
+	if firstErr != nil {
+		return false, firstErr
+	}
+
+	return !stopped.Load(), nil
 }
 
 func (h *hnsw) reassignNeighbor(neighbor uint64, deleteList helpers.AllowList, breakCleanUpTombstonedNodes breakCleanUpTombstonedNodesFunc) (ok bool, err error) {
-	h.resetLock.Lock()
-	defer h.resetLock.Unlock()
+	h.resetLock.RLock()
+	defer h.resetLock.RUnlock()
 
 	if breakCleanUpTombstonedNodes() {
 		return false, nil
@@ -291,7 +341,7 @@ func (h *hnsw) reassignNeighbor(neighbor uint64, deleteList helpers.AllowList, b
 	if h.compressed.Load() {
 		vec, err := h.compressedVectorsCache.get(context.Background(), neighbor)
 		if err == nil {
-			neighborVec = h.pq.Decode(vec)
+			neighborVec = h.compressor.Decode(vec)
 		}
 	} else {
 		neighborVec, err = h.cache.get(context.Background(), neighbor)
@@ -573,6 +623,7 @@ func (h *hnsw) removeTombstonesAndNodes(deleteList helpers.AllowList, breakClean
 	it := deleteList.Iterator()
 	for id, ok := it.Next(); ok; id, ok = it.Next() {
 		h.metrics.RemoveTombstone()
+		h.metrics.TombstoneCleanedUp()
 		h.tombstoneLock.Lock()
 		delete(h.tombstones, id)
 		h.tombstoneLock.Unlock()
@@ -242,6 +242,89 @@ func TestDelete_WithCleaningUpTombstonesOnce(t *testing.T) {
 	})
 }
 
+func TestDelete_WithConcurrentLimitedCleanupCycles(t *testing.T) {
+	// the cleanup runs with multiple goroutines and removes at most 10
+	// tombstones per cycle
+	vectors := vectorsForDeleteTest()
+	var vectorIndex *hnsw
+
+	t.Run("import the test vectors", func(t *testing.T) {
+		index, err := New(Config{
+			RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+			ID:                    "delete-test",
+			MakeCommitLoggerThunk: MakeNoopCommitLogger,
+			DistanceProvider:      distancer.NewCosineDistanceProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk:        TempVectorForIDThunk(vectors),
+			TombstoneCleanupConcurrency: 4,
+			TombstoneCleanupMaxPerCycle: 10,
+		}, ent.UserConfig{
+			MaxConnections:        30,
+			EFConstruction:        128,
+			VectorCacheMaxObjects: 100000,
+		}, cyclemanager.NewNoop())
+		require.Nil(t, err)
+		vectorIndex = index
+
+		for i, vec := range vectors {
+			err := vectorIndex.Add(uint64(i), vec)
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("deleting every even element", func(t *testing.T) {
+		for i := range vectors {
+			if i%2 != 0 {
+				continue
+			}
+
+			err := vectorIndex.Delete(uint64(i))
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("a single cycle removes at most 10 tombstones", func(t *testing.T) {
+		before := len(vectorIndex.tombstones)
+		require.Greater(t, before, 10)
+
+		err := vectorIndex.CleanUpTombstonedNodes(neverStop)
+		require.Nil(t, err)
+		assert.Len(t, vectorIndex.tombstones, before-10)
+	})
+
+	t.Run("the following cycles remove the rest", func(t *testing.T) {
+		for len(vectorIndex.tombstones) > 0 {
+			err := vectorIndex.CleanUpTombstonedNodes(neverStop)
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("search only contains the remaining elements", func(t *testing.T) {
+		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 20)
+
+		bf := bruteForceCosine(vectors, []float32{0.1, 0.1, 0.1}, len(vectors))
+		expected := make([]uint64, 0, 20)
+		for _, elem := range bf {
+			if elem%2 == 0 {
+				continue
+			}
+			expected = append(expected, elem)
+			if len(expected) == 20 {
+				break
+			}
+		}
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("destroy the index", func(t *testing.T) {
+		require.Nil(t, vectorIndex.Drop(context.Background()))
+	})
+}
+
 func TestDelete_WithCleaningUpTombstonesInBetween(t *testing.T) {
 	// there is a single bulk clean event after all the deletes
 	vectors := vectorsForDeleteTest()
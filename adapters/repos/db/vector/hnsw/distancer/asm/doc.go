@@ -9,5 +9,7 @@
 //  CONTACT: hello@weaviate.io
 //
 
-// asm only has amd64 specific implementations at the moment
+// asm has AVX2 and AVX-512 implementations for amd64 and NEON implementations
+// for arm64. The distancer package picks one at runtime depending on the
+// features of the CPU and falls back to pure Go otherwise.
 package asm
@@ -51,5 +51,6 @@ reduce:
 	VADDPS       X0, X4, X0
 	VHADDPS      X0, X0, X0
 	VHADDPS      X0, X0, X0
+	VZEROUPPER
 	MOVSS        X0, ret+48(FP)
 	RET
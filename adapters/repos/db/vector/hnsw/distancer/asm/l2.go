@@ -112,6 +112,8 @@ func main() {
 	VADDPS(result, tail, result)
 	VHADDPS(result, result, result)
 	VHADDPS(result, result, result)
+	// avoid the penalty of mixing the dirty upper registers with SSE code
+	VZEROUPPER()
 	Store(result, ReturnIndex(0))
 
 	RET()
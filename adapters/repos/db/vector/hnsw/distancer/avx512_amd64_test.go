@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer/asm"
+	"golang.org/x/sys/cpu"
+)
+
+func Test_AVX512_DistanceImplementations(t *testing.T) {
+	if !cpu.X86.HasAVX512F {
+		t.Skip("AVX-512 is not supported by this CPU")
+	}
+
+	r := getRandomSeed()
+	lengths := []int{1, 4, 15, 16, 17, 31, 63, 64, 65, 80, 128, 130, 256, 260, 384, 390, 768, 777, 1536}
+
+	for _, length := range lengths {
+		t.Run(fmt.Sprintf("with vector l=%d", length), func(t *testing.T) {
+			x := make([]float32, length)
+			y := make([]float32, length)
+			for i := range x {
+				x[i] = r.Float32()
+				y[i] = -r.Float32()
+			}
+
+			assert.InEpsilon(t, -DotProductGo(x, y), asm.DotAVX512(x, y), 0.0001)
+			assert.InEpsilon(t, L2PureGo(x, y), asm.L2AVX512(x, y), 0.0001)
+			assert.InEpsilon(t, ManhattanPureGo(x, y), asm.ManhattanAVX512(x, y), 0.0001)
+		})
+	}
+
+	for _, length := range lengths {
+		t.Run(fmt.Sprintf("hamming with vector l=%d", length), func(t *testing.T) {
+			x := make([]float32, length)
+			y := make([]float32, length)
+			for i := range x {
+				// few distinct values, so that about half of the dimensions match
+				x[i] = float32(r.Intn(2))
+				y[i] = float32(r.Intn(2))
+			}
+
+			assert.Equal(t, HammingPureGo(x, y), asm.HammingAVX512(x, y))
+		})
+	}
+
+	t.Run("with identical vectors", func(t *testing.T) {
+		x := make([]float32, 777)
+		for i := range x {
+			x[i] = r.Float32()
+		}
+
+		assert.Equal(t, float32(0), asm.L2AVX512(x, x))
+		assert.Equal(t, float32(0), asm.ManhattanAVX512(x, x))
+		assert.Equal(t, float32(0), asm.HammingAVX512(x, x))
+	})
+
+	t.Run("with empty vectors", func(t *testing.T) {
+		assert.Equal(t, float32(0), asm.DotAVX512(nil, nil))
+		assert.Equal(t, float32(0), asm.L2AVX512(nil, nil))
+		assert.Equal(t, float32(0), asm.ManhattanAVX512(nil, nil))
+		assert.Equal(t, float32(0), asm.HammingAVX512(nil, nil))
+	})
+}
+
+func Benchmark_AVX2_VS_AVX512(b *testing.B) {
+	if !cpu.X86.HasAVX512F {
+		b.Skip("AVX-512 is not supported by this CPU")
+	}
+
+	r := getRandomSeed()
+	lengths := []int{30, 32, 128, 256, 300, 384, 600, 768, 1024, 1536}
+	for _, length := range lengths {
+		b.Run(fmt.Sprintf("vector dim=%d", length), func(b *testing.B) {
+			x := make([]float32, length)
+			y := make([]float32, length)
+			for i := range x {
+				x[i] = r.Float32()
+				y[i] = r.Float32()
+			}
+
+			b.Run("dot avx2", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.Dot(x, y)
+				}
+			})
+
+			b.Run("dot avx512", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.DotAVX512(x, y)
+				}
+			})
+
+			b.Run("l2 avx2", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.L2(x, y)
+				}
+			})
+
+			b.Run("l2 avx512", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.L2AVX512(x, y)
+				}
+			})
+
+			b.Run("manhattan avx2", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.Manhattan(x, y)
+				}
+			})
+
+			b.Run("manhattan avx512", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.ManhattanAVX512(x, y)
+				}
+			})
+
+			b.Run("hamming avx2", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.Hamming(x, y)
+				}
+			})
+
+			b.Run("hamming avx512", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.HammingAVX512(x, y)
+				}
+			})
+		})
+	}
+}
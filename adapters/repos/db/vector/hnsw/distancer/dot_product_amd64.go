@@ -17,7 +17,10 @@ import (
 )
 
 func init() {
-	if cpu.X86.HasAVX2 {
+	// cpu.X86.HasAVX512F is only set if the OS also saves the zmm registers
+	if cpu.X86.HasAVX512F {
+		dotProductImplementation = asm.DotAVX512
+	} else if cpu.X86.HasAVX2 {
 		dotProductImplementation = asm.Dot
 	}
 }
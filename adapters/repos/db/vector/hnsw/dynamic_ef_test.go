@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/searchparams"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -101,3 +102,32 @@ func Test_DynamicEF(t *testing.T) {
 		})
 	}
 }
+
+func Test_QueryEF(t *testing.T) {
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "query-ef-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return nil, errors.Errorf("not implemented")
+		},
+	}, ent.UserConfig{
+		VectorCacheMaxObjects: 10,
+		EF:                    78,
+	}, cyclemanager.NewNoop())
+	require.Nil(t, err)
+	defer index.Drop(context.Background())
+
+	t.Run("without query params", func(t *testing.T) {
+		assert.Equal(t, 78, index.queryEF(5, searchparams.VectorIndex{}))
+	})
+
+	t.Run("with ef in query params", func(t *testing.T) {
+		assert.Equal(t, 300, index.queryEF(5, searchparams.VectorIndex{Ef: 300}))
+	})
+
+	t.Run("with ef in query params lower than limit", func(t *testing.T) {
+		assert.Equal(t, 20, index.queryEF(20, searchparams.VectorIndex{Ef: 10}))
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"io"
 	"math"
 	"math/rand"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 
@@ -42,8 +43,9 @@ type hnsw struct {
 
 	tombstoneLock *sync.RWMutex
 
-	// prevents tombstones cleanup to be performed in parallel with index reset operation
-	resetLock *sync.Mutex
+	// prevents tombstones cleanup to be performed in parallel with index reset
+	// operation. Neighbors are reassigned concurrently under the read lock.
+	resetLock *sync.RWMutex
 	// indicates whether reset operation occurred or not - if so tombstones cleanup method
 	// is aborted as it makes no sense anymore
 	resetCtx       context.Context
@@ -108,6 +110,9 @@ type hnsw struct {
 	// used for cancellation of the tombstone cleanup goroutine
 	unregisterTombstoneCleanup cyclemanager.UnregisterFunc
 
+	tombstoneCleanupConcurrency int
+	tombstoneCleanupMaxPerCycle int
+
 	// // for distributed spike, can be used to call a insertExternal on a different graph
 	// insertHook func(node, targetLevel int, neighborsAtLevel map[int][]uint32)
 
@@ -147,8 +152,12 @@ type hnsw struct {
 	deleteVsInsertLock sync.RWMutex
 
 	compressed             atomic.Bool
-	doNotRescore           bool
+	doNotRescore           atomic.Bool
 	pq                     *ssdhelpers.ProductQuantizer
+	compressor             ssdhelpers.Compressor
+	sqTrainer              atomic.Pointer[ssdhelpers.SQTrainer]
+	sqTrainingLimit        atomic.Int64
+	sqTrainOnce            sync.Once
 	pqConfig               ent.PQConfig
 	compressedVectorsCache cache[byte]
 	compressedStore        *lsmkv.Store
@@ -176,6 +185,7 @@ type CommitLogger interface {
 	RootPath() string
 	SwitchCommitLogs(bool) error
 	AddPQ(ssdhelpers.PQData) error
+	AddSQ(ssdhelpers.SQData) error
 }
 
 type BufferedLinksLogger interface {
@@ -215,11 +225,23 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		normalizeOnRead = true
 	}
 
-	vectorCache := newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
-		cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+	var vectorCache vectorCacheWithMultiGet
+	if uc.VectorCacheMmap {
+		mmapCache, err := newMmapCache(
+			filepath.Join(cfg.RootPath, fmt.Sprintf("%s.hnsw.vectors", cfg.ID)),
+			cfg.VectorForIDThunk, uc.VectorCacheMaxObjects, cfg.Logger,
+			normalizeOnRead, defaultDeletionInterval)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init vector cache of index %q", cfg.ID)
+		}
+		vectorCache = mmapCache
+	} else {
+		vectorCache = newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
+			cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+	}
 
 	var compressedVectorsCache *compressedShardedLockCache
-	if uc.PQ.Enabled {
+	if uc.PQ.Enabled || uc.BQ.Enabled || uc.SQ.Enabled {
 		compressedVectorsCache = newCompressedShardedLockCache(uc.VectorCacheMaxObjects, cfg.Logger)
 	}
 
@@ -246,10 +268,13 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		distancerProvider:      cfg.DistanceProvider,
 		deleteLock:             &sync.Mutex{},
 		tombstoneLock:          &sync.RWMutex{},
-		resetLock:              &sync.Mutex{},
+		resetLock:              &sync.RWMutex{},
 		resetCtx:               resetCtx,
 		resetCtxCancel:         resetCtxCancel,
 		initialInsertOnce:      &sync.Once{},
+
+		tombstoneCleanupConcurrency: cfg.TombstoneCleanupConcurrency,
+		tombstoneCleanupMaxPerCycle: cfg.TombstoneCleanupMaxPerCycle,
 		// cleanupInterval:        time.Duration(uc.CleanupIntervalSeconds) * time.Second,
 
 		ef:       int64(uc.EF),
@@ -268,6 +293,12 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		pqConfig:             uc.PQ,
 	}
 
+	index.doNotRescore.Store(skipRescore(uc))
+
+	if index.tombstoneCleanupConcurrency == 0 {
+		index.tombstoneCleanupConcurrency = 1
+	}
+
 	// TODO common_cycle_manager move to poststartup?
 	index.unregisterTombstoneCleanup = tombstoneCleanupCycle.Register(index.tombstoneCleanup)
 	index.insertMetrics = newInsertMetrics(index.metrics)
@@ -276,6 +307,16 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		return nil, errors.Wrapf(err, "init index %q", index.id)
 	}
 
+	if uc.BQ.Enabled {
+		if err := index.initBinaryQuantization(); err != nil {
+			return nil, errors.Wrapf(err, "init binary quantization of index %q", index.id)
+		}
+	}
+
+	if uc.SQ.Enabled && !index.compressed.Load() {
+		index.startScalarQuantizerTraining(uc.SQ.TrainingLimit)
+	}
+
 	return index, nil
 }
 
@@ -458,7 +499,7 @@ func (h *hnsw) distBetweenNodes(a, b uint64) (float32, bool, error) {
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", b)
 		}
 
-		return h.pq.DistanceBetweenCompressedVectors(v1, v2), true, nil
+		return h.compressor.DistanceBetweenCompressedVectors(v1, v2), true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -517,7 +558,7 @@ func (h *hnsw) distBetweenNodeAndVec(node uint64, vecB []float32) (float32, bool
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", node)
 		}
 
-		return h.pq.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
+		return h.compressor.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -547,33 +588,6 @@ func (h *hnsw) distBetweenNodeAndVec(node uint64, vecB []float32) (float32, bool
 	return h.distancerProvider.SingleDist(vecA, vecB)
 }
 
-func (h *hnsw) Stats() {
-	fmt.Printf("levels: %d\n", h.currentMaximumLayer)
-
-	perLevelCount := map[int]uint{}
-
-	for _, node := range h.nodes {
-		if node == nil {
-			continue
-		}
-		l := node.level
-		if l == 0 && len(node.connections) == 0 {
-			// filter out allocated space without nodes
-			continue
-		}
-		c, ok := perLevelCount[l]
-		if !ok {
-			perLevelCount[l] = 0
-		}
-
-		perLevelCount[l] = c + 1
-	}
-
-	for level, count := range perLevelCount {
-		fmt.Printf("unique count on level %d: %d\n", level, count)
-	}
-}
-
 func (h *hnsw) isEmpty() bool {
 	h.RLock()
 	defer h.RUnlock()
@@ -604,6 +618,12 @@ func (h *hnsw) nodeByID(id uint64) *vertex {
 	return h.nodes[id]
 }
 
+// ContainsNode reports whether the vector with the given id has been
+// inserted into the graph
+func (h *hnsw) ContainsNode(id uint64) bool {
+	return h.nodeByID(id) != nil
+}
+
 func (h *hnsw) Drop(ctx context.Context) error {
 	// cancel tombstone cleanup goroutine
 	if err := h.unregisterTombstoneCleanup(ctx); err != nil {
@@ -61,8 +61,14 @@ func (h *hnsw) Add(id uint64, vector []float32) error {
 	}
 
 	h.compressActionLock.RLock()
-	defer h.compressActionLock.RUnlock()
-	return h.insert(node, vector)
+	err := h.insert(node, vector)
+	h.compressActionLock.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	h.trainScalarQuantizer(vector)
+	return nil
 }
 
 func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
@@ -90,7 +96,7 @@ func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
 
 	h.nodes[node.id] = node
 	if h.compressed.Load() {
-		compressed := h.pq.Encode(nodeVec)
+		compressed := h.compressor.Encode(nodeVec)
 		h.storeCompressedVector(node.id, compressed)
 		h.compressedVectorsCache.preload(node.id, compressed)
 	} else {
@@ -168,7 +174,7 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 	// // make sure this new vec is immediately present in the cache, so we don't
 	// // have to read it from disk again
 	if h.compressed.Load() {
-		compressed := h.pq.Encode(nodeVec)
+		compressed := h.compressor.Encode(nodeVec)
 		h.storeCompressedVector(node.id, compressed)
 		h.compressedVectorsCache.preload(node.id, compressed)
 	} else {
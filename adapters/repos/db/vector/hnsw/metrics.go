@@ -21,6 +21,8 @@ import (
 type Metrics struct {
 	enabled          bool
 	tombstones       prometheus.Gauge
+	cleanupPending   prometheus.Gauge
+	cleanupProgress  prometheus.Gauge
 	threads          prometheus.Gauge
 	insert           prometheus.Gauge
 	insertTime       prometheus.ObserverVec
@@ -51,6 +53,16 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		"shard_name": shardName,
 	})
 
+	cleanupPending := prom.VectorIndexTombstoneCleanupPending.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
+	cleanupProgress := prom.VectorIndexTombstoneCycleProgress.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
 	threads := prom.VectorIndexTombstoneCleanupThreads.With(prometheus.Labels{
 		"class_name": className,
 		"shard_name": shardName,
@@ -115,6 +127,8 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 	return &Metrics{
 		enabled:          true,
 		tombstones:       tombstones,
+		cleanupPending:   cleanupPending,
+		cleanupProgress:  cleanupProgress,
 		threads:          threads,
 		cleaned:          cleaned,
 		insert:           insert,
@@ -145,6 +159,42 @@ func (m *Metrics) RemoveTombstone() {
 	m.tombstones.Dec()
 }
 
+func (m *Metrics) SetTombstones(count int) {
+	if !m.enabled {
+		return
+	}
+
+	m.tombstones.Set(float64(count))
+}
+
+// SetTombstoneCleanupPending sets the number of tombstones which the running
+// cleanup cycle is going to remove
+func (m *Metrics) SetTombstoneCleanupPending(count int) {
+	if !m.enabled {
+		return
+	}
+
+	m.cleanupPending.Set(float64(count))
+}
+
+func (m *Metrics) TombstoneCleanedUp() {
+	if !m.enabled {
+		return
+	}
+
+	m.cleanupPending.Dec()
+}
+
+// TombstoneCleanupProgress is the ratio of nodes the running cleanup cycle has
+// visited to reassign their edges
+func (m *Metrics) TombstoneCleanupProgress(ratio float64) {
+	if !m.enabled {
+		return
+	}
+
+	m.cleanupProgress.Set(ratio)
+}
+
 func (m *Metrics) StartCleanup(threads int) {
 	if !m.enabled {
 		return
@@ -0,0 +1,331 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// mmapSlotsPerChunk is the number of vectors per mapped region of the file.
+// As it is a multiple of the page size, every chunk starts at a page aligned
+// offset regardless of the size of a vector.
+const mmapSlotsPerChunk = 4096
+
+// mmapCache keeps the vectors in a memory-mapped file instead of the heap.
+// Every doc id has a fixed slot in the file, consisting of a marker which is
+// set once the vector is present, followed by the vector itself. As the
+// memory is backed by the file, the kernel can reclaim it whenever it runs
+// low, so indexes which are larger than the available memory remain
+// queryable and get slower instead of being killed. Once more vectors than
+// the max size have been written since the last check, the pages are
+// released, which bounds the memory used by the cache in the regular case.
+//
+// The file is recreated on startup, as the vectors are read from the object
+// store on demand.
+type mmapCache struct {
+	path            string
+	vectorForID     VectorForID
+	normalizeOnRead bool
+	maxSize         int64
+	count           int64
+	written         int64
+	hits            int64
+	misses          int64
+	cancel          chan bool
+	logger          logrus.FieldLogger
+	dims            int32
+	slotSize        int
+
+	// chunks are never unmapped while the cache is in use, so that vectors
+	// returned from the cache stay valid. The list is replaced when it grows.
+	chunks atomic.Pointer[[][]byte]
+	file   *os.File
+
+	// The maintenanceLock makes sure that only one maintenance operation, such
+	// as growing the file or releasing memory happens at the same time.
+	maintenanceLock sync.Mutex
+	dropped         bool
+}
+
+func newMmapCache(path string, vecForID VectorForID, maxSize int,
+	logger logrus.FieldLogger, normalizeOnRead bool, deletionInterval time.Duration,
+) (*mmapCache, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o666)
+	if err != nil {
+		return nil, errors.Wrap(err, "create mmap vector cache file")
+	}
+
+	c := &mmapCache{
+		path:            path,
+		vectorForID:     vecForID,
+		normalizeOnRead: normalizeOnRead,
+		maxSize:         int64(maxSize),
+		cancel:          make(chan bool),
+		logger:          logger,
+		file:            file,
+	}
+	c.chunks.Store(&[][]byte{})
+	c.watchForRelease(deletionInterval)
+	return c, nil
+}
+
+// slot returns the marker and the memory of the vector of the given id, ok is
+// false if the file doesn't contain the slot (yet)
+func (c *mmapCache) slot(id uint64) (marker *uint32, vec []float32, ok bool) {
+	chunks := *c.chunks.Load()
+	chunk := id / mmapSlotsPerChunk
+	if chunk >= uint64(len(chunks)) {
+		return nil, nil, false
+	}
+
+	offset := int(id%mmapSlotsPerChunk) * c.slotSize
+	slot := chunks[chunk][offset : offset+c.slotSize]
+	marker = (*uint32)(unsafe.Pointer(&slot[0]))
+	vec = unsafe.Slice((*float32)(unsafe.Pointer(&slot[4])), (c.slotSize-4)/4)
+	return marker, vec, true
+}
+
+func (c *mmapCache) get(ctx context.Context, id uint64) ([]float32, error) {
+	if marker, vec, ok := c.slot(id); ok && atomic.LoadUint32(marker) == 1 {
+		atomic.AddInt64(&c.hits, 1)
+		return vec, nil
+	}
+
+	return c.handleCacheMiss(ctx, id)
+}
+
+func (c *mmapCache) multiGet(ctx context.Context, ids []uint64) ([][]float32, []error) {
+	out := make([][]float32, len(ids))
+	errs := make([]error, len(ids))
+
+	for i, id := range ids {
+		out[i], errs[i] = c.get(ctx, id)
+	}
+
+	return out, errs
+}
+
+func (c *mmapCache) handleCacheMiss(ctx context.Context, id uint64) ([]float32, error) {
+	atomic.AddInt64(&c.misses, 1)
+	vec, err := c.vectorForID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.normalizeOnRead {
+		vec = distancer.Normalize(vec)
+	}
+
+	c.store(id, vec)
+	return vec, nil
+}
+
+// store writes the vector into its slot. Failures are only logged, as the
+// vector can still be served from the object store.
+func (c *mmapCache) store(id uint64, vec []float32) {
+	if err := c.ensureCapacity(id, len(vec)); err != nil {
+		c.logger.WithField("action", "hnsw_mmap_vector_cache").WithError(err).
+			Warn("failed to grow mmap vector cache")
+		return
+	}
+
+	marker, slot, ok := c.slot(id)
+	if !ok || len(slot) != len(vec) {
+		return
+	}
+
+	copy(slot, vec)
+	if atomic.SwapUint32(marker, 1) == 0 {
+		atomic.AddInt64(&c.count, 1)
+		atomic.AddInt64(&c.written, 1)
+	}
+}
+
+// ensureCapacity maps as many chunks as needed to hold the given id. The
+// dimensions of the first vector determine the size of a slot.
+func (c *mmapCache) ensureCapacity(id uint64, dims int) error {
+	if id < uint64(len(*c.chunks.Load())*mmapSlotsPerChunk) {
+		return nil
+	}
+
+	c.maintenanceLock.Lock()
+	defer c.maintenanceLock.Unlock()
+
+	if c.dropped {
+		// vectors which are read after a shutdown are no longer cached
+		return nil
+	}
+
+	if c.slotSize == 0 {
+		atomic.StoreInt32(&c.dims, int32(dims))
+		c.slotSize = 4 + 4*dims
+	}
+
+	chunks := *c.chunks.Load()
+	if id < uint64(len(chunks)*mmapSlotsPerChunk) {
+		return nil
+	}
+
+	chunkSize := int64(mmapSlotsPerChunk * c.slotSize)
+	grown := append(make([][]byte, 0, id/mmapSlotsPerChunk+1), chunks...)
+	for uint64(len(grown)) <= id/mmapSlotsPerChunk {
+		offset := int64(len(grown)) * chunkSize
+		if err := c.file.Truncate(offset + chunkSize); err != nil {
+			return errors.Wrap(err, "grow file")
+		}
+
+		chunk, err := syscall.Mmap(int(c.file.Fd()), offset, int(chunkSize),
+			syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+		if err != nil {
+			return errors.Wrap(err, "mmap file")
+		}
+		grown = append(grown, chunk)
+	}
+
+	c.chunks.Store(&grown)
+	return nil
+}
+
+func (c *mmapCache) delete(ctx context.Context, id uint64) {
+	marker, _, ok := c.slot(id)
+	if !ok {
+		return
+	}
+
+	if atomic.SwapUint32(marker, 0) == 1 {
+		atomic.AddInt64(&c.count, -1)
+	}
+}
+
+func (c *mmapCache) prefetch(id uint64) {
+	if marker, _, ok := c.slot(id); ok {
+		prefetchFunc(uintptr(unsafe.Pointer(marker)))
+	}
+}
+
+func (c *mmapCache) preload(id uint64, vec []float32) {
+	c.store(id, vec)
+}
+
+// grow is a no-op, the file grows with the first vector which doesn't fit
+func (c *mmapCache) grow(node uint64) {}
+
+func (c *mmapCache) len() int32 {
+	return int32(len(*c.chunks.Load()) * mmapSlotsPerChunk)
+}
+
+func (c *mmapCache) countVectors() int64 {
+	return atomic.LoadInt64(&c.count)
+}
+
+func (c *mmapCache) stats() cacheStats {
+	return cacheStats{
+		hits:           atomic.LoadInt64(&c.hits),
+		misses:         atomic.LoadInt64(&c.misses),
+		bytesPerVector: int(atomic.LoadInt32(&c.dims)) * 4,
+	}
+}
+
+func (c *mmapCache) all() [][]float32 {
+	out := make([][]float32, c.len())
+	for id := range out {
+		if marker, vec, ok := c.slot(uint64(id)); ok && atomic.LoadUint32(marker) == 1 {
+			out[id] = vec
+		}
+	}
+	return out
+}
+
+// drop unmaps and removes the file. As it is recreated on startup anyway,
+// there is no need to keep it around after a shutdown either.
+func (c *mmapCache) drop() {
+	c.cancel <- true
+
+	c.maintenanceLock.Lock()
+	defer c.maintenanceLock.Unlock()
+
+	chunks := *c.chunks.Load()
+	c.chunks.Store(&[][]byte{})
+	c.dropped = true
+
+	for _, chunk := range chunks {
+		if err := syscall.Munmap(chunk); err != nil {
+			c.logger.WithField("action", "hnsw_mmap_vector_cache").WithError(err).
+				Warn("failed to unmap vector cache")
+		}
+	}
+
+	if err := c.file.Close(); err != nil {
+		c.logger.WithField("action", "hnsw_mmap_vector_cache").WithError(err).
+			Warn("failed to close vector cache file")
+	}
+
+	if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+		c.logger.WithField("action", "hnsw_mmap_vector_cache").WithError(err).
+			Warn("failed to remove vector cache file")
+	}
+	atomic.StoreInt64(&c.count, 0)
+}
+
+func (c *mmapCache) watchForRelease(interval time.Duration) {
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-c.cancel:
+				return
+			case <-t.C:
+				c.releaseIfFull()
+			}
+		}
+	}()
+}
+
+// releaseIfFull tells the kernel that the mapped pages are no longer needed.
+// Unlike with the heap based cache, the vectors aren't lost, they are read
+// from the file again the next time they are accessed.
+func (c *mmapCache) releaseIfFull() {
+	if atomic.LoadInt64(&c.written) < atomic.LoadInt64(&c.maxSize) {
+		return
+	}
+
+	c.maintenanceLock.Lock()
+	defer c.maintenanceLock.Unlock()
+
+	for _, chunk := range *c.chunks.Load() {
+		if err := syscall.Madvise(chunk, syscall.MADV_DONTNEED); err != nil {
+			c.logger.WithField("action", "hnsw_mmap_vector_cache").WithError(err).
+				Warn("failed to release vector cache memory")
+			return
+		}
+	}
+	atomic.StoreInt64(&c.written, 0)
+}
+
+func (c *mmapCache) updateMaxSize(size int64) {
+	atomic.StoreInt64(&c.maxSize, size)
+}
+
+func (c *mmapCache) copyMaxSize() int64 {
+	return atomic.LoadInt64(&c.maxSize)
+}
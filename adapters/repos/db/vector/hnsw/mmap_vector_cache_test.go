@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestMmapCache(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	path := filepath.Join(t.TempDir(), "vectors")
+
+	var reads int64
+	vecForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		atomic.AddInt64(&reads, 1)
+		return []float32{float32(id), 1, 2}, nil
+	}
+
+	c, err := newMmapCache(path, vecForID, 1000, logger, false, time.Hour)
+	require.Nil(t, err)
+
+	t.Run("misses are read once and kept in the file", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			vec, err := c.get(context.Background(), 7)
+			require.Nil(t, err)
+			assert.Equal(t, []float32{7, 1, 2}, vec)
+		}
+		assert.Equal(t, int64(1), atomic.LoadInt64(&reads))
+		assert.Equal(t, int64(1), c.countVectors())
+	})
+
+	t.Run("the file grows to hold large ids", func(t *testing.T) {
+		id := uint64(3*mmapSlotsPerChunk + 5)
+		c.preload(id, []float32{9, 9, 9})
+
+		vec, err := c.get(context.Background(), id)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{9, 9, 9}, vec)
+		assert.Equal(t, int32(4*mmapSlotsPerChunk), c.len())
+		assert.Equal(t, []float32{9, 9, 9}, c.all()[id])
+	})
+
+	t.Run("deleted vectors are read again", func(t *testing.T) {
+		c.delete(context.Background(), 7)
+		_, err := c.get(context.Background(), 7)
+		require.Nil(t, err)
+		assert.Equal(t, int64(2), atomic.LoadInt64(&reads))
+	})
+
+	t.Run("releasing the memory keeps the vectors", func(t *testing.T) {
+		c.updateMaxSize(1)
+		c.releaseIfFull()
+
+		vec, err := c.get(context.Background(), 7)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{7, 1, 2}, vec)
+		assert.Equal(t, int64(2), atomic.LoadInt64(&reads))
+	})
+
+	t.Run("drop removes the file", func(t *testing.T) {
+		c.drop()
+		_, err := os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+
+		vec, err := c.get(context.Background(), 7)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{7, 1, 2}, vec)
+	})
+}
+
+func TestMmapCacheIndex(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecs(300, 0, 32)
+	rootPath := t.TempDir()
+	index, err := New(Config{
+		RootPath:              rootPath,
+		ID:                    "mmap-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 100,
+		VectorCacheMmap:       true,
+	}, cyclemanager.NewNoop())
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	_, err = os.Stat(filepath.Join(rootPath, "mmap-test.hnsw.vectors"))
+	require.Nil(t, err)
+
+	for _, id := range []uint64{0, 99, 250} {
+		ids, _, err := index.SearchByVector(vectors[id], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{id}, ids)
+	}
+
+	require.Nil(t, index.Shutdown(context.Background()))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
@@ -43,6 +44,20 @@ func (h *hnsw) searchTimeEF(k int) int {
 	return ef
 }
 
+// queryEF is the ef of a single query, the ef of the query params takes
+// precedence over the user config
+func (h *hnsw) queryEF(k int, params searchparams.VectorIndex) int {
+	if params.Ef < 1 {
+		return h.searchTimeEF(k)
+	}
+
+	if params.Ef < k {
+		return k
+	}
+
+	return params.Ef
+}
+
 func (h *hnsw) autoEfFromK(k int) int {
 	factor := int(atomic.LoadInt64(&h.efFactor))
 	min := int(atomic.LoadInt64(&h.efMin))
@@ -62,6 +77,14 @@ func (h *hnsw) autoEfFromK(k int) int {
 }
 
 func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+	return h.SearchByVectorWithParams(vector, k, allowList, searchparams.VectorIndex{})
+}
+
+// SearchByVectorWithParams is SearchByVector with the ef and the rescoring of
+// the user config overridden for a single query
+func (h *hnsw) SearchByVectorWithParams(vector []float32, k int, allowList helpers.AllowList,
+	params searchparams.VectorIndex,
+) ([]uint64, []float32, error) {
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
@@ -75,7 +98,14 @@ func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowLi
 	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
 		return h.flatSearch(vector, k, allowList)
 	}
-	return h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
+
+	ef := h.queryEF(k, params)
+	rescore := h.shouldRescore()
+	if params.Rescore != nil {
+		rescore = h.compressed.Load() && *params.Rescore
+	}
+
+	return h.knnSearchByVectorRescore(vector, k, ef, rescore, allowList)
 }
 
 // SearchByVectorDistance wraps SearchByVector, and calls it recursively until
@@ -89,6 +119,15 @@ func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowLi
 // passed in to truly obtain all results from the vector index.
 func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
 	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.SearchByVectorDistanceWithParams(vector, targetDistance, maxLimit,
+		allowList, searchparams.VectorIndex{})
+}
+
+// SearchByVectorDistanceWithParams is SearchByVectorDistance with the ef and
+// the rescoring of the user config overridden for a single query
+func (h *hnsw) SearchByVectorDistanceWithParams(vector []float32, targetDistance float32,
+	maxLimit int64, allowList helpers.AllowList, params searchparams.VectorIndex,
 ) ([]uint64, []float32, error) {
 	var (
 		searchParams = newSearchByDistParams(maxLimit)
@@ -100,7 +139,8 @@ func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32,
 	recursiveSearch := func() (bool, error) {
 		shouldContinue := false
 
-		ids, dist, err := h.SearchByVector(vector, searchParams.totalLimit, allowList)
+		ids, dist, err := h.SearchByVectorWithParams(vector, searchParams.totalLimit,
+			allowList, params)
 		if err != nil {
 			return false, errors.Wrap(err, "vector search")
 		}
@@ -158,24 +198,24 @@ func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32,
 }
 
 func (h *hnsw) shouldRescore() bool {
-	return h.compressed.Load() && !h.doNotRescore
+	return h.compressed.Load() && !h.doNotRescore.Load()
 }
 
 func (h *hnsw) searchLayerByVector(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList) (*priorityqueue.Queue, error,
 ) {
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer ssdhelpers.CompressorDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.compressor.NewCompressorDistancer(queryVector)
+		defer h.compressor.ReturnCompressorDistancer(byteDistancer)
 	}
 	return h.searchLayerByVectorWithDistancer(queryVector, entrypoints, ef, level, allowList, byteDistancer)
 }
 
 func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
-	allowList helpers.AllowList, byteDistancer *ssdhelpers.PQDistancer) (*priorityqueue.Queue, error,
+	allowList helpers.AllowList, byteDistancer ssdhelpers.CompressorDistancer) (*priorityqueue.Queue, error,
 ) {
 	h.pools.visitedListsLock.Lock()
 	visited := h.pools.visitedLists.Borrow()
@@ -185,8 +225,8 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	results := h.pools.pqResults.GetMax(ef)
 	var floatDistancer distancer.Distancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.compressor.NewCompressorDistancer(queryVector)
+		defer h.compressor.ReturnCompressorDistancer(byteDistancer)
 	} else {
 		floatDistancer = h.distancerProvider.New(queryVector)
 	}
@@ -376,7 +416,7 @@ func (h *hnsw) currentWorstResultDistanceToFloat(results *priorityqueue.Queue,
 }
 
 func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
-	distancer *ssdhelpers.PQDistancer,
+	distancer ssdhelpers.CompressorDistancer,
 ) (float32, error) {
 	if results.Len() > 0 {
 		item := results.Top()
@@ -403,7 +443,7 @@ func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
 	}
 }
 
-func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
+func (h *hnsw) distanceToByteNode(distancer ssdhelpers.CompressorDistancer,
 	nodeID uint64,
 ) (float32, bool, error) {
 	vec, err := h.compressedVectorsCache.get(context.Background(), nodeID)
@@ -420,7 +460,7 @@ func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
 	return distancer.Distance(vec)
 }
 
-func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer *ssdhelpers.PQDistancer, nodeID uint64) (float32, bool, error) {
+func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer ssdhelpers.CompressorDistancer, nodeID uint64) (float32, bool, error) {
 	slice := h.pools.tempVectors.Get(int(h.dims))
 	defer h.pools.tempVectors.Put(slice)
 	vec, err := h.TempVectorForIDThunk(context.Background(), nodeID, slice)
@@ -482,6 +522,12 @@ func (h *hnsw) handleDeletedNode(docID uint64) {
 
 func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 	ef int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.knnSearchByVectorRescore(searchVec, k, ef, h.shouldRescore(), allowList)
+}
+
+func (h *hnsw) knnSearchByVectorRescore(searchVec []float32, k int,
+	ef int, rescore bool, allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	if h.isEmpty() {
 		return nil, nil, nil
@@ -498,10 +544,10 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 			"it has been flagged for cleanup and should be fixed in the next cleanup cycle")
 	}
 
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer ssdhelpers.CompressorDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(searchVec)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.compressor.NewCompressorDistancer(searchVec)
+		defer h.compressor.ReturnCompressorDistancer(byteDistancer)
 	}
 	// stop at layer 1, not 0!
 	for level := h.currentMaximumLayer; level >= 1; level-- {
@@ -556,7 +602,7 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
 
-	if h.shouldRescore() {
+	if rescore {
 		ids := make([]uint64, res.Len())
 		i := len(ids) - 1
 		for res.Len() > 0 {
@@ -57,12 +57,26 @@ func (h *hnsw) restoreFromDisk() error {
 	beforeAll := time.Now()
 	defer h.metrics.TrackStartupTotal(beforeAll)
 
+	// a snapshot contains the state of all commit logs up to snapshotTS, so
+	// only the commit logs written afterwards need to be replayed
+	state, snapshotTS, err := loadLatestSnapshot(h.rootPath, h.id, h.logger)
+	if err != nil {
+		return errors.Wrap(err, "load snapshot")
+	}
+
 	fileNames, err := getCommitFileNames(h.rootPath, h.id)
 	if err != nil {
 		return err
 	}
 
-	if len(fileNames) == 0 {
+	if state != nil {
+		fileNames, err = commitLogsAfter(fileNames, snapshotTS)
+		if err != nil {
+			return err
+		}
+	}
+
+	if state == nil && len(fileNames) == 0 {
 		// nothing to do
 		return nil
 	}
@@ -72,7 +86,6 @@ func (h *hnsw) restoreFromDisk() error {
 		return errors.Wrap(err, "corrupted commit log fixer")
 	}
 
-	var state *DeserializationResult
 	for i, fileName := range fileNames {
 		beforeIndividual := time.Now()
 
@@ -120,6 +133,7 @@ func (h *hnsw) restoreFromDisk() error {
 	h.currentMaximumLayer = int(state.Level)
 	h.entryPointID = state.Entrypoint
 	h.tombstones = state.Tombstones
+	h.metrics.SetTombstones(len(h.tombstones))
 	h.compressed.Store(state.Compressed)
 
 	if state.Compressed {
@@ -129,14 +143,22 @@ func (h *hnsw) restoreFromDisk() error {
 		}
 		h.cache.drop()
 
-		h.pq, err = ssdhelpers.NewProductQuantizerWithEncoders(
-			h.pqConfig,
-			h.distancerProvider,
-			int(state.PQData.Dimensions),
-			state.PQData.Encoders,
-		)
-		if err != nil {
-			return errors.Wrap(err, "Restoring PQ data.")
+		if state.SQData != nil {
+			h.compressor, err = ssdhelpers.NewScalarQuantizer(h.distancerProvider, *state.SQData)
+			if err != nil {
+				return errors.Wrap(err, "Restoring SQ data.")
+			}
+		} else {
+			h.pq, err = ssdhelpers.NewProductQuantizerWithEncoders(
+				h.pqConfig,
+				h.distancerProvider,
+				int(state.PQData.Dimensions),
+				state.PQData.Encoders,
+			)
+			if err != nil {
+				return errors.Wrap(err, "Restoring PQ data.")
+			}
+			h.compressor = h.pq
 		}
 	} else {
 		// make sure the cache fits the current size
@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"unsafe"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+// Stats describes the current state of an hnsw index. It is meant for
+// capacity planning and debugging, the values are read without stopping
+// imports, so they are not guaranteed to be consistent with each other.
+type Stats struct {
+	// Nodes is the number of nodes in the graph, including tombstoned ones
+	Nodes int
+	// NodesPerLayer contains the number of nodes on each layer. A node which
+	// has been inserted at level l is part of all layers from 0 to l.
+	NodesPerLayer []int
+	Tombstones    int
+	EntryPoint    uint64
+	MaxLayer      int
+	// Compression is one of "none", "pq", "bq" or "sq"
+	Compression string
+	// CachedVectors is the number of vectors in the vector cache, which holds
+	// the compressed vectors once the index is compressed
+	CachedVectors int64
+	CacheMaxSize  int64
+	CacheHits     int64
+	CacheMisses   int64
+	// MemoryEstimate is a rough estimate in bytes of the memory used by the
+	// graph and the vector cache
+	MemoryEstimate int64
+}
+
+// cacheStats is provided by the vector caches which keep track of their hits
+// and misses
+type cacheStats struct {
+	hits           int64
+	misses         int64
+	bytesPerVector int
+}
+
+type cacheStatsProvider interface {
+	stats() cacheStats
+}
+
+// Stats returns the current stats of the index
+func (h *hnsw) Stats() Stats {
+	stats := Stats{
+		Compression: h.compressionName(),
+	}
+
+	h.RLock()
+	stats.EntryPoint = h.entryPointID
+	stats.MaxLayer = h.currentMaximumLayer
+	stats.NodesPerLayer = make([]int, h.currentMaximumLayer+1)
+	graphBytes := int64(cap(h.nodes)) * int64(unsafe.Sizeof(&vertex{}))
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+
+		stats.Nodes++
+		node.Lock()
+		for level := 0; level <= node.level && level < len(stats.NodesPerLayer); level++ {
+			stats.NodesPerLayer[level]++
+		}
+		graphBytes += int64(unsafe.Sizeof(*node))
+		for _, conns := range node.connections {
+			graphBytes += int64(unsafe.Sizeof(conns)) + int64(cap(conns))*8
+		}
+		node.Unlock()
+	}
+	h.RUnlock()
+
+	h.tombstoneLock.RLock()
+	stats.Tombstones = len(h.tombstones)
+	h.tombstoneLock.RUnlock()
+
+	var bytesPerVector int
+	if h.compressed.Load() {
+		stats.CachedVectors = h.compressedVectorsCache.countVectors()
+		stats.CacheMaxSize = h.compressedVectorsCache.copyMaxSize()
+		if provider, ok := h.compressedVectorsCache.(cacheStatsProvider); ok {
+			cs := provider.stats()
+			stats.CacheHits, stats.CacheMisses = cs.hits, cs.misses
+			bytesPerVector = cs.bytesPerVector
+		}
+	} else {
+		stats.CachedVectors = h.cache.countVectors()
+		stats.CacheMaxSize = h.cache.copyMaxSize()
+		if provider, ok := h.cache.(cacheStatsProvider); ok {
+			cs := provider.stats()
+			stats.CacheHits, stats.CacheMisses = cs.hits, cs.misses
+			bytesPerVector = cs.bytesPerVector
+		}
+	}
+
+	stats.MemoryEstimate = graphBytes + stats.CachedVectors*int64(bytesPerVector)
+	return stats
+}
+
+func (h *hnsw) compressionName() string {
+	if !h.compressed.Load() {
+		return "none"
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	switch h.compressor.(type) {
+	case *ssdhelpers.ProductQuantizer:
+		return "pq"
+	case *ssdhelpers.BinaryQuantizer:
+		return "bq"
+	case *ssdhelpers.ScalarQuantizer:
+		return "sq"
+	default:
+		return "none"
+	}
+}
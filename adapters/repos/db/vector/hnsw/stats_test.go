@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestHnswStats(t *testing.T) {
+	// the cache needs to be large enough to not be cleared during the test
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "unittest",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk:      testVectorForID,
+	}, ent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        60,
+		VectorCacheMaxObjects: 1e6,
+	}, cyclemanager.NewNoop())
+	require.Nil(t, err)
+
+	for i, vec := range testVectors {
+		err := index.Add(uint64(i), vec)
+		require.Nil(t, err)
+	}
+
+	t.Run("after the import", func(t *testing.T) {
+		stats := index.Stats()
+
+		assert.Equal(t, len(testVectors), stats.Nodes)
+		assert.Equal(t, 0, stats.Tombstones)
+		assert.Equal(t, "none", stats.Compression)
+		assert.Equal(t, index.currentMaximumLayer, stats.MaxLayer)
+		require.Len(t, stats.NodesPerLayer, stats.MaxLayer+1)
+		assert.Equal(t, len(testVectors), stats.NodesPerLayer[0])
+		assert.Equal(t, int64(len(testVectors)), stats.CachedVectors)
+		assert.Greater(t, stats.MemoryEstimate, int64(0))
+	})
+
+	t.Run("cache hits and misses are counted", func(t *testing.T) {
+		before := index.Stats()
+
+		_, err := index.cache.get(context.Background(), 0)
+		require.Nil(t, err)
+
+		after := index.Stats()
+		assert.Equal(t, before.CacheHits+1, after.CacheHits)
+		assert.Equal(t, before.CacheMisses, after.CacheMisses)
+	})
+
+	t.Run("deleted nodes are reported as tombstones", func(t *testing.T) {
+		require.Nil(t, index.Delete(1))
+
+		stats := index.Stats()
+		assert.Equal(t, 1, stats.Tombstones)
+	})
+}
@@ -29,6 +29,8 @@ type shardedLockCache struct {
 	normalizeOnRead     bool
 	maxSize             int64
 	count               int64
+	hits                int64
+	misses              int64
 	cancel              chan bool
 	logger              logrus.FieldLogger
 	dims                int32
@@ -78,6 +80,7 @@ func (s *shardedLockCache) get(ctx context.Context, id uint64) ([]float32, error
 	s.shardedLocks[id%shardFactor].RUnlock()
 
 	if vec != nil {
+		atomic.AddInt64(&s.hits, 1)
 		return vec, nil
 	}
 
@@ -98,6 +101,7 @@ func (s *shardedLockCache) delete(ctx context.Context, id uint64) {
 }
 
 func (s *shardedLockCache) handleCacheMiss(ctx context.Context, id uint64) ([]float32, error) {
+	atomic.AddInt64(&s.misses, 1)
 	vec, err := s.vectorForID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -132,6 +136,8 @@ func (s *shardedLockCache) multiGet(ctx context.Context, ids []uint64) ([][]floa
 			vecFromDisk, err := s.handleCacheMiss(ctx, id)
 			errs[i] = err
 			vec = vecFromDisk
+		} else {
+			atomic.AddInt64(&s.hits, 1)
 		}
 
 		out[i] = vec
@@ -195,6 +201,14 @@ func (s *shardedLockCache) countVectors() int64 {
 	return atomic.LoadInt64(&s.count)
 }
 
+func (s *shardedLockCache) stats() cacheStats {
+	return cacheStats{
+		hits:           atomic.LoadInt64(&s.hits),
+		misses:         atomic.LoadInt64(&s.misses),
+		bytesPerVector: int(atomic.LoadInt32(&s.dims)) * 4,
+	}
+}
+
 //nolint:unused
 func (s *shardedLockCache) drop() {
 	s.deleteAllVectors()
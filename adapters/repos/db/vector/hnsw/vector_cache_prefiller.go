@@ -38,6 +38,13 @@ type cache[T any] interface {
 	all() [][]T
 }
 
+// vectorCacheWithMultiGet is implemented by the caches of uncompressed
+// vectors, which can be read in batches
+type vectorCacheWithMultiGet interface {
+	cache[float32]
+	multiGet(ctx context.Context, ids []uint64) ([][]float32, []error)
+}
+
 func newVectorCachePrefiller[T any](cache cache[T], index *hnsw,
 	logger logrus.FieldLogger,
 ) *vectorCachePrefiller[T] {
@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/multivector"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+// IterateVectorsFn calls fn with the doc id and vector of every object which is
+// persisted in the shard
+type IterateVectorsFn func(fn func(id uint64, vector []float32) error) error
+
+// Index scores objects represented by multiple vectors with late interaction
+// (MaxSim) as introduced by ColBERT. The vector of an object is the
+// concatenation of its token vectors. As the score of an object depends on all
+// of its vectors, there is no graph to traverse, every search scores all
+// allowed objects, unless MUVERA is enabled: then the candidates are found
+// with the fixed dimensional encodings of the objects and only those are
+// scored with MaxSim. The vectors are kept in memory and are loaded from the
+// object store on startup, so the index doesn't persist anything itself.
+type Index struct {
+	sync.RWMutex
+	vectors         map[uint64][]float32
+	tokenDimensions int
+	iterateVectors  IterateVectorsFn
+	logger          logrus.FieldLogger
+	distancer       distancer.Provider
+
+	muveraConfig ent.MuveraConfig
+	muvera       *muveraEncoder // nil if MUVERA is disabled
+	encodings    map[uint64][]float32
+}
+
+func New(config ent.UserConfig, iterateVectors IterateVectorsFn,
+	logger logrus.FieldLogger,
+) *Index {
+	i := &Index{
+		vectors:         map[uint64][]float32{},
+		tokenDimensions: config.TokenDimensions,
+		iterateVectors:  iterateVectors,
+		logger:          logger,
+		distancer:       distancer.NewDotProductProvider(),
+		encodings:       map[uint64][]float32{},
+	}
+	i.setMuveraConfig(config.Muvera)
+	return i
+}
+
+// setMuveraConfig (re)creates the encoder and the encodings of all vectors.
+// It must be called with the lock held or before the index is in use.
+func (i *Index) setMuveraConfig(config ent.MuveraConfig) {
+	i.muveraConfig = config
+	i.muvera = nil
+	i.encodings = map[uint64][]float32{}
+	if !config.Enabled {
+		return
+	}
+
+	i.muvera = newMuveraEncoder(config, i.tokenDimensions)
+	for id, vector := range i.vectors {
+		i.encodings[id] = i.muvera.encodeObject(vector)
+	}
+}
+
+func (i *Index) Add(id uint64, vector []float32) error {
+	if err := i.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	// the vector is kept for the lifetime of the object, so it must not share
+	// its memory with the caller
+	i.vectors[id] = append(make([]float32, 0, len(vector)), vector...)
+	if i.muvera != nil {
+		i.encodings[id] = i.muvera.encodeObject(vector)
+	}
+	return nil
+}
+
+func (i *Index) Delete(ids ...uint64) error {
+	i.Lock()
+	defer i.Unlock()
+
+	for _, id := range ids {
+		delete(i.vectors, id)
+		delete(i.encodings, id)
+	}
+	return nil
+}
+
+func (i *Index) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	results, err := i.score(vector, k, allow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if k >= 0 && len(results) > k {
+		results = results[:k]
+	}
+	return results.split()
+}
+
+func (i *Index) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	results, err := i.score(vector, int(maxLimit), allow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for pos := range results {
+		dist := results[pos].dist
+		if dist > targetDistance &&
+			!floatcomp.InDelta(float64(dist), float64(targetDistance), 1e-6) {
+			results = results[:pos]
+			break
+		}
+	}
+
+	if maxLimit > 0 && int64(len(results)) > maxLimit {
+		results = results[:maxLimit]
+	}
+	return results.split()
+}
+
+// score returns the MaxSim distance of the allowed objects to the query,
+// ordered from the closest to the most distant object. With MUVERA enabled
+// and a positive limit, only the closest candidates by their encodings are
+// scored, otherwise all allowed objects are.
+func (i *Index) score(query []float32, limit int, allow helpers.AllowList) (scoredIDs, error) {
+	if err := i.validate(query); err != nil {
+		return nil, errors.Wrap(err, "query vector")
+	}
+
+	i.RLock()
+	defer i.RUnlock()
+
+	var candidates []uint64
+	if i.muvera != nil && limit > 0 {
+		candidates = i.muveraCandidates(query, limit, allow)
+	} else {
+		candidates = make([]uint64, 0, len(i.vectors))
+		for id := range i.vectors {
+			if allow != nil && !allow.Contains(id) {
+				continue
+			}
+			candidates = append(candidates, id)
+		}
+	}
+
+	results := make(scoredIDs, 0, len(candidates))
+	for _, id := range candidates {
+		dist, err := i.maxSimDistance(query, i.vectors[id])
+		if err != nil {
+			return nil, errors.Wrapf(err, "score doc id %d", id)
+		}
+		results = append(results, scoredID{id: id, dist: dist})
+	}
+
+	results.sort()
+	return results, nil
+}
+
+// muveraCandidates returns the allowed objects whose encodings have the
+// highest dot product with the encoding of the query. At least rescoreLimit
+// candidates are returned to make up for the approximation.
+func (i *Index) muveraCandidates(query []float32, limit int,
+	allow helpers.AllowList,
+) []uint64 {
+	if limit < i.muveraConfig.RescoreLimit {
+		limit = i.muveraConfig.RescoreLimit
+	}
+
+	encoded := i.muvera.encodeQuery(query)
+	scored := make(scoredIDs, 0, len(i.encodings))
+	for id, encoding := range i.encodings {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		scored = append(scored, scoredID{id: id, dist: -dot(encoded, encoding)})
+	}
+
+	scored.sort()
+	if len(scored) > limit {
+		scored = scored[:limit]
+	}
+
+	ids := make([]uint64, len(scored))
+	for pos := range scored {
+		ids[pos] = scored[pos].id
+	}
+	return ids
+}
+
+// maxSimDistance sums up the highest dot product of every query vector with
+// any of the object's vectors and negates it, so that lower is closer like
+// with all other distances
+func (i *Index) maxSimDistance(query, vector []float32) (float32, error) {
+	dims := i.tokenDimensions
+	var sum float32
+	for q := 0; q < len(query); q += dims {
+		best := float32(math.Inf(1))
+		for v := 0; v < len(vector); v += dims {
+			dist, _, err := i.distancer.SingleDist(query[q:q+dims], vector[v:v+dims])
+			if err != nil {
+				return 0, err
+			}
+			if dist < best {
+				best = dist
+			}
+		}
+		sum += best
+	}
+	return sum, nil
+}
+
+func (i *Index) validate(vector []float32) error {
+	if len(vector) == 0 || len(vector)%i.tokenDimensions != 0 {
+		return fmt.Errorf("vector has a length of %d, which is not a multiple of "+
+			"the %d tokenDimensions of the vector index", len(vector), i.tokenDimensions)
+	}
+	return nil
+}
+
+func (i *Index) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	callback()
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return fmt.Errorf("unrecognized vector index config: %T", updated)
+	}
+
+	// the tokenDimensions can't change without reimporting all objects, but the
+	// encodings can be recreated from the vectors in memory
+	i.Lock()
+	defer i.Unlock()
+
+	if parsed.Muvera != i.muveraConfig {
+		i.setMuveraConfig(parsed.Muvera)
+	}
+	return nil
+}
+
+// ValidateUserConfigUpdate rejects changes to the settings which determine
+// how the already imported vectors are interpreted
+func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
+	initialParsed, ok := initial.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	if initialParsed.TokenDimensions != updatedParsed.TokenDimensions {
+		return errors.Errorf("tokenDimensions is immutable: attempted change from \"%d\" to \"%d\"",
+			initialParsed.TokenDimensions, updatedParsed.TokenDimensions)
+	}
+
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from \"%s\" to \"%s\"",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+
+	return nil
+}
+
+func (i *Index) Drop(context.Context) error {
+	i.Lock()
+	defer i.Unlock()
+
+	i.vectors = map[uint64][]float32{}
+	i.encodings = map[uint64][]float32{}
+	return nil
+}
+
+func (i *Index) Flush() error {
+	return nil
+}
+
+func (i *Index) Shutdown(context.Context) error {
+	return nil
+}
+
+func (i *Index) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (i *Index) ListFiles(context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (i *Index) ValidateBeforeInsert(vector []float32) error {
+	if err := i.validate(vector); err != nil {
+		return err
+	}
+
+	// the vector of an object is stored with its length as uint16
+	if len(vector) > math.MaxUint16 {
+		return fmt.Errorf("vector has a length of %d, but the vectors of an object "+
+			"can have at most %d values in total", len(vector), math.MaxUint16)
+	}
+	return nil
+}
+
+// PostStartup loads the vectors of all objects which are already persisted
+func (i *Index) PostStartup() {
+	if i.iterateVectors == nil {
+		return
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	err := i.iterateVectors(func(id uint64, vector []float32) error {
+		if len(vector) > 0 {
+			i.vectors[id] = vector
+			if i.muvera != nil {
+				i.encodings[id] = i.muvera.encodeObject(vector)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		i.logger.WithField("action", "multivector_startup").WithError(err).
+			Error("failed to load vectors into multivector index")
+	}
+}
+
+func (i *Index) Dump(labels ...string) {
+	i.RLock()
+	defer i.RUnlock()
+
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("Token Dimensions: %d\n", i.tokenDimensions)
+	fmt.Printf("Objects: %d\n", len(i.vectors))
+	fmt.Printf("MUVERA: %t\n", i.muvera != nil)
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+type scoredID struct {
+	id   uint64
+	dist float32
+}
+
+type scoredIDs []scoredID
+
+// sort orders from the closest to the most distant object, ties are broken by
+// the doc id to keep results stable
+func (s scoredIDs) sort() {
+	sort.Slice(s, func(a, b int) bool {
+		if s[a].dist == s[b].dist {
+			return s[a].id < s[b].id
+		}
+		return s[a].dist < s[b].dist
+	})
+}
+
+func (s scoredIDs) split() ([]uint64, []float32, error) {
+	ids := make([]uint64, len(s))
+	dists := make([]float32, len(s))
+	for i := range s {
+		ids[i] = s[i].id
+		dists[i] = s[i].dist
+	}
+	return ids, dists, nil
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/multivector"
+)
+
+func testIndex(t *testing.T) *Index {
+	logger, _ := test.NewNullLogger()
+	index := New(ent.UserConfig{
+		Distance:        ent.DistanceMaxSim,
+		TokenDimensions: 2,
+	}, nil, logger)
+
+	require.Nil(t, index.Add(1, []float32{1, 0, 0, 1}))
+	require.Nil(t, index.Add(2, []float32{1, 0}))
+	require.Nil(t, index.Add(3, []float32{0, 1, 0, 1, 0, 1}))
+	return index
+}
+
+func TestIndex_SearchByVector(t *testing.T) {
+	t.Run("with multiple query vectors", func(t *testing.T) {
+		index := testIndex(t)
+
+		ids, dists, err := index.SearchByVector([]float32{1, 0, 0, 1}, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2, 3}, ids)
+		assert.Equal(t, []float32{-2, -1, -1}, dists)
+	})
+
+	t.Run("with a single query vector", func(t *testing.T) {
+		index := testIndex(t)
+
+		ids, dists, err := index.SearchByVector([]float32{0, 1}, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 3, 2}, ids)
+		assert.Equal(t, []float32{-1, -1, 0}, dists)
+	})
+
+	t.Run("with a limit", func(t *testing.T) {
+		index := testIndex(t)
+
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0, 1}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+	})
+
+	t.Run("with an allow list", func(t *testing.T) {
+		index := testIndex(t)
+
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0, 1}, 10,
+			helpers.NewAllowList(2, 3))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 3}, ids)
+	})
+
+	t.Run("after deleting an object", func(t *testing.T) {
+		index := testIndex(t)
+		require.Nil(t, index.Delete(1))
+
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0, 1}, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 3}, ids)
+	})
+
+	t.Run("with a query vector of the wrong length", func(t *testing.T) {
+		index := testIndex(t)
+
+		_, _, err := index.SearchByVector([]float32{1, 0, 0}, 10, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not a multiple of the 2 tokenDimensions")
+	})
+}
+
+func TestIndex_SearchByVectorDistance(t *testing.T) {
+	index := testIndex(t)
+
+	ids, dists, err := index.SearchByVectorDistance([]float32{1, 0, 0, 1}, -1.5, -1, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1}, ids)
+	assert.Equal(t, []float32{-2}, dists)
+
+	ids, _, err = index.SearchByVectorDistance([]float32{1, 0, 0, 1}, -1, 2, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2}, ids)
+}
+
+func TestIndex_ValidateBeforeInsert(t *testing.T) {
+	index := testIndex(t)
+
+	assert.Nil(t, index.ValidateBeforeInsert([]float32{1, 2, 3, 4}))
+	assert.NotNil(t, index.ValidateBeforeInsert([]float32{1, 2, 3}))
+	assert.NotNil(t, index.ValidateBeforeInsert(make([]float32, 65536)))
+	assert.NotNil(t, index.Add(4, []float32{1}))
+}
+
+func TestIndex_PostStartup(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	persisted := map[uint64][]float32{
+		7: {1, 0},
+		8: {0, 1, 1, 0},
+		9: nil,
+	}
+	index := New(ent.NewDefaultUserConfig(), func(fn func(uint64, []float32) error) error {
+		for id, vector := range persisted {
+			if err := fn(id, vector); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, logger)
+	index.tokenDimensions = 2
+
+	index.PostStartup()
+
+	ids, _, err := index.SearchByVector([]float32{1, 0}, 10, nil)
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []uint64{7, 8}, ids)
+
+	require.Nil(t, index.Drop(context.Background()))
+	ids, _, err = index.SearchByVector([]float32{1, 0}, 10, nil)
+	require.Nil(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestValidateUserConfigUpdate(t *testing.T) {
+	initial := ent.UserConfig{Distance: ent.DistanceMaxSim, TokenDimensions: 128}
+
+	t.Run("with unchanged config", func(t *testing.T) {
+		assert.Nil(t, ValidateUserConfigUpdate(initial, initial))
+	})
+
+	t.Run("with changed tokenDimensions", func(t *testing.T) {
+		updated := ent.UserConfig{Distance: ent.DistanceMaxSim, TokenDimensions: 96}
+
+		err := ValidateUserConfigUpdate(initial, updated)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "tokenDimensions is immutable")
+	})
+}
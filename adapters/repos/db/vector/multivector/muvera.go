@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+
+	ent "github.com/weaviate/weaviate/entities/vectorindex/multivector"
+)
+
+// muveraSeed makes the random hyperplanes and projections deterministic, so
+// that encodings stay comparable across restarts and nodes
+const muveraSeed = 42
+
+// muveraEncoder creates the fixed dimensional encodings (FDE) of MUVERA. In
+// each repetition, the token space is partitioned into 2^ksim buckets by the
+// signs of ksim random hyperplanes and the token vectors of each bucket are
+// aggregated after a random projection to dprojections dimensions. The dot
+// product of a query and an object encoding approximates their MaxSim
+// similarity.
+type muveraEncoder struct {
+	config          ent.MuveraConfig
+	tokenDimensions int
+	// hyperplanes holds ksim hyperplanes of tokenDimensions values per
+	// repetition
+	hyperplanes [][]float32
+	// projections holds dprojections rows of tokenDimensions random signs per
+	// repetition
+	projections [][]float32
+}
+
+func newMuveraEncoder(config ent.MuveraConfig, tokenDimensions int) *muveraEncoder {
+	rng := rand.New(rand.NewSource(muveraSeed))
+	scale := float32(1 / math.Sqrt(float64(config.DProjections)))
+
+	e := &muveraEncoder{
+		config:          config,
+		tokenDimensions: tokenDimensions,
+		hyperplanes:     make([][]float32, config.Repetitions),
+		projections:     make([][]float32, config.Repetitions),
+	}
+	for r := 0; r < config.Repetitions; r++ {
+		e.hyperplanes[r] = make([]float32, config.KSim*tokenDimensions)
+		for j := range e.hyperplanes[r] {
+			e.hyperplanes[r][j] = float32(rng.NormFloat64())
+		}
+
+		e.projections[r] = make([]float32, config.DProjections*tokenDimensions)
+		for j := range e.projections[r] {
+			if rng.Intn(2) == 0 {
+				e.projections[r][j] = scale
+			} else {
+				e.projections[r][j] = -scale
+			}
+		}
+	}
+
+	return e
+}
+
+// encodeObject averages the projected token vectors of each bucket. Empty
+// buckets are filled with the token closest to the bucket, so that every
+// query token finds a counterpart like it does with MaxSim.
+func (e *muveraEncoder) encodeObject(vector []float32) []float32 {
+	return e.encode(vector, false)
+}
+
+// encodeQuery sums up the projected token vectors of each bucket
+func (e *muveraEncoder) encodeQuery(vector []float32) []float32 {
+	return e.encode(vector, true)
+}
+
+func (e *muveraEncoder) encode(vector []float32, query bool) []float32 {
+	dims := e.tokenDimensions
+	dproj := e.config.DProjections
+	buckets := 1 << e.config.KSim
+	tokens := len(vector) / dims
+
+	out := make([]float32, e.config.Dimensions())
+	tokenBuckets := make([]int, tokens)
+	counts := make([]int, buckets)
+
+	for r := 0; r < e.config.Repetitions; r++ {
+		block := out[r*buckets*dproj : (r+1)*buckets*dproj]
+		for b := range counts {
+			counts[b] = 0
+		}
+
+		for t := 0; t < tokens; t++ {
+			token := vector[t*dims : (t+1)*dims]
+			b := e.bucket(r, token)
+			tokenBuckets[t] = b
+			counts[b]++
+			e.project(r, token, block[b*dproj:(b+1)*dproj])
+		}
+
+		if query {
+			continue
+		}
+
+		for b, count := range counts {
+			target := block[b*dproj : (b+1)*dproj]
+			switch {
+			case count == 0 && tokens > 0:
+				t := closestToken(tokenBuckets, b)
+				e.project(r, vector[t*dims:(t+1)*dims], target)
+			case count > 1:
+				for j := range target {
+					target[j] /= float32(count)
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// bucket returns the bucket of a token within a repetition, each bit is the
+// side of one hyperplane the token is on
+func (e *muveraEncoder) bucket(repetition int, token []float32) int {
+	dims := e.tokenDimensions
+	hyperplanes := e.hyperplanes[repetition]
+
+	bucket := 0
+	for k := 0; k < e.config.KSim; k++ {
+		if dot(hyperplanes[k*dims:(k+1)*dims], token) > 0 {
+			bucket |= 1 << k
+		}
+	}
+	return bucket
+}
+
+// project adds the random projection of the token to target
+func (e *muveraEncoder) project(repetition int, token, target []float32) {
+	dims := e.tokenDimensions
+	projections := e.projections[repetition]
+
+	for j := range target {
+		target[j] += dot(projections[j*dims:(j+1)*dims], token)
+	}
+}
+
+// closestToken returns the token whose bucket differs from the given bucket
+// in the fewest hyperplanes
+func closestToken(tokenBuckets []int, bucket int) int {
+	closest, closestDist := 0, math.MaxInt
+	for t, b := range tokenBuckets {
+		if dist := bits.OnesCount(uint(b ^ bucket)); dist < closestDist {
+			closest, closestDist = t, dist
+		}
+	}
+	return closest
+}
+
+func dot(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/multivector"
+)
+
+func testMuveraConfig() ent.MuveraConfig {
+	return ent.MuveraConfig{
+		Enabled:      true,
+		KSim:         3,
+		DProjections: 4,
+		Repetitions:  5,
+		RescoreLimit: 2,
+	}
+}
+
+func TestMuveraEncoder(t *testing.T) {
+	config := testMuveraConfig()
+
+	t.Run("encodings have fixed dimensions", func(t *testing.T) {
+		e := newMuveraEncoder(config, 2)
+
+		assert.Len(t, e.encodeObject([]float32{1, 0}), 5*8*4)
+		assert.Len(t, e.encodeObject([]float32{1, 0, 0, 1, 1, 1}), 5*8*4)
+		assert.Len(t, e.encodeQuery([]float32{0, 1, 1, 0}), 5*8*4)
+	})
+
+	t.Run("encodings are deterministic", func(t *testing.T) {
+		vector := []float32{0.3, -0.2, 0.9, 0.1}
+
+		assert.Equal(t, newMuveraEncoder(config, 2).encodeObject(vector),
+			newMuveraEncoder(config, 2).encodeObject(vector))
+	})
+
+	t.Run("object encodings fill every bucket", func(t *testing.T) {
+		e := newMuveraEncoder(config, 2)
+
+		encoded := e.encodeObject([]float32{1, 0})
+		for b := 0; b < len(encoded); b += config.DProjections {
+			assert.NotEqual(t, make([]float32, config.DProjections),
+				encoded[b:b+config.DProjections], "bucket at offset %d is empty", b)
+		}
+	})
+
+	t.Run("encodings approximate the MaxSim similarity", func(t *testing.T) {
+		dims := 16
+		e := newMuveraEncoder(ent.MuveraConfig{
+			Enabled:      true,
+			KSim:         4,
+			DProjections: 16,
+			Repetitions:  10,
+		}, dims)
+		rng := rand.New(rand.NewSource(7))
+		randomVector := func(tokens int) []float32 {
+			vector := make([]float32, tokens*dims)
+			for i := range vector {
+				vector[i] = float32(rng.NormFloat64())
+			}
+			return vector
+		}
+
+		query := randomVector(4)
+		// the object containing the query tokens must be closer than a random one
+		similar := append(append([]float32{}, query...), randomVector(8)...)
+		random := randomVector(12)
+
+		encodedQuery := e.encodeQuery(query)
+		assert.Greater(t, dot(encodedQuery, e.encodeObject(similar)),
+			dot(encodedQuery, e.encodeObject(random)))
+	})
+}
+
+func TestIndex_WithMuvera(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	newIndex := func(muvera ent.MuveraConfig) *Index {
+		index := New(ent.UserConfig{
+			Distance:        ent.DistanceMaxSim,
+			TokenDimensions: 2,
+			Muvera:          muvera,
+		}, nil, logger)
+
+		require.Nil(t, index.Add(1, []float32{1, 0, 0, 1}))
+		require.Nil(t, index.Add(2, []float32{1, 0}))
+		require.Nil(t, index.Add(3, []float32{0, 1, 0, 1, 0, 1}))
+		return index
+	}
+
+	t.Run("rescores the candidates with MaxSim", func(t *testing.T) {
+		index := newIndex(testMuveraConfig())
+
+		ids, dists, err := index.SearchByVector([]float32{1, 0, 0, 1}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+		assert.Equal(t, []float32{-2}, dists)
+	})
+
+	t.Run("with an allow list", func(t *testing.T) {
+		index := newIndex(testMuveraConfig())
+
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0, 1}, 10,
+			helpers.NewAllowList(2, 3))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 3}, ids)
+	})
+
+	t.Run("after deleting an object", func(t *testing.T) {
+		index := newIndex(testMuveraConfig())
+		require.Nil(t, index.Delete(1))
+
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0, 1}, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 3}, ids)
+		assert.Len(t, index.encodings, 2)
+	})
+
+	t.Run("enabling MUVERA on an existing index", func(t *testing.T) {
+		index := newIndex(ent.MuveraConfig{})
+		assert.Empty(t, index.encodings)
+
+		err := index.UpdateUserConfig(ent.UserConfig{
+			Distance:        ent.DistanceMaxSim,
+			TokenDimensions: 2,
+			Muvera:          testMuveraConfig(),
+		}, func() {})
+		require.Nil(t, err)
+		assert.Len(t, index.encodings, 3)
+
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0, 1}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+	})
+}
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// BinaryQuantizer keeps one bit per dimension, set if the value is positive.
+// Compressed vectors are compared by their hamming distance, which is only
+// meant to generate candidates that are then rescored with the full-precision
+// vectors.
+type BinaryQuantizer struct {
+	distance   distancer.Provider
+	dimensions int32
+}
+
+func NewBinaryQuantizer(distance distancer.Provider) *BinaryQuantizer {
+	return &BinaryQuantizer{distance: distance}
+}
+
+func (bq *BinaryQuantizer) Encode(vec []float32) []byte {
+	atomic.CompareAndSwapInt32(&bq.dimensions, 0, int32(len(vec)))
+
+	code := make([]byte, (len(vec)+7)/8)
+	for i, v := range vec {
+		if v > 0 {
+			code[i/8] |= 1 << (i % 8)
+		}
+	}
+	return code
+}
+
+// Decode restores a vector of +1/-1 values, which preserves the direction of
+// the original vector per dimension, but not its magnitude
+func (bq *BinaryQuantizer) Decode(code []byte) []float32 {
+	dims := int(atomic.LoadInt32(&bq.dimensions))
+	if dims == 0 {
+		dims = len(code) * 8
+	}
+
+	vec := make([]float32, dims)
+	for i := range vec {
+		if code[i/8]&(1<<(i%8)) != 0 {
+			vec[i] = 1
+		} else {
+			vec[i] = -1
+		}
+	}
+	return vec
+}
+
+func (bq *BinaryQuantizer) DistanceBetweenCompressedVectors(x, y []byte) float32 {
+	return float32(hammingDistance(x, y))
+}
+
+func (bq *BinaryQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32 {
+	return bq.DistanceBetweenCompressedVectors(bq.Encode(x), encoded)
+}
+
+type BQDistancer struct {
+	x    []float32
+	code []byte
+	bq   *BinaryQuantizer
+}
+
+func (bq *BinaryQuantizer) NewCompressorDistancer(vec []float32) CompressorDistancer {
+	return &BQDistancer{
+		x:    vec,
+		code: bq.Encode(vec),
+		bq:   bq,
+	}
+}
+
+func (bq *BinaryQuantizer) ReturnCompressorDistancer(d CompressorDistancer) {}
+
+func (d *BQDistancer) Distance(x []byte) (float32, bool, error) {
+	return d.bq.DistanceBetweenCompressedVectors(d.code, x), true, nil
+}
+
+func (d *BQDistancer) DistanceToFloat(x []float32) (float32, bool, error) {
+	return d.bq.distance.SingleDist(d.x, x)
+}
+
+func hammingDistance(x, y []byte) int {
+	n := len(x)
+	if len(y) < n {
+		n = len(y)
+	}
+
+	dist := 0
+	i := 0
+	for ; i+8 <= n; i += 8 {
+		dist += bits.OnesCount64(binary.LittleEndian.Uint64(x[i:]) ^
+			binary.LittleEndian.Uint64(y[i:]))
+	}
+	for ; i < n; i++ {
+		dist += bits.OnesCount8(x[i] ^ y[i])
+	}
+	return dist
+}
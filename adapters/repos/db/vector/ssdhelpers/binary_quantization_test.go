@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+func TestBinaryQuantizer(t *testing.T) {
+	bq := ssdhelpers.NewBinaryQuantizer(distancer.NewCosineDistanceProvider())
+
+	vec1 := []float32{0.1, -0.2, 0.3, -0.4, 0.5, -0.6, 0.7, -0.8, 0.9, -1.0}
+	vec2 := []float32{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1.0}
+
+	t.Run("encoding uses one bit per dimension", func(t *testing.T) {
+		code := bq.Encode(vec1)
+		require.Len(t, code, 2)
+		assert.Equal(t, []byte{0b01010101, 0b00000001}, code)
+	})
+
+	t.Run("decoding restores the signs", func(t *testing.T) {
+		decoded := bq.Decode(bq.Encode(vec1))
+		assert.Equal(t, []float32{1, -1, 1, -1, 1, -1, 1, -1, 1, -1}, decoded)
+	})
+
+	t.Run("compressed distances are hamming distances", func(t *testing.T) {
+		assert.Equal(t, float32(0), bq.DistanceBetweenCompressedVectors(bq.Encode(vec1), bq.Encode(vec1)))
+		assert.Equal(t, float32(5), bq.DistanceBetweenCompressedVectors(bq.Encode(vec1), bq.Encode(vec2)))
+		assert.Equal(t, float32(5), bq.DistanceBetweenCompressedAndUncompressedVectors(vec1, bq.Encode(vec2)))
+	})
+
+	t.Run("distancer rescores with full precision", func(t *testing.T) {
+		d := bq.NewCompressorDistancer(vec1)
+		defer bq.ReturnCompressorDistancer(d)
+
+		dist, ok, err := d.Distance(bq.Encode(vec2))
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, float32(5), dist)
+
+		expected, _, err := distancer.NewCosineDistanceProvider().SingleDist(vec1, vec2)
+		require.Nil(t, err)
+		dist, ok, err = d.DistanceToFloat(vec2)
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, expected, dist)
+	})
+}
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+// Compressor is implemented by all quantizers which the hnsw index can use to
+// keep compressed instead of full-precision vectors in memory
+type Compressor interface {
+	Encode(vec []float32) []byte
+	Decode(code []byte) []float32
+	DistanceBetweenCompressedVectors(x, y []byte) float32
+	DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32
+	NewCompressorDistancer(vec []float32) CompressorDistancer
+	ReturnCompressorDistancer(d CompressorDistancer)
+}
+
+// CompressorDistancer calculates distances from a fixed query vector, either
+// to compressed codes or, for rescoring, to full-precision vectors
+type CompressorDistancer interface {
+	Distance(x []byte) (float32, bool, error)
+	DistanceToFloat(x []float32) (float32, bool, error)
+}
+
+func (pq *ProductQuantizer) NewCompressorDistancer(vec []float32) CompressorDistancer {
+	return pq.NewDistancer(vec)
+}
+
+func (pq *ProductQuantizer) ReturnCompressorDistancer(d CompressorDistancer) {
+	pqd, ok := d.(*PQDistancer)
+	if !ok {
+		return
+	}
+	pq.ReturnDistancer(pqd)
+}
+
+var (
+	_ = Compressor(&ProductQuantizer{})
+	_ = Compressor(&BinaryQuantizer{})
+	_ = Compressor(&ScalarQuantizer{})
+)
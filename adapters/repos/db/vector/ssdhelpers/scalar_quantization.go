@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+const sqCodeMax = math.MaxUint8
+
+// ScalarQuantizer maps every dimension linearly from its learnt [min, max]
+// range onto a single byte
+type ScalarQuantizer struct {
+	distance distancer.Provider
+	min      []float32
+	max      []float32
+	scale    []float32
+}
+
+type SQData struct {
+	Dimensions uint16
+	Min        []float32
+	Max        []float32
+}
+
+func NewScalarQuantizer(distance distancer.Provider, data SQData) (*ScalarQuantizer, error) {
+	if int(data.Dimensions) != len(data.Min) || int(data.Dimensions) != len(data.Max) {
+		return nil, errors.New("sq bounds do not match the dimensions")
+	}
+
+	sq := &ScalarQuantizer{
+		distance: distance,
+		min:      data.Min,
+		max:      data.Max,
+		scale:    make([]float32, data.Dimensions),
+	}
+	for i := range sq.scale {
+		sq.scale[i] = (data.Max[i] - data.Min[i]) / sqCodeMax
+	}
+
+	return sq, nil
+}
+
+func (sq *ScalarQuantizer) ExposeFields() SQData {
+	return SQData{
+		Dimensions: uint16(len(sq.min)),
+		Min:        sq.min,
+		Max:        sq.max,
+	}
+}
+
+func (sq *ScalarQuantizer) Encode(vec []float32) []byte {
+	code := make([]byte, len(sq.min))
+	for i := range code {
+		if i >= len(vec) || sq.scale[i] == 0 {
+			continue
+		}
+		v := (vec[i] - sq.min[i]) / sq.scale[i]
+		switch {
+		case v <= 0:
+			code[i] = 0
+		case v >= sqCodeMax:
+			code[i] = sqCodeMax
+		default:
+			code[i] = byte(math.Round(float64(v)))
+		}
+	}
+	return code
+}
+
+func (sq *ScalarQuantizer) Decode(code []byte) []float32 {
+	vec := make([]float32, len(code))
+	for i, c := range code {
+		vec[i] = sq.min[i] + float32(c)*sq.scale[i]
+	}
+	return vec
+}
+
+func (sq *ScalarQuantizer) DistanceBetweenCompressedVectors(x, y []byte) float32 {
+	return sq.distance.Wrap(sq.distance.Step(sq.Decode(x), sq.Decode(y)))
+}
+
+func (sq *ScalarQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32 {
+	return sq.distance.Wrap(sq.distance.Step(x, sq.Decode(encoded)))
+}
+
+type SQDistancer struct {
+	x  []float32
+	sq *ScalarQuantizer
+}
+
+func (sq *ScalarQuantizer) NewCompressorDistancer(vec []float32) CompressorDistancer {
+	return &SQDistancer{x: vec, sq: sq}
+}
+
+func (sq *ScalarQuantizer) ReturnCompressorDistancer(d CompressorDistancer) {}
+
+func (d *SQDistancer) Distance(x []byte) (float32, bool, error) {
+	return d.sq.DistanceBetweenCompressedAndUncompressedVectors(d.x, x), true, nil
+}
+
+func (d *SQDistancer) DistanceToFloat(x []float32) (float32, bool, error) {
+	return d.sq.distance.SingleDist(d.x, x)
+}
+
+// SQTrainer learns the per-dimension bounds of the vectors it is shown, so
+// that a ScalarQuantizer can be trained online while vectors are imported
+type SQTrainer struct {
+	sync.Mutex
+	min   []float32
+	max   []float32
+	count int
+}
+
+func NewSQTrainer() *SQTrainer {
+	return &SQTrainer{}
+}
+
+// Add extends the bounds by the given vector and returns the number of
+// vectors seen so far
+func (t *SQTrainer) Add(vec []float32) int {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.min == nil {
+		t.min = make([]float32, len(vec))
+		t.max = make([]float32, len(vec))
+		copy(t.min, vec)
+		copy(t.max, vec)
+	}
+
+	for i := range t.min {
+		if i >= len(vec) {
+			break
+		}
+		if vec[i] < t.min[i] {
+			t.min[i] = vec[i]
+		}
+		if vec[i] > t.max[i] {
+			t.max[i] = vec[i]
+		}
+	}
+
+	t.count++
+	return t.count
+}
+
+func (t *SQTrainer) Quantizer(distance distancer.Provider) (*ScalarQuantizer, error) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.count == 0 {
+		return nil, errors.New("cannot train sq without any vectors")
+	}
+
+	lower := make([]float32, len(t.min))
+	upper := make([]float32, len(t.max))
+	copy(lower, t.min)
+	copy(upper, t.max)
+
+	return NewScalarQuantizer(distance, SQData{
+		Dimensions: uint16(len(lower)),
+		Min:        lower,
+		Max:        upper,
+	})
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+func TestScalarQuantizer(t *testing.T) {
+	trainer := ssdhelpers.NewSQTrainer()
+	assert.Equal(t, 1, trainer.Add([]float32{0, -1, 10}))
+	assert.Equal(t, 2, trainer.Add([]float32{2.55, 1, 10}))
+
+	sq, err := trainer.Quantizer(distancer.NewL2SquaredProvider())
+	require.Nil(t, err)
+
+	t.Run("bounds are learnt per dimension", func(t *testing.T) {
+		data := sq.ExposeFields()
+		assert.Equal(t, uint16(3), data.Dimensions)
+		assert.Equal(t, []float32{0, -1, 10}, data.Min)
+		assert.Equal(t, []float32{2.55, 1, 10}, data.Max)
+	})
+
+	t.Run("encoding uses one byte per dimension", func(t *testing.T) {
+		assert.Equal(t, []byte{0, 0, 0}, sq.Encode([]float32{0, -1, 10}))
+		assert.Equal(t, []byte{255, 255, 0}, sq.Encode([]float32{2.55, 1, 10}))
+		assert.Equal(t, []byte{100, 128, 0}, sq.Encode([]float32{1, 0.004, 10}))
+	})
+
+	t.Run("values outside of the bounds are clamped", func(t *testing.T) {
+		assert.Equal(t, []byte{0, 255, 0}, sq.Encode([]float32{-5, 7, 12}))
+	})
+
+	t.Run("decoding is close to the original", func(t *testing.T) {
+		vec := []float32{1.3, -0.5, 10}
+		decoded := sq.Decode(sq.Encode(vec))
+		require.Len(t, decoded, 3)
+		for i := range vec {
+			assert.InDelta(t, vec[i], decoded[i], 0.01)
+		}
+	})
+
+	t.Run("distances are close to full precision", func(t *testing.T) {
+		x := []float32{0.5, 0.5, 10}
+		y := []float32{2, -0.5, 10}
+
+		expected, _, err := distancer.NewL2SquaredProvider().SingleDist(x, y)
+		require.Nil(t, err)
+
+		assert.InDelta(t, expected, sq.DistanceBetweenCompressedVectors(sq.Encode(x), sq.Encode(y)), 0.05)
+
+		d := sq.NewCompressorDistancer(x)
+		defer sq.ReturnCompressorDistancer(d)
+		dist, ok, err := d.Distance(sq.Encode(y))
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.InDelta(t, expected, dist, 0.05)
+
+		dist, _, err = d.DistanceToFloat(y)
+		require.Nil(t, err)
+		assert.Equal(t, expected, dist)
+	})
+
+	t.Run("restoring from the exposed fields", func(t *testing.T) {
+		restored, err := ssdhelpers.NewScalarQuantizer(distancer.NewL2SquaredProvider(), sq.ExposeFields())
+		require.Nil(t, err)
+		assert.Equal(t, sq.Encode([]float32{1, 0, 10}), restored.Encode([]float32{1, 0, 10}))
+	})
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
 // VectorIndex is anything that indexes vectors efficiently. For an example
@@ -36,3 +37,33 @@ type VectorIndex interface {
 	PostStartup()
 	ValidateBeforeInsert(vector []float32) error
 }
+
+// paramsSearcher is implemented by vector indexes which accept search params
+// that override their user config for a single query
+type paramsSearcher interface {
+	SearchByVectorWithParams(vector []float32, k int, allow helpers.AllowList,
+		params searchparams.VectorIndex) ([]uint64, []float32, error)
+	SearchByVectorDistanceWithParams(vector []float32, dist float32, maxLimit int64,
+		allow helpers.AllowList, params searchparams.VectorIndex) ([]uint64, []float32, error)
+}
+
+// searchByVector searches the vector index with the search params of the
+// query. They are ignored by vector indexes which don't support them.
+func searchByVector(vi VectorIndex, vector []float32, k int,
+	allow helpers.AllowList, params *searchparams.VectorIndex,
+) ([]uint64, []float32, error) {
+	if searcher, ok := vi.(paramsSearcher); ok && params != nil {
+		return searcher.SearchByVectorWithParams(vector, k, allow, *params)
+	}
+	return vi.SearchByVector(vector, k, allow)
+}
+
+func searchByVectorDistance(vi VectorIndex, vector []float32, dist float32,
+	maxLimit int64, allow helpers.AllowList, params *searchparams.VectorIndex,
+) ([]uint64, []float32, error) {
+	if searcher, ok := vi.(paramsSearcher); ok && params != nil {
+		return searcher.SearchByVectorDistanceWithParams(vector, dist, maxLimit,
+			allow, *params)
+	}
+	return vi.SearchByVectorDistance(vector, dist, maxLimit, allow)
+}
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// postFilterMinSelectivity is the share of the objects of a shard a filter
+// has to match for a vector search to be post-filtered. Such a filter barely
+// restricts the search, so it is cheaper to search without the allow list and
+// to drop the few results it excludes afterwards.
+const postFilterMinSelectivity = 0.9
+
+// filterSelectivity is the share of the objects of a shard matched by the
+// allow list. The allow list holds the exact postings the filter planner
+// fetched for the filter, objectCount is the number of live objects.
+func filterSelectivity(allow helpers.AllowList, objectCount uint64) float64 {
+	if allow == nil || objectCount == 0 {
+		return 1
+	}
+	return math.Min(1, float64(allow.Len())/float64(objectCount))
+}
+
+// searchByVectorFiltered searches the vector index for the k closest vectors
+// matching the allow list. Restrictive filters are applied while searching
+// (pre-filtering), permissive ones afterwards (post-filtering).
+func searchByVectorFiltered(vi VectorIndex, vector []float32, k int,
+	allow helpers.AllowList, selectivity float64, params *searchparams.VectorIndex,
+) ([]uint64, []float32, error) {
+	if allow == nil || k <= 0 || selectivity < postFilterMinSelectivity {
+		return searchByVector(vi, vector, k, allow, params)
+	}
+
+	// widen the search by the share of objects the filter is expected to
+	// exclude, so that k results remain after dropping them
+	wideK := int(math.Ceil(float64(k) / selectivity))
+	ids, dists, err := searchByVector(vi, vector, wideK, nil, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allowedIDs := make([]uint64, 0, k)
+	allowedDists := make([]float32, 0, k)
+	for i, id := range ids {
+		if !allow.Contains(id) {
+			continue
+		}
+		allowedIDs = append(allowedIDs, id)
+		allowedDists = append(allowedDists, dists[i])
+		if len(allowedIDs) == k {
+			return allowedIDs, allowedDists, nil
+		}
+	}
+
+	if len(ids) < wideK {
+		// the index holds no further vectors, all matches were found
+		return allowedIDs, allowedDists, nil
+	}
+
+	// the filter excluded more results than estimated, fall back to
+	// pre-filtering to not return fewer than k results
+	return searchByVector(vi, vector, k, allow, params)
+}
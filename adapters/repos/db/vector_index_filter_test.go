@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+)
+
+// fakeOrderedIndex holds the ids 0 to size-1, each id being its own distance
+// to any search vector. It records whether searches were pre-filtered.
+type fakeOrderedIndex struct {
+	*noop.Index
+
+	size     uint64
+	searches []bool
+}
+
+func (f *fakeOrderedIndex) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	f.searches = append(f.searches, allow != nil)
+
+	var ids []uint64
+	var dists []float32
+	for id := uint64(0); id < f.size && len(ids) < k; id++ {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		ids = append(ids, id)
+		dists = append(dists, float32(id))
+	}
+	return ids, dists, nil
+}
+
+func allowAllBut(size uint64, excluded ...uint64) helpers.AllowList {
+	isExcluded := map[uint64]bool{}
+	for _, id := range excluded {
+		isExcluded[id] = true
+	}
+	var ids []uint64
+	for id := uint64(0); id < size; id++ {
+		if !isExcluded[id] {
+			ids = append(ids, id)
+		}
+	}
+	return helpers.NewAllowList(ids...)
+}
+
+func TestSearchByVectorFiltered(t *testing.T) {
+	t.Run("restrictive filter is applied while searching", func(t *testing.T) {
+		vi := &fakeOrderedIndex{Index: noop.NewIndex(), size: 100}
+		allow := helpers.NewAllowList(5, 50, 95)
+
+		ids, dists, err := searchByVectorFiltered(vi, []float32{1}, 10, allow,
+			filterSelectivity(allow, vi.size), nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, []uint64{5, 50, 95}, ids)
+		assert.Equal(t, []float32{5, 50, 95}, dists)
+		assert.Equal(t, []bool{true}, vi.searches)
+	})
+
+	t.Run("permissive filter is applied after searching", func(t *testing.T) {
+		vi := &fakeOrderedIndex{Index: noop.NewIndex(), size: 100}
+		allow := allowAllBut(100, 3)
+
+		ids, dists, err := searchByVectorFiltered(vi, []float32{1}, 5, allow,
+			filterSelectivity(allow, vi.size), nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, []uint64{0, 1, 2, 4, 5}, ids)
+		assert.Equal(t, []float32{0, 1, 2, 4, 5}, dists)
+		assert.Equal(t, []bool{false}, vi.searches)
+	})
+
+	t.Run("permissive filter excluding the closest results", func(t *testing.T) {
+		vi := &fakeOrderedIndex{Index: noop.NewIndex(), size: 100}
+		allow := allowAllBut(100, 0, 1, 2, 3, 4, 5)
+
+		ids, _, err := searchByVectorFiltered(vi, []float32{1}, 5, allow,
+			filterSelectivity(allow, vi.size), nil)
+		require.Nil(t, err)
+
+		// falls back to pre-filtering to find enough results
+		assert.Equal(t, []uint64{6, 7, 8, 9, 10}, ids)
+		assert.Equal(t, []bool{false, true}, vi.searches)
+	})
+
+	t.Run("permissive filter on an index with fewer vectors than requested", func(t *testing.T) {
+		vi := &fakeOrderedIndex{Index: noop.NewIndex(), size: 20}
+		allow := allowAllBut(20, 7)
+
+		ids, _, err := searchByVectorFiltered(vi, []float32{1}, 50, allow,
+			filterSelectivity(allow, vi.size), nil)
+		require.Nil(t, err)
+
+		assert.Len(t, ids, 19)
+		assert.NotContains(t, ids, uint64(7))
+		assert.Equal(t, []bool{false}, vi.searches)
+	})
+
+	t.Run("without filter", func(t *testing.T) {
+		vi := &fakeOrderedIndex{Index: noop.NewIndex(), size: 100}
+
+		ids, _, err := searchByVectorFiltered(vi, []float32{1}, 3, nil,
+			filterSelectivity(nil, vi.size), nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, []uint64{0, 1, 2}, ids)
+		assert.Equal(t, []bool{false}, vi.searches)
+	})
+}
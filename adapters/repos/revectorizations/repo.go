@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package revectorizations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/revectorization"
+	bolt "go.etcd.io/bbolt"
+)
+
+var revectorizationsBucket = []byte("revectorizations")
+
+type Repo struct {
+	logger  logrus.FieldLogger
+	baseDir string
+	db      *bolt.DB
+}
+
+func NewRepo(baseDir string, logger logrus.FieldLogger) (*Repo, error) {
+	r := &Repo{
+		baseDir: baseDir,
+		logger:  logger,
+	}
+
+	err := r.init()
+	return r, err
+}
+
+func (r *Repo) DBPath() string {
+	return fmt.Sprintf("%s/revectorizations.db", r.baseDir)
+}
+
+func (r *Repo) keyFromClassName(className string) []byte {
+	return []byte(className)
+}
+
+func (r *Repo) init() error {
+	if err := os.MkdirAll(r.baseDir, 0o777); err != nil {
+		return errors.Wrapf(err, "create root path directory at %s", r.baseDir)
+	}
+
+	boltdb, err := bolt.Open(r.DBPath(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", r.DBPath())
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(revectorizationsBucket); err != nil {
+			return errors.Wrapf(err, "create revectorizations bucket '%s'",
+				string(revectorizationsBucket))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create bolt buckets")
+	}
+
+	r.db = boltdb
+
+	return nil
+}
+
+func (r *Repo) Put(ctx context.Context, revectorization models.Revectorization) error {
+	revectorizationJSON, err := json.Marshal(revectorization)
+	if err != nil {
+		return errors.Wrap(err, "marshal revectorization to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(revectorizationsBucket)
+		return b.Put(r.keyFromClassName(revectorization.Class), revectorizationJSON)
+	})
+}
+
+func (r *Repo) Get(ctx context.Context, className string) (*models.Revectorization, error) {
+	var revectorizationJSON []byte
+	r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(revectorizationsBucket)
+		// the slice is only valid within the transaction
+		revectorizationJSON = append([]byte(nil), b.Get(r.keyFromClassName(className))...)
+		return nil
+	})
+
+	if len(revectorizationJSON) == 0 {
+		return nil, nil
+	}
+
+	var rv models.Revectorization
+	err := json.Unmarshal(revectorizationJSON, &rv)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse revectorization from JSON")
+	}
+
+	return &rv, nil
+}
+
+var _ = revectorization.Repo(&Repo{})
@@ -127,6 +127,39 @@ func TestRepositorySaveLoad(t *testing.T) {
 	repo.asserEqualSchema(t, schema, "delete class")
 }
 
+func TestRepositorySaveAliases(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		dirName   = t.TempDir()
+	)
+	repo, err := newRepo(dirName, -1, logger)
+	if err != nil {
+		t.Fatalf("create new repo: %v", err)
+	}
+
+	schema := ucs.NewState(2)
+	addClass(&schema, "C1", 0, 1, 0)
+	addClass(&schema, "C2", 0, 1, 1)
+	schema.Aliases = map[string]string{"A1": "C1"}
+	if err = repo.Save(ctx, schema); err != nil {
+		t.Fatalf("save schema: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "save schema with aliases")
+
+	schema.Aliases = map[string]string{"A1": "C2", "A2": "C1"}
+	if err := repo.SaveAliases(ctx, schema.Aliases); err != nil {
+		t.Fatalf("save aliases: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "update aliases")
+
+	schema.Aliases = nil
+	if err := repo.SaveAliases(ctx, nil); err != nil {
+		t.Fatalf("delete aliases: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "delete aliases")
+}
+
 func TestRepositoryUpdateClass(t *testing.T) {
 	var (
 		ctx       = context.Background()
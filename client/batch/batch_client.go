@@ -45,6 +45,8 @@ type ClientService interface {
 
 	BatchObjectsDelete(params *BatchObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsDeleteOK, error)
 
+	BatchRef2vecRecompute(params *BatchRef2vecRecomputeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchRef2vecRecomputeOK, error)
+
 	BatchReferencesCreate(params *BatchReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchReferencesCreateOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -132,6 +134,47 @@ func (a *Client) BatchObjectsDelete(params *BatchObjectsDeleteParams, authInfo r
 	panic(msg)
 }
 
+/*
+BatchRef2vecRecompute recomputes the vectors of ref2vec vectorized objects based on a match filter as a batch
+
+Recompute the vectors of objects that match a certain filter from the vectors of their references. Only applies to classes vectorized by a ref2vec module.
+*/
+func (a *Client) BatchRef2vecRecompute(params *BatchRef2vecRecomputeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchRef2vecRecomputeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchRef2vecRecomputeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.ref2vec.recompute",
+		Method:             "POST",
+		PathPattern:        "/batch/ref2vec",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchRef2vecRecomputeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchRef2vecRecomputeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.ref2vec.recompute: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BatchReferencesCreate creates new cross references between arbitrary classes in bulk
 
@@ -45,6 +45,8 @@ type ClientService interface {
 
 	NodesGetClass(params *NodesGetClassParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetClassOK, error)
 
+	NodesIndexStats(params *NodesIndexStatsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesIndexStatsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -126,6 +128,45 @@ func (a *Client) NodesGetClass(params *NodesGetClassParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+NodesIndexStats Returns stats of the vector index of a shard on this node, such as the number of nodes per layer, tombstones, cache hit rate and a memory estimate.
+*/
+func (a *Client) NodesIndexStats(params *NodesIndexStatsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesIndexStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesIndexStatsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.index.stats",
+		Method:             "GET",
+		PathPattern:        "/debug/index/{className}/{shardName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesIndexStatsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesIndexStatsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.index.stats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -41,6 +41,14 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	AliasesCreate(params *AliasesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AliasesCreateOK, error)
+
+	AliasesDelete(params *AliasesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AliasesDeleteOK, error)
+
+	AliasesGet(params *AliasesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AliasesGetOK, error)
+
+	AliasesUpdate(params *AliasesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AliasesUpdateOK, error)
+
 	SchemaClusterStatus(params *SchemaClusterStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaClusterStatusOK, error)
 
 	SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaDumpOK, error)
@@ -52,11 +60,20 @@ type ClientService interface {
 	SchemaObjectsGet(params *SchemaObjectsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGetOK, error)
 
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error)
+	SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesDeleteOK, error)
+
+	SchemaObjectsPropertiesUpdate(params *SchemaObjectsPropertiesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesUpdateOK, error)
+
+	SchemaObjectsRevectorize(params *SchemaObjectsRevectorizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeAccepted, error)
+
+	SchemaObjectsRevectorizeStatus(params *SchemaObjectsRevectorizeStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeStatusOK, error)
 
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error)
 
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error)
 
+	SchemaObjectsSynonymsUpdate(params *SchemaObjectsSynonymsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsSynonymsUpdateOK, error)
+
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error)
 
 	TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error)
@@ -68,6 +85,170 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+AliasesCreate Create an alias for a class.
+
+Creates an alias which can be used instead of the name of the class it points to in the object and GraphQL APIs. The alias must not collide with the name of an existing class or alias.
+*/
+func (a *Client) AliasesCreate(params *AliasesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AliasesCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAliasesCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "aliases.create",
+		Method:             "POST",
+		PathPattern:        "/aliases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AliasesCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AliasesCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for aliases.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AliasesDelete Delete an alias.
+
+Deletes the alias, the class it pointed to is not affected.
+*/
+func (a *Client) AliasesDelete(params *AliasesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AliasesDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAliasesDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "aliases.delete",
+		Method:             "DELETE",
+		PathPattern:        "/aliases/{aliasName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AliasesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AliasesDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for aliases.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AliasesGet List all aliases.
+
+Lists all aliases together with the classes they point to.
+*/
+func (a *Client) AliasesGet(params *AliasesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AliasesGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAliasesGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "aliases.get",
+		Method:             "GET",
+		PathPattern:        "/aliases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AliasesGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AliasesGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for aliases.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AliasesUpdate Point an alias to another class.
+
+Points an existing alias to another class. Requests using the alias are served by the new class right away, which allows to swap in a reindexed copy of a class without downtime.
+*/
+func (a *Client) AliasesUpdate(params *AliasesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AliasesUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAliasesUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "aliases.update",
+		Method:             "PUT",
+		PathPattern:        "/aliases/{aliasName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AliasesUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AliasesUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for aliases.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaClusterStatus schema cluster status API
 */
@@ -302,6 +483,168 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+SchemaObjectsPropertiesDelete deletes a property of an object class
+
+Deletes a top level property. Its inverted indexes are dropped right away, its values are hidden from all results and removed from the stored objects in the background. Vectors are not recomputed: objects whose vector was built from the property keep that vector until they are vectorized again, i.e. when they are updated or the class is revectorized.
+*/
+func (a *Client) SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.properties.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/properties/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsPropertiesUpdate renames a property of an object class
+
+Renames a property, only the name in the body is used. The inverted indexes are renamed right away, the values stored in the objects are moved to the new name in the background. Vectors are not recomputed: vectorizers which include property names only pick up the new name once an object is vectorized again, i.e. when it is updated or the class is revectorized. Renaming geoCoordinates properties is not supported.
+*/
+func (a *Client) SchemaObjectsPropertiesUpdate(params *SchemaObjectsPropertiesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.properties.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/properties/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsRevectorize revectorizes all objects of an object class
+
+Re-runs the vectorizer over all existing objects of an Object Class and swaps in the newly built vector index once all objects are done. The class stays readable, but rejects writes until the revectorization has completed. An interrupted or failed revectorization is resumed by sending the request again.
+*/
+func (a *Client) SchemaObjectsRevectorize(params *SchemaObjectsRevectorizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsRevectorizeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.revectorize",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/revectorize",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsRevectorizeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsRevectorizeAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.revectorize: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsRevectorizeStatus gets the status of the revectorization of an object class
+*/
+func (a *Client) SchemaObjectsRevectorizeStatus(params *SchemaObjectsRevectorizeStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsRevectorizeStatusParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.revectorize.status",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/revectorize",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsRevectorizeStatusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsRevectorizeStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.revectorize.status: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsShardsGet gets the shards status of an object class
 */
@@ -380,6 +723,47 @@ func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdatePara
 	panic(msg)
 }
 
+/*
+SchemaObjectsSynonymsUpdate replaces the synonym dictionary of an object class
+
+Replaces the synonyms which are used to expand the terms of bm25 and hybrid queries on the class, e.g. 'tv' with 'television'. Synonyms are applied at query time, so no reindexing is required.
+*/
+func (a *Client) SchemaObjectsSynonymsUpdate(params *SchemaObjectsSynonymsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsSynonymsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsSynonymsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.synonyms.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/synonyms",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsSynonymsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsSynonymsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.synonyms.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsUpdate updates settings of an existing schema class
 
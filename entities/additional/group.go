@@ -29,4 +29,5 @@ type GroupHitAdditional struct {
 	ID       string    `json:"id"`
 	Vector   []float32 `json:"vector"`
 	Distance float32   `json:"distance"`
+	Score    float32   `json:"score"`
 }
@@ -31,8 +31,8 @@ func Autocut(yValues []float32, cutOff int) int {
 			continue // we want the index _before_ the extrema
 		}
 
-		if i == len(diff)-1 && len(diff) > 1 { // for last element there is no "next" point
-			if diff[i] > diff[i-1] && diff[i] > diff[i-2] {
+		if i == len(diff)-1 { // for last element there is no "next" point
+			if diff[i] > diff[i-1] && (i < 2 || diff[i] > diff[i-2]) {
 				extremaCount += 1
 				if extremaCount >= cutOff {
 					return i
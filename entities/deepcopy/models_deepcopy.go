@@ -38,6 +38,13 @@ func Class(c *models.Class) *models.Class {
 	if c.ReplicationConfig != nil {
 		replicationConf = &models.ReplicationConfig{Factor: c.ReplicationConfig.Factor}
 	}
+	var vectorConfig map[string]models.VectorConfig = nil
+	if c.VectorConfig != nil {
+		vectorConfig = make(map[string]models.VectorConfig, len(c.VectorConfig))
+		for name, cfg := range c.VectorConfig {
+			vectorConfig[name] = cfg
+		}
+	}
 
 	return &models.Class{
 		Class:               c.Class,
@@ -48,6 +55,7 @@ func Class(c *models.Class) *models.Class {
 		VectorIndexType:     c.VectorIndexType,
 		ReplicationConfig:   replicationConf,
 		Vectorizer:          c.Vectorizer,
+		VectorConfig:        vectorConfig,
 		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
 		Properties:          properties,
 	}
@@ -55,14 +63,41 @@ func Class(c *models.Class) *models.Class {
 
 func Prop(p *models.Property) *models.Property {
 	return &models.Property{
-		DataType:        p.DataType,
-		Description:     p.Description,
-		ModuleConfig:    p.ModuleConfig,
-		Name:            p.Name,
-		Tokenization:    p.Tokenization,
-		IndexFilterable: ptrBoolCopy(p.IndexFilterable),
-		IndexSearchable: ptrBoolCopy(p.IndexSearchable),
+		DataType:            p.DataType,
+		Description:         p.Description,
+		ModuleConfig:        p.ModuleConfig,
+		Name:                p.Name,
+		Tokenization:        p.Tokenization,
+		Stemmer:             p.Stemmer,
+		IndexFilterable:     ptrBoolCopy(p.IndexFilterable),
+		IndexSearchable:     ptrBoolCopy(p.IndexSearchable),
+		IndexNullState:      ptrBoolCopy(p.IndexNullState),
+		IndexPositions:      ptrBoolCopy(p.IndexPositions),
+		IndexPropertyLength: ptrBoolCopy(p.IndexPropertyLength),
+		IndexRangeFilters:   ptrBoolCopy(p.IndexRangeFilters),
+		IndexReversedTerms:  ptrBoolCopy(p.IndexReversedTerms),
+		NestedProperties:    NestedProps(p.NestedProperties),
+	}
+}
+
+func NestedProps(nps []*models.NestedProperty) []*models.NestedProperty {
+	if nps == nil {
+		return nil
 	}
+
+	out := make([]*models.NestedProperty, len(nps))
+	for i, np := range nps {
+		out[i] = &models.NestedProperty{
+			DataType:         np.DataType,
+			Description:      np.Description,
+			Name:             np.Name,
+			Tokenization:     np.Tokenization,
+			IndexFilterable:  ptrBoolCopy(np.IndexFilterable),
+			IndexSearchable:  ptrBoolCopy(np.IndexSearchable),
+			NestedProperties: NestedProps(np.NestedProperties),
+		}
+	}
+	return out
 }
 
 func ptrBoolCopy(ptrBool *bool) *bool {
@@ -88,6 +123,17 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 		stopwords = &models.StopwordConfig{Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset, Removals: i.Stopwords.Removals}
 	}
 
+	var synonyms *models.SynonymConfig = nil
+	if i.Synonyms != nil {
+		groups := make([]*models.SynonymGroup, len(i.Synonyms.Groups))
+		for j, group := range i.Synonyms.Groups {
+			if group != nil {
+				groups[j] = &models.SynonymGroup{Terms: group.Terms}
+			}
+		}
+		synonyms = &models.SynonymConfig{Groups: groups, Weight: i.Synonyms.Weight}
+	}
+
 	return &models.InvertedIndexConfig{
 		Bm25:                   bm25,
 		CleanupIntervalSeconds: i.CleanupIntervalSeconds,
@@ -95,5 +141,6 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 		IndexPropertyLength:    i.IndexPropertyLength,
 		IndexTimestamps:        i.IndexTimestamps,
 		Stopwords:              stopwords,
+		Synonyms:               synonyms,
 	}
 }
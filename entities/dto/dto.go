@@ -18,6 +18,24 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
+// TargetCombinationType defines how the distances of an object to several
+// target vectors are joined into a single distance
+type TargetCombinationType int
+
+const (
+	TargetCombinationMinimum TargetCombinationType = iota
+	TargetCombinationSum
+	TargetCombinationAverage
+	TargetCombinationManualWeights
+)
+
+type TargetCombination struct {
+	Type TargetCombinationType
+	// Weights contains one weight per target vector, in the order of the
+	// target vectors. It is only used with manual weights.
+	Weights []float32
+}
+
 type GroupParams struct {
 	Strategy string
 	Force    float32
@@ -36,6 +54,10 @@ type GetParams struct {
 	HybridSearch          *searchparams.HybridSearch
 	GroupBy               *searchparams.GroupBy
 	SearchVector          []float32
+	TargetVector          string   // the named vector to search, empty for the class vector
+	TargetVectors         []string // the named vectors to search if more than one is targeted
+	TargetCombination     *TargetCombination
+	VectorIndexParams     *searchparams.VectorIndex
 	Group                 *GroupParams
 	ModuleParams          map[string]interface{}
 	AdditionalProperties  additional.Properties
@@ -33,6 +33,11 @@ const (
 	OperatorWithinGeoRange
 	OperatorLike
 	OperatorIsNull
+	OperatorContainsAny
+	OperatorContainsAll
+	OperatorWithinGeoPolygon
+	OperatorWithinGeoBoundingBox
+	OperatorRegex
 )
 
 func (o Operator) OnValue() bool {
@@ -45,7 +50,12 @@ func (o Operator) OnValue() bool {
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
 		OperatorLike,
-		OperatorIsNull:
+		OperatorIsNull,
+		OperatorContainsAny,
+		OperatorContainsAll,
+		OperatorWithinGeoPolygon,
+		OperatorWithinGeoBoundingBox,
+		OperatorRegex:
 		return true
 	default:
 		return false
@@ -78,11 +88,34 @@ func (o Operator) Name() string {
 		return "Like"
 	case OperatorIsNull:
 		return "IsNull"
+	case OperatorContainsAny:
+		return "ContainsAny"
+	case OperatorContainsAll:
+		return "ContainsAll"
+	case OperatorWithinGeoPolygon:
+		return "WithinGeoPolygon"
+	case OperatorWithinGeoBoundingBox:
+		return "WithinGeoBoundingBox"
+	case OperatorRegex:
+		return "Regex"
 	default:
 		panic("Unknown operator")
 	}
 }
 
+// OnArray reports whether the operator compares the property against an
+// array of values rather than a single one
+func (o Operator) OnArray() bool {
+	return o == OperatorContainsAny || o == OperatorContainsAll
+}
+
+// OnGeo reports whether the operator is served by the geo index of a
+// geoCoordinates property rather than by the inverted index
+func (o Operator) OnGeo() bool {
+	return o == OperatorWithinGeoRange || o == OperatorWithinGeoPolygon ||
+		o == OperatorWithinGeoBoundingBox
+}
+
 type LocalFilter struct {
 	Root *Clause `json:"root"`
 }
@@ -110,9 +143,62 @@ func (v *Value) UnmarshalJSON(data []byte) error {
 		v.Value = int(asFloat)
 	}
 
+	if asSlice, ok := v.Value.([]interface{}); ok {
+		v.Value = typedArrayValue(asSlice, v.Type)
+	}
+
 	return nil
 }
 
+// typedArrayValue restores the typed slice of an array value which was
+// unmarshalled into a []interface{}
+func typedArrayValue(in []interface{}, dt schema.DataType) interface{} {
+	switch dt {
+	case schema.DataTypeIntArray:
+		out := make([]int, len(in))
+		for i := range in {
+			asFloat, ok := in[i].(float64)
+			if !ok {
+				return in
+			}
+			out[i] = int(asFloat)
+		}
+		return out
+	case schema.DataTypeNumberArray:
+		out := make([]float64, len(in))
+		for i := range in {
+			asFloat, ok := in[i].(float64)
+			if !ok {
+				return in
+			}
+			out[i] = asFloat
+		}
+		return out
+	case schema.DataTypeBooleanArray:
+		out := make([]bool, len(in))
+		for i := range in {
+			asBool, ok := in[i].(bool)
+			if !ok {
+				return in
+			}
+			out[i] = asBool
+		}
+		return out
+	case schema.DataTypeTextArray, schema.DataTypeStringArray, schema.DataTypeDateArray:
+		out := make([]string, len(in))
+		for i := range in {
+			asString, ok := in[i].(string)
+			if !ok {
+				return in
+			}
+			out[i] = asString
+		}
+		return out
+	default:
+		return in
+	}
+}
+
 type Clause struct {
 	Operator Operator `json:"operator"`
 	On       *Path    `json:"on"`
@@ -126,3 +212,16 @@ type GeoRange struct {
 	*models.GeoCoordinates
 	Distance float32 `json:"distance"`
 }
+
+// GeoPolygon to be used with fields of type GeoCoordinates. Identifies the
+// corners of a polygon, the last corner is connected to the first one.
+type GeoPolygon struct {
+	Coordinates []models.GeoCoordinates `json:"coordinates"`
+}
+
+// GeoBoundingBox to be used with fields of type GeoCoordinates. Identifies
+// the north-west and the south-east corner of a box.
+type GeoBoundingBox struct {
+	TopLeft     *models.GeoCoordinates `json:"topLeft"`
+	BottomRight *models.GeoCoordinates `json:"bottomRight"`
+}
@@ -13,9 +13,11 @@ package filters
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -58,6 +60,11 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 
 	// validate current
 
+	cw, err := validateArrayValue(cw)
+	if err != nil {
+		return err
+	}
+
 	className := cw.getClassName()
 	propName := cw.getPropertyName()
 
@@ -82,6 +89,12 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return err
 	}
 
+	if schema.IsNestedDataType(prop.DataType) {
+		return errors.Errorf("property %q of data type %s cannot be filtered on directly, "+
+			"use a dot path to one of its nested properties instead, e.g. \"%s.<nestedProperty>\"",
+			propName, prop.DataType[0], propName)
+	}
+
 	if cw.getOperator() == OperatorIsNull {
 		if !cw.isType(schema.DataTypeBoolean) {
 			return errors.Errorf("operator IsNull requires a booleanValue, got %q instead",
@@ -109,6 +122,24 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return nil
 	}
 
+	if op := cw.getOperator(); (op == OperatorLike || op == OperatorRegex) &&
+		prop.Tokenization == models.PropertyTokenizationTrigram {
+		// the index only holds the trigrams of the words, a pattern can't be
+		// matched against them
+		return errors.Errorf("operator %s cannot be used on property %q with %s tokenization, "+
+			"use Equal to match partial words instead", op.Name(), propName, prop.Tokenization)
+	}
+
+	if cw.getOperator() == OperatorRegex {
+		return validateRegexClause(propName, prop, cw)
+	}
+
+	if schema.DataType(prop.DataType[0]) == schema.DataTypeGeoCoordinates {
+		if err := validateGeoValue(propName, cw); err != nil {
+			return err
+		}
+	}
+
 	if isUUIDType(prop.DataType[0]) {
 		return validateUUIDType(propName, cw)
 	}
@@ -116,8 +147,8 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 	if schema.IsRefDataType(prop.DataType) {
 		// bit of an edge case, directly on refs (i.e. not on a primitive prop of a
 		// ref) we only allow valueInt which is what's used to count references
-		if cw.isType(schema.DataTypeInt) {
-			return nil
+		if cw.isType(schema.DataTypeInt) && !cw.onArray {
+			return validateReferenceCount(propName, cw)
 		}
 		return errors.Errorf("Property %q is a ref prop to the class %q. Only "+
 			"\"valueInt\" can be used on a ref prop directly to count the number of refs. "+
@@ -142,6 +173,38 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 	return nil
 }
 
+// validateArrayValue makes sure array values are only used with the
+// ContainsAny and ContainsAll operators and returns a wrapper which validates
+// the type of a single array element against the property
+func validateArrayValue(cw *clauseWrapper) (*clauseWrapper, error) {
+	op := cw.getOperator()
+	baseType, isArray := schema.IsArrayType(cw.origType)
+	if !op.OnArray() {
+		if isArray {
+			return nil, errors.Errorf("operator %s cannot be used with %q, use ContainsAny or ContainsAll instead",
+				op.Name(), valueNameFromDataType(baseType)+"Array")
+		}
+		return cw, nil
+	}
+
+	if !isArray {
+		return nil, errors.Errorf("operator %s requires an array value, use %q instead of %q",
+			op.Name(), cw.getValueNameFromType()+"Array", cw.getValueNameFromType())
+	}
+
+	elem := &clauseWrapper{
+		clause:    cw.clause,
+		origType:  baseType,
+		aliasType: deprecatedDataTypeAliases[baseType],
+		onArray:   true,
+	}
+	if reflect.ValueOf(cw.getValue()).Len() == 0 {
+		return nil, errors.Errorf("operator %s requires at least one value, got an empty %q",
+			op.Name(), elem.getValueNameFromType())
+	}
+	return elem, nil
+}
+
 func valueNameFromDataType(dt schema.DataType) string {
 	return "value" + strings.ToUpper(string(dt[0])) + string(dt[1:])
 }
@@ -205,18 +268,122 @@ func validateUUIDOperators(propName schema.PropertyName, cw *clauseWrapper) erro
 
 	switch op {
 	case OperatorEqual, OperatorNotEqual, OperatorLessThan, OperatorLessThanEqual,
-		OperatorGreaterThan, OperatorGreaterThanEqual:
+		OperatorGreaterThan, OperatorGreaterThanEqual, OperatorContainsAny, OperatorContainsAll:
 		return nil
 	default:
 		return fmt.Errorf("operator %q cannot be used on uuid/uuid[] props", op.Name())
 	}
 }
 
+// validateRegexClause makes sure the Regex operator is used with a valid
+// pattern on a text or text[] property
+func validateRegexClause(propName schema.PropertyName, prop *models.Property,
+	cw *clauseWrapper,
+) error {
+	dt := schema.DataType(prop.DataType[0])
+	if baseType, ok := schema.IsArrayType(dt); ok {
+		dt = baseType
+	}
+	if dt != schema.DataTypeText && dt != schema.DataTypeString {
+		return errors.Errorf("operator Regex can only be used on text and text[] properties, "+
+			"property %q is of type %q", propName, prop.DataType[0])
+	}
+	if !cw.isType(schema.DataTypeText) && !cw.isType(schema.DataTypeString) {
+		return errors.Errorf("operator Regex requires the pattern as \"valueText\", got %q instead",
+			cw.getValueNameFromType())
+	}
+
+	_, err := CompileRegexPattern(cw.getValue().(string))
+	return err
+}
+
+// validateReferenceCount makes sure a filter on the number of references of a
+// ref prop compares against a count, the reference count is indexed as an
+// unsigned integer, so negative counts would never match as expected
+func validateReferenceCount(propName schema.PropertyName, cw *clauseWrapper) error {
+	switch op := cw.getOperator(); op {
+	case OperatorEqual, OperatorNotEqual, OperatorGreaterThan, OperatorGreaterThanEqual,
+		OperatorLessThan, OperatorLessThanEqual:
+		// ok
+	default:
+		return errors.Errorf("Filtering for the reference count of %q supports operators "+
+			"(not) equal and greater/less than (equal), got %q instead", propName, op.Name())
+	}
+	if val, ok := cw.getValue().(int); ok && val < 0 {
+		return errors.Errorf("Can only filter for non-negative reference counts of %q, got %v instead",
+			propName, val)
+	}
+	return nil
+}
+
+// validateGeoValue makes sure the value of a geo operator on a geoCoordinates
+// property matches the operator and describes a valid area
+func validateGeoValue(propName schema.PropertyName, cw *clauseWrapper) error {
+	switch op := cw.getOperator(); op {
+	case OperatorWithinGeoRange:
+		if _, ok := cw.getValue().(GeoRange); !ok {
+			return errors.Errorf("operator %s on property %q requires \"valueGeoRange\"", op.Name(), propName)
+		}
+		return nil
+	case OperatorWithinGeoPolygon:
+		polygon, ok := cw.getValue().(GeoPolygon)
+		if !ok {
+			return errors.Errorf("operator %s on property %q requires \"valueGeoPolygon\"", op.Name(), propName)
+		}
+		if len(polygon.Coordinates) < 3 {
+			return errors.Errorf("valueGeoPolygon requires at least 3 coordinates, got %d",
+				len(polygon.Coordinates))
+		}
+		for i := range polygon.Coordinates {
+			if err := validateGeoCoordinates(&polygon.Coordinates[i]); err != nil {
+				return errors.Wrapf(err, "valueGeoPolygon: coordinates %d", i)
+			}
+		}
+		return nil
+	case OperatorWithinGeoBoundingBox:
+		box, ok := cw.getValue().(GeoBoundingBox)
+		if !ok {
+			return errors.Errorf("operator %s on property %q requires \"valueGeoBoundingBox\"", op.Name(), propName)
+		}
+		if err := validateGeoCoordinates(box.TopLeft); err != nil {
+			return errors.Wrap(err, "valueGeoBoundingBox: topLeft")
+		}
+		if err := validateGeoCoordinates(box.BottomRight); err != nil {
+			return errors.Wrap(err, "valueGeoBoundingBox: bottomRight")
+		}
+		if *box.TopLeft.Latitude < *box.BottomRight.Latitude {
+			return errors.Errorf("valueGeoBoundingBox: latitude of topLeft must not be "+
+				"smaller than latitude of bottomRight, got %v and %v",
+				*box.TopLeft.Latitude, *box.BottomRight.Latitude)
+		}
+		return nil
+	default:
+		// the data type of the value is validated as for any other property
+		return nil
+	}
+}
+
+func validateGeoCoordinates(coordinates *models.GeoCoordinates) error {
+	if coordinates == nil || coordinates.Latitude == nil || coordinates.Longitude == nil {
+		return errors.Errorf("latitude and longitude must be set")
+	}
+	if lat := *coordinates.Latitude; lat < -90 || lat > 90 {
+		return errors.Errorf("latitude must be between -90 and 90, got %v", lat)
+	}
+	if lon := *coordinates.Longitude; lon < -180 || lon > 180 {
+		return errors.Errorf("longitude must be between -180 and 180, got %v", lon)
+	}
+	return nil
+}
+
 type clauseWrapper struct {
 	clause    *Clause
 	origType  schema.DataType
 	aliasType schema.DataType
 	operands  []*clauseWrapper
+	// onArray is set when validating the elements of an array value, origType
+	// and aliasType then hold the type of a single element
+	onArray bool
 }
 
 func newClauseWrapper(clause *Clause) *clauseWrapper {
@@ -241,6 +408,9 @@ func (w *clauseWrapper) isType(dt schema.DataType) bool {
 }
 
 func (w *clauseWrapper) getValueNameFromType() string {
+	if w.onArray {
+		return valueNameFromDataType(w.origType) + "Array"
+	}
 	return valueNameFromDataType(w.origType)
 }
 
@@ -12,6 +12,7 @@
 package filters
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -144,6 +145,85 @@ func TestValidatePropertyLength(t *testing.T) {
 	}
 }
 
+func TestValidateReferenceCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		operator  Operator
+		value     interface{}
+		valueType schema.DataType
+		valid     bool
+	}{
+		{
+			name:      "greater than a count",
+			operator:  OperatorGreaterThan,
+			value:     5,
+			valueType: schema.DataTypeInt,
+			valid:     true,
+		},
+		{
+			name:      "equal to zero",
+			operator:  OperatorEqual,
+			value:     0,
+			valueType: schema.DataTypeInt,
+			valid:     true,
+		},
+		{
+			name:      "negative count",
+			operator:  OperatorLessThan,
+			value:     -1,
+			valueType: schema.DataTypeInt,
+			valid:     false,
+		},
+		{
+			name:      "invalid operator (Like)",
+			operator:  OperatorLike,
+			value:     1,
+			valueType: schema.DataTypeInt,
+			valid:     false,
+		},
+		{
+			name:      "text value",
+			operator:  OperatorEqual,
+			value:     "1",
+			valueType: schema.DataTypeText,
+			valid:     false,
+		},
+	}
+
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Article",
+				Properties: []*models.Property{
+					{Name: "hasComments", DataType: []string{"Comment"}},
+				},
+			},
+			{
+				Class: "Comment",
+				Properties: []*models.Property{
+					{Name: "text", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: tt.valueType},
+				On:       &Path{Class: "Article", Property: "hasComments"},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateUUIDFilter(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -213,6 +293,357 @@ func TestValidateUUIDFilter(t *testing.T) {
 	}
 }
 
+func TestValidateContainsOperators(t *testing.T) {
+	tests := []struct {
+		name     string
+		operator Operator
+		property schema.PropertyName
+		value    *Value
+		valid    bool
+	}{
+		{
+			name:     "ContainsAny with text array on text[] prop",
+			operator: OperatorContainsAny,
+			property: "colors",
+			value:    &Value{Value: []string{"red", "blue"}, Type: schema.DataTypeTextArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAll with text array on text prop",
+			operator: OperatorContainsAll,
+			property: "name",
+			value:    &Value{Value: []string{"red"}, Type: schema.DataTypeTextArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAny with deprecated string array",
+			operator: OperatorContainsAny,
+			property: "colors",
+			value:    &Value{Value: []string{"red"}, Type: schema.DataTypeStringArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAny with int array on int[] prop",
+			operator: OperatorContainsAny,
+			property: "sizes",
+			value:    &Value{Value: []int{1, 2}, Type: schema.DataTypeIntArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAll with text array on uuid[] prop",
+			operator: OperatorContainsAll,
+			property: "ids",
+			value:    &Value{Value: []string{"8d5a3aa2-3c8d-4589-9ae1-3f638f506970"}, Type: schema.DataTypeTextArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAny with text array on id",
+			operator: OperatorContainsAny,
+			property: "id",
+			value:    &Value{Value: []string{"8d5a3aa2-3c8d-4589-9ae1-3f638f506970"}, Type: schema.DataTypeTextArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAny with wrong element type",
+			operator: OperatorContainsAny,
+			property: "sizes",
+			value:    &Value{Value: []string{"1"}, Type: schema.DataTypeTextArray},
+			valid:    false,
+		},
+		{
+			name:     "ContainsAny with single value",
+			operator: OperatorContainsAny,
+			property: "colors",
+			value:    &Value{Value: "red", Type: schema.DataTypeText},
+			valid:    false,
+		},
+		{
+			name:     "ContainsAny with empty array",
+			operator: OperatorContainsAny,
+			property: "colors",
+			value:    &Value{Value: []string{}, Type: schema.DataTypeTextArray},
+			valid:    false,
+		},
+		{
+			name:     "Equal with array value",
+			operator: OperatorEqual,
+			property: "colors",
+			value:    &Value{Value: []string{"red"}, Type: schema.DataTypeTextArray},
+			valid:    false,
+		},
+		{
+			name:     "ContainsAny with int array on ref prop",
+			operator: OperatorContainsAny,
+			property: "ofOwner",
+			value:    &Value{Value: []int{1}, Type: schema.DataTypeIntArray},
+			valid:    false,
+		},
+	}
+
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Car",
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+					{Name: "colors", DataType: schema.DataTypeTextArray.PropString()},
+					{Name: "sizes", DataType: schema.DataTypeIntArray.PropString()},
+					{Name: "ids", DataType: schema.DataTypeUUIDArray.PropString()},
+					{Name: "ofOwner", DataType: []string{"Owner"}},
+				},
+			},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    tt.value,
+				On:       &Path{Class: "Car", Property: tt.property},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateGeoOperators(t *testing.T) {
+	coords := func(lat, lon float32) models.GeoCoordinates {
+		return models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+	}
+	corner := func(lat, lon float32) *models.GeoCoordinates {
+		c := coords(lat, lon)
+		return &c
+	}
+	lat := float32(49)
+
+	tests := []struct {
+		name     string
+		operator Operator
+		value    interface{}
+		valid    bool
+	}{
+		{
+			name:     "WithinGeoRange with geo range",
+			operator: OperatorWithinGeoRange,
+			value:    GeoRange{GeoCoordinates: corner(48, 11), Distance: 100},
+			valid:    true,
+		},
+		{
+			name:     "WithinGeoPolygon with polygon",
+			operator: OperatorWithinGeoPolygon,
+			value:    GeoPolygon{Coordinates: []models.GeoCoordinates{coords(50, 8), coords(50, 13), coords(46, 11)}},
+			valid:    true,
+		},
+		{
+			name:     "WithinGeoPolygon with two corners",
+			operator: OperatorWithinGeoPolygon,
+			value:    GeoPolygon{Coordinates: []models.GeoCoordinates{coords(50, 8), coords(50, 13)}},
+			valid:    false,
+		},
+		{
+			name:     "WithinGeoPolygon with out of range latitude",
+			operator: OperatorWithinGeoPolygon,
+			value:    GeoPolygon{Coordinates: []models.GeoCoordinates{coords(50, 8), coords(95, 13), coords(46, 11)}},
+			valid:    false,
+		},
+		{
+			name:     "WithinGeoPolygon with geo range",
+			operator: OperatorWithinGeoPolygon,
+			value:    GeoRange{GeoCoordinates: corner(48, 11), Distance: 100},
+			valid:    false,
+		},
+		{
+			name:     "WithinGeoBoundingBox with box",
+			operator: OperatorWithinGeoBoundingBox,
+			value:    GeoBoundingBox{TopLeft: corner(49, 9), BottomRight: corner(48, 10)},
+			valid:    true,
+		},
+		{
+			name:     "WithinGeoBoundingBox spanning the antimeridian",
+			operator: OperatorWithinGeoBoundingBox,
+			value:    GeoBoundingBox{TopLeft: corner(-15, 175), BottomRight: corner(-20, -175)},
+			valid:    true,
+		},
+		{
+			name:     "WithinGeoBoundingBox with corners upside down",
+			operator: OperatorWithinGeoBoundingBox,
+			value:    GeoBoundingBox{TopLeft: corner(48, 9), BottomRight: corner(49, 10)},
+			valid:    false,
+		},
+		{
+			name:     "WithinGeoBoundingBox with missing longitude",
+			operator: OperatorWithinGeoBoundingBox,
+			value:    GeoBoundingBox{TopLeft: &models.GeoCoordinates{Latitude: &lat}, BottomRight: corner(48, 10)},
+			valid:    false,
+		},
+	}
+
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "City",
+				Properties: []*models.Property{
+					{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+				},
+			},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: schema.DataTypeGeoCoordinates},
+				On:       &Path{Class: "City", Property: "location"},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRegexOperator(t *testing.T) {
+	tests := []struct {
+		name      string
+		prop      string
+		value     interface{}
+		valueType schema.DataType
+		valid     bool
+	}{
+		{
+			name:      "pattern on text prop",
+			prop:      "name",
+			value:     "car(e|s)?",
+			valueType: schema.DataTypeText,
+			valid:     true,
+		},
+		{
+			name:      "pattern on text[] prop",
+			prop:      "tags",
+			value:     ".*car",
+			valueType: schema.DataTypeText,
+			valid:     true,
+		},
+		{
+			name:      "invalid pattern",
+			prop:      "name",
+			value:     "car(",
+			valueType: schema.DataTypeText,
+			valid:     false,
+		},
+		{
+			name:      "empty pattern",
+			prop:      "name",
+			value:     "",
+			valueType: schema.DataTypeText,
+			valid:     false,
+		},
+		{
+			name:      "too long pattern",
+			prop:      "name",
+			value:     strings.Repeat("a", MaxRegexPatternLength+1),
+			valueType: schema.DataTypeText,
+			valid:     false,
+		},
+		{
+			name:      "pattern on int prop",
+			prop:      "count",
+			value:     "1.*",
+			valueType: schema.DataTypeText,
+			valid:     false,
+		},
+		{
+			name:      "int value",
+			prop:      "name",
+			value:     1,
+			valueType: schema.DataTypeInt,
+			valid:     false,
+		},
+	}
+
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Car",
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+					{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+					{Name: "count", DataType: schema.DataTypeInt.PropString()},
+				},
+			},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: OperatorRegex,
+				Value:    &Value{Value: tt.value, Type: tt.valueType},
+				On:       &Path{Class: "Car", Property: schema.PropertyName(tt.prop)},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePatternOperatorsOnTrigrams(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Car",
+				Properties: []*models.Property{
+					{
+						Name:         "name",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationTrigram,
+					},
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		operator Operator
+		value    string
+		valid    bool
+	}{
+		{operator: OperatorEqual, value: "dat", valid: true},
+		{operator: OperatorLike, value: "dat*", valid: false},
+		{operator: OperatorRegex, value: "^dat", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operator.Name(), func(t *testing.T) {
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: schema.DataTypeText},
+				On:       &Path{Class: "Car", Property: "name"},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, "trigram tokenization")
+			}
+		})
+	}
+}
+
 func TestClauseWrapper(t *testing.T) {
 	type testCase struct {
 		name         string
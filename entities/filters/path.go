@@ -124,6 +124,12 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 				return nil, fmt.Errorf("Expected a valid property name in 'path' field for the filter, but got '%s'", lengthPropName)
 			}
 			propertyName = schema.PropertyName(rawPropertyName)
+		} else if strings.Contains(rawPropertyName, schema.NestedPropertySeparator) {
+			// dot path to a nested property of an object
+			propertyName, err = schema.ValidateNestedPropertyPath(rawPropertyName)
+			if err != nil {
+				return nil, fmt.Errorf("Expected a valid property name in 'path' field for the filter, but got '%s'", rawPropertyName)
+			}
 		} else {
 			propertyName, err = schema.ValidatePropertyName(rawPropertyName)
 			// Invalid property name?
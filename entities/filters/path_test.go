@@ -92,6 +92,21 @@ func Test_ParsePath(t *testing.T) {
 		_, err := ParsePath(segments, rootClass)
 		require.NotNil(t, err, "should error")
 	})
+
+	t.Run("with nested prop", func(t *testing.T) {
+		rootClass := "City"
+		segments := []interface{}{"address.zip.code"}
+		path, err := ParsePath(segments, rootClass)
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, &Path{Class: "City", Property: "address.zip.code"}, path)
+	})
+
+	t.Run("with non-valid nested prop", func(t *testing.T) {
+		rootClass := "City"
+		segments := []interface{}{"address..code"}
+		_, err := ParsePath(segments, rootClass)
+		require.NotNil(t, err, "should error")
+	})
 }
 
 func Test_SlicePath(t *testing.T) {
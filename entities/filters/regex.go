@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// MaxRegexPatternLength limits the length of the pattern of a Regex filter,
+// longer patterns are rejected before they are compiled
+const MaxRegexPatternLength = 1024
+
+// CompileRegexPattern compiles the pattern of a Regex filter. Patterns use the
+// RE2 syntax and, just like the patterns of the Like operator, have to match
+// a whole term, i.e. they are implicitly anchored at both ends.
+func CompileRegexPattern(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) == 0 {
+		return nil, errors.New("operator Regex requires a non-empty pattern")
+	}
+	if len(pattern) > MaxRegexPatternLength {
+		return nil, fmt.Errorf("operator Regex: pattern must not be longer than %d "+
+			"characters, got %d", MaxRegexPatternLength, len(pattern))
+	}
+
+	r, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, errors.Wrap(err, "operator Regex: invalid pattern")
+	}
+	return r, nil
+}
@@ -46,6 +46,9 @@ type InvertedIndexConfig struct {
 
 	// stopwords
 	Stopwords *StopwordConfig `json:"stopwords,omitempty"`
+
+	// synonyms
+	Synonyms *SynonymConfig `json:"synonyms,omitempty"`
 }
 
 // Validate validates this inverted index config
@@ -60,6 +63,10 @@ func (m *InvertedIndexConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSynonyms(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -104,6 +111,25 @@ func (m *InvertedIndexConfig) validateStopwords(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *InvertedIndexConfig) validateSynonyms(formats strfmt.Registry) error {
+	if swag.IsZero(m.Synonyms) { // not required
+		return nil
+	}
+
+	if m.Synonyms != nil {
+		if err := m.Synonyms.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("synonyms")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("synonyms")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this inverted index config based on the context it is used
 func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -116,6 +142,10 @@ func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSynonyms(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -154,6 +184,22 @@ func (m *InvertedIndexConfig) contextValidateStopwords(ctx context.Context, form
 	return nil
 }
 
+func (m *InvertedIndexConfig) contextValidateSynonyms(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Synonyms != nil {
+		if err := m.Synonyms.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("synonyms")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("synonyms")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *InvertedIndexConfig) MarshalBinary() ([]byte, error) {
 	if m == nil {
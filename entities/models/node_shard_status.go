@@ -36,6 +36,12 @@ type NodeShardStatus struct {
 
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
+
+	// The status of the vector indexing process.
+	VectorIndexingStatus string `json:"vectorIndexingStatus,omitempty"`
+
+	// The length of the vector indexing queue.
+	VectorQueueLength int64 `json:"vectorQueueLength,omitempty"`
 }
 
 // Validate validates this node shard status
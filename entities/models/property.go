@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -43,6 +44,21 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Optional. Should the null state of this property be indexed, so that objects without a value for it can be found with the `IsNull` filter operator. Defaults to the class-wide `invertedIndexConfig.indexNullState` setting. Applicable only to properties with indexFilterable or indexSearchable enabled
+	IndexNullState *bool `json:"indexNullState,omitempty"`
+
+	// Optional. Should the positions of the terms of this property be stored in the inverted index. Defaults to false. Applicable only to properties of data type text and text[] with indexSearchable enabled. Required to search for quoted phrases in bm25 or hybrid search, e.g. `"climate change"`
+	IndexPositions *bool `json:"indexPositions,omitempty"`
+
+	// Optional. Should the length of this property be indexed, so that objects can be filtered by it with `len(property)` where filters. Defaults to the class-wide `invertedIndexConfig.indexPropertyLength` setting. Applicable only to properties with indexFilterable or indexSearchable enabled and a data type that has a length, i.e. not int, number, boolean, date, geoCoordinates, phoneNumber and blob
+	IndexPropertyLength *bool `json:"indexPropertyLength,omitempty"`
+
+	// Optional. Should the values of this property be stored in an index optimized for range filters. Defaults to false. Applicable only to properties of data type int, number and date. Serves filters like `valueDate > X` without expanding them to all matching values, which is considerably faster for large classes
+	IndexRangeFilters *bool `json:"indexRangeFilters,omitempty"`
+
+	// Optional. Should the terms of this property also be stored reversed in the inverted index. Defaults to false. Applicable only to properties of data type text and text[] with indexFilterable enabled. Allows like filters starting with a wildcard, e.g. `*base`, to seek the matching terms instead of scanning all of them
+	IndexReversedTerms *bool `json:"indexReversedTerms,omitempty"`
+
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules
 	IndexSearchable *bool `json:"indexSearchable,omitempty"`
 
@@ -52,8 +68,14 @@ type Property struct {
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
-	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
-	// Enum: [word lowercase whitespace field]
+	// The nested properties of a property of data type `object` or `object[]`. Required for these data types and not supported for any other
+	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
+
+	// Optional. Reduces the words of text and text[] properties to their stem, so that e.g. `running` and `runs` both match `run` in bm25 and where filters. Applied when indexing and querying, cannot be changed afterwards. Allowed values are `en` and `de`, stemming is disabled if not set. Not supported for `field`, `trigram`, `gse` and `kagome_ja` tokenization
+	Stemmer string `json:"stemmer,omitempty"`
+
+	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims), `trigram` (splits on any non-alphanumerical, lowercases and indexes all sequences of three characters of each word, to match misspellings and partial words, not supported by the Like and Regex operators), `gse` (splits Chinese and Japanese text into all dictionary words it contains), `kagome_ja` (splits Japanese text into words by morphological analysis). Not supported for remaining data types
+	// Enum: [word lowercase whitespace field trigram gse kagome_ja]
 	Tokenization string `json:"tokenization,omitempty"`
 }
 
@@ -61,6 +83,10 @@ type Property struct {
 func (m *Property) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateNestedProperties(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTokenization(formats); err != nil {
 		res = append(res, err)
 	}
@@ -71,11 +97,37 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Property) validateNestedProperties(formats strfmt.Registry) error {
+	if swag.IsZero(m.NestedProperties) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+		if swag.IsZero(m.NestedProperties[i]) { // not required
+			continue
+		}
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var propertyTypeTokenizationPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field","trigram","gse","kagome_ja"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -96,6 +148,15 @@ const (
 
 	// PropertyTokenizationField captures enum value "field"
 	PropertyTokenizationField string = "field"
+
+	// PropertyTokenizationTrigram captures enum value "trigram"
+	PropertyTokenizationTrigram string = "trigram"
+
+	// PropertyTokenizationGse captures enum value "gse"
+	PropertyTokenizationGse string = "gse"
+
+	// PropertyTokenizationKagomeJa captures enum value "kagome_ja"
+	PropertyTokenizationKagomeJa string = "kagome_ja"
 )
 
 // prop value enum
@@ -119,8 +180,37 @@ func (m *Property) validateTokenization(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this property based on context it is used
+// ContextValidate validate this property based on the context it is used
 func (m *Property) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNestedProperties(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Property) contextValidateNestedProperties(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -32,6 +32,13 @@ type AdditionalPropertyWithSearchVector interface {
 	SetSearchVector(vector []float32)
 }
 
+// AdditionalPropertyWithModule defines additional property params
+// with the ability to choose the module computing the property
+// instead of the one configured for the class
+type AdditionalPropertyWithModule interface {
+	ModuleName() string
+}
+
 // AdditionalPropertyFn defines interface for additional property
 // functions performing given logic
 type AdditionalPropertyFn = func(ctx context.Context,
@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 )
@@ -37,6 +38,9 @@ type ClassifyParams struct {
 	Filters           Filters
 	UnclassifiedItems []search.Result
 	VectorRepo        VectorClassSearchRepo
+	// ClassConfig holds the classifying module's settings of the class that
+	// is being classified, it is set by the modules provider
+	ClassConfig moduletools.ClassConfig
 }
 
 type Filters interface {
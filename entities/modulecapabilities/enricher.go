@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// ObjectEnricher writes properties derived from the other properties of an
+// object at import time, so that they can be filtered and aggregated on
+type ObjectEnricher interface {
+	// EnrichedProperties returns the properties the module writes for the
+	// given class config, they are added to the class when it's created
+	EnrichedProperties(cfg moduletools.ClassConfig) []*models.Property
+	// EnrichObject sets the enriched properties on the object
+	EnrichObject(ctx context.Context, object *models.Object,
+		cfg moduletools.ClassConfig) error
+}
@@ -29,6 +29,15 @@ type Vectorizer interface {
 		cfg moduletools.ClassConfig) error
 }
 
+// VectorDimensionsProvider is implemented by vectorizers which can tell the
+// number of dimensions of the vectors they produce for a class ahead of time,
+// so that it can be checked against the vector index config on schema changes
+type VectorDimensionsProvider interface {
+	// VectorDimensions returns false if the dimensions are not known, e.g.
+	// because they depend on a model hosted by a third party
+	VectorDimensions(cfg moduletools.ClassConfig) (int, bool)
+}
+
 type FindObjectFn = func(ctx context.Context, class string, id strfmt.UUID,
 	props search.SelectProperties, adds additional.Properties, tenant string) (*search.Result, error)
 
@@ -42,6 +51,29 @@ type ReferenceVectorizer interface {
 		cfg moduletools.ClassConfig, findObjectFn FindObjectFn) error
 }
 
+// ReferenceChange describes a reference which was added to or removed from
+// an object's reference property
+type ReferenceChange struct {
+	Property string
+	Beacon   strfmt.URI
+	// Count is the number of times the reference was added to the property if
+	// positive, or removed from it if negative
+	Count int
+}
+
+// IncrementalReferenceVectorizer is implemented by ref2vec modules which can
+// update an object's vector from a single changed reference, without finding
+// all other referenced objects again
+type IncrementalReferenceVectorizer interface {
+	// UpdateObjectVector should mutate the vector of the object, which already
+	// contains the change in its properties. It returns false if the change
+	// cannot be applied incrementally, in which case the vector needs to be
+	// calculated from all references instead
+	UpdateObjectVector(ctx context.Context, object *models.Object,
+		change ReferenceChange, cfg moduletools.ClassConfig,
+		findObjectFn FindObjectFn) (bool, error)
+}
+
 type InputVectorizer interface {
 	VectorizeInput(ctx context.Context, input string,
 		cfg moduletools.ClassConfig) ([]float32, error)
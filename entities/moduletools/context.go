@@ -0,0 +1,27 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package moduletools
+
+import "context"
+
+// GetValueFromContext returns the first value of a request header which has
+// been injected into the context, e.g. an API key passed with the
+// X-Openai-Api-Key header. An empty string is returned if the header was not
+// set.
+func GetValueFromContext(ctx context.Context, key string) string {
+	if value := ctx.Value(key); value != nil {
+		if keyHeader, ok := value.([]string); ok && len(keyHeader) > 0 {
+			return keyHeader[0]
+		}
+	}
+	return ""
+}
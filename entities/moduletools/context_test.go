@@ -0,0 +1,37 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package moduletools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetValueFromContext(t *testing.T) {
+	t.Run("header is set", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "X-Openai-Api-Key", []string{"key"})
+
+		assert.Equal(t, "key", GetValueFromContext(ctx, "X-Openai-Api-Key"))
+	})
+
+	t.Run("header is set but empty", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "X-Openai-Api-Key", []string{})
+
+		assert.Equal(t, "", GetValueFromContext(ctx, "X-Openai-Api-Key"))
+	})
+
+	t.Run("header is not set", func(t *testing.T) {
+		assert.Equal(t, "", GetValueFromContext(context.Background(), "X-Openai-Api-Key"))
+	})
+}
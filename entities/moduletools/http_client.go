@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package moduletools
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+)
+
+// HTTPClientOptions configure how a module connects to its external API,
+// e.g. through a corporate proxy which presents certificates of a private
+// CA. The zero value uses the default transport.
+type HTTPClientOptions struct {
+	// ProxyURL is the http, https or socks5 proxy all requests are sent through
+	ProxyURL string
+	// CABundle is the path of a PEM file with certificates which are trusted
+	// in addition to the system's root CAs
+	CABundle           string
+	InsecureSkipVerify bool
+}
+
+// HTTPClientOptionsFromEnv reads the options from the <prefix>_PROXY_URL,
+// <prefix>_CA_BUNDLE and <prefix>_TLS_INSECURE_SKIP_VERIFY environment
+// variables
+func HTTPClientOptionsFromEnv(prefix string) (HTTPClientOptions, error) {
+	options := HTTPClientOptions{
+		ProxyURL: os.Getenv(prefix + "_PROXY_URL"),
+		CABundle: os.Getenv(prefix + "_CA_BUNDLE"),
+	}
+
+	if v := os.Getenv(prefix + "_TLS_INSECURE_SKIP_VERIFY"); v != "" {
+		asBool, err := strconv.ParseBool(v)
+		if err != nil {
+			return options, fmt.Errorf("%s_TLS_INSECURE_SKIP_VERIFY must be a boolean, got %q", prefix, v)
+		}
+		options.InsecureSkipVerify = asBool
+	}
+
+	if err := options.Validate(); err != nil {
+		return options, fmt.Errorf("%s http client: %w", prefix, err)
+	}
+	return options, nil
+}
+
+// Override returns the options with every value which is set in other taking
+// precedence, e.g. class settings overriding the environment
+func (o HTTPClientOptions) Override(other HTTPClientOptions) HTTPClientOptions {
+	if other.ProxyURL != "" {
+		o.ProxyURL = other.ProxyURL
+	}
+	if other.CABundle != "" {
+		o.CABundle = other.CABundle
+	}
+	if other.InsecureSkipVerify {
+		o.InsecureSkipVerify = true
+	}
+	return o
+}
+
+func (o HTTPClientOptions) Validate() error {
+	if _, err := o.proxyURL(); err != nil {
+		return err
+	}
+	if _, err := o.rootCAs(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// NewHTTPTransport returns the transport which applies the options. It
+// returns nil for the zero value, so that http.DefaultTransport is used.
+func NewHTTPTransport(o HTTPClientOptions) (http.RoundTripper, error) {
+	if o == (HTTPClientOptions{}) {
+		return nil, nil
+	}
+
+	proxyURL, err := o.proxyURL()
+	if err != nil {
+		return nil, err
+	}
+	rootCAs, err := o.rootCAs()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if rootCAs != nil || o.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:            rootCAs,
+			InsecureSkipVerify: o.InsecureSkipVerify,
+		}
+	}
+	return transport, nil
+}
+
+func (o HTTPClientOptions) proxyURL() (*url.URL, error) {
+	if o.ProxyURL == "" {
+		return nil, nil
+	}
+
+	parsed, err := url.Parse(o.ProxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL: scheme must be one of http, https, socks5, got %q",
+			parsed.Scheme)
+	}
+	if parsed.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL: missing host")
+	}
+	return parsed, nil
+}
+
+func (o HTTPClientOptions) rootCAs() (*x509.CertPool, error) {
+	if o.CABundle == "" {
+		return nil, nil
+	}
+
+	pem, err := os.ReadFile(o.CABundle)
+	if err != nil {
+		return nil, fmt.Errorf("read CA bundle: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("CA bundle %s contains no PEM encoded certificates", o.CABundle)
+	}
+	return pool, nil
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package moduletools
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClientOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	require.Nil(t, os.WriteFile(caBundle, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}), 0o600))
+
+	get := func(t *testing.T, options HTTPClientOptions) error {
+		transport, err := NewHTTPTransport(options)
+		require.Nil(t, err)
+		res, err := (&http.Client{Transport: transport}).Get(server.URL)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+
+	t.Run("zero value uses the default transport", func(t *testing.T) {
+		transport, err := NewHTTPTransport(HTTPClientOptions{})
+		require.Nil(t, err)
+		assert.Nil(t, transport)
+	})
+
+	t.Run("private CA is not trusted by default", func(t *testing.T) {
+		assert.NotNil(t, get(t, HTTPClientOptions{}))
+	})
+
+	t.Run("private CA is trusted with CA bundle", func(t *testing.T) {
+		assert.Nil(t, get(t, HTTPClientOptions{CABundle: caBundle}))
+	})
+
+	t.Run("certificate is not verified with skip verify", func(t *testing.T) {
+		assert.Nil(t, get(t, HTTPClientOptions{InsecureSkipVerify: true}))
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		assert.EqualError(t, HTTPClientOptions{ProxyURL: "ftp://proxy:21"}.Validate(),
+			`invalid proxy URL: scheme must be one of http, https, socks5, got "ftp"`)
+		assert.EqualError(t, HTTPClientOptions{ProxyURL: "http://"}.Validate(),
+			"invalid proxy URL: missing host")
+		assert.NotNil(t, HTTPClientOptions{CABundle: filepath.Join(t.TempDir(), "missing.pem")}.Validate())
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("TEST_PROXY_URL", "http://proxy.example:3128")
+		t.Setenv("TEST_CA_BUNDLE", caBundle)
+		t.Setenv("TEST_TLS_INSECURE_SKIP_VERIFY", "true")
+
+		options, err := HTTPClientOptionsFromEnv("TEST")
+		require.Nil(t, err)
+		assert.Equal(t, HTTPClientOptions{
+			ProxyURL:           "http://proxy.example:3128",
+			CABundle:           caBundle,
+			InsecureSkipVerify: true,
+		}, options)
+
+		t.Setenv("TEST_TLS_INSECURE_SKIP_VERIFY", "maybe")
+		_, err = HTTPClientOptionsFromEnv("TEST")
+		assert.EqualError(t, err, `TEST_TLS_INSECURE_SKIP_VERIFY must be a boolean, got "maybe"`)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		env := HTTPClientOptions{ProxyURL: "http://env:3128", CABundle: "/env/ca.pem"}
+		class := HTTPClientOptions{ProxyURL: "http://class:3128"}
+
+		assert.Equal(t, HTTPClientOptions{
+			ProxyURL: "http://class:3128",
+			CABundle: "/env/ca.pem",
+		}, env.Override(class))
+	})
+}
@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package moduletools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries        = 3
+	DefaultRetryInitialDelay = time.Second
+	DefaultRetryMaxDelay     = 30 * time.Second
+)
+
+// RetryPolicy describes how often and for how long a module backs off before
+// retrying a request to an external API which failed with a transient error.
+// The zero value disables retries.
+type RetryPolicy struct {
+	MaxRetries   int
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:   DefaultMaxRetries,
+		InitialDelay: DefaultRetryInitialDelay,
+		MaxDelay:     DefaultRetryMaxDelay,
+	}
+}
+
+// RetryPolicyFromEnv starts with the default policy and overrides it with
+// the values of the <prefix>_MAX_RETRIES, <prefix>_RETRY_INITIAL_DELAY and
+// <prefix>_RETRY_MAX_DELAY environment variables if they are set
+func RetryPolicyFromEnv(prefix string) (RetryPolicy, error) {
+	policy := DefaultRetryPolicy()
+
+	if v := os.Getenv(prefix + "_MAX_RETRIES"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil || asInt < 0 {
+			return policy, fmt.Errorf("%s_MAX_RETRIES must be a non-negative integer, got %q", prefix, v)
+		}
+		policy.MaxRetries = asInt
+	}
+
+	if v := os.Getenv(prefix + "_RETRY_INITIAL_DELAY"); v != "" {
+		asDuration, err := time.ParseDuration(v)
+		if err != nil || asDuration <= 0 {
+			return policy, fmt.Errorf("%s_RETRY_INITIAL_DELAY must be a positive duration, got %q", prefix, v)
+		}
+		policy.InitialDelay = asDuration
+	}
+
+	if v := os.Getenv(prefix + "_RETRY_MAX_DELAY"); v != "" {
+		asDuration, err := time.ParseDuration(v)
+		if err != nil || asDuration <= 0 {
+			return policy, fmt.Errorf("%s_RETRY_MAX_DELAY must be a positive duration, got %q", prefix, v)
+		}
+		policy.MaxDelay = asDuration
+	}
+
+	if policy.MaxDelay < policy.InitialDelay {
+		policy.MaxDelay = policy.InitialDelay
+	}
+
+	return policy, nil
+}
+
+// RetryableError marks an error as transient, so that the request which
+// caused it can be retried. If the server told us when to retry, retryAfter
+// is the minimal delay before the next attempt.
+type RetryableError struct {
+	err        error
+	retryAfter time.Duration
+}
+
+func NewRetryableError(err error, retryAfter time.Duration) error {
+	return &RetryableError{err: err, retryAfter: retryAfter}
+}
+
+func (e *RetryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *RetryableError) Unwrap() error {
+	return e.err
+}
+
+// IsRetryableStatusCode reports whether a request which failed with the given
+// status code is worth retrying, i.e. it was rate limited or the server had
+// a temporary issue
+func IsRetryableStatusCode(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// RetryAfter parses the Retry-After header if it is given in seconds
+func RetryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// Do calls fn until it succeeds, fails with an error which is not a
+// RetryableError, the retry budget is exhausted or the context expires. The
+// last error returned by fn is passed on to the caller.
+func (p RetryPolicy) Do(ctx context.Context, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+
+		var retryable *RetryableError
+		if err == nil || !errors.As(err, &retryable) || attempt >= p.MaxRetries {
+			return err
+		}
+
+		delay := p.backoff(attempt)
+		if retryable.retryAfter > delay {
+			delay = retryable.retryAfter
+			if p.MaxDelay > 0 && delay > p.MaxDelay {
+				delay = p.MaxDelay
+			}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff doubles the delay with every attempt up to the max delay. Half of
+// the delay is randomized, so that concurrent callers don't retry in lockstep.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.MaxDelay
+	if attempt < 32 {
+		if d := p.InitialDelay << attempt; d > 0 && d < p.MaxDelay {
+			delay = d
+		}
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package moduletools
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries:   3,
+		InitialDelay: time.Millisecond,
+		MaxDelay:     5 * time.Millisecond,
+	}
+
+	t.Run("succeeds after transient errors", func(t *testing.T) {
+		calls := 0
+		err := policy.Do(context.Background(), func() error {
+			calls++
+			if calls < 3 {
+				return NewRetryableError(errors.New("429"), 0)
+			}
+			return nil
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("gives up once the budget is exhausted", func(t *testing.T) {
+		calls := 0
+		err := policy.Do(context.Background(), func() error {
+			calls++
+			return NewRetryableError(errors.New("503"), 0)
+		})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "503")
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		calls := 0
+		err := policy.Do(context.Background(), func() error {
+			calls++
+			return errors.New("401")
+		})
+
+		require.NotNil(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("zero value does not retry", func(t *testing.T) {
+		calls := 0
+		err := RetryPolicy{}.Do(context.Background(), func() error {
+			calls++
+			return NewRetryableError(errors.New("503"), 0)
+		})
+
+		require.NotNil(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops when the context expires", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		calls := 0
+		err := RetryPolicy{MaxRetries: 3, InitialDelay: time.Hour, MaxDelay: time.Hour}.
+			Do(ctx, func() error {
+				calls++
+				return NewRetryableError(errors.New("503"), 0)
+			})
+
+		require.NotNil(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("backoff stays within bounds", func(t *testing.T) {
+		p := RetryPolicy{MaxRetries: 100, InitialDelay: time.Second, MaxDelay: 30 * time.Second}
+		for attempt := 0; attempt < 100; attempt++ {
+			delay := p.backoff(attempt)
+			assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+			assert.LessOrEqual(t, delay, 30*time.Second)
+		}
+	})
+}
+
+func TestRetryHelpers(t *testing.T) {
+	assert.True(t, IsRetryableStatusCode(http.StatusTooManyRequests))
+	assert.True(t, IsRetryableStatusCode(http.StatusServiceUnavailable))
+	assert.False(t, IsRetryableStatusCode(http.StatusUnauthorized))
+
+	header := http.Header{}
+	assert.Equal(t, time.Duration(0), RetryAfter(header))
+	header.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, RetryAfter(header))
+}
+
+func TestRetryPolicyFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		policy, err := RetryPolicyFromEnv("TEST_MODULE")
+		require.Nil(t, err)
+		assert.Equal(t, DefaultRetryPolicy(), policy)
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		t.Setenv("TEST_MODULE_MAX_RETRIES", "5")
+		t.Setenv("TEST_MODULE_RETRY_INITIAL_DELAY", "200ms")
+		t.Setenv("TEST_MODULE_RETRY_MAX_DELAY", "10s")
+
+		policy, err := RetryPolicyFromEnv("TEST_MODULE")
+		require.Nil(t, err)
+		assert.Equal(t, RetryPolicy{
+			MaxRetries:   5,
+			InitialDelay: 200 * time.Millisecond,
+			MaxDelay:     10 * time.Second,
+		}, policy)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("TEST_MODULE_MAX_RETRIES", "many")
+
+		_, err := RetryPolicyFromEnv("TEST_MODULE")
+		assert.NotNil(t, err)
+	})
+}
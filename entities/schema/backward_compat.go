@@ -37,11 +37,19 @@ func GetClassByName(s *models.Schema, className string) (*models.Class, error) {
 
 // GetPropertyByName returns the class by its name
 func GetPropertyByName(c *models.Class, propName string) (*models.Property, error) {
+	rootName := strings.Split(propName, NestedPropertySeparator)[0]
 	// For each class-property
 	for _, prop := range c.Properties {
 		// Check if the name of the property is the given name, that's the property we need
-		if prop.Name == strings.Split(propName, ".")[0] {
-			return prop, nil
+		if prop.Name == rootName {
+			if propName == rootName {
+				return prop, nil
+			}
+			// a dot path into an object resolves to the nested property
+			if IsNestedDataType(prop.DataType) {
+				return GetNestedPropertyByPath(c, propName)
+			}
+			break
 		}
 	}
 
@@ -113,7 +121,9 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeIntArray),
 		string(DataTypeNumberArray),
 		string(DataTypeBooleanArray),
-		string(DataTypeDateArray):
+		string(DataTypeDateArray),
+		string(DataTypeObject),
+		string(DataTypeObjectArray):
 		return true
 	}
 	return false
@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NestedPropertySeparator separates the names of a property and its nested
+// properties in a dot path, e.g. address.city
+const NestedPropertySeparator = "."
+
+func IsNestedDataType(dt []string) bool {
+	_, ok := AsNested(dt)
+	return ok
+}
+
+// FlattenNestedProperties returns a property for every nested property of
+// the given object or object[] property which holds a primitive value. The
+// properties are named by their dot path and can be indexed and filtered like
+// any other property. A nested property with an object[] ancestor holds one
+// value per element of the array, so it becomes the array version of its data
+// type. Nested properties which cannot be indexed, i.e. blobs, are omitted.
+func FlattenNestedProperties(prop *models.Property) []*models.Property {
+	if !IsNestedDataType(prop.DataType) {
+		return nil
+	}
+
+	var out []*models.Property
+	flattenNestedProperties(prop.Name, prop.NestedProperties,
+		DataType(prop.DataType[0]) == DataTypeObjectArray,
+		prop.IndexFilterable, prop.IndexSearchable, &out)
+	return out
+}
+
+// flattenNestedProperties appends the flattened nested properties to out. The
+// filterable and searchable flags of the ancestors are passed down, as
+// disabling an index of an object disables it for all its nested properties.
+func flattenNestedProperties(path string, nestedProps []*models.NestedProperty,
+	inArray bool, filterable, searchable *bool, out *[]*models.Property,
+) {
+	for _, np := range nestedProps {
+		if len(np.DataType) != 1 {
+			continue
+		}
+
+		npPath := path + NestedPropertySeparator + np.Name
+		npFilterable := nestedIndexFlag(filterable, np.IndexFilterable)
+		npSearchable := nestedIndexFlag(searchable, np.IndexSearchable)
+
+		dt := DataType(np.DataType[0])
+		switch dt {
+		case DataTypeObject, DataTypeObjectArray:
+			flattenNestedProperties(npPath, np.NestedProperties,
+				inArray || dt == DataTypeObjectArray, npFilterable, npSearchable, out)
+			continue
+		case DataTypeBlob:
+			continue
+		}
+
+		if _, isArray := IsArrayType(dt); inArray && !isArray {
+			dt = arrayOf(dt)
+		}
+
+		*out = append(*out, &models.Property{
+			Name:            npPath,
+			DataType:        dt.PropString(),
+			Description:     np.Description,
+			Tokenization:    np.Tokenization,
+			IndexFilterable: npFilterable,
+			IndexSearchable: npSearchable,
+			// neither the null state nor the length of nested properties are indexed
+			IndexNullState:      falsePtr(),
+			IndexPropertyLength: falsePtr(),
+		})
+	}
+}
+
+// GetNestedPropertyByPath returns the flattened property (see
+// [FlattenNestedProperties]) of the nested property at the given dot path,
+// e.g. address.city
+func GetNestedPropertyByPath(class *models.Class, path string) (*models.Property, error) {
+	rootName, _, ok := strings.Cut(path, NestedPropertySeparator)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a path to a nested property", path)
+	}
+
+	for _, prop := range class.Properties {
+		if prop.Name != rootName {
+			continue
+		}
+		for _, flat := range FlattenNestedProperties(prop) {
+			if flat.Name == path {
+				return flat, nil
+			}
+		}
+		break
+	}
+
+	return nil, fmt.Errorf(ErrorNoSuchProperty, path, class.Class)
+}
+
+// GetNestedPropertyValues returns the values of the nested property at the
+// given path, relative to the object or object[] value of its root
+// property. Values nested in arrays of objects are collected in order.
+func GetNestedPropertyValues(value interface{}, path []string) []interface{} {
+	if len(path) == 0 {
+		if asSlice, ok := value.([]interface{}); ok {
+			return asSlice
+		}
+		if value == nil {
+			return nil
+		}
+		return []interface{}{value}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return GetNestedPropertyValues(v[path[0]], path[1:])
+	case []interface{}:
+		var out []interface{}
+		for _, elem := range v {
+			out = append(out, GetNestedPropertyValues(elem, path)...)
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// nestedIndexFlag disables the index of a nested property if it is disabled
+// for its parent, otherwise the setting of the nested property applies
+func nestedIndexFlag(root, nested *bool) *bool {
+	if root != nil && !*root {
+		return falsePtr()
+	}
+	return nested
+}
+
+func arrayOf(dt DataType) DataType {
+	switch dt {
+	case DataTypeText:
+		return DataTypeTextArray
+	case DataTypeInt:
+		return DataTypeIntArray
+	case DataTypeNumber:
+		return DataTypeNumberArray
+	case DataTypeBoolean:
+		return DataTypeBooleanArray
+	case DataTypeDate:
+		return DataTypeDateArray
+	case DataTypeUUID:
+		return DataTypeUUIDArray
+	default:
+		return dt
+	}
+}
+
+func falsePtr() *bool {
+	f := false
+	return &f
+}
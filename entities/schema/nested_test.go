@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func nestedTestClass() *models.Class {
+	vFalse := false
+	return &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: DataTypeText.PropString(),
+			},
+			{
+				Name:     "address",
+				DataType: DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: DataTypeText.PropString()},
+					{Name: "zip", DataType: DataTypeInt.PropString(), IndexFilterable: &vFalse},
+					{Name: "photo", DataType: DataTypeBlob.PropString()},
+				},
+			},
+			{
+				Name:            "pets",
+				DataType:        DataTypeObjectArray.PropString(),
+				IndexFilterable: &vFalse,
+				NestedProperties: []*models.NestedProperty{
+					{Name: "kind", DataType: DataTypeText.PropString()},
+					{
+						Name:     "vet",
+						DataType: DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "visits", DataType: DataTypeDate.PropString()},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestFlattenNestedProperties(t *testing.T) {
+	class := nestedTestClass()
+
+	t.Run("primitive property", func(t *testing.T) {
+		assert.Nil(t, FlattenNestedProperties(class.Properties[0]))
+	})
+
+	t.Run("object property", func(t *testing.T) {
+		flat := FlattenNestedProperties(class.Properties[1])
+
+		require.Len(t, flat, 2)
+		assert.Equal(t, "address.city", flat[0].Name)
+		assert.Equal(t, DataTypeText.PropString(), flat[0].DataType)
+		assert.Nil(t, flat[0].IndexFilterable)
+		assert.Equal(t, "address.zip", flat[1].Name)
+		assert.Equal(t, DataTypeInt.PropString(), flat[1].DataType)
+		assert.False(t, *flat[1].IndexFilterable)
+		for _, prop := range flat {
+			assert.False(t, *prop.IndexNullState)
+			assert.False(t, *prop.IndexPropertyLength)
+		}
+	})
+
+	t.Run("object[] property", func(t *testing.T) {
+		flat := FlattenNestedProperties(class.Properties[2])
+
+		require.Len(t, flat, 2)
+		assert.Equal(t, "pets.kind", flat[0].Name)
+		assert.Equal(t, DataTypeTextArray.PropString(), flat[0].DataType)
+		assert.Equal(t, "pets.vet.visits", flat[1].Name)
+		assert.Equal(t, DataTypeDateArray.PropString(), flat[1].DataType)
+		for _, prop := range flat {
+			// disabled for the root, so disabled for all nested properties
+			assert.False(t, *prop.IndexFilterable)
+		}
+	})
+}
+
+func TestGetPropertyByNameWithNestedPath(t *testing.T) {
+	class := nestedTestClass()
+
+	prop, err := GetPropertyByName(class, "address")
+	require.Nil(t, err)
+	assert.Equal(t, "address", prop.Name)
+
+	prop, err = GetPropertyByName(class, "address.city")
+	require.Nil(t, err)
+	assert.Equal(t, "address.city", prop.Name)
+
+	dt, err := GetPropertyDataType(class, "pets.vet.visits")
+	require.Nil(t, err)
+	assert.Equal(t, DataTypeDateArray, *dt)
+
+	for _, path := range []string{"address.photo", "address.street", "name.first", "pets.vet"} {
+		_, err = GetPropertyByName(class, path)
+		assert.NotNil(t, err, path)
+	}
+}
+
+func TestGetNestedPropertyValues(t *testing.T) {
+	value := []interface{}{
+		map[string]interface{}{
+			"kind": "cat",
+			"vet":  map[string]interface{}{"name": "Dr. Who"},
+		},
+		map[string]interface{}{
+			"kind": "dog",
+		},
+		map[string]interface{}{
+			"kind": "bird",
+			"vet":  map[string]interface{}{"name": "Dr. No"},
+		},
+	}
+
+	assert.Equal(t, []interface{}{"cat", "dog", "bird"},
+		GetNestedPropertyValues(value, []string{"kind"}))
+	assert.Equal(t, []interface{}{"Dr. Who", "Dr. No"},
+		GetNestedPropertyValues(value, []string{"vet", "name"}))
+	assert.Nil(t, GetNestedPropertyValues(value, []string{"color"}))
+	assert.Equal(t, []interface{}{"cat"},
+		GetNestedPropertyValues(value[0], []string{"kind"}))
+}
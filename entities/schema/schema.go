@@ -39,6 +39,9 @@ type ClassAndProperty struct {
 // Describes the schema that is used in Weaviate.
 type Schema struct {
 	Objects *models.Schema
+
+	// Aliases maps alternative names to the name of an existing class
+	Aliases map[string]string
 }
 
 func Empty() Schema {
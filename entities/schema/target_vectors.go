@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// VectorizerNone is the vectorizer of named vectors which are not vectorized
+// by a module, the user has to provide their vectors at import time
+const VectorizerNone = "none"
+
+var validateTargetVectorNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]{0,229}$`)
+
+// ValidateTargetVectorName validates that this string is a valid name for a
+// named vector. Target vector names are used as GraphQL enum-like arguments
+// and as part of file names, so they follow the property name format.
+func ValidateTargetVectorName(name string) error {
+	if validateTargetVectorNameRegex.MatchString(name) {
+		return nil
+	}
+	return fmt.Errorf("'%s' is not a valid target vector name. "+
+		"Target vector names must match “/[_A-Za-z][_0-9A-Za-z]{0,229}/”.", name)
+}
+
+// HasTargetVectors returns true if the class has named vectors configured
+func HasTargetVectors(class *models.Class) bool {
+	return class != nil && len(class.VectorConfig) > 0
+}
+
+// TargetVectorVectorizer returns the name of the module vectorizing the given
+// named vector along with its module settings. A named vector without a
+// vectorizer is not vectorized by a module.
+func TargetVectorVectorizer(cfg models.VectorConfig) (string, map[string]interface{}, error) {
+	if cfg.Vectorizer == nil {
+		return VectorizerNone, map[string]interface{}{}, nil
+	}
+
+	vectorizer, ok := cfg.Vectorizer.(map[string]interface{})
+	if !ok {
+		return "", nil, fmt.Errorf("vectorizer config must be an object, got %T", cfg.Vectorizer)
+	}
+	if len(vectorizer) != 1 {
+		return "", nil, fmt.Errorf("vectorizer config must contain exactly one vectorizer, got %d",
+			len(vectorizer))
+	}
+
+	for name, settings := range vectorizer {
+		if settings == nil {
+			return name, map[string]interface{}{}, nil
+		}
+		asMap, ok := settings.(map[string]interface{})
+		if !ok {
+			return "", nil, fmt.Errorf("settings of vectorizer %q must be an object, got %T",
+				name, settings)
+		}
+		return name, asMap, nil
+	}
+
+	return VectorizerNone, map[string]interface{}{}, nil
+}
+
+// ClassForTargetVector returns a shallow copy of the class as it is seen by
+// the vectorizer and the vector index of the given named vector: the
+// vectorizer, its class-level module config and the vector index settings
+// are taken from the named vector's config. The original class is not
+// modified.
+func ClassForTargetVector(class *models.Class, targetVector string) (*models.Class, error) {
+	cfg, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("class %s does not have named vector %q",
+			class.Class, targetVector)
+	}
+
+	vectorizer, settings, err := TargetVectorVectorizer(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("named vector %q: %w", targetVector, err)
+	}
+
+	moduleConfig := map[string]interface{}{}
+	if vectorizer != VectorizerNone {
+		moduleConfig[vectorizer] = settings
+	}
+
+	view := *class
+	view.Vectorizer = vectorizer
+	view.ModuleConfig = moduleConfig
+	view.VectorIndexType = cfg.VectorIndexType
+	view.VectorIndexConfig = cfg.VectorIndexConfig
+	view.VectorConfig = nil
+
+	return &view, nil
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidateTargetVectorName(t *testing.T) {
+	for _, name := range []string{"title", "_title", "title_vector2", "A", strings.Repeat("a", 230)} {
+		assert.Nil(t, ValidateTargetVectorName(name), name)
+	}
+
+	for _, name := range []string{"", "2title", "title-vector", "title vector", strings.Repeat("a", 231)} {
+		assert.NotNil(t, ValidateTargetVectorName(name), name)
+	}
+}
+
+func TestClassForTargetVector(t *testing.T) {
+	class := &models.Class{
+		Class:             "Article",
+		Vectorizer:        "text2vec-contextionary",
+		VectorIndexType:   "hnsw",
+		VectorIndexConfig: map[string]interface{}{"distance": "cosine"},
+		ModuleConfig: map[string]interface{}{
+			"text2vec-contextionary": map[string]interface{}{"vectorizeClassName": true},
+		},
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer: map[string]interface{}{
+					"text2vec-openai": map[string]interface{}{"model": "ada"},
+				},
+				VectorIndexType:   "flat",
+				VectorIndexConfig: map[string]interface{}{"distance": "dot"},
+			},
+			"custom": {},
+		},
+	}
+
+	t.Run("with a vectorizer", func(t *testing.T) {
+		view, err := ClassForTargetVector(class, "title")
+		require.Nil(t, err)
+
+		assert.Equal(t, "Article", view.Class)
+		assert.Equal(t, "text2vec-openai", view.Vectorizer)
+		assert.Equal(t, "flat", view.VectorIndexType)
+		assert.Equal(t, map[string]interface{}{"distance": "dot"}, view.VectorIndexConfig)
+		assert.Equal(t, map[string]interface{}{
+			"text2vec-openai": map[string]interface{}{"model": "ada"},
+		}, view.ModuleConfig)
+		assert.Nil(t, view.VectorConfig)
+
+		// the original class is left untouched
+		assert.Equal(t, "text2vec-contextionary", class.Vectorizer)
+		assert.Len(t, class.VectorConfig, 2)
+	})
+
+	t.Run("without a vectorizer", func(t *testing.T) {
+		view, err := ClassForTargetVector(class, "custom")
+		require.Nil(t, err)
+
+		assert.Equal(t, VectorizerNone, view.Vectorizer)
+		assert.Equal(t, map[string]interface{}{}, view.ModuleConfig)
+	})
+
+	t.Run("unknown named vector", func(t *testing.T) {
+		_, err := ClassForTargetVector(class, "body")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("more than one vectorizer", func(t *testing.T) {
+		_, _, err := TargetVectorVectorizer(models.VectorConfig{
+			Vectorizer: map[string]interface{}{"a": nil, "b": nil},
+		})
+		assert.NotNil(t, err)
+	})
+}
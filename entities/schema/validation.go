@@ -14,6 +14,7 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 var (
@@ -51,6 +52,21 @@ func ValidatePropertyName(name string) (PropertyName, error) {
 		"which must be “/[_A-Za-z][_0-9A-Za-z]*/”.", name)
 }
 
+// ValidateNestedPropertyPath validates that this string is a dot path to a
+// nested property, e.g. address.city, made up of valid property names
+func ValidateNestedPropertyPath(path string) (PropertyName, error) {
+	names := strings.Split(path, NestedPropertySeparator)
+	if len(names) < 2 {
+		return "", fmt.Errorf("'%s' is not a path to a nested property", path)
+	}
+	for _, name := range names {
+		if _, err := ValidatePropertyName(name); err != nil {
+			return "", err
+		}
+	}
+	return PropertyName(path), nil
+}
+
 // ValidateReservedPropertyName validates that a string is not a reserved property name
 func ValidateReservedPropertyName(name string) error {
 	for i := range reservedPropertyNames {
@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package searchparams
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePropertyBoost splits a property of a keyword search in the form of
+// "title^3" into the name of the property and its boost, so that matches in
+// the property score higher. Properties without a boost have a boost of 1.
+func ParsePropertyBoost(property string) (string, float32, error) {
+	name, boostStr, hasBoost := strings.Cut(property, "^")
+	if name == "" {
+		return "", 0, fmt.Errorf("property %q has no name", property)
+	}
+	if !hasBoost {
+		return name, 1, nil
+	}
+
+	boost, err := strconv.ParseFloat(boostStr, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("property %q has an invalid boost, "+
+			"expected a number like %s^2: %w", property, name, err)
+	}
+	if boost <= 0 {
+		return "", 0, fmt.Errorf("property %q has an invalid boost, "+
+			"it must be greater than 0", property)
+	}
+	return name, float32(boost), nil
+}
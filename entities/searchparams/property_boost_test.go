@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package searchparams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePropertyBoost(t *testing.T) {
+	t.Run("valid properties", func(t *testing.T) {
+		testCases := []struct {
+			property      string
+			expectedName  string
+			expectedBoost float32
+		}{
+			{property: "title", expectedName: "title", expectedBoost: 1},
+			{property: "title^3", expectedName: "title", expectedBoost: 3},
+			{property: "title^0.5", expectedName: "title", expectedBoost: 0.5},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.property, func(t *testing.T) {
+				name, boost, err := ParsePropertyBoost(tc.property)
+				require.Nil(t, err)
+				assert.Equal(t, tc.expectedName, name)
+				assert.Equal(t, tc.expectedBoost, boost)
+			})
+		}
+	})
+
+	t.Run("invalid properties", func(t *testing.T) {
+		for _, property := range []string{"", "^2", "title^", "title^abc", "title^2^3", "title^0", "title^-1"} {
+			t.Run(property, func(t *testing.T) {
+				_, _, err := ParsePropertyBoost(property)
+				assert.NotNil(t, err)
+			})
+		}
+	})
+}
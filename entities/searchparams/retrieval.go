@@ -23,6 +23,7 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	Autocorrect            bool     `json:"autocorrect"`
 }
 
 type WeightedSearchResult struct {
@@ -39,6 +40,7 @@ type HybridSearch struct {
 	Vector          []float32   `json:"vector"`
 	Properties      []string    `json:"properties"`
 	FusionAlgorithm int         `json:"fusionalgorithm"`
+	Autocorrect     bool        `json:"autocorrect"`
 }
 
 type NearObject struct {
@@ -78,3 +80,16 @@ type GroupBy struct {
 	Groups          int
 	ObjectsPerGroup int
 }
+
+// VectorIndex overrides settings of the vector index for a single query.
+// Settings which don't apply to the vector index type of the class are
+// ignored.
+type VectorIndex struct {
+	// Ef is the size of the dynamic candidate list of hnsw, unset (0) to use
+	// the ef of the index config
+	Ef int `json:"ef"`
+
+	// Rescore enables or disables rescoring the results of a compressed index
+	// with the uncompressed vectors, unset (nil) to use the index config
+	Rescore *bool `json:"rescore"`
+}
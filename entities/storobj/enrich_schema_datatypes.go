@@ -17,14 +17,26 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
-func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
-	if schema == nil {
+func (ko *Object) enrichSchemaTypes(props map[string]interface{}, class *models.Class) error {
+	if props == nil {
 		return nil
 	}
 
-	for propName, value := range schema {
+	for propName, value := range props {
+		if dataType, ok := declaredDataType(class, propName); ok {
+			parsed, ok, err := parseDeclaredProp(value, dataType)
+			if err != nil {
+				return errors.Wrapf(err, "property %q", propName)
+			}
+			if ok {
+				props[propName] = parsed
+				continue
+			}
+		}
+
 		switch typed := value.(type) {
 		case []interface{}:
 			if isArrayValue(typed) {
@@ -35,21 +47,21 @@ func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
 						return errors.Wrapf(err, "property %q of type string array", propName)
 					}
 
-					schema[propName] = parsed
+					props[propName] = parsed
 				case bool:
 					parsed, err := parseBoolArrayValue(typed)
 					if err != nil {
 						return errors.Wrapf(err, "property %q of type boolean array", propName)
 					}
 
-					schema[propName] = parsed
+					props[propName] = parsed
 				default:
 					parsed, err := parseStringArrayValue(typed)
 					if err != nil {
 						return errors.Wrapf(err, "property %q of type string array", propName)
 					}
 
-					schema[propName] = parsed
+					props[propName] = parsed
 				}
 			} else if len(typed) == 0 {
 				// empty arrays. Here we use []interface{} as a placeholder
@@ -57,14 +69,18 @@ func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
 				// actual type. in the future, we should persist the schema
 				// property type information alongside the value to avoid
 				// this situation
-				schema[propName] = typed
+				props[propName] = typed
+			} else if !isCrossRefValue(typed) {
+				// arrays of maps which are not beacons are values of
+				// object[] properties and are kept as they are
+				props[propName] = typed
 			} else {
 				parsed, err := parseCrossRef(typed)
 				if err != nil {
 					return errors.Wrapf(err, "property %q of type cross-ref", propName)
 				}
 
-				schema[propName] = parsed
+				props[propName] = parsed
 			}
 		case map[string]interface{}:
 			parsed, err := parseMapProp(typed)
@@ -72,7 +88,7 @@ func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
 				return errors.Wrapf(err, "property %q of type map", propName)
 			}
 
-			schema[propName] = parsed
+			props[propName] = parsed
 		default:
 			continue
 		}
@@ -81,6 +97,62 @@ func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
 	return nil
 }
 
+// declaredDataType returns the data type the class declares for the
+// property. Objects read without their class have no declared types
+func declaredDataType(class *models.Class, propName string) ([]string, bool) {
+	if class == nil {
+		return nil, false
+	}
+	for _, prop := range class.Properties {
+		if prop.Name == propName {
+			return prop.DataType, true
+		}
+	}
+	return nil, false
+}
+
+// parseDeclaredProp types the value of a property whose shape is ambiguous by
+// its declared data type. It returns false for the data types which can be
+// told apart by their shape alone
+func parseDeclaredProp(value interface{}, dataType []string) (interface{}, bool, error) {
+	if schema.IsRefDataType(dataType) {
+		refs, ok := value.([]interface{})
+		if !ok {
+			return nil, true, fmt.Errorf("expected cross-ref to be array - got %T", value)
+		}
+		if len(refs) == 0 {
+			return refs, true, nil
+		}
+		parsed, err := parseCrossRef(refs)
+		return parsed, true, err
+	}
+
+	if _, ok := schema.AsNested(dataType); ok {
+		// objects may have any keys, including those of geo coordinates,
+		// phone numbers or beacons, they are kept as they are
+		return value, true, nil
+	}
+
+	switch dt, _ := schema.AsPrimitive(dataType); dt {
+	case schema.DataTypeGeoCoordinates:
+		asMap, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, true, fmt.Errorf("expected geo coordinates to be map - got %T", value)
+		}
+		parsed, err := parseGeoProp(asMap["latitude"], asMap["longitude"])
+		return parsed, true, err
+	case schema.DataTypePhoneNumber:
+		asMap, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, true, fmt.Errorf("expected phone number to be map - got %T", value)
+		}
+		parsed, err := parsePhoneNumber(asMap)
+		return parsed, true, err
+	default:
+		return nil, false, nil
+	}
+}
+
 func parseMapProp(input map[string]interface{}) (interface{}, error) {
 	lat, latOK := input["latitude"]
 	lon, lonOK := input["longitude"]
@@ -96,7 +168,8 @@ func parseMapProp(input map[string]interface{}) (interface{}, error) {
 		return parsePhoneNumber(input)
 	}
 
-	return nil, fmt.Errorf("unknown map prop which is not a geo prop or phone: %v", input)
+	// neither geo nor phone, so this is the value of an object property
+	return input, nil
 }
 
 func parseGeoProp(lat interface{}, lon interface{}) (*models.GeoCoordinates, error) {
@@ -215,6 +288,15 @@ func isArrayValue(value []interface{}) bool {
 	return false
 }
 
+func isCrossRefValue(value []interface{}) bool {
+	asMap, ok := value[0].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = asMap["beacon"]
+	return ok
+}
+
 func parseStringArrayValue(value []interface{}) ([]string, error) {
 	parsed := make([]string, len(value))
 	for i := range value {
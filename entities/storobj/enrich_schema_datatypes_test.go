@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package storobj
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestEnrichSchemaTypesByDeclaredDataTypes(t *testing.T) {
+	class := &models.Class{
+		Class: "Place",
+		Properties: []*models.Property{
+			{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+			{Name: "phone", DataType: schema.DataTypePhoneNumber.PropString()},
+			{Name: "ofPlace", DataType: []string{"Place"}},
+			{Name: "area", DataType: schema.DataTypeObject.PropString()},
+			{Name: "contact", DataType: schema.DataTypeObject.PropString()},
+			{Name: "links", DataType: schema.DataTypeObjectArray.PropString()},
+		},
+	}
+
+	area := map[string]interface{}{
+		"latitude":  float64(52.37),
+		"longitude": float64(4.89),
+		"name":      "Amsterdam",
+	}
+	contact := map[string]interface{}{
+		"input":          "020 1234567",
+		"defaultCountry": "nl",
+		"department":     "sales",
+	}
+	links := []interface{}{
+		map[string]interface{}{
+			"beacon": "https://example.com/place",
+			"label":  "website",
+		},
+	}
+
+	before := FromObject(&models.Object{
+		Class: "Place",
+		ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+		Properties: map[string]interface{}{
+			"location": &models.GeoCoordinates{
+				Latitude:  ptFloat32(52.37),
+				Longitude: ptFloat32(4.89),
+			},
+			"phone": &models.PhoneNumber{Input: "020 1234567", DefaultCountry: "nl"},
+			"ofPlace": models.MultipleRef{
+				{Beacon: "weaviate://localhost/Place/73f2eb5f-5abf-447a-81ca-74b1dd168247"},
+			},
+			"area":    area,
+			"contact": contact,
+			"links":   links,
+		},
+	}, nil)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	assertDeclaredTypes := func(t *testing.T, after *Object) {
+		props := after.Properties().(map[string]interface{})
+		assert.Equal(t, before.Properties().(map[string]interface{})["location"], props["location"])
+		assert.Equal(t, before.Properties().(map[string]interface{})["phone"], props["phone"])
+		assert.Equal(t, before.Properties().(map[string]interface{})["ofPlace"], props["ofPlace"])
+	}
+
+	t.Run("object with latitude and longitude keys", func(t *testing.T) {
+		after, err := FromBinaryWithClass(asBinary, class)
+		require.Nil(t, err)
+
+		assert.Equal(t, area, after.Properties().(map[string]interface{})["area"])
+		assertDeclaredTypes(t, after)
+	})
+
+	t.Run("object with phone number keys", func(t *testing.T) {
+		after, err := FromBinaryWithClass(asBinary, class)
+		require.Nil(t, err)
+
+		assert.Equal(t, contact, after.Properties().(map[string]interface{})["contact"])
+		assertDeclaredTypes(t, after)
+	})
+
+	t.Run("object[] with a beacon key in the first element", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, class)
+		require.Nil(t, err)
+
+		assert.Equal(t, links, after.Properties().(map[string]interface{})["links"])
+		assertDeclaredTypes(t, after)
+	})
+
+	t.Run("without the class the shape decides", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+
+		props := after.Properties().(map[string]interface{})
+		assert.IsType(t, &models.GeoCoordinates{}, props["area"])
+		assert.IsType(t, &models.PhoneNumber{}, props["contact"])
+		assert.IsType(t, models.MultipleRef{}, props["links"])
+	})
+}
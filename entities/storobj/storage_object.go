@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 
 	"github.com/buger/jsonparser"
 
@@ -41,6 +42,9 @@ type Object struct {
 	BelongsToShard    string        `json:"-"`
 	IsConsistent      bool          `json:"-"`
 
+	// Vectors holds the named vectors of the object, keyed by target vector
+	Vectors map[string][]float32 `json:"vectors"`
+
 	docID uint64
 }
 
@@ -63,17 +67,34 @@ func FromObject(object *models.Object, vector []float32) *Object {
 		object.Properties = properties
 	}
 
+	var vectors map[string][]float32
+	if len(object.Vectors) > 0 {
+		vectors = make(map[string][]float32, len(object.Vectors))
+		for name, vec := range object.Vectors {
+			vectors[name] = vec
+		}
+	}
+
 	return &Object{
 		Object:            *object,
 		Vector:            vector,
+		Vectors:           vectors,
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
 	}
 }
 
+// FromBinary unmarshals an object without knowing its class, the types of
+// its property values are derived from their shape
 func FromBinary(data []byte) (*Object, error) {
+	return FromBinaryWithClass(data, nil)
+}
+
+// FromBinaryWithClass unmarshals an object and types its property values by
+// the data types declared in class
+func FromBinaryWithClass(data []byte, class *models.Class) (*Object, error) {
 	ko := &Object{}
-	if err := ko.UnmarshalBinary(data); err != nil {
+	if err := ko.unmarshalBinary(data, class); err != nil {
 		return nil, err
 	}
 
@@ -109,7 +130,7 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 }
 
 func FromBinaryOptional(data []byte,
-	addProp additional.Properties,
+	addProp additional.Properties, class *models.Class,
 ) (*Object, error) {
 	if addProp.NoProps {
 		return FromBinaryUUIDOnly(data)
@@ -180,6 +201,18 @@ func FromBinaryOptional(data []byte,
 	_, err = r.Read(vectorWeights)
 	ec.AddWrap(err, "vector weights")
 
+	// objects written before named vectors were introduced end here
+	var targetVectors []byte
+	if r.Len() > 0 {
+		var targetVectorsLength uint32
+		ec.AddWrap(binary.Read(r, le, &targetVectorsLength), "target vectors length")
+		if addProp.Vector && targetVectorsLength > 0 {
+			targetVectors = make([]byte, targetVectorsLength)
+			_, err = r.Read(targetVectors)
+			ec.AddWrap(err, "target vectors")
+		}
+	}
+
 	if err := ec.ToError(); err != nil {
 		return nil, errors.Wrap(err, "compound err")
 	}
@@ -197,10 +230,18 @@ func FromBinaryOptional(data []byte,
 		schema,
 		meta,
 		vectorWeights,
+		class,
 	); err != nil {
 		return nil, errors.Wrap(err, "parse")
 	}
 
+	if targetVectors != nil {
+		ko.Vectors, err = unmarshalTargetVectors(targetVectors)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse target vectors")
+		}
+	}
+
 	return ko, nil
 }
 
@@ -209,7 +250,7 @@ type bucket interface {
 }
 
 func ObjectsByDocID(bucket bucket, ids []uint64,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*Object, error) {
 	if bucket == nil {
 		return nil, fmt.Errorf("objects bucket not found")
@@ -232,7 +273,7 @@ func ObjectsByDocID(bucket bucket, ids []uint64,
 			continue
 		}
 
-		unmarshalled, err := FromBinaryOptional(res, additional)
+		unmarshalled, err := FromBinaryOptional(res, additional, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
 		}
@@ -374,11 +415,20 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		additionalProperties["explainScore"] = ko.ExplainScore()
 	}
 
+	var vectors models.Vectors
+	if len(ko.Vectors) > 0 {
+		vectors = make(models.Vectors, len(ko.Vectors))
+		for name, vec := range ko.Vectors {
+			vectors[name] = vec
+		}
+	}
+
 	return &search.Result{
 		ID:        ko.ID(),
 		ClassName: ko.Class().String(),
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
+		Vectors:   vectors,
 		Dims:      ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
@@ -466,6 +516,11 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+// 4          | uint32    | length of target vectors section
+// n          | []byte    | target vectors, see marshalTargetVectors
+//
+// The target vectors section was added after version 1 was introduced,
+// objects written before don't contain it and end after the vector weights.
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -501,8 +556,13 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return nil, err
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
+	targetVectors, err := marshalTargetVectors(ko.Vectors)
+	if err != nil {
+		return nil, err
+	}
+	targetVectorsLength := uint32(len(targetVectors))
 
-	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
+	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength + 4 + targetVectorsLength
 	byteBuffer := make([]byte, totalBufferLength)
 	byteOps := byte_operations.ByteOperations{Buffer: byteBuffer}
 	byteOps.WriteByte(ko.MarshallerVersion)
@@ -541,10 +601,90 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	if err != nil {
 		return byteBuffer, errors.Wrap(err, "Could not copy vectorWeights")
 	}
+	byteOps.WriteUint32(targetVectorsLength)
+	err = byteOps.CopyBytesToBuffer(targetVectors)
+	if err != nil {
+		return byteBuffer, errors.Wrap(err, "Could not copy target vectors")
+	}
 
 	return byteBuffer, nil
 }
 
+// marshalTargetVectors creates the binary representation of the named
+// vectors. The entries are sorted by name, so that the same vectors always
+// lead to the same bytes
+//
+// No. of B   | Type      | Content
+// ------------------------------------------------
+// 2          | uint16    | length of target vector name
+// n          | []byte    | target vector name
+// 2          | uint16    | VectorLength
+// n*4        | []float32 | vector of length n
+// ...        | ...       | repeated for every target vector
+func marshalTargetVectors(vectors map[string][]float32) ([]byte, error) {
+	if len(vectors) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(vectors))
+	length := 0
+	for name, vec := range vectors {
+		names = append(names, name)
+		length += 2 + len(name) + 2 + len(vec)*4
+	}
+	sort.Strings(names)
+
+	buf := make([]byte, length)
+	byteOps := byte_operations.ByteOperations{Buffer: buf}
+	for _, name := range names {
+		vec := vectors[name]
+		byteOps.WriteUint16(uint16(len(name)))
+		if err := byteOps.CopyBytesToBuffer([]byte(name)); err != nil {
+			return nil, errors.Wrapf(err, "copy name of target vector %q", name)
+		}
+		byteOps.WriteUint16(uint16(len(vec)))
+		for _, v := range vec {
+			byteOps.WriteUint32(math.Float32bits(v))
+		}
+	}
+
+	return buf, nil
+}
+
+func unmarshalTargetVectors(data []byte) (map[string][]float32, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	vectors := map[string][]float32{}
+	byteOps := byte_operations.ByteOperations{Buffer: data}
+	for byteOps.Position < uint64(len(data)) {
+		if byteOps.Position+2 > uint64(len(data)) {
+			return nil, errors.Errorf("target vector name length exceeds buffer")
+		}
+		nameLength := uint64(byteOps.ReadUint16())
+		if byteOps.Position+nameLength > uint64(len(data)) {
+			return nil, errors.Errorf("target vector name exceeds buffer")
+		}
+		name := string(byteOps.ReadBytesFromBuffer(nameLength))
+
+		if byteOps.Position+2 > uint64(len(data)) {
+			return nil, errors.Errorf("length of target vector %q exceeds buffer", name)
+		}
+		vectorLength := uint64(byteOps.ReadUint16())
+		if byteOps.Position+vectorLength*4 > uint64(len(data)) {
+			return nil, errors.Errorf("target vector %q exceeds buffer", name)
+		}
+		vec := make([]float32, vectorLength)
+		for j := range vec {
+			vec[j] = math.Float32frombits(byteOps.ReadUint32())
+		}
+		vectors[name] = vec
+	}
+
+	return vectors, nil
+}
+
 // UnmarshalPropertiesFromObject only unmarshals and returns the properties part of the object
 //
 // Check MarshalBinary for the order of elements in the input array
@@ -634,6 +774,10 @@ func parseValues(dt jsonparser.ValueType, value []byte) (interface{}, error) {
 // UnmarshalBinary is the versioned way to unmarshal a kind object from binary,
 // see MarshalBinary for the exact contents of each version
 func (ko *Object) UnmarshalBinary(data []byte) error {
+	return ko.unmarshalBinary(data, nil)
+}
+
+func (ko *Object) unmarshalBinary(data []byte, class *models.Class) error {
 	version := data[0]
 	if version != 1 {
 		return errors.Errorf("unsupported binary marshaller version %d", version)
@@ -684,7 +828,17 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
-	return ko.parseObject(
+	// objects written before named vectors were introduced end here
+	var targetVectors []byte
+	if byteOps.Position < uint64(len(data)) {
+		targetVectorsLength := uint64(byteOps.ReadUint32())
+		targetVectors, err = byteOps.CopyBytesFromBuffer(targetVectorsLength, nil)
+		if err != nil {
+			return errors.Wrap(err, "Could not copy target vectors")
+		}
+	}
+
+	if err := ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
 		updateTime,
@@ -692,7 +846,17 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		schema,
 		meta,
 		vectorWeights,
-	)
+		class,
+	); err != nil {
+		return err
+	}
+
+	ko.Vectors, err = unmarshalTargetVectors(targetVectors)
+	if err != nil {
+		return errors.Wrap(err, "parse target vectors")
+	}
+
+	return nil
 }
 
 func VectorFromBinary(in []byte, buffer []float32) ([]float32, error) {
@@ -730,15 +894,70 @@ func VectorFromBinary(in []byte, buffer []float32) ([]float32, error) {
 	return out, nil
 }
 
+// TargetVectorFromBinary reads the named vector of the given target vector
+// from the binary representation of an object without parsing the remaining
+// fields. It returns nil if the object does not have a vector for the
+// target.
+func TargetVectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	// skip the fixed size fields and the vector, see MarshalBinary
+	vecLen := binary.LittleEndian.Uint16(in[42:44])
+	byteOps := byte_operations.ByteOperations{Buffer: in, Position: 44 + uint64(vecLen)*4}
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint16())) // class name
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint32())) // schema
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint32())) // meta
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint32())) // vector weights
+
+	if byteOps.Position >= uint64(len(in)) {
+		// written before named vectors were introduced
+		return nil, nil
+	}
+
+	targetVectorsLength := uint64(byteOps.ReadUint32())
+	end := byteOps.Position + targetVectorsLength
+	if end > uint64(len(in)) {
+		return nil, errors.Errorf("target vectors exceed buffer")
+	}
+	for byteOps.Position < end {
+		name := byteOps.ReadBytesFromBuffer(uint64(byteOps.ReadUint16()))
+		vectorLength := int(byteOps.ReadUint16())
+		if string(name) != targetVector {
+			byteOps.MoveBufferPositionForward(uint64(vectorLength) * 4)
+			continue
+		}
+
+		var out []float32
+		if cap(buffer) >= vectorLength {
+			out = buffer[:vectorLength]
+		} else {
+			out = make([]float32, vectorLength)
+		}
+		for j := range out {
+			out[j] = math.Float32frombits(byteOps.ReadUint32())
+		}
+		return out, nil
+	}
+
+	return nil, nil
+}
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
-	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
+	schemaB []byte, additionalB []byte, vectorWeightsB []byte, class *models.Class,
 ) error {
 	var schema map[string]interface{}
 	if err := json.Unmarshal(schemaB, &schema); err != nil {
 		return err
 	}
 
-	if err := ko.enrichSchemaTypes(schema); err != nil {
+	if err := ko.enrichSchemaTypes(schema, class); err != nil {
 		return errors.Wrap(err, "enrich schema datatypes")
 	}
 
@@ -825,6 +1044,7 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		docID:             ko.docID,
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
+		Vectors:           deepCopyVectors(ko.Vectors),
 	}
 }
 
@@ -841,6 +1061,17 @@ func deepCopyVector(orig []float32) []float32 {
 	return out
 }
 
+func deepCopyVectors(orig map[string][]float32) map[string][]float32 {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string][]float32, len(orig))
+	for name, vec := range orig {
+		out[name] = deepCopyVector(vec)
+	}
+	return out
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
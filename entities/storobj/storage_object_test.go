@@ -85,6 +85,77 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingWithTargetVectors(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.Vectors = map[string][]float32{
+		"title":   {0.1, 0.2},
+		"content": {0.3, 0.4, 0.5},
+	}
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("compare", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("optional with vectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vector, after.Vector)
+		assert.Equal(t, before.Vectors, after.Vectors)
+	})
+
+	t.Run("optional without vectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+		assert.Equal(t, "MyName", after.Properties().(map[string]interface{})["name"])
+	})
+
+	t.Run("extract single target vector", func(t *testing.T) {
+		vec, err := TargetVectorFromBinary(asBinary, nil, "content")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.3, 0.4, 0.5}, vec)
+
+		vec, err = TargetVectorFromBinary(asBinary, make([]float32, 0, 8), "title")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.1, 0.2}, vec)
+
+		vec, err = TargetVectorFromBinary(asBinary, nil, "unknown")
+		require.Nil(t, err)
+		assert.Nil(t, vec)
+	})
+
+	t.Run("objects without target vectors", func(t *testing.T) {
+		before.Vectors = nil
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		vec, err := TargetVectorFromBinary(asBinary, nil, "title")
+		require.Nil(t, err)
+		assert.Nil(t, vec)
+
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+	})
+}
+
 func TestFilteringNilProperty(t *testing.T) {
 	object := FromObject(
 		&models.Object{
@@ -141,7 +212,7 @@ func TestStorageObjectUnmarshallingSpecificProps(t *testing.T) {
 	require.Nil(t, err)
 
 	t.Run("without any optional", func(t *testing.T) {
-		after, err := FromBinaryOptional(asBinary, additional.Properties{})
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
 		require.Nil(t, err)
 
 		t.Run("compare", func(t *testing.T) {
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// IndexType is the vectorIndexType of classes whose shards start out as a
+// flat index and are upgraded to hnsw once they grow beyond the threshold.
+// This keeps the memory usage of small tenants proportional to their size.
+const IndexType = "dynamic"
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultDistanceMetric = hnsw.DistanceCosine
+	DefaultThreshold      = 10000
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Distance string `json:"distance"`
+	// Threshold is the number of objects at which a shard is upgraded from
+	// the flat to the hnsw index
+	Threshold int             `json:"threshold"`
+	HNSW      hnsw.UserConfig `json:"hnsw"`
+	Flat      flat.UserConfig `json:"flat"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return IndexType
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = DefaultDistanceMetric
+	u.Threshold = DefaultThreshold
+	u.HNSW = hnsw.NewDefaultUserConfig()
+	u.Flat = flat.NewDefaultUserConfig()
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if value, ok := asMap["distance"]; ok {
+		asString, ok := value.(string)
+		if !ok {
+			return uc, errors.Errorf("distance must be a string, got %T", value)
+		}
+		uc.Distance = asString
+	}
+
+	if value, ok := asMap["threshold"]; ok {
+		// depending on whether we get the results from disk or from the REST
+		// API, numbers may be represented slightly differently
+		switch typed := value.(type) {
+		case json.Number:
+			asInt64, err := typed.Int64()
+			if err != nil {
+				return uc, errors.Wrap(err, "json.Number to int64 for \"threshold\"")
+			}
+			uc.Threshold = int(asInt64)
+		case float64:
+			uc.Threshold = int(typed)
+		default:
+			return uc, errors.Errorf("threshold must be a number, got %T", value)
+		}
+	}
+
+	hnswConfig, err := hnsw.ParseAndValidateConfig(withDistance(asMap["hnsw"], uc.Distance))
+	if err != nil {
+		return uc, errors.Wrap(err, "hnsw")
+	}
+	uc.HNSW = hnswConfig.(hnsw.UserConfig)
+
+	flatConfig, err := flat.ParseAndValidateConfig(withDistance(asMap["flat"], uc.Distance))
+	if err != nil {
+		return uc, errors.Wrap(err, "flat")
+	}
+	uc.Flat = flatConfig.(flat.UserConfig)
+
+	return uc, uc.validate()
+}
+
+// withDistance sets the distance of the dynamic index on the config of the
+// underlying index, both indexes must compare vectors the same way so that
+// the results don't change with the upgrade
+func withDistance(input interface{}, distance string) map[string]interface{} {
+	out := map[string]interface{}{}
+	if asMap, ok := input.(map[string]interface{}); ok {
+		for key, value := range asMap {
+			out[key] = value
+		}
+	}
+	out["distance"] = distance
+	return out
+}
+
+func (u *UserConfig) validate() error {
+	if u.Threshold <= 0 {
+		return errors.Errorf("invalid dynamic config: threshold must be a positive "+
+			"integer, got %d", u.Threshold)
+	}
+
+	if u.HNSW.Skip {
+		return errors.Errorf("invalid dynamic config: hnsw.skip is not supported, " +
+			"use the flat index type instead")
+	}
+
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     func() UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: NewDefaultUserConfig,
+		},
+		{
+			name: "with a threshold from the REST API",
+			input: map[string]interface{}{
+				"threshold": json.Number("500"),
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Threshold = 500
+				return uc
+			},
+		},
+		{
+			name: "the distance applies to both indexes",
+			input: map[string]interface{}{
+				"distance":  "l2-squared",
+				"threshold": float64(100),
+				"hnsw": map[string]interface{}{
+					"distance":       "dot",
+					"maxConnections": json.Number("16"),
+				},
+				"flat": map[string]interface{}{
+					"bq": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Distance = hnsw.DistanceL2Squared
+				uc.Threshold = 100
+				uc.HNSW.Distance = hnsw.DistanceL2Squared
+				uc.HNSW.MaxConnections = 16
+				uc.Flat = flat.UserConfig{
+					Distance: hnsw.DistanceL2Squared,
+					BQ: flat.BQConfig{
+						Enabled:      true,
+						RescoreLimit: flat.DefaultBQRescoreLimit,
+					},
+				}
+				return uc
+			},
+		},
+		{
+			name: "with an invalid threshold",
+			input: map[string]interface{}{
+				"threshold": json.Number("0"),
+			},
+			expectErr:    true,
+			expectErrMsg: "threshold must be a positive integer, got 0",
+		},
+		{
+			name: "with skipped vectors",
+			input: map[string]interface{}{
+				"hnsw": map[string]interface{}{
+					"skip": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "hnsw.skip is not supported",
+		},
+		{
+			name: "with an unsupported distance",
+			input: map[string]interface{}{
+				"distance": "maxsim",
+			},
+			expectErr:    true,
+			expectErrMsg: "maxsim",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, test.expected(), cfg)
+		})
+	}
+}
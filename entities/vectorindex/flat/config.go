@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// IndexType is the vectorIndexType of classes which are searched by brute
+// force. This is meant for small classes and tenants, where the memory
+// footprint of a graph isn't worth it.
+const IndexType = "flat"
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultDistanceMetric = hnsw.DistanceCosine
+	DefaultBQEnabled      = false
+	DefaultBQRescoreLimit = 100
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Distance string   `json:"distance"`
+	BQ       BQConfig `json:"bq"`
+}
+
+// BQConfig configures binary quantization. Only the one bit per dimension
+// codes are kept in memory and scanned, the closest candidates are then
+// rescored with the full-precision vectors read from disk.
+type BQConfig struct {
+	Enabled bool `json:"enabled"`
+	// RescoreLimit is the minimum number of candidates which are rescored, a
+	// search always rescores at least as many candidates as it returns
+	RescoreLimit int `json:"rescoreLimit"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return IndexType
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = DefaultDistanceMetric
+	u.BQ = BQConfig{
+		Enabled:      DefaultBQEnabled,
+		RescoreLimit: DefaultBQRescoreLimit,
+	}
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if value, ok := asMap["distance"]; ok {
+		asString, ok := value.(string)
+		if !ok {
+			return uc, errors.Errorf("distance must be a string, got %T", value)
+		}
+		uc.Distance = asString
+	}
+
+	if value, ok := asMap["bq"]; ok {
+		bqMap, ok := value.(map[string]interface{})
+		if !ok {
+			return uc, errors.Errorf("bq must be an object, got %T", value)
+		}
+
+		if value, ok := bqMap["enabled"]; ok {
+			asBool, ok := value.(bool)
+			if !ok {
+				return uc, errors.Errorf("bq.enabled must be a boolean, got %T", value)
+			}
+			uc.BQ.Enabled = asBool
+		}
+
+		if value, ok := bqMap["rescoreLimit"]; ok {
+			asInt, err := intFromValue(value, "bq.rescoreLimit")
+			if err != nil {
+				return uc, err
+			}
+			uc.BQ.RescoreLimit = asInt
+		}
+	}
+
+	return uc, uc.validate()
+}
+
+func intFromValue(value interface{}, name string) (int, error) {
+	// depending on whether we get the results from disk or from the REST
+	// API, numbers may be represented slightly differently
+	switch typed := value.(type) {
+	case json.Number:
+		asInt64, err := typed.Int64()
+		if err != nil {
+			return 0, errors.Wrapf(err, "json.Number to int64 for %q", name)
+		}
+		return int(asInt64), nil
+	case float64:
+		return int(typed), nil
+	default:
+		return 0, errors.Errorf("%s must be a number, got %T", name, value)
+	}
+}
+
+func (u *UserConfig) validate() error {
+	switch u.Distance {
+	case hnsw.DistanceCosine, hnsw.DistanceDot, hnsw.DistanceL2Squared,
+		hnsw.DistanceManhattan, hnsw.DistanceHamming:
+	default:
+		return errors.Errorf("invalid flat config: unsupported distance %q", u.Distance)
+	}
+
+	if u.BQ.RescoreLimit < 0 {
+		return errors.Errorf("invalid flat config: bq.rescoreLimit must not be "+
+			"negative, got %d", u.BQ.RescoreLimit)
+	}
+
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
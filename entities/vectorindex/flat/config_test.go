@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance: DefaultDistanceMetric,
+				BQ: BQConfig{
+					Enabled:      DefaultBQEnabled,
+					RescoreLimit: DefaultBQRescoreLimit,
+				},
+			},
+		},
+		{
+			name: "with bq from the REST API",
+			input: map[string]interface{}{
+				"distance": "l2-squared",
+				"bq": map[string]interface{}{
+					"enabled":      true,
+					"rescoreLimit": json.Number("250"),
+				},
+			},
+			expected: UserConfig{
+				Distance: "l2-squared",
+				BQ: BQConfig{
+					Enabled:      true,
+					RescoreLimit: 250,
+				},
+			},
+		},
+		{
+			name: "with bq from disk",
+			input: map[string]interface{}{
+				"distance": "dot",
+				"bq": map[string]interface{}{
+					"enabled":      true,
+					"rescoreLimit": float64(50),
+				},
+			},
+			expected: UserConfig{
+				Distance: "dot",
+				BQ: BQConfig{
+					Enabled:      true,
+					RescoreLimit: 50,
+				},
+			},
+		},
+		{
+			name: "with an unsupported distance",
+			input: map[string]interface{}{
+				"distance": "maxsim",
+			},
+			expectErr:    true,
+			expectErrMsg: "unsupported distance \"maxsim\"",
+		},
+		{
+			name: "with a negative rescore limit",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"rescoreLimit": json.Number("-1"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "bq.rescoreLimit must not be negative, got -1",
+		},
+		{
+			name: "with bq of the wrong type",
+			input: map[string]interface{}{
+				"bq": true,
+			},
+			expectErr:    true,
+			expectErrMsg: "bq must be an object, got bool",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, cfg)
+		})
+	}
+}
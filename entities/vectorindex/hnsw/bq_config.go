@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+const (
+	DefaultBQEnabled     = false
+	DefaultBQSkipRescore = false
+)
+
+// BQConfig configures binary quantization, which keeps a single bit per
+// dimension in memory. Unlike PQ it needs no training, so it can only be
+// enabled when the class is created and applies from the first import on.
+type BQConfig struct {
+	Enabled bool `json:"enabled"`
+	// SkipRescore disables re-ranking the hamming distance candidates with the
+	// full-precision vectors read from disk
+	SkipRescore bool `json:"skipRescore"`
+}
+
+func parseBQMap(in map[string]interface{}, bq *BQConfig) error {
+	bqConfigValue, ok := in["bq"]
+	if !ok {
+		return nil
+	}
+
+	bqConfigMap, ok := bqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalBoolFromMap(bqConfigMap, "enabled", func(v bool) {
+		bq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalBoolFromMap(bqConfigMap, "skipRescore", func(v bool) {
+		bq.SkipRescore = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -40,6 +40,7 @@ const (
 	DefaultDynamicEFMax           = 500
 	DefaultDynamicEFFactor        = 8
 	DefaultVectorCacheMaxObjects  = 1e12
+	DefaultVectorCacheMmap        = false
 	DefaultSkip                   = false
 	DefaultFlatSearchCutoff       = 40000
 	DefaultDistanceMetric         = DistanceCosine
@@ -60,9 +61,12 @@ type UserConfig struct {
 	DynamicEFMax           int      `json:"dynamicEfMax"`
 	DynamicEFFactor        int      `json:"dynamicEfFactor"`
 	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
+	VectorCacheMmap        bool     `json:"vectorCacheMmap"`
 	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
 	Distance               string   `json:"distance"`
 	PQ                     PQConfig `json:"pq"`
+	BQ                     BQConfig `json:"bq"`
+	SQ                     SQConfig `json:"sq"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -77,6 +81,7 @@ func (u *UserConfig) SetDefaults() {
 	u.EFConstruction = DefaultEFConstruction
 	u.CleanupIntervalSeconds = DefaultCleanupIntervalSeconds
 	u.VectorCacheMaxObjects = DefaultVectorCacheMaxObjects
+	u.VectorCacheMmap = DefaultVectorCacheMmap
 	u.EF = DefaultEF
 	u.DynamicEFFactor = DefaultDynamicEFFactor
 	u.DynamicEFMax = DefaultDynamicEFMax
@@ -90,11 +95,21 @@ func (u *UserConfig) SetDefaults() {
 		Segments:       DefaultPQSegments,
 		Centroids:      DefaultPQCentroids,
 		TrainingLimit:  DefaultPQTrainingLimit,
+		SkipRescore:    DefaultPQSkipRescore,
 		Encoder: PQEncoder{
 			Type:         DefaultPQEncoderType,
 			Distribution: DefaultPQEncoderDistribution,
 		},
 	}
+	u.BQ = BQConfig{
+		Enabled:     DefaultBQEnabled,
+		SkipRescore: DefaultBQSkipRescore,
+	}
+	u.SQ = SQConfig{
+		Enabled:       DefaultSQEnabled,
+		TrainingLimit: DefaultSQTrainingLimit,
+		SkipRescore:   DefaultSQSkipRescore,
+	}
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -160,6 +175,12 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := optionalBoolFromMap(asMap, "vectorCacheMmap", func(v bool) {
+		uc.VectorCacheMmap = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := optionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
 		uc.FlatSearchCutoff = v
 	}); err != nil {
@@ -182,6 +203,14 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseBQMap(asMap, &uc.BQ); err != nil {
+		return uc, err
+	}
+
+	if err := parseSQMap(asMap, &uc.SQ); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -201,6 +230,43 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	if u.EF < -1 || u.EF == 0 {
+		errMsgs = append(errMsgs, "ef must be a positive integer or -1 to use dynamic ef")
+	}
+
+	if u.DynamicEFMin > u.DynamicEFMax {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"dynamicEfMin (%d) must not be larger than dynamicEfMax (%d)",
+			u.DynamicEFMin, u.DynamicEFMax,
+		))
+	}
+
+	if u.DynamicEFFactor < 1 {
+		errMsgs = append(errMsgs, "dynamicEfFactor must be a positive integer")
+	}
+
+	if u.VectorCacheMaxObjects < 0 {
+		errMsgs = append(errMsgs, "vectorCacheMaxObjects must not be negative")
+	}
+
+	if u.FlatSearchCutoff < 0 {
+		errMsgs = append(errMsgs, "flatSearchCutoff must not be negative")
+	}
+
+	compressions := 0
+	for _, enabled := range []bool{u.PQ.Enabled, u.BQ.Enabled, u.SQ.Enabled} {
+		if enabled {
+			compressions++
+		}
+	}
+	if compressions > 1 {
+		errMsgs = append(errMsgs, "only one of pq, bq and sq compression can be enabled")
+	}
+
+	if u.SQ.Enabled && u.SQ.TrainingLimit <= 0 {
+		errMsgs = append(errMsgs, "sq.trainingLimit must be a positive integer")
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -56,6 +56,11 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
 			},
 		},
 
@@ -86,6 +91,11 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
 			},
 		},
 
@@ -127,6 +137,11 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
 			},
 		},
 
@@ -168,6 +183,11 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
 			},
 		},
 
@@ -209,6 +229,11 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
 			},
 		},
 
@@ -248,6 +273,11 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
 			},
 		},
 
@@ -296,6 +326,52 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: "normal",
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
+			},
+		},
+
+		{
+			name: "with pq training limit and without rescoring",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled":       true,
+					"segments":      float64(64),
+					"trainingLimit": float64(50000),
+					"skipRescore":   true,
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:       true,
+					Segments:      64,
+					Centroids:     DefaultPQCentroids,
+					TrainingLimit: 50000,
+					SkipRescore:   true,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
 			},
 		},
 
@@ -343,9 +419,182 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
+			},
+		},
+
+		{
+			name: "with a memory-mapped vector cache",
+			input: map[string]interface{}{
+				"vectorCacheMmap":       true,
+				"vectorCacheMaxObjects": json.Number("50000"),
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  50000,
+				VectorCacheMmap:        true,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				BQ: BQConfig{
+					Enabled:     DefaultBQEnabled,
+					SkipRescore: DefaultBQSkipRescore,
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
 			},
 		},
 
+		{
+			name: "with bq",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled":     true,
+					"skipRescore": true,
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				BQ: BQConfig{
+					Enabled:     true,
+					SkipRescore: true,
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
+			},
+		},
+
+		{
+			name: "with pq and bq enabled",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled": true,
+				},
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "only one of pq, bq and sq compression can be enabled",
+		},
+
+		{
+			name: "with sq",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"enabled":       true,
+					"trainingLimit": float64(5000),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       true,
+					TrainingLimit: 5000,
+				},
+			},
+		},
+
+		{
+			name: "with sq and an invalid training limit",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"enabled":       true,
+					"trainingLimit": float64(0),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "sq.trainingLimit must be a positive integer",
+		},
+
+		{
+			name: "with dynamic ef min larger than max",
+			input: map[string]interface{}{
+				"dynamicEfMin": float64(600),
+				"dynamicEfMax": float64(500),
+			},
+			expectErr:    true,
+			expectErrMsg: "dynamicEfMin (600) must not be larger than dynamicEfMax (500)",
+		},
+
+		{
+			name: "with invalid ef",
+			input: map[string]interface{}{
+				"ef": float64(0),
+			},
+			expectErr:    true,
+			expectErrMsg: "ef must be a positive integer or -1 to use dynamic ef",
+		},
+
 		{
 			name: "with invalid encoder",
 			input: map[string]interface{}{
@@ -410,6 +659,11 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					SkipRescore:   DefaultSQSkipRescore,
+				},
 			},
 		},
 		{
@@ -30,6 +30,7 @@ const (
 	DefaultPQEncoderDistribution = PQEncoderDistributionLogNormal
 	DefaultPQCentroids           = 256
 	DefaultPQTrainingLimit       = 100000
+	DefaultPQSkipRescore         = false
 )
 
 // Product Quantization encoder configuration
@@ -46,6 +47,9 @@ type PQConfig struct {
 	Centroids      int       `json:"centroids"`
 	TrainingLimit  int       `json:"trainingLimit"`
 	Encoder        PQEncoder `json:"encoder"`
+	// SkipRescore disables re-ranking the compressed candidates with the
+	// full-precision vectors read from disk, trading recall for latency
+	SkipRescore bool `json:"skipRescore"`
 }
 
 func validEncoder(v string) error {
@@ -165,6 +169,12 @@ func parsePQMap(in map[string]interface{}, pq *PQConfig) error {
 		return err
 	}
 
+	if err := optionalBoolFromMap(pqConfigMap, "skipRescore", func(v bool) {
+		pq.SkipRescore = v
+	}); err != nil {
+		return err
+	}
+
 	pqEncoderValue, ok := pqConfigMap["encoder"]
 	if !ok {
 		return nil
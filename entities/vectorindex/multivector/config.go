@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// IndexType is the vectorIndexType of classes whose objects are represented
+// by multiple vectors, such as the token-level embeddings of ColBERT models
+const IndexType = "multivector"
+
+// DistanceMaxSim is the late interaction distance of ColBERT: the negated sum
+// over all query vectors of the maximum dot product with any object vector
+const DistanceMaxSim = "maxsim"
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultDistanceMetric  = DistanceMaxSim
+	DefaultTokenDimensions = 128
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Distance string `json:"distance"`
+	// TokenDimensions is the length of a single vector, the vector of an object
+	// is the concatenation of all its vectors and must be a multiple of it
+	TokenDimensions int `json:"tokenDimensions"`
+	// Muvera enables candidate generation with fixed dimensional encodings
+	Muvera MuveraConfig `json:"muvera"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return IndexType
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = DefaultDistanceMetric
+	u.TokenDimensions = DefaultTokenDimensions
+	u.Muvera = MuveraConfig{
+		Enabled:      DefaultMuveraEnabled,
+		KSim:         DefaultMuveraKSim,
+		DProjections: DefaultMuveraDProjections,
+		Repetitions:  DefaultMuveraRepetitions,
+		RescoreLimit: DefaultMuveraRescoreLimit,
+	}
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if value, ok := asMap["distance"]; ok {
+		asString, ok := value.(string)
+		if !ok {
+			return uc, errors.Errorf("distance must be a string, got %T", value)
+		}
+		uc.Distance = asString
+	}
+
+	if value, ok := asMap["tokenDimensions"]; ok {
+		asInt, err := intFromValue(value, "tokenDimensions")
+		if err != nil {
+			return uc, err
+		}
+		uc.TokenDimensions = asInt
+	}
+
+	if err := parseMuveraMap(asMap, &uc.Muvera); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	if u.Distance != DistanceMaxSim {
+		return errors.Errorf("invalid multivector config: unsupported distance %q, "+
+			"the only supported distance is %q", u.Distance, DistanceMaxSim)
+	}
+
+	if u.TokenDimensions <= 0 {
+		return errors.Errorf("invalid multivector config: tokenDimensions must be "+
+			"a positive integer, got %d", u.TokenDimensions)
+	}
+
+	return u.Muvera.validate()
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance:        DefaultDistanceMetric,
+				TokenDimensions: DefaultTokenDimensions,
+				Muvera:          NewDefaultUserConfig().Muvera,
+			},
+		},
+		{
+			name: "with token dimensions from the REST API",
+			input: map[string]interface{}{
+				"tokenDimensions": json.Number("96"),
+			},
+			expected: UserConfig{
+				Distance:        DefaultDistanceMetric,
+				TokenDimensions: 96,
+				Muvera:          NewDefaultUserConfig().Muvera,
+			},
+		},
+		{
+			name: "with token dimensions from disk",
+			input: map[string]interface{}{
+				"distance":        "maxsim",
+				"tokenDimensions": float64(64),
+			},
+			expected: UserConfig{
+				Distance:        DistanceMaxSim,
+				TokenDimensions: 64,
+				Muvera:          NewDefaultUserConfig().Muvera,
+			},
+		},
+		{
+			name: "with muvera enabled",
+			input: map[string]interface{}{
+				"muvera": map[string]interface{}{
+					"enabled":      true,
+					"ksim":         json.Number("3"),
+					"dprojections": float64(8),
+				},
+			},
+			expected: UserConfig{
+				Distance:        DefaultDistanceMetric,
+				TokenDimensions: DefaultTokenDimensions,
+				Muvera: MuveraConfig{
+					Enabled:      true,
+					KSim:         3,
+					DProjections: 8,
+					Repetitions:  DefaultMuveraRepetitions,
+					RescoreLimit: DefaultMuveraRescoreLimit,
+				},
+			},
+		},
+		{
+			name: "with an invalid muvera ksim",
+			input: map[string]interface{}{
+				"muvera": map[string]interface{}{
+					"enabled": true,
+					"ksim":    json.Number("12"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "muvera.ksim must be between 1 and 10, got 12",
+		},
+		{
+			name: "with invalid muvera settings while disabled",
+			input: map[string]interface{}{
+				"muvera": map[string]interface{}{
+					"repetitions": json.Number("0"),
+				},
+			},
+			expected: UserConfig{
+				Distance:        DefaultDistanceMetric,
+				TokenDimensions: DefaultTokenDimensions,
+				Muvera: MuveraConfig{
+					KSim:         DefaultMuveraKSim,
+					DProjections: DefaultMuveraDProjections,
+					RescoreLimit: DefaultMuveraRescoreLimit,
+				},
+			},
+		},
+		{
+			name: "with an unsupported distance",
+			input: map[string]interface{}{
+				"distance": "cosine",
+			},
+			expectErr:    true,
+			expectErrMsg: "unsupported distance \"cosine\"",
+		},
+		{
+			name: "with invalid token dimensions",
+			input: map[string]interface{}{
+				"tokenDimensions": json.Number("0"),
+			},
+			expectErr:    true,
+			expectErrMsg: "tokenDimensions must be a positive integer, got 0",
+		},
+		{
+			name: "with token dimensions of the wrong type",
+			input: map[string]interface{}{
+				"tokenDimensions": "128",
+			},
+			expectErr:    true,
+			expectErrMsg: "tokenDimensions must be a number, got string",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
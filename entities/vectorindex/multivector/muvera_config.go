@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultMuveraEnabled      = false
+	DefaultMuveraKSim         = 4
+	DefaultMuveraDProjections = 16
+	DefaultMuveraRepetitions  = 10
+	DefaultMuveraRescoreLimit = 100
+)
+
+// MuveraConfig configures the fixed dimensional encodings (FDE) of MUVERA.
+// Every object and query is additionally encoded into a single vector whose
+// dot product approximates the MaxSim similarity, so that candidates can be
+// found without scoring every object's token vectors. The candidates are
+// rescored with the exact MaxSim distance.
+type MuveraConfig struct {
+	Enabled bool `json:"enabled"`
+	// KSim is the number of random hyperplanes partitioning the token space,
+	// each repetition uses 2^KSim buckets
+	KSim int `json:"ksim"`
+	// DProjections is the number of dimensions each bucket is projected to
+	DProjections int `json:"dprojections"`
+	// Repetitions is the number of independent encodings which are
+	// concatenated to form the final encoding
+	Repetitions int `json:"repetitions"`
+	// RescoreLimit is the minimum number of candidates rescored with MaxSim
+	RescoreLimit int `json:"rescoreLimit"`
+}
+
+// Dimensions returns the length of a fixed dimensional encoding
+func (m MuveraConfig) Dimensions() int {
+	return m.Repetitions * (1 << m.KSim) * m.DProjections
+}
+
+func parseMuveraMap(in map[string]interface{}, muvera *MuveraConfig) error {
+	value, ok := in["muvera"]
+	if !ok {
+		return nil
+	}
+
+	asMap, ok := value.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("muvera must be an object, got %T", value)
+	}
+
+	if value, ok := asMap["enabled"]; ok {
+		asBool, ok := value.(bool)
+		if !ok {
+			return errors.Errorf("muvera.enabled must be a boolean, got %T", value)
+		}
+		muvera.Enabled = asBool
+	}
+
+	for name, target := range map[string]*int{
+		"ksim":         &muvera.KSim,
+		"dprojections": &muvera.DProjections,
+		"repetitions":  &muvera.Repetitions,
+		"rescoreLimit": &muvera.RescoreLimit,
+	} {
+		if value, ok := asMap[name]; ok {
+			asInt, err := intFromValue(value, "muvera."+name)
+			if err != nil {
+				return err
+			}
+			*target = asInt
+		}
+	}
+
+	return nil
+}
+
+func (m MuveraConfig) validate() error {
+	if !m.Enabled {
+		return nil
+	}
+
+	// 2^ksim buckets per repetition, more than that would make the encodings
+	// larger than the token vectors of most documents
+	if m.KSim < 1 || m.KSim > 10 {
+		return errors.Errorf("invalid multivector config: muvera.ksim must be "+
+			"between 1 and 10, got %d", m.KSim)
+	}
+	if m.DProjections <= 0 {
+		return errors.Errorf("invalid multivector config: muvera.dprojections must be "+
+			"a positive integer, got %d", m.DProjections)
+	}
+	if m.Repetitions <= 0 {
+		return errors.Errorf("invalid multivector config: muvera.repetitions must be "+
+			"a positive integer, got %d", m.Repetitions)
+	}
+	if m.RescoreLimit <= 0 {
+		return errors.Errorf("invalid multivector config: muvera.rescoreLimit must be "+
+			"a positive integer, got %d", m.RescoreLimit)
+	}
+
+	return nil
+}
+
+// intFromValue parses a number, depending on whether we get the results from
+// disk or from the REST API, numbers may be represented slightly differently
+func intFromValue(value interface{}, name string) (int, error) {
+	switch typed := value.(type) {
+	case json.Number:
+		asInt64, err := typed.Int64()
+		if err != nil {
+			return 0, errors.Wrapf(err, "json.Number to int64 for %q", name)
+		}
+		return int(asInt64), nil
+	case float64:
+		return int(typed), nil
+	default:
+		return 0, errors.Errorf("%s must be a number, got %T", name, value)
+	}
+}
@@ -29,6 +29,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error)
 }
 
 type weaviateClient struct {
@@ -48,11 +49,44 @@ func (c *weaviateClient) Search(ctx context.Context, in *SearchRequest, opts ...
 	return out, nil
 }
 
+func (c *weaviateClient) SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviategrpc.Weaviate/SearchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateSearchStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_SearchStreamClient interface {
+	Recv() (*SearchStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateSearchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateSearchStreamClient) Recv() (*SearchStreamReply, error) {
+	m := new(SearchStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	SearchStream(*SearchRequest, Weaviate_SearchStreamServer) error
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -62,6 +96,9 @@ type UnimplementedWeaviateServer struct{}
 func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*SearchReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedWeaviateServer) SearchStream(*SearchRequest, Weaviate_SearchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchStream not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +130,27 @@ func _Weaviate_Search_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_SearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).SearchStream(m, &weaviateSearchStreamServer{stream})
+}
+
+type Weaviate_SearchStreamServer interface {
+	Send(*SearchStreamReply) error
+	grpc.ServerStream
+}
+
+type weaviateSearchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateSearchStreamServer) Send(m *SearchStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +163,12 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Weaviate_Search_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchStream",
+			Handler:       _Weaviate_SearchStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "weaviate.proto",
 }
@@ -0,0 +1,394 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/generative-anthropic/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+// anthropicVersion is the version of the Messages API the requests are
+// written against
+const anthropicVersion = "2023-06-01"
+
+var compile, _ = regexp.Compile(`{([\w\s]*?)}`)
+
+type anthropic struct {
+	apiKey     string
+	host       string
+	path       string
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+func New(apiKey string, logger logrus.FieldLogger) *anthropic {
+	return &anthropic{
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+		host:   "https://api.anthropic.com",
+		path:   "/v1/messages",
+		logger: logger,
+	}
+}
+
+func (a *anthropic) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forPrompt, err := a.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return a.Generate(ctx, cfg, forPrompt)
+}
+
+func (a *anthropic) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forTask, err := a.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return a.Generate(ctx, cfg, forTask)
+}
+
+// GenerateSingleResultWithImages passes the images along with the prompt,
+// all Claude 3 models are vision capable
+func (a *anthropic) GenerateSingleResultWithImages(ctx context.Context, textProperties map[string]string, images []string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forPrompt, err := a.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return a.generate(ctx, cfg, forPrompt, images)
+}
+
+func (a *anthropic) GenerateAllResultsWithImages(ctx context.Context, textProperties []map[string]string, images []string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forTask, err := a.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return a.generate(ctx, cfg, forTask, images)
+}
+
+func (a *anthropic) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*generativemodels.GenerateResponse, error) {
+	return a.generate(ctx, cfg, prompt, nil)
+}
+
+func (a *anthropic) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, images []string) (*generativemodels.GenerateResponse, error) {
+	req, err := a.newRequest(ctx, cfg, prompt, images, false)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody generateResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode != 200 || resBody.Error != nil {
+		return nil, a.getError(res.StatusCode, resBody.Error)
+	}
+
+	var text strings.Builder
+	for _, content := range resBody.Content {
+		if content.Type == "text" {
+			text.WriteString(content.Text)
+		}
+	}
+	textResponse := text.String()
+
+	return &generativemodels.GenerateResponse{
+		Result: &textResponse,
+		Usage:  resBody.usage(config.NewClassSettings(cfg).Model()),
+	}, nil
+}
+
+func (a *anthropic) GenerateSingleResultStream(ctx context.Context, textProperties map[string]string,
+	prompt string, cfg moduletools.ClassConfig, onChunk func(text string) error,
+) (*generativemodels.GenerateResponse, error) {
+	forPrompt, err := a.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return a.GenerateStream(ctx, cfg, forPrompt, onChunk)
+}
+
+func (a *anthropic) GenerateAllResultsStream(ctx context.Context, textProperties []map[string]string,
+	task string, cfg moduletools.ClassConfig, onChunk func(text string) error,
+) (*generativemodels.GenerateResponse, error) {
+	forTask, err := a.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return a.GenerateStream(ctx, cfg, forTask, onChunk)
+}
+
+// GenerateStream requests the answer as server-sent events and passes its
+// text to onChunk as it arrives
+func (a *anthropic) GenerateStream(ctx context.Context, cfg moduletools.ClassConfig,
+	prompt string, onChunk func(text string) error,
+) (*generativemodels.GenerateResponse, error) {
+	req, err := a.newRequest(ctx, cfg, prompt, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		bodyBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "read response body")
+		}
+		var resBody generateResponse
+		if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+			return nil, errors.Wrap(err, "unmarshal response body")
+		}
+		return nil, a.getError(res.StatusCode, resBody.Error)
+	}
+
+	var text strings.Builder
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+
+		var event streamEvent
+		if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &event); err != nil {
+			return nil, errors.Wrap(err, "unmarshal response event")
+		}
+		if event.Type == "error" {
+			return nil, a.getError(res.StatusCode, event.Error)
+		}
+		if event.Type == "message_stop" {
+			break
+		}
+		if event.Type != "content_block_delta" || event.Delta == nil || event.Delta.Text == "" {
+			continue
+		}
+
+		text.WriteString(event.Delta.Text)
+		if err := onChunk(event.Delta.Text); err != nil {
+			return nil, errors.Wrap(err, "stream chunk")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read response stream")
+	}
+
+	textResponse := text.String()
+	return &generativemodels.GenerateResponse{
+		Result: &textResponse,
+	}, nil
+}
+
+func (a *anthropic) newRequest(ctx context.Context, cfg moduletools.ClassConfig,
+	prompt string, images []string, stream bool,
+) (*http.Request, error) {
+	settings := config.NewClassSettings(cfg)
+
+	anthropicUrl, err := url.JoinPath(a.host, a.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "join Anthropic API host and path")
+	}
+	input := generateInput{
+		Model:       settings.Model(),
+		MaxTokens:   settings.MaxTokens(),
+		Temperature: settings.Temperature(),
+		Messages: []message{{
+			Role:    "user",
+			Content: messageContent(prompt, images),
+		}},
+		Stream: stream,
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", anthropicUrl,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	apiKey, err := a.getApiKey(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Anthropic API Key")
+	}
+	req.Header.Add("x-api-key", apiKey)
+	req.Header.Add("anthropic-version", anthropicVersion)
+	req.Header.Add("Content-Type", "application/json")
+	return req, nil
+}
+
+func (a *anthropic) getError(statusCode int, resBodyError *anthropicApiError) error {
+	if resBodyError != nil {
+		return fmt.Errorf("connection to Anthropic API failed with status: %d error: %v", statusCode, resBodyError.Message)
+	}
+	return fmt.Errorf("connection to Anthropic API failed with status: %d", statusCode)
+}
+
+func (a *anthropic) generatePromptForTask(textProperties []map[string]string, task string) (string, error) {
+	marshal, err := json.Marshal(textProperties)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`'%v:
+%v`, task, string(marshal)), nil
+}
+
+func (a *anthropic) generateForPrompt(textProperties map[string]string, prompt string) (string, error) {
+	all := compile.FindAll([]byte(prompt), -1)
+	for _, match := range all {
+		originalProperty := string(match)
+		replacedProperty := compile.FindStringSubmatch(originalProperty)[1]
+		replacedProperty = strings.TrimSpace(replacedProperty)
+		value := textProperties[replacedProperty]
+		if value == "" {
+			return "", errors.Errorf("Following property has empty value: '%v'. Make sure you spell the property name correctly, verify that the property exists and has a value", replacedProperty)
+		}
+		prompt = strings.ReplaceAll(prompt, originalProperty, value)
+	}
+	return prompt, nil
+}
+
+func (a *anthropic) getApiKey(ctx context.Context) (string, error) {
+	// a key passed with the request takes precedence over the one the
+	// server was started with
+	if apiKey := moduletools.GetValueFromContext(ctx, "X-Anthropic-Api-Key"); apiKey != "" {
+		return apiKey, nil
+	}
+	if a.apiKey != "" {
+		return a.apiKey, nil
+	}
+	return "", errors.New("no api key found " +
+		"neither in request header: X-Anthropic-Api-Key " +
+		"nor in environment variable under ANTHROPIC_APIKEY")
+}
+
+type generateInput struct {
+	Model       string    `json:"model"`
+	MaxTokens   int       `json:"max_tokens"`
+	Temperature float64   `json:"temperature"`
+	Messages    []message `json:"messages"`
+	Stream      bool      `json:"stream,omitempty"`
+}
+
+type message struct {
+	Role string `json:"role"`
+	// Content is the prompt or, with images, a list of contentBlocks
+	Content interface{} `json:"content"`
+}
+
+type contentBlock struct {
+	Type   string       `json:"type"`
+	Text   string       `json:"text,omitempty"`
+	Source *imageSource `json:"source,omitempty"`
+}
+
+type imageSource struct {
+	Type      string `json:"type"`
+	MediaType string `json:"media_type"`
+	Data      string `json:"data"`
+}
+
+// messageContent places the images before the prompt as recommended by
+// Anthropic
+func messageContent(prompt string, images []string) interface{} {
+	if len(images) == 0 {
+		return prompt
+	}
+	blocks := make([]contentBlock, 0, len(images)+1)
+	for _, image := range images {
+		blocks = append(blocks, contentBlock{
+			Type: "image",
+			Source: &imageSource{
+				Type:      "base64",
+				MediaType: generate.ImageMediaType(image),
+				Data:      image,
+			},
+		})
+	}
+	return append(blocks, contentBlock{Type: "text", Text: prompt})
+}
+
+type generateResponse struct {
+	Type       string             `json:"type"`
+	Content    []content          `json:"content"`
+	StopReason string             `json:"stop_reason"`
+	Usage      *usage             `json:"usage,omitempty"`
+	Error      *anthropicApiError `json:"error,omitempty"`
+}
+
+type usage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+func (r *generateResponse) usage(model string) *generativemodels.GenerateUsage {
+	if r.Usage == nil {
+		return nil
+	}
+	return &generativemodels.GenerateUsage{
+		Model:            model,
+		PromptTokens:     r.Usage.InputTokens,
+		CompletionTokens: r.Usage.OutputTokens,
+		TotalTokens:      r.Usage.InputTokens + r.Usage.OutputTokens,
+	}
+}
+
+type content struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type streamEvent struct {
+	Type  string             `json:"type"`
+	Delta *content           `json:"delta,omitempty"`
+	Error *anthropicApiError `json:"error,omitempty"`
+}
+
+type anthropicApiError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
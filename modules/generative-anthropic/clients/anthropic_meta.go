@@ -0,0 +1,19 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+func (a *anthropic) MetaInfo() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"name":              "Generative Search - Anthropic",
+		"documentationHref": "https://docs.anthropic.com/en/api/messages",
+	}, nil
+}
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMeta(t *testing.T) {
+	t.Run("when the module is providing meta", func(t *testing.T) {
+		c := New("apiKey", nullLogger())
+		meta, err := c.MetaInfo()
+
+		assert.Nil(t, err)
+		assert.NotNil(t, meta)
+		metaModel := meta["name"]
+		assert.True(t, metaModel != nil)
+		documentationHref := meta["documentationHref"]
+		assert.True(t, documentationHref != nil)
+	})
+}
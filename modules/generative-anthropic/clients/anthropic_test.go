@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
+
+func TestGetAnswer(t *testing.T) {
+	textProperties := []map[string]string{{"prop": "My name is john"}}
+	t.Run("when the server has a successful answer", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t: t,
+			answer: generateResponse{
+				Type:       "message",
+				Content:    []content{{Type: "text", Text: "John"}},
+				StopReason: "end_turn",
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		expected := generativemodels.GenerateResponse{
+			Result: ptString("John"),
+		}
+
+		res, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, *res)
+		assert.Equal(t, "apiKey", handler.lastHeader.Get("x-api-key"))
+		assert.Equal(t, anthropicVersion, handler.lastHeader.Get("anthropic-version"))
+		assert.Equal(t, "claude-3-haiku-20240307", handler.lastBody["model"])
+		assert.Equal(t, float64(1024), handler.lastBody["max_tokens"])
+		assert.Nil(t, handler.lastBody["stream"])
+	})
+
+	t.Run("when the key is passed with the request", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t: t,
+			answer: generateResponse{
+				Content: []content{{Type: "text", Text: "John"}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		ctx := context.WithValue(context.Background(),
+			"X-Anthropic-Api-Key", []string{"requestApiKey"})
+		_, err := c.GenerateAllResults(ctx, textProperties, "What is my name?", nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, "requestApiKey", handler.lastHeader.Get("x-api-key"))
+	})
+
+	t.Run("when no key is configured", func(t *testing.T) {
+		c := New("", nullLogger())
+
+		_, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil)
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "Anthropic API Key: no api key found "+
+			"neither in request header: X-Anthropic-Api-Key "+
+			"nor in environment variable under ANTHROPIC_APIKEY")
+	})
+
+	t.Run("when the server has an error", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{
+			t: t,
+			answer: generateResponse{
+				Type: "error",
+				Error: &anthropicApiError{
+					Type:    "invalid_request_error",
+					Message: "some error from the server",
+				},
+			},
+		})
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		_, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil)
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to Anthropic API failed with status: 400 error: some error from the server")
+	})
+
+	t.Run("when the server streams the answer", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t:      t,
+			deltas: []string{"My name", " is", " John"},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		var chunks []string
+		res, err := c.GenerateAllResultsStream(context.Background(), textProperties, "What is my name?", nil,
+			func(text string) error {
+				chunks = append(chunks, text)
+				return nil
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, true, handler.lastBody["stream"])
+		assert.Equal(t, []string{"My name", " is", " John"}, chunks)
+		assert.Equal(t, "My name is John", *res.Result)
+	})
+}
+
+type testAnswerHandler struct {
+	t      *testing.T
+	answer generateResponse
+	// deltas are sent as a stream of events if set
+	deltas     []string
+	lastHeader http.Header
+	lastBody   map[string]interface{}
+}
+
+func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/v1/messages", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastHeader = r.Header
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastBody))
+
+	if f.answer.Error != nil {
+		outBytes, err := json.Marshal(f.answer)
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(outBytes)
+		return
+	}
+
+	if f.deltas != nil {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: message_start\ndata: {\"type\": \"message_start\"}\n\n")
+		for _, delta := range f.deltas {
+			outBytes, err := json.Marshal(streamEvent{
+				Type:  "content_block_delta",
+				Delta: &content{Type: "text_delta", Text: delta},
+			})
+			require.Nil(f.t, err)
+			fmt.Fprintf(w, "event: content_block_delta\ndata: %s\n\n", outBytes)
+		}
+		fmt.Fprint(w, "event: message_stop\ndata: {\"type\": \"message_stop\"}\n\n")
+		return
+	}
+
+	outBytes, err := json.Marshal(f.answer)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+func ptString(in string) *string {
+	return &in
+}
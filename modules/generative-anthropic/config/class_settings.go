@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const (
+	modelProperty       = "model"
+	maxTokensProperty   = "maxTokens"
+	temperatureProperty = "temperature"
+)
+
+var availableAnthropicModels = []string{
+	"claude-3-5-sonnet-20240620",
+	"claude-3-opus-20240229",
+	"claude-3-sonnet-20240229",
+	"claude-3-haiku-20240307",
+}
+
+var (
+	DefaultAnthropicModel       = "claude-3-haiku-20240307"
+	DefaultAnthropicMaxTokens   = 1024
+	DefaultAnthropicTemperature = 1.0
+)
+
+// maxOutputTokens is the most tokens the claude-3 models produce in a
+// single response
+const maxOutputTokens = 4096
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	var errorMessages []string
+
+	if model := ic.Model(); !contains(availableAnthropicModels, model) {
+		errorMessages = append(errorMessages, fmt.Sprintf("wrong Anthropic model name, available model names are: %v", availableAnthropicModels))
+	}
+	maxTokens := ic.MaxTokens()
+	if maxTokens < 1 || maxTokens > maxOutputTokens {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be an integer value between 1 and %d", maxTokensProperty, maxOutputTokens))
+	}
+	temperature := ic.Temperature()
+	if temperature < 0 || temperature > 1 {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be float value between 0 and 1", temperatureProperty))
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("%s", strings.Join(errorMessages, ", "))
+	}
+
+	return nil
+}
+
+func (ic *classSettings) getStringProperty(name, defaultValue string) string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	value, ok := ic.cfg.ClassByModuleName("generative-anthropic")[name]
+	if ok {
+		asString, ok := value.(string)
+		if ok {
+			return asString
+		}
+	}
+	return defaultValue
+}
+
+func (ic *classSettings) getFloatProperty(name string, defaultValue float64) float64 {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	val, ok := ic.cfg.ClassByModuleName("generative-anthropic")[name]
+	if ok {
+		asFloat, ok := val.(float64)
+		if ok {
+			return asFloat
+		}
+		asNumber, ok := val.(json.Number)
+		if ok {
+			asFloat, _ := asNumber.Float64()
+			return asFloat
+		}
+		asInt, ok := val.(int)
+		if ok {
+			asFloat := float64(asInt)
+			return asFloat
+		}
+	}
+
+	return defaultValue
+}
+
+func (ic *classSettings) getIntProperty(name string, defaultValue int) int {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	val, ok := ic.cfg.ClassByModuleName("generative-anthropic")[name]
+	if ok {
+		asFloat, ok := val.(float64)
+		if ok {
+			return int(asFloat)
+		}
+		asNumber, ok := val.(json.Number)
+		if ok {
+			asInt64, _ := asNumber.Int64()
+			return int(asInt64)
+		}
+		asInt, ok := val.(int)
+		if ok {
+			return asInt
+		}
+	}
+
+	return defaultValue
+}
+
+func (ic *classSettings) Model() string {
+	return ic.getStringProperty(modelProperty, DefaultAnthropicModel)
+}
+
+// 1 - 4096
+func (ic *classSettings) MaxTokens() int {
+	return ic.getIntProperty(maxTokensProperty, DefaultAnthropicMaxTokens)
+}
+
+// 0.0 - 1.0
+func (ic *classSettings) Temperature() float64 {
+	return ic.getFloatProperty(temperatureProperty, DefaultAnthropicTemperature)
+}
+
+func contains[T comparable](s []T, e T) bool {
+	for _, v := range s {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		cfg             moduletools.ClassConfig
+		wantModel       string
+		wantMaxTokens   int
+		wantTemperature float64
+		wantErr         error
+	}{
+		{
+			name: "default settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantModel:       "claude-3-haiku-20240307",
+			wantMaxTokens:   1024,
+			wantTemperature: 1.0,
+			wantErr:         nil,
+		},
+		{
+			name: "everything non default configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model":       "claude-3-opus-20240229",
+					"maxTokens":   4096,
+					"temperature": 0.5,
+				},
+			},
+			wantModel:       "claude-3-opus-20240229",
+			wantMaxTokens:   4096,
+			wantTemperature: 0.5,
+			wantErr:         nil,
+		},
+		{
+			name: "wrong model configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model": "wrong-model",
+				},
+			},
+			wantErr: errors.Errorf("wrong Anthropic model name, available model names are: " +
+				"[claude-3-5-sonnet-20240620 claude-3-opus-20240229 claude-3-sonnet-20240229 claude-3-haiku-20240307]"),
+		},
+		{
+			name: "wrong maxTokens and temperature configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"maxTokens":   5000,
+					"temperature": 2,
+				},
+			},
+			wantErr: errors.Errorf("maxTokens has to be an integer value between 1 and 4096, " +
+				"temperature has to be float value between 0 and 1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr.Error(), ic.Validate(nil).Error())
+			} else {
+				assert.Nil(t, ic.Validate(nil))
+				assert.Equal(t, tt.wantModel, ic.Model())
+				assert.Equal(t, tt.wantMaxTokens, ic.MaxTokens())
+				assert.Equal(t, tt.wantTemperature, ic.Temperature())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modgenerativeanthropic
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/generative-anthropic/clients"
+	additionalprovider "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/classification"
+)
+
+const Name = "generative-anthropic"
+
+func New() *GenerativeAnthropicModule {
+	return &GenerativeAnthropicModule{}
+}
+
+type GenerativeAnthropicModule struct {
+	generative                   generativeClient
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	classifiers                  []modulecapabilities.Classifier
+}
+
+type generativeClient interface {
+	GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
+	GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
+	Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*generativemodels.GenerateResponse, error)
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *GenerativeAnthropicModule) Name() string {
+	return Name
+}
+
+func (m *GenerativeAnthropicModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2TextGenerative
+}
+
+func (m *GenerativeAnthropicModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	if err := m.initAdditional(ctx, params.GetLogger()); err != nil {
+		return errors.Wrap(err, "init q/a")
+	}
+
+	return nil
+}
+
+func (m *GenerativeAnthropicModule) initAdditional(ctx context.Context,
+	logger logrus.FieldLogger,
+) error {
+	apiKey := os.Getenv("ANTHROPIC_APIKEY")
+
+	client := clients.New(apiKey, logger)
+
+	m.generative = client
+
+	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(Name, m.generative)
+	m.classifiers = []modulecapabilities.Classifier{
+		classification.NewZeroShotClassifier(Name, m.generative),
+	}
+
+	return nil
+}
+
+func (m *GenerativeAnthropicModule) MetaInfo() (map[string]interface{}, error) {
+	return m.generative.MetaInfo()
+}
+
+func (m *GenerativeAnthropicModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *GenerativeAnthropicModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+func (m *GenerativeAnthropicModule) Classifiers() []modulecapabilities.Classifier {
+	return m.classifiers
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.AdditionalProperties(New())
+	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ClassificationProvider(New())
+)
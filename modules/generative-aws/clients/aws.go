@@ -0,0 +1,365 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/generative-aws/config"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+const serviceBedrock = "bedrock"
+
+// anthropicVersion is the version of the Messages API the Claude models on
+// Bedrock expect
+const anthropicVersion = "bedrock-2023-05-31"
+
+var compile, _ = regexp.Compile(`{([\w\s]*?)}`)
+
+type claudeRequest struct {
+	AnthropicVersion string          `json:"anthropic_version"`
+	MaxTokens        int             `json:"max_tokens"`
+	Temperature      float64         `json:"temperature"`
+	Messages         []claudeMessage `json:"messages"`
+}
+
+type claudeMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type titanRequest struct {
+	InputText            string                    `json:"inputText"`
+	TextGenerationConfig titanTextGenerationConfig `json:"textGenerationConfig"`
+}
+
+type titanTextGenerationConfig struct {
+	MaxTokenCount int     `json:"maxTokenCount"`
+	Temperature   float64 `json:"temperature"`
+}
+
+type llamaRequest struct {
+	Prompt      string  `json:"prompt"`
+	MaxGenLen   int     `json:"max_gen_len"`
+	Temperature float64 `json:"temperature"`
+}
+
+// generateResponse covers the responses of the Claude, Titan and Llama
+// models, only the fields of the invoked model's family are set
+type generateResponse struct {
+	Content    []claudeContent `json:"content,omitempty"`
+	Results    []titanResult   `json:"results,omitempty"`
+	Generation *string         `json:"generation,omitempty"`
+	Message    string          `json:"message,omitempty"`
+
+	// token counts, which every family reports differently
+	Usage                *claudeUsage `json:"usage,omitempty"`
+	InputTextTokenCount  int          `json:"inputTextTokenCount,omitempty"`
+	PromptTokenCount     int          `json:"prompt_token_count,omitempty"`
+	GenerationTokenCount int          `json:"generation_token_count,omitempty"`
+}
+
+type claudeUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+type claudeContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type titanResult struct {
+	OutputText string `json:"outputText"`
+	TokenCount int    `json:"tokenCount"`
+}
+
+func (r *generateResponse) text() (string, bool) {
+	switch {
+	case len(r.Content) > 0:
+		var text strings.Builder
+		for _, content := range r.Content {
+			if content.Type == "text" {
+				text.WriteString(content.Text)
+			}
+		}
+		return text.String(), true
+	case len(r.Results) > 0:
+		return r.Results[0].OutputText, true
+	case r.Generation != nil:
+		return *r.Generation, true
+	default:
+		return "", false
+	}
+}
+
+func (r *generateResponse) usage(model string) *generativemodels.GenerateUsage {
+	var promptTokens, completionTokens int
+	switch {
+	case r.Usage != nil:
+		promptTokens, completionTokens = r.Usage.InputTokens, r.Usage.OutputTokens
+	case len(r.Results) > 0:
+		promptTokens, completionTokens = r.InputTextTokenCount, r.Results[0].TokenCount
+	case r.Generation != nil:
+		promptTokens, completionTokens = r.PromptTokenCount, r.GenerationTokenCount
+	default:
+		return nil
+	}
+	return &generativemodels.GenerateUsage{
+		Model:            model,
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		TotalTokens:      promptTokens + completionTokens,
+	}
+}
+
+type aws struct {
+	credentials *credentials.Credentials
+	retryPolicy moduletools.RetryPolicy
+	httpClient  *http.Client
+	originFn    func(region string) string
+	now         func() time.Time
+	logger      logrus.FieldLogger
+}
+
+func New(creds *credentials.Credentials, retryPolicy moduletools.RetryPolicy,
+	transport http.RoundTripper, logger logrus.FieldLogger,
+) *aws {
+	return &aws{
+		credentials: creds,
+		retryPolicy: retryPolicy,
+		httpClient:  &http.Client{Transport: transport},
+		originFn:    bedrockOrigin,
+		now:         time.Now,
+		logger:      logger,
+	}
+}
+
+func bedrockOrigin(region string) string {
+	return fmt.Sprintf("https://bedrock-runtime.%s.amazonaws.com", region)
+}
+
+func (a *aws) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forPrompt, err := a.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return a.Generate(ctx, cfg, forPrompt)
+}
+
+func (a *aws) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forTask, err := a.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return a.Generate(ctx, cfg, forTask)
+}
+
+func (a *aws) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*generativemodels.GenerateResponse, error) {
+	settings := config.NewClassSettings(cfg)
+
+	creds, err := a.getCredentials(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "AWS credentials")
+	}
+
+	body, err := json.Marshal(a.getGenerateRequest(settings.Model(), settings.MaxTokens(),
+		settings.Temperature(), prompt))
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal body")
+	}
+
+	// model ids like anthropic.claude-v2:1 contain characters which need
+	// escaping
+	url := a.originFn(settings.Region()) + uriEncodePath("/model/"+settings.Model()+"/invoke")
+
+	var resBody *generateResponse
+	err = a.retryPolicy.Do(ctx, func() error {
+		resBody, err = a.sendRequest(ctx, url, body, creds, settings.Region())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	textResponse, ok := resBody.text()
+	if !ok {
+		return nil, errors.Errorf("empty response from model %s", settings.Model())
+	}
+	textResponse = strings.TrimSpace(textResponse)
+
+	return &generativemodels.GenerateResponse{
+		Result: &textResponse,
+		Usage:  resBody.usage(settings.Model()),
+	}, nil
+}
+
+// getGenerateRequest builds the request body of the model's family, the
+// models on Bedrock don't share a common request format
+func (a *aws) getGenerateRequest(model string, maxTokens int, temperature float64,
+	prompt string,
+) interface{} {
+	switch config.ModelProvider(model) {
+	case "amazon":
+		return titanRequest{
+			InputText: prompt,
+			TextGenerationConfig: titanTextGenerationConfig{
+				MaxTokenCount: maxTokens,
+				Temperature:   temperature,
+			},
+		}
+	case "meta":
+		return llamaRequest{
+			Prompt:      llamaPrompt(prompt),
+			MaxGenLen:   maxTokens,
+			Temperature: temperature,
+		}
+	default:
+		return claudeRequest{
+			AnthropicVersion: anthropicVersion,
+			MaxTokens:        maxTokens,
+			Temperature:      temperature,
+			Messages:         []claudeMessage{{Role: "user", Content: prompt}},
+		}
+	}
+}
+
+// llamaPrompt wraps the prompt in the chat template of the Llama 3 instruct
+// models, which expect the raw prompt to carry the conversation markup
+func llamaPrompt(prompt string) string {
+	return "<|begin_of_text|><|start_header_id|>user<|end_header_id|>\n\n" + prompt +
+		"<|eot_id|><|start_header_id|>assistant<|end_header_id|>\n\n"
+}
+
+func (a *aws) sendRequest(ctx context.Context, url string, body []byte,
+	creds awsCredentials, region string,
+) (*generateResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+	// every attempt is signed anew, as signatures expire
+	signV4(req, body, creds, region, serviceBedrock, a.now())
+
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		err = errors.Wrap(err, "send POST request")
+		if ctx.Err() == nil {
+			return nil, moduletools.NewRetryableError(err, 0)
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody generateResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		err = errors.Wrap(err, "unmarshal response body")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(err, moduletools.RetryAfter(res.Header))
+		}
+		return nil, err
+	}
+
+	if res.StatusCode >= 500 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "connection to AWS failed with status: %d error: %v")
+		return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+	} else if res.StatusCode > 200 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "failed with status: %d error: %v")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+		}
+		return nil, errors.Errorf(errorMessage)
+	}
+
+	return &resBody, nil
+}
+
+func getErrorMessage(statusCode int, resBodyError string, errorTemplate string) string {
+	if resBodyError != "" {
+		return fmt.Sprintf(errorTemplate, statusCode, resBodyError)
+	}
+	return fmt.Sprintf(errorTemplate, statusCode)
+}
+
+func (a *aws) generatePromptForTask(textProperties []map[string]string, task string) (string, error) {
+	marshal, err := json.Marshal(textProperties)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`'%v:
+%v`, task, string(marshal)), nil
+}
+
+func (a *aws) generateForPrompt(textProperties map[string]string, prompt string) (string, error) {
+	all := compile.FindAll([]byte(prompt), -1)
+	for _, match := range all {
+		originalProperty := string(match)
+		replacedProperty := compile.FindStringSubmatch(originalProperty)[1]
+		replacedProperty = strings.TrimSpace(replacedProperty)
+		value := textProperties[replacedProperty]
+		if value == "" {
+			return "", errors.Errorf("Following property has empty value: '%v'. Make sure you spell the property name correctly, verify that the property exists and has a value", replacedProperty)
+		}
+		prompt = strings.ReplaceAll(prompt, originalProperty, value)
+	}
+	return prompt, nil
+}
+
+// getCredentials prefers the credentials passed in the request headers over
+// the ones of the AWS credential chain
+func (a *aws) getCredentials(ctx context.Context) (awsCredentials, error) {
+	accessKey := moduletools.GetValueFromContext(ctx, "X-Aws-Access-Key")
+	secretKey := moduletools.GetValueFromContext(ctx, "X-Aws-Secret-Key")
+	if accessKey != "" && secretKey != "" {
+		return awsCredentials{
+			accessKeyID:     accessKey,
+			secretAccessKey: secretKey,
+			sessionToken:    moduletools.GetValueFromContext(ctx, "X-Aws-Session-Token"),
+		}, nil
+	}
+
+	if a.credentials != nil {
+		value, err := a.credentials.Get()
+		if err == nil && value.AccessKeyID != "" && value.SecretAccessKey != "" {
+			return awsCredentials{
+				accessKeyID:     value.AccessKeyID,
+				secretAccessKey: value.SecretAccessKey,
+				sessionToken:    value.SessionToken,
+			}, nil
+		}
+	}
+
+	return awsCredentials{}, errors.New("no AWS credentials found " +
+		"neither in request headers: X-Aws-Access-Key and X-Aws-Secret-Key " +
+		"nor in the AWS credential chain: environment variables, " +
+		"shared credentials file or IAM role")
+}
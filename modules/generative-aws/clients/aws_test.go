@@ -0,0 +1,213 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	newClient := func(serverURL string) *aws {
+		return &aws{
+			httpClient: &http.Client{},
+			originFn: func(region string) string {
+				return serverURL
+			},
+			now:    time.Now,
+			logger: nullLogger(),
+		}
+	}
+	ctxWithCredentials := func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, "X-Aws-Access-Key", []string{"access-key"})
+		return context.WithValue(ctx, "X-Aws-Secret-Key", []string{"secret-key"})
+	}
+	classConfig := func(model string) fakeClassConfig {
+		return fakeClassConfig{classConfig: map[string]interface{}{
+			"region": "us-east-1",
+			"model":  model,
+		}}
+	}
+
+	t.Run("when a Claude model is used", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+
+		res, err := c.Generate(ctxWithCredentials(context.Background()),
+			classConfig("anthropic.claude-3-haiku-20240307-v1:0"), "What is my text?")
+
+		require.Nil(t, err)
+		assert.Equal(t, "John", *res.Result)
+		assert.Equal(t, "/model/anthropic.claude-3-haiku-20240307-v1:0/invoke", handler.lastPath)
+		assert.Equal(t, "bedrock-2023-05-31", handler.lastBody["anthropic_version"])
+		assert.Equal(t, float64(1024), handler.lastBody["max_tokens"])
+		assert.True(t, strings.HasPrefix(handler.lastAuthorization,
+			"AWS4-HMAC-SHA256 Credential=access-key/"))
+		assert.Contains(t, handler.lastAuthorization, "/us-east-1/bedrock/aws4_request")
+	})
+
+	t.Run("when a Titan model is used", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+
+		res, err := c.Generate(ctxWithCredentials(context.Background()),
+			classConfig("amazon.titan-text-express-v1"), "What is my text?")
+
+		require.Nil(t, err)
+		assert.Equal(t, "John", *res.Result)
+		assert.Equal(t, "/model/amazon.titan-text-express-v1/invoke", handler.lastPath)
+		assert.Equal(t, "What is my text?", handler.lastBody["inputText"])
+	})
+
+	t.Run("when a Llama model is used", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+
+		res, err := c.Generate(ctxWithCredentials(context.Background()),
+			classConfig("meta.llama3-8b-instruct-v1:0"), "What is my text?")
+
+		require.Nil(t, err)
+		assert.Equal(t, "John", *res.Result)
+		assert.Contains(t, handler.lastBody["prompt"], "What is my text?")
+		assert.Equal(t, float64(1024), handler.lastBody["max_gen_len"])
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		handler := &fakeHandler{t: t, serverError: errors.New("validation failed")}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+
+		_, err := c.Generate(ctxWithCredentials(context.Background()),
+			classConfig("anthropic.claude-3-haiku-20240307-v1:0"), "What is my text?")
+
+		require.NotNil(t, err)
+		assert.Equal(t, "connection to AWS failed with status: 500 error: validation failed", err.Error())
+	})
+
+	t.Run("when no credentials are found", func(t *testing.T) {
+		c := newClient("http://localhost")
+
+		_, err := c.Generate(context.Background(),
+			classConfig("anthropic.claude-3-haiku-20240307-v1:0"), "What is my text?")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no AWS credentials found")
+	})
+
+	t.Run("when the prompt references properties", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+
+		_, err := c.GenerateSingleResult(ctxWithCredentials(context.Background()),
+			map[string]string{"name": "John"}, "Say hello to {name}",
+			classConfig("amazon.titan-text-lite-v1"))
+
+		require.Nil(t, err)
+		assert.Equal(t, "Say hello to John", handler.lastBody["inputText"])
+	})
+}
+
+type fakeHandler struct {
+	t                 *testing.T
+	serverError       error
+	lastPath          string
+	lastAuthorization string
+	lastBody          map[string]interface{}
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastPath = r.URL.Path
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	if f.serverError != nil {
+		outBytes, err := json.Marshal(map[string]interface{}{
+			"message": f.serverError.Error(),
+		})
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastBody))
+
+	var generateResponse interface{}
+	switch {
+	case f.lastBody["messages"] != nil:
+		generateResponse = map[string]interface{}{
+			"content": []map[string]interface{}{{"type": "text", "text": "John"}},
+		}
+	case f.lastBody["inputText"] != nil:
+		generateResponse = map[string]interface{}{
+			"results": []map[string]interface{}{{"outputText": "\nJohn"}},
+		}
+	default:
+		generateResponse = map[string]interface{}{
+			"generation": "John",
+		}
+	}
+	outBytes, err := json.Marshal(generateResponse)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
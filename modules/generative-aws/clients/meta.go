@@ -9,23 +9,11 @@
 //  CONTACT: hello@weaviate.io
 //
 
-package rank
+package clients
 
-type Params struct {
-	Property *string
-	Query    *string
-}
-
-func (n Params) GetQuery() string {
-	if n.Query != nil {
-		return *n.Query
-	}
-	return ""
-}
-
-func (n Params) GetProperty() string {
-	if n.Property != nil {
-		return *n.Property
-	}
-	return ""
+func (a *aws) MetaInfo() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"name":              "Generative Search - AWS",
+		"documentationHref": "https://docs.aws.amazon.com/bedrock/latest/userguide/model-parameters.html",
+	}, nil
 }
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const (
+	regionProperty      = "region"
+	modelProperty       = "model"
+	maxTokensProperty   = "maxTokens"
+	temperatureProperty = "temperature"
+)
+
+var availableBedrockModels = []string{
+	"anthropic.claude-3-5-sonnet-20240620-v1:0",
+	"anthropic.claude-3-sonnet-20240229-v1:0",
+	"anthropic.claude-3-haiku-20240307-v1:0",
+	"anthropic.claude-v2:1",
+	"anthropic.claude-instant-v1",
+	"amazon.titan-text-premier-v1:0",
+	"amazon.titan-text-express-v1",
+	"amazon.titan-text-lite-v1",
+	"meta.llama3-70b-instruct-v1:0",
+	"meta.llama3-8b-instruct-v1:0",
+}
+
+// maxOutputTokens is the most tokens the models of a provider produce in a
+// single response
+var maxOutputTokens = map[string]int{
+	"anthropic": 4096,
+	"amazon":    8192,
+	"meta":      2048,
+}
+
+var (
+	DefaultBedrockModel       = "anthropic.claude-3-haiku-20240307-v1:0"
+	DefaultBedrockMaxTokens   = 1024
+	DefaultBedrockTemperature = 0.0
+)
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	var errorMessages []string
+
+	if ic.Region() == "" {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s cannot be empty", regionProperty))
+	}
+	model := ic.Model()
+	if !contains(availableBedrockModels, model) {
+		errorMessages = append(errorMessages, fmt.Sprintf("wrong Bedrock model name, available model names are: %v", availableBedrockModels))
+	} else if maxTokens, limit := ic.MaxTokens(), maxOutputTokens[ModelProvider(model)]; maxTokens < 1 || maxTokens > limit {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be an integer value between 1 and %d for model %s", maxTokensProperty, limit, model))
+	}
+	temperature := ic.Temperature()
+	if temperature < 0 || temperature > 1 {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be float value between 0 and 1", temperatureProperty))
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("%s", strings.Join(errorMessages, ", "))
+	}
+
+	return nil
+}
+
+func (ic *classSettings) getStringProperty(name, defaultValue string) string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	value, ok := ic.cfg.ClassByModuleName("generative-aws")[name]
+	if ok {
+		asString, ok := value.(string)
+		if ok {
+			return strings.TrimSpace(asString)
+		}
+	}
+	return defaultValue
+}
+
+func (ic *classSettings) getFloatProperty(name string, defaultValue float64) float64 {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	val, ok := ic.cfg.ClassByModuleName("generative-aws")[name]
+	if ok {
+		asFloat, ok := val.(float64)
+		if ok {
+			return asFloat
+		}
+		asNumber, ok := val.(json.Number)
+		if ok {
+			asFloat, _ := asNumber.Float64()
+			return asFloat
+		}
+		asInt, ok := val.(int)
+		if ok {
+			asFloat := float64(asInt)
+			return asFloat
+		}
+	}
+
+	return defaultValue
+}
+
+func (ic *classSettings) getIntProperty(name string, defaultValue int) int {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	val, ok := ic.cfg.ClassByModuleName("generative-aws")[name]
+	if ok {
+		asFloat, ok := val.(float64)
+		if ok {
+			return int(asFloat)
+		}
+		asNumber, ok := val.(json.Number)
+		if ok {
+			asInt64, _ := asNumber.Int64()
+			return int(asInt64)
+		}
+		asInt, ok := val.(int)
+		if ok {
+			return asInt
+		}
+	}
+
+	return defaultValue
+}
+
+func (ic *classSettings) Region() string {
+	return ic.getStringProperty(regionProperty, "")
+}
+
+// Model is the Bedrock model id
+func (ic *classSettings) Model() string {
+	return ic.getStringProperty(modelProperty, DefaultBedrockModel)
+}
+
+func (ic *classSettings) MaxTokens() int {
+	return ic.getIntProperty(maxTokensProperty, DefaultBedrockMaxTokens)
+}
+
+// 0.0 - 1.0
+func (ic *classSettings) Temperature() float64 {
+	return ic.getFloatProperty(temperatureProperty, DefaultBedrockTemperature)
+}
+
+// ModelProvider returns the provider part of a Bedrock model id, e.g.
+// anthropic for anthropic.claude-v2:1. The providers' models expect
+// different request bodies.
+func ModelProvider(model string) string {
+	provider, _, _ := strings.Cut(model, ".")
+	return provider
+}
+
+func contains[T comparable](s []T, e T) bool {
+	for _, v := range s {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		cfg             moduletools.ClassConfig
+		wantRegion      string
+		wantModel       string
+		wantMaxTokens   int
+		wantTemperature float64
+		wantErr         error
+	}{
+		{
+			name: "default settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"region": "us-east-1",
+				},
+			},
+			wantRegion:      "us-east-1",
+			wantModel:       "anthropic.claude-3-haiku-20240307-v1:0",
+			wantMaxTokens:   1024,
+			wantTemperature: 0,
+			wantErr:         nil,
+		},
+		{
+			name: "everything non default configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"region":      "eu-central-1",
+					"model":       "amazon.titan-text-express-v1",
+					"maxTokens":   8192,
+					"temperature": 0.7,
+				},
+			},
+			wantRegion:      "eu-central-1",
+			wantModel:       "amazon.titan-text-express-v1",
+			wantMaxTokens:   8192,
+			wantTemperature: 0.7,
+			wantErr:         nil,
+		},
+		{
+			name: "missing region and wrong model",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model": "wrong-model",
+				},
+			},
+			wantErr: errors.Errorf("region cannot be empty, wrong Bedrock model name, available model names are: " +
+				"[anthropic.claude-3-5-sonnet-20240620-v1:0 anthropic.claude-3-sonnet-20240229-v1:0 " +
+				"anthropic.claude-3-haiku-20240307-v1:0 anthropic.claude-v2:1 anthropic.claude-instant-v1 " +
+				"amazon.titan-text-premier-v1:0 amazon.titan-text-express-v1 amazon.titan-text-lite-v1 " +
+				"meta.llama3-70b-instruct-v1:0 meta.llama3-8b-instruct-v1:0]"),
+		},
+		{
+			name: "maxTokens above the limit of the model",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"region":    "us-east-1",
+					"model":     "meta.llama3-8b-instruct-v1:0",
+					"maxTokens": 4096,
+				},
+			},
+			wantErr: errors.Errorf("maxTokens has to be an integer value between 1 and 2048 " +
+				"for model meta.llama3-8b-instruct-v1:0"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr.Error(), ic.Validate(nil).Error())
+			} else {
+				assert.Nil(t, ic.Validate(nil))
+				assert.Equal(t, tt.wantRegion, ic.Region())
+				assert.Equal(t, tt.wantModel, ic.Model())
+				assert.Equal(t, tt.wantMaxTokens, ic.MaxTokens())
+				assert.Equal(t, tt.wantTemperature, ic.Temperature())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
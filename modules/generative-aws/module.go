@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modgenerativeaws
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/generative-aws/clients"
+	additionalprovider "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/classification"
+)
+
+const Name = "generative-aws"
+
+func New() *GenerativeAWSModule {
+	return &GenerativeAWSModule{}
+}
+
+type GenerativeAWSModule struct {
+	generative                   generativeClient
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	classifiers                  []modulecapabilities.Classifier
+}
+
+type generativeClient interface {
+	GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
+	GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
+	Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*generativemodels.GenerateResponse, error)
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *GenerativeAWSModule) Name() string {
+	return Name
+}
+
+func (m *GenerativeAWSModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2TextGenerative
+}
+
+func (m *GenerativeAWSModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	if err := m.initAdditional(ctx, params.GetLogger()); err != nil {
+		return errors.Wrap(err, "init q/a")
+	}
+
+	return nil
+}
+
+func (m *GenerativeAWSModule) initAdditional(ctx context.Context,
+	logger logrus.FieldLogger,
+) error {
+	// the standard AWS credential chain, credentials passed in the request
+	// headers take precedence
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.FileAWSCredentials{},
+		&credentials.IAM{Client: &http.Client{Transport: http.DefaultTransport}},
+	})
+	retryPolicy, err := moduletools.RetryPolicyFromEnv("AWS")
+	if err != nil {
+		return err
+	}
+	httpOptions, err := moduletools.HTTPClientOptionsFromEnv("AWS")
+	if err != nil {
+		return err
+	}
+	transport, err := moduletools.NewHTTPTransport(httpOptions)
+	if err != nil {
+		return err
+	}
+	client := clients.New(creds, retryPolicy, transport, logger)
+
+	m.generative = client
+
+	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(Name, m.generative)
+	m.classifiers = []modulecapabilities.Classifier{
+		classification.NewZeroShotClassifier(Name, m.generative),
+	}
+
+	return nil
+}
+
+func (m *GenerativeAWSModule) MetaInfo() (map[string]interface{}, error) {
+	return m.generative.MetaInfo()
+}
+
+func (m *GenerativeAWSModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *GenerativeAWSModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+func (m *GenerativeAWSModule) Classifiers() []modulecapabilities.Classifier {
+	return m.classifiers
+}
+
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.AdditionalProperties(New())
+	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ClassificationProvider(New())
+)
@@ -69,6 +69,45 @@ func (v *cohere) GenerateAllResults(ctx context.Context, textProperties []map[st
 }
 
 func (v *cohere) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*generativemodels.GenerateResponse, error) {
+	req, err := v.newRequest(ctx, cfg, prompt, false)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody generateResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode != 200 || resBody.Error != nil {
+		if resBody.Error != nil {
+			return nil, errors.Errorf("connection to Cohere API failed with status: %d error: %v", res.StatusCode, resBody.Error.Message)
+		}
+		return nil, errors.Errorf("connection to Cohere API failed with status: %d", res.StatusCode)
+	}
+
+	textResponse := resBody.Generations[0].Text
+
+	return &generativemodels.GenerateResponse{
+		Result: &textResponse,
+		Usage:  resBody.usage(config.NewClassSettings(cfg).Model()),
+	}, nil
+}
+
+func (v *cohere) newRequest(ctx context.Context, cfg moduletools.ClassConfig,
+	prompt string, stream bool,
+) (*http.Request, error) {
 	settings := config.NewClassSettings(cfg)
 
 	cohereUrl, err := url.JoinPath(v.host, v.path)
@@ -83,6 +122,7 @@ func (v *cohere) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 		K:                 settings.K(),
 		StopSequences:     settings.StopSequences(),
 		ReturnLikelihoods: settings.ReturnLikelihoods(),
+		Stream:            stream,
 	}
 
 	body, err := json.Marshal(input)
@@ -101,35 +141,7 @@ func (v *cohere) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}
 	req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", apiKey))
 	req.Header.Add("Content-Type", "application/json")
-
-	res, err := v.httpClient.Do(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "send POST request")
-	}
-	defer res.Body.Close()
-
-	bodyBytes, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "read response body")
-	}
-
-	var resBody generateResponse
-	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
-		return nil, errors.Wrap(err, "unmarshal response body")
-	}
-
-	if res.StatusCode != 200 || resBody.Error != nil {
-		if resBody.Error != nil {
-			return nil, errors.Errorf("connection to Cohere API failed with status: %d error: %v", res.StatusCode, resBody.Error.Message)
-		}
-		return nil, errors.Errorf("connection to Cohere API failed with status: %d", res.StatusCode)
-	}
-
-	textResponse := resBody.Generations[0].Text
-
-	return &generativemodels.GenerateResponse{
-		Result: &textResponse,
-	}, nil
+	return req, nil
 }
 
 func (v *cohere) generatePromptForTask(textProperties []map[string]string, task string) (string, error) {
@@ -178,13 +190,36 @@ type generateInput struct {
 	K                 int      `json:"k"`
 	StopSequences     []string `json:"stop_sequences"`
 	ReturnLikelihoods string   `json:"return_likelihoods"`
+	Stream            bool     `json:"stream,omitempty"`
 }
 
 type generateResponse struct {
 	Generations []generation
+	Meta        *meta           `json:"meta,omitempty"`
 	Error       *cohereApiError `json:"error,omitempty"`
 }
 
+type meta struct {
+	BilledUnits *billedUnits `json:"billed_units,omitempty"`
+}
+
+type billedUnits struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+func (r *generateResponse) usage(model string) *generativemodels.GenerateUsage {
+	if r.Meta == nil || r.Meta.BilledUnits == nil {
+		return nil
+	}
+	return &generativemodels.GenerateUsage{
+		Model:            model,
+		PromptTokens:     r.Meta.BilledUnits.InputTokens,
+		CompletionTokens: r.Meta.BilledUnits.OutputTokens,
+		TotalTokens:      r.Meta.BilledUnits.InputTokens + r.Meta.BilledUnits.OutputTokens,
+	}
+}
+
 type generation struct {
 	Text string `json:"text"`
 }
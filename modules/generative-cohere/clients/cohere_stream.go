@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+func (v *cohere) GenerateSingleResultStream(ctx context.Context, textProperties map[string]string,
+	prompt string, cfg moduletools.ClassConfig, onChunk func(text string) error,
+) (*generativemodels.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return v.GenerateStream(ctx, cfg, forPrompt, onChunk)
+}
+
+func (v *cohere) GenerateAllResultsStream(ctx context.Context, textProperties []map[string]string,
+	task string, cfg moduletools.ClassConfig, onChunk func(text string) error,
+) (*generativemodels.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return v.GenerateStream(ctx, cfg, forTask, onChunk)
+}
+
+// GenerateStream requests the answer as a stream of JSON lines and passes
+// its text to onChunk as it arrives
+func (v *cohere) GenerateStream(ctx context.Context, cfg moduletools.ClassConfig,
+	prompt string, onChunk func(text string) error,
+) (*generativemodels.GenerateResponse, error) {
+	req, err := v.newRequest(ctx, cfg, prompt, true)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		bodyBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "read response body")
+		}
+		var resBody generateResponse
+		if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+			return nil, errors.Wrap(err, "unmarshal response body")
+		}
+		if resBody.Error != nil {
+			return nil, errors.Errorf("connection to Cohere API failed with status: %d error: %v", res.StatusCode, resBody.Error.Message)
+		}
+		return nil, errors.Errorf("connection to Cohere API failed with status: %d", res.StatusCode)
+	}
+
+	var text strings.Builder
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var chunk streamChunk
+		if err := json.Unmarshal([]byte(line), &chunk); err != nil {
+			return nil, errors.Wrap(err, "unmarshal response chunk")
+		}
+		if chunk.IsFinished {
+			if strings.HasPrefix(chunk.FinishReason, "ERROR") {
+				return nil, errors.Errorf("Cohere API stream finished with reason: %s", chunk.FinishReason)
+			}
+			break
+		}
+		if chunk.Text == "" {
+			continue
+		}
+
+		text.WriteString(chunk.Text)
+		if err := onChunk(chunk.Text); err != nil {
+			return nil, errors.Wrap(err, "stream chunk")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read response stream")
+	}
+
+	textResponse := text.String()
+	return &generativemodels.GenerateResponse{
+		Result: &textResponse,
+	}, nil
+}
+
+type streamChunk struct {
+	Text         string `json:"text"`
+	IsFinished   bool   `json:"is_finished"`
+	FinishReason string `json:"finish_reason"`
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAnswerStream(t *testing.T) {
+	textProperties := []map[string]string{{"prop": "My name is john"}}
+
+	t.Run("when the server streams the answer", func(t *testing.T) {
+		handler := &testStreamHandler{
+			t:      t,
+			chunks: []streamChunk{{Text: "My name"}, {Text: " is"}, {Text: " John"}},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		var chunks []string
+		res, err := c.GenerateAllResultsStream(context.Background(), textProperties, "What is my name?", nil,
+			func(text string) error {
+				chunks = append(chunks, text)
+				return nil
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, true, handler.lastBody["stream"])
+		assert.Equal(t, []string{"My name", " is", " John"}, chunks)
+		assert.Equal(t, "My name is John", *res.Result)
+	})
+
+	t.Run("when the stream finishes with an error", func(t *testing.T) {
+		server := httptest.NewServer(&testStreamHandler{
+			t:            t,
+			chunks:       []streamChunk{{Text: "My name"}},
+			finishReason: "ERROR_TOXIC",
+		})
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		_, err := c.GenerateAllResultsStream(context.Background(), textProperties, "What is my name?", nil,
+			func(text string) error { return nil })
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "Cohere API stream finished with reason: ERROR_TOXIC")
+	})
+
+	t.Run("when the server has an error", func(t *testing.T) {
+		server := httptest.NewServer(&testStreamHandler{
+			t:   t,
+			err: &cohereApiError{Message: "some error from the server"},
+		})
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		_, err := c.GenerateAllResultsStream(context.Background(), textProperties, "What is my name?", nil,
+			func(text string) error { return nil })
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to Cohere API failed with status: 500 error: some error from the server")
+	})
+}
+
+type testStreamHandler struct {
+	t            *testing.T
+	chunks       []streamChunk
+	finishReason string
+	err          *cohereApiError
+	lastBody     map[string]interface{}
+}
+
+func (f *testStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/v1/generate", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastBody))
+
+	if f.err != nil {
+		outBytes, err := json.Marshal(generateResponse{Error: f.err})
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	finishReason := f.finishReason
+	if finishReason == "" {
+		finishReason = "COMPLETE"
+	}
+	for _, chunk := range append(f.chunks, streamChunk{IsFinished: true, FinishReason: finishReason}) {
+		outBytes, err := json.Marshal(chunk)
+		require.Nil(f.t, err)
+		fmt.Fprintf(w, "%s\n", outBytes)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/modules/generative-cohere/clients"
 	additionalprovider "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/classification"
 )
 
 const Name = "generative-cohere"
@@ -34,6 +35,7 @@ func New() *GenerativeCohereModule {
 type GenerativeCohereModule struct {
 	generative                   generativeClient
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	classifiers                  []modulecapabilities.Classifier
 }
 
 type generativeClient interface {
@@ -70,7 +72,10 @@ func (m *GenerativeCohereModule) initAdditional(ctx context.Context,
 
 	m.generative = client
 
-	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(m.generative)
+	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(Name, m.generative)
+	m.classifiers = []modulecapabilities.Classifier{
+		classification.NewZeroShotClassifier(Name, m.generative),
+	}
 
 	return nil
 }
@@ -88,9 +93,14 @@ func (m *GenerativeCohereModule) AdditionalProperties() map[string]modulecapabil
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
 
+func (m *GenerativeCohereModule) Classifiers() []modulecapabilities.Classifier {
+	return m.classifiers
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ClassificationProvider(New())
 )
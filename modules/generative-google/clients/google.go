@@ -0,0 +1,412 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/generative-google/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"golang.org/x/oauth2"
+	googleauth "golang.org/x/oauth2/google"
+)
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+var compile, _ = regexp.Compile(`{([\w\s]*?)}`)
+
+func buildStudioURL(model string) string {
+	urlTemplate := "https://generativelanguage.googleapis.com/v1beta/models/%s:generateContent"
+	return fmt.Sprintf(urlTemplate, model)
+}
+
+func buildVertexURL(location, projectID, model string) string {
+	urlTemplate := "https://%s-aiplatform.googleapis.com/v1/projects/%s/locations/%s/publishers/google/models/%s:generateContent"
+	return fmt.Sprintf(urlTemplate, location, projectID, location, model)
+}
+
+type google struct {
+	apiKey             string
+	httpClient         *http.Client
+	studioUrlBuilderFn func(model string) string
+	vertexUrlBuilderFn func(location, projectID, model string) string
+	logger             logrus.FieldLogger
+
+	// the token source of the application default credentials is looked up
+	// on first use, so that the module can start without credentials if they
+	// are passed in the request headers only
+	tokenSourceLock sync.Mutex
+	tokenSource     oauth2.TokenSource
+	tokenSourceFn   func(ctx context.Context) (oauth2.TokenSource, error)
+}
+
+func New(apiKey string, logger logrus.FieldLogger) *google {
+	return &google{
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+		studioUrlBuilderFn: buildStudioURL,
+		vertexUrlBuilderFn: buildVertexURL,
+		logger:             logger,
+		tokenSourceFn:      defaultTokenSource,
+	}
+}
+
+func defaultTokenSource(ctx context.Context) (oauth2.TokenSource, error) {
+	return googleauth.DefaultTokenSource(ctx, cloudPlatformScope)
+}
+
+func (v *google) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return v.Generate(ctx, cfg, forPrompt)
+}
+
+func (v *google) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return v.Generate(ctx, cfg, forTask)
+}
+
+// GenerateSingleResultWithImages passes the images as inline data parts
+// along with the prompt, all Gemini models are multimodal
+func (v *google) GenerateSingleResultWithImages(ctx context.Context, textProperties map[string]string, images []string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return v.generate(ctx, cfg, forPrompt, images)
+}
+
+func (v *google) GenerateAllResultsWithImages(ctx context.Context, textProperties []map[string]string, images []string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return v.generate(ctx, cfg, forTask, images)
+}
+
+func (v *google) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*generativemodels.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, nil)
+}
+
+func (v *google) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, images []string) (*generativemodels.GenerateResponse, error) {
+	settings := config.NewClassSettings(cfg)
+
+	model, err := v.getModel(ctx, settings.Model())
+	if err != nil {
+		return nil, err
+	}
+
+	input := generateInput{
+		Contents: []content{
+			{
+				Role:  "user",
+				Parts: parts(prompt, images),
+			},
+		},
+		GenerationConfig: generationConfig{
+			Temperature:     settings.Temperature(),
+			MaxOutputTokens: settings.MaxOutputTokens(),
+			TopP:            settings.TopP(),
+			TopK:            settings.TopK(),
+		},
+		SafetySettings: settings.SafetySettings(),
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal body")
+	}
+
+	req, err := v.newRequest(ctx, settings.ProjectID(), settings.Location(), model, body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody generateResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode != 200 || resBody.Error != nil {
+		if resBody.Error != nil {
+			return nil, fmt.Errorf("connection to Google Gemini failed with status: %v error: %v",
+				res.StatusCode, resBody.Error.Message)
+		}
+		return nil, fmt.Errorf("connection to Google Gemini failed with status: %d", res.StatusCode)
+	}
+
+	if resBody.PromptFeedback != nil && resBody.PromptFeedback.BlockReason != "" {
+		return nil, errors.Errorf("prompt blocked by Google Gemini: %s", resBody.PromptFeedback.BlockReason)
+	}
+	if len(resBody.Candidates) == 0 {
+		return &generativemodels.GenerateResponse{
+			Result: nil,
+		}, nil
+	}
+
+	candidate := resBody.Candidates[0]
+	if candidate.FinishReason == "SAFETY" {
+		return nil, errors.New("answer blocked by the safety settings of Google Gemini")
+	}
+	var text strings.Builder
+	for _, part := range candidate.Content.Parts {
+		text.WriteString(part.Text)
+	}
+	trimmedResponse := strings.Trim(text.String(), "\n")
+
+	return &generativemodels.GenerateResponse{
+		Result: &trimmedResponse,
+		Usage:  resBody.usage(model),
+	}, nil
+}
+
+// newRequest addresses Vertex AI when the class is configured with a
+// project, the Gemini API of Google AI Studio otherwise
+func (v *google) newRequest(ctx context.Context, projectID, location, model string,
+	body []byte,
+) (*http.Request, error) {
+	if projectID != "" {
+		req, err := http.NewRequestWithContext(ctx, "POST",
+			v.vertexUrlBuilderFn(location, projectID, model), bytes.NewReader(body))
+		if err != nil {
+			return nil, errors.Wrap(err, "create POST request")
+		}
+		token, err := v.getToken(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Google OAuth token")
+		}
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+		req.Header.Add("Content-Type", "application/json")
+		return req, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST",
+		v.studioUrlBuilderFn(model), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	apiKey, err := v.getApiKey(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Google AI Studio API Key")
+	}
+	req.Header.Add("x-goog-api-key", apiKey)
+	req.Header.Add("Content-Type", "application/json")
+	return req, nil
+}
+
+// getModel lets the X-Google-Model header choose the model of a single
+// request instead of the one of the class
+func (v *google) getModel(ctx context.Context, classModel string) (string, error) {
+	model := moduletools.GetValueFromContext(ctx, "X-Google-Model")
+	if model == "" {
+		return classModel, nil
+	}
+	for _, available := range config.AvailableGeminiModels {
+		if model == available {
+			return model, nil
+		}
+	}
+	return "", errors.Errorf("wrong Gemini model name in request header X-Google-Model: %s, "+
+		"available model names are: %v", model, config.AvailableGeminiModels)
+}
+
+// OverrideNames maps the settings a query can override to the names of the
+// class settings
+func (v *google) OverrideNames() map[string]string {
+	return map[string]string{
+		"maxTokens": "maxOutputTokens",
+	}
+}
+
+func (v *google) generatePromptForTask(textProperties []map[string]string, task string) (string, error) {
+	marshal, err := json.Marshal(textProperties)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`'%v:
+%v`, task, string(marshal)), nil
+}
+
+func (v *google) generateForPrompt(textProperties map[string]string, prompt string) (string, error) {
+	all := compile.FindAll([]byte(prompt), -1)
+	for _, match := range all {
+		originalProperty := string(match)
+		replacedProperty := compile.FindStringSubmatch(originalProperty)[1]
+		replacedProperty = strings.TrimSpace(replacedProperty)
+		value := textProperties[replacedProperty]
+		if value == "" {
+			return "", errors.Errorf("Following property has empty value: '%v'. Make sure you spell the property name correctly, verify that the property exists and has a value", replacedProperty)
+		}
+		prompt = strings.ReplaceAll(prompt, originalProperty, value)
+	}
+	return prompt, nil
+}
+
+func (v *google) getApiKey(ctx context.Context) (string, error) {
+	// a key passed with the request takes precedence over the one the
+	// server was started with
+	if apiKey := moduletools.GetValueFromContext(ctx, "X-Google-Studio-Api-Key"); apiKey != "" {
+		return apiKey, nil
+	}
+	if v.apiKey != "" {
+		return v.apiKey, nil
+	}
+	return "", errors.New("no api key found " +
+		"neither in request header: X-Google-Studio-Api-Key " +
+		"nor in environment variable under GOOGLE_APIKEY")
+}
+
+// getToken prefers the OAuth access token passed in the request header over
+// the application default credentials
+func (v *google) getToken(ctx context.Context) (string, error) {
+	if token := moduletools.GetValueFromContext(ctx, "X-Google-Api-Key"); token != "" {
+		return token, nil
+	}
+
+	tokenSource, err := v.getTokenSource()
+	if err != nil {
+		return "", errors.Wrap(err, "no access token found "+
+			"neither in request header: X-Google-Api-Key "+
+			"nor in the application default credentials")
+	}
+	oauthToken, err := tokenSource.Token()
+	if err != nil {
+		return "", errors.Wrap(err, "request access token")
+	}
+	return oauthToken.AccessToken, nil
+}
+
+func (v *google) getTokenSource() (oauth2.TokenSource, error) {
+	v.tokenSourceLock.Lock()
+	defer v.tokenSourceLock.Unlock()
+
+	if v.tokenSource != nil {
+		return v.tokenSource, nil
+	}
+	// tokens are refreshed in the background of later requests, so they
+	// must not be bound to the context of the current request
+	tokenSource, err := v.tokenSourceFn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	v.tokenSource = tokenSource
+	return tokenSource, nil
+}
+
+type generateInput struct {
+	Contents         []content              `json:"contents"`
+	GenerationConfig generationConfig       `json:"generationConfig"`
+	SafetySettings   []config.SafetySetting `json:"safetySettings,omitempty"`
+}
+
+type content struct {
+	Role  string `json:"role,omitempty"`
+	Parts []part `json:"parts"`
+}
+
+type part struct {
+	Text       string      `json:"text,omitempty"`
+	InlineData *inlineData `json:"inlineData,omitempty"`
+}
+
+type inlineData struct {
+	MimeType string `json:"mimeType"`
+	Data     string `json:"data"`
+}
+
+func parts(prompt string, images []string) []part {
+	parts := []part{{Text: prompt}}
+	for _, image := range images {
+		parts = append(parts, part{InlineData: &inlineData{
+			MimeType: generate.ImageMediaType(image),
+			Data:     image,
+		}})
+	}
+	return parts
+}
+
+type generationConfig struct {
+	Temperature     float64 `json:"temperature"`
+	MaxOutputTokens int     `json:"maxOutputTokens"`
+	TopP            float64 `json:"topP"`
+	TopK            int     `json:"topK"`
+}
+
+type generateResponse struct {
+	Candidates     []candidate     `json:"candidates,omitempty"`
+	PromptFeedback *promptFeedback `json:"promptFeedback,omitempty"`
+	UsageMetadata  *usageMetadata  `json:"usageMetadata,omitempty"`
+	Error          *googleApiError `json:"error,omitempty"`
+}
+
+type usageMetadata struct {
+	PromptTokenCount     int `json:"promptTokenCount"`
+	CandidatesTokenCount int `json:"candidatesTokenCount"`
+	TotalTokenCount      int `json:"totalTokenCount"`
+}
+
+func (r *generateResponse) usage(model string) *generativemodels.GenerateUsage {
+	if r.UsageMetadata == nil {
+		return nil
+	}
+	return &generativemodels.GenerateUsage{
+		Model:            model,
+		PromptTokens:     r.UsageMetadata.PromptTokenCount,
+		CompletionTokens: r.UsageMetadata.CandidatesTokenCount,
+		TotalTokens:      r.UsageMetadata.TotalTokenCount,
+	}
+}
+
+type candidate struct {
+	Content      content `json:"content"`
+	FinishReason string  `json:"finishReason,omitempty"`
+}
+
+type promptFeedback struct {
+	BlockReason string `json:"blockReason,omitempty"`
+}
+
+type googleApiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
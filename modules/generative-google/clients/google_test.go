@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/generative-google/config"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
+
+func TestGetAnswer(t *testing.T) {
+	successfulAnswer := generateResponse{
+		Candidates: []candidate{
+			{
+				Content: content{
+					Role:  "model",
+					Parts: []part{{Text: "John\n"}},
+				},
+				FinishReason: "STOP",
+			},
+		},
+	}
+	newClient := func(serverURL string) *google {
+		return &google{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			studioUrlBuilderFn: func(model string) string {
+				return serverURL + "/studio/" + model
+			},
+			vertexUrlBuilderFn: func(location, projectID, model string) string {
+				return serverURL + "/vertex/" + model
+			},
+			logger: nullLogger(),
+		}
+	}
+
+	t.Run("when the server has a successful answer", func(t *testing.T) {
+		handler := &testAnswerHandler{t: t, answer: successfulAnswer}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+
+		textProperties := []map[string]string{{"prop": "My name is john"}}
+		expected := generativemodels.GenerateResponse{
+			Result: ptString("John"),
+		}
+
+		res, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, *res)
+		assert.Equal(t, "/studio/gemini-1.5-flash", handler.lastPath)
+		assert.Equal(t, "apiKey", handler.lastApiKey)
+	})
+
+	t.Run("when the class is configured for Vertex AI", func(t *testing.T) {
+		handler := &testAnswerHandler{t: t, answer: successfulAnswer}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{
+			"projectId": "project",
+			"safetySettings": []interface{}{
+				map[string]interface{}{
+					"category":  "HARM_CATEGORY_HARASSMENT",
+					"threshold": "BLOCK_NONE",
+				},
+			},
+		}}
+		ctx := context.WithValue(context.Background(), "X-Google-Api-Key", []string{"token"})
+
+		_, err := c.Generate(ctx, cfg, "What is my name?")
+
+		require.Nil(t, err)
+		assert.Equal(t, "/vertex/gemini-1.5-flash", handler.lastPath)
+		assert.Equal(t, "Bearer token", handler.lastAuthorization)
+		assert.Equal(t, []config.SafetySetting{
+			{Category: "HARM_CATEGORY_HARASSMENT", Threshold: "BLOCK_NONE"},
+		}, handler.lastInput.SafetySettings)
+	})
+
+	t.Run("when the model is chosen per request", func(t *testing.T) {
+		handler := &testAnswerHandler{t: t, answer: successfulAnswer}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+		ctx := context.WithValue(context.Background(), "X-Google-Model", []string{"gemini-1.5-pro"})
+
+		_, err := c.Generate(ctx, nil, "What is my name?")
+
+		require.Nil(t, err)
+		assert.Equal(t, "/studio/gemini-1.5-pro", handler.lastPath)
+	})
+
+	t.Run("when a wrong model is chosen per request", func(t *testing.T) {
+		c := newClient("http://localhost")
+		ctx := context.WithValue(context.Background(), "X-Google-Model", []string{"chat-bison"})
+
+		_, err := c.Generate(ctx, nil, "What is my name?")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "wrong Gemini model name in request header X-Google-Model: chat-bison")
+	})
+
+	t.Run("when the answer is blocked", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{
+			t: t,
+			answer: generateResponse{
+				Candidates: []candidate{{FinishReason: "SAFETY"}},
+			},
+		})
+		defer server.Close()
+		c := newClient(server.URL)
+
+		_, err := c.Generate(context.Background(), nil, "What is my name?")
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "answer blocked by the safety settings of Google Gemini")
+	})
+
+	t.Run("when the server has a an error", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{
+			t: t,
+			answer: generateResponse{
+				Error: &googleApiError{
+					Message: "some error from the server",
+				},
+			},
+		})
+		defer server.Close()
+		c := newClient(server.URL)
+
+		textProperties := []map[string]string{{"prop": "My name is john"}}
+
+		_, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil)
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to Google Gemini failed with status: 500 error: some error from the server")
+	})
+}
+
+type testAnswerHandler struct {
+	t *testing.T
+	// the test handler will report as not ready before the time has passed
+	answer            generateResponse
+	lastPath          string
+	lastApiKey        string
+	lastAuthorization string
+	lastInput         generateInput
+}
+
+func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastPath = r.URL.Path
+	f.lastApiKey = r.Header.Get("x-goog-api-key")
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	if f.answer.Error != nil && f.answer.Error.Message != "" {
+		outBytes, err := json.Marshal(f.answer)
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastInput))
+
+	require.Len(f.t, f.lastInput.Contents, 1)
+	require.Len(f.t, f.lastInput.Contents[0].Parts, 1)
+	require.True(f.t, len(f.lastInput.Contents[0].Parts[0].Text) > 0)
+
+	outBytes, err := json.Marshal(f.answer)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func ptString(in string) *string {
+	return &in
+}
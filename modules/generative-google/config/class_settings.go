@@ -0,0 +1,262 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const (
+	projectIDProperty       = "projectId"
+	locationProperty        = "location"
+	modelProperty           = "model"
+	temperatureProperty     = "temperature"
+	maxOutputTokensProperty = "maxOutputTokens"
+	topPProperty            = "topP"
+	topKProperty            = "topK"
+	safetySettingsProperty  = "safetySettings"
+)
+
+var AvailableGeminiModels = []string{
+	"gemini-1.0-pro",
+	"gemini-1.5-pro",
+	"gemini-1.5-flash",
+}
+
+var availableHarmCategories = []string{
+	"HARM_CATEGORY_HARASSMENT",
+	"HARM_CATEGORY_HATE_SPEECH",
+	"HARM_CATEGORY_SEXUALLY_EXPLICIT",
+	"HARM_CATEGORY_DANGEROUS_CONTENT",
+}
+
+var availableHarmBlockThresholds = []string{
+	"BLOCK_NONE",
+	"BLOCK_ONLY_HIGH",
+	"BLOCK_MEDIUM_AND_ABOVE",
+	"BLOCK_LOW_AND_ABOVE",
+}
+
+var (
+	DefaultGeminiLocation        = "us-central1"
+	DefaultGeminiModel           = "gemini-1.5-flash"
+	DefaultGeminiTemperature     = 0.9
+	DefaultGeminiMaxOutputTokens = 2048
+	DefaultGeminiTopP            = 0.95
+	DefaultGeminiTopK            = 40
+)
+
+// SafetySetting overrides the threshold at which Gemini blocks answers of
+// a harm category
+type SafetySetting struct {
+	Category  string `json:"category"`
+	Threshold string `json:"threshold"`
+}
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	var errorMessages []string
+
+	if !contains(AvailableGeminiModels, ic.Model()) {
+		errorMessages = append(errorMessages, fmt.Sprintf("wrong Gemini model name, available model names are: %v", AvailableGeminiModels))
+	}
+	temperature := ic.Temperature()
+	if temperature < 0 || temperature > 2 {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be float value between 0 and 2", temperatureProperty))
+	}
+	maxOutputTokens := ic.MaxOutputTokens()
+	if maxOutputTokens < 1 || maxOutputTokens > 8192 {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be an integer value between 1 and 8192", maxOutputTokensProperty))
+	}
+	topK := ic.TopK()
+	if topK < 1 || topK > 40 {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be an integer value between 1 and 40", topKProperty))
+	}
+	topP := ic.TopP()
+	if topP < 0 || topP > 1 {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be float value between 0 and 1", topPProperty))
+	}
+	if _, err := ic.safetySettings(); err != nil {
+		errorMessages = append(errorMessages, err.Error())
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("%s", strings.Join(errorMessages, ", "))
+	}
+
+	return nil
+}
+
+func (ic *classSettings) getProperty(name string) (interface{}, bool) {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return nil, false
+	}
+
+	value, ok := ic.cfg.ClassByModuleName("generative-google")[name]
+	return value, ok
+}
+
+func (ic *classSettings) getStringProperty(name, defaultValue string) string {
+	value, ok := ic.getProperty(name)
+	if ok {
+		asString, ok := value.(string)
+		if ok {
+			return asString
+		}
+	}
+	return defaultValue
+}
+
+func (ic *classSettings) getFloatProperty(name string, defaultValue float64) float64 {
+	val, ok := ic.getProperty(name)
+	if ok {
+		asFloat, ok := val.(float64)
+		if ok {
+			return asFloat
+		}
+		asNumber, ok := val.(json.Number)
+		if ok {
+			asFloat, _ := asNumber.Float64()
+			return asFloat
+		}
+		asInt, ok := val.(int)
+		if ok {
+			asFloat := float64(asInt)
+			return asFloat
+		}
+	}
+
+	return defaultValue
+}
+
+func (ic *classSettings) getIntProperty(name string, defaultValue int) int {
+	val, ok := ic.getProperty(name)
+	if ok {
+		asFloat, ok := val.(float64)
+		if ok {
+			return int(asFloat)
+		}
+		asNumber, ok := val.(json.Number)
+		if ok {
+			asInt64, _ := asNumber.Int64()
+			return int(asInt64)
+		}
+		asInt, ok := val.(int)
+		if ok {
+			return asInt
+		}
+	}
+
+	return defaultValue
+}
+
+// ProjectID is the Google Cloud project of Vertex AI, without it the
+// Gemini API of Google AI Studio is used
+func (ic *classSettings) ProjectID() string {
+	return ic.getStringProperty(projectIDProperty, "")
+}
+
+func (ic *classSettings) Location() string {
+	return ic.getStringProperty(locationProperty, DefaultGeminiLocation)
+}
+
+func (ic *classSettings) Model() string {
+	return ic.getStringProperty(modelProperty, DefaultGeminiModel)
+}
+
+// parameters
+
+// 0.0 - 2.0
+func (ic *classSettings) Temperature() float64 {
+	return ic.getFloatProperty(temperatureProperty, DefaultGeminiTemperature)
+}
+
+// 1 - 8192
+func (ic *classSettings) MaxOutputTokens() int {
+	return ic.getIntProperty(maxOutputTokensProperty, DefaultGeminiMaxOutputTokens)
+}
+
+// 1 - 40
+func (ic *classSettings) TopK() int {
+	return ic.getIntProperty(topKProperty, DefaultGeminiTopK)
+}
+
+// 0.0 - 1.0
+func (ic *classSettings) TopP() float64 {
+	return ic.getFloatProperty(topPProperty, DefaultGeminiTopP)
+}
+
+// SafetySettings are passed to Gemini as they are, categories which are not
+// configured keep Gemini's default threshold
+func (ic *classSettings) SafetySettings() []SafetySetting {
+	safetySettings, _ := ic.safetySettings()
+	return safetySettings
+}
+
+func (ic *classSettings) safetySettings() ([]SafetySetting, error) {
+	val, ok := ic.getProperty(safetySettingsProperty)
+	if !ok || val == nil {
+		return nil, nil
+	}
+	settings, ok := val.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("%s has to be a list of category and threshold objects", safetySettingsProperty)
+	}
+
+	safetySettings := make([]SafetySetting, len(settings))
+	for i, setting := range settings {
+		asMap, ok := setting.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("%s has to be a list of category and threshold objects", safetySettingsProperty)
+		}
+		category, _ := asMap["category"].(string)
+		threshold, _ := asMap["threshold"].(string)
+		if !contains(availableHarmCategories, category) {
+			return nil, errors.Errorf("wrong %s category %q, available categories are: %v",
+				safetySettingsProperty, category, availableHarmCategories)
+		}
+		if !contains(availableHarmBlockThresholds, threshold) {
+			return nil, errors.Errorf("wrong %s threshold %q, available thresholds are: %v",
+				safetySettingsProperty, threshold, availableHarmBlockThresholds)
+		}
+		safetySettings[i] = SafetySetting{Category: category, Threshold: threshold}
+	}
+	return safetySettings, nil
+}
+
+func contains[T comparable](s []T, e T) bool {
+	for _, v := range s {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name                string
+		cfg                 moduletools.ClassConfig
+		wantProjectID       string
+		wantLocation        string
+		wantModel           string
+		wantTemperature     float64
+		wantMaxOutputTokens int
+		wantTopK            int
+		wantTopP            float64
+		wantSafetySettings  []SafetySetting
+		wantErr             error
+	}{
+		{
+			name: "default settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantLocation:        "us-central1",
+			wantModel:           "gemini-1.5-flash",
+			wantTemperature:     0.9,
+			wantMaxOutputTokens: 2048,
+			wantTopK:            40,
+			wantTopP:            0.95,
+			wantErr:             nil,
+		},
+		{
+			name: "everything non default configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"projectId":       "projectId",
+					"location":        "europe-west4",
+					"model":           "gemini-1.5-pro",
+					"temperature":     1.5,
+					"maxOutputTokens": 8192,
+					"topK":            20,
+					"topP":            0.5,
+					"safetySettings": []interface{}{
+						map[string]interface{}{
+							"category":  "HARM_CATEGORY_HATE_SPEECH",
+							"threshold": "BLOCK_ONLY_HIGH",
+						},
+					},
+				},
+			},
+			wantProjectID:       "projectId",
+			wantLocation:        "europe-west4",
+			wantModel:           "gemini-1.5-pro",
+			wantTemperature:     1.5,
+			wantMaxOutputTokens: 8192,
+			wantTopK:            20,
+			wantTopP:            0.5,
+			wantSafetySettings: []SafetySetting{
+				{Category: "HARM_CATEGORY_HATE_SPEECH", Threshold: "BLOCK_ONLY_HIGH"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "wrong model and temperature",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model":       "chat-bison",
+					"temperature": 3,
+				},
+			},
+			wantErr: errors.Errorf("wrong Gemini model name, available model names are: " +
+				"[gemini-1.0-pro gemini-1.5-pro gemini-1.5-flash], " +
+				"temperature has to be float value between 0 and 2"),
+		},
+		{
+			name: "wrong safety settings threshold",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"safetySettings": []interface{}{
+						map[string]interface{}{
+							"category":  "HARM_CATEGORY_HARASSMENT",
+							"threshold": "BLOCK_ALL",
+						},
+					},
+				},
+			},
+			wantErr: errors.Errorf("wrong safetySettings threshold \"BLOCK_ALL\", available thresholds are: " +
+				"[BLOCK_NONE BLOCK_ONLY_HIGH BLOCK_MEDIUM_AND_ABOVE BLOCK_LOW_AND_ABOVE]"),
+		},
+		{
+			name: "safety settings not a list",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"safetySettings": "BLOCK_NONE",
+				},
+			},
+			wantErr: errors.Errorf("safetySettings has to be a list of category and threshold objects"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr.Error(), ic.Validate(nil).Error())
+			} else {
+				assert.Nil(t, ic.Validate(nil))
+				assert.Equal(t, tt.wantProjectID, ic.ProjectID())
+				assert.Equal(t, tt.wantLocation, ic.Location())
+				assert.Equal(t, tt.wantModel, ic.Model())
+				assert.Equal(t, tt.wantTemperature, ic.Temperature())
+				assert.Equal(t, tt.wantMaxOutputTokens, ic.MaxOutputTokens())
+				assert.Equal(t, tt.wantTopK, ic.TopK())
+				assert.Equal(t, tt.wantTopP, ic.TopP())
+				assert.Equal(t, tt.wantSafetySettings, ic.SafetySettings())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/generative-openai/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
 )
 
@@ -48,22 +49,27 @@ func buildUrlFn(isLegacy bool, resourceName, deploymentID string) (string, error
 }
 
 type openai struct {
-	openAIApiKey string
-	azureApiKey  string
-	buildUrl     func(isLegacy bool, resourceName, deploymentID string) (string, error)
-	httpClient   *http.Client
-	logger       logrus.FieldLogger
+	openAIApiKey   string
+	azureApiKey    string
+	buildUrl       func(isLegacy bool, resourceName, deploymentID string) (string, error)
+	getTokensCount func(model string, messages []message) (int, error)
+	httpClient     *http.Client
+	logger         logrus.FieldLogger
 }
 
-func New(openAIApiKey, azureApiKey string, logger logrus.FieldLogger) *openai {
+func New(openAIApiKey, azureApiKey string, transport http.RoundTripper,
+	logger logrus.FieldLogger,
+) *openai {
 	return &openai{
 		openAIApiKey: openAIApiKey,
 		azureApiKey:  azureApiKey,
 		httpClient: &http.Client{
-			Timeout: 60 * time.Second,
+			Transport: transport,
+			Timeout:   60 * time.Second,
 		},
-		buildUrl: buildUrlFn,
-		logger:   logger,
+		buildUrl:       buildUrlFn,
+		getTokensCount: getTokensCount,
+		logger:         logger,
 	}
 }
 
@@ -83,35 +89,38 @@ func (v *openai) GenerateAllResults(ctx context.Context, textProperties []map[st
 	return v.Generate(ctx, cfg, forTask)
 }
 
-func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*generativemodels.GenerateResponse, error) {
-	settings := config.NewClassSettings(cfg)
-
-	oaiUrl, err := v.buildUrl(settings.IsLegacy(), settings.ResourceName(), settings.DeploymentID())
+// GenerateSingleResultWithImages passes the images along with the prompt to
+// vision capable models such as gpt-4o
+func (v *openai) GenerateSingleResultWithImages(ctx context.Context, textProperties map[string]string, images []string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(textProperties, prompt)
 	if err != nil {
-		return nil, errors.Wrap(err, "url join path")
+		return nil, err
 	}
+	return v.generate(ctx, cfg, forPrompt, images)
+}
 
-	input, err := v.generateInput(prompt, settings)
+func (v *openai) GenerateAllResultsWithImages(ctx context.Context, textProperties []map[string]string, images []string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(textProperties, task)
 	if err != nil {
-		return nil, errors.Wrap(err, "generate input")
+		return nil, err
 	}
+	return v.generate(ctx, cfg, forTask, images)
+}
 
-	body, err := json.Marshal(input)
-	if err != nil {
-		return nil, errors.Wrap(err, "marshal body")
-	}
+func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*generativemodels.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, nil)
+}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", oaiUrl,
-		bytes.NewReader(body))
-	if err != nil {
-		return nil, errors.Wrap(err, "create POST request")
+func (v *openai) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, images []string) (*generativemodels.GenerateResponse, error) {
+	settings := config.NewClassSettings(cfg)
+	if len(images) > 0 && settings.IsLegacy() {
+		return nil, errors.Errorf("model %s doesn't support images", settings.Model())
 	}
-	apiKey, err := v.getApiKey(ctx, settings.IsAzure())
+
+	req, err := v.newRequest(ctx, settings, prompt, images, false)
 	if err != nil {
-		return nil, errors.Wrapf(err, "OpenAI API Key")
+		return nil, err
 	}
-	req.Header.Add(v.getApiKeyHeaderAndValue(apiKey, settings.IsAzure()))
-	req.Header.Add("Content-Type", "application/json")
 
 	res, err := v.httpClient.Do(req)
 	if err != nil {
@@ -138,6 +147,7 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 		trimmedResponse := strings.Trim(textResponse, "\n")
 		return &generativemodels.GenerateResponse{
 			Result: &trimmedResponse,
+			Usage:  resBody.usage(settings.Model()),
 		}, nil
 	}
 
@@ -147,15 +157,56 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 		trimmedResponse := strings.Trim(textResponse, "\n")
 		return &generativemodels.GenerateResponse{
 			Result: &trimmedResponse,
+			Usage:  resBody.usage(settings.Model()),
 		}, nil
 	}
 
 	return &generativemodels.GenerateResponse{
 		Result: nil,
+		Usage:  resBody.usage(settings.Model()),
 	}, nil
 }
 
-func (v *openai) generateInput(prompt string, settings config.ClassSettings) (generateInput, error) {
+func (v *openai) newRequest(ctx context.Context, settings config.ClassSettings,
+	prompt string, images []string, stream bool,
+) (*http.Request, error) {
+	oaiUrl, err := v.buildUrl(settings.IsLegacy(), settings.ResourceName(), settings.DeploymentID())
+	if err != nil {
+		return nil, errors.Wrap(err, "url join path")
+	}
+
+	input, err := v.generateInput(prompt, images, settings)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate input")
+	}
+	input.Stream = stream
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", oaiUrl,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	apiKey, err := v.getApiKey(ctx, settings.IsAzure())
+	if err != nil {
+		return nil, errors.Wrapf(err, "OpenAI API Key")
+	}
+	req.Header.Add(v.getApiKeyHeaderAndValue(apiKey, settings.IsAzure()))
+	req.Header.Add("Content-Type", "application/json")
+	if organization := settings.Organization(); organization != "" {
+		req.Header.Add("OpenAI-Organization", organization)
+	}
+	if project := settings.Project(); project != "" {
+		req.Header.Add("OpenAI-Project", project)
+	}
+	return req, nil
+}
+
+func (v *openai) generateInput(prompt string, images []string, settings config.ClassSettings) (generateInput, error) {
 	if settings.IsLegacy() {
 		return generateInput{
 			Prompt:           prompt,
@@ -171,6 +222,7 @@ func (v *openai) generateInput(prompt string, settings config.ClassSettings) (ge
 		messages := []message{{
 			Role:    "user",
 			Content: prompt,
+			Images:  images,
 		}}
 		tokens, err := v.determineTokens(settings.GetMaxTokensForModel(settings.Model()), settings.MaxTokens(), settings.Model(), messages)
 		if err != nil {
@@ -204,7 +256,7 @@ func (v *openai) getError(statusCode int, resBodyError *openAIApiError, isAzure
 }
 
 func (v *openai) determineTokens(maxTokensSetting float64, classSetting float64, model string, messages []message) (float64, error) {
-	tokenMessagesCount, err := getTokensCount(model, messages)
+	tokenMessagesCount, err := v.getTokensCount(model, messages)
 	if err != nil {
 		return 0, err
 	}
@@ -248,30 +300,25 @@ func (v *openai) generateForPrompt(textProperties map[string]string, prompt stri
 }
 
 func (v *openai) getApiKey(ctx context.Context, isAzure bool) (string, error) {
-	var apiKey, envVar string
+	var apiKey, envVar, envApiKey string
 
 	if isAzure {
 		apiKey = "X-Azure-Api-Key"
 		envVar = "AZURE_APIKEY"
-		if len(v.azureApiKey) > 0 {
-			return v.azureApiKey, nil
-		}
+		envApiKey = v.azureApiKey
 	} else {
 		apiKey = "X-Openai-Api-Key"
 		envVar = "OPENAI_APIKEY"
-		if len(v.openAIApiKey) > 0 {
-			return v.openAIApiKey, nil
-		}
+		envApiKey = v.openAIApiKey
 	}
 
-	return v.getApiKeyFromContext(ctx, apiKey, envVar)
-}
-
-func (v *openai) getApiKeyFromContext(ctx context.Context, apiKey, envVar string) (string, error) {
-	if apiValue := ctx.Value(apiKey); apiValue != nil {
-		if apiKeyHeader, ok := apiValue.([]string); ok && len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
-			return apiKeyHeader[0], nil
-		}
+	// a key passed with the request takes precedence over the one the
+	// server was started with
+	if apiKeyValue := moduletools.GetValueFromContext(ctx, apiKey); apiKeyValue != "" {
+		return apiKeyValue, nil
+	}
+	if envApiKey != "" {
+		return envApiKey, nil
 	}
 	return "", fmt.Errorf("no api key found neither in request header: %s nor in environment variable under %s", apiKey, envVar)
 }
@@ -286,25 +333,79 @@ type generateInput struct {
 	FrequencyPenalty float64   `json:"frequency_penalty"`
 	PresencePenalty  float64   `json:"presence_penalty"`
 	TopP             float64   `json:"top_p"`
+	Stream           bool      `json:"stream,omitempty"`
 }
 
 type message struct {
 	Role    string `json:"role"`
 	Content string `json:"content"`
 	Name    string `json:"name,omitempty"`
+	// base64 encoded images sent as image_url content parts after the text
+	Images []string `json:"-"`
+}
+
+func (m message) MarshalJSON() ([]byte, error) {
+	type plainMessage message
+	if len(m.Images) == 0 {
+		return json.Marshal(plainMessage(m))
+	}
+	parts := []contentPart{{Type: "text", Text: m.Content}}
+	for _, image := range m.Images {
+		parts = append(parts, contentPart{
+			Type: "image_url",
+			ImageURL: &imageURL{
+				URL: fmt.Sprintf("data:%s;base64,%s", generate.ImageMediaType(image), image),
+			},
+		})
+	}
+	return json.Marshal(struct {
+		Role    string        `json:"role"`
+		Content []contentPart `json:"content"`
+		Name    string        `json:"name,omitempty"`
+	}{m.Role, parts, m.Name})
+}
+
+type contentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *imageURL `json:"image_url,omitempty"`
+}
+
+type imageURL struct {
+	URL string `json:"url"`
 }
 
 type generateResponse struct {
 	Choices []choice
+	Usage   *usage          `json:"usage,omitempty"`
 	Error   *openAIApiError `json:"error,omitempty"`
 }
 
+type usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+func (r *generateResponse) usage(model string) *generativemodels.GenerateUsage {
+	if r.Usage == nil {
+		return nil
+	}
+	return &generativemodels.GenerateUsage{
+		Model:            model,
+		PromptTokens:     r.Usage.PromptTokens,
+		CompletionTokens: r.Usage.CompletionTokens,
+		TotalTokens:      r.Usage.TotalTokens,
+	}
+}
+
 type choice struct {
 	FinishReason string
 	Index        float32
 	Logprobs     string
 	Text         string   `json:"text,omitempty"`
 	Message      *message `json:"message,omitempty"`
+	Delta        *message `json:"delta,omitempty"`
 }
 
 type openAIApiError struct {
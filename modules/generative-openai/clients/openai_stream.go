@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/generative-openai/config"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+func (v *openai) GenerateSingleResultStream(ctx context.Context, textProperties map[string]string,
+	prompt string, cfg moduletools.ClassConfig, onChunk func(text string) error,
+) (*generativemodels.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return v.GenerateStream(ctx, cfg, forPrompt, onChunk)
+}
+
+func (v *openai) GenerateAllResultsStream(ctx context.Context, textProperties []map[string]string,
+	task string, cfg moduletools.ClassConfig, onChunk func(text string) error,
+) (*generativemodels.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return v.GenerateStream(ctx, cfg, forTask, onChunk)
+}
+
+// GenerateStream requests the answer as server-sent events and passes its
+// text to onChunk as it arrives. Newlines around the answer are trimmed the
+// same way Generate trims them, so the chunks add up to the returned result.
+func (v *openai) GenerateStream(ctx context.Context, cfg moduletools.ClassConfig,
+	prompt string, onChunk func(text string) error,
+) (*generativemodels.GenerateResponse, error) {
+	settings := config.NewClassSettings(cfg)
+
+	req, err := v.newRequest(ctx, settings, prompt, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		bodyBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "read response body")
+		}
+		var resBody generateResponse
+		if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+			return nil, errors.Wrap(err, "unmarshal response body")
+		}
+		return nil, v.getError(res.StatusCode, resBody.Error, settings.IsAzure())
+	}
+
+	var text strings.Builder
+	sent := 0
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk generateResponse
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return nil, errors.Wrap(err, "unmarshal response chunk")
+		}
+		if chunk.Error != nil {
+			return nil, v.getError(res.StatusCode, chunk.Error, settings.IsAzure())
+		}
+		if len(chunk.Choices) == 0 {
+			// Azure sends the results of its content filters in a chunk
+			// without choices
+			continue
+		}
+
+		text.WriteString(chunk.Choices[0].Text)
+		if delta := chunk.Choices[0].Delta; delta != nil {
+			text.WriteString(delta.Content)
+		}
+
+		// newlines at the end are held back until more text follows, so
+		// everything sent is a prefix of the trimmed answer
+		trimmed := strings.Trim(text.String(), "\n")
+		if len(trimmed) > sent {
+			if err := onChunk(trimmed[sent:]); err != nil {
+				return nil, errors.Wrap(err, "stream chunk")
+			}
+			sent = len(trimmed)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read response stream")
+	}
+
+	trimmedResponse := strings.Trim(text.String(), "\n")
+	return &generativemodels.GenerateResponse{
+		Result: &trimmedResponse,
+	}, nil
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTokensCount counts the words of the messages, the tiktoken encodings
+// would be downloaded on first use
+func fakeTokensCount(model string, messages []message) (int, error) {
+	count := 0
+	for _, m := range messages {
+		count += len(strings.Fields(m.Content))
+	}
+	return count, nil
+}
+
+func TestGetAnswerStream(t *testing.T) {
+	textProperties := []map[string]string{{"prop": "My name is john"}}
+
+	t.Run("when the server streams the answer", func(t *testing.T) {
+		handler := &testStreamHandler{
+			t:      t,
+			deltas: []string{"\n", "My name", "\n", " is", " John", "\n\n"},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.getTokensCount = fakeTokensCount
+		c.buildUrl = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
+			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
+		}
+
+		var chunks []string
+		res, err := c.GenerateAllResultsStream(context.Background(), textProperties, "What is my name?", nil,
+			func(text string) error {
+				chunks = append(chunks, text)
+				return nil
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, true, handler.lastBody["stream"])
+		assert.Equal(t, []string{"My name", "\n is", " John"}, chunks)
+		assert.Equal(t, "My name\n is John", *res.Result)
+	})
+
+	t.Run("when the stream consumer fails", func(t *testing.T) {
+		server := httptest.NewServer(&testStreamHandler{
+			t:      t,
+			deltas: []string{"My name", " is John"},
+		})
+		defer server.Close()
+
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.getTokensCount = fakeTokensCount
+		c.buildUrl = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
+			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
+		}
+
+		_, err := c.GenerateAllResultsStream(context.Background(), textProperties, "What is my name?", nil,
+			func(text string) error {
+				return fmt.Errorf("client gone")
+			})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "stream chunk: client gone")
+	})
+
+	t.Run("when the server has an error", func(t *testing.T) {
+		server := httptest.NewServer(&testStreamHandler{
+			t:   t,
+			err: &openAIApiError{Message: "some error from the server"},
+		})
+		defer server.Close()
+
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.getTokensCount = fakeTokensCount
+		c.buildUrl = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
+			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
+		}
+
+		_, err := c.GenerateAllResultsStream(context.Background(), textProperties, "What is my name?", nil,
+			func(text string) error { return nil })
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to: OpenAI API failed with status: 500 error: some error from the server")
+	})
+}
+
+type testStreamHandler struct {
+	t        *testing.T
+	deltas   []string
+	err      *openAIApiError
+	lastBody map[string]interface{}
+}
+
+func (f *testStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/v1/chat/completions", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastBody))
+
+	if f.err != nil {
+		outBytes, err := json.Marshal(generateResponse{Error: f.err})
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	for _, delta := range f.deltas {
+		outBytes, err := json.Marshal(generateResponse{
+			Choices: []choice{{Delta: &message{Role: "assistant", Content: delta}}},
+		})
+		require.Nil(f.t, err)
+		fmt.Fprintf(w, "data: %s\n\n", outBytes)
+	}
+	fmt.Fprint(w, "data: [DONE]\n\n")
+}
@@ -59,7 +59,8 @@ func TestGetAnswer(t *testing.T) {
 		server := httptest.NewServer(handler)
 		defer server.Close()
 
-		c := New("openAIApiKey", "", nullLogger())
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.getTokensCount = fakeTokensCount
 		c.buildUrl = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
 			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
 		}
@@ -74,6 +75,73 @@ func TestGetAnswer(t *testing.T) {
 		assert.Equal(t, expected, *res)
 	})
 
+	t.Run("when the server reports token usage", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t: t,
+			answer: generateResponse{
+				Choices: []choice{{
+					FinishReason: "test",
+					Index:        0,
+					Text:         "John",
+				}},
+				Usage: &usage{
+					PromptTokens:     12,
+					CompletionTokens: 3,
+					TotalTokens:      15,
+				},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.getTokensCount = fakeTokensCount
+		c.buildUrl = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
+			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
+		}
+
+		res, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, &generativemodels.GenerateUsage{
+			Model:            "gpt-3.5-turbo",
+			PromptTokens:     12,
+			CompletionTokens: 3,
+			TotalTokens:      15,
+		}, res.Usage)
+	})
+
+	t.Run("when organization and project are configured", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t: t,
+			answer: generateResponse{
+				Choices: []choice{{
+					FinishReason: "test",
+					Index:        0,
+					Text:         "John",
+				}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.getTokensCount = fakeTokensCount
+		c.buildUrl = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
+			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
+		}
+
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{
+			"organization": "org-AbC",
+			"project":      "proj_XyZ",
+		}}
+		_, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", cfg)
+
+		require.Nil(t, err)
+		assert.Equal(t, "org-AbC", handler.lastHeader.Get("OpenAI-Organization"))
+		assert.Equal(t, "proj_XyZ", handler.lastHeader.Get("OpenAI-Project"))
+	})
+
 	t.Run("when the server has a an error", func(t *testing.T) {
 		server := httptest.NewServer(&testAnswerHandler{
 			t: t,
@@ -85,7 +153,8 @@ func TestGetAnswer(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := New("openAIApiKey", "", nullLogger())
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.getTokensCount = fakeTokensCount
 		c.buildUrl = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
 			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
 		}
@@ -100,12 +169,14 @@ func TestGetAnswer(t *testing.T) {
 type testAnswerHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
-	answer generateResponse
+	answer     generateResponse
+	lastHeader http.Header
 }
 
 func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	assert.Equal(f.t, "/v1/chat/completions", r.URL.String())
 	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastHeader = r.Header
 
 	if f.answer.Error != nil && f.answer.Error.Message != "" {
 		outBytes, err := json.Marshal(f.answer)
@@ -132,3 +203,42 @@ func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func ptString(in string) *string {
 	return &in
 }
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func TestMessageWithImages(t *testing.T) {
+	t.Run("without images", func(t *testing.T) {
+		body, err := json.Marshal(message{Role: "user", Content: "What is my name?"})
+
+		require.Nil(t, err)
+		assert.JSONEq(t, `{"role":"user","content":"What is my name?"}`, string(body))
+	})
+
+	t.Run("with images", func(t *testing.T) {
+		body, err := json.Marshal(message{Role: "user", Content: "Describe this photo", Images: []string{"aW1hZ2U="}})
+
+		require.Nil(t, err)
+		assert.JSONEq(t, `{"role":"user","content":[
+			{"type":"text","text":"Describe this photo"},
+			{"type":"image_url","image_url":{"url":"data:image/jpeg;base64,aW1hZ2U="}}
+		]}`, string(body))
+	})
+}
@@ -14,6 +14,7 @@ package clients
 import (
 	"testing"
 
+	"github.com/pkoukk/tiktoken-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -120,6 +121,12 @@ func Test_getTokensCount(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				// tiktoken downloads the encoding on first use
+				if _, err := tiktoken.EncodingForModel(tt.model); err != nil {
+					t.Skipf("encoding for model %s not available: %v", tt.model, err)
+				}
+			}
 			got, err := getTokensCount(tt.model, tt.messages)
 			if err != nil {
 				assert.EqualError(t, err, tt.wantErr)
@@ -70,6 +70,8 @@ type ClassSettings interface {
 	ResourceName() string
 	DeploymentID() string
 	IsAzure() bool
+	Organization() string
+	Project() string
 	GetMaxTokensForModel(model string) float64
 	Validate(class *models.Class) error
 }
@@ -123,6 +125,10 @@ func (ic *classSettings) Validate(class *models.Class) error {
 		return err
 	}
 
+	if ic.IsAzure() && (ic.Organization() != "" || ic.Project() != "") {
+		return errors.Errorf("organization and project can't be combined with resourceName and deploymentId")
+	}
+
 	return nil
 }
 
@@ -224,6 +230,14 @@ func (ic *classSettings) IsAzure() bool {
 	return ic.ResourceName() != "" && ic.DeploymentID() != ""
 }
 
+func (ic *classSettings) Organization() string {
+	return *ic.getStringProperty("organization", "")
+}
+
+func (ic *classSettings) Project() string {
+	return *ic.getStringProperty("project", "")
+}
+
 func (ic *classSettings) validateAzureConfig(resourceName string, deploymentId string) error {
 	if (resourceName == "" && deploymentId != "") || (resourceName != "" && deploymentId == "") {
 		return fmt.Errorf("both resourceName and deploymentId must be provided")
@@ -32,6 +32,8 @@ func Test_classSettings_Validate(t *testing.T) {
 		wantResourceName     string
 		wantDeploymentID     string
 		wantIsAzure          bool
+		wantOrganization     string
+		wantProject          string
 		wantErr              error
 	}{
 		{
@@ -111,6 +113,35 @@ func Test_classSettings_Validate(t *testing.T) {
 			wantPresencePenalty:  0.9,
 			wantErr:              nil,
 		},
+		{
+			name: "OpenAI organization and project",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"organization": "org-AbC",
+					"project":      "proj_XyZ",
+				},
+			},
+			wantModel:            "gpt-3.5-turbo",
+			wantMaxTokens:        4097,
+			wantTemperature:      0.0,
+			wantTopP:             1,
+			wantFrequencyPenalty: 0.0,
+			wantPresencePenalty:  0.0,
+			wantOrganization:     "org-AbC",
+			wantProject:          "proj_XyZ",
+			wantErr:              nil,
+		},
+		{
+			name: "Wrong organization combined with Azure",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"resourceName": "weaviate",
+					"deploymentId": "gpt-3.5-turbo",
+					"organization": "org-AbC",
+				},
+			},
+			wantErr: errors.Errorf("organization and project can't be combined with resourceName and deploymentId"),
+		},
 		{
 			name: "With gpt-3.5-turbo-16k model",
 			cfg: fakeClassConfig{
@@ -210,6 +241,8 @@ func Test_classSettings_Validate(t *testing.T) {
 				assert.Equal(t, tt.wantResourceName, ic.ResourceName())
 				assert.Equal(t, tt.wantDeploymentID, ic.DeploymentID())
 				assert.Equal(t, tt.wantIsAzure, ic.IsAzure())
+				assert.Equal(t, tt.wantOrganization, ic.Organization())
+				assert.Equal(t, tt.wantProject, ic.Project())
 			}
 		})
 	}
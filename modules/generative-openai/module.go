@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/modules/generative-openai/clients"
 	additionalprovider "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/classification"
 )
 
 const Name = "generative-openai"
@@ -34,6 +35,7 @@ func New() *GenerativeOpenAIModule {
 type GenerativeOpenAIModule struct {
 	generative                   generativeClient
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	classifiers                  []modulecapabilities.Classifier
 }
 
 type generativeClient interface {
@@ -67,11 +69,22 @@ func (m *GenerativeOpenAIModule) initAdditional(ctx context.Context,
 	openAIApiKey := os.Getenv("OPENAI_APIKEY")
 	azureApiKey := os.Getenv("AZURE_APIKEY")
 
-	client := clients.New(openAIApiKey, azureApiKey, logger)
+	httpOptions, err := moduletools.HTTPClientOptionsFromEnv("OPENAI")
+	if err != nil {
+		return err
+	}
+	transport, err := moduletools.NewHTTPTransport(httpOptions)
+	if err != nil {
+		return err
+	}
+	client := clients.New(openAIApiKey, azureApiKey, transport, logger)
 
 	m.generative = client
 
-	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(m.generative)
+	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(Name, m.generative)
+	m.classifiers = []modulecapabilities.Classifier{
+		classification.NewZeroShotClassifier(Name, m.generative),
+	}
 
 	return nil
 }
@@ -89,9 +102,14 @@ func (m *GenerativeOpenAIModule) AdditionalProperties() map[string]modulecapabil
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
 
+func (m *GenerativeOpenAIModule) Classifiers() []modulecapabilities.Classifier {
+	return m.classifiers
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ClassificationProvider(New())
 )
@@ -146,6 +146,7 @@ func (v *palm) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt
 			trimmedResponse := strings.Trim(content, "\n")
 			return &generativemodels.GenerateResponse{
 				Result: &trimmedResponse,
+				Usage:  resBody.usage(modelID),
 			}, nil
 		}
 	}
@@ -155,6 +156,15 @@ func (v *palm) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt
 	}, nil
 }
 
+// OverrideNames maps the settings a query can override to the names of the
+// class settings
+func (v *palm) OverrideNames() map[string]string {
+	return map[string]string{
+		"model":     "modelId",
+		"maxTokens": "tokenLimit",
+	}
+}
+
 func (v *palm) generatePromptForTask(textProperties []map[string]string, task string) (string, error) {
 	marshal, err := json.Marshal(textProperties)
 	if err != nil {
@@ -223,6 +233,7 @@ type parameters struct {
 
 type generateResponse struct {
 	Predictions      []prediction  `json:"predictions,omitempty"`
+	Metadata         *metadata     `json:"metadata,omitempty"`
 	Error            *palmApiError `json:"error,omitempty"`
 	DeployedModelId  string        `json:"deployedModelId,omitempty"`
 	Model            string        `json:"model,omitempty"`
@@ -230,6 +241,33 @@ type generateResponse struct {
 	ModelVersionId   string        `json:"modelVersionId,omitempty"`
 }
 
+type metadata struct {
+	TokenMetadata *tokenMetadata `json:"tokenMetadata,omitempty"`
+}
+
+type tokenMetadata struct {
+	InputTokenCount  tokenCount `json:"inputTokenCount"`
+	OutputTokenCount tokenCount `json:"outputTokenCount"`
+}
+
+type tokenCount struct {
+	TotalTokens             int `json:"totalTokens"`
+	TotalBillableCharacters int `json:"totalBillableCharacters"`
+}
+
+func (r *generateResponse) usage(model string) *generativemodels.GenerateUsage {
+	if r.Metadata == nil || r.Metadata.TokenMetadata == nil {
+		return nil
+	}
+	tokens := r.Metadata.TokenMetadata
+	return &generativemodels.GenerateUsage{
+		Model:            model,
+		PromptTokens:     tokens.InputTokenCount.TotalTokens,
+		CompletionTokens: tokens.OutputTokenCount.TotalTokens,
+		TotalTokens:      tokens.InputTokenCount.TotalTokens + tokens.OutputTokenCount.TotalTokens,
+	}
+}
+
 type prediction struct {
 	Candidates       []candidate       `json:"candidates,omitempty"`
 	SafetyAttributes *safetyAttributes `json:"safetyAttributes,omitempty"`
@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/modules/generative-palm/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
 )
 
 func (m *GenerativePaLMModule) ClassConfigDefaults() map[string]interface{} {
@@ -35,7 +36,10 @@ func (m *GenerativePaLMModule) ValidateClass(ctx context.Context,
 	class *models.Class, cfg moduletools.ClassConfig,
 ) error {
 	settings := config.NewClassSettings(cfg)
-	return settings.Validate(class)
+	if err := settings.Validate(class); err != nil {
+		return err
+	}
+	return generate.ValidatePromptTemplates(cfg.ClassByModuleName(Name))
 }
 
 var _ = modulecapabilities.ClassConfigurator(New())
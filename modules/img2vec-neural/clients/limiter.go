@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// concurrencyLimiter caps the number of requests in flight to an inference
+// container, so that imports can saturate a GPU without running it out of
+// memory. Requests above the limit are queued. A limit of 0 means unlimited.
+type concurrencyLimiter struct {
+	slots chan struct{}
+
+	queued   prometheus.Gauge
+	waitTime prometheus.Observer
+}
+
+func newConcurrencyLimiter(limit int, queued prometheus.Gauge,
+	waitTime prometheus.Observer,
+) *concurrencyLimiter {
+	l := &concurrencyLimiter{queued: queued, waitTime: waitTime}
+	if limit > 0 {
+		l.slots = make(chan struct{}, limit)
+	}
+	return l
+}
+
+// acquire blocks until a request may be sent or the context expires. Every
+// successful call needs to be followed by a call to release.
+func (l *concurrencyLimiter) acquire(ctx context.Context) error {
+	if l.slots == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	l.queued.Inc()
+	defer l.queued.Dec()
+	before := time.Now()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case l.slots <- struct{}{}:
+		l.waitTime.Observe(float64(time.Since(before) / time.Millisecond))
+		return nil
+	}
+}
+
+func (l *concurrencyLimiter) release() {
+	if l.slots == nil {
+		return
+	}
+	<-l.slots
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(limit int) (*concurrencyLimiter, prometheus.Gauge) {
+	queued := prometheus.NewGauge(prometheus.GaugeOpts{Name: "queued"})
+	waitTime := prometheus.NewSummary(prometheus.SummaryOpts{Name: "wait"})
+	return newConcurrencyLimiter(limit, queued, waitTime), queued
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	t.Run("without a limit", func(t *testing.T) {
+		l, _ := newTestLimiter(0)
+		for i := 0; i < 100; i++ {
+			require.Nil(t, l.acquire(context.Background()))
+		}
+	})
+
+	t.Run("with a limit", func(t *testing.T) {
+		l, queued := newTestLimiter(2)
+		require.Nil(t, l.acquire(context.Background()))
+		require.Nil(t, l.acquire(context.Background()))
+
+		acquired := make(chan error)
+		go func() {
+			acquired <- l.acquire(context.Background())
+		}()
+
+		assert.Eventually(t, func() bool {
+			return testutil.ToFloat64(queued) == 1
+		}, time.Second, time.Millisecond)
+		select {
+		case <-acquired:
+			t.Fatal("third request must wait for a free slot")
+		default:
+		}
+
+		l.release()
+		require.Nil(t, <-acquired)
+		assert.Equal(t, float64(0), testutil.ToFloat64(queued))
+	})
+
+	t.Run("when the context expires while waiting", func(t *testing.T) {
+		l, queued := newTestLimiter(1)
+		require.Nil(t, l.acquire(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := l.acquire(ctx)
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Equal(t, float64(0), testutil.ToFloat64(queued))
+	})
+}
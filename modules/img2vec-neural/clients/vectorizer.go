@@ -18,29 +18,74 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/modules/img2vec-neural/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/batch"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const (
+	moduleName = "img2vec-neural"
+
+	// DefaultMaxBatchSize of 1 disables batching, since it requires an
+	// inference container which serves batch requests
+	DefaultMaxBatchSize = 1
+	// DefaultMaxConcurrentRequests of 0 doesn't limit the requests in flight
+	DefaultMaxConcurrentRequests = 0
+
+	// batchWindow is how long the images of a batch import are collected
+	// before a batch which isn't full yet is sent
+	batchWindow = 10 * time.Millisecond
 )
 
 type vectorizer struct {
 	origin     string
 	httpClient *http.Client
 	logger     logrus.FieldLogger
+	limiter    *concurrencyLimiter
+	batcher    *batch.Batcher[vecRequest, vecResponse]
 }
 
-func New(origin string, logger logrus.FieldLogger) *vectorizer {
-	return &vectorizer{
+func New(origin string, maxBatchSize, maxConcurrentRequests int,
+	logger logrus.FieldLogger,
+) *vectorizer {
+	metrics := monitoring.GetMetrics()
+	v := &vectorizer{
 		origin:     origin,
 		httpClient: &http.Client{},
 		logger:     logger,
+		limiter: newConcurrencyLimiter(maxConcurrentRequests,
+			metrics.ModuleExternalRequestsQueued.WithLabelValues(moduleName),
+			metrics.ModuleExternalRequestWaitDurations.WithLabelValues(moduleName)),
+	}
+	if maxBatchSize > 1 {
+		v.batcher = batch.NewBatcher(maxBatchSize, batchWindow, v.vectorizeBatch)
 	}
+	return v
 }
 
 func (v *vectorizer) Vectorize(ctx context.Context,
 	id, image string,
 ) (*ent.VectorizationResult, error) {
+	if v.batcher != nil {
+		res, err := v.batcher.Vectorize(ctx, vecRequest{ID: id, Image: image})
+		if err != nil {
+			return nil, err
+		}
+		if res.Error != "" {
+			return nil, errors.Errorf("vectorize image %s: %s", id, res.Error)
+		}
+		return &ent.VectorizationResult{
+			ID:         res.ID,
+			Image:      image,
+			Dimensions: res.Dim,
+			Vector:     res.Vector,
+		}, nil
+	}
+
 	body, err := json.Marshal(vecRequest{
 		ID:    id,
 		Image: image,
@@ -49,44 +94,83 @@ func (v *vectorizer) Vectorize(ctx context.Context,
 		return nil, errors.Wrapf(err, "marshal body")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", v.url("/vectors"),
+	var resBody vecResponse
+	if err := v.send(ctx, "/vectors", body, &resBody); err != nil {
+		return nil, err
+	}
+
+	return &ent.VectorizationResult{
+		ID:         resBody.ID,
+		Image:      image,
+		Dimensions: resBody.Dim,
+		Vector:     resBody.Vector,
+	}, nil
+}
+
+// vectorizeBatch sends the images of a batch import in a single request to
+// the inference container
+func (v *vectorizer) vectorizeBatch(ctx context.Context,
+	input []vecRequest,
+) ([]vecResponse, error) {
+	body, err := json.Marshal(vecBatchRequest{Images: input})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	var resBody vecBatchResponse
+	if err := v.send(ctx, "/vectors/batch", body, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody.Vectors, nil
+}
+
+func (v *vectorizer) send(ctx context.Context, path string, body []byte,
+	resBody errorResponse,
+) error {
+	if err := v.limiter.acquire(ctx); err != nil {
+		return err
+	}
+	defer v.limiter.release()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", v.url(path),
 		bytes.NewReader(body))
 	if err != nil {
-		return nil, errors.Wrap(err, "create POST request")
+		return errors.Wrap(err, "create POST request")
 	}
 
 	res, err := v.httpClient.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "send POST request")
+		return errors.Wrap(err, "send POST request")
 	}
 	defer res.Body.Close()
 
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "read response body")
+		return errors.Wrap(err, "read response body")
 	}
 
-	var resBody vecResponse
-	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
-		return nil, errors.Wrap(err, "unmarshal response body")
+	if err := json.Unmarshal(bodyBytes, resBody); err != nil {
+		return errors.Wrap(err, "unmarshal response body")
 	}
 
 	if res.StatusCode > 399 {
-		return nil, errors.Errorf("fail with status %d", res.StatusCode)
+		if msg := resBody.errorMessage(); msg != "" {
+			return errors.Errorf("fail with status %d: %s", res.StatusCode, msg)
+		}
+		return errors.Errorf("fail with status %d", res.StatusCode)
 	}
 
-	return &ent.VectorizationResult{
-		ID:         resBody.ID,
-		Image:      image,
-		Dimensions: resBody.Dim,
-		Vector:     resBody.Vector,
-	}, nil
+	return nil
 }
 
 func (v *vectorizer) url(path string) string {
 	return fmt.Sprintf("%s%s", v.origin, path)
 }
 
+type errorResponse interface {
+	errorMessage() string
+}
+
 type vecRequest struct {
 	ID    string `json:"id"`
 	Image string `json:"image"`
@@ -98,3 +182,20 @@ type vecResponse struct {
 	Dim    int       `json:"dim"`
 	Error  string    `json:"error"`
 }
+
+func (r *vecResponse) errorMessage() string {
+	return r.Error
+}
+
+type vecBatchRequest struct {
+	Images []vecRequest `json:"images"`
+}
+
+type vecBatchResponse struct {
+	Vectors []vecResponse `json:"vectors"`
+	Error   string        `json:"error"`
+}
+
+func (r *vecBatchResponse) errorMessage() string {
+	return r.Error
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/img2vec-neural/ent"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("when all is fine", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/vectors", r.URL.String())
+
+			var b vecRequest
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			require.Nil(t, json.NewEncoder(w).Encode(vecResponse{
+				ID:     b.ID,
+				Vector: []float32{0.1, 0.2, 0.3},
+				Dim:    3,
+			}))
+		}))
+		defer server.Close()
+		c := New(server.URL, 1, 0, nullLogger())
+		expected := &ent.VectorizationResult{
+			ID:         "some-id",
+			Image:      "image",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Dimensions: 3,
+		}
+
+		res, err := c.Vectorize(context.Background(), "some-id", "image")
+
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":"nope, not gonna happen"}`))
+		}))
+		defer server.Close()
+		c := New(server.URL, 1, 0, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), "some-id", "image")
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "fail with status 500: nope, not gonna happen")
+	})
+
+	t.Run("when batching is enabled", func(t *testing.T) {
+		var lock sync.Mutex
+		var batches [][]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/vectors/batch", r.URL.String())
+
+			var b vecBatchRequest
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			ids := make([]string, len(b.Images))
+			res := vecBatchResponse{}
+			for i, image := range b.Images {
+				ids[i] = image.ID
+				res.Vectors = append(res.Vectors, vecResponse{
+					ID:     image.ID,
+					Dim:    1,
+					Vector: []float32{float32(len(image.Image))},
+				})
+			}
+			lock.Lock()
+			batches = append(batches, ids)
+			lock.Unlock()
+
+			require.Nil(t, json.NewEncoder(w).Encode(res))
+		}))
+		defer server.Close()
+		c := New(server.URL, 4, 0, nullLogger())
+
+		ids := []string{"a", "b", "c", "d"}
+		images := []string{"i", "ii", "iii", "iiii"}
+		results := make([]*ent.VectorizationResult, len(ids))
+		errs := make([]error, len(ids))
+		var wg sync.WaitGroup
+		for i := range ids {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], errs[i] = c.Vectorize(context.Background(), ids[i], images[i])
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range ids {
+			require.Nil(t, errs[i])
+			assert.Equal(t, ids[i], results[i].ID)
+			assert.Equal(t, []float32{float32(len(images[i]))}, results[i].Vector)
+		}
+		require.Len(t, batches, 1)
+		assert.ElementsMatch(t, ids, batches[0])
+	})
+
+	t.Run("when an image of a batch can't be vectorized", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var b vecBatchRequest
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			require.Len(t, b.Images, 1)
+			require.Nil(t, json.NewEncoder(w).Encode(vecBatchResponse{
+				Vectors: []vecResponse{{ID: b.Images[0].ID, Error: "corrupt image"}},
+			}))
+		}))
+		defer server.Close()
+		c := New(server.URL, 4, 0, nullLogger())
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, "some-id", "image")
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "vectorize image some-id: corrupt image")
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -74,7 +75,18 @@ func (m *ImageModule) initVectorizer(ctx context.Context,
 		return errors.Errorf("required variable IMAGE_INFERENCE_API is not set")
 	}
 
-	client := clients.New(uri, logger)
+	maxBatchSize, err := intFromEnv("IMAGE_INFERENCE_MAX_BATCH_SIZE",
+		clients.DefaultMaxBatchSize)
+	if err != nil {
+		return err
+	}
+	maxConcurrentRequests, err := intFromEnv("IMAGE_INFERENCE_MAX_CONCURRENT_REQUESTS",
+		clients.DefaultMaxConcurrentRequests)
+	if err != nil {
+		return err
+	}
+
+	client := clients.New(uri, maxBatchSize, maxConcurrentRequests, logger)
 	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
 		return errors.Wrap(err, "init remote vectorizer")
 	}
@@ -100,6 +112,18 @@ func (m *ImageModule) MetaInfo() (map[string]interface{}, error) {
 	return map[string]interface{}{}, nil
 }
 
+func intFromEnv(name string, defaultValue int) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+	asInt, err := strconv.Atoi(v)
+	if err != nil || asInt < 0 {
+		return 0, errors.Errorf("%s must be a non-negative integer, got %q", name, v)
+	}
+	return asInt, nil
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
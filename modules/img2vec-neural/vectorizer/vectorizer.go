@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/img2vec-neural/ent"
 	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
+	"golang.org/x/sync/errgroup"
 )
 
 type Vectorizer struct {
@@ -87,14 +88,24 @@ func (v *Vectorizer) object(ctx context.Context, id strfmt.UUID,
 		return objDiff.GetVec(), nil
 	}
 
-	vectors := [][]float32{}
-	for i, image := range images {
-		imgID := fmt.Sprintf("%s_%v", id, i)
-		vector, err := v.VectorizeImage(ctx, imgID, image)
-		if err != nil {
-			return nil, err
-		}
-		vectors = append(vectors, vector)
+	// the images are queued concurrently, so that the client can send them
+	// to the inference container in a single batch
+	vectors := make([][]float32, len(images))
+	eg, egCtx := errgroup.WithContext(ctx)
+	for i := range images {
+		i := i
+		eg.Go(func() error {
+			imgID := fmt.Sprintf("%s_%v", id, i)
+			vector, err := v.VectorizeImage(egCtx, imgID, images[i])
+			if err != nil {
+				return err
+			}
+			vectors[i] = vector
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
 	}
 
 	return libvectorizer.CombineVectors(vectors), nil
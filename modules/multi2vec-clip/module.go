@@ -48,7 +48,8 @@ type metaClient interface {
 type imageVectorizer interface {
 	Object(ctx context.Context, object *models.Object, objDiff *moduletools.ObjectDiff,
 		settings vectorizer.ClassSettings) error
-	VectorizeImage(ctx context.Context, image string) ([]float32, error)
+	VectorizeImage(ctx context.Context, image string,
+		settings vectorizer.ClassSettings) ([]float32, error)
 }
 
 type textVectorizer interface {
@@ -17,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	localvectorizer "github.com/weaviate/weaviate/modules/multi2vec-clip/vectorizer"
 )
 
 type Searcher struct {
@@ -28,7 +29,8 @@ func NewSearcher(vectorizer imgVectorizer) *Searcher {
 }
 
 type imgVectorizer interface {
-	VectorizeImage(ctx context.Context, image string) ([]float32, error)
+	VectorizeImage(ctx context.Context, image string,
+		settings localvectorizer.ClassSettings) ([]float32, error)
 }
 
 func (s *Searcher) VectorSearches() map[string]modulecapabilities.VectorForParams {
@@ -49,8 +51,10 @@ func (s *Searcher) vectorFromNearImageParam(ctx context.Context,
 	params *NearImageParams, className string, findVectorFn modulecapabilities.FindVectorFn,
 	cfg moduletools.ClassConfig,
 ) ([]float32, error) {
-	// find vector for given search query
-	vector, err := s.vectorizer.VectorizeImage(ctx, params.Image)
+	// find vector for given search query, the image is preprocessed the same
+	// way as the images of the class's objects
+	vector, err := s.vectorizer.VectorizeImage(ctx, params.Image,
+		localvectorizer.NewClassSettings(cfg))
 	if err != nil {
 		return nil, errors.Errorf("vectorize image: %v", err)
 	}
@@ -159,12 +159,59 @@ func Test_classSettings_Validate(t *testing.T) {
 					build(),
 			},
 		},
+		{
+			name: "should pass with a weight for all imageFields and all textFields",
+			fields: fields{
+				cfg: newConfigBuilder().
+					addSetting("textFields", []interface{}{"textField1", "textField2"}).
+					addSetting("imageFields", []interface{}{"imageField1"}).
+					addSetting("weights", map[string]interface{}{"textFields": 0.3, "imageFields": 0.7}).
+					build(),
+			},
+		},
+		{
+			name: "should not pass with not proper weight for all imageFields",
+			fields: fields{
+				cfg: newConfigBuilder().
+					addSetting("imageFields", []interface{}{"imageField1"}).
+					addSetting("weights", map[string]interface{}{"imageFields": true}).
+					build(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should pass with image preprocessing",
+			fields: fields{
+				cfg: newConfigBuilder().
+					addSetting("imageFields", []interface{}{"imageField1"}).
+					addSetting("imagePreprocessing", map[string]interface{}{"maxSize": json.Number("224"), "normalize": true}).
+					build(),
+			},
+		},
+		{
+			name: "should not pass with negative image preprocessing max size",
+			fields: fields{
+				cfg: newConfigBuilder().
+					addSetting("imageFields", []interface{}{"imageField1"}).
+					addSetting("imagePreprocessing", map[string]interface{}{"maxSize": -1}).
+					build(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should not pass with not proper image preprocessing",
+			fields: fields{
+				cfg: newConfigBuilder().
+					addSetting("imageFields", []interface{}{"imageField1"}).
+					addSetting("imagePreprocessing", "resize").
+					build(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ic := &classSettings{
-				cfg: tt.fields.cfg,
-			}
+			ic := NewClassSettings(tt.fields.cfg)
 			if err := ic.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("classSettings.Validate() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"bytes"
+	"encoding/base64"
+	goimage "image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/pkg/errors"
+)
+
+// ImagePreprocessing describes how images are prepared before they are sent
+// to the inference container. The zero value sends images as they are.
+type ImagePreprocessing struct {
+	// MaxSize is the maximum width and height in pixels, larger images are
+	// downscaled keeping their aspect ratio. 0 keeps the original size.
+	MaxSize int
+	// Normalize converts images to RGB, flattening transparency onto a white
+	// background, and sends them as PNG regardless of the uploaded format
+	Normalize bool
+}
+
+func (p ImagePreprocessing) enabled() bool {
+	return p.MaxSize > 0 || p.Normalize
+}
+
+// preprocessImage applies the preprocessing to a base64 encoded image. Only
+// JPEG, PNG and GIF images can be preprocessed.
+func preprocessImage(encoded string, p ImagePreprocessing) (string, error) {
+	if !p.enabled() {
+		return encoded, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", errors.Wrap(err, "decode base64 image")
+	}
+	img, format, err := goimage.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return "", errors.Wrap(err, "decode image")
+	}
+
+	resized := false
+	if width, height, ok := scaledSize(img.Bounds(), p.MaxSize); ok {
+		img = resize(img, width, height)
+		resized = true
+	}
+	if !resized && !p.Normalize {
+		return encoded, nil
+	}
+
+	var buf bytes.Buffer
+	switch {
+	case p.Normalize:
+		err = png.Encode(&buf, toRGB(img))
+	case format == "jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95})
+	default:
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "encode image")
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// scaledSize returns the size of the image after it was downscaled to fit
+// into maxSize x maxSize, ok is false if it fits already
+func scaledSize(bounds goimage.Rectangle, maxSize int) (width, height int, ok bool) {
+	width, height = bounds.Dx(), bounds.Dy()
+	if maxSize <= 0 || (width <= maxSize && height <= maxSize) {
+		return width, height, false
+	}
+
+	if width >= height {
+		height = max(1, height*maxSize/width)
+		width = maxSize
+	} else {
+		width = max(1, width*maxSize/height)
+		height = maxSize
+	}
+	return width, height, true
+}
+
+// resize downscales the image by averaging the source pixels covered by
+// every pixel of the result
+func resize(src goimage.Image, width, height int) *goimage.RGBA {
+	dst := goimage.NewRGBA(goimage.Rect(0, 0, width, height))
+	bounds := src.Bounds()
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+	return dst
+}
+
+// toRGB draws the image onto a white background, so that the result is
+// fully opaque and is encoded without an alpha channel
+func toRGB(src goimage.Image) *goimage.RGBA {
+	bounds := src.Bounds()
+	dst := goimage.NewRGBA(goimage.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), goimage.White, goimage.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Over)
+	return dst
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -20,7 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/multi2vec-clip/ent"
-	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/multimodal"
 )
 
 type Vectorizer struct {
@@ -40,9 +40,12 @@ type Client interface {
 
 type ClassSettings interface {
 	ImageField(property string) bool
+	ImageFields() []string
 	ImageFieldsWeights() ([]float32, error)
 	TextField(property string) bool
+	TextFields() []string
 	TextFieldsWeights() ([]float32, error)
+	ImagePreprocessing() ImagePreprocessing
 }
 
 func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
@@ -57,7 +60,13 @@ func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
 	return nil
 }
 
-func (v *Vectorizer) VectorizeImage(ctx context.Context, image string) ([]float32, error) {
+func (v *Vectorizer) VectorizeImage(ctx context.Context, image string,
+	settings ClassSettings,
+) ([]float32, error) {
+	image, err := preprocessImage(image, settings.ImagePreprocessing())
+	if err != nil {
+		return nil, errors.Wrap(err, "preprocess image")
+	}
 	res, err := v.client.Vectorize(ctx, []string{}, []string{image})
 	if err != nil {
 		return nil, err
@@ -72,28 +81,9 @@ func (v *Vectorizer) VectorizeImage(ctx context.Context, image string) ([]float3
 func (v *Vectorizer) object(ctx context.Context, id strfmt.UUID,
 	schema interface{}, objDiff *moduletools.ObjectDiff, ichek ClassSettings,
 ) ([]float32, error) {
-	vectorize := objDiff == nil || objDiff.GetVec() == nil
-
-	// vectorize image and text
-	texts := []string{}
-	images := []string{}
-	if schema != nil {
-		for prop, value := range schema.(map[string]interface{}) {
-			if ichek.ImageField(prop) {
-				valueString, ok := value.(string)
-				if ok {
-					images = append(images, valueString)
-					vectorize = vectorize || (objDiff != nil && objDiff.IsChangedProp(prop))
-				}
-			}
-			if ichek.TextField(prop) {
-				valueString, ok := value.(string)
-				if ok {
-					texts = append(texts, valueString)
-					vectorize = vectorize || (objDiff != nil && objDiff.IsChangedProp(prop))
-				}
-			}
-		}
+	inputs, vectorize, err := multimodal.ObjectInputs(schema, objDiff, ichek)
+	if err != nil {
+		return nil, err
 	}
 
 	// no property was changed, old vector can be used
@@ -101,54 +91,21 @@ func (v *Vectorizer) object(ctx context.Context, id strfmt.UUID,
 		return objDiff.GetVec(), nil
 	}
 
-	vectors := [][]float32{}
-	if len(texts) > 0 || len(images) > 0 {
-		res, err := v.client.Vectorize(ctx, texts, images)
+	preprocessing := ichek.ImagePreprocessing()
+	for i := range inputs.Images {
+		inputs.Images[i], err = preprocessImage(inputs.Images[i], preprocessing)
 		if err != nil {
-			return nil, err
+			return nil, errors.Wrap(err, "preprocess image")
 		}
-		vectors = append(vectors, res.TextVectors...)
-		vectors = append(vectors, res.ImageVectors...)
 	}
-	weights, err := v.getWeights(ichek)
-	if err != nil {
-		return nil, err
-	}
-
-	return libvectorizer.CombineVectorsWithWeights(vectors, weights), nil
-}
 
-func (v *Vectorizer) getWeights(ichek ClassSettings) ([]float32, error) {
-	weights := []float32{}
-	textFieldsWeights, err := ichek.TextFieldsWeights()
-	if err != nil {
-		return nil, err
+	if len(inputs.Texts) == 0 && len(inputs.Images) == 0 {
+		return inputs.CombineVectors(nil, nil), nil
 	}
-	imageFieldsWeights, err := ichek.ImageFieldsWeights()
+	res, err := v.client.Vectorize(ctx, inputs.Texts, inputs.Images)
 	if err != nil {
 		return nil, err
 	}
 
-	weights = append(weights, textFieldsWeights...)
-	weights = append(weights, imageFieldsWeights...)
-
-	normalizedWeights := v.normalizeWeights(weights)
-
-	return normalizedWeights, nil
-}
-
-func (v *Vectorizer) normalizeWeights(weights []float32) []float32 {
-	if len(weights) > 0 {
-		var denominator float32
-		for i := range weights {
-			denominator += weights[i]
-		}
-		normalizer := 1 / denominator
-		normalized := make([]float32, len(weights))
-		for i := range weights {
-			normalized[i] = weights[i] * normalizer
-		}
-		return normalized
-	}
-	return nil
+	return inputs.CombineVectors(res.TextVectors, res.ImageVectors), nil
 }
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/multi2vec-cohere/ent"
+)
+
+const (
+	inputTypeSearchDocument = "search_document"
+	inputTypeSearchQuery    = "search_query"
+	inputTypeImage          = "image"
+)
+
+const embeddingTypeFloat = "float"
+
+type embeddingsRequest struct {
+	Texts          []string `json:"texts,omitempty"`
+	Images         []string `json:"images,omitempty"`
+	Model          string   `json:"model,omitempty"`
+	InputType      string   `json:"input_type"`
+	EmbeddingTypes []string `json:"embedding_types"`
+}
+
+type embeddingsResponse struct {
+	// a list of float embeddings, or an object holding the embeddings by
+	// type if embedding types were requested
+	Embeddings json.RawMessage `json:"embeddings,omitempty"`
+	Message    string          `json:"message,omitempty"`
+}
+
+type vectorizer struct {
+	apiKey      string
+	retryPolicy moduletools.RetryPolicy
+	httpClient  *http.Client
+	logger      logrus.FieldLogger
+}
+
+func New(apiKey string, retryPolicy moduletools.RetryPolicy,
+	transport http.RoundTripper, logger logrus.FieldLogger,
+) *vectorizer {
+	return &vectorizer{
+		apiKey:      apiKey,
+		retryPolicy: retryPolicy,
+		httpClient:  &http.Client{Transport: transport},
+		logger:      logger,
+	}
+}
+
+// Vectorize embeds the texts as documents and the base64 encoded images.
+// Cohere accepts a single image per request, so every image is sent on its
+// own.
+func (v *vectorizer) Vectorize(ctx context.Context, texts, images []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	result := &ent.VectorizationResult{
+		TextVectors:  [][]float32{},
+		ImageVectors: make([][]float32, 0, len(images)),
+	}
+	if len(texts) > 0 {
+		textVectors, err := v.vectorize(ctx, embeddingsRequest{
+			Texts:     texts,
+			InputType: inputTypeSearchDocument,
+		}, config)
+		if err != nil {
+			return nil, err
+		}
+		if len(textVectors) != len(texts) {
+			return nil, errors.Errorf("expected %d text embeddings, got %d",
+				len(texts), len(textVectors))
+		}
+		result.TextVectors = textVectors
+	}
+	for i := range images {
+		imageVectors, err := v.vectorize(ctx, embeddingsRequest{
+			Images:    []string{toDataURI(images[i])},
+			InputType: inputTypeImage,
+		}, config)
+		if err != nil {
+			return nil, err
+		}
+		if len(imageVectors) != 1 {
+			return nil, errors.Errorf("expected 1 image embedding, got %d",
+				len(imageVectors))
+		}
+		result.ImageVectors = append(result.ImageVectors, imageVectors[0])
+	}
+	return result, nil
+}
+
+// VectorizeQuery embeds the texts as search queries
+func (v *vectorizer) VectorizeQuery(ctx context.Context, texts []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	textVectors, err := v.vectorize(ctx, embeddingsRequest{
+		Texts:     texts,
+		InputType: inputTypeSearchQuery,
+	}, config)
+	if err != nil {
+		return nil, err
+	}
+	if len(textVectors) != len(texts) {
+		return nil, errors.Errorf("expected %d text embeddings, got %d",
+			len(texts), len(textVectors))
+	}
+	return &ent.VectorizationResult{
+		TextVectors:  textVectors,
+		ImageVectors: [][]float32{},
+	}, nil
+}
+
+func (v *vectorizer) vectorize(ctx context.Context, request embeddingsRequest,
+	config ent.VectorizationConfig,
+) ([][]float32, error) {
+	request.Model = config.Model
+	request.EmbeddingTypes = []string{embeddingTypeFloat}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	var resBody *embeddingsResponse
+	err = v.retryPolicy.Do(ctx, func() error {
+		resBody, err = v.sendRequest(ctx, v.url(config), body)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	embeddings, err := parseEmbeddings(resBody.Embeddings)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse embeddings")
+	}
+	return embeddings, nil
+}
+
+// parseEmbeddings accepts both a plain list of float embeddings and an
+// object holding the embeddings by type
+func parseEmbeddings(raw json.RawMessage) ([][]float32, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return nil, nil
+	}
+	if trimmed[0] == '[' {
+		var embeddings [][]float32
+		if err := json.Unmarshal(trimmed, &embeddings); err != nil {
+			return nil, err
+		}
+		return embeddings, nil
+	}
+
+	var byType map[string][][]float32
+	if err := json.Unmarshal(trimmed, &byType); err != nil {
+		return nil, err
+	}
+	embeddings, ok := byType[embeddingTypeFloat]
+	if !ok {
+		return nil, errors.Errorf("response doesn't contain %s embeddings", embeddingTypeFloat)
+	}
+	return embeddings, nil
+}
+
+// toDataURI turns the base64 encoded image into the data URI expected by
+// Cohere, the media type is sniffed from the image's first bytes
+func toDataURI(image string) string {
+	if strings.HasPrefix(image, "data:") {
+		return image
+	}
+	// 512 bytes are enough for the content type detection, which requires
+	// 684 base64 encoded characters
+	prefix := image
+	if len(prefix) > 684 {
+		prefix = prefix[:684]
+	}
+	decoded, _ := base64.StdEncoding.DecodeString(prefix)
+	return fmt.Sprintf("data:%s;base64,%s", http.DetectContentType(decoded), image)
+}
+
+func (v *vectorizer) sendRequest(ctx context.Context, url string,
+	body []byte,
+) (*embeddingsResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	apiKey, err := v.getApiKey(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cohere API Key")
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		err = errors.Wrap(err, "send POST request")
+		if ctx.Err() == nil {
+			return nil, moduletools.NewRetryableError(err, 0)
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+	var resBody embeddingsResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		err = errors.Wrap(err, "unmarshal response body")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(err, moduletools.RetryAfter(res.Header))
+		}
+		return nil, err
+	}
+
+	if res.StatusCode >= 500 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "connection to Cohere failed with status: %d error: %v")
+		return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+	} else if res.StatusCode > 200 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "failed with status: %d error: %v")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+		}
+		return nil, errors.Errorf(errorMessage)
+	}
+
+	return &resBody, nil
+}
+
+func getErrorMessage(statusCode int, resBodyError string, errorTemplate string) string {
+	if resBodyError != "" {
+		return fmt.Sprintf(errorTemplate, statusCode, resBodyError)
+	}
+	return fmt.Sprintf(errorTemplate, statusCode)
+}
+
+func (v *vectorizer) getApiKey(ctx context.Context) (string, error) {
+	if len(v.apiKey) > 0 {
+		return v.apiKey, nil
+	}
+	apiKey := ctx.Value("X-Cohere-Api-Key")
+	if apiKeyHeader, ok := apiKey.([]string); ok &&
+		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
+		return apiKeyHeader[0], nil
+	}
+	return "", errors.New("no api key found " +
+		"neither in request header: X-Cohere-Api-Key " +
+		"nor in environment variable under COHERE_APIKEY")
+}
+
+func (v *vectorizer) url(config ent.VectorizationConfig) string {
+	return fmt.Sprintf("%s/v1/embed", strings.TrimSuffix(config.BaseURL, "/"))
+}
@@ -0,0 +1,220 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/multi2vec-cohere/ent"
+)
+
+// a 1x1 png
+const pngImage = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNk+M9QDwADhgGAWjR9awAAAABJRU5ErkJggg=="
+
+func TestClient(t *testing.T) {
+	t.Run("when all is fine", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			logger:     nullLogger(),
+		}
+		expected := &ent.VectorizationResult{
+			TextVectors:  [][]float32{{0.1, 0.2, 0.3}},
+			ImageVectors: [][]float32{{0.1, 0.2, 0.3}},
+		}
+
+		res, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			[]string{pngImage}, ent.VectorizationConfig{
+				Model:   "embed-english-v3.0",
+				BaseURL: server.URL,
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+		require.Len(t, handler.requests, 2)
+		assert.Equal(t, []string{"This is my text"}, handler.requests[0].Texts)
+		assert.Equal(t, "search_document", handler.requests[0].InputType)
+		assert.Equal(t, []string{"float"}, handler.requests[0].EmbeddingTypes)
+		assert.Equal(t, []string{"data:image/png;base64," + pngImage}, handler.requests[1].Images)
+		assert.Equal(t, "image", handler.requests[1].InputType)
+		assert.Equal(t, "embed-english-v3.0", handler.requests[1].Model)
+		assert.Equal(t, "Bearer apiKey", handler.lastAuthorization)
+	})
+
+	t.Run("when a query is vectorized", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			logger:     nullLogger(),
+		}
+
+		res, err := c.VectorizeQuery(context.Background(), []string{"What is my text?"},
+			ent.VectorizationConfig{BaseURL: server.URL})
+
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{0.1, 0.2, 0.3}}, res.TextVectors)
+		require.Len(t, handler.requests, 1)
+		assert.Equal(t, "search_query", handler.requests[0].InputType)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			logger:     nullLogger(),
+		}
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, []string{"This is my text"}, []string{},
+			ent.VectorizationConfig{BaseURL: server.URL})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: "nope, not gonna happen",
+		})
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			logger:     nullLogger(),
+		}
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"}, []string{},
+			ent.VectorizationConfig{BaseURL: server.URL})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to Cohere failed with status: 500 error: nope, not gonna happen")
+	})
+
+	t.Run("when the api key is passed using X-Cohere-Api-Key header", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &vectorizer{
+			httpClient: &http.Client{},
+			logger:     nullLogger(),
+		}
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Cohere-Api-Key", []string{"some-key"})
+
+		_, err := c.Vectorize(ctxWithValue, []string{"This is my text"}, []string{},
+			ent.VectorizationConfig{BaseURL: server.URL})
+
+		require.Nil(t, err)
+		assert.Equal(t, "Bearer some-key", handler.lastAuthorization)
+	})
+
+	t.Run("when no api key is found", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := &vectorizer{
+			httpClient: &http.Client{},
+			logger:     nullLogger(),
+		}
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"}, []string{},
+			ent.VectorizationConfig{BaseURL: server.URL})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "Cohere API Key: no api key found "+
+			"neither in request header: X-Cohere-Api-Key "+
+			"nor in environment variable under COHERE_APIKEY")
+	})
+}
+
+func TestParseEmbeddings(t *testing.T) {
+	t.Run("list of embeddings", func(t *testing.T) {
+		embeddings, err := parseEmbeddings(json.RawMessage(`[[0.1,0.2]]`))
+
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{0.1, 0.2}}, embeddings)
+	})
+
+	t.Run("embeddings by type", func(t *testing.T) {
+		embeddings, err := parseEmbeddings(json.RawMessage(`{"float":[[0.1,0.2]]}`))
+
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{0.1, 0.2}}, embeddings)
+	})
+
+	t.Run("missing float embeddings", func(t *testing.T) {
+		_, err := parseEmbeddings(json.RawMessage(`{"int8":[[1,2]]}`))
+
+		assert.EqualError(t, err, "response doesn't contain float embeddings")
+	})
+}
+
+type fakeHandler struct {
+	t                 *testing.T
+	serverError       string
+	requests          []embeddingsRequest
+	lastAuthorization string
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	assert.Equal(f.t, "/v1/embed", r.URL.String())
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	if f.serverError != "" {
+		w.WriteHeader(http.StatusInternalServerError)
+		require.Nil(f.t, json.NewEncoder(w).Encode(embeddingsResponse{Message: f.serverError}))
+		return
+	}
+
+	var req embeddingsRequest
+	require.Nil(f.t, json.NewDecoder(r.Body).Decode(&req))
+	f.requests = append(f.requests, req)
+	for _, image := range req.Images {
+		assert.True(f.t, strings.HasPrefix(image, "data:image/"))
+	}
+
+	inputs := len(req.Texts) + len(req.Images)
+	embeddings := make([][]float32, inputs)
+	for i := range embeddings {
+		embeddings[i] = []float32{0.1, 0.2, 0.3}
+	}
+	out, err := json.Marshal(map[string]interface{}{
+		"embeddings": map[string]interface{}{"float": embeddings},
+	})
+	require.Nil(f.t, err)
+	w.Write(out)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
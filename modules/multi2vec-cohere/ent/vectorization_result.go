@@ -11,8 +11,7 @@
 
 package ent
 
-type RankResult struct {
-	Query             string
-	RankPropertyValue string
-	Score             float64
+type VectorizationResult struct {
+	TextVectors  [][]float32
+	ImageVectors [][]float32
 }
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modmulti2veccohere
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/multi2vec-cohere/clients"
+	"github.com/weaviate/weaviate/modules/multi2vec-cohere/vectorizer"
+)
+
+const Name = "multi2vec-cohere"
+
+func New() *CohereModule {
+	return &CohereModule{}
+}
+
+type CohereModule struct {
+	imageVectorizer          imageVectorizer
+	nearImageGraphqlProvider modulecapabilities.GraphQLArguments
+	nearImageSearcher        modulecapabilities.Searcher
+	textVectorizer           textVectorizer
+	nearTextGraphqlProvider  modulecapabilities.GraphQLArguments
+	nearTextSearcher         modulecapabilities.Searcher
+	nearTextTransformer      modulecapabilities.TextTransform
+	metaClient               metaClient
+}
+
+type metaClient interface {
+	MetaInfo() (map[string]interface{}, error)
+}
+
+type imageVectorizer interface {
+	Object(ctx context.Context, object *models.Object, objDiff *moduletools.ObjectDiff,
+		settings vectorizer.ClassSettings) error
+	VectorizeImage(ctx context.Context, image string,
+		settings vectorizer.ClassSettings) ([]float32, error)
+}
+
+type textVectorizer interface {
+	Texts(ctx context.Context, input []string,
+		settings vectorizer.ClassSettings) ([]float32, error)
+	MoveTo(source, target []float32, weight float32) ([]float32, error)
+	MoveAwayFrom(source, target []float32, weight float32) ([]float32, error)
+	CombineVectors(vectors [][]float32) []float32
+}
+
+func (m *CohereModule) Name() string {
+	return Name
+}
+
+func (m *CohereModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Multi2Vec
+}
+
+func (m *CohereModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	if err := m.initVectorizer(ctx, params.GetLogger()); err != nil {
+		return errors.Wrap(err, "init vectorizer")
+	}
+
+	if err := m.initNearImage(); err != nil {
+		return errors.Wrap(err, "init near text")
+	}
+
+	return nil
+}
+
+func (m *CohereModule) InitExtension(modules []modulecapabilities.Module) error {
+	for _, module := range modules {
+		if module.Name() == m.Name() {
+			continue
+		}
+		if arg, ok := module.(modulecapabilities.TextTransformers); ok {
+			if arg != nil && arg.TextTransformers() != nil {
+				m.nearTextTransformer = arg.TextTransformers()["nearText"]
+			}
+		}
+	}
+
+	if err := m.initNearText(); err != nil {
+		return errors.Wrap(err, "init near text")
+	}
+
+	return nil
+}
+
+func (m *CohereModule) initVectorizer(ctx context.Context,
+	logger logrus.FieldLogger,
+) error {
+	apiKey := os.Getenv("COHERE_APIKEY")
+	retryPolicy, err := moduletools.RetryPolicyFromEnv("COHERE")
+	if err != nil {
+		return err
+	}
+	httpOptions, err := moduletools.HTTPClientOptionsFromEnv("COHERE")
+	if err != nil {
+		return err
+	}
+	transport, err := moduletools.NewHTTPTransport(httpOptions)
+	if err != nil {
+		return err
+	}
+	client := clients.New(apiKey, retryPolicy, transport, logger)
+
+	m.imageVectorizer = vectorizer.New(client)
+	m.textVectorizer = vectorizer.New(client)
+	m.metaClient = client
+
+	return nil
+}
+
+func (m *CohereModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *CohereModule) VectorizeObject(ctx context.Context,
+	obj *models.Object, objDiff *moduletools.ObjectDiff, cfg moduletools.ClassConfig,
+) error {
+	icheck := vectorizer.NewClassSettings(cfg)
+	return m.imageVectorizer.Object(ctx, obj, objDiff, icheck)
+}
+
+func (m *CohereModule) MetaInfo() (map[string]interface{}, error) {
+	return m.metaClient.MetaInfo()
+}
+
+func (m *CohereModule) VectorizeInput(ctx context.Context,
+	input string, cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	return m.textVectorizer.Texts(ctx, []string{input}, vectorizer.NewClassSettings(cfg))
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.Vectorizer(New())
+	_ = modulecapabilities.InputVectorizer(New())
+)
@@ -9,23 +9,18 @@
 //  CONTACT: hello@weaviate.io
 //
 
-package rank
+package neartext
 
-type Params struct {
-	Property *string
-	Query    *string
-}
-
-func (n Params) GetQuery() string {
-	if n.Query != nil {
-		return *n.Query
-	}
-	return ""
-}
+type fakeTransformer struct{}
 
-func (n Params) GetProperty() string {
-	if n.Property != nil {
-		return *n.Property
+func (t *fakeTransformer) Transform(in []string) ([]string, error) {
+	result := make([]string, len(in))
+	for i, txt := range in {
+		if txt == "transform this" {
+			result[i] = "transformed text"
+		} else {
+			result[i] = txt
+		}
 	}
-	return ""
+	return result, nil
 }
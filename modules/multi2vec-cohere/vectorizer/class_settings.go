@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/multimodal"
+)
+
+const (
+	modelProperty   = "model"
+	baseURLProperty = "baseURL"
+)
+
+const (
+	DefaultCohereModel = "embed-english-v3.0"
+	DefaultBaseURL     = "https://api.cohere.ai"
+)
+
+// only the v3 embedding models accept image inputs
+var availableCohereModels = []string{
+	"embed-english-v3.0",
+	"embed-english-light-v3.0",
+	"embed-multilingual-v3.0",
+	"embed-multilingual-light-v3.0",
+}
+
+type classSettings struct {
+	*multimodal.BaseClassSettings
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{
+		BaseClassSettings: multimodal.NewBaseClassSettings(cfg),
+		cfg:               cfg,
+	}
+}
+
+func (ic *classSettings) Model() string {
+	return ic.getStringProperty(modelProperty, DefaultCohereModel)
+}
+
+func (ic *classSettings) BaseURL() string {
+	return ic.getStringProperty(baseURLProperty, DefaultBaseURL)
+}
+
+func (ic *classSettings) getStringProperty(name, defaultValue string) string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	value, ok := ic.cfg.Class()[name]
+	if ok {
+		asString, ok := value.(string)
+		if ok {
+			return asString
+		}
+	}
+	return defaultValue
+}
+
+func (ic *classSettings) Validate() error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	if !containsString(availableCohereModels, ic.Model()) {
+		return errors.Errorf("wrong %s available model names are: %v",
+			modelProperty, availableCohereModels)
+	}
+	if ic.BaseURL() == "" {
+		return errors.Errorf("%s cannot be empty", baseURLProperty)
+	}
+
+	return ic.ValidateFields()
+}
+
+func containsString(values []string, value string) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/modules/multi2vec-google/ent"
+	"golang.org/x/oauth2"
+	googleauth "golang.org/x/oauth2/google"
+)
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+func buildURL(location, projectID, modelID string) string {
+	urlTemplate := "https://%s-aiplatform.googleapis.com/v1/projects/%s/locations/%s/publishers/google/models/%s:predict"
+	return fmt.Sprintf(urlTemplate, location, projectID, location, modelID)
+}
+
+type google struct {
+	httpClient   *http.Client
+	urlBuilderFn func(location, projectID, modelID string) string
+	logger       logrus.FieldLogger
+
+	// the token source of the application default credentials is looked up
+	// on first use, so that the module can start without credentials if they
+	// are passed in the request headers only
+	tokenSourceLock sync.Mutex
+	tokenSource     oauth2.TokenSource
+	tokenSourceFn   func(ctx context.Context) (oauth2.TokenSource, error)
+}
+
+func New(logger logrus.FieldLogger) *google {
+	return &google{
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+		urlBuilderFn:  buildURL,
+		logger:        logger,
+		tokenSourceFn: defaultTokenSource,
+	}
+}
+
+func defaultTokenSource(ctx context.Context) (oauth2.TokenSource, error) {
+	return googleauth.DefaultTokenSource(ctx, cloudPlatformScope)
+}
+
+// Vectorize embeds the texts and the base64 encoded images. The multimodal
+// models embed a single text and a single image per instance, so every
+// input is sent as an instance of its own.
+func (v *google) Vectorize(ctx context.Context, texts, images []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	instances := make([]instance, 0, len(texts)+len(images))
+	for i := range texts {
+		instances = append(instances, instance{Text: &texts[i]})
+	}
+	for i := range images {
+		instances = append(instances, instance{Image: &image{BytesBase64Encoded: images[i]}})
+	}
+
+	predictions, err := v.predict(ctx, instances, config)
+	if err != nil {
+		return nil, err
+	}
+	if len(predictions) != len(instances) {
+		return nil, errors.Errorf("expected %d embeddings, got %d",
+			len(instances), len(predictions))
+	}
+
+	result := &ent.VectorizationResult{
+		TextVectors:  make([][]float32, len(texts)),
+		ImageVectors: make([][]float32, len(images)),
+	}
+	for i := range texts {
+		if len(predictions[i].TextEmbedding) == 0 {
+			return nil, errors.New("empty text embedding")
+		}
+		result.TextVectors[i] = predictions[i].TextEmbedding
+	}
+	for i := range images {
+		prediction := predictions[len(texts)+i]
+		if len(prediction.ImageEmbedding) == 0 {
+			return nil, errors.New("empty image embedding")
+		}
+		result.ImageVectors[i] = prediction.ImageEmbedding
+	}
+	return result, nil
+}
+
+func (v *google) predict(ctx context.Context, instances []instance,
+	config ent.VectorizationConfig,
+) ([]prediction, error) {
+	endpointURL := v.urlBuilderFn(config.Location, config.ProjectID, config.Model)
+
+	predictions := make([]prediction, 0, len(instances))
+	for i := range instances {
+		request := embeddingsRequest{Instances: instances[i : i+1]}
+		if config.Dimensions > 0 {
+			request.Parameters = &parameters{Dimension: config.Dimensions}
+		}
+		body, err := json.Marshal(request)
+		if err != nil {
+			return nil, errors.Wrapf(err, "marshal body")
+		}
+
+		resBody, err := v.send(ctx, endpointURL, body)
+		if err != nil {
+			return nil, err
+		}
+		if len(resBody.Predictions) == 0 {
+			return nil, errors.Errorf("empty embeddings response")
+		}
+		predictions = append(predictions, resBody.Predictions[0])
+	}
+	return predictions, nil
+}
+
+func (v *google) send(ctx context.Context, endpointURL string,
+	body []byte,
+) (*embeddingsResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpointURL,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+
+	token, err := v.getToken(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Google OAuth token")
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody embeddingsResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode != 200 || resBody.Error != nil {
+		if resBody.Error != nil {
+			return nil, fmt.Errorf("connection to Google Vertex AI failed with status: %v error: %v",
+				res.StatusCode, resBody.Error.Message)
+		}
+		return nil, fmt.Errorf("connection to Google Vertex AI failed with status: %d", res.StatusCode)
+	}
+
+	return &resBody, nil
+}
+
+// getToken prefers the OAuth access token passed in the request header over
+// the application default credentials
+func (v *google) getToken(ctx context.Context) (string, error) {
+	token := ctx.Value("X-Google-Api-Key")
+	if tokenHeader, ok := token.([]string); ok &&
+		len(tokenHeader) > 0 && len(tokenHeader[0]) > 0 {
+		return tokenHeader[0], nil
+	}
+
+	tokenSource, err := v.getTokenSource()
+	if err != nil {
+		return "", errors.Wrap(err, "no access token found "+
+			"neither in request header: X-Google-Api-Key "+
+			"nor in the application default credentials")
+	}
+	oauthToken, err := tokenSource.Token()
+	if err != nil {
+		return "", errors.Wrap(err, "request access token")
+	}
+	return oauthToken.AccessToken, nil
+}
+
+func (v *google) getTokenSource() (oauth2.TokenSource, error) {
+	v.tokenSourceLock.Lock()
+	defer v.tokenSourceLock.Unlock()
+
+	if v.tokenSource != nil {
+		return v.tokenSource, nil
+	}
+	// tokens are refreshed in the background of later requests, so they
+	// must not be bound to the context of the current request
+	tokenSource, err := v.tokenSourceFn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	v.tokenSource = tokenSource
+	return tokenSource, nil
+}
+
+type embeddingsRequest struct {
+	Instances  []instance  `json:"instances,omitempty"`
+	Parameters *parameters `json:"parameters,omitempty"`
+}
+
+type instance struct {
+	Text  *string `json:"text,omitempty"`
+	Image *image  `json:"image,omitempty"`
+}
+
+type image struct {
+	BytesBase64Encoded string `json:"bytesBase64Encoded"`
+}
+
+type parameters struct {
+	Dimension int64 `json:"dimension,omitempty"`
+}
+
+type embeddingsResponse struct {
+	Predictions     []prediction    `json:"predictions,omitempty"`
+	Error           *googleApiError `json:"error,omitempty"`
+	DeployedModelId string          `json:"deployedModelId,omitempty"`
+}
+
+type prediction struct {
+	TextEmbedding  []float32 `json:"textEmbedding,omitempty"`
+	ImageEmbedding []float32 `json:"imageEmbedding,omitempty"`
+}
+
+type googleApiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
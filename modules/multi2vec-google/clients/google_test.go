@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/multi2vec-google/ent"
+	"golang.org/x/oauth2"
+)
+
+func TestClient(t *testing.T) {
+	staticTokenSource := func(ctx context.Context) (oauth2.TokenSource, error) {
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "default-token"}), nil
+	}
+
+	t.Run("when all is fine", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				assert.Equal(t, "us-central1", location)
+				assert.Equal(t, "project", projectID)
+				assert.Equal(t, "multimodalembedding@001", modelID)
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+		expected := &ent.VectorizationResult{
+			TextVectors:  [][]float32{{0.1, 0.2, 0.3}},
+			ImageVectors: [][]float32{{0.4, 0.5, 0.6}, {0.4, 0.5, 0.6}},
+		}
+
+		res, err := c.Vectorize(context.Background(), []string{"a text"},
+			[]string{"aW1hZ2Ux", "aW1hZ2Uy"},
+			ent.VectorizationConfig{
+				Location:   "us-central1",
+				ProjectID:  "project",
+				Model:      "multimodalembedding@001",
+				Dimensions: 512,
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, "Bearer default-token", handler.lastAuthorization)
+		require.Len(t, handler.requests, 3)
+		assert.Equal(t, "a text", *handler.requests[0].Instances[0].Text)
+		assert.Nil(t, handler.requests[0].Instances[0].Image)
+		assert.Equal(t, "aW1hZ2Uy", handler.requests[2].Instances[0].Image.BytesBase64Encoded)
+		assert.Equal(t, int64(512), handler.requests[2].Parameters.Dimension)
+	})
+
+	t.Run("when the access token is passed in the request header", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Google-Api-Key", []string{"header-token"})
+
+		_, err := c.Vectorize(ctxWithValue, []string{"a text"}, nil, ent.VectorizationConfig{})
+
+		require.Nil(t, err)
+		assert.Equal(t, "Bearer header-token", handler.lastAuthorization)
+		assert.Nil(t, handler.requests[0].Parameters)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, []string{"a text"}, nil, ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t, serverError: "nope, not gonna happen"})
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+
+		_, err := c.Vectorize(context.Background(), nil, []string{"aW1hZ2U="},
+			ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.Equal(t, "connection to Google Vertex AI failed with status: 500 error: nope, not gonna happen",
+			err.Error())
+	})
+}
+
+type fakeHandler struct {
+	t                 *testing.T
+	serverError       string
+	lastAuthorization string
+	requests          []embeddingsRequest
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	if f.serverError != "" {
+		w.WriteHeader(http.StatusInternalServerError)
+		outBytes, err := json.Marshal(embeddingsResponse{
+			Error: &googleApiError{Code: 500, Message: f.serverError, Status: "INTERNAL"},
+		})
+		require.Nil(f.t, err)
+		w.Write(outBytes)
+		return
+	}
+
+	var request embeddingsRequest
+	require.Nil(f.t, json.NewDecoder(r.Body).Decode(&request))
+	require.Len(f.t, request.Instances, 1)
+	f.requests = append(f.requests, request)
+
+	var res prediction
+	if request.Instances[0].Text != nil {
+		res.TextEmbedding = []float32{0.1, 0.2, 0.3}
+	}
+	if request.Instances[0].Image != nil {
+		res.ImageEmbedding = []float32{0.4, 0.5, 0.6}
+	}
+	outBytes, err := json.Marshal(embeddingsResponse{Predictions: []prediction{res}})
+	require.Nil(f.t, err)
+	w.Write(outBytes)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
@@ -9,10 +9,9 @@
 //  CONTACT: hello@weaviate.io
 //
 
-package models
+package ent
 
-// Answer used in qna module to represent
-// the answer to a given question
-type RankResult struct {
-	Score *float64 `json:"score,omitempty"`
+type VectorizationResult struct {
+	TextVectors  [][]float32
+	ImageVectors [][]float32
 }
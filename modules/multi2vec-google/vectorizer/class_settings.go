@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/multimodal"
+)
+
+const (
+	projectIDProperty  = "projectId"
+	locationProperty   = "location"
+	modelIDProperty    = "modelId"
+	dimensionsProperty = "dimensions"
+)
+
+const (
+	DefaultLocation = "us-central1"
+	DefaultModelID  = "multimodalembedding@001"
+)
+
+var availableGoogleModels = []string{
+	"multimodalembedding@001",
+}
+
+// the multimodal models return 1408 dimensions unless a lower dimension is
+// requested
+var availableDimensions = []int64{128, 256, 512, 1408}
+
+type classSettings struct {
+	*multimodal.BaseClassSettings
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{
+		BaseClassSettings: multimodal.NewBaseClassSettings(cfg),
+		cfg:               cfg,
+	}
+}
+
+// ImagePreprocessing returns how images are prepared before they are sent to
+// Vertex AI
+func (ic *classSettings) ImagePreprocessing() ImagePreprocessing {
+	settings, ok := ic.getImagePreprocessing()
+	if !ok {
+		return ImagePreprocessing{}
+	}
+
+	var preprocessing ImagePreprocessing
+	if maxSize, err := multimodal.GetNumber(settings["maxSize"]); err == nil {
+		preprocessing.MaxSize = int(maxSize)
+	}
+	if normalize, ok := settings["normalize"].(bool); ok {
+		preprocessing.Normalize = normalize
+	}
+	return preprocessing
+}
+
+func (ic *classSettings) ProjectID() string {
+	return ic.getStringProperty(projectIDProperty, "")
+}
+
+func (ic *classSettings) Location() string {
+	return ic.getStringProperty(locationProperty, DefaultLocation)
+}
+
+func (ic *classSettings) ModelID() string {
+	return ic.getStringProperty(modelIDProperty, DefaultModelID)
+}
+
+// Dimensions returns the requested embedding size, 0 means the model's default
+func (ic *classSettings) Dimensions() int64 {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return 0
+	}
+	value, ok := ic.cfg.Class()[dimensionsProperty]
+	if !ok {
+		return 0
+	}
+	dimensions, err := multimodal.GetNumber(value)
+	if err != nil {
+		return -1
+	}
+	return int64(dimensions)
+}
+
+func (ic *classSettings) getStringProperty(name, defaultValue string) string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	value, ok := ic.cfg.Class()[name]
+	if ok {
+		asString, ok := value.(string)
+		if ok {
+			return asString
+		}
+	}
+	return defaultValue
+}
+
+func (ic *classSettings) Validate() error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	if ic.ProjectID() == "" {
+		return errors.Errorf("%s cannot be empty", projectIDProperty)
+	}
+	if ic.Location() == "" {
+		return errors.Errorf("%s cannot be empty", locationProperty)
+	}
+	if !containsString(availableGoogleModels, ic.ModelID()) {
+		return errors.Errorf("wrong %s available model names are: %v",
+			modelIDProperty, availableGoogleModels)
+	}
+	if dimensions := ic.Dimensions(); dimensions != 0 && !containsInt64(availableDimensions, dimensions) {
+		return errors.Errorf("wrong %s available dimensions are: %v",
+			dimensionsProperty, availableDimensions)
+	}
+
+	if err := ic.ValidateFields(); err != nil {
+		return err
+	}
+
+	return ic.validateImagePreprocessing()
+}
+
+func (ic *classSettings) validateImagePreprocessing() error {
+	if _, ok := ic.cfg.Class()["imagePreprocessing"]; !ok {
+		return nil
+	}
+
+	settings, ok := ic.getImagePreprocessing()
+	if !ok {
+		return errors.New("imagePreprocessing must be an object")
+	}
+	if maxSize, ok := settings["maxSize"]; ok {
+		size, err := multimodal.GetNumber(maxSize)
+		if err != nil || size < 0 || size != float32(int(size)) {
+			return errors.New("imagePreprocessing.maxSize must be a non-negative integer")
+		}
+	}
+	if normalize, ok := settings["normalize"]; ok {
+		if _, ok := normalize.(bool); !ok {
+			return errors.New("imagePreprocessing.normalize must be a boolean")
+		}
+	}
+	return nil
+}
+
+func (ic *classSettings) getImagePreprocessing() (map[string]interface{}, bool) {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return nil, false
+	}
+	settings, ok := ic.cfg.Class()["imagePreprocessing"].(map[string]interface{})
+	return settings, ok
+}
+
+func containsString(values []string, value string) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt64(values []int64, value int64) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+	return false
+}
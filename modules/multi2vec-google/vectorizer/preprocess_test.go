@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"bytes"
+	"encoding/base64"
+	goimage "image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeTestImage(t *testing.T, img goimage.Image, format string) string {
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		require.Nil(t, jpeg.Encode(&buf, img, nil))
+	} else {
+		require.Nil(t, png.Encode(&buf, img))
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func decodeTestImage(t *testing.T, encoded string) (goimage.Image, string) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	require.Nil(t, err)
+	img, format, err := goimage.Decode(bytes.NewReader(raw))
+	require.Nil(t, err)
+	return img, format
+}
+
+func TestPreprocessImage(t *testing.T) {
+	transparent := goimage.NewNRGBA(goimage.Rect(0, 0, 400, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 400; x++ {
+			transparent.Set(x, y, color.NRGBA{R: 255, A: 128})
+		}
+	}
+
+	t.Run("without preprocessing", func(t *testing.T) {
+		encoded := encodeTestImage(t, transparent, "png")
+
+		res, err := preprocessImage(encoded, ImagePreprocessing{})
+
+		require.Nil(t, err)
+		assert.Equal(t, encoded, res)
+	})
+
+	t.Run("when the image is smaller than the max size", func(t *testing.T) {
+		encoded := encodeTestImage(t, transparent, "png")
+
+		res, err := preprocessImage(encoded, ImagePreprocessing{MaxSize: 400})
+
+		require.Nil(t, err)
+		assert.Equal(t, encoded, res)
+	})
+
+	t.Run("when the image is downscaled", func(t *testing.T) {
+		encoded := encodeTestImage(t, transparent, "jpeg")
+
+		res, err := preprocessImage(encoded, ImagePreprocessing{MaxSize: 100})
+
+		require.Nil(t, err)
+		img, format := decodeTestImage(t, res)
+		assert.Equal(t, "jpeg", format)
+		assert.Equal(t, goimage.Rect(0, 0, 100, 50), img.Bounds())
+	})
+
+	t.Run("when the image is normalized", func(t *testing.T) {
+		encoded := encodeTestImage(t, transparent, "png")
+
+		res, err := preprocessImage(encoded, ImagePreprocessing{MaxSize: 40, Normalize: true})
+
+		require.Nil(t, err)
+		img, format := decodeTestImage(t, res)
+		assert.Equal(t, "png", format)
+		assert.Equal(t, goimage.Rect(0, 0, 40, 20), img.Bounds())
+		// half transparent red on white
+		r, g, b, a := img.At(10, 10).RGBA()
+		assert.Equal(t, uint32(0xffff), a)
+		assert.Equal(t, uint32(0xffff), r)
+		assert.InDelta(t, 0x7fff, g, 0x200)
+		assert.InDelta(t, 0x7fff, b, 0x200)
+	})
+
+	t.Run("when the image can't be decoded", func(t *testing.T) {
+		encoded := base64.StdEncoding.EncodeToString([]byte("not an image"))
+
+		_, err := preprocessImage(encoded, ImagePreprocessing{Normalize: true})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "decode image")
+	})
+}
+
+func TestScaledSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		maxSize       int
+		wantWidth     int
+		wantHeight    int
+		wantScaled    bool
+	}{
+		{name: "landscape", width: 1000, height: 500, maxSize: 224, wantWidth: 224, wantHeight: 112, wantScaled: true},
+		{name: "portrait", width: 300, height: 900, maxSize: 300, wantWidth: 100, wantHeight: 300, wantScaled: true},
+		{name: "very wide", width: 10000, height: 2, maxSize: 100, wantWidth: 100, wantHeight: 1, wantScaled: true},
+		{name: "fits", width: 224, height: 100, maxSize: 224, wantWidth: 224, wantHeight: 100, wantScaled: false},
+		{name: "no max size", width: 1000, height: 500, maxSize: 0, wantWidth: 1000, wantHeight: 500, wantScaled: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height, scaled := scaledSize(goimage.Rect(0, 0, tt.width, tt.height), tt.maxSize)
+			assert.Equal(t, tt.wantWidth, width)
+			assert.Equal(t, tt.wantHeight, height)
+			assert.Equal(t, tt.wantScaled, scaled)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/multi2vec-google/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/multimodal"
+)
+
+type Vectorizer struct {
+	client Client
+}
+
+func New(client Client) *Vectorizer {
+	return &Vectorizer{
+		client: client,
+	}
+}
+
+type Client interface {
+	Vectorize(ctx context.Context, texts, images []string,
+		config ent.VectorizationConfig) (*ent.VectorizationResult, error)
+}
+
+type ClassSettings interface {
+	ImageField(property string) bool
+	ImageFields() []string
+	ImageFieldsWeights() ([]float32, error)
+	TextField(property string) bool
+	TextFields() []string
+	TextFieldsWeights() ([]float32, error)
+	ImagePreprocessing() ImagePreprocessing
+	ProjectID() string
+	Location() string
+	ModelID() string
+	Dimensions() int64
+}
+
+func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
+	objDiff *moduletools.ObjectDiff, settings ClassSettings,
+) error {
+	vec, err := v.object(ctx, object.ID, object.Properties, objDiff, settings)
+	if err != nil {
+		return err
+	}
+
+	object.Vector = vec
+	return nil
+}
+
+func (v *Vectorizer) VectorizeImage(ctx context.Context, image string,
+	settings ClassSettings,
+) ([]float32, error) {
+	image, err := preprocessImage(image, settings.ImagePreprocessing())
+	if err != nil {
+		return nil, errors.Wrap(err, "preprocess image")
+	}
+	res, err := v.client.Vectorize(ctx, []string{}, []string{image},
+		vectorizationConfig(settings))
+	if err != nil {
+		return nil, err
+	}
+	if len(res.ImageVectors) != 1 {
+		return nil, errors.New("empty vector")
+	}
+
+	return res.ImageVectors[0], nil
+}
+
+func (v *Vectorizer) object(ctx context.Context, id strfmt.UUID,
+	schema interface{}, objDiff *moduletools.ObjectDiff, ichek ClassSettings,
+) ([]float32, error) {
+	inputs, vectorize, err := multimodal.ObjectInputs(schema, objDiff, ichek)
+	if err != nil {
+		return nil, err
+	}
+
+	// no property was changed, old vector can be used
+	if !vectorize {
+		return objDiff.GetVec(), nil
+	}
+
+	preprocessing := ichek.ImagePreprocessing()
+	for i := range inputs.Images {
+		inputs.Images[i], err = preprocessImage(inputs.Images[i], preprocessing)
+		if err != nil {
+			return nil, errors.Wrap(err, "preprocess image")
+		}
+	}
+
+	if len(inputs.Texts) == 0 && len(inputs.Images) == 0 {
+		return inputs.CombineVectors(nil, nil), nil
+	}
+	res, err := v.client.Vectorize(ctx, inputs.Texts, inputs.Images, vectorizationConfig(ichek))
+	if err != nil {
+		return nil, err
+	}
+
+	return inputs.CombineVectors(res.TextVectors, res.ImageVectors), nil
+}
+
+func vectorizationConfig(settings ClassSettings) ent.VectorizationConfig {
+	return ent.VectorizationConfig{
+		ProjectID:  settings.ProjectID(),
+		Location:   settings.Location(),
+		Model:      settings.ModelID(),
+		Dimensions: settings.Dimensions(),
+	}
+}
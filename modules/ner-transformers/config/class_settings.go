@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	entityPropertyProperty = "entityProperty"
+	propertiesProperty     = "properties"
+	entityTypesProperty    = "entityTypes"
+)
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	if _, ok := ic.cfg.Class()[entityPropertyProperty]; ok {
+		entityProperty, ok := ic.cfg.Class()[entityPropertyProperty].(string)
+		if !ok {
+			return errors.Errorf("%s must be a string", entityPropertyProperty)
+		}
+		if _, err := schema.ValidatePropertyName(entityProperty); err != nil {
+			return errors.Wrapf(err, "invalid %s", entityPropertyProperty)
+		}
+		for _, prop := range class.Properties {
+			if prop.Name == entityProperty && !isTextArray(prop.DataType) {
+				return errors.Errorf("%s %q must be of data type text[]", entityPropertyProperty, entityProperty)
+			}
+		}
+	}
+
+	for _, name := range []string{propertiesProperty, entityTypesProperty} {
+		if _, err := ic.getStringListProperty(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EntityProperty is the property recognized entities are written to at
+// import time, entities aren't written if it is empty
+func (ic *classSettings) EntityProperty() string {
+	if ic.cfg == nil {
+		return ""
+	}
+	entityProperty, _ := ic.cfg.Class()[entityPropertyProperty].(string)
+	return entityProperty
+}
+
+// Properties are the text properties entities are recognized in, all text
+// properties are used if it is empty
+func (ic *classSettings) Properties() []string {
+	properties, _ := ic.getStringListProperty(propertiesProperty)
+	return properties
+}
+
+// EntityTypes are the entity types which are written, e.g. "I-PER", all
+// types are written if it is empty
+func (ic *classSettings) EntityTypes() []string {
+	entityTypes, _ := ic.getStringListProperty(entityTypesProperty)
+	return entityTypes
+}
+
+func (ic *classSettings) getStringListProperty(name string) ([]string, error) {
+	if ic.cfg == nil {
+		return nil, nil
+	}
+	value, ok := ic.cfg.Class()[name]
+	if !ok {
+		return nil, nil
+	}
+
+	switch values := value.(type) {
+	case []string:
+		return values, nil
+	case []interface{}:
+		out := make([]string, len(values))
+		for i := range values {
+			asString, ok := values[i].(string)
+			if !ok {
+				return nil, errors.Errorf("%s must be an array of strings", name)
+			}
+			out[i] = asString
+		}
+		return out, nil
+	default:
+		return nil, errors.Errorf("%s must be an array of strings", name)
+	}
+}
+
+func isTextArray(dataType []string) bool {
+	return len(dataType) == 1 && dataType[0] == string(schema.DataTypeTextArray)
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name               string
+		cfg                moduletools.ClassConfig
+		class              *models.Class
+		wantEntityProperty string
+		wantProperties     []string
+		wantEntityTypes    []string
+		wantErr            string
+	}{
+		{
+			name: "default settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			class: &models.Class{},
+		},
+		{
+			name: "custom settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"entityProperty": "entities",
+					"properties":     []interface{}{"content"},
+					"entityTypes":    []interface{}{"I-PER", "I-LOC"},
+				},
+			},
+			class: &models.Class{
+				Properties: []*models.Property{
+					{Name: "entities", DataType: []string{"text[]"}},
+				},
+			},
+			wantEntityProperty: "entities",
+			wantProperties:     []string{"content"},
+			wantEntityTypes:    []string{"I-PER", "I-LOC"},
+		},
+		{
+			name: "entity property with wrong data type",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"entityProperty": "entities",
+				},
+			},
+			class: &models.Class{
+				Properties: []*models.Property{
+					{Name: "entities", DataType: []string{"text"}},
+				},
+			},
+			wantErr: `entityProperty "entities" must be of data type text[]`,
+		},
+		{
+			name: "invalid entity property name",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"entityProperty": "not valid",
+				},
+			},
+			class:   &models.Class{},
+			wantErr: "invalid entityProperty",
+		},
+		{
+			name: "properties which aren't strings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"properties": []interface{}{1},
+				},
+			},
+			class:   &models.Class{},
+			wantErr: "properties must be an array of strings",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			err := ic.Validate(tt.class)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.wantEntityProperty, ic.EntityProperty())
+				assert.Equal(t, tt.wantProperties, ic.Properties())
+				assert.Equal(t, tt.wantEntityTypes, ic.EntityTypes())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
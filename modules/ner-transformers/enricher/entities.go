@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package enricher
+
+import (
+	"context"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/modules/ner-transformers/config"
+	"github.com/weaviate/weaviate/modules/ner-transformers/ent"
+)
+
+type nerClient interface {
+	GetTokens(ctx context.Context, property, text string) ([]ent.TokenResult, error)
+}
+
+// EntityEnricher writes the entities recognized in the text properties of
+// an object to the entity property configured for its class
+type EntityEnricher struct {
+	ner nerClient
+}
+
+func New(ner nerClient) *EntityEnricher {
+	return &EntityEnricher{ner}
+}
+
+func (e *EntityEnricher) EnrichedProperties(cfg moduletools.ClassConfig) []*models.Property {
+	entityProperty := config.NewClassSettings(cfg).EntityProperty()
+	if entityProperty == "" {
+		return nil
+	}
+
+	vTrue := true
+	return []*models.Property{{
+		Name:            entityProperty,
+		DataType:        schema.DataTypeTextArray.PropString(),
+		Description:     "Named entities recognized by the ner-transformers module",
+		Tokenization:    models.PropertyTokenizationField,
+		IndexFilterable: &vTrue,
+		IndexSearchable: &vTrue,
+	}}
+}
+
+func (e *EntityEnricher) EnrichObject(ctx context.Context, object *models.Object,
+	cfg moduletools.ClassConfig,
+) error {
+	settings := config.NewClassSettings(cfg)
+	entityProperty := settings.EntityProperty()
+	if entityProperty == "" {
+		return nil
+	}
+
+	properties := object.Properties.(map[string]interface{})
+	sources := settings.Properties()
+	entityTypes := settings.EntityTypes()
+
+	// properties are read in a stable order, so that the entities are too
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		if name != entityProperty && (len(sources) == 0 || contains(sources, name)) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	entities := []string{}
+	seen := map[string]struct{}{}
+	for _, name := range names {
+		text, ok := properties[name].(string)
+		if !ok || text == "" {
+			continue
+		}
+		tokens, err := e.ner.GetTokens(ctx, name, text)
+		if err != nil {
+			return err
+		}
+		for _, token := range tokens {
+			if len(entityTypes) > 0 && !contains(entityTypes, token.Entity) {
+				continue
+			}
+			if _, ok := seen[token.Word]; ok {
+				continue
+			}
+			seen[token.Word] = struct{}{}
+			entities = append(entities, token.Word)
+		}
+	}
+
+	properties[entityProperty] = entities
+	return nil
+}
+
+func contains(s []string, e string) bool {
+	for _, v := range s {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package enricher
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/modules/ner-transformers/ent"
+)
+
+func TestEntityEnricher(t *testing.T) {
+	t.Run("enriched property", func(t *testing.T) {
+		e := New(&fakeNERClient{})
+
+		props := e.EnrichedProperties(fakeClassConfig{"entityProperty": "entities"})
+
+		require.Len(t, props, 1)
+		assert.Equal(t, "entities", props[0].Name)
+		assert.Equal(t, []string{"text[]"}, props[0].DataType)
+		assert.Empty(t, e.EnrichedProperties(fakeClassConfig{}))
+	})
+
+	t.Run("entities of all text properties", func(t *testing.T) {
+		e := New(&fakeNERClient{})
+		object := &models.Object{Properties: map[string]interface{}{
+			"title":   "Sarah",
+			"content": "Berlin",
+			"count":   float64(1),
+		}}
+
+		err := e.EnrichObject(context.Background(), object, fakeClassConfig{"entityProperty": "entities"})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Berlin", "Sarah"}, object.Properties.(map[string]interface{})["entities"])
+	})
+
+	t.Run("entities of configured properties and types", func(t *testing.T) {
+		e := New(&fakeNERClient{})
+		object := &models.Object{Properties: map[string]interface{}{
+			"title":   "Sarah",
+			"content": "Berlin Berlin Sarah",
+		}}
+
+		err := e.EnrichObject(context.Background(), object, fakeClassConfig{
+			"entityProperty": "entities",
+			"properties":     []interface{}{"content"},
+			"entityTypes":    []interface{}{"I-LOC"},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Berlin"}, object.Properties.(map[string]interface{})["entities"])
+	})
+
+	t.Run("no entities without entity property", func(t *testing.T) {
+		e := New(&fakeNERClient{})
+		object := &models.Object{Properties: map[string]interface{}{"content": "Berlin"}}
+
+		err := e.EnrichObject(context.Background(), object, fakeClassConfig{})
+
+		require.Nil(t, err)
+		assert.Len(t, object.Properties.(map[string]interface{}), 1)
+	})
+}
+
+// fakeNERClient recognizes the words Berlin and Sarah
+type fakeNERClient struct{}
+
+func (c *fakeNERClient) GetTokens(ctx context.Context, property, text string) ([]ent.TokenResult, error) {
+	entities := map[string]string{"Berlin": "I-LOC", "Sarah": "I-PER"}
+	var tokens []ent.TokenResult
+	for _, word := range strings.Fields(text) {
+		if entity, ok := entities[word]; ok {
+			tokens = append(tokens, ent.TokenResult{Property: property, Word: word, Entity: entity})
+		}
+	}
+	return tokens, nil
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
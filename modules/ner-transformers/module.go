@@ -19,11 +19,13 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	neradditional "github.com/weaviate/weaviate/modules/ner-transformers/additional"
 	neradditionaltoken "github.com/weaviate/weaviate/modules/ner-transformers/additional/tokens"
 	"github.com/weaviate/weaviate/modules/ner-transformers/clients"
+	"github.com/weaviate/weaviate/modules/ner-transformers/enricher"
 	"github.com/weaviate/weaviate/modules/ner-transformers/ent"
 )
 
@@ -34,6 +36,7 @@ func New() *NERModule {
 type NERModule struct {
 	ner                          nerClient
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	enricher                     *enricher.EntityEnricher
 }
 
 type nerClient interface {
@@ -75,6 +78,7 @@ func (m *NERModule) initAdditional(ctx context.Context,
 
 	tokenProvider := neradditionaltoken.New(m.ner)
 	m.additionalPropertiesProvider = neradditional.New(tokenProvider)
+	m.enricher = enricher.New(m.ner)
 
 	return nil
 }
@@ -92,9 +96,20 @@ func (m *NERModule) AdditionalProperties() map[string]modulecapabilities.Additio
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
 
+func (m *NERModule) EnrichedProperties(cfg moduletools.ClassConfig) []*models.Property {
+	return m.enricher.EnrichedProperties(cfg)
+}
+
+func (m *NERModule) EnrichObject(ctx context.Context, object *models.Object,
+	cfg moduletools.ClassConfig,
+) error {
+	return m.enricher.EnrichObject(ctx, object, cfg)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ObjectEnricher(New())
 )
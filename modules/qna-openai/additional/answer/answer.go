@@ -25,12 +25,13 @@ import (
 type Params struct{}
 
 type qnaClient interface {
-	Answer(ctx context.Context, text, question string, cfg moduletools.ClassConfig) (*ent.AnswerResult, error)
+	Answer(ctx context.Context, text, question, model string, cfg moduletools.ClassConfig) (*ent.AnswerResult, error)
 }
 
 type paramsHelper interface {
 	GetQuestion(params interface{}) string
 	GetProperties(params interface{}) []string
+	GetModel(params interface{}) string
 }
 
 type AnswerProvider struct {
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
@@ -31,6 +32,7 @@ func (p *AnswerProvider) findAnswer(ctx context.Context, in []search.Result, par
 		return in, errors.New("empty question")
 	}
 	properties := p.paramsHelper.GetProperties(argumentModuleParams["ask"])
+	model := p.paramsHelper.GetModel(argumentModuleParams["ask"])
 
 	for i := range in {
 		textProperties := map[string]string{}
@@ -52,7 +54,7 @@ func (p *AnswerProvider) findAnswer(ctx context.Context, in []search.Result, par
 			return in, errors.New("empty content")
 		}
 
-		answer, err := p.qna.Answer(ctx, text, question, cfg)
+		answer, err := p.qna.Answer(ctx, text, question, model, cfg)
 		if err != nil {
 			return in, err
 		}
@@ -61,7 +63,16 @@ func (p *AnswerProvider) findAnswer(ctx context.Context, in []search.Result, par
 		if ap == nil {
 			ap = models.AdditionalProperties{}
 		}
-		propertyName, startPos, endPos := p.findProperty(answer.Answer, textProperties)
+		// the span of the quote the model cited is more precise than the
+		// span of the answer, which may be rephrased
+		span := answer.Answer
+		if answer.Quote != nil {
+			span = answer.Quote
+		}
+		propertyName, startPos, endPos := p.findProperty(span, textProperties)
+		if answer.Quote != nil && propertyName != nil && *propertyName == "" {
+			propertyName, startPos, endPos = p.findProperty(answer.Answer, textProperties)
+		}
 		ap["answer"] = &qnamodels.Answer{
 			Result:        answer.Answer,
 			Property:      propertyName,
@@ -96,9 +107,10 @@ func (p *AnswerProvider) findProperty(answer *string, textProperties map[string]
 	if len(lowercaseAnswer) > 0 {
 		for property, value := range textProperties {
 			lowercaseValue := strings.ToLower(strings.ReplaceAll(value, "\n", " "))
-			if strings.Contains(lowercaseValue, lowercaseAnswer) {
-				startIndex := strings.Index(lowercaseValue, lowercaseAnswer)
-				return &property, startIndex, startIndex + len(lowercaseAnswer)
+			if startIndex := strings.Index(lowercaseValue, lowercaseAnswer); startIndex >= 0 {
+				// positions are character offsets, not byte offsets
+				start := utf8.RuneCountInString(lowercaseValue[:startIndex])
+				return &property, start, start + utf8.RuneCountInString(lowercaseAnswer)
 			}
 		}
 	}
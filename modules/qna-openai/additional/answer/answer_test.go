@@ -150,12 +150,56 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 		assert.Equal(t, 19, answerAdditional.EndPosition)
 		assert.Equal(t, true, answerAdditional.HasAnswer)
 	})
+
+	t.Run("should answer with the span of the quote", func(t *testing.T) {
+		// given
+		quote := "the answer is here"
+		qnaClient := &fakeQnAClient{quote: &quote}
+		fakeHelper := &fakeParamsHelper{}
+		answerProvider := New(qnaClient, fakeHelper)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "Grüße, the answer is here",
+				},
+			},
+		}
+		fakeParams := &Params{}
+		limit := 1
+		argumentModuleParams := map[string]interface{}{
+			"ask": map[string]interface{}{
+				"question": "question",
+				"model":    "gpt-4o",
+			},
+		}
+
+		// when
+		_, err := answerProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams, nil)
+
+		// then
+		require.Nil(t, err)
+		assert.Equal(t, "gpt-4o", qnaClient.model)
+		answerAdditional, ok := in[0].AdditionalProperties["answer"].(*qnamodels.Answer)
+		require.True(t, ok)
+		assert.Equal(t, "answer", *answerAdditional.Result)
+		assert.Equal(t, "content", *answerAdditional.Property)
+		assert.Equal(t, 7, answerAdditional.StartPosition)
+		assert.Equal(t, 25, answerAdditional.EndPosition)
+		assert.True(t, answerAdditional.HasAnswer)
+	})
 }
 
-type fakeQnAClient struct{}
+type fakeQnAClient struct {
+	quote *string
+	model string
+}
 
-func (c *fakeQnAClient) Answer(ctx context.Context, text, question string, cfg moduletools.ClassConfig) (*ent.AnswerResult, error) {
-	return c.getAnswer(question, "answer"), nil
+func (c *fakeQnAClient) Answer(ctx context.Context, text, question, model string, cfg moduletools.ClassConfig) (*ent.AnswerResult, error) {
+	c.model = model
+	answer := c.getAnswer(question, "answer")
+	answer.Quote = c.quote
+	return answer, nil
 }
 
 func (c *fakeQnAClient) getAnswer(question, answer string) *ent.AnswerResult {
@@ -177,6 +221,15 @@ func (h *fakeParamsHelper) GetQuestion(params interface{}) string {
 	return ""
 }
 
+func (h *fakeParamsHelper) GetModel(params interface{}) string {
+	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
+		if model, ok := fakeParamsMap["model"].(string); ok {
+			return model
+		}
+	}
+	return ""
+}
+
 func (h *fakeParamsHelper) GetProperties(params interface{}) []string {
 	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
 		if properties, ok := fakeParamsMap["properties"].([]string); ok {
@@ -53,6 +53,10 @@ func (g *GraphQLArgumentsProvider) askFields(prefix string) graphql.InputObjectC
 			Description: "Properties which contains text",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"model": &graphql.InputObjectFieldConfig{
+			Description: "OpenAI model used to answer the question, overrides the model of the class",
+			Type:        graphql.String,
+		},
 	}
 	if g.askTransformer != nil {
 		askFields["autocorrect"] = &graphql.InputObjectFieldConfig{
@@ -30,14 +30,15 @@ func TestAskGraphQLArgument(t *testing.T) {
 		// the built graphQL field needs to support this structure:
 		// ask {
 		//   question: "question?",
-		//   properties: ["prop1", "prop2"]
+		//   properties: ["prop1", "prop2"],
+		//   model: "gpt-3.5-turbo"
 		// }
 		assert.NotNil(t, ask)
 		assert.Equal(t, "QnATransformersPrefixClassAskInpObj", ask.Type.Name())
 		askFields, ok := ask.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, askFields)
-		assert.Equal(t, 2, len(askFields.Fields()))
+		assert.Equal(t, 3, len(askFields.Fields()))
 		fields := askFields.Fields()
 		question := fields["question"]
 		questionNonNull, questionNonNullOK := question.Type.(*graphql.NonNull)
@@ -48,5 +49,8 @@ func TestAskGraphQLArgument(t *testing.T) {
 		propertiesList, propertiesListOK := properties.Type.(*graphql.List)
 		assert.True(t, propertiesListOK)
 		assert.Equal(t, "String", propertiesList.OfType.Name())
+		model := fields["model"]
+		assert.NotNil(t, model)
+		assert.Equal(t, "String", model.Type.Name())
 	})
 }
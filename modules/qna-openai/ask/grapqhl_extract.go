@@ -27,5 +27,10 @@ func (g *GraphQLArgumentsProvider) extractAskFn(source map[string]interface{}) i
 		}
 	}
 
+	model, ok := source["model"].(string)
+	if ok {
+		args.Model = model
+	}
+
 	return &args
 }
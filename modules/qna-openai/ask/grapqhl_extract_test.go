@@ -56,6 +56,19 @@ func Test_extractAskFn(t *testing.T) {
 				Properties: []string{"prop1", "prop2"},
 			},
 		},
+		{
+			name: "should parse properly with question and model",
+			args: args{
+				source: map[string]interface{}{
+					"question": "some question",
+					"model":    "gpt-4o",
+				},
+			},
+			want: &AskParams{
+				Question: "some question",
+				Model:    "gpt-4o",
+			},
+		},
 	}
 	t.Run("should extract without text transformer", func(t *testing.T) {
 		provider := New(nil)
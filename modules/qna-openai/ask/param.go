@@ -22,6 +22,7 @@ type AskParams struct {
 	WithDistance bool
 	Properties   []string
 	Autocorrect  bool
+	Model        string
 }
 
 func (n AskParams) GetCertainty() float64 {
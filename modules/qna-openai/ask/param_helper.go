@@ -31,6 +31,13 @@ func (p *ParamsHelper) GetProperties(params interface{}) []string {
 	return nil
 }
 
+func (p *ParamsHelper) GetModel(params interface{}) string {
+	if parameters, ok := params.(*AskParams); ok {
+		return parameters.Model
+	}
+	return ""
+}
+
 func (p *ParamsHelper) GetCertainty(params interface{}) float64 {
 	if parameters, ok := params.(*AskParams); ok {
 		return parameters.Certainty
@@ -149,3 +149,35 @@ func TestParamsHelper_GetProperties(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsHelper_GetModel(t *testing.T) {
+	tests := []struct {
+		name   string
+		params interface{}
+		want   string
+	}{
+		{
+			name:   "should get model",
+			params: &AskParams{Question: "question", Model: "gpt-4o"},
+			want:   "gpt-4o",
+		},
+		{
+			name:   "should get empty model when not set",
+			params: &AskParams{Question: "question"},
+			want:   "",
+		},
+		{
+			name:   "should get empty model with nil params",
+			params: nil,
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ParamsHelper{}
+			if got := p.GetModel(tt.params); got != tt.want {
+				t.Errorf("ParamsHelper.GetModel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
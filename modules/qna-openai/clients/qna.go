@@ -28,56 +28,86 @@ import (
 	"github.com/weaviate/weaviate/modules/qna-openai/ent"
 )
 
-func buildUrl(resourceName, deploymentID string) (string, error) {
+func buildUrl(isLegacy bool, resourceName, deploymentID string) (string, error) {
 	if resourceName != "" && deploymentID != "" {
 		host := "https://" + resourceName + ".openai.azure.com"
-		path := "openai/deployments/" + deploymentID + "/completions"
-		queryParam := "api-version=2022-12-01"
+		path := "openai/deployments/" + deploymentID + "/chat/completions"
+		queryParam := "api-version=2024-02-01"
+		if isLegacy {
+			path = "openai/deployments/" + deploymentID + "/completions"
+			queryParam = "api-version=2022-12-01"
+		}
 		return fmt.Sprintf("%s/%s?%s", host, path, queryParam), nil
 	}
 	host := "https://api.openai.com"
-	path := "/v1/completions"
+	path := "/v1/chat/completions"
+	if isLegacy {
+		path = "/v1/completions"
+	}
 	return url.JoinPath(host, path)
 }
 
 type qna struct {
 	openAIApiKey string
 	azureApiKey  string
-	buildUrlFn   func(resourceName, deploymentID string) (string, error)
+	buildUrlFn   func(isLegacy bool, resourceName, deploymentID string) (string, error)
 	httpClient   *http.Client
 	logger       logrus.FieldLogger
 }
 
-func New(openAIApiKey, azureApiKey string, logger logrus.FieldLogger) *qna {
+func New(openAIApiKey, azureApiKey string, transport http.RoundTripper,
+	logger logrus.FieldLogger,
+) *qna {
 	return &qna{
 		openAIApiKey: openAIApiKey,
 		azureApiKey:  azureApiKey,
-		httpClient:   &http.Client{},
+		httpClient:   &http.Client{Transport: transport},
 		buildUrlFn:   buildUrl,
 		logger:       logger,
 	}
 }
 
-func (v *qna) Answer(ctx context.Context, text, question string, cfg moduletools.ClassConfig) (*ent.AnswerResult, error) {
-	prompt := v.generatePrompt(text, question)
-
+// Answer answers the question with the text. The model of the class settings
+// is used unless a model is given.
+func (v *qna) Answer(ctx context.Context, text, question, model string, cfg moduletools.ClassConfig) (*ent.AnswerResult, error) {
 	settings := config.NewClassSettings(cfg)
+	if model == "" {
+		model = settings.Model()
+	}
+	isLegacy := config.IsLegacyModel(model)
 
-	body, err := json.Marshal(answersInput{
-		Prompt:           prompt,
-		Model:            settings.Model(),
-		MaxTokens:        settings.MaxTokens(),
-		Temperature:      settings.Temperature(),
-		Stop:             []string{"\n"},
-		FrequencyPenalty: settings.FrequencyPenalty(),
-		PresencePenalty:  settings.PresencePenalty(),
-		TopP:             settings.TopP(),
-	})
+	var input interface{}
+	if isLegacy {
+		input = answersInput{
+			Prompt:           v.generatePrompt(text, question),
+			Model:            model,
+			MaxTokens:        settings.MaxTokens(),
+			Temperature:      settings.Temperature(),
+			Stop:             []string{"\n"},
+			FrequencyPenalty: settings.FrequencyPenalty(),
+			PresencePenalty:  settings.PresencePenalty(),
+			TopP:             settings.TopP(),
+		}
+	} else {
+		input = chatInput{
+			Messages: []message{
+				{Role: "system", Content: chatInstruction},
+				{Role: "user", Content: v.generateChatPrompt(text, question)},
+			},
+			Model:            model,
+			MaxTokens:        settings.MaxTokens(),
+			Temperature:      settings.Temperature(),
+			FrequencyPenalty: settings.FrequencyPenalty(),
+			PresencePenalty:  settings.PresencePenalty(),
+			TopP:             settings.TopP(),
+		}
+	}
+	body, err := json.Marshal(input)
 	if err != nil {
 		return nil, errors.Wrapf(err, "marshal body")
 	}
 
-	oaiUrl, err := v.buildUrlFn(settings.ResourceName(), settings.DeploymentID())
+	oaiUrl, err := v.buildUrlFn(isLegacy, settings.ResourceName(), settings.DeploymentID())
 	if err != nil {
 		return nil, errors.Wrap(err, "join OpenAI API host and path")
 	}
@@ -114,18 +144,23 @@ func (v *qna) Answer(ctx context.Context, text, question string, cfg moduletools
 		return nil, v.getError(res.StatusCode, resBody.Error, settings.IsAzure())
 	}
 
-	if len(resBody.Choices) > 0 && resBody.Choices[0].Text != "" {
-		return &ent.AnswerResult{
-			Text:     text,
-			Question: question,
-			Answer:   &resBody.Choices[0].Text,
-		}, nil
-	}
-	return &ent.AnswerResult{
+	result := &ent.AnswerResult{
 		Text:     text,
 		Question: question,
-		Answer:   nil,
-	}, nil
+	}
+	if len(resBody.Choices) == 0 {
+		return result, nil
+	}
+	if isLegacy {
+		if resBody.Choices[0].Text != "" {
+			result.Answer = &resBody.Choices[0].Text
+		}
+		return result, nil
+	}
+	if resBody.Choices[0].Message != nil {
+		result.Answer, result.Quote = parseChatAnswer(resBody.Choices[0].Message.Content)
+	}
+	return result, nil
 }
 
 func (v *qna) getError(statusCode int, resBodyError *openAIApiError, isAzure bool) error {
@@ -156,31 +191,63 @@ Q: %v
 A:`, strings.ReplaceAll(text, "\n", " "), question)
 }
 
+// chatInstruction makes chat models cite the excerpt of the context their
+// answer is based on, which is located in the properties of the object
+const chatInstruction = `Answer the question using only the given context. Reply with a JSON object ` +
+	`with the fields "answer", a short answer to the question, and "quote", the shortest excerpt ` +
+	`of the context which contains the answer, copied exactly. If the context doesn't answer the ` +
+	`question, reply with {"answer": null, "quote": null}.`
+
+func (v *qna) generateChatPrompt(text string, question string) string {
+	return fmt.Sprintf("Context: %v\n\nQuestion: %v", strings.ReplaceAll(text, "\n", " "), question)
+}
+
+// parseChatAnswer returns the answer and quote of the JSON reply of a chat
+// model, replies which aren't JSON are taken as answer without quote
+func parseChatAnswer(content string) (*string, *string) {
+	content = strings.TrimSpace(content)
+	content = strings.TrimPrefix(content, "```json")
+	content = strings.Trim(content, "`\n ")
+	if content == "" {
+		return nil, nil
+	}
+
+	var reply struct {
+		Answer *string `json:"answer"`
+		Quote  *string `json:"quote"`
+	}
+	if err := json.Unmarshal([]byte(content), &reply); err != nil {
+		return &content, nil
+	}
+	if reply.Answer == nil || *reply.Answer == "" {
+		return nil, nil
+	}
+	if reply.Quote != nil && *reply.Quote == "" {
+		reply.Quote = nil
+	}
+	return reply.Answer, reply.Quote
+}
+
 func (v *qna) getApiKey(ctx context.Context, isAzure bool) (string, error) {
-	var apiKey, envVar string
+	var apiKey, envVar, envApiKey string
 
 	if isAzure {
 		apiKey = "X-Azure-Api-Key"
 		envVar = "AZURE_APIKEY"
-		if len(v.azureApiKey) > 0 {
-			return v.azureApiKey, nil
-		}
+		envApiKey = v.azureApiKey
 	} else {
 		apiKey = "X-Openai-Api-Key"
 		envVar = "OPENAI_APIKEY"
-		if len(v.openAIApiKey) > 0 {
-			return v.openAIApiKey, nil
-		}
+		envApiKey = v.openAIApiKey
 	}
 
-	return v.getApiKeyFromContext(ctx, apiKey, envVar)
-}
-
-func (v *qna) getApiKeyFromContext(ctx context.Context, apiKey, envVar string) (string, error) {
-	if apiValue := ctx.Value(apiKey); apiValue != nil {
-		if apiKeyHeader, ok := apiValue.([]string); ok && len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
-			return apiKeyHeader[0], nil
-		}
+	// a key passed with the request takes precedence over the one the
+	// server was started with
+	if apiKeyValue := moduletools.GetValueFromContext(ctx, apiKey); apiKeyValue != "" {
+		return apiKeyValue, nil
+	}
+	if envApiKey != "" {
+		return envApiKey, nil
 	}
 	return "", fmt.Errorf("no api key found neither in request header: %s nor in environment variable under %s", apiKey, envVar)
 }
@@ -196,6 +263,21 @@ type answersInput struct {
 	TopP             float64  `json:"top_p"`
 }
 
+type chatInput struct {
+	Messages         []message `json:"messages"`
+	Model            string    `json:"model"`
+	MaxTokens        float64   `json:"max_tokens"`
+	Temperature      float64   `json:"temperature"`
+	FrequencyPenalty float64   `json:"frequency_penalty"`
+	PresencePenalty  float64   `json:"presence_penalty"`
+	TopP             float64   `json:"top_p"`
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
 type answersResponse struct {
 	Choices []choice
 	Error   *openAIApiError `json:"error,omitempty"`
@@ -206,6 +288,7 @@ type choice struct {
 	Index        float32
 	Logprobs     string
 	Text         string
+	Message      *message `json:"message,omitempty"`
 }
 
 type openAIApiError struct {
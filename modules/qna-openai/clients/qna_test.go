@@ -32,8 +32,8 @@ func nullLogger() logrus.FieldLogger {
 	return l
 }
 
-func fakeBuildUrl(serverURL, resourceName, deploymentID string) (string, error) {
-	endpoint, err := buildUrl(resourceName, deploymentID)
+func fakeBuildUrl(serverURL string, isLegacy bool, resourceName, deploymentID string) (string, error) {
+	endpoint, err := buildUrl(isLegacy, resourceName, deploymentID)
 	if err != nil {
 		return "", err
 	}
@@ -44,7 +44,8 @@ func fakeBuildUrl(serverURL, resourceName, deploymentID string) (string, error)
 func TestGetAnswer(t *testing.T) {
 	t.Run("when the server has a successful answer ", func(t *testing.T) {
 		handler := &testAnswerHandler{
-			t: t,
+			t:    t,
+			path: "/v1/completions",
 			answer: answersResponse{
 				Choices: []choice{{
 					FinishReason: "test",
@@ -58,9 +59,9 @@ func TestGetAnswer(t *testing.T) {
 		server := httptest.NewServer(handler)
 		defer server.Close()
 
-		c := New("openAIApiKey", "", nullLogger())
-		c.buildUrlFn = func(resourceName, deploymentID string) (string, error) {
-			return fakeBuildUrl(server.URL, resourceName, deploymentID)
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.buildUrlFn = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
+			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
 		}
 
 		expected := ent.AnswerResult{
@@ -69,7 +70,7 @@ func TestGetAnswer(t *testing.T) {
 			Answer:   ptString("John"),
 		}
 
-		res, err := c.Answer(context.Background(), "My name is John", "What is my name?", nil)
+		res, err := c.Answer(context.Background(), "My name is John", "What is my name?", "text-davinci-003", nil)
 
 		assert.Nil(t, err)
 		assert.Equal(t, expected, *res)
@@ -77,7 +78,8 @@ func TestGetAnswer(t *testing.T) {
 
 	t.Run("when the server has a an error", func(t *testing.T) {
 		server := httptest.NewServer(&testAnswerHandler{
-			t: t,
+			t:    t,
+			path: "/v1/completions",
 			answer: answersResponse{
 				Error: &openAIApiError{
 					Message: "some error from the server",
@@ -86,26 +88,126 @@ func TestGetAnswer(t *testing.T) {
 		})
 		defer server.Close()
 
-		c := New("openAIApiKey", "", nullLogger())
-		c.buildUrlFn = func(resourceName, deploymentID string) (string, error) {
-			return fakeBuildUrl(server.URL, resourceName, deploymentID)
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.buildUrlFn = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
+			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
 		}
 
-		_, err := c.Answer(context.Background(), "My name is John", "What is my name?", nil)
+		_, err := c.Answer(context.Background(), "My name is John", "What is my name?", "text-davinci-003", nil)
 
 		require.NotNil(t, err)
 		assert.Error(t, err, "connection to OpenAI failed with status: 500 error: some error from the server")
 	})
+
+	t.Run("when a chat model answers with a quote", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t:    t,
+			path: "/v1/chat/completions",
+			answer: answersResponse{
+				Choices: []choice{{
+					Message: &message{
+						Role:    "assistant",
+						Content: `{"answer": "John", "quote": "My name is John"}`,
+					},
+				}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("openAIApiKey", "", nil, nullLogger())
+		c.buildUrlFn = func(isLegacy bool, resourceName, deploymentID string) (string, error) {
+			return fakeBuildUrl(server.URL, isLegacy, resourceName, deploymentID)
+		}
+
+		expected := ent.AnswerResult{
+			Text:     "My name is John",
+			Question: "What is my name?",
+			Answer:   ptString("John"),
+			Quote:    ptString("My name is John"),
+		}
+
+		res, err := c.Answer(context.Background(), "My name is John", "What is my name?", "gpt-4o", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, *res)
+		assert.Equal(t, "gpt-4o", handler.model)
+	})
+}
+
+func TestParseChatAnswer(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		answer  *string
+		quote   *string
+	}{
+		{
+			name:    "answer with quote",
+			content: `{"answer": "John", "quote": "My name is John"}`,
+			answer:  ptString("John"),
+			quote:   ptString("My name is John"),
+		},
+		{
+			name:    "answer in code fence",
+			content: "```json\n{\"answer\": \"John\", \"quote\": \"\"}\n```",
+			answer:  ptString("John"),
+		},
+		{
+			name:    "no answer",
+			content: `{"answer": null, "quote": null}`,
+		},
+		{
+			name:    "plain text reply",
+			content: "John",
+			answer:  ptString("John"),
+		},
+		{
+			name:    "empty reply",
+			content: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, quote := parseChatAnswer(tt.content)
+			assert.Equal(t, tt.answer, answer)
+			assert.Equal(t, tt.quote, quote)
+		})
+	}
+}
+
+func TestBuildUrl(t *testing.T) {
+	t.Run("OpenAI", func(t *testing.T) {
+		url, err := buildUrl(false, "", "")
+		require.Nil(t, err)
+		assert.Equal(t, "https://api.openai.com/v1/chat/completions", url)
+
+		url, err = buildUrl(true, "", "")
+		require.Nil(t, err)
+		assert.Equal(t, "https://api.openai.com/v1/completions", url)
+	})
+
+	t.Run("Azure", func(t *testing.T) {
+		url, err := buildUrl(false, "resource", "deployment")
+		require.Nil(t, err)
+		assert.Equal(t, "https://resource.openai.azure.com/openai/deployments/deployment/chat/completions?api-version=2024-02-01", url)
+
+		url, err = buildUrl(true, "resource", "deployment")
+		require.Nil(t, err)
+		assert.Equal(t, "https://resource.openai.azure.com/openai/deployments/deployment/completions?api-version=2022-12-01", url)
+	})
 }
 
 type testAnswerHandler struct {
-	t *testing.T
+	t    *testing.T
+	path string
 	// the test handler will report as not ready before the time has passed
 	answer answersResponse
+	model  string
 }
 
 func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	assert.Equal(f.t, "/v1/completions", r.URL.String())
+	assert.Equal(f.t, f.path, r.URL.String())
 	assert.Equal(f.t, http.MethodPost, r.Method)
 
 	if f.answer.Error != nil && f.answer.Error.Message != "" {
@@ -123,6 +225,7 @@ func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	var b map[string]interface{}
 	require.Nil(f.t, json.Unmarshal(bodyBytes, &b))
+	f.model, _ = b["model"].(string)
 
 	outBytes, err := json.Marshal(f.answer)
 	require.Nil(f.t, err)
@@ -30,9 +30,9 @@ const (
 )
 
 var (
-	DefaultOpenAIModel                    = "text-ada-001"
+	DefaultOpenAIModel                    = "gpt-4o-mini"
 	DefaultOpenAITemperature      float64 = 0.0
-	DefaultOpenAIMaxTokens        float64 = 16
+	DefaultOpenAIMaxTokens        float64 = 256
 	DefaultOpenAIFrequencyPenalty float64 = 0.0
 	DefaultOpenAIPresencePenalty  float64 = 0.0
 	DefaultOpenAITopP             float64 = 1.0
@@ -44,9 +44,16 @@ var maxTokensForModel = map[string]float64{
 	"text-curie-001":   2048,
 	"text-davinci-002": 4000,
 	"text-davinci-003": 4000,
+	"gpt-3.5-turbo":    4096,
+	"gpt-4":            8192,
+	"gpt-4-turbo":      4096,
+	"gpt-4o":           4096,
+	"gpt-4o-mini":      16384,
 }
 
-var availableOpenAIModels = []string{
+// availableOpenAILegacyModels are served by the completions API, all other
+// models by the chat completions API
+var availableOpenAILegacyModels = []string{
 	"text-ada-001",
 	"text-babbage-001",
 	"text-curie-001",
@@ -54,6 +61,14 @@ var availableOpenAIModels = []string{
 	"text-davinci-003",
 }
 
+var availableOpenAIModels = append([]string{
+	"gpt-3.5-turbo",
+	"gpt-4",
+	"gpt-4-turbo",
+	"gpt-4o",
+	"gpt-4o-mini",
+}, availableOpenAILegacyModels...)
+
 type classSettings struct {
 	cfg moduletools.ClassConfig
 }
@@ -173,6 +188,17 @@ func (ic *classSettings) Model() string {
 	return *ic.getStringProperty(modelProperty, DefaultOpenAIModel)
 }
 
+// IsLegacyModel reports whether the model is served by the completions API
+// instead of the chat completions API
+func IsLegacyModel(model string) bool {
+	for _, legacyModel := range availableOpenAILegacyModels {
+		if model == legacyModel {
+			return true
+		}
+	}
+	return false
+}
+
 func (ic *classSettings) MaxTokens() float64 {
 	return *ic.getFloatProperty(maxTokensProperty, &DefaultOpenAIMaxTokens)
 }
@@ -39,8 +39,8 @@ func Test_classSettings_Validate(t *testing.T) {
 			cfg: fakeClassConfig{
 				classConfig: map[string]interface{}{},
 			},
-			wantModel:            "text-ada-001",
-			wantMaxTokens:        16,
+			wantModel:            "gpt-4o-mini",
+			wantMaxTokens:        256,
 			wantTemperature:      0.0,
 			wantTopP:             1,
 			wantFrequencyPenalty: 0.0,
@@ -67,6 +67,22 @@ func Test_classSettings_Validate(t *testing.T) {
 			wantPresencePenalty:  0.9,
 			wantErr:              nil,
 		},
+		{
+			name: "Chat model configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model":     "gpt-4o",
+					"maxTokens": 1000,
+				},
+			},
+			wantModel:            "gpt-4o",
+			wantMaxTokens:        1000,
+			wantTemperature:      0.0,
+			wantTopP:             1,
+			wantFrequencyPenalty: 0.0,
+			wantPresencePenalty:  0.0,
+			wantErr:              nil,
+		},
 		{
 			name: "Azure OpenAI config",
 			cfg: fakeClassConfig{
@@ -75,11 +91,11 @@ func Test_classSettings_Validate(t *testing.T) {
 					"deploymentId": "text-ada-001",
 				},
 			},
-			wantModel:            "text-ada-001",
+			wantModel:            "gpt-4o-mini",
 			wantResourceName:     "weaviate",
 			wantDeploymentID:     "text-ada-001",
 			wantIsAzure:          true,
-			wantMaxTokens:        16,
+			wantMaxTokens:        256,
 			wantTemperature:      0.0,
 			wantTopP:             1,
 			wantFrequencyPenalty: 0.0,
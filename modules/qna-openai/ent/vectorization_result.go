@@ -15,4 +15,7 @@ type AnswerResult struct {
 	Text     string
 	Question string
 	Answer   *string
+	// Quote is the excerpt of the text the answer is based on, if the
+	// model cites one
+	Quote *string
 }
@@ -44,7 +44,7 @@ type QnAModule struct {
 }
 
 type qnaClient interface {
-	Answer(ctx context.Context, text, question string, cfg moduletools.ClassConfig) (*ent.AnswerResult, error)
+	Answer(ctx context.Context, text, question, model string, cfg moduletools.ClassConfig) (*ent.AnswerResult, error)
 	MetaInfo() (map[string]interface{}, error)
 }
 
@@ -135,7 +135,15 @@ func (m *QnAModule) initAdditional(ctx context.Context,
 	openAIApiKey := os.Getenv("OPENAI_APIKEY")
 	azureApiKey := os.Getenv("AZURE_APIKEY")
 
-	client := clients.New(openAIApiKey, azureApiKey, logger)
+	httpOptions, err := moduletools.HTTPClientOptionsFromEnv("OPENAI")
+	if err != nil {
+		return err
+	}
+	transport, err := moduletools.NewHTTPTransport(httpOptions)
+	if err != nil {
+		return err
+	}
+	client := clients.New(openAIApiKey, azureApiKey, transport, logger)
 
 	m.qna = client
 
@@ -11,18 +11,40 @@
 
 package config
 
-import "github.com/weaviate/weaviate/entities/moduletools"
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
 
 const (
-	MethodMean    = "mean"
+	// MethodMean is the plain mean of the referenced vectors
+	MethodMean = "mean"
+	// MethodWeighted weighs the referenced vectors by a numeric property of
+	// the referenced objects
+	MethodWeighted = "weighted"
+	// MethodDecay weighs the referenced vectors by their age, the weight
+	// halves with every half-life
+	MethodDecay = "decay"
+	// MethodMedian is the median of every dimension of the referenced vectors
+	MethodMedian = "median"
+
 	MethodDefault = MethodMean
 )
 
+// DefaultHalfLife is used by the decay method unless configured otherwise
+const DefaultHalfLife = 30 * 24 * time.Hour
+
 const (
 	calculationMethodField   = "method"
 	referencePropertiesField = "referenceProperties"
+	weightPropertyField      = "weightProperty"
+	timePropertyField        = "timeProperty"
+	halfLifeField            = "halfLife"
 )
 
+var methods = []string{MethodMean, MethodWeighted, MethodDecay, MethodMedian}
+
 func Default() map[string]interface{} {
 	return map[string]interface{}{
 		calculationMethodField: MethodDefault,
@@ -54,3 +76,32 @@ func (c *Config) CalculationMethod() string {
 	calcMethod := props[calculationMethodField].(string)
 	return calcMethod
 }
+
+// WeightProperty is the numeric property of the referenced objects which
+// holds their weight for the weighted method
+func (c *Config) WeightProperty() string {
+	prop, _ := c.class.Class()[weightPropertyField].(string)
+	return prop
+}
+
+// TimeProperty is the date property of the referenced objects which the
+// decay method uses to determine their age. If it is not set, the creation
+// time of the referenced objects is used.
+func (c *Config) TimeProperty() string {
+	prop, _ := c.class.Class()[timePropertyField].(string)
+	return prop
+}
+
+// HalfLife is the age at which a referenced vector has half of the weight of
+// a brand new one for the decay method
+func (c *Config) HalfLife() time.Duration {
+	halfLife, ok := c.class.Class()[halfLifeField].(string)
+	if !ok {
+		return DefaultHalfLife
+	}
+	d, err := time.ParseDuration(halfLife)
+	if err != nil {
+		return DefaultHalfLife
+	}
+	return d
+}
@@ -14,6 +14,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var errInvalidConfig = errors.New("invalid config")
@@ -46,5 +47,50 @@ func Validate(cfg *Config) error {
 		}
 	}
 
-	return nil
+	return validateMethod(class)
+}
+
+func validateMethod(class map[string]interface{}) error {
+	iMethod, ok := class[calculationMethodField]
+	if !ok {
+		return nil
+	}
+	method, ok := iMethod.(string)
+	if !ok {
+		return fmt.Errorf("%w: expected string for field %q, got %T",
+			errInvalidConfig, calculationMethodField, iMethod)
+	}
+
+	switch method {
+	case "", MethodMean, MethodMedian:
+		return nil
+	case MethodWeighted:
+		if prop, ok := class[weightPropertyField].(string); !ok || prop == "" {
+			return fmt.Errorf("%w: method %q requires a property name in the %q field",
+				errInvalidConfig, MethodWeighted, weightPropertyField)
+		}
+		return nil
+	case MethodDecay:
+		if iProp, ok := class[timePropertyField]; ok {
+			if _, ok := iProp.(string); !ok {
+				return fmt.Errorf("%w: expected string for field %q, got %T",
+					errInvalidConfig, timePropertyField, iProp)
+			}
+		}
+		if iHalfLife, ok := class[halfLifeField]; ok {
+			halfLife, ok := iHalfLife.(string)
+			if !ok {
+				return fmt.Errorf("%w: expected duration string for field %q, got %T",
+					errInvalidConfig, halfLifeField, iHalfLife)
+			}
+			if d, err := time.ParseDuration(halfLife); err != nil || d <= 0 {
+				return fmt.Errorf("%w: expected positive duration such as \"720h\" for field %q, got %q",
+					errInvalidConfig, halfLifeField, halfLife)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown method %q, available methods are: %v",
+			errInvalidConfig, method, methods)
+	}
 }
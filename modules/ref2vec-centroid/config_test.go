@@ -83,6 +83,67 @@ func TestConfigValidator(t *testing.T) {
 				"to contain strings, found int: [someRef 123]",
 				class.Class),
 		},
+		{
+			name:  "valid config - weighted",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "weighted",
+				"weightProperty":      "rating",
+			},
+		},
+		{
+			name:  "invalid config - weighted without weightProperty",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "weighted",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: method \"weighted\" "+
+				"requires a property name in the \"weightProperty\" field",
+				class.Class),
+		},
+		{
+			name:  "valid config - decay",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "decay",
+				"timeProperty":        "publishedAt",
+				"halfLife":            "168h",
+			},
+		},
+		{
+			name:  "invalid config - decay with negative halfLife",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "decay",
+				"halfLife":            "-1h",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: expected positive duration "+
+				"such as \"720h\" for field \"halfLife\", got \"-1h\"",
+				class.Class),
+		},
+		{
+			name:  "valid config - median",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "median",
+			},
+		},
+		{
+			name:  "invalid config - unknown method",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "mode",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: unknown method \"mode\", "+
+				"available methods are: [mean weighted decay median]",
+				class.Class),
+		},
 	}
 
 	for _, test := range tests {
@@ -64,9 +64,18 @@ func (m *CentroidModule) VectorizeObject(ctx context.Context,
 	return vzr.Object(ctx, obj)
 }
 
+func (m *CentroidModule) UpdateObjectVector(ctx context.Context,
+	obj *models.Object, change modulecapabilities.ReferenceChange,
+	cfg moduletools.ClassConfig, findRefVecsFn modulecapabilities.FindObjectFn,
+) (bool, error) {
+	vzr := vectorizer.New(cfg, findRefVecsFn)
+	return vzr.Update(ctx, obj, change.Property, change.Beacon, change.Count)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.ReferenceVectorizer(New())
+	_ = modulecapabilities.IncrementalReferenceVectorizer(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
@@ -39,3 +39,21 @@ func calculateMean(refVecs ...[]float32) ([]float32, error) {
 
 	return meanVec, nil
 }
+
+// updateMean turns the mean of prevCount vectors into the mean of count
+// vectors, by adding refVec to it or removing refVec from it as often as the
+// counts differ
+func updateMean(meanVec, refVec []float32, prevCount, count int) ([]float32, error) {
+	if len(meanVec) != len(refVec) {
+		return nil, fmt.Errorf("update mean: found vectors of different length: %d and %d",
+			len(meanVec), len(refVec))
+	}
+
+	diff := float32(count - prevCount)
+	updated := make([]float32, len(meanVec))
+	for i := range meanVec {
+		updated[i] = (meanVec[i]*float32(prevCount) + diff*refVec[i]) / float32(count)
+	}
+
+	return updated, nil
+}
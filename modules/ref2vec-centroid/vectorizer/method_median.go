@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"fmt"
+	"sort"
+)
+
+func calculateMedian(refVecs ...[]float32) ([]float32, error) {
+	if len(refVecs) == 0 || len(refVecs[0]) == 0 {
+		return nil, nil
+	}
+
+	targetVecLen := len(refVecs[0])
+	for _, vec := range refVecs {
+		if len(vec) != targetVecLen {
+			return nil, fmt.Errorf("calculate median: found vectors of different length: %d and %d",
+				targetVecLen, len(vec))
+		}
+	}
+
+	medianVec := make([]float32, targetVecLen)
+	values := make([]float32, len(refVecs))
+	middle := len(refVecs) / 2
+	for i := range medianVec {
+		for j, vec := range refVecs {
+			values[j] = vec[i]
+		}
+		sort.Slice(values, func(a, b int) bool { return values[a] < values[b] })
+
+		if len(values)%2 == 1 {
+			medianVec[i] = values[middle]
+		} else {
+			medianVec[i] = (values[middle-1] + values[middle]) / 2
+		}
+	}
+
+	return medianVec, nil
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// calculateWeightedMean is the mean of the vectors weighted by the weights of
+// the same index. If all weights are zero there is nothing to calculate the
+// centroid from, just as if there were no references.
+func calculateWeightedMean(refVecs [][]float32, weights []float32) ([]float32, error) {
+	if len(refVecs) == 0 || len(refVecs[0]) == 0 {
+		return nil, nil
+	}
+
+	targetVecLen := len(refVecs[0])
+	meanVec := make([]float32, targetVecLen)
+
+	var weightSum float32
+	for i, vec := range refVecs {
+		if len(vec) != targetVecLen {
+			return nil, fmt.Errorf("calculate weighted mean: found vectors of different length: %d and %d",
+				targetVecLen, len(vec))
+		}
+
+		for j, val := range vec {
+			meanVec[j] += weights[i] * val
+		}
+		weightSum += weights[i]
+	}
+
+	if weightSum == 0 {
+		return nil, nil
+	}
+	for i := range meanVec {
+		meanVec[i] /= weightSum
+	}
+
+	return meanVec, nil
+}
+
+// propertyWeight reads the weight from a numeric property of the referenced
+// object. References without the property have the weight 1, so they count
+// the same as in the plain mean.
+func propertyWeight(res *search.Result, prop string) (float32, error) {
+	props, _ := res.Schema.(map[string]interface{})
+	value, ok := props[prop]
+	if !ok || value == nil {
+		return 1, nil
+	}
+
+	var weight float64
+	switch v := value.(type) {
+	case float64:
+		weight = v
+	case float32:
+		weight = float64(v)
+	case int64:
+		weight = float64(v)
+	case int:
+		weight = float64(v)
+	case json.Number:
+		var err error
+		if weight, err = v.Float64(); err != nil {
+			return 0, fmt.Errorf("weight property %q of object %s: %w", prop, res.ID, err)
+		}
+	default:
+		return 0, fmt.Errorf("weight property %q of object %s: expected number, got %T",
+			prop, res.ID, value)
+	}
+
+	if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+		return 0, fmt.Errorf("weight property %q of object %s: expected non-negative number, got %v",
+			prop, res.ID, weight)
+	}
+	return float32(weight), nil
+}
+
+// decayWeight halves the weight of the referenced object with every
+// half-life of its age. The age is taken from a date property or, if none is
+// configured or the object doesn't have it, from the object's creation time.
+func decayWeight(res *search.Result, prop string, halfLife time.Duration,
+	now time.Time,
+) (float32, error) {
+	created := time.UnixMilli(res.Created)
+	if prop != "" {
+		props, _ := res.Schema.(map[string]interface{})
+		switch v := props[prop].(type) {
+		case nil:
+		case time.Time:
+			created = v
+		case string:
+			parsed, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return 0, fmt.Errorf("time property %q of object %s: %w", prop, res.ID, err)
+			}
+			created = parsed
+		default:
+			return 0, fmt.Errorf("time property %q of object %s: expected date, got %T",
+				prop, res.ID, v)
+		}
+	}
+
+	age := now.Sub(created)
+	if age < 0 {
+		// objects from the future are treated as brand new
+		age = 0
+	}
+	return float32(math.Exp2(-float64(age) / float64(halfLife))), nil
+}
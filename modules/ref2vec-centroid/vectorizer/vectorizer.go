@@ -14,6 +14,7 @@ package vectorizer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -27,23 +28,46 @@ import (
 
 type calcFn func(vecs ...[]float32) ([]float32, error)
 
+// weightFn returns the weight of a referenced object for the weighted mean
+type weightFn func(res *search.Result) (float32, error)
+
 type Vectorizer struct {
 	config       *config.Config
 	calcFn       calcFn
+	weightFn     weightFn
 	findObjectFn modulecapabilities.FindObjectFn
+	now          func() time.Time
+	// incremental is true if a single reference change can be applied to the
+	// current vector, which is only the case for the plain mean
+	incremental bool
 }
 
 func New(cfg moduletools.ClassConfig, findFn modulecapabilities.FindObjectFn) *Vectorizer {
 	v := &Vectorizer{
 		config:       config.New(cfg),
 		findObjectFn: findFn,
+		now:          time.Now,
 	}
 
 	switch v.config.CalculationMethod() {
 	case config.MethodMean:
 		v.calcFn = calculateMean
+		v.incremental = true
+	case config.MethodMedian:
+		v.calcFn = calculateMedian
+	case config.MethodWeighted:
+		prop := v.config.WeightProperty()
+		v.weightFn = func(res *search.Result) (float32, error) {
+			return propertyWeight(res, prop)
+		}
+	case config.MethodDecay:
+		prop, halfLife := v.config.TimeProperty(), v.config.HalfLife()
+		v.weightFn = func(res *search.Result) (float32, error) {
+			return decayWeight(res, prop, halfLife, v.now())
+		}
 	default:
 		v.calcFn = calculateMean
+		v.incremental = true
 	}
 
 	return v
@@ -52,17 +76,17 @@ func New(cfg moduletools.ClassConfig, findFn modulecapabilities.FindObjectFn) *V
 func (v *Vectorizer) Object(ctx context.Context, obj *models.Object) error {
 	props := v.config.ReferenceProperties()
 
-	refVecs, err := v.referenceVectorSearch(ctx, obj, props)
+	refs, err := v.referenceVectorSearch(ctx, obj, props)
 	if err != nil {
 		return err
 	}
 
-	if len(refVecs) == 0 {
+	if len(refs) == 0 {
 		obj.Vector = nil
 		return nil
 	}
 
-	vec, err := v.calcFn(refVecs...)
+	vec, err := v.calculate(refs)
 	if err != nil {
 		return fmt.Errorf("calculate vector: %w", err)
 	}
@@ -71,10 +95,81 @@ func (v *Vectorizer) Object(ctx context.Context, obj *models.Object) error {
 	return nil
 }
 
+// Update applies a reference which was added to or removed from obj to the
+// object's current vector, without finding all other referenced objects. The
+// properties of obj must already contain the change. It returns false if the
+// vector can't be updated incrementally and needs to be calculated by Object.
+//
+// The new mean is derived from the number of references, so it is only exact
+// as long as all referenced objects have vectors. If the changed reference
+// has none, the vector is always calculated from scratch.
+func (v *Vectorizer) Update(ctx context.Context, obj *models.Object,
+	prop string, beacon strfmt.URI, count int,
+) (bool, error) {
+	refProps := v.config.ReferenceProperties()
+	if _, ok := refProps[prop]; !ok || count == 0 {
+		// the property doesn't contribute to the vector
+		return true, nil
+	}
+	if !v.incremental || obj.Vector == nil {
+		return false, nil
+	}
+
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+	total := len(beaconsForVectorization(props, refProps))
+	if count > 0 && total-count <= 0 {
+		return false, nil
+	}
+	if total == 0 {
+		obj.Vector = nil
+		return true, nil
+	}
+
+	ref, err := v.findReferenceObject(ctx, beacon)
+	if err != nil {
+		return false, err
+	}
+	if ref.Vector == nil {
+		return false, nil
+	}
+
+	vec, err := updateMean(obj.Vector, ref.Vector, total-count, total)
+	if err != nil {
+		return false, fmt.Errorf("calculate vector: %w", err)
+	}
+
+	obj.Vector = vec
+	return true, nil
+}
+
+func (v *Vectorizer) calculate(refs []*search.Result) ([]float32, error) {
+	refVecs := make([][]float32, len(refs))
+	for i := range refs {
+		refVecs[i] = refs[i].Vector
+	}
+
+	if v.weightFn == nil {
+		return v.calcFn(refVecs...)
+	}
+
+	weights := make([]float32, len(refs))
+	for i := range refs {
+		weight, err := v.weightFn(refs[i])
+		if err != nil {
+			return nil, err
+		}
+		weights[i] = weight
+	}
+	return calculateWeightedMean(refVecs, weights)
+}
+
 func (v *Vectorizer) referenceVectorSearch(ctx context.Context,
 	obj *models.Object, refProps map[string]struct{},
-) ([][]float32, error) {
-	var refVecs [][]float32
+) ([]*search.Result, error) {
+	var refs []*search.Result
 	props := obj.Properties.(map[string]interface{})
 
 	// use the ids from parent's beacons to find the referenced objects
@@ -89,11 +184,11 @@ func (v *Vectorizer) referenceVectorSearch(ctx context.Context,
 		// these will be used to compute the parent's
 		// vector eventually
 		if res.Vector != nil {
-			refVecs = append(refVecs, res.Vector)
+			refs = append(refs, res)
 		}
 	}
 
-	return refVecs, nil
+	return refs, nil
 }
 
 func (v *Vectorizer) findReferenceObject(ctx context.Context, beacon strfmt.URI) (res *search.Result, err error) {
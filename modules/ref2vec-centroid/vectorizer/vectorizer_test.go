@@ -16,6 +16,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -167,3 +168,220 @@ func TestVectorizer_Object(t *testing.T) {
 		assert.Nil(t, obj.Vector)
 	})
 }
+
+func TestVectorizer_ObjectWithMethods(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name           string
+		cfg            fakeClassConfig
+		results        []*search.Result
+		expectedResult []float32
+		expectedErr    string
+	}{
+		{
+			name: "median",
+			cfg:  fakeClassConfig{"method": "median"},
+			results: []*search.Result{
+				{Vector: []float32{1, 10}},
+				{Vector: []float32{2, 30}},
+				{Vector: []float32{100, 20}},
+			},
+			expectedResult: []float32{2, 20},
+		},
+		{
+			name: "weighted",
+			cfg:  fakeClassConfig{"method": "weighted", "weightProperty": "rating"},
+			results: []*search.Result{
+				{Vector: []float32{2, 4}, Schema: map[string]interface{}{"rating": float64(3)}},
+				{Vector: []float32{6, 8}, Schema: map[string]interface{}{"rating": float64(1)}},
+			},
+			expectedResult: []float32{3, 5},
+		},
+		{
+			name: "weighted with missing weight property",
+			cfg:  fakeClassConfig{"method": "weighted", "weightProperty": "rating"},
+			results: []*search.Result{
+				{Vector: []float32{2, 4}, Schema: map[string]interface{}{}},
+				{Vector: []float32{6, 8}},
+			},
+			expectedResult: []float32{4, 6},
+		},
+		{
+			name: "weighted with all weights zero",
+			cfg:  fakeClassConfig{"method": "weighted", "weightProperty": "rating"},
+			results: []*search.Result{
+				{Vector: []float32{2, 4}, Schema: map[string]interface{}{"rating": float64(0)}},
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "weighted with negative weight",
+			cfg:  fakeClassConfig{"method": "weighted", "weightProperty": "rating"},
+			results: []*search.Result{
+				{Vector: []float32{2, 4}, Schema: map[string]interface{}{"rating": float64(-1)}},
+			},
+			expectedErr: "calculate vector: weight property \"rating\" of object : " +
+				"expected non-negative number, got -1",
+		},
+		{
+			name: "decay by creation time",
+			cfg:  fakeClassConfig{"method": "decay", "halfLife": "24h"},
+			results: []*search.Result{
+				{Vector: []float32{0, 3}, Created: now.UnixMilli()},
+				{Vector: []float32{3, 0}, Created: now.Add(-day).UnixMilli()},
+			},
+			expectedResult: []float32{1, 2},
+		},
+		{
+			name: "decay by time property",
+			cfg: fakeClassConfig{
+				"method": "decay", "halfLife": "24h", "timeProperty": "publishedAt",
+			},
+			results: []*search.Result{
+				{
+					Vector: []float32{0, 3},
+					Schema: map[string]interface{}{"publishedAt": now.Add(day).Format(time.RFC3339)},
+				},
+				{
+					Vector: []float32{3, 0},
+					Schema: map[string]interface{}{"publishedAt": now.Add(-day).Format(time.RFC3339)},
+				},
+			},
+			expectedResult: []float32{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &fakeObjectsRepo{}
+			test.cfg["referenceProperties"] = []interface{}{"toRef"}
+
+			modelRefs := make(models.MultipleRef, len(test.results))
+			for i, res := range test.results {
+				crossRef := crossref.New("localhost", "SomeClass",
+					strfmt.UUID(uuid.NewString()))
+				modelRefs[i] = crossRef.SingleRef()
+
+				repo.On("Object", ctx, crossRef.Class, crossRef.TargetID).
+					Return(res, nil)
+			}
+
+			obj := &models.Object{
+				Properties: map[string]interface{}{"toRef": modelRefs},
+			}
+
+			vzr := New(test.cfg, repo.Object)
+			vzr.now = func() time.Time { return now }
+
+			err := vzr.Object(ctx, obj)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				assert.Nil(t, err)
+				assert.InDeltaSlice(t, test.expectedResult, obj.Vector, 1e-6)
+			}
+		})
+	}
+}
+
+func TestVectorizer_Update(t *testing.T) {
+	ctx := context.Background()
+	refProps := []interface{}{"toRef"}
+	changed := crossref.New("localhost", "SomeClass", strfmt.UUID(uuid.NewString()))
+	other := crossref.New("localhost", "SomeClass", strfmt.UUID(uuid.NewString()))
+
+	newObject := func(vec []float32, refs ...*crossref.Ref) *models.Object {
+		modelRefs := make(models.MultipleRef, len(refs))
+		for i := range refs {
+			modelRefs[i] = refs[i].SingleRef()
+		}
+		return &models.Object{
+			Properties: map[string]interface{}{"toRef": modelRefs},
+			Vector:     vec,
+		}
+	}
+
+	t.Run("add reference", func(t *testing.T) {
+		repo := &fakeObjectsRepo{}
+		repo.On("Object", ctx, changed.Class, changed.TargetID).
+			Return(&search.Result{Vector: []float32{4, 6}}, nil)
+		cfg := fakeClassConfig{"method": "mean", "referenceProperties": refProps}
+
+		obj := newObject([]float32{1, 3}, other, other, changed)
+		updated, err := New(cfg, repo.Object).Update(ctx, obj, "toRef", changed.SingleRef().Beacon, 1)
+		assert.Nil(t, err)
+		assert.True(t, updated)
+		assert.InDeltaSlice(t, []float32{2, 4}, obj.Vector, 1e-6)
+	})
+
+	t.Run("remove reference twice", func(t *testing.T) {
+		repo := &fakeObjectsRepo{}
+		repo.On("Object", ctx, changed.Class, changed.TargetID).
+			Return(&search.Result{Vector: []float32{4, 6}}, nil)
+		cfg := fakeClassConfig{"method": "mean", "referenceProperties": refProps}
+
+		obj := newObject([]float32{3, 5}, other, other)
+		updated, err := New(cfg, repo.Object).Update(ctx, obj, "toRef", changed.SingleRef().Beacon, -2)
+		assert.Nil(t, err)
+		assert.True(t, updated)
+		assert.InDeltaSlice(t, []float32{2, 4}, obj.Vector, 1e-6)
+	})
+
+	t.Run("remove last reference", func(t *testing.T) {
+		repo := &fakeObjectsRepo{}
+		cfg := fakeClassConfig{"method": "mean", "referenceProperties": refProps}
+
+		obj := newObject([]float32{4, 6})
+		updated, err := New(cfg, repo.Object).Update(ctx, obj, "toRef", changed.SingleRef().Beacon, -1)
+		assert.Nil(t, err)
+		assert.True(t, updated)
+		assert.Nil(t, obj.Vector)
+	})
+
+	t.Run("reference property not used for vectorization", func(t *testing.T) {
+		repo := &fakeObjectsRepo{}
+		cfg := fakeClassConfig{"method": "mean", "referenceProperties": refProps}
+
+		obj := newObject([]float32{1, 3}, other)
+		updated, err := New(cfg, repo.Object).Update(ctx, obj, "otherRef", changed.SingleRef().Beacon, 1)
+		assert.Nil(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, models.C11yVector{1, 3}, obj.Vector)
+	})
+
+	t.Run("first reference", func(t *testing.T) {
+		repo := &fakeObjectsRepo{}
+		cfg := fakeClassConfig{"method": "mean", "referenceProperties": refProps}
+
+		obj := newObject(nil, changed)
+		updated, err := New(cfg, repo.Object).Update(ctx, obj, "toRef", changed.SingleRef().Beacon, 1)
+		assert.Nil(t, err)
+		assert.False(t, updated)
+	})
+
+	t.Run("referenced object without vector", func(t *testing.T) {
+		repo := &fakeObjectsRepo{}
+		repo.On("Object", ctx, changed.Class, changed.TargetID).
+			Return(&search.Result{}, nil)
+		cfg := fakeClassConfig{"method": "mean", "referenceProperties": refProps}
+
+		obj := newObject([]float32{1, 3}, other, changed)
+		updated, err := New(cfg, repo.Object).Update(ctx, obj, "toRef", changed.SingleRef().Beacon, 1)
+		assert.Nil(t, err)
+		assert.False(t, updated)
+		assert.Equal(t, models.C11yVector{1, 3}, obj.Vector)
+	})
+
+	t.Run("method which can't be updated incrementally", func(t *testing.T) {
+		repo := &fakeObjectsRepo{}
+		cfg := fakeClassConfig{"method": "median", "referenceProperties": refProps}
+
+		obj := newObject([]float32{1, 3}, other, changed)
+		updated, err := New(cfg, repo.Object).Update(ctx, obj, "toRef", changed.SingleRef().Beacon, 1)
+		assert.Nil(t, err)
+		assert.False(t, updated)
+	})
+}
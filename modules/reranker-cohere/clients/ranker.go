@@ -24,7 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/reranker-cohere/config"
-	"github.com/weaviate/weaviate/modules/reranker-cohere/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
 )
 
 type client struct {
@@ -45,22 +45,41 @@ func New(apiKey string, logger logrus.FieldLogger) *client {
 	}
 }
 
-func (v *client) Rank(ctx context.Context, cfg moduletools.ClassConfig,
-	rankpropertyValue string, query string,
+// maxDocumentsPerRequest is the number of documents Cohere reranks at once
+const maxDocumentsPerRequest = 1000
+
+func (v *client) Rank(ctx context.Context, query string, documents []string,
+	cfg moduletools.ClassConfig,
 ) (*ent.RankResult, error) {
+	result := &ent.RankResult{Query: query}
+	for start := 0; start < len(documents); start += maxDocumentsPerRequest {
+		end := start + maxDocumentsPerRequest
+		if end > len(documents) {
+			end = len(documents)
+		}
+		scores, err := v.rank(ctx, cfg, query, documents[start:end])
+		if err != nil {
+			return nil, err
+		}
+		result.DocumentScores = append(result.DocumentScores, scores...)
+	}
+	return result, nil
+}
+
+func (v *client) rank(ctx context.Context, cfg moduletools.ClassConfig,
+	query string, documents []string,
+) ([]ent.DocumentScore, error) {
 	settings := config.NewClassSettings(cfg)
 	cohereUrl, err := url.JoinPath(v.host, v.path)
 	if err != nil {
 		return nil, errors.Wrap(err, "join Cohere API host and path")
 	}
 
-	cohereDocumentsInput := []string{rankpropertyValue}
-
 	input := RankInput{
-		RankPropertyValue: cohereDocumentsInput,
-		Query:             query,
-		Model:             settings.Model(),
-		ReturnDocuments:   settings.ReturnDocuments(),
+		Documents:       documents,
+		Query:           query,
+		Model:           settings.Model(),
+		ReturnDocuments: settings.ReturnDocuments(),
 	}
 
 	body, err := json.Marshal(input)
@@ -107,11 +126,20 @@ func (v *client) Rank(ctx context.Context, cfg moduletools.ClassConfig,
 	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
 		return nil, errors.Wrap(err, "unmarshal response body")
 	}
-	return &ent.RankResult{
-		RankPropertyValue: rankpropertyValue,
-		Query:             query,
-		Score:             resBody.Results[0].RelevanceScore,
-	}, nil
+
+	// results are sorted by relevance, they're put back in the order of
+	// the documents
+	scores := make([]ent.DocumentScore, len(documents))
+	for i := range documents {
+		scores[i].Document = documents[i]
+	}
+	for _, result := range resBody.Results {
+		if result.Index < 0 || result.Index >= len(documents) {
+			return nil, errors.Errorf("Cohere API returned unknown document index %d", result.Index)
+		}
+		scores[result.Index].Score = result.RelevanceScore
+	}
+	return scores, nil
 }
 
 func (v *client) getApiKey(ctx context.Context) (string, error) {
@@ -129,10 +157,10 @@ func (v *client) getApiKey(ctx context.Context) (string, error) {
 }
 
 type RankInput struct {
-	RankPropertyValue []string `json:"documents"`
-	Query             string   `json:"query"`
-	Model             string   `json:"model"`
-	ReturnDocuments   bool     `json:"return_documents"`
+	Documents       []string `json:"documents"`
+	Query           string   `json:"query"`
+	Model           string   `json:"model"`
+	ReturnDocuments bool     `json:"return_documents"`
 }
 
 type Result struct {
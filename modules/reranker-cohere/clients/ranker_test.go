@@ -23,7 +23,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/weaviate/weaviate/modules/reranker-cohere/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
 )
 
 func nullLogger() logrus.FieldLogger {
@@ -38,9 +38,13 @@ func TestRank(t *testing.T) {
 			response: RankResponse{
 				Results: []Result{
 					{
-						Index:          0,
+						Index:          1,
 						RelevanceScore: 0.9,
 					},
+					{
+						Index:          0,
+						RelevanceScore: 0.15,
+					},
 				},
 			},
 		}
@@ -51,12 +55,14 @@ func TestRank(t *testing.T) {
 		c.host = server.URL
 
 		expected := &ent.RankResult{
-			RankPropertyValue: "I work at Apple",
-			Query:             "Where do I work?",
-			Score:             0.9,
+			Query: "Where do I work?",
+			DocumentScores: []ent.DocumentScore{
+				{Document: "I like apples", Score: 0.15},
+				{Document: "I work at Apple", Score: 0.9},
+			},
 		}
 
-		res, err := c.Rank(context.Background(), nil, "I work at Apple", "Where do I work?")
+		res, err := c.Rank(context.Background(), "Where do I work?", []string{"I like apples", "I work at Apple"}, nil)
 
 		assert.Nil(t, err)
 		assert.Equal(t, expected, res)
@@ -76,7 +82,7 @@ func TestRank(t *testing.T) {
 		c := New("apiKey", nullLogger())
 		c.host = server.URL
 
-		_, err := c.Rank(context.Background(), nil, "I work at Apple", "Where do I work?")
+		_, err := c.Rank(context.Background(), "Where do I work?", []string{"I work at Apple"}, nil)
 
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "some error from the server")
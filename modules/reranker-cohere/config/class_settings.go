@@ -25,6 +25,8 @@ const (
 var availableCohereModels = []string{
 	"rerank-english-v2.0",
 	"rerank-multilingual-v2.0",
+	"rerank-english-v3.0",
+	"rerank-multilingual-v3.0",
 }
 
 // note it might not like this -- might want int values for e.g. MaxTokens
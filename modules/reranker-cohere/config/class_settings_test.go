@@ -46,6 +46,16 @@ func Test_classSettings_Validate(t *testing.T) {
 			wantModel:           "rerank-english-v2.0",
 			wantReturnDocuments: true,
 		},
+		{
+			name: "v3 model",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model": "rerank-english-v3.0",
+				},
+			},
+			wantModel:           "rerank-english-v3.0",
+			wantReturnDocuments: false,
+		},
 		{
 			name: "unsupported model error",
 			cfg: fakeClassConfig{
@@ -53,7 +63,7 @@ func Test_classSettings_Validate(t *testing.T) {
 					"model": "rerank-french-v2.0",
 				},
 			},
-			wantErr: fmt.Errorf("wrong Cohere model name, available model names are: [rerank-english-v2.0 rerank-multilingual-v2.0]"),
+			wantErr: fmt.Errorf("wrong Cohere model name, available model names are: [rerank-english-v2.0 rerank-multilingual-v2.0 rerank-english-v3.0 rerank-multilingual-v3.0]"),
 		},
 	}
 	for _, tt := range tests {
@@ -20,10 +20,9 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
-	rerankeradditional "github.com/weaviate/weaviate/modules/reranker-cohere/additional"
-	rerankeradditionalrank "github.com/weaviate/weaviate/modules/reranker-cohere/additional/rank"
 	"github.com/weaviate/weaviate/modules/reranker-cohere/clients"
-	"github.com/weaviate/weaviate/modules/reranker-cohere/ent"
+	additionalprovider "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
 )
 
 const Name = "reranker-cohere"
@@ -38,7 +37,7 @@ type ReRankerCohereModule struct {
 }
 
 type ReRankerCohereClient interface {
-	Rank(ctx context.Context, cfg moduletools.ClassConfig, property string, query string) (*ent.RankResult, error)
+	Rank(ctx context.Context, query string, documents []string, cfg moduletools.ClassConfig) (*ent.RankResult, error)
 	MetaInfo() (map[string]interface{}, error)
 }
 
@@ -69,8 +68,7 @@ func (m *ReRankerCohereModule) initAdditional(ctx context.Context,
 
 	m.reranker = client
 
-	rerankerProvider := rerankeradditionalrank.New(m.reranker)
-	m.additionalPropertiesProvider = rerankeradditional.New(rerankerProvider)
+	m.additionalPropertiesProvider = additionalprovider.NewRankerProvider(m.reranker)
 	return nil
 }
 
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
+)
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
+
+func TestRank(t *testing.T) {
+	documents := []string{"I like apples", "I work at Apple", "Apple pie"}
+
+	t.Run("when the server has a successful response", func(t *testing.T) {
+		handler := &testRankHandler{
+			t: t,
+			response: RankResponse{
+				Results: []Result{
+					{Index: 1, RelevanceScore: 0.9},
+					{Index: 0, RelevanceScore: 0.2},
+				},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		res, err := c.Rank(context.Background(), "Where do I work?", documents, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, &ent.RankResult{
+			Query: "Where do I work?",
+			DocumentScores: []ent.DocumentScore{
+				{Document: "I like apples", Score: 0.2},
+				{Document: "I work at Apple", Score: 0.9},
+				{Document: "Apple pie", Score: 0},
+			},
+		}, res)
+		assert.Equal(t, "Bearer apiKey", handler.lastHeader.Get("Authorization"))
+		assert.Equal(t, "jina-reranker-v2-base-multilingual", handler.lastInput.Model)
+		assert.Nil(t, handler.lastInput.TopN)
+	})
+
+	t.Run("when topN is configured", func(t *testing.T) {
+		handler := &testRankHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"topN": float64(2)}}
+		_, err := c.Rank(context.Background(), "Where do I work?", documents, cfg)
+
+		require.Nil(t, err)
+		require.NotNil(t, handler.lastInput.TopN)
+		assert.Equal(t, 2, *handler.lastInput.TopN)
+	})
+
+	t.Run("when the api key is passed with the request", func(t *testing.T) {
+		handler := &testRankHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		ctx := context.WithValue(context.Background(), "X-Jinaai-Api-Key", []string{"requestApiKey"})
+		_, err := c.Rank(ctx, "Where do I work?", documents, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, "Bearer requestApiKey", handler.lastHeader.Get("Authorization"))
+	})
+
+	t.Run("when there is no api key", func(t *testing.T) {
+		c := New("", nullLogger())
+
+		_, err := c.Rank(context.Background(), "Where do I work?", documents, nil)
+
+		require.NotNil(t, err)
+		assert.Equal(t, "Jina AI API Key: no api key found neither in request header: X-JinaAI-Api-Key nor in environment variable under JINAAI_APIKEY", err.Error())
+	})
+
+	t.Run("when the server has an error", func(t *testing.T) {
+		handler := &testRankHandler{
+			t:            t,
+			errorMessage: "some error from the server",
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		_, err := c.Rank(context.Background(), "Where do I work?", documents, nil)
+
+		require.NotNil(t, err)
+		assert.Equal(t, "connection to Jina AI API failed with status 500: some error from the server", err.Error())
+	})
+}
+
+type testRankHandler struct {
+	t            *testing.T
+	response     RankResponse
+	errorMessage string
+	lastInput    RankInput
+	lastHeader   http.Header
+}
+
+func (f *testRankHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/v1/rerank", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastHeader = r.Header
+
+	if f.errorMessage != "" {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"detail":"` + f.errorMessage + `"}`))
+		return
+	}
+
+	require.Nil(f.t, json.NewDecoder(r.Body).Decode(&f.lastInput))
+
+	outBytes, err := json.Marshal(f.response)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
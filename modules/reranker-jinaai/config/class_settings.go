@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const (
+	modelProperty = "model"
+	topNProperty  = "topN"
+)
+
+var availableModels = []string{
+	"jina-reranker-v2-base-multilingual",
+	"jina-reranker-v1-base-en",
+	"jina-reranker-v1-turbo-en",
+	"jina-reranker-v1-tiny-en",
+	"jina-colbert-v1-en",
+}
+
+var (
+	DefaultModel = "jina-reranker-v2-base-multilingual"
+	// DefaultTopN of 0 scores all documents
+	DefaultTopN = 0
+)
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+	model := ic.getStringProperty(modelProperty, DefaultModel)
+	if model == nil || !contains(availableModels, *model) {
+		return errors.Errorf("wrong Jina AI model name, available model names are: %v", availableModels)
+	}
+	topN := ic.getIntProperty(topNProperty, DefaultTopN)
+	if topN == nil || *topN < 0 {
+		return errors.Errorf("wrong %s, must be a non-negative integer", topNProperty)
+	}
+
+	return nil
+}
+
+func (ic *classSettings) getStringProperty(name string, defaultValue string) *string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return &defaultValue
+	}
+
+	value, ok := ic.cfg.ClassByModuleName("reranker-jinaai")[name]
+	if ok {
+		asString, ok := value.(string)
+		if ok {
+			return &asString
+		}
+		var empty string
+		return &empty
+	}
+	return &defaultValue
+}
+
+func (ic *classSettings) getIntProperty(name string, defaultValue int) *int {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return &defaultValue
+	}
+
+	value, ok := ic.cfg.ClassByModuleName("reranker-jinaai")[name]
+	if !ok {
+		return &defaultValue
+	}
+	switch v := value.(type) {
+	case int:
+		return &v
+	case float64:
+		if v == float64(int(v)) {
+			asInt := int(v)
+			return &asInt
+		}
+	case json.Number:
+		if asInt64, err := v.Int64(); err == nil {
+			asInt := int(asInt64)
+			return &asInt
+		}
+	}
+	return nil
+}
+
+func (ic *classSettings) Model() string {
+	return *ic.getStringProperty(modelProperty, DefaultModel)
+}
+
+// TopN is the number of the most relevant documents which are scored, the
+// scores of all other documents are 0
+func (ic *classSettings) TopN() int {
+	if topN := ic.getIntProperty(topNProperty, DefaultTopN); topN != nil {
+		return *topN
+	}
+	return DefaultTopN
+}
+
+func contains[T comparable](s []T, e T) bool {
+	for _, v := range s {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modrerankerjinaai
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/reranker-jinaai/clients"
+	additionalprovider "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
+)
+
+const Name = "reranker-jinaai"
+
+func New() *ReRankerJinaAIModule {
+	return &ReRankerJinaAIModule{}
+}
+
+type ReRankerJinaAIModule struct {
+	reranker                     ReRankerJinaAIClient
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+}
+
+type ReRankerJinaAIClient interface {
+	Rank(ctx context.Context, query string, documents []string, cfg moduletools.ClassConfig) (*ent.RankResult, error)
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *ReRankerJinaAIModule) Name() string {
+	return Name
+}
+
+func (m *ReRankerJinaAIModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2TextReranker
+}
+
+func (m *ReRankerJinaAIModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	if err := m.initAdditional(ctx, params.GetLogger()); err != nil {
+		return errors.Wrap(err, "init reranker")
+	}
+
+	return nil
+}
+
+func (m *ReRankerJinaAIModule) initAdditional(ctx context.Context,
+	logger logrus.FieldLogger,
+) error {
+	apiKey := os.Getenv("JINAAI_APIKEY")
+
+	client := clients.New(apiKey, logger)
+
+	m.reranker = client
+	m.additionalPropertiesProvider = additionalprovider.NewRankerProvider(m.reranker)
+	return nil
+}
+
+func (m *ReRankerJinaAIModule) MetaInfo() (map[string]interface{}, error) {
+	return m.reranker.MetaInfo()
+}
+
+func (m *ReRankerJinaAIModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *ReRankerJinaAIModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.AdditionalProperties(New())
+	_ = modulecapabilities.MetaProvider(New())
+)
@@ -21,75 +21,146 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
-	"github.com/weaviate/weaviate/modules/reranker-transformers/ent"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
 )
 
+// DefaultMaxBatchSize of 1 ranks every document with its own request, since
+// batches require an inference container which serves batch requests
+const DefaultMaxBatchSize = 1
+
 type client struct {
-	origin     string
-	httpClient *http.Client
-	logger     logrus.FieldLogger
+	origin       string
+	maxBatchSize int
+	httpClient   *http.Client
+	logger       logrus.FieldLogger
 }
 
-func New(origin string, logger logrus.FieldLogger) *client {
+func New(origin string, maxBatchSize int, logger logrus.FieldLogger) *client {
 	return &client{
-		origin:     origin,
-		httpClient: &http.Client{},
-		logger:     logger,
+		origin:       origin,
+		maxBatchSize: maxBatchSize,
+		httpClient:   &http.Client{},
+		logger:       logger,
 	}
 }
 
-func (v *client) Rank(ctx context.Context,
-	rankpropertyValue string, query string,
+func (v *client) Rank(ctx context.Context, query string, documents []string,
+	cfg moduletools.ClassConfig,
 ) (*ent.RankResult, error) {
+	result := &ent.RankResult{Query: query}
+	if v.maxBatchSize <= 1 {
+		for _, document := range documents {
+			score, err := v.rank(ctx, query, document)
+			if err != nil {
+				return nil, err
+			}
+			result.DocumentScores = append(result.DocumentScores, *score)
+		}
+		return result, nil
+	}
+
+	for start := 0; start < len(documents); start += v.maxBatchSize {
+		end := start + v.maxBatchSize
+		if end > len(documents) {
+			end = len(documents)
+		}
+		scores, err := v.rankBatch(ctx, query, documents[start:end])
+		if err != nil {
+			return nil, err
+		}
+		result.DocumentScores = append(result.DocumentScores, scores...)
+	}
+	return result, nil
+}
+
+func (v *client) rank(ctx context.Context, query, document string,
+) (*ent.DocumentScore, error) {
 	body, err := json.Marshal(RankInput{
-		RankPropertyValue: rankpropertyValue,
+		RankPropertyValue: document,
 		Query:             query,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "marshal body")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", v.url("/rerank"),
+	var resBody RankResponse
+	if err := v.send(ctx, "/rerank", body, &resBody); err != nil {
+		return nil, err
+	}
+	return &ent.DocumentScore{Document: document, Score: resBody.Score}, nil
+}
+
+// rankBatch sends the documents of a batch in a single request to the
+// inference container
+func (v *client) rankBatch(ctx context.Context, query string, documents []string,
+) ([]ent.DocumentScore, error) {
+	body, err := json.Marshal(RankBatchInput{
+		Query:     query,
+		Documents: documents,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	var resBody RankBatchResponse
+	if err := v.send(ctx, "/rerank/batch", body, &resBody); err != nil {
+		return nil, err
+	}
+	if len(resBody.Scores) != len(documents) {
+		return nil, errors.Errorf("got %d scores for %d documents",
+			len(resBody.Scores), len(documents))
+	}
+
+	scores := make([]ent.DocumentScore, len(documents))
+	for i := range documents {
+		scores[i] = ent.DocumentScore{Document: documents[i], Score: resBody.Scores[i].Score}
+	}
+	return scores, nil
+}
+
+func (v *client) send(ctx context.Context, path string, body []byte,
+	resBody errorResponse,
+) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", v.url(path),
 		bytes.NewReader(body))
 	if err != nil {
-		return nil, errors.Wrap(err, "create POST request")
+		return errors.Wrap(err, "create POST request")
 	}
 
 	res, err := v.httpClient.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "send POST request")
+		return errors.Wrap(err, "send POST request")
 	}
 	defer res.Body.Close()
 
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "read response body")
+		return errors.Wrap(err, "read response body")
 	}
 
-	var resBody RankResponse
-	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
-		return nil, errors.Wrap(err, "unmarshal response body")
+	if err := json.Unmarshal(bodyBytes, resBody); err != nil {
+		return errors.Wrap(err, "unmarshal response body")
 	}
 
 	if res.StatusCode != 200 {
-		if resBody.Error != "" {
-			return nil, errors.Errorf("fail with status %d: %s", res.StatusCode,
-				resBody.Error)
+		if msg := resBody.errorMessage(); msg != "" {
+			return errors.Errorf("fail with status %d: %s", res.StatusCode, msg)
 		}
-		return nil, errors.Errorf("fail with status %d", res.StatusCode)
+		return errors.Errorf("fail with status %d", res.StatusCode)
 	}
 
-	return &ent.RankResult{
-		RankPropertyValue: resBody.RankPropertyValue,
-		Query:             resBody.Query,
-		Score:             resBody.Score,
-	}, nil
+	return nil
 }
 
 func (v *client) url(path string) string {
 	return fmt.Sprintf("%s%s", v.origin, path)
 }
 
+type errorResponse interface {
+	errorMessage() string
+}
+
 type RankInput struct {
 	RankPropertyValue string `json:"property"`
 	Query             string `json:"query"`
@@ -101,3 +172,27 @@ type RankResponse struct {
 	Score             float64 `json:"score"`
 	Error             string  `json:"error"`
 }
+
+func (r *RankResponse) errorMessage() string {
+	return r.Error
+}
+
+type RankBatchInput struct {
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+}
+
+type DocumentScore struct {
+	Document string  `json:"document"`
+	Score    float64 `json:"score"`
+}
+
+type RankBatchResponse struct {
+	Query  string          `json:"query"`
+	Scores []DocumentScore `json:"scores"`
+	Error  string          `json:"error"`
+}
+
+func (r *RankBatchResponse) errorMessage() string {
+	return r.Error
+}
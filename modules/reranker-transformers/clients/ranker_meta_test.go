@@ -24,7 +24,7 @@ func TestGetMeta(t *testing.T) {
 	t.Run("when the server is providing meta", func(t *testing.T) {
 		server := httptest.NewServer(&testMetaHandler{t: t})
 		defer server.Close()
-		c := New(server.URL, nullLogger())
+		c := New(server.URL, DefaultMaxBatchSize, nullLogger())
 		meta, err := c.MetaInfo()
 
 		assert.Nil(t, err)
@@ -20,7 +20,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/weaviate/weaviate/modules/reranker-transformers/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
 )
 
 func TestGetScore(t *testing.T) {
@@ -34,14 +34,15 @@ func TestGetScore(t *testing.T) {
 			},
 		})
 		defer server.Close()
-		c := New(server.URL, nullLogger())
-		res, err := c.Rank(context.Background(), "prop", "I work at Apple")
+		c := New(server.URL, DefaultMaxBatchSize, nullLogger())
+		res, err := c.Rank(context.Background(), "Where do I work?", []string{"I work at Apple"}, nil)
 
 		assert.Nil(t, err)
 		assert.Equal(t, ent.RankResult{
-			Query:             "Where do I work?",
-			RankPropertyValue: "I work at Apple",
-			Score:             0.15,
+			Query: "Where do I work?",
+			DocumentScores: []ent.DocumentScore{
+				{Document: "I work at Apple", Score: 0.15},
+			},
 		}, *res)
 	})
 
@@ -53,13 +54,30 @@ func TestGetScore(t *testing.T) {
 			},
 		})
 		defer server.Close()
-		c := New(server.URL, nullLogger())
-		_, err := c.Rank(context.Background(), "prop",
-			"I work at Apple")
+		c := New(server.URL, DefaultMaxBatchSize, nullLogger())
+		_, err := c.Rank(context.Background(), "Where do I work?",
+			[]string{"I work at Apple"}, nil)
 
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "some error from the server")
 	})
+
+	t.Run("when documents are ranked in batches", func(t *testing.T) {
+		handler := &testBatchRankerHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New(server.URL, 2, nullLogger())
+		res, err := c.Rank(context.Background(), "Where do I work?",
+			[]string{"I work at Apple", "I like apples", "Apple"}, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, 2, handler.requests)
+		assert.Equal(t, []ent.DocumentScore{
+			{Document: "I work at Apple", Score: 15},
+			{Document: "I like apples", Score: 13},
+			{Document: "Apple", Score: 5},
+		}, res.DocumentScores)
+	})
 }
 
 type testCrossRankerHandler struct {
@@ -79,3 +97,25 @@ func (f *testCrossRankerHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	jsonBytes, _ := json.Marshal(f.res)
 	w.Write(jsonBytes)
 }
+
+// testBatchRankerHandler scores documents by their length
+type testBatchRankerHandler struct {
+	t        *testing.T
+	requests int
+}
+
+func (f *testBatchRankerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/rerank/batch", r.URL.String())
+	f.requests++
+
+	var input RankBatchInput
+	require.Nil(f.t, json.NewDecoder(r.Body).Decode(&input))
+	assert.LessOrEqual(f.t, len(input.Documents), 2)
+
+	res := RankBatchResponse{Query: input.Query}
+	for _, document := range input.Documents {
+		res.Scores = append(res.Scores, DocumentScore{Document: document, Score: float64(len(document))})
+	}
+	jsonBytes, _ := json.Marshal(res)
+	w.Write(jsonBytes)
+}
@@ -15,16 +15,16 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
-	rerankeradditional "github.com/weaviate/weaviate/modules/reranker-transformers/additional"
-	rerankeradditionalrank "github.com/weaviate/weaviate/modules/reranker-transformers/additional/rank"
 	client "github.com/weaviate/weaviate/modules/reranker-transformers/clients"
-	"github.com/weaviate/weaviate/modules/reranker-transformers/ent"
+	additionalprovider "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
 )
 
 const Name = "reranker-transformers"
@@ -39,7 +39,7 @@ type ReRankerModule struct {
 }
 
 type ReRankerClient interface {
-	Rank(ctx context.Context, property string, query string) (*ent.RankResult, error)
+	Rank(ctx context.Context, query string, documents []string, cfg moduletools.ClassConfig) (*ent.RankResult, error)
 	MetaInfo() (map[string]interface{}, error)
 }
 
@@ -69,15 +69,20 @@ func (m *ReRankerModule) initAdditional(ctx context.Context,
 		return errors.Errorf("required variable RERANKER_INFERENCE_API is not set")
 	}
 
-	client := client.New(uri, logger)
+	maxBatchSize, err := intFromEnv("RERANKER_INFERENCE_MAX_BATCH_SIZE",
+		client.DefaultMaxBatchSize)
+	if err != nil {
+		return err
+	}
+
+	client := client.New(uri, maxBatchSize, logger)
 
 	m.reranker = client
 	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
 		return errors.Wrap(err, "init remote sum module")
 	}
 
-	rerankerProvider := rerankeradditionalrank.New(m.reranker)
-	m.additionalPropertiesProvider = rerankeradditional.New(rerankerProvider)
+	m.additionalPropertiesProvider = additionalprovider.NewRankerProvider(m.reranker)
 	return nil
 }
 
@@ -94,6 +99,18 @@ func (m *ReRankerModule) AdditionalProperties() map[string]modulecapabilities.Ad
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
 
+func intFromEnv(name string, defaultValue int) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+	asInt, err := strconv.Atoi(v)
+	if err != nil || asInt < 0 {
+		return 0, errors.Errorf("%s must be a non-negative integer, got %q", name, v)
+	}
+	return asInt, nil
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
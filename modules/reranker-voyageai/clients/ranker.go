@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/reranker-voyageai/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
+)
+
+type client struct {
+	apiKey     string
+	host       string
+	path       string
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+func New(apiKey string, logger logrus.FieldLogger) *client {
+	return &client{
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+		host:   "https://api.voyageai.com",
+		path:   "/v1/rerank",
+		logger: logger,
+	}
+}
+
+func (v *client) Rank(ctx context.Context, query string, documents []string,
+	cfg moduletools.ClassConfig,
+) (*ent.RankResult, error) {
+	settings := config.NewClassSettings(cfg)
+	rankUrl, err := url.JoinPath(v.host, v.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "join Voyage AI API host and path")
+	}
+
+	input := RankInput{
+		Query:     query,
+		Documents: documents,
+		Model:     settings.Model(),
+	}
+	if topN := settings.TopN(); topN > 0 {
+		input.TopK = &topN
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", rankUrl, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+
+	apiKey, err := v.getApiKey(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Voyage AI API Key")
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	if res.StatusCode != 200 {
+		var apiError apiError
+		if err := json.Unmarshal(bodyBytes, &apiError); err == nil && apiError.Detail != "" {
+			return nil, errors.Errorf("connection to Voyage AI API failed with status %d: %s", res.StatusCode, apiError.Detail)
+		}
+		return nil, errors.Errorf("connection to Voyage AI API failed with status %d", res.StatusCode)
+	}
+
+	var resBody RankResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	// results are sorted by relevance and, with a topN, incomplete. They're
+	// put back in the order of the documents, documents without a result
+	// are scored 0
+	result := &ent.RankResult{
+		Query:          query,
+		DocumentScores: make([]ent.DocumentScore, len(documents)),
+	}
+	for i := range documents {
+		result.DocumentScores[i].Document = documents[i]
+	}
+	for _, r := range resBody.Data {
+		if r.Index < 0 || r.Index >= len(documents) {
+			return nil, errors.Errorf("Voyage AI API returned unknown document index %d", r.Index)
+		}
+		result.DocumentScores[r.Index].Score = r.RelevanceScore
+	}
+	return result, nil
+}
+
+func (v *client) getApiKey(ctx context.Context) (string, error) {
+	// a key passed with the request takes precedence over the one the
+	// server was started with
+	if apiKey := moduletools.GetValueFromContext(ctx, "X-Voyageai-Api-Key"); apiKey != "" {
+		return apiKey, nil
+	}
+	if len(v.apiKey) > 0 {
+		return v.apiKey, nil
+	}
+	return "", errors.New("no api key found " +
+		"neither in request header: X-VoyageAI-Api-Key " +
+		"nor in environment variable under VOYAGEAI_APIKEY")
+}
+
+type RankInput struct {
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+	Model     string   `json:"model"`
+	TopK      *int     `json:"top_k,omitempty"`
+}
+
+type Result struct {
+	Index          int     `json:"index"`
+	RelevanceScore float64 `json:"relevance_score"`
+}
+
+type RankResponse struct {
+	Data []Result `json:"data"`
+}
+
+type apiError struct {
+	Detail string `json:"detail"`
+}
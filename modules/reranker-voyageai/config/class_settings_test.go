@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       moduletools.ClassConfig
+		wantModel string
+		wantTopN  int
+		wantErr   error
+	}{
+		{
+			name: "default settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantModel: "rerank-lite-1",
+			wantTopN:  0,
+		},
+		{
+			name: "custom settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model": "rerank-2",
+					"topN":  float64(5),
+				},
+			},
+			wantModel: "rerank-2",
+			wantTopN:  5,
+		},
+		{
+			name: "unsupported model error",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model": "unknown-model",
+				},
+			},
+			wantErr: fmt.Errorf("wrong Voyage AI model name, available model names are: %v", availableModels),
+		},
+		{
+			name: "negative topN error",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"topN": float64(-1),
+				},
+			},
+			wantErr: fmt.Errorf("wrong topN, must be a non-negative integer"),
+		},
+		{
+			name: "non integer topN error",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"topN": "five",
+				},
+			},
+			wantErr: fmt.Errorf("wrong topN, must be a non-negative integer"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(nil), tt.wantErr.Error())
+			} else {
+				assert.Nil(t, ic.Validate(nil))
+				assert.Equal(t, tt.wantModel, ic.Model())
+				assert.Equal(t, tt.wantTopN, ic.TopN())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package summary
+
+import (
+	"context"
+	"strings"
+
+	"github.com/weaviate/weaviate/modules/sum-transformers/ent"
+)
+
+// maxSummaryRounds limits how often the merged summaries of the chunks of a
+// text are summarized again
+const maxSummaryRounds = 3
+
+// summarize summarizes texts longer than chunkSize words by splitting them
+// into overlapping chunks, summarizing each chunk and summarizing the merged
+// summaries again until they fit into a single chunk
+func (p *SummaryProvider) summarize(ctx context.Context, property, text string,
+	chunkSize, chunkOverlap int,
+) ([]ent.SummaryResult, error) {
+	chunks := splitChunks(text, chunkSize, chunkOverlap)
+	if len(chunks) <= 1 {
+		return p.sum.GetSummary(ctx, property, text)
+	}
+
+	for round := 0; ; round++ {
+		summaries := make([]string, 0, len(chunks))
+		for _, chunk := range chunks {
+			summary, err := p.sum.GetSummary(ctx, property, chunk)
+			if err != nil {
+				return nil, err
+			}
+			for i := range summary {
+				summaries = append(summaries, summary[i].Result)
+			}
+		}
+
+		merged := strings.Join(summaries, " ")
+		next := splitChunks(merged, chunkSize, chunkOverlap)
+		if len(next) <= 1 || len(next) >= len(chunks) || round+1 >= maxSummaryRounds {
+			return []ent.SummaryResult{{Property: property, Result: merged}}, nil
+		}
+		chunks = next
+	}
+}
+
+// splitChunks splits text into chunks of at most chunkSize words, each
+// chunk repeating the last chunkOverlap words of the previous one
+func splitChunks(text string, chunkSize, chunkOverlap int) []string {
+	words := strings.Fields(text)
+	if len(words) <= chunkSize {
+		return []string{text}
+	}
+
+	step := chunkSize - chunkOverlap
+	var chunks []string
+	for start := 0; start < len(words); start += step {
+		end := start + chunkSize
+		if end > len(words) {
+			end = len(words)
+		}
+		chunks = append(chunks, strings.Join(words[start:end], " "))
+		if end == len(words) {
+			break
+		}
+	}
+	return chunks
+}
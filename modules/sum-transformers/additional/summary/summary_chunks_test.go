@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package summary
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/modules/sum-transformers/ent"
+)
+
+func TestSplitChunks(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		chunkSize    int
+		chunkOverlap int
+		want         []string
+	}{
+		{
+			name:         "short text",
+			text:         "one two three",
+			chunkSize:    5,
+			chunkOverlap: 1,
+			want:         []string{"one two three"},
+		},
+		{
+			name:         "without overlap",
+			text:         "one two three four five",
+			chunkSize:    2,
+			chunkOverlap: 0,
+			want:         []string{"one two", "three four", "five"},
+		},
+		{
+			name:         "with overlap",
+			text:         "one two three four five",
+			chunkSize:    3,
+			chunkOverlap: 1,
+			want:         []string{"one two three", "three four five"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, splitChunks(tt.text, tt.chunkSize, tt.chunkOverlap))
+		})
+	}
+}
+
+func TestSummaryProviderChunks(t *testing.T) {
+	longText := strings.Repeat("word ", 25)
+
+	t.Run("should summarize chunks of long texts", func(t *testing.T) {
+		sumClient := &fakeSUMClient{}
+		summaryProvider := New(sumClient)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": longText,
+				},
+			},
+		}
+		chunkSize, chunkOverlap := 10, 2
+		params := &Params{Properties: []string{"content"}, ChunkSize: &chunkSize, ChunkOverlap: &chunkOverlap}
+
+		_, err := summaryProvider.AdditionalPropertyFn(context.Background(), in, params, nil, nil, nil)
+
+		require.Nil(t, err)
+		// 25 words are split into 3 chunks of 10 words starting every 8 words,
+		// their 12 words of summaries are split into 2 chunks again
+		assert.Len(t, sumClient.texts, 5)
+		summary, ok := in[0].AdditionalProperties["summary"].([]ent.SummaryResult)
+		require.True(t, ok)
+		require.Len(t, summary, 1)
+		assert.Equal(t, "content", summary[0].Property)
+		assert.Equal(t, "this is the summary this is the summary", summary[0].Result)
+	})
+
+	t.Run("should fail with invalid chunk params", func(t *testing.T) {
+		summaryProvider := New(&fakeSUMClient{})
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": longText,
+				},
+			},
+		}
+		chunkSize, chunkOverlap := 10, 10
+		params := &Params{Properties: []string{"content"}, ChunkSize: &chunkSize, ChunkOverlap: &chunkOverlap}
+
+		_, err := summaryProvider.AdditionalPropertyFn(context.Background(), in, params, nil, nil, nil)
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "chunkOverlap")
+	})
+}
@@ -25,6 +25,16 @@ func (p *SummaryProvider) additionalSummaryField(classname string) *graphql.Fiel
 				Type:         graphql.NewList(graphql.String),
 				DefaultValue: nil,
 			},
+			"chunkSize": &graphql.ArgumentConfig{
+				Description:  "Number of words of long texts which are summarized at once",
+				Type:         graphql.Int,
+				DefaultValue: nil,
+			},
+			"chunkOverlap": &graphql.ArgumentConfig{
+				Description:  "Number of words consecutive chunks of long texts share",
+				Type:         graphql.Int,
+				DefaultValue: nil,
+			},
 		},
 		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
 			Name: fmt.Sprintf("%sAdditionalSummary", classname),
@@ -11,10 +11,34 @@
 
 package summary
 
+const (
+	// DefaultChunkSize is the number of words of a text which is summarized
+	// at once, longer texts are split into chunks
+	DefaultChunkSize = 500
+	// DefaultChunkOverlap is the number of words consecutive chunks share
+	DefaultChunkOverlap = 50
+)
+
 type Params struct {
-	Properties []string
+	Properties   []string
+	ChunkSize    *int
+	ChunkOverlap *int
 }
 
 func (n Params) GetProperties() []string {
 	return n.Properties
 }
+
+func (n Params) GetChunkSize() int {
+	if n.ChunkSize != nil {
+		return *n.ChunkSize
+	}
+	return DefaultChunkSize
+}
+
+func (n Params) GetChunkOverlap() int {
+	if n.ChunkOverlap != nil {
+		return *n.ChunkOverlap
+	}
+	return DefaultChunkOverlap
+}
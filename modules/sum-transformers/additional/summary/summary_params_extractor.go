@@ -13,6 +13,7 @@ package summary
 
 import (
 	"log"
+	"strconv"
 
 	"github.com/tailor-inc/graphql/language/ast"
 )
@@ -30,6 +31,13 @@ func (p *SummaryProvider) parseSummaryArguments(args []*ast.Argument) *Params {
 				out.Properties[i] = value.(*ast.StringValue).Value
 			}
 
+		case "chunkSize":
+			asInt, _ := strconv.Atoi(arg.Value.GetValue().(string))
+			out.ChunkSize = &asInt
+		case "chunkOverlap":
+			asInt, _ := strconv.Atoi(arg.Value.GetValue().(string))
+			out.ChunkOverlap = &asInt
+
 		default:
 			// ignore what we don't recognize
 			log.Printf("Igonore not recognized value: %v", arg.Name.Value)
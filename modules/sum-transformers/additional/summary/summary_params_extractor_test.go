@@ -44,6 +44,21 @@ func Test_parseSummaryArguments(t *testing.T) {
 				Properties: []string{"prop1", "prop2"},
 			},
 		},
+		{
+			name: "Should create with chunk params",
+			args: args{
+				args: []*ast.Argument{
+					createListArg("properties", []string{"prop1"}),
+					createValueArg("chunkSize", &ast.IntValue{Kind: "IntValue", Value: "200"}),
+					createValueArg("chunkOverlap", &ast.IntValue{Kind: "IntValue", Value: "20"}),
+				},
+			},
+			want: &Params{
+				Properties:   []string{"prop1"},
+				ChunkSize:    intPtr(200),
+				ChunkOverlap: intPtr(20),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -57,6 +72,22 @@ func Test_parseSummaryArguments(t *testing.T) {
 	}
 }
 
+func createValueArg(name string, value ast.Value) *ast.Argument {
+	n := ast.Name{
+		Value: name,
+	}
+	arg := ast.Argument{
+		Name:  ast.NewName(&n),
+		Kind:  "Kind",
+		Value: value,
+	}
+	return ast.NewArgument(&arg)
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
 func createListArg(name string, valuesIn []string) *ast.Argument {
 	n := ast.Name{
 		Value: name,
@@ -37,6 +37,13 @@ func (p *SummaryProvider) findSummary(ctx context.Context,
 		if len(properties) == 0 {
 			return in, errors.New("no properties provided")
 		}
+		chunkSize, chunkOverlap := params.GetChunkSize(), params.GetChunkOverlap()
+		if chunkSize <= 0 {
+			return in, errors.New("chunkSize must be positive")
+		}
+		if chunkOverlap < 0 || chunkOverlap >= chunkSize {
+			return in, errors.New("chunkOverlap must not be negative and smaller than chunkSize")
+		}
 
 		for i := range in { // for each result of the general GraphQL Query
 			ap := in[i].AdditionalProperties
@@ -59,7 +66,7 @@ func (p *SummaryProvider) findSummary(ctx context.Context,
 
 			// for each text property result, call the SUM function and add to additional result
 			for property, value := range textProperties {
-				summary, err := p.sum.GetSummary(ctx, property, value)
+				summary, err := p.summarize(ctx, property, value, chunkSize, chunkOverlap)
 				if err != nil {
 					return in, err
 				}
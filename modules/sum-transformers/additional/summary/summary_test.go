@@ -100,10 +100,13 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 	})
 }
 
-type fakeSUMClient struct{}
+type fakeSUMClient struct {
+	texts []string
+}
 
 func (c *fakeSUMClient) GetSummary(ctx context.Context, property, text string,
 ) ([]ent.SummaryResult, error) {
+	c.texts = append(c.texts, text)
 	return c.getSummary(property), nil
 }
 
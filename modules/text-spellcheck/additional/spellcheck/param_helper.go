@@ -30,6 +30,12 @@ func (p *paramHelper) getTexts(argumentModuleParams map[string]interface{}) (str
 		texts, err := p.parseAsk(argumentModuleParams["ask"])
 		return "ask", texts, err
 	}
+	for _, name := range []string{"bm25", "hybrid"} {
+		if argumentModuleParams[name] != nil {
+			texts, err := p.parseQuery(argumentModuleParams[name])
+			return name, texts, err
+		}
+	}
 	return "", []string{}, nil
 }
 
@@ -67,6 +73,17 @@ func (p *paramHelper) parseNearText(arg interface{}) ([]string, error) {
 	return []string{}, nil
 }
 
+func (p *paramHelper) parseQuery(arg interface{}) ([]string, error) {
+	argument, err := p.toJsonParam(arg)
+	if err != nil {
+		return nil, err
+	}
+	if query, ok := argument["query"].(string); ok && query != "" {
+		return []string{query}, nil
+	}
+	return []string{}, nil
+}
+
 func (p *paramHelper) parseAsk(arg interface{}) ([]string, error) {
 	argument, err := p.toJsonParam(arg)
 	if err != nil {
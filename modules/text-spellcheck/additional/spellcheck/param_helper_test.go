@@ -14,6 +14,8 @@ package spellcheck
 import (
 	"reflect"
 	"testing"
+
+	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
 type fakeNearText struct {
@@ -57,6 +59,28 @@ func Test_paramHelper_getTexts(t *testing.T) {
 			want1:   []string{"a"},
 			wantErr: false,
 		},
+		{
+			name: "should get query from bm25",
+			args: args{
+				argumentModuleParams: map[string]interface{}{
+					"bm25": &searchparams.KeywordRanking{Query: "a", Autocorrect: true},
+				},
+			},
+			want:    "bm25",
+			want1:   []string{"a"},
+			wantErr: false,
+		},
+		{
+			name: "should get query from hybrid",
+			args: args{
+				argumentModuleParams: map[string]interface{}{
+					"hybrid": &searchparams.HybridSearch{Query: "a", Autocorrect: true},
+				},
+			},
+			want:    "hybrid",
+			want1:   []string{"a"},
+			wantErr: false,
+		},
 		{
 			name: "should be empty",
 			args: args{
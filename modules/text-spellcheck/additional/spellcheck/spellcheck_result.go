@@ -68,10 +68,14 @@ func (p *SpellCheckProvider) getSpellCheckAdditionalProperty(name string, spellC
 }
 
 func (p *SpellCheckProvider) getSpellCheckLocation(name string, i int) string {
-	if name == "nearText" {
+	switch name {
+	case "nearText":
 		return fmt.Sprintf("nearText.concepts[%v]", i)
+	case "bm25", "hybrid":
+		return fmt.Sprintf("%s.query", name)
+	default:
+		return "ask.question"
 	}
-	return "ask.question"
 }
 
 func (p *SpellCheckProvider) getSpellCheckAdditionalPropertyObject(originalText, location string, spellCheckResult *ent.SpellCheckResult) *spellcheckmodels.SpellCheck {
@@ -27,5 +27,7 @@ func (p *TextTransformerProvider) TextTransformers() map[string]modulecapabiliti
 	textTransformers := map[string]modulecapabilities.TextTransform{}
 	textTransformers["nearText"] = p.autocorrecProvider
 	textTransformers["ask"] = p.autocorrecProvider
+	textTransformers["bm25"] = p.autocorrecProvider
+	textTransformers["hybrid"] = p.autocorrecProvider
 	return textTransformers
 }
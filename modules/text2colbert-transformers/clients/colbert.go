@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/modules/text2colbert-transformers/ent"
+)
+
+const (
+	// inputTypeDocument and inputTypeQuery tell the inference container how to
+	// encode the text: ColBERT models mark queries and documents with different
+	// special tokens and pad queries to a fixed length
+	inputTypeDocument = "document"
+	inputTypeQuery    = "query"
+)
+
+type client struct {
+	origin     string
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+func New(origin string, logger logrus.FieldLogger) *client {
+	return &client{
+		origin:     origin,
+		httpClient: &http.Client{},
+		logger:     logger,
+	}
+}
+
+func (c *client) VectorizeObject(ctx context.Context, input string,
+) (*ent.VectorizationResult, error) {
+	return c.vectorize(ctx, input, inputTypeDocument)
+}
+
+func (c *client) VectorizeQuery(ctx context.Context, input string,
+) (*ent.VectorizationResult, error) {
+	return c.vectorize(ctx, input, inputTypeQuery)
+}
+
+func (c *client) vectorize(ctx context.Context, input string,
+	inputType string,
+) (*ent.VectorizationResult, error) {
+	body, err := json.Marshal(vecRequest{
+		Text: input,
+		Config: vecRequestConfig{
+			Type: inputType,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.url("/vectors"),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody vecResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode > 399 {
+		return nil, errors.Errorf("fail with status %d: %s", res.StatusCode,
+			resBody.Error)
+	}
+
+	if len(resBody.Vectors) == 0 {
+		return nil, errors.Errorf("no token vectors for text %q", input)
+	}
+
+	return &ent.VectorizationResult{
+		Text:       resBody.Text,
+		Dimensions: resBody.Dims,
+		Vectors:    resBody.Vectors,
+	}, nil
+}
+
+func (c *client) url(path string) string {
+	return fmt.Sprintf("%s%s", c.origin, path)
+}
+
+type vecRequest struct {
+	Text   string           `json:"text"`
+	Config vecRequestConfig `json:"config"`
+}
+
+type vecRequestConfig struct {
+	Type string `json:"type"`
+}
+
+type vecResponse struct {
+	Text    string      `json:"text"`
+	Dims    int         `json:"dims"`
+	Vectors [][]float32 `json:"vectors"`
+	Error   string      `json:"error"`
+}
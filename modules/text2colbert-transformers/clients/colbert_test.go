@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2colbert-transformers/ent"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("when vectorizing a document", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t, expectedType: "document"})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		expected := &ent.VectorizationResult{
+			Text:       "This is my text",
+			Dimensions: 2,
+			Vectors:    [][]float32{{0.1, 0.2}, {0.3, 0.4}},
+		}
+		res, err := c.VectorizeObject(context.Background(), "This is my text")
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("when vectorizing a query", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t, expectedType: "query"})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		res, err := c.VectorizeQuery(context.Background(), "This is my text")
+
+		require.Nil(t, err)
+		assert.Len(t, res.Vectors, 2)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t, expectedType: "document"})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.VectorizeObject(ctx, "This is my text")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: "nope, not gonna happen",
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		_, err := c.VectorizeObject(context.Background(), "This is my text")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "nope, not gonna happen")
+	})
+
+	t.Run("when the server returns no vectors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"text":"","dims":2,"vectors":[]}`))
+		}))
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		_, err := c.VectorizeObject(context.Background(), "")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no token vectors")
+	})
+}
+
+type fakeHandler struct {
+	t            *testing.T
+	expectedType string
+	serverError  string
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/vectors", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	if f.serverError != "" {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(vecResponse{Error: f.serverError})
+		return
+	}
+
+	var req vecRequest
+	require.Nil(f.t, json.NewDecoder(r.Body).Decode(&req))
+	assert.Equal(f.t, f.expectedType, req.Config.Type)
+
+	json.NewEncoder(w).Encode(vecResponse{
+		Text:    req.Text,
+		Dims:    2,
+		Vectors: [][]float32{{0.1, 0.2}, {0.3, 0.4}},
+	})
+}
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modcolbert
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/text2colbert-transformers/clients"
+	"github.com/weaviate/weaviate/modules/text2colbert-transformers/vectorizer"
+)
+
+const Name = "text2colbert-transformers"
+
+func New() *ColBERTModule {
+	return &ColBERTModule{}
+}
+
+// ColBERTModule vectorizes texts into one vector per token with a ColBERT
+// model served by a local inference container. The token vectors of an object
+// are concatenated into its vector, which requires the "multivector" vector
+// index type to score objects with late interaction (MaxSim).
+type ColBERTModule struct {
+	vectorizer          textVectorizer
+	metaProvider        metaProvider
+	graphqlProvider     modulecapabilities.GraphQLArguments
+	searcher            modulecapabilities.Searcher
+	nearTextTransformer modulecapabilities.TextTransform
+	logger              logrus.FieldLogger
+}
+
+type textVectorizer interface {
+	Object(ctx context.Context, obj *models.Object, objDiff *moduletools.ObjectDiff,
+		settings vectorizer.ClassSettings) error
+	Texts(ctx context.Context, input []string,
+		settings vectorizer.ClassSettings) ([]float32, error)
+}
+
+type metaProvider interface {
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *ColBERTModule) Name() string {
+	return Name
+}
+
+func (m *ColBERTModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2Vec
+}
+
+func (m *ColBERTModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	m.logger = params.GetLogger()
+
+	if err := m.initVectorizer(ctx, m.logger); err != nil {
+		return errors.Wrap(err, "init vectorizer")
+	}
+
+	return nil
+}
+
+func (m *ColBERTModule) InitExtension(modules []modulecapabilities.Module) error {
+	for _, module := range modules {
+		if module.Name() == m.Name() {
+			continue
+		}
+		if arg, ok := module.(modulecapabilities.TextTransformers); ok {
+			if arg != nil && arg.TextTransformers() != nil {
+				m.nearTextTransformer = arg.TextTransformers()["nearText"]
+			}
+		}
+	}
+
+	if err := m.initNearText(); err != nil {
+		return errors.Wrap(err, "init graphql provider")
+	}
+	return nil
+}
+
+func (m *ColBERTModule) initVectorizer(ctx context.Context,
+	logger logrus.FieldLogger,
+) error {
+	uri := os.Getenv("COLBERT_INFERENCE_API")
+	if uri == "" {
+		return errors.Errorf("required variable COLBERT_INFERENCE_API is not set")
+	}
+
+	client := clients.New(uri, logger)
+	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
+		return errors.Wrap(err, "init remote vectorizer")
+	}
+
+	m.vectorizer = vectorizer.New(client)
+	m.metaProvider = client
+
+	return nil
+}
+
+func (m *ColBERTModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *ColBERTModule) VectorizeObject(ctx context.Context,
+	obj *models.Object, objDiff *moduletools.ObjectDiff, cfg moduletools.ClassConfig,
+) error {
+	icheck := vectorizer.NewClassSettings(cfg)
+	return m.vectorizer.Object(ctx, obj, objDiff, icheck)
+}
+
+func (m *ColBERTModule) MetaInfo() (map[string]interface{}, error) {
+	return m.metaProvider.MetaInfo()
+}
+
+func (m *ColBERTModule) VectorizeInput(ctx context.Context,
+	input string, cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	return m.vectorizer.Texts(ctx, []string{input}, vectorizer.NewClassSettings(cfg))
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.Vectorizer(New())
+	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.InputVectorizer(New())
+)
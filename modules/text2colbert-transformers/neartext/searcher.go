@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package neartext
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	localvectorizer "github.com/weaviate/weaviate/modules/text2colbert-transformers/vectorizer"
+)
+
+type Searcher struct {
+	vectorizer vectorizer
+}
+
+func NewSearcher(vectorizer vectorizer) *Searcher {
+	return &Searcher{vectorizer}
+}
+
+type vectorizer interface {
+	Texts(ctx context.Context, input []string,
+		settings localvectorizer.ClassSettings) ([]float32, error)
+}
+
+func (s *Searcher) VectorSearches() map[string]modulecapabilities.VectorForParams {
+	vectorSearches := map[string]modulecapabilities.VectorForParams{}
+	vectorSearches["nearText"] = s.vectorForNearTextParam
+	return vectorSearches
+}
+
+func (s *Searcher) vectorForNearTextParam(ctx context.Context, params interface{},
+	className string,
+	findVectorFn modulecapabilities.FindVectorFn,
+	cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	return s.vectorFromNearTextParam(ctx, params.(*NearTextParams), className, findVectorFn, cfg)
+}
+
+func (s *Searcher) vectorFromNearTextParam(ctx context.Context,
+	params *NearTextParams,
+	className string, findVectorFn modulecapabilities.FindVectorFn,
+	cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	// moving a query means interpolating between two vectors of the same
+	// length, but the number of token vectors differs from text to text
+	if params.MoveTo.Force > 0 || params.MoveAwayFrom.Force > 0 {
+		return nil, errors.Errorf("moveTo and moveAwayFrom are not supported " +
+			"with token-level multi-vectors")
+	}
+
+	// it is safe to call NewClassSettings even knowing that cfg can be nil, it
+	// is to built to work with all defaults in the case of a nil-config, see
+	// vectorizer/class_settings_test.go for details.
+	settings := localvectorizer.NewClassSettings(cfg)
+	vector, err := s.vectorizer.Texts(ctx, params.Values, settings)
+	if err != nil {
+		return nil, errors.Errorf("vectorize keywords: %v", err)
+	}
+
+	return vector, nil
+}
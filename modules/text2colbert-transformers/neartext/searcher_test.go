@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package neartext
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/modules"
+)
+
+func TestSearcher(t *testing.T) {
+	cfg := modules.NewClassBasedModuleConfig(&models.Class{Class: "MyClass"},
+		"text2colbert-transformers", "")
+
+	t.Run("with values", func(t *testing.T) {
+		v := &fakeVectorizer{}
+		s := NewSearcher(v)
+
+		vector, err := s.vectorForNearTextParam(context.Background(),
+			&NearTextParams{Values: []string{"foo", "bar"}}, "MyClass", nil, cfg)
+
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 0, 0, 1}, vector)
+		assert.Equal(t, []string{"foo", "bar"}, v.lastInput)
+	})
+
+	t.Run("with moveTo", func(t *testing.T) {
+		s := NewSearcher(&fakeVectorizer{})
+
+		_, err := s.vectorForNearTextParam(context.Background(),
+			&NearTextParams{
+				Values: []string{"foo"},
+				MoveTo: ExploreMove{Values: []string{"bar"}, Force: 0.5},
+			}, "MyClass", nil, cfg)
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "moveTo and moveAwayFrom are not supported")
+	})
+}
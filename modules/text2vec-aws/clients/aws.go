@@ -0,0 +1,286 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/text2vec-aws/ent"
+)
+
+const (
+	serviceBedrock   = "bedrock"
+	serviceSagemaker = "sagemaker"
+)
+
+// Cohere models distinguish between the texts which are retrieved and the
+// texts used to retrieve them
+const (
+	cohereInputTypeDocument = "search_document"
+	cohereInputTypeQuery    = "search_query"
+)
+
+type titanEmbeddingsRequest struct {
+	InputText string `json:"inputText"`
+}
+
+type cohereEmbeddingsRequest struct {
+	Texts     []string `json:"texts"`
+	InputType string   `json:"input_type"`
+}
+
+type sagemakerEmbeddingsRequest struct {
+	Inputs []string `json:"inputs"`
+}
+
+// embeddingsResponse covers the responses of the Titan and Cohere models as
+// well as of the common SageMaker embedding containers
+type embeddingsResponse struct {
+	Embedding  []float32   `json:"embedding,omitempty"`
+	Embeddings [][]float32 `json:"embeddings,omitempty"`
+	Message    string      `json:"message,omitempty"`
+}
+
+func (r *embeddingsResponse) vector() []float32 {
+	if len(r.Embedding) > 0 {
+		return r.Embedding
+	}
+	if len(r.Embeddings) > 0 {
+		return r.Embeddings[0]
+	}
+	return nil
+}
+
+type vectorizer struct {
+	credentials *credentials.Credentials
+	retryPolicy moduletools.RetryPolicy
+	httpClient  *http.Client
+	originFn    func(service, region string) string
+	now         func() time.Time
+	logger      logrus.FieldLogger
+}
+
+func New(creds *credentials.Credentials, retryPolicy moduletools.RetryPolicy,
+	transport http.RoundTripper, logger logrus.FieldLogger,
+) *vectorizer {
+	return &vectorizer{
+		credentials: creds,
+		retryPolicy: retryPolicy,
+		httpClient:  &http.Client{Transport: transport},
+		originFn:    awsOrigin,
+		now:         time.Now,
+		logger:      logger,
+	}
+}
+
+func awsOrigin(service, region string) string {
+	if service == serviceSagemaker {
+		return fmt.Sprintf("https://runtime.sagemaker.%s.amazonaws.com", region)
+	}
+	return fmt.Sprintf("https://bedrock-runtime.%s.amazonaws.com", region)
+}
+
+func (v *vectorizer) Vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config, cohereInputTypeDocument)
+}
+
+func (v *vectorizer) VectorizeQuery(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config, cohereInputTypeQuery)
+}
+
+func (v *vectorizer) vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig, cohereInputType string,
+) (*ent.VectorizationResult, error) {
+	creds, err := v.getCredentials(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "AWS credentials")
+	}
+
+	body, err := json.Marshal(v.getEmbeddingsRequest(input, config, cohereInputType))
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	url := v.originFn(config.Service, config.Region) + v.getPath(config)
+
+	var resBody *embeddingsResponse
+	err = v.retryPolicy.Do(ctx, func() error {
+		resBody, err = v.sendRequest(ctx, url, body, creds, config)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vector := resBody.vector()
+	if len(vector) == 0 {
+		return nil, errors.Errorf("empty embeddings response")
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input,
+		Dimensions: len(vector),
+		Vector:     vector,
+	}, nil
+}
+
+func (v *vectorizer) getEmbeddingsRequest(input []string, config ent.VectorizationConfig,
+	cohereInputType string,
+) interface{} {
+	if config.Service == serviceSagemaker {
+		return sagemakerEmbeddingsRequest{Inputs: input}
+	}
+	if strings.HasPrefix(config.Model, "cohere.") {
+		return cohereEmbeddingsRequest{Texts: input, InputType: cohereInputType}
+	}
+	// Titan models embed a single text per request
+	return titanEmbeddingsRequest{InputText: strings.Join(input, " ")}
+}
+
+// getPath returns the escaped path of the invocation, model ids like
+// amazon.titan-embed-text-v2:0 contain characters which need escaping
+func (v *vectorizer) getPath(config ent.VectorizationConfig) string {
+	if config.Service == serviceSagemaker {
+		return uriEncodePath("/endpoints/" + config.Endpoint + "/invocations")
+	}
+	return uriEncodePath("/model/" + config.Model + "/invoke")
+}
+
+func (v *vectorizer) sendRequest(ctx context.Context, url string, body []byte,
+	creds awsCredentials, config ent.VectorizationConfig,
+) (*embeddingsResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+	// every attempt is signed anew, as signatures expire
+	signV4(req, body, creds, config.Region, config.Service, v.now())
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		err = errors.Wrap(err, "send POST request")
+		if ctx.Err() == nil {
+			return nil, moduletools.NewRetryableError(err, 0)
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	resBody, err := parseEmbeddingsResponse(bodyBytes)
+	if err != nil {
+		err = errors.Wrap(err, "unmarshal response body")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(err, moduletools.RetryAfter(res.Header))
+		}
+		return nil, err
+	}
+
+	if res.StatusCode >= 500 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "connection to AWS failed with status: %d error: %v")
+		return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+	} else if res.StatusCode > 200 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "failed with status: %d error: %v")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+		}
+		return nil, errors.Errorf(errorMessage)
+	}
+
+	return resBody, nil
+}
+
+// parseEmbeddingsResponse also accepts the plain list of embeddings returned
+// by many SageMaker embedding containers
+func parseEmbeddingsResponse(bodyBytes []byte) (*embeddingsResponse, error) {
+	trimmed := bytes.TrimSpace(bodyBytes)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var embeddings [][]float32
+		if err := json.Unmarshal(trimmed, &embeddings); err != nil {
+			return nil, err
+		}
+		return &embeddingsResponse{Embeddings: embeddings}, nil
+	}
+
+	var resBody embeddingsResponse
+	if err := json.Unmarshal(trimmed, &resBody); err != nil {
+		return nil, err
+	}
+	return &resBody, nil
+}
+
+func getErrorMessage(statusCode int, resBodyError string, errorTemplate string) string {
+	if resBodyError != "" {
+		return fmt.Sprintf(errorTemplate, statusCode, resBodyError)
+	}
+	return fmt.Sprintf(errorTemplate, statusCode)
+}
+
+// getCredentials prefers the credentials passed in the request headers over
+// the ones of the AWS credential chain
+func (v *vectorizer) getCredentials(ctx context.Context) (awsCredentials, error) {
+	accessKey := v.getHeaderValue(ctx, "X-Aws-Access-Key")
+	secretKey := v.getHeaderValue(ctx, "X-Aws-Secret-Key")
+	if accessKey != "" && secretKey != "" {
+		return awsCredentials{
+			accessKeyID:     accessKey,
+			secretAccessKey: secretKey,
+			sessionToken:    v.getHeaderValue(ctx, "X-Aws-Session-Token"),
+		}, nil
+	}
+
+	if v.credentials != nil {
+		value, err := v.credentials.Get()
+		if err == nil && value.AccessKeyID != "" && value.SecretAccessKey != "" {
+			return awsCredentials{
+				accessKeyID:     value.AccessKeyID,
+				secretAccessKey: value.SecretAccessKey,
+				sessionToken:    value.SessionToken,
+			}, nil
+		}
+	}
+
+	return awsCredentials{}, errors.New("no AWS credentials found " +
+		"neither in request headers: X-Aws-Access-Key and X-Aws-Secret-Key " +
+		"nor in the AWS credential chain: environment variables, " +
+		"shared credentials file or IAM role")
+}
+
+func (v *vectorizer) getHeaderValue(ctx context.Context, header string) string {
+	value := ctx.Value(header)
+	if headerValue, ok := value.([]string); ok &&
+		len(headerValue) > 0 && len(headerValue[0]) > 0 {
+		return headerValue[0]
+	}
+	return ""
+}
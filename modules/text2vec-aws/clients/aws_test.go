@@ -0,0 +1,221 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2vec-aws/ent"
+)
+
+func TestClient(t *testing.T) {
+	newClient := func(serverURL string) *vectorizer {
+		return &vectorizer{
+			httpClient: &http.Client{},
+			originFn: func(service, region string) string {
+				return serverURL
+			},
+			now:    time.Now,
+			logger: nullLogger(),
+		}
+	}
+	ctxWithCredentials := func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, "X-Aws-Access-Key", []string{"access-key"})
+		return context.WithValue(ctx, "X-Aws-Secret-Key", []string{"secret-key"})
+	}
+
+	t.Run("when a Titan model is used", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+
+		res, err := c.Vectorize(ctxWithCredentials(context.Background()), []string{"This is my text"},
+			ent.VectorizationConfig{
+				Service: "bedrock",
+				Region:  "us-east-1",
+				Model:   "amazon.titan-embed-text-v2:0",
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, &ent.VectorizationResult{
+			Text:       []string{"This is my text"},
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Dimensions: 3,
+		}, res)
+		assert.Equal(t, "/model/amazon.titan-embed-text-v2:0/invoke", handler.lastPath)
+		assert.Equal(t, "This is my text", handler.lastBody["inputText"])
+		assert.True(t, strings.HasPrefix(handler.lastAuthorization,
+			"AWS4-HMAC-SHA256 Credential=access-key/"))
+		assert.Contains(t, handler.lastAuthorization, "/us-east-1/bedrock/aws4_request")
+	})
+
+	t.Run("when a Cohere model is queried", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+
+		res, err := c.VectorizeQuery(ctxWithCredentials(context.Background()), []string{"What is my text?"},
+			ent.VectorizationConfig{
+				Service: "bedrock",
+				Region:  "us-east-1",
+				Model:   "cohere.embed-english-v3",
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3}, res.Vector)
+		assert.Equal(t, "search_query", handler.lastBody["input_type"])
+		assert.Equal(t, []interface{}{"What is my text?"}, handler.lastBody["texts"])
+	})
+
+	t.Run("when a SageMaker endpoint is used", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := newClient(server.URL)
+
+		res, err := c.Vectorize(ctxWithCredentials(context.Background()), []string{"This is my text"},
+			ent.VectorizationConfig{
+				Service:  "sagemaker",
+				Region:   "eu-west-1",
+				Endpoint: "my-endpoint",
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3}, res.Vector)
+		assert.Equal(t, "/endpoints/my-endpoint/invocations", handler.lastPath)
+		assert.Equal(t, []interface{}{"This is my text"}, handler.lastBody["inputs"])
+		assert.Contains(t, handler.lastAuthorization, "/eu-west-1/sagemaker/aws4_request")
+	})
+
+	t.Run("when the server has a problem", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: errors.Errorf("nope, not gonna happen"),
+		})
+		defer server.Close()
+		c := newClient(server.URL)
+
+		_, err := c.Vectorize(ctxWithCredentials(context.Background()), []string{"This is my text"},
+			ent.VectorizationConfig{
+				Service: "bedrock",
+				Region:  "us-east-1",
+				Model:   "amazon.titan-embed-text-v1",
+			})
+
+		require.NotNil(t, err)
+		assert.Equal(t, "connection to AWS failed with status: 500 error: nope, not gonna happen", err.Error())
+	})
+
+	t.Run("when no credentials are found", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := newClient(server.URL)
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{
+				Service: "bedrock",
+				Region:  "us-east-1",
+				Model:   "amazon.titan-embed-text-v1",
+			})
+
+		require.NotNil(t, err)
+		assert.Equal(t, "AWS credentials: no AWS credentials found "+
+			"neither in request headers: X-Aws-Access-Key and X-Aws-Secret-Key "+
+			"nor in the AWS credential chain: environment variables, "+
+			"shared credentials file or IAM role", err.Error())
+	})
+}
+
+func TestParseEmbeddingsResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "Titan response", body: `{"embedding":[0.1,0.2],"inputTextTokenCount":3}`},
+		{name: "Cohere response", body: `{"embeddings":[[0.1,0.2]],"id":"some-id"}`},
+		{name: "list of embeddings", body: ` [[0.1,0.2]]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := parseEmbeddingsResponse([]byte(tt.body))
+			require.Nil(t, err)
+			assert.Equal(t, []float32{0.1, 0.2}, res.vector())
+		})
+	}
+}
+
+type fakeHandler struct {
+	t                 *testing.T
+	serverError       error
+	lastPath          string
+	lastAuthorization string
+	lastBody          map[string]interface{}
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastPath = r.URL.Path
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	if f.serverError != nil {
+		outBytes, err := json.Marshal(map[string]interface{}{
+			"message": f.serverError.Error(),
+		})
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastBody))
+
+	var embeddingResponse interface{}
+	switch {
+	case f.lastBody["inputText"] != nil:
+		embeddingResponse = map[string]interface{}{
+			"embedding": []float32{0.1, 0.2, 0.3},
+		}
+	case f.lastBody["texts"] != nil:
+		embeddingResponse = map[string]interface{}{
+			"embeddings": [][]float32{{0.1, 0.2, 0.3}},
+		}
+	default:
+		embeddingResponse = [][]float32{{0.1, 0.2, 0.3}}
+	}
+	outBytes, err := json.Marshal(embeddingResponse)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignV4(t *testing.T) {
+	creds := awsCredentials{
+		accessKeyID:     "AKIDEXAMPLE",
+		secretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	}
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+
+	t.Run("matches the get-vanilla case of the AWS test suite", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+		require.Nil(t, err)
+
+		signV4(req, nil, creds, "us-east-1", "service", now)
+
+		assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+		assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, "+
+			"SignedHeaders=host;x-amz-date, "+
+			"Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+			req.Header.Get("Authorization"))
+	})
+
+	t.Run("signs the session token", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+		require.Nil(t, err)
+
+		withToken := creds
+		withToken.sessionToken = "some-token"
+		signV4(req, nil, withToken, "us-east-1", "service", now)
+
+		assert.Equal(t, "some-token", req.Header.Get("X-Amz-Security-Token"))
+		assert.Contains(t, req.Header.Get("Authorization"),
+			"SignedHeaders=host;x-amz-date;x-amz-security-token")
+	})
+
+	t.Run("encodes the path segments twice", func(t *testing.T) {
+		req, err := http.NewRequest("POST",
+			"https://bedrock-runtime.us-east-1.amazonaws.com/model/amazon.titan-embed-text-v2%3A0/invoke", nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, "/model/amazon.titan-embed-text-v2%253A0/invoke", canonicalURI(req.URL))
+	})
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modaws
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/modules/text2vec-aws/vectorizer"
+)
+
+func (m *AwsModule) ClassConfigDefaults() map[string]interface{} {
+	return map[string]interface{}{
+		"vectorizeClassName": vectorizer.DefaultVectorizeClassName,
+	}
+}
+
+func (m *AwsModule) PropertyConfigDefaults(
+	dt *schema.DataType,
+) map[string]interface{} {
+	return map[string]interface{}{
+		"skip":                  !vectorizer.DefaultPropertyIndexed,
+		"vectorizePropertyName": vectorizer.DefaultVectorizePropertyName,
+	}
+}
+
+func (m *AwsModule) ValidateClass(ctx context.Context,
+	class *models.Class, cfg moduletools.ClassConfig,
+) error {
+	settings := vectorizer.NewClassSettings(cfg)
+	return settings.Validate(class)
+}
+
+var _ = modulecapabilities.ClassConfigurator(New())
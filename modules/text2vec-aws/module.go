@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modaws
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/text2vec-aws/additional"
+	"github.com/weaviate/weaviate/modules/text2vec-aws/additional/projector"
+	"github.com/weaviate/weaviate/modules/text2vec-aws/clients"
+	"github.com/weaviate/weaviate/modules/text2vec-aws/vectorizer"
+)
+
+const Name = "text2vec-aws"
+
+func New() *AwsModule {
+	return &AwsModule{}
+}
+
+type AwsModule struct {
+	vectorizer                   textVectorizer
+	metaProvider                 metaProvider
+	graphqlProvider              modulecapabilities.GraphQLArguments
+	searcher                     modulecapabilities.Searcher
+	nearTextTransformer          modulecapabilities.TextTransform
+	logger                       logrus.FieldLogger
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+}
+
+type textVectorizer interface {
+	Object(ctx context.Context, obj *models.Object, objDiff *moduletools.ObjectDiff,
+		settings vectorizer.ClassSettings) error
+	Texts(ctx context.Context, input []string,
+		settings vectorizer.ClassSettings) ([]float32, error)
+
+	MoveTo(source, target []float32, weight float32) ([]float32, error)
+	MoveAwayFrom(source, target []float32, weight float32) ([]float32, error)
+	CombineVectors([][]float32) []float32
+}
+
+type metaProvider interface {
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *AwsModule) Name() string {
+	return Name
+}
+
+func (m *AwsModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2MultiVec
+}
+
+func (m *AwsModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	m.logger = params.GetLogger()
+
+	if err := m.initVectorizer(ctx, m.logger); err != nil {
+		return errors.Wrap(err, "init vectorizer")
+	}
+
+	if err := m.initAdditionalPropertiesProvider(); err != nil {
+		return errors.Wrap(err, "init additional properties provider")
+	}
+
+	return nil
+}
+
+func (m *AwsModule) InitExtension(modules []modulecapabilities.Module) error {
+	for _, module := range modules {
+		if module.Name() == m.Name() {
+			continue
+		}
+		if arg, ok := module.(modulecapabilities.TextTransformers); ok {
+			if arg != nil && arg.TextTransformers() != nil {
+				m.nearTextTransformer = arg.TextTransformers()["nearText"]
+			}
+		}
+	}
+
+	if err := m.initNearText(); err != nil {
+		return errors.Wrap(err, "init graphql provider")
+	}
+	return nil
+}
+
+func (m *AwsModule) initVectorizer(ctx context.Context,
+	logger logrus.FieldLogger,
+) error {
+	// the standard AWS credential chain, credentials passed in the request
+	// headers take precedence
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.FileAWSCredentials{},
+		&credentials.IAM{Client: &http.Client{Transport: http.DefaultTransport}},
+	})
+	retryPolicy, err := moduletools.RetryPolicyFromEnv("AWS")
+	if err != nil {
+		return err
+	}
+	httpOptions, err := moduletools.HTTPClientOptionsFromEnv("AWS")
+	if err != nil {
+		return err
+	}
+	transport, err := moduletools.NewHTTPTransport(httpOptions)
+	if err != nil {
+		return err
+	}
+	client := clients.New(creds, retryPolicy, transport, logger)
+
+	m.vectorizer = vectorizer.New(client)
+	m.metaProvider = client
+
+	return nil
+}
+
+func (m *AwsModule) initAdditionalPropertiesProvider() error {
+	projector := projector.New()
+	m.additionalPropertiesProvider = additional.New(projector)
+	return nil
+}
+
+func (m *AwsModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *AwsModule) VectorizeObject(ctx context.Context,
+	obj *models.Object, objDiff *moduletools.ObjectDiff, cfg moduletools.ClassConfig,
+) error {
+	icheck := vectorizer.NewClassSettings(cfg)
+	return m.vectorizer.Object(ctx, obj, objDiff, icheck)
+}
+
+func (m *AwsModule) MetaInfo() (map[string]interface{}, error) {
+	return m.metaProvider.MetaInfo()
+}
+
+func (m *AwsModule) VectorizeInput(ctx context.Context,
+	input string, cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	return m.vectorizer.Texts(ctx, []string{input}, vectorizer.NewClassSettings(cfg))
+}
+
+func (m *AwsModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.Vectorizer(New())
+	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.Searcher(New())
+	_ = modulecapabilities.GraphQLArguments(New())
+	_ = modulecapabilities.InputVectorizer(New())
+)
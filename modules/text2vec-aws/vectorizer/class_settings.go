@@ -0,0 +1,247 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	ServiceBedrock   = "bedrock"
+	ServiceSagemaker = "sagemaker"
+)
+
+const (
+	DefaultService               = ServiceBedrock
+	DefaultBedrockModel          = "amazon.titan-embed-text-v1"
+	DefaultVectorizeClassName    = true
+	DefaultPropertyIndexed       = true
+	DefaultVectorizePropertyName = false
+)
+
+var (
+	availableServices      = []string{ServiceBedrock, ServiceSagemaker}
+	availableBedrockModels = []string{
+		"amazon.titan-embed-text-v1", "amazon.titan-embed-text-v2:0",
+		"cohere.embed-english-v3", "cohere.embed-multilingual-v3",
+	}
+)
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (cs *classSettings) PropertyIndexed(propName string) bool {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultPropertyIndexed
+	}
+
+	vcn, ok := cs.cfg.Property(propName)["skip"]
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	return !asBool
+}
+
+func (cs *classSettings) VectorizePropertyName(propName string) bool {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizePropertyName
+	}
+	vcn, ok := cs.cfg.Property(propName)["vectorizePropertyName"]
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	return asBool
+}
+
+func (cs *classSettings) VectorizeClassName() bool {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizeClassName
+	}
+
+	vcn, ok := cs.cfg.Class()["vectorizeClassName"]
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	return asBool
+}
+
+// Service is either Amazon Bedrock, which serves the embedding models of
+// several providers, or a SageMaker endpoint deployed by the user
+func (cs *classSettings) Service() string {
+	return cs.getProperty("service", DefaultService)
+}
+
+func (cs *classSettings) Region() string {
+	return cs.getProperty("region", "")
+}
+
+// Model is the Bedrock model id, it isn't used with SageMaker whose
+// endpoints serve a single model
+func (cs *classSettings) Model() string {
+	if cs.Service() != ServiceBedrock {
+		return cs.getProperty("model", "")
+	}
+	return cs.getProperty("model", DefaultBedrockModel)
+}
+
+// Endpoint is the name of the SageMaker endpoint
+func (cs *classSettings) Endpoint() string {
+	return cs.getRawProperty("endpoint", "")
+}
+
+func (cs *classSettings) Validate(class *models.Class) error {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	var errorMessages []string
+
+	service := cs.Service()
+	if !cs.validateAwsSetting(service, availableServices) {
+		errorMessages = append(errorMessages,
+			fmt.Sprintf("wrong service, available services are: %v", availableServices))
+	}
+	if cs.Region() == "" {
+		errorMessages = append(errorMessages, "region cannot be empty")
+	}
+
+	switch service {
+	case ServiceBedrock:
+		if !cs.validateAwsSetting(cs.Model(), availableBedrockModels) {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf("wrong Bedrock model name, available model names are: %v", availableBedrockModels))
+		}
+		if cs.Endpoint() != "" {
+			errorMessages = append(errorMessages, "endpoint setting can only be used with the sagemaker service")
+		}
+	case ServiceSagemaker:
+		if cs.Endpoint() == "" {
+			errorMessages = append(errorMessages, "endpoint cannot be empty with the sagemaker service")
+		}
+		if cs.Model() != "" {
+			errorMessages = append(errorMessages, "model setting can only be used with the bedrock service")
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return errors.New(strings.Join(errorMessages, ", "))
+	}
+
+	err := cs.validateIndexState(class, cs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cs *classSettings) validateAwsSetting(value string, availableValues []string) bool {
+	for i := range availableValues {
+		if value == availableValues[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func (cs *classSettings) getProperty(name, defaultValue string) string {
+	return strings.ToLower(cs.getRawProperty(name, defaultValue))
+}
+
+// getRawProperty keeps the case of the setting, which matters for names
+// chosen by the user, such as the names of SageMaker endpoints
+func (cs *classSettings) getRawProperty(name, defaultValue string) string {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	model, ok := cs.cfg.Class()[name]
+	if ok {
+		asString, ok := model.(string)
+		if ok {
+			return strings.TrimSpace(asString)
+		}
+	}
+
+	return defaultValue
+}
+
+func (cs *classSettings) validateIndexState(class *models.Class, settings ClassSettings) error {
+	if settings.VectorizeClassName() {
+		// if the user chooses to vectorize the classname, vector-building will
+		// always be possible, no need to investigate further
+
+		return nil
+	}
+
+	// search if there is at least one indexed, string/text prop. If found pass
+	// validation
+	for _, prop := range class.Properties {
+		if len(prop.DataType) < 1 {
+			return errors.Errorf("property %s must have at least one datatype: "+
+				"got %v", prop.Name, prop.DataType)
+		}
+
+		if prop.DataType[0] != string(schema.DataTypeText) {
+			// we can only vectorize text-like props
+			continue
+		}
+
+		if settings.PropertyIndexed(prop.Name) {
+			// found at least one, this is a valid schema
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid properties: didn't find a single property which is " +
+		"of type string or text and is not excluded from indexing. In addition the " +
+		"class name is excluded from vectorization as well, meaning that it cannot be " +
+		"used to determine the vector position. To fix this, set 'vectorizeClassName' " +
+		"to true if the class name is contextionary-valid. Alternatively add at least " +
+		"contextionary-valid text/string property which is not excluded from " +
+		"indexing")
+}
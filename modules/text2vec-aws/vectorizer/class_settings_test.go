@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "Test",
+		Properties: []*models.Property{
+			{
+				DataType: []string{"text"},
+				Name:     "test",
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		cfg          fakeClassConfig
+		wantService  string
+		wantRegion   string
+		wantModel    string
+		wantEndpoint string
+		wantErr      string
+	}{
+		{
+			name: "bedrock with the default model",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"region": "us-east-1",
+			}},
+			wantService: "bedrock",
+			wantRegion:  "us-east-1",
+			wantModel:   "amazon.titan-embed-text-v1",
+		},
+		{
+			name: "bedrock with a Cohere model",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"service": "bedrock",
+				"region":  "eu-central-1",
+				"model":   "cohere.embed-multilingual-v3",
+			}},
+			wantService: "bedrock",
+			wantRegion:  "eu-central-1",
+			wantModel:   "cohere.embed-multilingual-v3",
+		},
+		{
+			name: "sagemaker endpoint",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"service":  "sagemaker",
+				"region":   "us-west-2",
+				"endpoint": "My-Embeddings-Endpoint",
+			}},
+			wantService:  "sagemaker",
+			wantRegion:   "us-west-2",
+			wantEndpoint: "My-Embeddings-Endpoint",
+		},
+		{
+			name: "wrong service and missing region",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"service": "lambda",
+			}},
+			wantErr: "wrong service, available services are: [bedrock sagemaker], " +
+				"region cannot be empty",
+		},
+		{
+			name: "wrong bedrock model",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"region": "us-east-1",
+				"model":  "amazon.titan-text-express-v1",
+			}},
+			wantErr: "wrong Bedrock model name, available model names are: " +
+				"[amazon.titan-embed-text-v1 amazon.titan-embed-text-v2:0 " +
+				"cohere.embed-english-v3 cohere.embed-multilingual-v3]",
+		},
+		{
+			name: "sagemaker without endpoint but with model",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"service": "sagemaker",
+				"region":  "us-east-1",
+				"model":   "amazon.titan-embed-text-v1",
+			}},
+			wantErr: "endpoint cannot be empty with the sagemaker service, " +
+				"model setting can only be used with the bedrock service",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := NewClassSettings(tt.cfg)
+			err := cs.Validate(class)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantService, cs.Service())
+			assert.Equal(t, tt.wantRegion, cs.Region())
+			assert.Equal(t, tt.wantModel, cs.Model())
+			assert.Equal(t, tt.wantEndpoint, cs.Endpoint())
+		})
+	}
+}
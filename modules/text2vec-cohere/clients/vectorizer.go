@@ -18,63 +18,167 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-cohere/ent"
 )
 
+// the embed v3 models require the input type, which tells them whether the
+// texts are going to be retrieved or used to retrieve
+const (
+	inputTypeSearchDocument = "search_document"
+	inputTypeSearchQuery    = "search_query"
+)
+
+const (
+	embeddingTypeFloat  = "float"
+	embeddingTypeInt8   = "int8"
+	embeddingTypeBinary = "binary"
+)
+
 type embeddingsRequest struct {
-	Input    []string `json:"texts"`
-	Model    string   `json:"model,omitempty"`
-	Truncate string   `json:"truncate,omitempty"`
+	Input          []string `json:"texts"`
+	Model          string   `json:"model,omitempty"`
+	Truncate       string   `json:"truncate,omitempty"`
+	InputType      string   `json:"input_type,omitempty"`
+	EmbeddingTypes []string `json:"embedding_types,omitempty"`
 }
 
 type embeddingsResponse struct {
-	Embeddings [][]float32 `json:"embeddings,omitempty"`
-	Message    string      `json:"message,omitempty"`
+	// a list of float embeddings, or an object holding the embeddings by
+	// type if embedding types were requested
+	Embeddings json.RawMessage `json:"embeddings,omitempty"`
+	Message    string          `json:"message,omitempty"`
 }
 
 type vectorizer struct {
-	apiKey     string
-	httpClient *http.Client
-	urlBuilder *cohereUrlBuilder
-	logger     logrus.FieldLogger
+	apiKey      string
+	retryPolicy moduletools.RetryPolicy
+	httpClient  *http.Client
+	urlBuilder  *cohereUrlBuilder
+	logger      logrus.FieldLogger
 }
 
-func New(apiKey string, logger logrus.FieldLogger) *vectorizer {
+func New(apiKey string, retryPolicy moduletools.RetryPolicy,
+	transport http.RoundTripper, logger logrus.FieldLogger,
+) *vectorizer {
 	return &vectorizer{
-		apiKey:     apiKey,
-		httpClient: &http.Client{},
-		urlBuilder: newCohereUrlBuilder(),
-		logger:     logger,
+		apiKey:      apiKey,
+		retryPolicy: retryPolicy,
+		httpClient:  &http.Client{Transport: transport},
+		urlBuilder:  newCohereUrlBuilder(),
+		logger:      logger,
 	}
 }
 
 func (v *vectorizer) Vectorize(ctx context.Context, input []string,
 	config ent.VectorizationConfig,
 ) (*ent.VectorizationResult, error) {
-	return v.vectorize(ctx, input, v.url(), v.getModel(config), v.getTruncate(config))
+	return v.vectorize(ctx, input, v.url(), inputTypeSearchDocument, config)
 }
 
 func (v *vectorizer) VectorizeQuery(ctx context.Context, input []string,
 	config ent.VectorizationConfig,
 ) (*ent.VectorizationResult, error) {
-	return v.vectorize(ctx, input, v.url(), v.getModel(config), v.getTruncate(config))
+	return v.vectorize(ctx, input, v.url(), inputTypeSearchQuery, config)
 }
 
 func (v *vectorizer) vectorize(ctx context.Context, input []string,
-	url string, model string, truncate string,
+	url string, inputType string, config ent.VectorizationConfig,
 ) (*ent.VectorizationResult, error) {
-	body, err := json.Marshal(embeddingsRequest{
+	model := v.getModel(config)
+	embeddingType := v.getEmbeddingType(config)
+	request := embeddingsRequest{
 		Input:    input,
 		Model:    model,
-		Truncate: truncate,
-	})
+		Truncate: v.getTruncate(config),
+	}
+	if isEmbedV3Model(model) {
+		request.InputType = inputType
+		if embeddingType != embeddingTypeFloat {
+			request.EmbeddingTypes = []string{embeddingType}
+		}
+	}
+	body, err := json.Marshal(request)
 	if err != nil {
 		return nil, errors.Wrapf(err, "marshal body")
 	}
 
+	var resBody *embeddingsResponse
+	err = v.retryPolicy.Do(ctx, func() error {
+		resBody, err = v.sendRequest(ctx, url, body)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	embeddings, err := parseEmbeddings(resBody.Embeddings, embeddingType)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse embeddings")
+	}
+	if len(embeddings) == 0 {
+		return nil, errors.Errorf("empty embeddings response")
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input,
+		Dimensions: len(embeddings[0]),
+		Vector:     embeddings[0],
+	}, nil
+}
+
+// parseEmbeddings returns the embeddings as float vectors. Binary embeddings
+// hold 8 dimensions per value, they are unpacked into one dimension per bit
+// with the values 0 and 1.
+func parseEmbeddings(raw json.RawMessage, embeddingType string) ([][]float32, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return nil, nil
+	}
+	if trimmed[0] == '[' {
+		var embeddings [][]float32
+		if err := json.Unmarshal(trimmed, &embeddings); err != nil {
+			return nil, err
+		}
+		return embeddings, nil
+	}
+
+	var byType map[string][][]float32
+	if err := json.Unmarshal(trimmed, &byType); err != nil {
+		return nil, err
+	}
+	embeddings, ok := byType[embeddingType]
+	if !ok {
+		return nil, errors.Errorf("response doesn't contain %s embeddings", embeddingType)
+	}
+	if embeddingType != embeddingTypeBinary {
+		return embeddings, nil
+	}
+
+	unpacked := make([][]float32, len(embeddings))
+	for i, packed := range embeddings {
+		unpacked[i] = make([]float32, 0, len(packed)*8)
+		for _, value := range packed {
+			bits := uint8(int8(value))
+			for bit := 7; bit >= 0; bit-- {
+				unpacked[i] = append(unpacked[i], float32((bits>>bit)&1))
+			}
+		}
+	}
+	return unpacked, nil
+}
+
+func isEmbedV3Model(model string) bool {
+	return strings.HasPrefix(model, "embed-") && strings.HasSuffix(model, "-v3.0")
+}
+
+func (v *vectorizer) sendRequest(ctx context.Context, url string,
+	body []byte,
+) (*embeddingsResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, "POST", url,
 		bytes.NewReader(body))
 	if err != nil {
@@ -89,7 +193,11 @@ func (v *vectorizer) vectorize(ctx context.Context, input []string,
 
 	res, err := v.httpClient.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "send POST request")
+		err = errors.Wrap(err, "send POST request")
+		if ctx.Err() == nil {
+			return nil, moduletools.NewRetryableError(err, 0)
+		}
+		return nil, err
 	}
 	defer res.Body.Close()
 	bodyBytes, err := io.ReadAll(res.Body)
@@ -98,26 +206,25 @@ func (v *vectorizer) vectorize(ctx context.Context, input []string,
 	}
 	var resBody embeddingsResponse
 	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
-		return nil, errors.Wrap(err, "unmarshal response body")
+		err = errors.Wrap(err, "unmarshal response body")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(err, moduletools.RetryAfter(res.Header))
+		}
+		return nil, err
 	}
 
 	if res.StatusCode >= 500 {
 		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "connection to Cohere failed with status: %d error: %v")
-		return nil, errors.Errorf(errorMessage)
+		return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
 	} else if res.StatusCode > 200 {
 		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "failed with status: %d error: %v")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+		}
 		return nil, errors.Errorf(errorMessage)
 	}
 
-	if len(resBody.Embeddings) == 0 {
-		return nil, errors.Errorf("empty embeddings response")
-	}
-
-	return &ent.VectorizationResult{
-		Text:       input,
-		Dimensions: len(resBody.Embeddings[0]),
-		Vector:     resBody.Embeddings[0],
-	}, nil
+	return &resBody, nil
 }
 
 func getErrorMessage(statusCode int, resBodyError string, errorTemplate string) string {
@@ -152,3 +259,10 @@ func (v *vectorizer) getModel(config ent.VectorizationConfig) string {
 func (v *vectorizer) getTruncate(config ent.VectorizationConfig) string {
 	return config.Truncate
 }
+
+func (v *vectorizer) getEmbeddingType(config ent.VectorizationConfig) string {
+	if config.EmbeddingType == "" {
+		return embeddingTypeFloat
+	}
+	return config.EmbeddingType
+}
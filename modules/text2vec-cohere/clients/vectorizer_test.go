@@ -55,6 +55,116 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("when using an embed v3 model", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			urlBuilder: &cohereUrlBuilder{
+				origin:   server.URL,
+				pathMask: "/embed",
+			},
+			logger: nullLogger(),
+		}
+		config := ent.VectorizationConfig{Model: "embed-english-v3.0"}
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"}, config)
+		require.Nil(t, err)
+		assert.Equal(t, "search_document", handler.lastRequest["input_type"])
+		assert.NotContains(t, handler.lastRequest, "embedding_types")
+
+		_, err = c.VectorizeQuery(context.Background(), []string{"This is my text"}, config)
+		require.Nil(t, err)
+		assert.Equal(t, "search_query", handler.lastRequest["input_type"])
+	})
+
+	t.Run("when using a model prior to embed v3", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			urlBuilder: &cohereUrlBuilder{
+				origin:   server.URL,
+				pathMask: "/embed",
+			},
+			logger: nullLogger(),
+		}
+
+		_, err := c.VectorizeQuery(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{Model: "embed-english-v2.0"})
+
+		require.Nil(t, err)
+		assert.NotContains(t, handler.lastRequest, "input_type")
+	})
+
+	t.Run("when requesting int8 embeddings", func(t *testing.T) {
+		handler := &fakeHandler{
+			t: t,
+			embeddings: map[string]interface{}{
+				"int8": [][]int{{-128, 0, 127}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			urlBuilder: &cohereUrlBuilder{
+				origin:   server.URL,
+				pathMask: "/embed",
+			},
+			logger: nullLogger(),
+		}
+		expected := &ent.VectorizationResult{
+			Text:       []string{"This is my text"},
+			Vector:     []float32{-128, 0, 127},
+			Dimensions: 3,
+		}
+
+		res, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{Model: "embed-english-v3.0", EmbeddingType: "int8"})
+
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, []interface{}{"int8"}, handler.lastRequest["embedding_types"])
+	})
+
+	t.Run("when requesting binary embeddings", func(t *testing.T) {
+		handler := &fakeHandler{
+			t: t,
+			embeddings: map[string]interface{}{
+				// 0b10100000 and 0b00000011
+				"binary": [][]int{{-96, 3}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			urlBuilder: &cohereUrlBuilder{
+				origin:   server.URL,
+				pathMask: "/embed",
+			},
+			logger: nullLogger(),
+		}
+		expected := &ent.VectorizationResult{
+			Text:       []string{"This is my text"},
+			Vector:     []float32{1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1},
+			Dimensions: 16,
+		}
+
+		res, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{Model: "embed-english-v3.0", EmbeddingType: "binary"})
+
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
 	t.Run("when the context is expired", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
@@ -180,6 +290,9 @@ func TestClient(t *testing.T) {
 type fakeHandler struct {
 	t           *testing.T
 	serverError error
+	// embeddings overrides the default float embeddings in the response
+	embeddings  interface{}
+	lastRequest map[string]interface{}
 }
 
 func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -207,12 +320,17 @@ func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	var b map[string]interface{}
 	require.Nil(f.t, json.Unmarshal(bodyBytes, &b))
+	f.lastRequest = b
 
 	textInput := b["texts"].([]interface{})
 	assert.Greater(f.t, len(textInput), 0)
 
+	var embeddings interface{} = [][]float32{{0.1, 0.2, 0.3}}
+	if f.embeddings != nil {
+		embeddings = f.embeddings
+	}
 	embeddingResponse := map[string]interface{}{
-		"embeddings": [][]float32{{0.1, 0.2, 0.3}},
+		"embeddings": embeddings,
 	}
 	outBytes, err := json.Marshal(embeddingResponse)
 	require.Nil(f.t, err)
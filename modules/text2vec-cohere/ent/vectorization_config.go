@@ -12,6 +12,7 @@
 package ent
 
 type VectorizationConfig struct {
-	Model    string
-	Truncate string
+	Model         string
+	Truncate      string
+	EmbeddingType string
 }
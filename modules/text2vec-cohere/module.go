@@ -104,7 +104,19 @@ func (m *CohereModule) initVectorizer(ctx context.Context,
 	logger logrus.FieldLogger,
 ) error {
 	apiKey := os.Getenv("COHERE_APIKEY")
-	client := clients.New(apiKey, logger)
+	retryPolicy, err := moduletools.RetryPolicyFromEnv("COHERE")
+	if err != nil {
+		return err
+	}
+	httpOptions, err := moduletools.HTTPClientOptionsFromEnv("COHERE")
+	if err != nil {
+		return err
+	}
+	transport, err := moduletools.NewHTTPTransport(httpOptions)
+	if err != nil {
+		return err
+	}
+	client := clients.New(apiKey, retryPolicy, transport, logger)
 
 	m.vectorizer = vectorizer.New(client)
 	m.metaProvider = client
@@ -25,6 +25,7 @@ import (
 const (
 	DefaultCohereModel           = "embed-multilingual-v2.0"
 	DefaultTruncate              = "RIGHT"
+	DefaultEmbeddingType         = "float"
 	DefaultVectorizeClassName    = true
 	DefaultPropertyIndexed       = true
 	DefaultVectorizePropertyName = false
@@ -35,9 +36,12 @@ var (
 		"medium",
 		"large", "small", "multilingual-22-12",
 		"embed-english-v2.0", "embed-english-light-v2.0", "embed-multilingual-v2.0",
+		"embed-english-v3.0", "embed-english-light-v3.0",
+		"embed-multilingual-v3.0", "embed-multilingual-light-v3.0",
 	}
 	experimetnalCohereModels = []string{"multilingual-2210-alpha"}
 	availableTruncates       = []string{"NONE", "LEFT", "RIGHT"}
+	availableEmbeddingTypes  = []string{"float", "int8", "binary"}
 )
 
 type classSettings struct {
@@ -93,6 +97,12 @@ func (cs *classSettings) Truncate() string {
 	return cs.getProperty("truncate", DefaultTruncate)
 }
 
+// EmbeddingType selects the compressed embeddings of the embed v3 models,
+// which trade some accuracy for a smaller memory footprint
+func (cs *classSettings) EmbeddingType() string {
+	return cs.getProperty("embeddingType", DefaultEmbeddingType)
+}
+
 func (cs *classSettings) VectorizeClassName() bool {
 	if cs.cfg == nil {
 		// we would receive a nil-config on cross-class requests, such as Explore{}
@@ -126,6 +136,13 @@ func (cs *classSettings) Validate(class *models.Class) error {
 	if !cs.validateCohereSetting(truncate, availableTruncates) {
 		return errors.Errorf("wrong truncate type, available types are: %v", availableTruncates)
 	}
+	embeddingType := cs.EmbeddingType()
+	if !cs.validateCohereSetting(embeddingType, availableEmbeddingTypes) {
+		return errors.Errorf("wrong embeddingType, available types are: %v", availableEmbeddingTypes)
+	}
+	if embeddingType != DefaultEmbeddingType && !isEmbedV3Model(model) {
+		return errors.Errorf("embeddingType %s can only be used with embed v3 models", embeddingType)
+	}
 
 	err := cs.validateIndexState(class, cs)
 	if err != nil {
@@ -144,6 +161,10 @@ func (cs *classSettings) validateCohereSetting(value string, availableValues []s
 	return false
 }
 
+func isEmbedV3Model(model string) bool {
+	return strings.HasPrefix(model, "embed-") && strings.HasSuffix(model, "-v3.0")
+}
+
 func (cs *classSettings) getProperty(name, defaultValue string) string {
 	if cs.cfg == nil {
 		// we would receive a nil-config on cross-class requests, such as Explore{}
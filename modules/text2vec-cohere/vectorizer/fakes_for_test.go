@@ -52,6 +52,7 @@ type fakeSettings struct {
 	excludedProperty   string
 	cohereModel        string
 	truncateType       string
+	embeddingType      string
 }
 
 func (f *fakeSettings) PropertyIndexed(propName string) bool {
@@ -73,3 +74,7 @@ func (f *fakeSettings) Model() string {
 func (f *fakeSettings) Truncate() string {
 	return f.truncateType
 }
+
+func (f *fakeSettings) EmbeddingType() string {
+	return f.embeddingType
+}
@@ -182,6 +182,7 @@ func TestVectorizingObjects(t *testing.T) {
 				skippedProperty:    test.noindex,
 				vectorizeClassName: test.excludedClass != "Car",
 				cohereModel:        test.cohereModel,
+				embeddingType:      "int8",
 			}
 			err := v.Object(context.Background(), test.input, nil, ic)
 
@@ -191,6 +192,7 @@ func TestVectorizingObjects(t *testing.T) {
 			actual := strings.Split(client.lastInput[0], " ")
 			assert.Equal(t, expected, actual)
 			assert.Equal(t, test.expectedCohereModel, client.lastConfig.Model)
+			assert.Equal(t, "int8", client.lastConfig.EmbeddingType)
 		})
 	}
 }
@@ -23,8 +23,9 @@ func (v *Vectorizer) Texts(ctx context.Context, inputs []string,
 	settings ClassSettings,
 ) ([]float32, error) {
 	res, err := v.client.VectorizeQuery(ctx, []string{v.joinSentences(inputs)}, ent.VectorizationConfig{
-		Model:    settings.Model(),
-		Truncate: settings.Truncate(),
+		Model:         settings.Model(),
+		Truncate:      settings.Truncate(),
+		EmbeddingType: settings.EmbeddingType(),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "remote client vectorize")
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/text2vec-custom/ent"
+)
+
+const (
+	// documentType marks texts of objects, queryType texts of queries, so
+	// that services with asymmetric models can embed them differently
+	documentType = "document"
+	queryType    = "query"
+)
+
+// vectorizeRequest is POSTed to the endpoint of the class
+type vectorizeRequest struct {
+	Texts []string `json:"texts"`
+	Model string   `json:"model,omitempty"`
+	Type  string   `json:"type"`
+}
+
+// vectorizeResponse is expected from the endpoint, with one vector per text
+// in the order of the texts of the request
+type vectorizeResponse struct {
+	Vectors [][]float32 `json:"vectors,omitempty"`
+	Error   string      `json:"error,omitempty"`
+}
+
+type custom struct {
+	apiKey     string
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *custom {
+	return &custom{
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+		logger: logger,
+	}
+}
+
+func (v *custom) Vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, documentType, config)
+}
+
+func (v *custom) VectorizeQuery(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, queryType, config)
+}
+
+func (v *custom) vectorize(ctx context.Context, input []string, textType string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	body, err := json.Marshal(vectorizeRequest{
+		Texts: input,
+		Model: config.Model,
+		Type:  textType,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", config.Endpoint,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	req.Header.Add("Content-Type", "application/json")
+	if apiKey := v.getApiKey(ctx); apiKey != "" {
+		req.Header.Add("Authorization", "Bearer "+apiKey)
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody vectorizeResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		if res.StatusCode != 200 {
+			return nil, fmt.Errorf("connection to custom vectorizer failed with status: %d", res.StatusCode)
+		}
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode != 200 || resBody.Error != "" {
+		if resBody.Error != "" {
+			return nil, fmt.Errorf("connection to custom vectorizer failed with status: %d error: %v",
+				res.StatusCode, resBody.Error)
+		}
+		return nil, fmt.Errorf("connection to custom vectorizer failed with status: %d", res.StatusCode)
+	}
+
+	if len(resBody.Vectors) != len(input) {
+		return nil, errors.Errorf("expected %d vectors, got %d", len(input), len(resBody.Vectors))
+	}
+	if len(resBody.Vectors[0]) == 0 {
+		return nil, errors.Errorf("empty vector")
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input[0],
+		Dimensions: len(resBody.Vectors[0]),
+		Vector:     resBody.Vectors[0],
+	}, nil
+}
+
+// getApiKey returns the key sent as bearer token, a key passed with the
+// X-Custom-Api-Key header takes precedence over the key of the environment
+func (v *custom) getApiKey(ctx context.Context) string {
+	if apiKey := moduletools.GetValueFromContext(ctx, "X-Custom-Api-Key"); apiKey != "" {
+		return apiKey
+	}
+	return v.apiKey
+}
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2vec-custom/ent"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("when all is fine", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("apiKey", time.Minute, nullLogger())
+
+		expected := &ent.VectorizationResult{
+			Text:       "This is my text",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Dimensions: 3,
+		}
+		res, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{
+				Endpoint: server.URL + "/vectorize",
+				Model:    "my-embedding-model",
+			})
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, "/vectorize", handler.lastPath)
+		assert.Equal(t, "Bearer apiKey", handler.lastAuthorization)
+		assert.Equal(t, vectorizeRequest{
+			Texts: []string{"This is my text"},
+			Model: "my-embedding-model",
+			Type:  "document",
+		}, handler.lastRequest)
+	})
+
+	t.Run("when a query is vectorized", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("", time.Minute, nullLogger())
+
+		_, err := c.VectorizeQuery(context.Background(), []string{"This is my query"},
+			ent.VectorizationConfig{Endpoint: server.URL})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", handler.lastAuthorization)
+		assert.Equal(t, vectorizeRequest{
+			Texts: []string{"This is my query"},
+			Type:  "query",
+		}, handler.lastRequest)
+	})
+
+	t.Run("when the api key is passed with the request", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("apiKey", time.Minute, nullLogger())
+
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Custom-Api-Key", []string{"headerApiKey"})
+		_, err := c.Vectorize(ctxWithValue, []string{"This is my text"},
+			ent.VectorizationConfig{Endpoint: server.URL})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Bearer headerApiKey", handler.lastAuthorization)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New("", time.Minute, nullLogger())
+
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, []string{"This is my text"},
+			ent.VectorizationConfig{Endpoint: server.URL})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: errors.Errorf("model not found"),
+		})
+		defer server.Close()
+		c := New("", time.Minute, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{Endpoint: server.URL})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to custom vectorizer failed with status: 500 "+
+			"error: model not found")
+	})
+
+	t.Run("when the server returns too few vectors", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t, noVectors: true})
+		defer server.Close()
+		c := New("", time.Minute, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{Endpoint: server.URL})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "expected 1 vectors, got 0")
+	})
+}
+
+type fakeHandler struct {
+	t                 *testing.T
+	serverError       error
+	noVectors         bool
+	lastPath          string
+	lastAuthorization string
+	lastRequest       vectorizeRequest
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastPath = r.URL.Path
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	if f.serverError != nil {
+		outBytes, err := json.Marshal(&vectorizeResponse{Error: f.serverError.Error()})
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	f.lastRequest = vectorizeRequest{}
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastRequest))
+
+	resBody := &vectorizeResponse{}
+	if !f.noVectors {
+		for range f.lastRequest.Texts {
+			resBody.Vectors = append(resBody.Vectors, []float32{0.1, 0.2, 0.3})
+		}
+	}
+	outBytes, err := json.Marshal(resBody)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
@@ -0,0 +1,231 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/weaviate/weaviate/modules/text2vec-custom/vectorizer"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	endpointProperty = "endpoint"
+	modelProperty    = "model"
+)
+
+const (
+	DefaultVectorizeClassName    = true
+	DefaultPropertyIndexed       = true
+	DefaultVectorizePropertyName = false
+	DefaultModel                 = ""
+)
+
+const moduleName = "text2vec-custom"
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) PropertyIndexed(propName string) bool {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultPropertyIndexed
+	}
+
+	vcn, ok := ic.cfg.Property(propName)["skip"]
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	return !asBool
+}
+
+func (ic *classSettings) VectorizePropertyName(propName string) bool {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizePropertyName
+	}
+	vcn, ok := ic.cfg.Property(propName)["vectorizePropertyName"]
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	return asBool
+}
+
+func (ic *classSettings) VectorizeClassName() bool {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizeClassName
+	}
+
+	vcn, ok := ic.cfg.Class()["vectorizeClassName"]
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	return asBool
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	var errorMessages []string
+
+	if err := ic.validateEndpoint(ic.Endpoint()); err != nil {
+		errorMessages = append(errorMessages, err.Error())
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("%s", strings.Join(errorMessages, ", "))
+	}
+
+	err := ic.validateIndexState(class, ic)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ic *classSettings) validateEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return fmt.Errorf("%s must be set", endpointProperty)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s must be an http or https URL, got %q", endpointProperty, endpoint)
+	}
+	if allowed := allowedHosts(); len(allowed) > 0 && !contains(allowed, u.Hostname()) {
+		return fmt.Errorf("%s host %q is not allowed, allowed hosts are: %v",
+			endpointProperty, u.Hostname(), allowed)
+	}
+	return nil
+}
+
+// allowedHosts are the hosts endpoints may point to, set by the operator
+// with CUSTOM_VECTORIZER_ALLOWED_HOSTS, any host is allowed if it's empty
+func allowedHosts() []string {
+	var hosts []string
+	for _, host := range strings.Split(os.Getenv("CUSTOM_VECTORIZER_ALLOWED_HOSTS"), ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+func contains(s []string, e string) bool {
+	for _, v := range s {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
+
+func (ic *classSettings) getStringProperty(name, defaultValue string) string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	value, ok := ic.cfg.ClassByModuleName(moduleName)[name]
+	if ok {
+		asString, ok := value.(string)
+		if ok {
+			return asString
+		}
+	}
+	return defaultValue
+}
+
+func (cv *classSettings) validateIndexState(class *models.Class, settings vectorizer.ClassSettings) error {
+	if settings.VectorizeClassName() {
+		// if the user chooses to vectorize the classname, vector-building will
+		// always be possible, no need to investigate further
+
+		return nil
+	}
+
+	// search if there is at least one indexed, string/text prop. If found pass
+	// validation
+	for _, prop := range class.Properties {
+		if len(prop.DataType) < 1 {
+			return errors.Errorf("property %s must have at least one datatype: "+
+				"got %v", prop.Name, prop.DataType)
+		}
+
+		if prop.DataType[0] != string(schema.DataTypeString) &&
+			prop.DataType[0] != string(schema.DataTypeText) {
+			// we can only vectorize text-like props
+			continue
+		}
+
+		if settings.PropertyIndexed(prop.Name) {
+			// found at least one, this is a valid schema
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid properties: didn't find a single property which is " +
+		"of type string or text and is not excluded from indexing. In addition the " +
+		"class name is excluded from vectorization as well, meaning that it cannot be " +
+		"used to determine the vector position. To fix this, set 'vectorizeClassName' " +
+		"to true if the class name is contextionary-valid. Alternatively add at least " +
+		"contextionary-valid text/string property which is not excluded from " +
+		"indexing")
+}
+
+// Custom vectorizer params
+
+// Endpoint is the URL of the embedding service the texts are POSTed to
+func (ic *classSettings) Endpoint() string {
+	return ic.getStringProperty(endpointProperty, "")
+}
+
+// Model is passed on to the embedding service, which may serve several
+// models, it is omitted if empty
+func (ic *classSettings) Model() string {
+	return ic.getStringProperty(modelProperty, DefaultModel)
+}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "Test",
+		Properties: []*models.Property{
+			{
+				DataType: []string{"text"},
+				Name:     "test",
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		cfg          moduletools.ClassConfig
+		wantEndpoint string
+		wantModel    string
+		allowedHosts string
+		wantErr      error
+	}{
+		{
+			name: "happy flow",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"endpoint": "http://embeddings:8080/vectorize",
+				},
+			},
+			wantEndpoint: "http://embeddings:8080/vectorize",
+			wantModel:    "",
+			wantErr:      nil,
+		},
+		{
+			name: "custom values",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"endpoint": "https://embeddings.example.com/v1/embed",
+					"model":    "my-embedding-model",
+				},
+			},
+			wantEndpoint: "https://embeddings.example.com/v1/embed",
+			wantModel:    "my-embedding-model",
+			wantErr:      nil,
+		},
+		{
+			name: "missing endpoint",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantErr: errors.Errorf("endpoint must be set"),
+		},
+		{
+			name: "wrong endpoint",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"endpoint": "embeddings:8080",
+				},
+			},
+			wantErr: errors.Errorf(`endpoint must be an http or https URL, got "embeddings:8080"`),
+		},
+		{
+			name: "endpoint host not allowed",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"endpoint": "http://169.254.169.254/latest",
+				},
+			},
+			allowedHosts: "embeddings, embeddings.example.com",
+			wantErr: errors.Errorf(`endpoint host "169.254.169.254" is not allowed, ` +
+				"allowed hosts are: [embeddings embeddings.example.com]"),
+		},
+		{
+			name: "endpoint host allowed",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"endpoint": "http://embeddings:8080/vectorize",
+				},
+			},
+			allowedHosts: "embeddings, embeddings.example.com",
+			wantEndpoint: "http://embeddings:8080/vectorize",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CUSTOM_VECTORIZER_ALLOWED_HOSTS", tt.allowedHosts)
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(class), tt.wantErr.Error())
+			} else {
+				assert.Nil(t, ic.Validate(class))
+				assert.Equal(t, tt.wantEndpoint, ic.Endpoint())
+				assert.Equal(t, tt.wantModel, ic.Model())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
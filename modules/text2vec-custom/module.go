@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modcustom
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/weaviate/weaviate/modules/text2vec-custom/config"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/text2vec-custom/additional"
+	"github.com/weaviate/weaviate/modules/text2vec-custom/additional/projector"
+	"github.com/weaviate/weaviate/modules/text2vec-custom/clients"
+	"github.com/weaviate/weaviate/modules/text2vec-custom/vectorizer"
+)
+
+const Name = "text2vec-custom"
+
+func New() *CustomModule {
+	return &CustomModule{}
+}
+
+type CustomModule struct {
+	vectorizer                   textVectorizer
+	metaProvider                 metaProvider
+	graphqlProvider              modulecapabilities.GraphQLArguments
+	searcher                     modulecapabilities.Searcher
+	nearTextTransformer          modulecapabilities.TextTransform
+	logger                       logrus.FieldLogger
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+}
+
+type textVectorizer interface {
+	Object(ctx context.Context, obj *models.Object, objDiff *moduletools.ObjectDiff,
+		settings vectorizer.ClassSettings) error
+	Texts(ctx context.Context, input []string,
+		settings vectorizer.ClassSettings) ([]float32, error)
+
+	MoveTo(source, target []float32, weight float32) ([]float32, error)
+	MoveAwayFrom(source, target []float32, weight float32) ([]float32, error)
+	CombineVectors([][]float32) []float32
+}
+
+type metaProvider interface {
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *CustomModule) Name() string {
+	return Name
+}
+
+func (m *CustomModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2Vec
+}
+
+func (m *CustomModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	m.logger = params.GetLogger()
+
+	if err := m.initVectorizer(ctx, m.logger); err != nil {
+		return errors.Wrap(err, "init vectorizer")
+	}
+
+	if err := m.initAdditionalPropertiesProvider(); err != nil {
+		return errors.Wrap(err, "init additional properties provider")
+	}
+
+	return nil
+}
+
+func (m *CustomModule) InitExtension(modules []modulecapabilities.Module) error {
+	for _, module := range modules {
+		if module.Name() == m.Name() {
+			continue
+		}
+		if arg, ok := module.(modulecapabilities.TextTransformers); ok {
+			if arg != nil && arg.TextTransformers() != nil {
+				m.nearTextTransformer = arg.TextTransformers()["nearText"]
+			}
+		}
+	}
+
+	if err := m.initNearText(); err != nil {
+		return errors.Wrap(err, "init graphql provider")
+	}
+	return nil
+}
+
+// DefaultTimeout is the timeout of requests to the embedding services, it
+// can be changed with CUSTOM_VECTORIZER_TIMEOUT
+const DefaultTimeout = 60 * time.Second
+
+func (m *CustomModule) initVectorizer(ctx context.Context,
+	logger logrus.FieldLogger,
+) error {
+	timeout := DefaultTimeout
+	if value := os.Getenv("CUSTOM_VECTORIZER_TIMEOUT"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return errors.Errorf("invalid CUSTOM_VECTORIZER_TIMEOUT %q, must be a positive duration", value)
+		}
+		timeout = parsed
+	}
+
+	client := clients.New(os.Getenv("CUSTOM_VECTORIZER_APIKEY"), timeout, logger)
+
+	m.vectorizer = vectorizer.New(client)
+	m.metaProvider = client
+
+	return nil
+}
+
+func (m *CustomModule) initAdditionalPropertiesProvider() error {
+	projector := projector.New()
+	m.additionalPropertiesProvider = additional.New(projector)
+	return nil
+}
+
+func (m *CustomModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *CustomModule) VectorizeObject(ctx context.Context,
+	obj *models.Object, objDiff *moduletools.ObjectDiff, cfg moduletools.ClassConfig,
+) error {
+	icheck := config.NewClassSettings(cfg)
+	return m.vectorizer.Object(ctx, obj, objDiff, icheck)
+}
+
+func (m *CustomModule) MetaInfo() (map[string]interface{}, error) {
+	return m.metaProvider.MetaInfo()
+}
+
+func (m *CustomModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+func (m *CustomModule) VectorizeInput(ctx context.Context,
+	input string, cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	return m.vectorizer.Texts(ctx, []string{input}, config.NewClassSettings(cfg))
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.Vectorizer(New())
+	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.Searcher(New())
+	_ = modulecapabilities.GraphQLArguments(New())
+)
@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/modules/text2vec-google/ent"
+	"golang.org/x/oauth2"
+	googleauth "golang.org/x/oauth2/google"
+)
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// task types let the models embed texts differently depending on whether
+// they are searched for or used to search
+const (
+	taskTypeRetrievalDocument = "RETRIEVAL_DOCUMENT"
+	taskTypeRetrievalQuery    = "RETRIEVAL_QUERY"
+)
+
+// modelsWithoutTaskType predate the task type parameter and reject it
+var modelsWithoutTaskType = map[string]struct{}{
+	"textembedding-gecko@001": {},
+}
+
+func buildURL(location, projectID, modelID string) string {
+	urlTemplate := "https://%s-aiplatform.googleapis.com/v1/projects/%s/locations/%s/publishers/google/models/%s:predict"
+	return fmt.Sprintf(urlTemplate, location, projectID, location, modelID)
+}
+
+type google struct {
+	httpClient   *http.Client
+	urlBuilderFn func(location, projectID, modelID string) string
+	logger       logrus.FieldLogger
+
+	// the token source of the application default credentials is looked up
+	// on first use, so that the module can start without credentials if they
+	// are passed in the request headers only
+	tokenSourceLock sync.Mutex
+	tokenSource     oauth2.TokenSource
+	tokenSourceFn   func(ctx context.Context) (oauth2.TokenSource, error)
+}
+
+func New(logger logrus.FieldLogger) *google {
+	return &google{
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+		urlBuilderFn:  buildURL,
+		logger:        logger,
+		tokenSourceFn: defaultTokenSource,
+	}
+}
+
+func defaultTokenSource(ctx context.Context) (oauth2.TokenSource, error) {
+	return googleauth.DefaultTokenSource(ctx, cloudPlatformScope)
+}
+
+func (v *google) Vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config, taskTypeRetrievalDocument)
+}
+
+func (v *google) VectorizeQuery(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config, taskTypeRetrievalQuery)
+}
+
+func (v *google) vectorize(ctx context.Context, input []string, config ent.VectorizationConfig,
+	taskType string,
+) (*ent.VectorizationResult, error) {
+	endpointURL := v.urlBuilderFn(v.getLocation(config), v.getProjectID(config), v.getModel(config))
+
+	if _, ok := modelsWithoutTaskType[v.getModel(config)]; ok {
+		taskType = ""
+	}
+	body, err := json.Marshal(embeddingsRequest{
+		Instances: []instance{
+			{
+				Content:  input[0],
+				TaskType: taskType,
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpointURL,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+
+	token, err := v.getToken(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Google OAuth token")
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody embeddingsResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode != 200 || resBody.Error != nil {
+		if resBody.Error != nil {
+			return nil, fmt.Errorf("connection to Google Vertex AI failed with status: %v error: %v",
+				res.StatusCode, resBody.Error.Message)
+		}
+		return nil, fmt.Errorf("connection to Google Vertex AI failed with status: %d", res.StatusCode)
+	}
+
+	if len(resBody.Predictions) == 0 {
+		return nil, errors.Errorf("empty embeddings response")
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input[0],
+		Dimensions: len(resBody.Predictions[0].Embeddings.Values),
+		Vector:     resBody.Predictions[0].Embeddings.Values,
+	}, nil
+}
+
+// getToken prefers the OAuth access token passed in the request header over
+// the application default credentials
+func (v *google) getToken(ctx context.Context) (string, error) {
+	token := ctx.Value("X-Google-Api-Key")
+	if tokenHeader, ok := token.([]string); ok &&
+		len(tokenHeader) > 0 && len(tokenHeader[0]) > 0 {
+		return tokenHeader[0], nil
+	}
+
+	tokenSource, err := v.getTokenSource()
+	if err != nil {
+		return "", errors.Wrap(err, "no access token found "+
+			"neither in request header: X-Google-Api-Key "+
+			"nor in the application default credentials")
+	}
+	oauthToken, err := tokenSource.Token()
+	if err != nil {
+		return "", errors.Wrap(err, "request access token")
+	}
+	return oauthToken.AccessToken, nil
+}
+
+func (v *google) getTokenSource() (oauth2.TokenSource, error) {
+	v.tokenSourceLock.Lock()
+	defer v.tokenSourceLock.Unlock()
+
+	if v.tokenSource != nil {
+		return v.tokenSource, nil
+	}
+	// tokens are refreshed in the background of later requests, so they
+	// must not be bound to the context of the current request
+	tokenSource, err := v.tokenSourceFn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	v.tokenSource = tokenSource
+	return tokenSource, nil
+}
+
+func (v *google) getLocation(config ent.VectorizationConfig) string {
+	return config.Location
+}
+
+func (v *google) getProjectID(config ent.VectorizationConfig) string {
+	return config.ProjectID
+}
+
+func (v *google) getModel(config ent.VectorizationConfig) string {
+	return config.Model
+}
+
+type embeddingsRequest struct {
+	Instances []instance `json:"instances,omitempty"`
+}
+
+type instance struct {
+	Content  string `json:"content"`
+	TaskType string `json:"task_type,omitempty"`
+}
+
+type embeddingsResponse struct {
+	Predictions      []prediction    `json:"predictions,omitempty"`
+	Error            *googleApiError `json:"error,omitempty"`
+	DeployedModelId  string          `json:"deployedModelId,omitempty"`
+	Model            string          `json:"model,omitempty"`
+	ModelDisplayName string          `json:"modelDisplayName,omitempty"`
+	ModelVersionId   string          `json:"modelVersionId,omitempty"`
+}
+
+type prediction struct {
+	Embeddings       embeddings        `json:"embeddings,omitempty"`
+	SafetyAttributes *safetyAttributes `json:"safetyAttributes,omitempty"`
+}
+
+type embeddings struct {
+	Values []float32 `json:"values,omitempty"`
+}
+
+type safetyAttributes struct {
+	Scores     []float64 `json:"scores,omitempty"`
+	Blocked    *bool     `json:"blocked,omitempty"`
+	Categories []string  `json:"categories,omitempty"`
+}
+
+type googleApiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
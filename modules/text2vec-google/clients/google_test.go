@@ -0,0 +1,256 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2vec-google/ent"
+	"golang.org/x/oauth2"
+)
+
+func TestClient(t *testing.T) {
+	staticTokenSource := func(ctx context.Context) (oauth2.TokenSource, error) {
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "default-token"}), nil
+	}
+
+	t.Run("when all is fine", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				assert.Equal(t, "us-central1", location)
+				assert.Equal(t, "project", projectID)
+				assert.Equal(t, "textembedding-gecko@003", modelID)
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+		expected := &ent.VectorizationResult{
+			Text:       "This is my text",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Dimensions: 3,
+		}
+		res, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{
+				Location:  "us-central1",
+				ProjectID: "project",
+				Model:     "textembedding-gecko@003",
+			})
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, "Bearer default-token", handler.lastAuthorization)
+		assert.Equal(t, "RETRIEVAL_DOCUMENT", handler.lastTaskType)
+	})
+
+	t.Run("when a query is vectorized", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+
+		_, err := c.VectorizeQuery(context.Background(), []string{"What is my text?"},
+			ent.VectorizationConfig{Model: "textembedding-gecko@003"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "RETRIEVAL_QUERY", handler.lastTaskType)
+	})
+
+	t.Run("when the model doesn't support task types", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+
+		_, err := c.VectorizeQuery(context.Background(), []string{"What is my text?"},
+			ent.VectorizationConfig{Model: "textembedding-gecko@001"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", handler.lastTaskType)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, []string{"This is my text"}, ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: errors.Errorf("nope, not gonna happen"),
+		})
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to Google Vertex AI failed with status: 500 error: nope, not gonna happen")
+	})
+
+	t.Run("when the access token is passed using X-Google-Api-Key header", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				return server.URL
+			},
+			tokenSourceFn: staticTokenSource,
+			logger:        nullLogger(),
+		}
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Google-Api-Key", []string{"header-token"})
+
+		_, err := c.Vectorize(ctxWithValue, []string{"This is my text"}, ent.VectorizationConfig{})
+
+		require.Nil(t, err)
+		assert.Equal(t, "Bearer header-token", handler.lastAuthorization)
+	})
+
+	t.Run("when no credentials are found", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := &google{
+			httpClient: &http.Client{},
+			urlBuilderFn: func(location, projectID, modelID string) string {
+				return server.URL
+			},
+			tokenSourceFn: func(ctx context.Context) (oauth2.TokenSource, error) {
+				return nil, errors.New("could not find default credentials")
+			},
+			logger: nullLogger(),
+		}
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"}, ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "Google OAuth token: no access token found "+
+			"neither in request header: X-Google-Api-Key "+
+			"nor in the application default credentials: could not find default credentials")
+	})
+}
+
+type fakeHandler struct {
+	t                 *testing.T
+	serverError       error
+	lastAuthorization string
+	lastTaskType      string
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	if f.serverError != nil {
+		embeddingResponse := &embeddingsResponse{
+			Error: &googleApiError{
+				Code:    http.StatusInternalServerError,
+				Status:  "error",
+				Message: f.serverError.Error(),
+			},
+		}
+
+		outBytes, err := json.Marshal(embeddingResponse)
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	var req embeddingsRequest
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &req))
+
+	require.NotNil(f.t, req)
+	require.Len(f.t, req.Instances, 1)
+
+	textInput := req.Instances[0].Content
+	assert.Greater(f.t, len(textInput), 0)
+	f.lastTaskType = req.Instances[0].TaskType
+
+	embeddingResponse := &embeddingsResponse{
+		Predictions: []prediction{
+			{
+				Embeddings: embeddings{
+					Values: []float32{0.1, 0.2, 0.3},
+				},
+			},
+		},
+	}
+
+	outBytes, err := json.Marshal(embeddingResponse)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           moduletools.ClassConfig
+		wantLocation  string
+		wantProjectID string
+		wantModelID   string
+		wantErr       error
+	}{
+		{
+			name: "happy flow",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"projectId": "projectId",
+				},
+			},
+			wantLocation:  "us-central1",
+			wantProjectID: "projectId",
+			wantModelID:   "textembedding-gecko@003",
+			wantErr:       nil,
+		},
+		{
+			name: "custom values",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"location":  "europe-west4",
+					"projectId": "projectId",
+					"modelId":   "textembedding-gecko-multilingual@001",
+				},
+			},
+			wantLocation:  "europe-west4",
+			wantProjectID: "projectId",
+			wantModelID:   "textembedding-gecko-multilingual@001",
+			wantErr:       nil,
+		},
+		{
+			name: "empty projectId",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"projectId": "",
+				},
+			},
+			wantErr: errors.Errorf("projectId cannot be empty"),
+		},
+		{
+			name: "wrong modelId",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"projectId": "projectId",
+					"modelId":   "wrong-model",
+				},
+			},
+			wantErr: errors.Errorf("wrong modelId available model names are: " +
+				"[textembedding-gecko@001 textembedding-gecko@002 textembedding-gecko@003 textembedding-gecko-multilingual@001]"),
+		},
+		{
+			name: "all wrong",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"projectId": "",
+					"location":  "",
+					"modelId":   "wrong-model",
+				},
+			},
+			wantErr: errors.Errorf("projectId cannot be empty, location cannot be empty, " +
+				"wrong modelId available model names are: " +
+				"[textembedding-gecko@001 textembedding-gecko@002 textembedding-gecko@003 textembedding-gecko-multilingual@001]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(nil), tt.wantErr.Error())
+			} else {
+				assert.Equal(t, tt.wantLocation, ic.Location())
+				assert.Equal(t, tt.wantProjectID, ic.ProjectID())
+				assert.Equal(t, tt.wantModelID, ic.ModelID())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -0,0 +1,429 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bert
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	configFile         = "config.json"
+	weightsFile        = "model.safetensors"
+	vocabFile          = "vocab.txt"
+	tokenizerFile      = "tokenizer_config.json"
+	sentenceConfigFile = "sentence_bert_config.json"
+
+	// DefaultMaxSeqLength is the sequence length the sentence-transformers
+	// models such as all-MiniLM-L6-v2 were trained with
+	DefaultMaxSeqLength = 256
+)
+
+// Config holds the architecture of a model as found in its config.json
+type Config struct {
+	HiddenSize            int     `json:"hidden_size"`
+	NumHiddenLayers       int     `json:"num_hidden_layers"`
+	NumAttentionHeads     int     `json:"num_attention_heads"`
+	IntermediateSize      int     `json:"intermediate_size"`
+	MaxPositionEmbeddings int     `json:"max_position_embeddings"`
+	LayerNormEps          float64 `json:"layer_norm_eps"`
+	HiddenAct             string  `json:"hidden_act"`
+}
+
+// Model is a BERT encoder which embeds texts by mean pooling its last hidden
+// states, the way sentence-transformers models are used. The weights are
+// only read during inference, so a model is safe for concurrent use.
+type Model struct {
+	config       Config
+	tokenizer    *Tokenizer
+	maxSeqLength int
+	activation   func(float32) float32
+
+	wordEmbeddings      tensor
+	positionEmbeddings  tensor
+	tokenTypeEmbeddings tensor
+	embeddingsNorm      layerNorm
+	layers              []encoderLayer
+}
+
+type linear struct {
+	weight tensor // [out, in]
+	bias   tensor // [out]
+}
+
+type layerNorm struct {
+	weight tensor
+	bias   tensor
+}
+
+type encoderLayer struct {
+	query, key, value linear
+	attentionOutput   linear
+	attentionNorm     layerNorm
+	intermediate      linear
+	output            linear
+	outputNorm        layerNorm
+}
+
+// Load reads a model from a directory in the Hugging Face format, holding
+// config.json, model.safetensors and vocab.txt
+func Load(dir string) (*Model, error) {
+	var config Config
+	if err := readJSON(filepath.Join(dir, configFile), &config); err != nil {
+		return nil, errors.Wrap(err, "read model config")
+	}
+
+	// uncased models lower case the input, the tokenizer config is optional
+	tokenizerConfig := struct {
+		DoLowerCase *bool `json:"do_lower_case"`
+	}{}
+	if err := readJSON(filepath.Join(dir, tokenizerFile), &tokenizerConfig); err != nil &&
+		!os.IsNotExist(errors.Cause(err)) {
+		return nil, errors.Wrap(err, "read tokenizer config")
+	}
+	lowerCase := tokenizerConfig.DoLowerCase == nil || *tokenizerConfig.DoLowerCase
+	tokenizer, err := LoadTokenizer(filepath.Join(dir, vocabFile), lowerCase)
+	if err != nil {
+		return nil, errors.Wrap(err, "load tokenizer")
+	}
+
+	sentenceConfig := struct {
+		MaxSeqLength int `json:"max_seq_length"`
+	}{MaxSeqLength: DefaultMaxSeqLength}
+	if err := readJSON(filepath.Join(dir, sentenceConfigFile), &sentenceConfig); err != nil &&
+		!os.IsNotExist(errors.Cause(err)) {
+		return nil, errors.Wrap(err, "read sentence-transformers config")
+	}
+
+	weights, err := readSafetensors(filepath.Join(dir, weightsFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "read model weights")
+	}
+
+	return New(config, tokenizer, sentenceConfig.MaxSeqLength, weights)
+}
+
+func readJSON(path string, v interface{}) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// New builds the model from its weights, which are named the way the
+// Hugging Face BertModel names them
+func New(config Config, tokenizer *Tokenizer, maxSeqLength int,
+	weights map[string]tensor,
+) (*Model, error) {
+	if config.HiddenSize <= 0 || config.NumAttentionHeads <= 0 ||
+		config.HiddenSize%config.NumAttentionHeads != 0 {
+		return nil, errors.Errorf("hidden size %d must be a multiple of the %d attention heads",
+			config.HiddenSize, config.NumAttentionHeads)
+	}
+	if config.MaxPositionEmbeddings < 2 {
+		return nil, errors.Errorf("max position embeddings must be at least 2, got %d",
+			config.MaxPositionEmbeddings)
+	}
+	if config.LayerNormEps == 0 {
+		config.LayerNormEps = 1e-12
+	}
+	if maxSeqLength <= 0 || maxSeqLength > config.MaxPositionEmbeddings {
+		maxSeqLength = config.MaxPositionEmbeddings
+	}
+
+	activation, err := activationFn(config.HiddenAct)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &loader{weights: weights}
+	hidden, intermediate := config.HiddenSize, config.IntermediateSize
+	m := &Model{
+		config:              config,
+		tokenizer:           tokenizer,
+		maxSeqLength:        maxSeqLength,
+		activation:          activation,
+		wordEmbeddings:      l.tensor("embeddings.word_embeddings.weight", -1, hidden),
+		positionEmbeddings:  l.tensor("embeddings.position_embeddings.weight", config.MaxPositionEmbeddings, hidden),
+		tokenTypeEmbeddings: l.tensor("embeddings.token_type_embeddings.weight", -1, hidden),
+		embeddingsNorm:      l.layerNorm("embeddings.LayerNorm", hidden),
+		layers:              make([]encoderLayer, config.NumHiddenLayers),
+	}
+	for i := range m.layers {
+		prefix := "encoder.layer." + strconv.Itoa(i) + "."
+		m.layers[i] = encoderLayer{
+			query:           l.linear(prefix+"attention.self.query", hidden, hidden),
+			key:             l.linear(prefix+"attention.self.key", hidden, hidden),
+			value:           l.linear(prefix+"attention.self.value", hidden, hidden),
+			attentionOutput: l.linear(prefix+"attention.output.dense", hidden, hidden),
+			attentionNorm:   l.layerNorm(prefix+"attention.output.LayerNorm", hidden),
+			intermediate:    l.linear(prefix+"intermediate.dense", intermediate, hidden),
+			output:          l.linear(prefix+"output.dense", hidden, intermediate),
+			outputNorm:      l.layerNorm(prefix+"output.LayerNorm", hidden),
+		}
+	}
+	if l.err != nil {
+		return nil, l.err
+	}
+	if m.wordEmbeddings.shape[0] < tokenizer.vocabSize {
+		return nil, errors.Errorf("vocab holds %d tokens, but the model only %d",
+			tokenizer.vocabSize, m.wordEmbeddings.shape[0])
+	}
+	return m, nil
+}
+
+// Dimensions returns the size of the embeddings
+func (m *Model) Dimensions() int {
+	return m.config.HiddenSize
+}
+
+// Embed returns the normalized mean of the token embeddings of the text
+func (m *Model) Embed(ctx context.Context, text string) ([]float32, error) {
+	ids := m.tokenizer.Encode(text, m.maxSeqLength)
+	hidden := m.config.HiddenSize
+
+	x := make([]float32, len(ids)*hidden)
+	for t, id := range ids {
+		row := x[t*hidden : (t+1)*hidden]
+		word := m.wordEmbeddings.data[id*hidden : (id+1)*hidden]
+		position := m.positionEmbeddings.data[t*hidden : (t+1)*hidden]
+		tokenType := m.tokenTypeEmbeddings.data[:hidden]
+		for i := range row {
+			row[i] = word[i] + position[i] + tokenType[i]
+		}
+	}
+	m.normalize(x, m.embeddingsNorm)
+
+	for i := range m.layers {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		x = m.encode(x, len(ids), &m.layers[i])
+	}
+
+	pooled := make([]float32, hidden)
+	for t := 0; t < len(ids); t++ {
+		for i, v := range x[t*hidden : (t+1)*hidden] {
+			pooled[i] += v
+		}
+	}
+	var norm float64
+	for i := range pooled {
+		pooled[i] /= float32(len(ids))
+		norm += float64(pooled[i]) * float64(pooled[i])
+	}
+	if norm > 0 {
+		scale := float32(1 / math.Sqrt(norm))
+		for i := range pooled {
+			pooled[i] *= scale
+		}
+	}
+	return pooled, nil
+}
+
+// encode runs a single transformer layer over the hidden states of all tokens
+func (m *Model) encode(x []float32, tokens int, layer *encoderLayer) []float32 {
+	hidden := m.config.HiddenSize
+	heads := m.config.NumAttentionHeads
+	headSize := hidden / heads
+	scale := float32(1 / math.Sqrt(float64(headSize)))
+
+	q := apply(x, tokens, layer.query)
+	k := apply(x, tokens, layer.key)
+	v := apply(x, tokens, layer.value)
+
+	attended := make([]float32, tokens*hidden)
+	scores := make([]float32, tokens)
+	for h := 0; h < heads; h++ {
+		offset := h * headSize
+		for i := 0; i < tokens; i++ {
+			qi := q[i*hidden+offset : i*hidden+offset+headSize]
+			for j := 0; j < tokens; j++ {
+				scores[j] = dot(qi, k[j*hidden+offset:j*hidden+offset+headSize]) * scale
+			}
+			softmax(scores)
+			ci := attended[i*hidden+offset : i*hidden+offset+headSize]
+			for j := 0; j < tokens; j++ {
+				vj := v[j*hidden+offset : j*hidden+offset+headSize]
+				for d := range ci {
+					ci[d] += scores[j] * vj[d]
+				}
+			}
+		}
+	}
+
+	attention := apply(attended, tokens, layer.attentionOutput)
+	for i := range attention {
+		attention[i] += x[i]
+	}
+	m.normalize(attention, layer.attentionNorm)
+
+	intermediate := apply(attention, tokens, layer.intermediate)
+	for i := range intermediate {
+		intermediate[i] = m.activation(intermediate[i])
+	}
+	out := apply(intermediate, tokens, layer.output)
+	for i := range out {
+		out[i] += attention[i]
+	}
+	m.normalize(out, layer.outputNorm)
+	return out
+}
+
+// apply multiplies the rows of x with the transposed weight matrix and adds
+// the bias
+func apply(x []float32, rows int, l linear) []float32 {
+	out, in := l.weight.shape[0], l.weight.shape[1]
+	y := make([]float32, rows*out)
+	for r := 0; r < rows; r++ {
+		xr := x[r*in : (r+1)*in]
+		yr := y[r*out : (r+1)*out]
+		for o := range yr {
+			yr[o] = dot(xr, l.weight.data[o*in:(o+1)*in]) + l.bias.data[o]
+		}
+	}
+	return y
+}
+
+func (m *Model) normalize(x []float32, ln layerNorm) {
+	hidden := m.config.HiddenSize
+	for r := 0; r < len(x)/hidden; r++ {
+		row := x[r*hidden : (r+1)*hidden]
+		var mean, variance float64
+		for _, v := range row {
+			mean += float64(v)
+		}
+		mean /= float64(hidden)
+		for _, v := range row {
+			d := float64(v) - mean
+			variance += d * d
+		}
+		variance /= float64(hidden)
+		inv := 1 / math.Sqrt(variance+m.config.LayerNormEps)
+		for i, v := range row {
+			row[i] = float32((float64(v)-mean)*inv)*ln.weight.data[i] + ln.bias.data[i]
+		}
+	}
+}
+
+// dot is unrolled, which lets the independent sums be computed in parallel
+func dot(a, b []float32) float32 {
+	var s0, s1, s2, s3 float32
+	i := 0
+	for ; i+4 <= len(a); i += 4 {
+		s0 += a[i] * b[i]
+		s1 += a[i+1] * b[i+1]
+		s2 += a[i+2] * b[i+2]
+		s3 += a[i+3] * b[i+3]
+	}
+	for ; i < len(a); i++ {
+		s0 += a[i] * b[i]
+	}
+	return s0 + s1 + s2 + s3
+}
+
+func softmax(x []float32) {
+	maxValue := x[0]
+	for _, v := range x[1:] {
+		if v > maxValue {
+			maxValue = v
+		}
+	}
+	var sum float32
+	for i, v := range x {
+		x[i] = float32(math.Exp(float64(v - maxValue)))
+		sum += x[i]
+	}
+	for i := range x {
+		x[i] /= sum
+	}
+}
+
+func activationFn(name string) (func(float32) float32, error) {
+	switch name {
+	case "gelu", "":
+		return func(x float32) float32 {
+			return float32(0.5 * float64(x) * (1 + math.Erf(float64(x)/math.Sqrt2)))
+		}, nil
+	case "gelu_new", "gelu_pytorch_tanh":
+		return func(x float32) float32 {
+			v := float64(x)
+			return float32(0.5 * v * (1 + math.Tanh(math.Sqrt(2/math.Pi)*(v+0.044715*v*v*v))))
+		}, nil
+	case "relu":
+		return func(x float32) float32 {
+			if x < 0 {
+				return 0
+			}
+			return x
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported activation %s", name)
+	}
+}
+
+// loader looks up the weights and checks their shapes, the first error is
+// kept so that the model can be assembled without checking every weight
+type loader struct {
+	weights map[string]tensor
+	err     error
+}
+
+func (l *loader) tensor(name string, shape ...int) tensor {
+	if l.err != nil {
+		return tensor{}
+	}
+	t, ok := l.weights[name]
+	if !ok {
+		// checkpoints of BertForPreTraining and friends prefix the encoder's
+		// weights
+		t, ok = l.weights["bert."+name]
+	}
+	if !ok {
+		l.err = errors.Errorf("missing weight %s", name)
+		return tensor{}
+	}
+	if len(t.shape) != len(shape) {
+		l.err = errors.Errorf("weight %s has shape %v, expected %d dimensions",
+			name, t.shape, len(shape))
+		return tensor{}
+	}
+	for i := range shape {
+		// -1 accepts any size, such as the vocab size
+		if shape[i] != -1 && t.shape[i] != shape[i] {
+			l.err = errors.Errorf("weight %s has shape %v, expected %v", name, t.shape, shape)
+			return tensor{}
+		}
+	}
+	return t
+}
+
+func (l *loader) linear(name string, out, in int) linear {
+	return linear{
+		weight: l.tensor(name+".weight", out, in),
+		bias:   l.tensor(name+".bias", out),
+	}
+}
+
+func (l *loader) layerNorm(name string, size int) layerNorm {
+	return layerNorm{
+		weight: l.tensor(name+".weight", size),
+		bias:   l.tensor(name+".bias", size),
+	}
+}
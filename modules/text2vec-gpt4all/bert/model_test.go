@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bert
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = Config{
+	HiddenSize:            8,
+	NumHiddenLayers:       2,
+	NumAttentionHeads:     2,
+	IntermediateSize:      16,
+	MaxPositionEmbeddings: 16,
+	LayerNormEps:          1e-12,
+	HiddenAct:             "gelu",
+}
+
+func TestModel(t *testing.T) {
+	dir := writeTestModel(t, testConfig)
+
+	model, err := Load(dir)
+	require.Nil(t, err)
+
+	t.Run("embeddings are normalized", func(t *testing.T) {
+		vector, err := model.Embed(context.Background(), "hello, world!")
+		require.Nil(t, err)
+
+		require.Len(t, vector, 8)
+		assert.Equal(t, 8, model.Dimensions())
+		var norm float64
+		for _, v := range vector {
+			norm += float64(v) * float64(v)
+		}
+		assert.InDelta(t, 1, norm, 1e-5)
+	})
+
+	t.Run("embeddings are deterministic", func(t *testing.T) {
+		first, err := model.Embed(context.Background(), "hello world")
+		require.Nil(t, err)
+		second, err := model.Embed(context.Background(), "Hello   World")
+		require.Nil(t, err)
+		other, err := model.Embed(context.Background(), "unaffable cafe")
+		require.Nil(t, err)
+
+		assert.Equal(t, first, second)
+		assert.NotEqual(t, first, other)
+	})
+
+	t.Run("long texts are truncated", func(t *testing.T) {
+		vector, err := model.Embed(context.Background(),
+			strings.Repeat("hello world ", 100))
+
+		require.Nil(t, err)
+		assert.Len(t, vector, 8)
+	})
+
+	t.Run("when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := model.Embed(ctx, "hello world")
+
+		assert.Equal(t, context.Canceled, err)
+	})
+}
+
+func TestLoadErrors(t *testing.T) {
+	t.Run("missing weight", func(t *testing.T) {
+		weights := testWeights(testConfig)
+		delete(weights, "encoder.layer.1.output.dense.bias")
+		tokenizer, err := NewTokenizer(testVocab, true)
+		require.Nil(t, err)
+
+		_, err = New(testConfig, tokenizer, 0, weights)
+
+		assert.EqualError(t, err, "missing weight encoder.layer.1.output.dense.bias")
+	})
+
+	t.Run("wrong shape", func(t *testing.T) {
+		config := testConfig
+		config.IntermediateSize = 32
+		tokenizer, err := NewTokenizer(testVocab, true)
+		require.Nil(t, err)
+
+		_, err = New(config, tokenizer, 0, testWeights(testConfig))
+
+		assert.EqualError(t, err, "weight encoder.layer.0.intermediate.dense.weight "+
+			"has shape [16 8], expected [32 8]")
+	})
+
+	t.Run("unsupported activation", func(t *testing.T) {
+		config := testConfig
+		config.HiddenAct = "swish"
+		tokenizer, err := NewTokenizer(testVocab, true)
+		require.Nil(t, err)
+
+		_, err = New(config, tokenizer, 0, testWeights(config))
+
+		assert.EqualError(t, err, "unsupported activation swish")
+	})
+
+	t.Run("missing model directory", func(t *testing.T) {
+		_, err := Load(filepath.Join(t.TempDir(), "missing"))
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "read model config")
+	})
+}
+
+func TestSafetensors(t *testing.T) {
+	t.Run("half precision", func(t *testing.T) {
+		assert.Equal(t, float32(1), float16ToFloat32(0x3c00))
+		assert.Equal(t, float32(-2), float16ToFloat32(0xc000))
+		assert.Equal(t, float32(math.Pow(2, -24)), float16ToFloat32(0x0001))
+		assert.True(t, math.IsInf(float64(float16ToFloat32(0x7c00)), 1))
+	})
+
+	t.Run("out of bounds offsets", func(t *testing.T) {
+		raw := encodeSafetensors(t, map[string]tensor{
+			"a": {shape: []int{2}, data: []float32{1, 2}},
+		})
+		_, err := parseSafetensors(raw[:len(raw)-1])
+
+		assert.EqualError(t, err, "safetensors: tensor a: data offsets [0 8] out of bounds")
+	})
+}
+
+func testWeights(config Config) map[string]tensor {
+	r := rand.New(rand.NewSource(1))
+	random := func(shape ...int) tensor {
+		size := 1
+		for _, dim := range shape {
+			size *= dim
+		}
+		data := make([]float32, size)
+		for i := range data {
+			data[i] = float32(r.NormFloat64() * 0.5)
+		}
+		return tensor{shape: shape, data: data}
+	}
+
+	hidden, intermediate := config.HiddenSize, config.IntermediateSize
+	weights := map[string]tensor{
+		"embeddings.word_embeddings.weight":       random(len(testVocab), hidden),
+		"embeddings.position_embeddings.weight":   random(config.MaxPositionEmbeddings, hidden),
+		"embeddings.token_type_embeddings.weight": random(2, hidden),
+		"embeddings.LayerNorm.weight":             random(hidden),
+		"embeddings.LayerNorm.bias":               random(hidden),
+	}
+	for i := 0; i < config.NumHiddenLayers; i++ {
+		prefix := "encoder.layer." + strconv.Itoa(i) + "."
+		for name, shape := range map[string][]int{
+			"attention.self.query":       {hidden, hidden},
+			"attention.self.key":         {hidden, hidden},
+			"attention.self.value":       {hidden, hidden},
+			"attention.output.dense":     {hidden, hidden},
+			"attention.output.LayerNorm": {hidden},
+			"intermediate.dense":         {intermediate, hidden},
+			"output.dense":               {hidden, intermediate},
+			"output.LayerNorm":           {hidden},
+		} {
+			weights[prefix+name+".weight"] = random(shape...)
+			weights[prefix+name+".bias"] = random(shape[0])
+		}
+	}
+	return weights
+}
+
+func writeTestModel(t *testing.T, config Config) string {
+	dir := t.TempDir()
+
+	configJSON, err := json.Marshal(config)
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(filepath.Join(dir, configFile), configJSON, 0o644))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, vocabFile),
+		[]byte(strings.Join(testVocab, "\n")+"\n"), 0o644))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, weightsFile),
+		encodeSafetensors(t, testWeights(config)), 0o644))
+	return dir
+}
+
+func encodeSafetensors(t *testing.T, tensors map[string]tensor) []byte {
+	names := make([]string, 0, len(tensors))
+	for name := range tensors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	header := map[string]interface{}{
+		"__metadata__": map[string]string{"format": "pt"},
+	}
+	var data []byte
+	for _, name := range names {
+		start := len(data)
+		for _, v := range tensors[name].data {
+			data = binary.LittleEndian.AppendUint32(data, math.Float32bits(v))
+		}
+		header[name] = tensorHeader{
+			DType:       "F32",
+			Shape:       tensors[name].shape,
+			DataOffsets: [2]int64{int64(start), int64(len(data))},
+		}
+	}
+	headerJSON, err := json.Marshal(header)
+	require.Nil(t, err)
+
+	raw := binary.LittleEndian.AppendUint64(nil, uint64(len(headerJSON)))
+	raw = append(raw, headerJSON...)
+	return append(raw, data...)
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bert
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// tensor is a row-major float32 tensor
+type tensor struct {
+	shape []int
+	data  []float32
+}
+
+type tensorHeader struct {
+	DType       string   `json:"dtype"`
+	Shape       []int    `json:"shape"`
+	DataOffsets [2]int64 `json:"data_offsets"`
+}
+
+// maxHeaderSize guards against reading garbage as header size, the header of
+// a BERT checkpoint is a few kilobytes
+const maxHeaderSize = 100 << 20
+
+// readSafetensors loads all tensors of a file in the safetensors format,
+// which is a little endian header size, a JSON header describing the tensors
+// and the raw tensor data. Half precision tensors are converted to float32.
+func readSafetensors(path string) (map[string]tensor, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseSafetensors(raw)
+}
+
+func parseSafetensors(raw []byte) (map[string]tensor, error) {
+	if len(raw) < 8 {
+		return nil, errors.New("safetensors: file too short")
+	}
+	headerSize := binary.LittleEndian.Uint64(raw[:8])
+	if headerSize > maxHeaderSize || headerSize > uint64(len(raw)-8) {
+		return nil, errors.Errorf("safetensors: invalid header size %d", headerSize)
+	}
+
+	var header map[string]json.RawMessage
+	if err := json.Unmarshal(raw[8:8+headerSize], &header); err != nil {
+		return nil, errors.Wrap(err, "safetensors: parse header")
+	}
+	data := raw[8+headerSize:]
+
+	tensors := make(map[string]tensor, len(header))
+	for name, value := range header {
+		if name == "__metadata__" {
+			continue
+		}
+		var th tensorHeader
+		if err := json.Unmarshal(value, &th); err != nil {
+			return nil, errors.Wrapf(err, "safetensors: parse header of %s", name)
+		}
+		t, err := decodeTensor(th, data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "safetensors: tensor %s", name)
+		}
+		tensors[name] = t
+	}
+	return tensors, nil
+}
+
+func decodeTensor(th tensorHeader, data []byte) (tensor, error) {
+	size := 1
+	for _, dim := range th.Shape {
+		if dim < 0 {
+			return tensor{}, errors.Errorf("invalid shape %v", th.Shape)
+		}
+		size *= dim
+	}
+
+	var elemSize int
+	switch th.DType {
+	case "F32":
+		elemSize = 4
+	case "F16", "BF16":
+		elemSize = 2
+	default:
+		return tensor{}, errors.Errorf("unsupported dtype %s", th.DType)
+	}
+
+	start, end := th.DataOffsets[0], th.DataOffsets[1]
+	if start < 0 || end < start || end > int64(len(data)) {
+		return tensor{}, errors.Errorf("data offsets %v out of bounds", th.DataOffsets)
+	}
+	if end-start != int64(size*elemSize) {
+		return tensor{}, errors.Errorf("expected %d bytes for shape %v, got %d",
+			size*elemSize, th.Shape, end-start)
+	}
+	buf := data[start:end]
+
+	values := make([]float32, size)
+	for i := range values {
+		switch th.DType {
+		case "F32":
+			values[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4*i:]))
+		case "F16":
+			values[i] = float16ToFloat32(binary.LittleEndian.Uint16(buf[2*i:]))
+		case "BF16":
+			values[i] = math.Float32frombits(uint32(binary.LittleEndian.Uint16(buf[2*i:])) << 16)
+		}
+	}
+	return tensor{shape: th.Shape, data: values}, nil
+}
+
+func float16ToFloat32(h uint16) float32 {
+	sign := uint32(h>>15) << 31
+	exponent := uint32(h>>10) & 0x1f
+	mantissa := uint32(h) & 0x3ff
+
+	switch {
+	case exponent == 0 && mantissa == 0:
+		return math.Float32frombits(sign)
+	case exponent == 0:
+		// subnormal, normalize the mantissa
+		e := uint32(127 - 15 + 1)
+		for mantissa&0x400 == 0 {
+			mantissa <<= 1
+			e--
+		}
+		mantissa &= 0x3ff
+		return math.Float32frombits(sign | e<<23 | mantissa<<13)
+	case exponent == 0x1f:
+		// infinity or NaN
+		return math.Float32frombits(sign | 0xff<<23 | mantissa<<13)
+	default:
+		return math.Float32frombits(sign | (exponent+127-15)<<23 | mantissa<<13)
+	}
+}
@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bert
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	unknownToken  = "[UNK]"
+	classToken    = "[CLS]"
+	separateToken = "[SEP]"
+
+	// words longer than this are mapped to the unknown token, as done by the
+	// reference implementation
+	maxWordChars = 100
+)
+
+// Tokenizer splits texts into the WordPiece tokens of a BERT vocabulary
+type Tokenizer struct {
+	vocab     map[string]int
+	vocabSize int
+	lowerCase bool
+
+	unknownID  int
+	classID    int
+	separateID int
+}
+
+// LoadTokenizer reads a vocab.txt file, which holds one token per line with
+// the line number being the token's id
+func LoadTokenizer(path string, lowerCase bool) (*Tokenizer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var tokens []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		tokens = append(tokens, strings.TrimRight(scanner.Text(), "\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read vocab")
+	}
+	return NewTokenizer(tokens, lowerCase)
+}
+
+func NewTokenizer(tokens []string, lowerCase bool) (*Tokenizer, error) {
+	vocab := make(map[string]int, len(tokens))
+	for id, token := range tokens {
+		if _, ok := vocab[token]; !ok {
+			vocab[token] = id
+		}
+	}
+
+	t := &Tokenizer{vocab: vocab, vocabSize: len(tokens), lowerCase: lowerCase}
+	for _, special := range []struct {
+		token string
+		id    *int
+	}{
+		{unknownToken, &t.unknownID},
+		{classToken, &t.classID},
+		{separateToken, &t.separateID},
+	} {
+		tokenID, ok := vocab[special.token]
+		if !ok {
+			return nil, errors.Errorf("vocab is missing the special token %s", special.token)
+		}
+		*special.id = tokenID
+	}
+	return t, nil
+}
+
+// Encode returns the token ids of the text wrapped in the class and separator
+// tokens. Texts which don't fit into maxLen tokens are truncated.
+func (t *Tokenizer) Encode(text string, maxLen int) []int {
+	ids := []int{t.classID}
+	for _, token := range t.Tokenize(text) {
+		if len(ids) >= maxLen-1 {
+			break
+		}
+		id, ok := t.vocab[token]
+		if !ok {
+			id = t.unknownID
+		}
+		ids = append(ids, id)
+	}
+	return append(ids, t.separateID)
+}
+
+// Tokenize splits the text into words and punctuation and those into the
+// longest pieces found in the vocabulary
+func (t *Tokenizer) Tokenize(text string) []string {
+	var tokens []string
+	for _, word := range t.splitWords(text) {
+		tokens = append(tokens, t.wordPieces(word)...)
+	}
+	return tokens
+}
+
+func (t *Tokenizer) splitWords(text string) []string {
+	if t.lowerCase {
+		// strip the accents by decomposing the characters and dropping the
+		// combining marks
+		text = norm.NFD.String(strings.ToLower(text))
+	}
+
+	var words []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			words = append(words, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range text {
+		switch {
+		case r == 0 || r == unicode.ReplacementChar || isControl(r):
+			continue
+		case t.lowerCase && unicode.Is(unicode.Mn, r):
+			continue
+		case isWhitespace(r):
+			flush()
+		case isPunctuation(r) || isCJK(r):
+			// punctuation and CJK characters are tokens of their own
+			flush()
+			words = append(words, string(r))
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return words
+}
+
+func (t *Tokenizer) wordPieces(word string) []string {
+	runes := []rune(word)
+	if len(runes) > maxWordChars {
+		return []string{unknownToken}
+	}
+
+	var pieces []string
+	for start := 0; start < len(runes); {
+		end := len(runes)
+		found := ""
+		for ; end > start; end-- {
+			piece := string(runes[start:end])
+			if start > 0 {
+				piece = "##" + piece
+			}
+			if _, ok := t.vocab[piece]; ok {
+				found = piece
+				break
+			}
+		}
+		if found == "" {
+			return []string{unknownToken}
+		}
+		pieces = append(pieces, found)
+		start = end
+	}
+	return pieces
+}
+
+func isWhitespace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r' || unicode.Is(unicode.Zs, r)
+}
+
+func isControl(r rune) bool {
+	if r == '\t' || r == '\n' || r == '\r' {
+		return false
+	}
+	return unicode.In(r, unicode.Cc, unicode.Cf, unicode.Co, unicode.Cs)
+}
+
+// isPunctuation treats all non-alphanumeric ASCII characters as punctuation,
+// such as "$" and "^", which are not in the unicode punctuation class
+func isPunctuation(r rune) bool {
+	if (r >= 33 && r <= 47) || (r >= 58 && r <= 64) ||
+		(r >= 91 && r <= 96) || (r >= 123 && r <= 126) {
+		return true
+	}
+	return unicode.IsPunct(r)
+}
+
+func isCJK(r rune) bool {
+	return (r >= 0x4E00 && r <= 0x9FFF) ||
+		(r >= 0x3400 && r <= 0x4DBF) ||
+		(r >= 0x20000 && r <= 0x2A6DF) ||
+		(r >= 0x2A700 && r <= 0x2B73F) ||
+		(r >= 0x2B740 && r <= 0x2B81F) ||
+		(r >= 0x2B820 && r <= 0x2CEAF) ||
+		(r >= 0xF900 && r <= 0xFAFF) ||
+		(r >= 0x2F800 && r <= 0x2FA1F)
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testVocab = []string{
+	"[PAD]", "[UNK]", "[CLS]", "[SEP]",
+	"hello", "world", ",", "!", "un", "##aff", "##able", "cafe", "中", "$", "5",
+}
+
+func TestTokenizer(t *testing.T) {
+	tokenizer, err := NewTokenizer(testVocab, true)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "words and punctuation",
+			text:     "Hello, World!",
+			expected: []string{"hello", ",", "world", "!"},
+		},
+		{
+			name:     "word pieces",
+			text:     "unaffable",
+			expected: []string{"un", "##aff", "##able"},
+		},
+		{
+			name:     "accents and whitespace",
+			text:     "  Café\t\nhello world ",
+			expected: []string{"cafe", "hello", "world"},
+		},
+		{
+			name:     "unknown words",
+			text:     "unaffablex hello",
+			expected: []string{"[UNK]", "hello"},
+		},
+		{
+			name:     "cjk characters and ascii symbols",
+			text:     "hello中world$5",
+			expected: []string{"hello", "中", "world", "$", "5"},
+		},
+		{
+			name:     "control characters",
+			text:     "hel\u200blo\x00",
+			expected: []string{"hello"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, tokenizer.Tokenize(test.text))
+		})
+	}
+
+	t.Run("cased tokenizer", func(t *testing.T) {
+		tokenizer, err := NewTokenizer(testVocab, false)
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"[UNK]", "world"}, tokenizer.Tokenize("Hello world"))
+	})
+
+	t.Run("encode", func(t *testing.T) {
+		assert.Equal(t, []int{2, 4, 6, 5, 3}, tokenizer.Encode("hello, world", 10))
+	})
+
+	t.Run("encode truncates", func(t *testing.T) {
+		assert.Equal(t, []int{2, 4, 6, 3}, tokenizer.Encode("hello, world", 4))
+	})
+
+	t.Run("missing special tokens", func(t *testing.T) {
+		_, err := NewTokenizer([]string{"hello"}, true)
+
+		assert.EqualError(t, err, "vocab is missing the special token [UNK]")
+	})
+}
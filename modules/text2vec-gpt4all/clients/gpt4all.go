@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/modules/text2vec-gpt4all/bert"
+	"github.com/weaviate/weaviate/modules/text2vec-gpt4all/ent"
+)
+
+// gpt4all embeds texts in-process with a local BERT model, such as the
+// all-MiniLM-L6-v2 model shipped with GPT4All
+type gpt4all struct {
+	model     *bert.Model
+	modelPath string
+	logger    logrus.FieldLogger
+
+	// inference is CPU bound, running more texts than there are CPUs at the
+	// same time would only slow down all of them
+	slots chan struct{}
+}
+
+func New(modelPath string, logger logrus.FieldLogger) (*gpt4all, error) {
+	model, err := bert.Load(modelPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load model from %s", modelPath)
+	}
+	return &gpt4all{
+		model:     model,
+		modelPath: modelPath,
+		logger:    logger,
+		slots:     make(chan struct{}, runtime.GOMAXPROCS(0)),
+	}, nil
+}
+
+func (v *gpt4all) Vectorize(ctx context.Context, text string,
+) (*ent.VectorizationResult, error) {
+	select {
+	case v.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-v.slots }()
+
+	vector, err := v.model.Embed(ctx, text)
+	if err != nil {
+		return nil, errors.Wrap(err, "embed text")
+	}
+	return &ent.VectorizationResult{
+		Text:       text,
+		Dimensions: len(vector),
+		Vector:     vector,
+	}, nil
+}
@@ -0,0 +1,21 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+func (v *gpt4all) MetaInfo() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"name":              "GPT4All Module",
+		"documentationHref": "https://docs.gpt4all.io/gpt4all_python_embedding.html",
+		"model":             v.modelPath,
+		"dimensions":        v.model.Dimensions(),
+	}, nil
+}
@@ -18,9 +18,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-huggingface/ent"
 )
 
@@ -37,7 +39,8 @@ type embeddingsRequest struct {
 type options struct {
 	WaitForModel bool `json:"wait_for_model,omitempty"`
 	UseGPU       bool `json:"use_gpu,omitempty"`
-	UseCache     bool `json:"use_cache,omitempty"`
+	// the inference API caches by default, so false has to be sent explicitly
+	UseCache bool `json:"use_cache"`
 }
 
 type embedding [][]float32
@@ -52,15 +55,19 @@ type huggingFaceApiError struct {
 
 type vectorizer struct {
 	apiKey                string
+	retryPolicy           moduletools.RetryPolicy
 	httpClient            *http.Client
 	bertEmbeddingsDecoder *bertEmbeddingsDecoder
 	logger                logrus.FieldLogger
 }
 
-func New(apiKey string, logger logrus.FieldLogger) *vectorizer {
+func New(apiKey string, retryPolicy moduletools.RetryPolicy,
+	transport http.RoundTripper, logger logrus.FieldLogger,
+) *vectorizer {
 	return &vectorizer{
 		apiKey:                apiKey,
-		httpClient:            &http.Client{},
+		retryPolicy:           retryPolicy,
+		httpClient:            &http.Client{Transport: transport},
 		bertEmbeddingsDecoder: newBertEmbeddingsDecoder(),
 		logger:                logger,
 	}
@@ -89,6 +96,30 @@ func (v *vectorizer) vectorize(ctx context.Context, url string,
 		return nil, errors.Wrapf(err, "marshal body")
 	}
 
+	var bodyBytes []byte
+	err = v.retryPolicy.Do(ctx, func() error {
+		bodyBytes, err = v.sendRequest(ctx, url, body)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vector, err := v.decodeVector(bodyBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decode vector")
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input,
+		Dimensions: len(vector),
+		Vector:     vector,
+	}, nil
+}
+
+func (v *vectorizer) sendRequest(ctx context.Context, url string,
+	body []byte,
+) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, "POST", url,
 		bytes.NewReader(body))
 	if err != nil {
@@ -101,7 +132,11 @@ func (v *vectorizer) vectorize(ctx context.Context, url string,
 
 	res, err := v.httpClient.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "send POST request")
+		err = errors.Wrap(err, "send POST request")
+		if ctx.Err() == nil {
+			return nil, moduletools.NewRetryableError(err, 0)
+		}
+		return nil, err
 	}
 	defer res.Body.Close()
 
@@ -111,19 +146,13 @@ func (v *vectorizer) vectorize(ctx context.Context, url string,
 	}
 
 	if err := checkResponse(res, bodyBytes); err != nil {
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(err, retryAfter(res, bodyBytes))
+		}
 		return nil, err
 	}
 
-	vector, err := v.decodeVector(bodyBytes)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot decode vector")
-	}
-
-	return &ent.VectorizationResult{
-		Text:       input,
-		Dimensions: len(vector),
-		Vector:     vector,
-	}, nil
+	return bodyBytes, nil
 }
 
 func checkResponse(res *http.Response, bodyBytes []byte) error {
@@ -154,6 +183,24 @@ func checkResponse(res *http.Response, bodyBytes []byte) error {
 	return errors.New(message)
 }
 
+// retryAfter returns the delay before the next attempt. While a model is
+// being loaded the inference API responds with 503 and an estimate of how
+// long the loading takes, which is used if no Retry-After header is set.
+func retryAfter(res *http.Response, bodyBytes []byte) time.Duration {
+	if delay := moduletools.RetryAfter(res.Header); delay > 0 {
+		return delay
+	}
+	if res.StatusCode != http.StatusServiceUnavailable {
+		return 0
+	}
+	var resBody huggingFaceApiError
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil ||
+		resBody.EstimatedTime == nil || *resBody.EstimatedTime <= 0 {
+		return 0
+	}
+	return time.Duration(float64(*resBody.EstimatedTime) * float64(time.Second))
+}
+
 func (v *vectorizer) decodeVector(bodyBytes []byte) ([]float32, error) {
 	var emb embedding
 	if err := json.Unmarshal(bodyBytes, &emb); err != nil {
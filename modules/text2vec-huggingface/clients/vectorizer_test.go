@@ -25,6 +25,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-huggingface/ent"
 )
 
@@ -147,6 +148,43 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, err.Error(), "failed with status: 401 error: A valid user or organization token is required")
 	})
 
+	t.Run("when the model is still loading", func(t *testing.T) {
+		var requests []map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var b map[string]interface{}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			requests = append(requests, b)
+			if len(requests) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`{"error":"Model bert-base-uncased is currently loading","estimated_time":0.01}`))
+				return
+			}
+			w.Write([]byte(`[[0.1, 0.2, 0.3]]`))
+		}))
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:      "apiKey",
+			retryPolicy: moduletools.RetryPolicy{MaxRetries: 1, InitialDelay: time.Millisecond, MaxDelay: time.Second},
+			httpClient:  &http.Client{},
+			logger:      nullLogger(),
+		}
+
+		res, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{
+				EndpointURL:  server.URL,
+				WaitForModel: true,
+				UseCache:     false,
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3}, res.Vector)
+		require.Len(t, requests, 2)
+		assert.Equal(t, map[string]interface{}{
+			"wait_for_model": true,
+			"use_cache":      false,
+		}, requests[1]["options"])
+	})
+
 	t.Run("when the server returns an error with warnings", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{
 			t:           t,
@@ -281,3 +319,48 @@ func Test_getURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_retryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		header     http.Header
+		body       string
+		want       time.Duration
+	}{
+		{
+			name:       "model is loading",
+			statusCode: http.StatusServiceUnavailable,
+			body:       `{"error":"Model is currently loading","estimated_time":1.5}`,
+			want:       1500 * time.Millisecond,
+		},
+		{
+			name:       "Retry-After header takes precedence",
+			statusCode: http.StatusServiceUnavailable,
+			header:     http.Header{"Retry-After": []string{"3"}},
+			body:       `{"error":"Model is currently loading","estimated_time":1.5}`,
+			want:       3 * time.Second,
+		},
+		{
+			name:       "estimated time is only used for 503",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"error":"nope","estimated_time":1.5}`,
+			want:       0,
+		},
+		{
+			name:       "no estimated time",
+			statusCode: http.StatusServiceUnavailable,
+			body:       `{"error":"unavailable"}`,
+			want:       0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{StatusCode: tt.statusCode, Header: tt.header}
+			if res.Header == nil {
+				res.Header = http.Header{}
+			}
+			assert.Equal(t, tt.want, retryAfter(res, []byte(tt.body)))
+		})
+	}
+}
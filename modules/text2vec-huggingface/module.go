@@ -104,7 +104,19 @@ func (m *HuggingFaceModule) initVectorizer(ctx context.Context,
 	logger logrus.FieldLogger,
 ) error {
 	apiKey := os.Getenv("HUGGINGFACE_APIKEY")
-	client := clients.New(apiKey, logger)
+	retryPolicy, err := moduletools.RetryPolicyFromEnv("HUGGINGFACE")
+	if err != nil {
+		return err
+	}
+	httpOptions, err := moduletools.HTTPClientOptionsFromEnv("HUGGINGFACE")
+	if err != nil {
+		return err
+	}
+	transport, err := moduletools.NewHTTPTransport(httpOptions)
+	if err != nil {
+		return err
+	}
+	client := clients.New(apiKey, retryPolicy, transport, logger)
 
 	m.vectorizer = vectorizer.New(client)
 	m.metaProvider = client
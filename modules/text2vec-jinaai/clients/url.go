@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import "fmt"
+
+type jinaaiUrlBuilder struct {
+	origin   string
+	pathMask string
+}
+
+func newJinaAIUrlBuilder() *jinaaiUrlBuilder {
+	return &jinaaiUrlBuilder{
+		origin:   "https://api.jina.ai",
+		pathMask: "/v1/embeddings",
+	}
+}
+
+func (c *jinaaiUrlBuilder) url() string {
+	return fmt.Sprintf("%s%s", c.origin, c.pathMask)
+}
@@ -0,0 +1,307 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/text2vec-jinaai/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/batch"
+)
+
+const (
+	// DefaultBatchSize is the number of texts sent in a single embeddings
+	// request if not configured otherwise
+	DefaultBatchSize = 100
+	// how long a batch waits for further texts before it is sent
+	defaultBatchWindow = 10 * time.Millisecond
+)
+
+// jina-embeddings-v3 embeds the texts with task specific adapters, objects
+// and queries are embedded with the retrieval adapters unless a task type is
+// configured explicitly
+const (
+	jinaEmbeddingsV3     = "jina-embeddings-v3"
+	taskRetrievalPassage = "retrieval.passage"
+	taskRetrievalQuery   = "retrieval.query"
+)
+
+type embeddingsRequest struct {
+	Input      []string `json:"input"`
+	Model      string   `json:"model,omitempty"`
+	Dimensions *int64   `json:"dimensions,omitempty"`
+	Task       string   `json:"task,omitempty"`
+}
+
+type embeddingsResponse struct {
+	Data   []embeddingData `json:"data,omitempty"`
+	Detail string          `json:"detail,omitempty"`
+}
+
+type embeddingData struct {
+	Embedding []float32 `json:"embedding"`
+	Index     int       `json:"index"`
+}
+
+type vectorizer struct {
+	apiKey      string
+	batchSize   int
+	batchWindow time.Duration
+	retryPolicy moduletools.RetryPolicy
+	httpClient  *http.Client
+	urlBuilder  *jinaaiUrlBuilder
+	logger      logrus.FieldLogger
+
+	batchers *batch.Batchers[batchKey, string, embeddingData]
+}
+
+// batchKey identifies the texts which can be sent in the same request
+type batchKey struct {
+	apiKey     string
+	model      string
+	dimensions int64
+	task       string
+}
+
+func New(apiKey string, batchSize int, retryPolicy moduletools.RetryPolicy,
+	transport http.RoundTripper, logger logrus.FieldLogger,
+) *vectorizer {
+	return &vectorizer{
+		apiKey:      apiKey,
+		batchSize:   batchSize,
+		batchWindow: defaultBatchWindow,
+		retryPolicy: retryPolicy,
+		httpClient:  &http.Client{Transport: transport},
+		urlBuilder:  newJinaAIUrlBuilder(),
+		logger:      logger,
+		batchers:    batch.NewBatchers[batchKey, string, embeddingData](batch.DefaultIdleTimeout),
+	}
+}
+
+func (v *vectorizer) Vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	task := v.getTask(config, taskRetrievalPassage)
+	if v.batchSize > 1 && len(input) == 1 {
+		return v.vectorizeBatched(ctx, input[0], config, task)
+	}
+	return v.vectorize(ctx, input, config, task)
+}
+
+func (v *vectorizer) VectorizeQuery(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config, v.getTask(config, taskRetrievalQuery))
+}
+
+func (v *vectorizer) vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig, task string,
+) (*ent.VectorizationResult, error) {
+	apiKey, err := v.getApiKey(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "JinaAI API Key")
+	}
+
+	data, err := v.embed(ctx, apiKey, input, config, task)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input,
+		Dimensions: len(data[0].Embedding),
+		Vector:     data[0].Embedding,
+	}, nil
+}
+
+// vectorizeBatched sends the input together with the texts of concurrent
+// calls using the same model and settings in a single request
+func (v *vectorizer) vectorizeBatched(ctx context.Context, input string,
+	config ent.VectorizationConfig, task string,
+) (*ent.VectorizationResult, error) {
+	apiKey, err := v.getApiKey(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "JinaAI API Key")
+	}
+
+	data, err := v.getBatcher(apiKey, config, task).Vectorize(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent.VectorizationResult{
+		Text:       []string{input},
+		Dimensions: len(data.Embedding),
+		Vector:     data.Embedding,
+	}, nil
+}
+
+// embed returns the embeddings of the input in the order of the input
+func (v *vectorizer) embed(ctx context.Context, apiKey string, input []string,
+	config ent.VectorizationConfig, task string,
+) ([]embeddingData, error) {
+	body, err := json.Marshal(embeddingsRequest{
+		Input:      input,
+		Model:      v.getModel(config),
+		Dimensions: config.Dimensions,
+		Task:       task,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	var resBody *embeddingsResponse
+	err = v.retryPolicy.Do(ctx, func() error {
+		resBody, err = v.sendRequest(ctx, v.url(), apiKey, body)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resBody.Data) != len(input) {
+		return nil, errors.Errorf("expected %d embeddings, got %d",
+			len(input), len(resBody.Data))
+	}
+	data := make([]embeddingData, len(input))
+	for i := range resBody.Data {
+		index := resBody.Data[i].Index
+		if index < 0 || index >= len(data) {
+			return nil, errors.Errorf("embedding index %d out of range", index)
+		}
+		if len(resBody.Data[i].Embedding) == 0 {
+			return nil, errors.Errorf("empty embeddings response")
+		}
+		data[index] = resBody.Data[i]
+	}
+	return data, nil
+}
+
+func (v *vectorizer) sendRequest(ctx context.Context, url, apiKey string,
+	body []byte,
+) (*embeddingsResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		err = errors.Wrap(err, "send POST request")
+		if ctx.Err() == nil {
+			return nil, moduletools.NewRetryableError(err, 0)
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+	var resBody embeddingsResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		err = errors.Wrap(err, "unmarshal response body")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(err, moduletools.RetryAfter(res.Header))
+		}
+		return nil, err
+	}
+
+	if res.StatusCode >= 500 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Detail, "connection to JinaAI failed with status: %d error: %v")
+		return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+	} else if res.StatusCode > 200 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Detail, "failed with status: %d error: %v")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+		}
+		return nil, errors.Errorf(errorMessage)
+	}
+
+	return &resBody, nil
+}
+
+// getBatcher returns the batcher collecting the texts which are sent with the
+// same api key, model and settings. Batchers of api keys which haven't been
+// used for a while are dropped.
+func (v *vectorizer) getBatcher(apiKey string, config ent.VectorizationConfig,
+	task string,
+) *batch.Batcher[string, embeddingData] {
+	key := batchKey{
+		apiKey: apiKey,
+		model:  v.getModel(config),
+		task:   task,
+	}
+	if config.Dimensions != nil {
+		key.dimensions = *config.Dimensions
+	}
+
+	return v.batchers.Get(key, func() *batch.Batcher[string, embeddingData] {
+		return batch.NewBatcher(v.batchSize, v.batchWindow,
+			func(ctx context.Context, input []string) ([]embeddingData, error) {
+				return v.embed(ctx, apiKey, input, config, task)
+			})
+	})
+}
+
+func getErrorMessage(statusCode int, resBodyError string, errorTemplate string) string {
+	if resBodyError != "" {
+		return fmt.Sprintf(errorTemplate, statusCode, resBodyError)
+	}
+	return fmt.Sprintf(errorTemplate, statusCode)
+}
+
+func (v *vectorizer) getApiKey(ctx context.Context) (string, error) {
+	if len(v.apiKey) > 0 {
+		return v.apiKey, nil
+	}
+	apiKey := ctx.Value("X-Jinaai-Api-Key")
+	if apiKeyHeader, ok := apiKey.([]string); ok &&
+		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
+		return apiKeyHeader[0], nil
+	}
+	return "", errors.New("no api key found " +
+		"neither in request header: X-JinaAI-Api-Key " +
+		"nor in environment variable under JINAAI_APIKEY")
+}
+
+func (v *vectorizer) url() string {
+	return v.urlBuilder.url()
+}
+
+func (v *vectorizer) getModel(config ent.VectorizationConfig) string {
+	return config.Model
+}
+
+// getTask returns the task adapter to use, only jina-embeddings-v3 supports
+// task adapters
+func (v *vectorizer) getTask(config ent.VectorizationConfig, defaultTask string) string {
+	if config.Model != jinaEmbeddingsV3 {
+		return ""
+	}
+	if config.TaskType != "" {
+		return config.TaskType
+	}
+	return defaultTask
+}
@@ -0,0 +1,278 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	DefaultJinaAIModel           = "jina-embeddings-v2-base-en"
+	DefaultVectorizeClassName    = true
+	DefaultPropertyIndexed       = true
+	DefaultVectorizePropertyName = false
+)
+
+const (
+	jinaEmbeddingsV3     = "jina-embeddings-v3"
+	minV3Dimensions      = 32
+	maxV3Dimensions      = 1024
+	TaskRetrievalQuery   = "retrieval.query"
+	TaskRetrievalPassage = "retrieval.passage"
+)
+
+var (
+	availableJinaAIModels = []string{
+		"jina-embeddings-v2-base-en", "jina-embeddings-v2-small-en",
+		"jina-embeddings-v2-base-de", "jina-embeddings-v2-base-es",
+		"jina-embeddings-v2-base-zh", "jina-embeddings-v2-base-code",
+		jinaEmbeddingsV3,
+	}
+	availableTaskTypes = []string{
+		TaskRetrievalQuery, TaskRetrievalPassage,
+		"separation", "classification", "text-matching",
+	}
+)
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (cs *classSettings) PropertyIndexed(propName string) bool {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultPropertyIndexed
+	}
+
+	vcn, ok := cs.cfg.Property(propName)["skip"]
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	return !asBool
+}
+
+func (cs *classSettings) VectorizePropertyName(propName string) bool {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizePropertyName
+	}
+	vcn, ok := cs.cfg.Property(propName)["vectorizePropertyName"]
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	return asBool
+}
+
+func (cs *classSettings) Model() string {
+	return cs.getProperty("model", DefaultJinaAIModel)
+}
+
+// Dimensions shortens the vectors of jina-embeddings-v3, which is trained to
+// keep the most significant information in the leading dimensions
+func (cs *classSettings) Dimensions() *int64 {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return nil
+	}
+
+	val, ok := cs.cfg.Class()["dimensions"]
+	if !ok || val == nil {
+		return nil
+	}
+
+	asInt64 := toInt64(val)
+	return &asInt64
+}
+
+// TaskType selects the task specific adapter of jina-embeddings-v3. If it
+// isn't set, objects are embedded as passages and queries as queries.
+func (cs *classSettings) TaskType() string {
+	return cs.getProperty("taskType", "")
+}
+
+func (cs *classSettings) VectorizeClassName() bool {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizeClassName
+	}
+
+	vcn, ok := cs.cfg.Class()["vectorizeClassName"]
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	return asBool
+}
+
+func (cs *classSettings) Validate(class *models.Class) error {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	model := cs.Model()
+	if !cs.validateJinaAISetting(model, availableJinaAIModels) {
+		return errors.Errorf("wrong JinaAI model name, available model names are: %v", availableJinaAIModels)
+	}
+	if err := cs.validateDimensions(model, cs.Dimensions()); err != nil {
+		return err
+	}
+	if err := cs.validateTaskType(model, cs.TaskType()); err != nil {
+		return err
+	}
+
+	err := cs.validateIndexState(class, cs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cs *classSettings) validateJinaAISetting(value string, availableValues []string) bool {
+	for i := range availableValues {
+		if value == availableValues[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func (cs *classSettings) validateDimensions(model string, dimensions *int64) error {
+	if dimensions == nil {
+		return nil
+	}
+	if model != jinaEmbeddingsV3 {
+		return errors.Errorf("dimensions setting can only be used with the %s model", jinaEmbeddingsV3)
+	}
+	if *dimensions < minV3Dimensions || *dimensions > maxV3Dimensions {
+		return errors.Errorf("dimensions setting must be between %d and %d",
+			minV3Dimensions, maxV3Dimensions)
+	}
+	return nil
+}
+
+func (cs *classSettings) validateTaskType(model, taskType string) error {
+	if taskType == "" {
+		return nil
+	}
+	if model != jinaEmbeddingsV3 {
+		return errors.Errorf("taskType setting can only be used with the %s model", jinaEmbeddingsV3)
+	}
+	if !cs.validateJinaAISetting(taskType, availableTaskTypes) {
+		return errors.Errorf("wrong taskType setting, available task types are: %v", availableTaskTypes)
+	}
+	return nil
+}
+
+// toInt64 converts the numbers of the module config, invalid values are
+// returned as -1 so that they get rejected on validation
+func toInt64(val interface{}) int64 {
+	switch v := val.(type) {
+	case int:
+		return int64(v)
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	case json.Number:
+		parsed, err := v.Int64()
+		if err != nil {
+			return -1
+		}
+		return parsed
+	default:
+		return -1
+	}
+}
+
+func (cs *classSettings) getProperty(name, defaultValue string) string {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	model, ok := cs.cfg.Class()[name]
+	if ok {
+		asString, ok := model.(string)
+		if ok {
+			return strings.ToLower(asString)
+		}
+	}
+
+	return defaultValue
+}
+
+func (cs *classSettings) validateIndexState(class *models.Class, settings ClassSettings) error {
+	if settings.VectorizeClassName() {
+		// if the user chooses to vectorize the classname, vector-building will
+		// always be possible, no need to investigate further
+
+		return nil
+	}
+
+	// search if there is at least one indexed, string/text prop. If found pass
+	// validation
+	for _, prop := range class.Properties {
+		if len(prop.DataType) < 1 {
+			return errors.Errorf("property %s must have at least one datatype: "+
+				"got %v", prop.Name, prop.DataType)
+		}
+
+		if prop.DataType[0] != string(schema.DataTypeText) {
+			// we can only vectorize text-like props
+			continue
+		}
+
+		if settings.PropertyIndexed(prop.Name) {
+			// found at least one, this is a valid schema
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid properties: didn't find a single property which is " +
+		"of type string or text and is not excluded from indexing. In addition the " +
+		"class name is excluded from vectorization as well, meaning that it cannot be " +
+		"used to determine the vector position. To fix this, set 'vectorizeClassName' " +
+		"to true if the class name is contextionary-valid. Alternatively add at least " +
+		"contextionary-valid text/string property which is not excluded from " +
+		"indexing")
+}
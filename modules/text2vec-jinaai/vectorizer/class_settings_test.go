@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "Test",
+		Properties: []*models.Property{
+			{
+				DataType: []string{"text"},
+				Name:     "test",
+			},
+		},
+	}
+	tests := []struct {
+		name           string
+		cfg            fakeClassConfig
+		wantModel      string
+		wantDimensions *int64
+		wantTaskType   string
+		wantErr        string
+	}{
+		{
+			name:      "default settings",
+			cfg:       fakeClassConfig{classConfig: map[string]interface{}{}},
+			wantModel: "jina-embeddings-v2-base-en",
+		},
+		{
+			name: "jina-embeddings-v3 with dimensions and task type",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"model":      "jina-embeddings-v3",
+				"dimensions": json.Number("256"),
+				"taskType":   "text-matching",
+			}},
+			wantModel:      "jina-embeddings-v3",
+			wantDimensions: ptInt64(256),
+			wantTaskType:   "text-matching",
+		},
+		{
+			name: "wrong model",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"model": "jina-embeddings-v1",
+			}},
+			wantErr: "wrong JinaAI model name, available model names are: " +
+				"[jina-embeddings-v2-base-en jina-embeddings-v2-small-en " +
+				"jina-embeddings-v2-base-de jina-embeddings-v2-base-es " +
+				"jina-embeddings-v2-base-zh jina-embeddings-v2-base-code jina-embeddings-v3]",
+		},
+		{
+			name: "dimensions with a v2 model",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"dimensions": 256,
+			}},
+			wantErr: "dimensions setting can only be used with the jina-embeddings-v3 model",
+		},
+		{
+			name: "dimensions out of range",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"model":      "jina-embeddings-v3",
+				"dimensions": 2048,
+			}},
+			wantErr: "dimensions setting must be between 32 and 1024",
+		},
+		{
+			name: "task type with a v2 model",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"taskType": "text-matching",
+			}},
+			wantErr: "taskType setting can only be used with the jina-embeddings-v3 model",
+		},
+		{
+			name: "wrong task type",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"model":    "jina-embeddings-v3",
+				"taskType": "summarization",
+			}},
+			wantErr: "wrong taskType setting, available task types are: " +
+				"[retrieval.query retrieval.passage separation classification text-matching]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := NewClassSettings(tt.cfg)
+			err := cs.Validate(class)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantModel, cs.Model())
+			assert.Equal(t, tt.wantDimensions, cs.Dimensions())
+			assert.Equal(t, tt.wantTaskType, cs.TaskType())
+		})
+	}
+}
+
+func ptInt64(i int64) *int64 {
+	return &i
+}
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/modules/text2vec-jinaai/ent"
+)
+
+type fakeClient struct {
+	lastInput  []string
+	lastConfig ent.VectorizationConfig
+}
+
+func (c *fakeClient) Vectorize(ctx context.Context,
+	text []string, cfg ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	c.lastInput = text
+	c.lastConfig = cfg
+	return &ent.VectorizationResult{
+		Vector:     []float32{0, 1, 2, 3},
+		Dimensions: 4,
+		Text:       text,
+	}, nil
+}
+
+func (c *fakeClient) VectorizeQuery(ctx context.Context,
+	text []string, cfg ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	c.lastInput = text
+	c.lastConfig = cfg
+	return &ent.VectorizationResult{
+		Vector:     []float32{0.1, 1.1, 2.1, 3.1},
+		Dimensions: 4,
+		Text:       text,
+	}, nil
+}
+
+type fakeSettings struct {
+	skippedProperty    string
+	vectorizeClassName bool
+	excludedProperty   string
+	jinaaiModel        string
+	dimensions         *int64
+	taskType           string
+}
+
+func (f *fakeSettings) PropertyIndexed(propName string) bool {
+	return f.skippedProperty != propName
+}
+
+func (f *fakeSettings) VectorizePropertyName(propName string) bool {
+	return f.excludedProperty != propName
+}
+
+func (f *fakeSettings) VectorizeClassName() bool {
+	return f.vectorizeClassName
+}
+
+func (f *fakeSettings) Model() string {
+	return f.jinaaiModel
+}
+
+func (f *fakeSettings) Dimensions() *int64 {
+	return f.dimensions
+}
+
+func (f *fakeSettings) TaskType() string {
+	return f.taskType
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
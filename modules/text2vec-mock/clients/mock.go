@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/modules/text2vec-mock/ent"
+)
+
+// mock derives vectors from the words of a text by feature hashing, without
+// any model. Every word contributes a pseudo-random direction which only
+// depends on the word itself, so equal texts always get equal vectors and
+// texts sharing words get similar ones, which is enough to exercise vector
+// search in tests.
+type mock struct{}
+
+func New() *mock {
+	return &mock{}
+}
+
+func (m *mock) Vectorize(ctx context.Context, input string,
+	dimensions int,
+) (*ent.VectorizationResult, error) {
+	if dimensions <= 0 {
+		return nil, errors.Errorf("dimensions must be a positive integer, got %d",
+			dimensions)
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input,
+		Dimensions: dimensions,
+		Vector:     vectorize(input, dimensions),
+	}, nil
+}
+
+func (m *mock) MetaInfo() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"name":        "Mock Module",
+		"description": "deterministic vectors derived from the hashed words of a text",
+	}, nil
+}
+
+func vectorize(text string, dimensions int) []float32 {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(words) == 0 {
+		// texts without words still need a valid, non-zero vector
+		words = []string{text}
+	}
+
+	vector := make([]float32, dimensions)
+	for _, word := range words {
+		h := fnv.New64a()
+		h.Write([]byte(word))
+		state := h.Sum64()
+		for i := range vector {
+			vector[i] += nextUniform(&state)
+		}
+	}
+
+	return normalize(vector)
+}
+
+// nextUniform returns the next value of a splitmix64 sequence mapped to
+// [-1, 1). The generator is implemented here rather than taken from math/rand
+// so that the vectors never change between Go versions.
+func nextUniform(state *uint64) float32 {
+	*state += 0x9e3779b97f4a7c15
+	z := *state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z = z ^ (z >> 31)
+
+	// use the upper 24 bits, which a float32 can represent exactly
+	return float32(z>>40)/float32(1<<23) - 1
+}
+
+func normalize(vector []float32) []float32 {
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	norm = math.Sqrt(norm)
+	if norm == 0 {
+		return vector
+	}
+
+	for i := range vector {
+		vector[i] = float32(float64(vector[i]) / norm)
+	}
+	return vector
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMock(t *testing.T) {
+	m := New()
+
+	vectorize := func(t *testing.T, text string, dims int) []float32 {
+		res, err := m.Vectorize(context.Background(), text, dims)
+		require.Nil(t, err)
+		require.Len(t, res.Vector, dims)
+		return res.Vector
+	}
+
+	t.Run("vectors are deterministic", func(t *testing.T) {
+		assert.Equal(t, vectorize(t, "hello world", 32), vectorize(t, "hello world", 32))
+	})
+
+	t.Run("vectors ignore casing and punctuation", func(t *testing.T) {
+		assert.Equal(t, vectorize(t, "hello world", 32), vectorize(t, "Hello, World!", 32))
+	})
+
+	t.Run("vectors are normalized", func(t *testing.T) {
+		for _, text := range []string{"hello", "hello world", "", "!!!"} {
+			assert.InDelta(t, 1, dot(vectorize(t, text, 64), vectorize(t, text, 64)), 1e-5)
+		}
+	})
+
+	t.Run("texts sharing words are more similar", func(t *testing.T) {
+		query := vectorize(t, "red car", 128)
+		similar := vectorize(t, "a red car for sale", 128)
+		different := vectorize(t, "the history of rome", 128)
+
+		assert.Greater(t, dot(query, similar), dot(query, different))
+	})
+
+	t.Run("with invalid dimensions", func(t *testing.T) {
+		_, err := m.Vectorize(context.Background(), "hello", 0)
+		require.NotNil(t, err)
+		assert.Equal(t, "dimensions must be a positive integer, got 0", err.Error())
+	})
+}
+
+func dot(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
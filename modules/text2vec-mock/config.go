@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modmock
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/modules/text2vec-mock/config"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func (m *MockModule) ClassConfigDefaults() map[string]interface{} {
+	return map[string]interface{}{
+		"vectorizeClassName": config.DefaultVectorizeClassName,
+		"dimensions":         config.DefaultDimensions,
+	}
+}
+
+func (m *MockModule) PropertyConfigDefaults(
+	dt *schema.DataType,
+) map[string]interface{} {
+	return map[string]interface{}{
+		"skip":                  !config.DefaultPropertyIndexed,
+		"vectorizePropertyName": config.DefaultVectorizePropertyName,
+	}
+}
+
+func (m *MockModule) ValidateClass(ctx context.Context,
+	class *models.Class, cfg moduletools.ClassConfig,
+) error {
+	settings := config.NewClassSettings(cfg)
+	return settings.Validate(class)
+}
+
+func (m *MockModule) VectorDimensions(cfg moduletools.ClassConfig) (int, bool) {
+	return config.NewClassSettings(cfg).Dimensions(), true
+}
+
+var (
+	_ = modulecapabilities.ClassConfigurator(New())
+	_ = modulecapabilities.VectorDimensionsProvider(New())
+)
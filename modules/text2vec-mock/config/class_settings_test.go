@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "Test",
+		Properties: []*models.Property{
+			{
+				DataType: []string{"text"},
+				Name:     "test",
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		class   *models.Class
+		cfg     moduletools.ClassConfig
+		wantErr error
+	}{
+		{
+			name:  "happy flow",
+			class: class,
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "empty config",
+			class:   class,
+			cfg:     nil,
+			wantErr: errors.Errorf("empty config"),
+		},
+		{
+			name:  "custom dimensions",
+			class: class,
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"dimensions": json.Number("16"),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "invalid dimensions",
+			class: class,
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"dimensions": float64(0),
+				},
+			},
+			wantErr: errors.Errorf("dimensions must be between 1 and 65535, got 0"),
+		},
+		{
+			name: "nothing to vectorize",
+			class: &models.Class{
+				Class: "Test",
+				Properties: []*models.Property{
+					{
+						DataType: []string{"int"},
+						Name:     "test",
+					},
+				},
+			},
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"vectorizeClassName": false,
+				},
+			},
+			wantErr: errors.Errorf("invalid properties: didn't find a single property which is " +
+				"of type string or text and is not excluded from indexing. In addition the " +
+				"class name is excluded from vectorization as well, meaning that it cannot be " +
+				"used to determine the vector position. To fix this, set 'vectorizeClassName' " +
+				"to true if the class name is contextionary-valid. Alternatively add at least " +
+				"contextionary-valid text/string property which is not excluded from " +
+				"indexing"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(tt.class), tt.wantErr.Error())
+			} else {
+				assert.Nil(t, ic.Validate(tt.class))
+			}
+		})
+	}
+}
+
+func Test_classSettings_Dimensions(t *testing.T) {
+	t.Run("with a nil config", func(t *testing.T) {
+		assert.Equal(t, DefaultDimensions, NewClassSettings(nil).Dimensions())
+	})
+
+	t.Run("with defaults", func(t *testing.T) {
+		ic := NewClassSettings(fakeClassConfig{classConfig: map[string]interface{}{}})
+		assert.Equal(t, DefaultDimensions, ic.Dimensions())
+	})
+
+	t.Run("with custom dimensions", func(t *testing.T) {
+		ic := NewClassSettings(fakeClassConfig{classConfig: map[string]interface{}{
+			"dimensions": json.Number("16"),
+		}})
+		assert.Equal(t, 16, ic.Dimensions())
+	})
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/modules/text2vec-ollama/ent"
+)
+
+func buildURL(apiEndpoint string) (string, error) {
+	return url.JoinPath(apiEndpoint, "/api/embeddings")
+}
+
+type ollama struct {
+	httpClient   *http.Client
+	urlBuilderFn func(apiEndpoint string) (string, error)
+	logger       logrus.FieldLogger
+}
+
+func New(logger logrus.FieldLogger) *ollama {
+	return &ollama{
+		httpClient: &http.Client{
+			// local models may need a while to be loaded on the first request
+			Timeout: 120 * time.Second,
+		},
+		urlBuilderFn: buildURL,
+		logger:       logger,
+	}
+}
+
+func (v *ollama) Vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config)
+}
+
+func (v *ollama) VectorizeQuery(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config)
+}
+
+func (v *ollama) vectorize(ctx context.Context, input []string, config ent.VectorizationConfig) (*ent.VectorizationResult, error) {
+	endpointURL, err := v.urlBuilderFn(config.ApiEndpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "join Ollama API host and path")
+	}
+
+	body, err := json.Marshal(embeddingsRequest{
+		Model:  config.Model,
+		Prompt: input[0],
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpointURL,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody embeddingsResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode != 200 || resBody.Error != "" {
+		if resBody.Error != "" {
+			return nil, fmt.Errorf("connection to Ollama failed with status: %d error: %v",
+				res.StatusCode, resBody.Error)
+		}
+		return nil, fmt.Errorf("connection to Ollama failed with status: %d", res.StatusCode)
+	}
+
+	if len(resBody.Embedding) == 0 {
+		return nil, errors.Errorf("empty embeddings response")
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input[0],
+		Dimensions: len(resBody.Embedding),
+		Vector:     resBody.Embedding,
+	}, nil
+}
+
+type embeddingsRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+}
+
+type embeddingsResponse struct {
+	Embedding []float32 `json:"embedding,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2vec-ollama/ent"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("when all is fine", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New(nullLogger())
+
+		expected := &ent.VectorizationResult{
+			Text:       "This is my text",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Dimensions: 3,
+		}
+		res, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{
+				ApiEndpoint: server.URL,
+				Model:       "nomic-embed-text",
+			})
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, "/api/embeddings", handler.lastPath)
+		assert.Equal(t, embeddingsRequest{
+			Model:  "nomic-embed-text",
+			Prompt: "This is my text",
+		}, handler.lastRequest)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New(nullLogger())
+
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, []string{"This is my text"},
+			ent.VectorizationConfig{ApiEndpoint: server.URL})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: errors.Errorf(`model "llama" not found, try pulling it first`),
+		})
+		defer server.Close()
+		c := New(nullLogger())
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{ApiEndpoint: server.URL, Model: "llama"})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to Ollama failed with status: 500 "+
+			`error: model "llama" not found, try pulling it first`)
+	})
+}
+
+type fakeHandler struct {
+	t           *testing.T
+	serverError error
+	lastPath    string
+	lastRequest embeddingsRequest
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastPath = r.URL.Path
+
+	if f.serverError != nil {
+		outBytes, err := json.Marshal(&embeddingsResponse{Error: f.serverError.Error()})
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastRequest))
+	assert.Greater(f.t, len(f.lastRequest.Prompt), 0)
+
+	outBytes, err := json.Marshal(&embeddingsResponse{
+		Embedding: []float32{0.1, 0.2, 0.3},
+	})
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
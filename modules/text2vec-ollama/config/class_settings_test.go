@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "Test",
+		Properties: []*models.Property{
+			{
+				DataType: []string{"text"},
+				Name:     "test",
+			},
+		},
+	}
+	tests := []struct {
+		name            string
+		cfg             moduletools.ClassConfig
+		wantApiEndpoint string
+		wantModel       string
+		wantErr         error
+	}{
+		{
+			name: "happy flow",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantApiEndpoint: "http://localhost:11434",
+			wantModel:       "nomic-embed-text",
+			wantErr:         nil,
+		},
+		{
+			name: "custom values",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint": "http://ollama:11434",
+					"model":       "mxbai-embed-large",
+				},
+			},
+			wantApiEndpoint: "http://ollama:11434",
+			wantModel:       "mxbai-embed-large",
+			wantErr:         nil,
+		},
+		{
+			name: "empty model",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model": "",
+				},
+			},
+			wantErr: errors.Errorf("model cannot be empty"),
+		},
+		{
+			name: "all wrong",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint": "ollama:11434",
+					"model":       "",
+				},
+			},
+			wantErr: errors.Errorf(`apiEndpoint must be an http or https URL, got "ollama:11434", ` +
+				"model cannot be empty"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(class), tt.wantErr.Error())
+			} else {
+				assert.Nil(t, ic.Validate(class))
+				assert.Equal(t, tt.wantApiEndpoint, ic.ApiEndpoint())
+				assert.Equal(t, tt.wantModel, ic.Model())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -49,3 +49,9 @@ func additionalFeatureProjectionField(classname string) *graphql.Field {
 		}),
 	}
 }
+
+func additionalVectorizationInputField(classname string) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.String,
+	}
+}
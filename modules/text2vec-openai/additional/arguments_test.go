@@ -53,3 +53,13 @@ func TestFeatureProjectionField(t *testing.T) {
 		assert.NotNil(t, featureProjectionObject.Fields()["vector"])
 	})
 }
+
+func TestVectorizationInputField(t *testing.T) {
+	t.Run("should generate vectorizationInput field properly", func(t *testing.T) {
+		vectorizationInput := additionalVectorizationInputField("Class")
+
+		assert.NotNil(t, vectorizationInput)
+		assert.Equal(t, graphql.String, vectorizationInput.Type)
+		assert.Empty(t, vectorizationInput.Args)
+	})
+}
@@ -14,19 +14,24 @@ package additional
 import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/additional/projector"
+	"github.com/weaviate/weaviate/modules/text2vec-openai/additional/vectorizationinput"
 )
 
 type GraphQLAdditionalArgumentsProvider struct {
-	projector *projector.FeatureProjector
+	projector          *projector.FeatureProjector
+	vectorizationInput *vectorizationinput.VectorizationInput
 }
 
-func New(projector *projector.FeatureProjector) *GraphQLAdditionalArgumentsProvider {
-	return &GraphQLAdditionalArgumentsProvider{projector}
+func New(projector *projector.FeatureProjector,
+	vectorizationInput *vectorizationinput.VectorizationInput,
+) *GraphQLAdditionalArgumentsProvider {
+	return &GraphQLAdditionalArgumentsProvider{projector, vectorizationInput}
 }
 
 func (p *GraphQLAdditionalArgumentsProvider) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
 	additionalProperties := map[string]modulecapabilities.AdditionalProperty{}
 	additionalProperties["featureProjection"] = p.getFeatureProjection()
+	additionalProperties["vectorizationInput"] = p.getVectorizationInput()
 	return additionalProperties
 }
 
@@ -49,3 +54,24 @@ func (p *GraphQLAdditionalArgumentsProvider) getFeatureProjection() modulecapabi
 		},
 	}
 }
+
+func (p *GraphQLAdditionalArgumentsProvider) getVectorizationInput() modulecapabilities.AdditionalProperty {
+	return modulecapabilities.AdditionalProperty{
+		RestNames: []string{
+			"vectorizationInput",
+			"vectorizationinput",
+			"vectorization-input",
+			"vectorization_input",
+		},
+		DefaultValue:           p.vectorizationInput.AdditionalPropertyDefaultValue(),
+		GraphQLNames:           []string{"vectorizationInput"},
+		GraphQLFieldFunction:   additionalVectorizationInputField,
+		GraphQLExtractFunction: p.vectorizationInput.ExtractAdditionalFn,
+		SearchFunctions: modulecapabilities.AdditionalSearch{
+			ObjectGet:   p.vectorizationInput.AdditionalPropertyFn,
+			ObjectList:  p.vectorizationInput.AdditionalPropertyFn,
+			ExploreGet:  p.vectorizationInput.AdditionalPropertyFn,
+			ExploreList: p.vectorizationInput.AdditionalPropertyFn,
+		},
+	}
+}
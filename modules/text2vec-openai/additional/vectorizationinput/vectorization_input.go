@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizationinput
+
+import (
+	"context"
+
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+	localvectorizer "github.com/weaviate/weaviate/modules/text2vec-openai/vectorizer"
+)
+
+type inputBuilder interface {
+	Input(className string, schema interface{},
+		settings localvectorizer.ClassSettings) string
+}
+
+// VectorizationInput adds the text which the module sends to OpenAI to
+// vectorize an object to the results, without calling OpenAI. It helps to
+// understand why objects end up with similar vectors.
+type VectorizationInput struct {
+	builder inputBuilder
+}
+
+func New(builder inputBuilder) *VectorizationInput {
+	return &VectorizationInput{builder}
+}
+
+func (e *VectorizationInput) AdditionalPropertyDefaultValue() interface{} {
+	return true
+}
+
+func (e *VectorizationInput) AdditionalPropertyFn(ctx context.Context,
+	in []search.Result, params interface{}, limit *int,
+	argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig,
+) ([]search.Result, error) {
+	settings := localvectorizer.NewClassSettings(cfg)
+	for i := range in {
+		ap := in[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
+		}
+		ap["vectorizationInput"] = e.builder.Input(in[i].ClassName, in[i].Schema, settings)
+		in[i].AdditionalProperties = ap
+	}
+	return in, nil
+}
+
+func (e *VectorizationInput) ExtractAdditionalFn(param []*ast.Argument) interface{} {
+	return true
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizationinput
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	localvectorizer "github.com/weaviate/weaviate/modules/text2vec-openai/vectorizer"
+)
+
+func TestVectorizationInput(t *testing.T) {
+	t.Run("adds the input of every result", func(t *testing.T) {
+		v := New(localvectorizer.New(nil, 0))
+		in := []search.Result{
+			{
+				ClassName: "Car",
+				Schema: map[string]interface{}{
+					"brand": "Mercedes",
+					"color": "Black",
+				},
+			},
+			{
+				ClassName:            "SuperCar",
+				AdditionalProperties: models.AdditionalProperties{"certainty": 0.9},
+			},
+		}
+
+		res, err := v.AdditionalPropertyFn(context.Background(), in, nil, nil, nil,
+			fakeClassConfig{})
+
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, "car mercedes black", res[0].AdditionalProperties["vectorizationInput"])
+		assert.Equal(t, "super car", res[1].AdditionalProperties["vectorizationInput"])
+		assert.Equal(t, 0.9, res[1].AdditionalProperties["certainty"])
+	})
+
+	t.Run("respects the class settings", func(t *testing.T) {
+		v := New(localvectorizer.New(nil, 0))
+		in := []search.Result{{
+			ClassName: "Car",
+			Schema: map[string]interface{}{
+				"brand": "Mercedes",
+			},
+		}}
+
+		res, err := v.AdditionalPropertyFn(context.Background(), in, nil, nil, nil,
+			fakeClassConfig{classConfig: map[string]interface{}{
+				"vectorizeClassName": false,
+			}})
+
+		require.Nil(t, err)
+		assert.Equal(t, "mercedes", res[0].AdditionalProperties["vectorizationInput"])
+	})
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
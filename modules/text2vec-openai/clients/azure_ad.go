@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultAzureAuthorityHost   = "https://login.microsoftonline.com"
+	azureCognitiveServicesScope = "https://cognitiveservices.azure.com/.default"
+	// tokens are refreshed ahead of their expiry, so that they don't expire
+	// while a request is in flight
+	azureTokenRefreshMargin = 5 * time.Minute
+)
+
+// AzureADCredentials identify the service principal which requests tokens
+// from Azure AD. Either the ClientSecret (client credentials flow) or the
+// FederatedTokenFile (workload identity) need to be set.
+type AzureADCredentials struct {
+	TenantID           string
+	ClientID           string
+	ClientSecret       string
+	FederatedTokenFile string
+	AuthorityHost      string
+}
+
+func (c AzureADCredentials) isSet() bool {
+	return c.TenantID != "" && c.ClientID != "" &&
+		(c.ClientSecret != "" || c.FederatedTokenFile != "")
+}
+
+// azureADTokenSource requests access tokens for Azure OpenAI from Azure AD
+// and caches them until shortly before they expire
+type azureADTokenSource struct {
+	sync.Mutex
+	credentials AzureADCredentials
+	token       string
+	expiresAt   time.Time
+	now         func() time.Time
+}
+
+type azureADTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func newAzureADTokenSource(credentials AzureADCredentials) *azureADTokenSource {
+	return &azureADTokenSource{
+		credentials: credentials,
+		now:         time.Now,
+	}
+}
+
+func (s *azureADTokenSource) Token(ctx context.Context, httpClient *http.Client) (string, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.token != "" && s.now().Add(azureTokenRefreshMargin).Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	if !s.credentials.isSet() {
+		return "", errors.New("no Azure AD credentials found, set AZURE_TENANT_ID, AZURE_CLIENT_ID " +
+			"and either AZURE_CLIENT_SECRET or AZURE_FEDERATED_TOKEN_FILE")
+	}
+
+	form, err := s.tokenRequestForm()
+	if err != nil {
+		return "", err
+	}
+
+	authorityHost := s.credentials.AuthorityHost
+	if authorityHost == "" {
+		authorityHost = DefaultAzureAuthorityHost
+	}
+	endpoint, err := url.JoinPath(authorityHost, s.credentials.TenantID, "oauth2/v2.0/token")
+	if err != nil {
+		return "", errors.Wrap(err, "join Azure AD host and path")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "create POST request")
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "read response body")
+	}
+
+	var resBody azureADTokenResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return "", errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode != 200 || resBody.AccessToken == "" {
+		if resBody.Error != "" {
+			return "", fmt.Errorf("connection to: Azure AD failed with status: %d error: %s: %s",
+				res.StatusCode, resBody.Error, resBody.ErrorDescription)
+		}
+		return "", fmt.Errorf("connection to: Azure AD failed with status: %d", res.StatusCode)
+	}
+
+	s.token = resBody.AccessToken
+	s.expiresAt = s.now().Add(time.Duration(resBody.ExpiresIn) * time.Second)
+	return s.token, nil
+}
+
+func (s *azureADTokenSource) tokenRequestForm() (url.Values, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", s.credentials.ClientID)
+	form.Set("scope", azureCognitiveServicesScope)
+
+	if s.credentials.ClientSecret != "" {
+		form.Set("client_secret", s.credentials.ClientSecret)
+		return form, nil
+	}
+
+	// the federated token is rotated by the platform, so it is read anew on
+	// every refresh
+	assertion, err := os.ReadFile(s.credentials.FederatedTokenFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "read federated token file")
+	}
+	form.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	form.Set("client_assertion", strings.TrimSpace(string(assertion)))
+	return form, nil
+}
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAzureADTokenSource(t *testing.T) {
+	t.Run("client credentials", func(t *testing.T) {
+		handler := &fakeTokenHandler{t: t, token: "token", expiresIn: 3600}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		s := newAzureADTokenSource(AzureADCredentials{
+			TenantID:      "tenant",
+			ClientID:      "client",
+			ClientSecret:  "secret",
+			AuthorityHost: server.URL,
+		})
+
+		token, err := s.Token(context.Background(), server.Client())
+		require.Nil(t, err)
+		assert.Equal(t, "token", token)
+		assert.Equal(t, "/tenant/oauth2/v2.0/token", handler.lastPath)
+		assert.Equal(t, "client_credentials", handler.lastForm.Get("grant_type"))
+		assert.Equal(t, "client", handler.lastForm.Get("client_id"))
+		assert.Equal(t, "secret", handler.lastForm.Get("client_secret"))
+		assert.Equal(t, azureCognitiveServicesScope, handler.lastForm.Get("scope"))
+	})
+
+	t.Run("workload identity", func(t *testing.T) {
+		handler := &fakeTokenHandler{t: t, token: "token", expiresIn: 3600}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.Nil(t, os.WriteFile(tokenFile, []byte("federated-token\n"), 0o600))
+
+		s := newAzureADTokenSource(AzureADCredentials{
+			TenantID:           "tenant",
+			ClientID:           "client",
+			FederatedTokenFile: tokenFile,
+			AuthorityHost:      server.URL,
+		})
+
+		_, err := s.Token(context.Background(), server.Client())
+		require.Nil(t, err)
+		assert.Equal(t, "federated-token", handler.lastForm.Get("client_assertion"))
+		assert.Equal(t, "urn:ietf:params:oauth:client-assertion-type:jwt-bearer",
+			handler.lastForm.Get("client_assertion_type"))
+		assert.Empty(t, handler.lastForm.Get("client_secret"))
+	})
+
+	t.Run("token is cached until shortly before it expires", func(t *testing.T) {
+		handler := &fakeTokenHandler{t: t, token: "token", expiresIn: 3600}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		now := time.Now()
+		s := newAzureADTokenSource(AzureADCredentials{
+			TenantID:      "tenant",
+			ClientID:      "client",
+			ClientSecret:  "secret",
+			AuthorityHost: server.URL,
+		})
+		s.now = func() time.Time { return now }
+
+		for i := 0; i < 3; i++ {
+			_, err := s.Token(context.Background(), server.Client())
+			require.Nil(t, err)
+		}
+		assert.Equal(t, 1, handler.calls)
+
+		now = now.Add(time.Hour - azureTokenRefreshMargin)
+		handler.Lock()
+		handler.token = "refreshed"
+		handler.Unlock()
+		token, err := s.Token(context.Background(), server.Client())
+		require.Nil(t, err)
+		assert.Equal(t, "refreshed", token)
+		assert.Equal(t, 2, handler.calls)
+	})
+
+	t.Run("when Azure AD returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeTokenHandler{t: t, err: "invalid_client"})
+		defer server.Close()
+
+		s := newAzureADTokenSource(AzureADCredentials{
+			TenantID:      "tenant",
+			ClientID:      "client",
+			ClientSecret:  "wrong",
+			AuthorityHost: server.URL,
+		})
+
+		_, err := s.Token(context.Background(), server.Client())
+		assert.EqualError(t, err, "connection to: Azure AD failed with status: 401 "+
+			"error: invalid_client: invalid_client description")
+	})
+
+	t.Run("when credentials are missing", func(t *testing.T) {
+		s := newAzureADTokenSource(AzureADCredentials{TenantID: "tenant"})
+
+		_, err := s.Token(context.Background(), http.DefaultClient)
+		assert.EqualError(t, err, "no Azure AD credentials found, set AZURE_TENANT_ID, "+
+			"AZURE_CLIENT_ID and either AZURE_CLIENT_SECRET or AZURE_FEDERATED_TOKEN_FILE")
+	})
+}
+
+type fakeTokenHandler struct {
+	sync.Mutex
+	t         *testing.T
+	token     string
+	expiresIn int
+	err       string
+	calls     int
+	lastPath  string
+	lastForm  url.Values
+}
+
+func (f *fakeTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	require.Nil(f.t, r.ParseForm())
+	f.calls++
+	f.lastPath = r.URL.Path
+	f.lastForm = r.PostForm
+
+	if f.err != "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(azureADTokenResponse{
+			Error:            f.err,
+			ErrorDescription: f.err + " description",
+		})
+		return
+	}
+
+	json.NewEncoder(w).Encode(azureADTokenResponse{
+		AccessToken: f.token,
+		ExpiresIn:   f.expiresIn,
+	})
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const moduleName = "text2vec-openai"
+
+// costPer1KTokens are the list prices in USD of the embedding models. Models
+// which are not listed, such as fine-tuned ones, are not accounted for in
+// the estimated cost.
+var costPer1KTokens = map[string]float64{
+	"text-embedding-3-small":        0.00002,
+	"text-embedding-3-large":        0.00013,
+	"text-embedding-ada-002":        0.0001,
+	"text-search-ada-doc-001":       0.004,
+	"text-search-ada-query-001":     0.004,
+	"code-search-ada-code-001":      0.004,
+	"code-search-ada-text-001":      0.004,
+	"text-search-babbage-doc-001":   0.005,
+	"text-search-babbage-query-001": 0.005,
+	"code-search-babbage-code-001":  0.005,
+	"code-search-babbage-text-001":  0.005,
+	"text-search-curie-doc-001":     0.02,
+	"text-search-curie-query-001":   0.02,
+	"text-search-davinci-doc-001":   0.2,
+	"text-search-davinci-query-001": 0.2,
+}
+
+// observeRequest records the latency of a single request and, if it failed,
+// its status code. Requests which didn't receive a response are recorded
+// with the status code "none".
+func observeRequest(config ent.VectorizationConfig, model string,
+	started time.Time, statusCode int, failed bool,
+) {
+	metrics := monitoring.GetMetrics()
+	metrics.ModuleExternalRequestDurations.
+		WithLabelValues(moduleName, config.ClassName, model).
+		Observe(float64(time.Since(started)) / float64(time.Millisecond))
+
+	if !failed {
+		return
+	}
+	code := "none"
+	if statusCode > 0 {
+		code = strconv.Itoa(statusCode)
+	}
+	metrics.ModuleExternalRequestErrors.
+		WithLabelValues(moduleName, config.ClassName, model, code).Inc()
+}
+
+func observeRetries(config ent.VectorizationConfig, model string, retries int) {
+	if retries <= 0 {
+		return
+	}
+	monitoring.GetMetrics().ModuleExternalRequestRetries.
+		WithLabelValues(moduleName, config.ClassName, model).Add(float64(retries))
+}
+
+// observeUsage records the tokens consumed by a successful request and their
+// estimated cost
+func observeUsage(config ent.VectorizationConfig, model string, tokens int) {
+	metrics := monitoring.GetMetrics()
+	metrics.ModuleExternalTokensUsed.
+		WithLabelValues(moduleName, config.ClassName, model).Add(float64(tokens))
+
+	if cost, ok := costPer1KTokens[model]; ok {
+		metrics.ModuleExternalEstimatedCost.
+			WithLabelValues(moduleName, config.ClassName, model).
+			Add(float64(tokens) / 1000 * cost)
+	}
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+func TestMetrics(t *testing.T) {
+	metrics := monitoring.GetMetrics()
+	model := "text-embedding-3-small"
+
+	t.Run("tokens and cost of successful requests", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+
+		_, err := c.VectorizeQuery(context.Background(), []string{"one", "two"},
+			ent.VectorizationConfig{ClassName: "MetricsUsage", Model: model})
+		require.Nil(t, err)
+
+		assert.Equal(t, float64(16), testutil.ToFloat64(
+			metrics.ModuleExternalTokensUsed.WithLabelValues(moduleName, "MetricsUsage", model)))
+		assert.InDelta(t, 16.0/1000*0.00002, testutil.ToFloat64(
+			metrics.ModuleExternalEstimatedCost.WithLabelValues(moduleName, "MetricsUsage", model)), 1e-12)
+		assert.Equal(t, float64(0), testutil.ToFloat64(
+			metrics.ModuleExternalRequestRetries.WithLabelValues(moduleName, "MetricsUsage", model)))
+	})
+
+	t.Run("errors and retries", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:                  t,
+			serverError:        errors.Errorf("rate limit reached"),
+			serverErrorStatus:  http.StatusTooManyRequests,
+			serverErrorRetries: 2,
+		})
+		defer server.Close()
+
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{
+			MaxRetries:   3,
+			InitialDelay: time.Millisecond,
+			MaxDelay:     time.Millisecond,
+		}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+
+		_, err := c.VectorizeQuery(context.Background(), []string{"text"},
+			ent.VectorizationConfig{ClassName: "MetricsRetries", Model: model})
+		require.Nil(t, err)
+
+		assert.Equal(t, float64(2), testutil.ToFloat64(
+			metrics.ModuleExternalRequestErrors.WithLabelValues(moduleName, "MetricsRetries", model, "429")))
+		assert.Equal(t, float64(2), testutil.ToFloat64(
+			metrics.ModuleExternalRequestRetries.WithLabelValues(moduleName, "MetricsRetries", model)))
+		assert.Equal(t, float64(8), testutil.ToFloat64(
+			metrics.ModuleExternalTokensUsed.WithLabelValues(moduleName, "MetricsRetries", model)))
+	})
+
+	t.Run("unknown models are not priced", func(t *testing.T) {
+		observeUsage(ent.VectorizationConfig{ClassName: "MetricsUnknown"}, "ft:custom", 100)
+
+		assert.Equal(t, float64(100), testutil.ToFloat64(
+			metrics.ModuleExternalTokensUsed.WithLabelValues(moduleName, "MetricsUnknown", "ft:custom")))
+		assert.Equal(t, float64(0), testutil.ToFloat64(
+			metrics.ModuleExternalEstimatedCost.WithLabelValues(moduleName, "MetricsUnknown", "ft:custom")))
+	})
+}
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// rateLimiter paces requests so that neither the configured requests per
+// minute nor tokens per minute are exceeded. Capacity is reserved up front,
+// so concurrent callers are served in the order in which they arrived. A
+// limit of 0 means unlimited.
+type rateLimiter struct {
+	sync.Mutex
+	requestsPerMinute float64
+	tokensPerMinute   float64
+
+	// available capacity, may become negative if callers have reserved
+	// capacity ahead of time
+	requests float64
+	tokens   float64
+	last     time.Time
+	now      func() time.Time
+
+	queued   prometheus.Gauge
+	waitTime prometheus.Observer
+}
+
+func newRateLimiter(requestsPerMinute, tokensPerMinute int,
+	queued prometheus.Gauge, waitTime prometheus.Observer,
+) *rateLimiter {
+	return &rateLimiter{
+		requestsPerMinute: float64(requestsPerMinute),
+		tokensPerMinute:   float64(tokensPerMinute),
+		requests:          float64(requestsPerMinute),
+		tokens:            float64(tokensPerMinute),
+		last:              time.Now(),
+		now:               time.Now,
+		queued:            queued,
+		waitTime:          waitTime,
+	}
+}
+
+// wait blocks until the request may be sent or the context expires
+func (l *rateLimiter) wait(ctx context.Context, tokens int) error {
+	delay := l.reserve(tokens)
+	if delay <= 0 {
+		return nil
+	}
+
+	l.queued.Inc()
+	defer l.queued.Dec()
+	before := time.Now()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.release(tokens)
+		return ctx.Err()
+	case <-timer.C:
+		l.waitTime.Observe(float64(time.Since(before) / time.Millisecond))
+		return nil
+	}
+}
+
+// reserve takes the capacity for a single request and returns how long the
+// caller has to wait before sending it
+func (l *rateLimiter) reserve(tokens int) time.Duration {
+	l.Lock()
+	defer l.Unlock()
+
+	l.refill()
+
+	var delay time.Duration
+	if l.requestsPerMinute > 0 {
+		l.requests--
+		delay = maxDuration(delay, deficit(l.requests, l.requestsPerMinute))
+	}
+	if l.tokensPerMinute > 0 {
+		// a single request larger than the limit could never be served,
+		// let it through once the full minute's budget is available
+		l.tokens -= math.Min(float64(tokens), l.tokensPerMinute)
+		delay = maxDuration(delay, deficit(l.tokens, l.tokensPerMinute))
+	}
+
+	return delay
+}
+
+// release returns the capacity of a request which was never sent
+func (l *rateLimiter) release(tokens int) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.refill()
+
+	if l.requestsPerMinute > 0 {
+		l.requests = math.Min(l.requestsPerMinute, l.requests+1)
+	}
+	if l.tokensPerMinute > 0 {
+		l.tokens = math.Min(l.tokensPerMinute,
+			l.tokens+math.Min(float64(tokens), l.tokensPerMinute))
+	}
+}
+
+func (l *rateLimiter) refill() {
+	now := l.now()
+	elapsed := now.Sub(l.last).Minutes()
+	l.last = now
+
+	if l.requestsPerMinute > 0 {
+		l.requests = math.Min(l.requestsPerMinute, l.requests+elapsed*l.requestsPerMinute)
+	}
+	if l.tokensPerMinute > 0 {
+		l.tokens = math.Min(l.tokensPerMinute, l.tokens+elapsed*l.tokensPerMinute)
+	}
+}
+
+func deficit(available, perMinute float64) time.Duration {
+	if available >= 0 {
+		return 0
+	}
+	return time.Duration(-available / perMinute * float64(time.Minute))
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// estimateTokens approximates the number of tokens of the input using the
+// rule of thumb of roughly four characters per token for English text
+func estimateTokens(input []string) int {
+	tokens := 0
+	for i := range input {
+		tokens += (len(input[i]) + 3) / 4
+	}
+	return tokens
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	newLimiter := func(requestsPerMinute, tokensPerMinute int) (*rateLimiter, *time.Time) {
+		l := newRateLimiter(requestsPerMinute, tokensPerMinute,
+			prometheus.NewGauge(prometheus.GaugeOpts{Name: "queued"}),
+			prometheus.NewSummary(prometheus.SummaryOpts{Name: "wait"}))
+		now := time.Now()
+		l.last = now
+		l.now = func() time.Time { return now }
+		return l, &now
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		l, _ := newLimiter(0, 0)
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, time.Duration(0), l.reserve(1000))
+		}
+	})
+
+	t.Run("requests per minute", func(t *testing.T) {
+		l, now := newLimiter(60, 0)
+		for i := 0; i < 60; i++ {
+			require.Equal(t, time.Duration(0), l.reserve(1))
+		}
+		assert.Equal(t, time.Second, l.reserve(1))
+		assert.Equal(t, 2*time.Second, l.reserve(1))
+
+		*now = now.Add(2 * time.Second)
+		assert.Equal(t, time.Second, l.reserve(1))
+	})
+
+	t.Run("tokens per minute", func(t *testing.T) {
+		l, now := newLimiter(0, 600)
+		assert.Equal(t, time.Duration(0), l.reserve(600))
+		assert.Equal(t, 10*time.Second, l.reserve(100))
+
+		*now = now.Add(time.Minute)
+		assert.Equal(t, time.Duration(0), l.reserve(100))
+	})
+
+	t.Run("request larger than tokens per minute", func(t *testing.T) {
+		l, _ := newLimiter(0, 100)
+		assert.Equal(t, time.Duration(0), l.reserve(1000))
+		assert.Equal(t, time.Minute, l.reserve(1000))
+	})
+
+	t.Run("cancelled wait releases capacity", func(t *testing.T) {
+		l, _ := newLimiter(1, 0)
+		require.Nil(t, l.wait(context.Background(), 1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := l.wait(ctx, 1)
+		require.NotNil(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// only the request which was actually sent is accounted for
+		assert.Equal(t, time.Minute, l.reserve(1))
+	})
+}
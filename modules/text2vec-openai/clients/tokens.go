@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/pkoukk/tiktoken-go"
+	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+)
+
+var (
+	encodingsLock sync.Mutex
+	encodings     = map[string]*tiktoken.Tiktoken{}
+)
+
+// getContextWindow returns the name of the tokenizer and the maximum number
+// of input tokens of the given embedding model
+func getContextWindow(model string) (string, int) {
+	if strings.HasSuffix(model, "-001") {
+		// the first generation of embedding models
+		return "r50k_base", 2046
+	}
+	return "cl100k_base", 8191
+}
+
+func getEncoding(name string) (*tiktoken.Tiktoken, error) {
+	encodingsLock.Lock()
+	defer encodingsLock.Unlock()
+
+	if tke, ok := encodings[name]; ok {
+		return tke, nil
+	}
+	tke, err := tiktoken.GetEncoding(name)
+	if err != nil {
+		return nil, err
+	}
+	encodings[name] = tke
+	return tke, nil
+}
+
+// truncateInput trims every text exceeding the model's context window to its
+// last (truncate start) or first (truncate end) tokens
+func truncateInput(input []string, model, truncate string) ([]string, error) {
+	if truncate == "" || truncate == ent.TruncateOff {
+		return input, nil
+	}
+
+	encodingName, maxTokens := getContextWindow(model)
+	tke, err := getEncoding(encodingName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "encoding for model %s", model)
+	}
+
+	truncated := make([]string, len(input))
+	for i := range input {
+		tokens := tke.Encode(input[i], nil, nil)
+		if len(tokens) <= maxTokens {
+			truncated[i] = input[i]
+			continue
+		}
+
+		if truncate == ent.TruncateStart {
+			tokens = tokens[len(tokens)-maxTokens:]
+		} else {
+			tokens = tokens[:maxTokens]
+		}
+		// cutting between the tokens of a multi-byte character leaves
+		// invalid bytes at the edges
+		truncated[i] = strings.ToValidUTF8(tke.Decode(tokens), "")
+	}
+	return truncated, nil
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+)
+
+func Test_truncateInput(t *testing.T) {
+	model := "text-embedding-ada-002"
+	long := "first " + strings.Repeat("word ", 9000) + "last"
+	short := "a short text"
+
+	// tiktoken downloads the BPE ranks of an encoding on first use, there is
+	// nothing to truncate with if they can't be fetched
+	if _, err := getEncoding("cl100k_base"); err != nil {
+		t.Skipf("cl100k_base encoding not available: %v", err)
+	}
+
+	countTokens := func(t *testing.T, text string) int {
+		tke, err := getEncoding("cl100k_base")
+		require.Nil(t, err)
+		return len(tke.Encode(text, nil, nil))
+	}
+
+	t.Run("off", func(t *testing.T) {
+		res, err := truncateInput([]string{long, short}, model, ent.TruncateOff)
+		require.Nil(t, err)
+		assert.Equal(t, []string{long, short}, res)
+	})
+
+	t.Run("start", func(t *testing.T) {
+		res, err := truncateInput([]string{long, short}, model, ent.TruncateStart)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.LessOrEqual(t, countTokens(t, res[0]), 8191)
+		assert.False(t, strings.HasPrefix(res[0], "first"))
+		assert.True(t, strings.HasSuffix(res[0], "last"))
+		assert.Equal(t, short, res[1])
+	})
+
+	t.Run("end", func(t *testing.T) {
+		res, err := truncateInput([]string{long, short}, model, ent.TruncateEnd)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.LessOrEqual(t, countTokens(t, res[0]), 8191)
+		assert.True(t, strings.HasPrefix(res[0], "first"))
+		assert.False(t, strings.HasSuffix(res[0], "last"))
+		assert.Equal(t, short, res[1])
+	})
+}
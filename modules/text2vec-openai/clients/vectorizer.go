@@ -19,23 +19,36 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/batch"
+	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
 type embeddingsRequest struct {
-	Input []string `json:"input"`
-	Model string   `json:"model,omitempty"`
+	Input      []string `json:"input"`
+	Model      string   `json:"model,omitempty"`
+	Dimensions *int64   `json:"dimensions,omitempty"`
 }
 
 type embedding struct {
 	Object string          `json:"object"`
 	Data   []embeddingData `json:"data,omitempty"`
+	Usage  *usage          `json:"usage,omitempty"`
 	Error  *openAIApiError `json:"error,omitempty"`
 }
 
+type usage struct {
+	PromptTokens int `json:"prompt_tokens"`
+	TotalTokens  int `json:"total_tokens"`
+}
+
 type embeddingData struct {
 	Object    string    `json:"object"`
 	Index     int       `json:"index"`
@@ -49,103 +62,476 @@ type openAIApiError struct {
 	Code    string `json:"code"`
 }
 
-func buildUrl(config ent.VectorizationConfig) (string, error) {
+const (
+	DefaultBaseURL    = "https://api.openai.com"
+	defaultApiVersion = "2022-12-01"
+)
+
+func buildUrl(baseURL string, config ent.VectorizationConfig) (string, error) {
 	if config.IsAzure {
 		host := "https://" + config.ResourceName + ".openai.azure.com"
 		path := "openai/deployments/" + config.DeploymentID + "/embeddings"
-		queryParam := "api-version=2022-12-01"
+		apiVersion := config.ApiVersion
+		if apiVersion == "" {
+			apiVersion = defaultApiVersion
+		}
+		queryParam := fmt.Sprintf("api-version=%s", apiVersion)
 		return fmt.Sprintf("%s/%s?%s", host, path, queryParam), nil
 	}
-	host := "https://api.openai.com"
 	path := "/v1/embeddings"
-	return url.JoinPath(host, path)
+	return url.JoinPath(baseURL, path)
 }
 
+const (
+	// DefaultBatchSize is the number of texts sent in a single embeddings
+	// request if not configured otherwise
+	DefaultBatchSize = 100
+	// Azure OpenAI deployments accept at most 16 inputs per request
+	azureMaxBatchSize = 16
+	// upper bound of the estimated tokens of all texts in a single request
+	maxBatchTokens = 300000
+	// how long a batch waits for further texts before it is sent
+	defaultBatchWindow = 10 * time.Millisecond
+)
+
 type vectorizer struct {
-	openAIApiKey string
-	azureApiKey  string
-	httpClient   *http.Client
-	buildUrlFn   func(config ent.VectorizationConfig) (string, error)
-	logger       logrus.FieldLogger
+	openAIApiKey      string
+	azureApiKey       string
+	baseURL           string
+	requestsPerMinute int
+	tokensPerMinute   int
+	batchSize         int
+	batchWindow       time.Duration
+	httpOptions       moduletools.HTTPClientOptions
+	azureAD           *azureADTokenSource
+	retryPolicy       moduletools.RetryPolicy
+	buildUrlFn        func(baseURL string, config ent.VectorizationConfig) (string, error)
+	logger            logrus.FieldLogger
+
+	httpClientsLock sync.Mutex
+	httpClients     map[moduletools.HTTPClientOptions]*http.Client
+
+	rateLimitersLock sync.Mutex
+	rateLimiters     map[string]*rateLimiter
+
+	batchers *batch.Batchers[batchKey, string, embeddingData]
+
+	unavailableModelsLock sync.Mutex
+	unavailableModels     map[string]struct{}
+}
+
+// batchKey identifies the texts which can be sent in the same request
+type batchKey struct {
+	endpoint          string
+	apiKey            string
+	authMode          string
+	className         string
+	model             string
+	organization      string
+	project           string
+	dimensions        int64
+	requestsPerMinute int
+	tokensPerMinute   int
+	requestTimeout    time.Duration
 }
 
-func New(openAIApiKey, azureApiKey string, logger logrus.FieldLogger) *vectorizer {
+func New(openAIApiKey, azureApiKey, baseURL string,
+	requestsPerMinute, tokensPerMinute, batchSize int,
+	httpOptions moduletools.HTTPClientOptions, azureAD AzureADCredentials,
+	retryPolicy moduletools.RetryPolicy, logger logrus.FieldLogger,
+) *vectorizer {
 	return &vectorizer{
-		openAIApiKey: openAIApiKey,
-		azureApiKey:  azureApiKey,
-		httpClient:   &http.Client{},
-		buildUrlFn:   buildUrl,
-		logger:       logger,
+		openAIApiKey:      openAIApiKey,
+		azureApiKey:       azureApiKey,
+		baseURL:           baseURL,
+		requestsPerMinute: requestsPerMinute,
+		tokensPerMinute:   tokensPerMinute,
+		batchSize:         batchSize,
+		batchWindow:       defaultBatchWindow,
+		httpOptions:       httpOptions,
+		azureAD:           newAzureADTokenSource(azureAD),
+		retryPolicy:       retryPolicy,
+		buildUrlFn:        buildUrl,
+		logger:            logger,
+		httpClients:       map[moduletools.HTTPClientOptions]*http.Client{},
+		rateLimiters:      map[string]*rateLimiter{},
+		batchers:          batch.NewBatchers[batchKey, string, embeddingData](batch.DefaultIdleTimeout),
+		unavailableModels: map[string]struct{}{},
 	}
 }
 
 func (v *vectorizer) Vectorize(ctx context.Context, input string,
 	config ent.VectorizationConfig,
 ) (*ent.VectorizationResult, error) {
-	return v.vectorize(ctx, []string{input}, v.getModelString(config.Type, config.Model, "document", config.ModelVersion), config)
+	return v.withFallback(config, "document", func(model string) (*ent.VectorizationResult, error) {
+		if v.batchSize > 1 {
+			return v.vectorizeBatched(ctx, input, model, config)
+		}
+		return v.vectorize(ctx, []string{input}, model, config)
+	})
 }
 
 func (v *vectorizer) VectorizeQuery(ctx context.Context, input []string,
 	config ent.VectorizationConfig,
 ) (*ent.VectorizationResult, error) {
-	return v.vectorize(ctx, input, v.getModelString(config.Type, config.Model, "query", config.ModelVersion), config)
+	return v.withFallback(config, "query", func(model string) (*ent.VectorizationResult, error) {
+		return v.vectorize(ctx, input, model, config)
+	})
+}
+
+// withFallback vectorizes with the configured model and switches over to the
+// fallback model once OpenAI no longer serves the configured one. As models
+// are not brought back after their deprecation, later calls go straight to
+// the fallback model.
+func (v *vectorizer) withFallback(config ent.VectorizationConfig, action string,
+	vectorize func(model string) (*ent.VectorizationResult, error),
+) (*ent.VectorizationResult, error) {
+	model := v.getModelString(config.Type, config.Model, action, config.ModelVersion)
+	if config.FallbackModel == "" {
+		return vectorize(model)
+	}
+	fallbackModel := v.getModelString(config.Type, config.FallbackModel, action, config.FallbackModelVersion)
+
+	v.unavailableModelsLock.Lock()
+	_, unavailable := v.unavailableModels[model]
+	v.unavailableModelsLock.Unlock()
+	if unavailable {
+		return vectorize(fallbackModel)
+	}
+
+	res, err := vectorize(model)
+	var unavailableErr *modelUnavailableError
+	if err == nil || !errors.As(err, &unavailableErr) {
+		return res, err
+	}
+
+	v.unavailableModelsLock.Lock()
+	if _, ok := v.unavailableModels[model]; !ok {
+		v.unavailableModels[model] = struct{}{}
+		v.logger.WithField("module", moduleName).
+			WithField("model", model).
+			WithField("fallback_model", fallbackModel).
+			WithError(err).
+			Warn("model is no longer available, using fallback model instead")
+	}
+	v.unavailableModelsLock.Unlock()
+
+	return vectorize(fallbackModel)
 }
 
 func (v *vectorizer) vectorize(ctx context.Context, input []string, model string, config ent.VectorizationConfig) (*ent.VectorizationResult, error) {
-	body, err := json.Marshal(v.getEmbeddingsRequest(input, model, config.IsAzure))
+	apiKey, endpoint, err := v.getApiKeyAndEndpoint(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err = truncateInput(input, model, config.Truncate)
+	if err != nil {
+		return nil, errors.Wrap(err, "truncate input")
+	}
+
+	data, err := v.embed(ctx, endpoint, apiKey, input, model, config)
+	if err != nil {
+		return nil, err
+	}
+	return v.getVectorizationResult(data), nil
+}
+
+// vectorizeBatched sends the input together with the texts of concurrent
+// calls using the same endpoint and model in a single request
+func (v *vectorizer) vectorizeBatched(ctx context.Context, input string, model string, config ent.VectorizationConfig) (*ent.VectorizationResult, error) {
+	apiKey, endpoint, err := v.getApiKeyAndEndpoint(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	truncated, err := truncateInput([]string{input}, model, config.Truncate)
+	if err != nil {
+		return nil, errors.Wrap(err, "truncate input")
+	}
+
+	data, err := v.getBatcher(endpoint, apiKey, model, config).Vectorize(ctx, truncated[0])
+	if err != nil {
+		return nil, err
+	}
+	return v.getVectorizationResult([]embeddingData{*data}), nil
+}
+
+func (v *vectorizer) getApiKeyAndEndpoint(ctx context.Context, config ent.VectorizationConfig) (string, string, error) {
+	var apiKey string
+	if !isAzureAD(config) {
+		// with Azure AD a token is requested for every request instead
+		var err error
+		apiKey, err = v.getApiKey(ctx, config.IsAzure)
+		if err != nil {
+			return "", "", errors.Wrap(err, "API Key")
+		}
+	}
+
+	endpoint, err := v.buildUrlFn(v.getBaseURL(config), config)
+	if err != nil {
+		return "", "", errors.Wrap(err, "join OpenAI API host and path")
+	}
+	return apiKey, endpoint, nil
+}
+
+func (v *vectorizer) getVectorizationResult(data []embeddingData) *ent.VectorizationResult {
+	texts := make([]string, len(data))
+	embeddings := make([][]float32, len(data))
+	for i := range data {
+		texts[i] = data[i].Object
+		embeddings[i] = data[i].Embedding
+	}
+
+	return &ent.VectorizationResult{
+		Text:       texts,
+		Dimensions: len(data[0].Embedding),
+		Vector:     embeddings,
+	}
+}
+
+// embed returns the embeddings of the input in the order of the input
+func (v *vectorizer) embed(ctx context.Context, endpoint, apiKey string,
+	input []string, model string, config ent.VectorizationConfig,
+) ([]embeddingData, error) {
+	body, err := json.Marshal(v.getEmbeddingsRequest(input, model, config.IsAzure, config.Dimensions))
 	if err != nil {
 		return nil, errors.Wrap(err, "marshal body")
 	}
 
-	endpoint, err := v.buildUrlFn(config)
+	var resBody *embedding
+	attempts := 0
+	err = v.retryPolicy.Do(ctx, func() error {
+		attempts++
+		resBody, err = v.sendRequest(ctx, endpoint, apiKey, body, input, model, config)
+		return err
+	})
+	observeRetries(config, model, attempts-1)
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Usage != nil {
+		observeUsage(config, model, resBody.Usage.TotalTokens)
+	} else {
+		// OpenAI compatible APIs don't necessarily report the usage
+		observeUsage(config, model, estimateTokens(input))
+	}
+
+	if len(resBody.Data) != len(input) {
+		return nil, errors.Errorf("expected %d embeddings, got %d",
+			len(input), len(resBody.Data))
+	}
+	data := make([]embeddingData, len(input))
+	for i := range resBody.Data {
+		index := resBody.Data[i].Index
+		if index < 0 || index >= len(data) {
+			return nil, errors.Errorf("embedding index %d out of range", index)
+		}
+		data[index] = resBody.Data[i]
+	}
+	return data, nil
+}
+
+func (v *vectorizer) sendRequest(ctx context.Context, endpoint, apiKey string,
+	body []byte, input []string, model string, config ent.VectorizationConfig,
+) (*embedding, error) {
+	if err := v.getRateLimiter(endpoint, config).wait(ctx, estimateTokens(input)); err != nil {
+		return nil, errors.Wrap(err, "wait for rate limiter")
+	}
+
+	reqCtx := ctx
+	if config.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		reqCtx, cancel = context.WithTimeout(ctx, config.RequestTimeout)
+		defer cancel()
+	}
+
+	httpClient, err := v.getHTTPClient(config)
 	if err != nil {
-		return nil, errors.Wrap(err, "join OpenAI API host and path")
+		return nil, errors.Wrap(err, "create HTTP client")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", endpoint,
+	req, err := http.NewRequestWithContext(reqCtx, "POST", endpoint,
 		bytes.NewReader(body))
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
-	apiKey, err := v.getApiKey(ctx, config.IsAzure)
-	if err != nil {
-		return nil, errors.Wrap(err, "API Key")
+	if isAzureAD(config) {
+		token, err := v.azureAD.Token(ctx, httpClient)
+		if err != nil {
+			return nil, errors.Wrap(err, "Azure AD token")
+		}
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	} else {
+		req.Header.Add(v.getApiKeyHeaderAndValue(apiKey, config.IsAzure))
 	}
-	req.Header.Add(v.getApiKeyHeaderAndValue(apiKey, config.IsAzure))
 	req.Header.Add("Content-Type", "application/json")
+	if config.Organization != "" {
+		req.Header.Add("OpenAI-Organization", config.Organization)
+	}
+	if config.Project != "" {
+		req.Header.Add("OpenAI-Project", config.Project)
+	}
 
-	res, err := v.httpClient.Do(req)
+	started := time.Now()
+	res, err := httpClient.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "send POST request")
+		observeRequest(config, model, started, 0, true)
+		err = errors.Wrap(err, "send POST request")
+		if ctx.Err() == nil {
+			// connection issues and requests which timed out are worth
+			// another attempt
+			return nil, moduletools.NewRetryableError(err, 0)
+		}
+		return nil, err
 	}
 	defer res.Body.Close()
 
 	bodyBytes, err := io.ReadAll(res.Body)
+	observeRequest(config, model, started, res.StatusCode,
+		err != nil || res.StatusCode != 200)
 	if err != nil {
-		return nil, errors.Wrap(err, "read response body")
+		err = errors.Wrap(err, "read response body")
+		if ctx.Err() == nil && reqCtx.Err() != nil {
+			return nil, moduletools.NewRetryableError(err, 0)
+		}
+		return nil, err
 	}
 
 	var resBody embedding
 	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
-		return nil, errors.Wrap(err, "unmarshal response body")
+		err = errors.Wrap(err, "unmarshal response body")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			// e.g. an HTML error page of a gateway in front of the API
+			return nil, moduletools.NewRetryableError(err, moduletools.RetryAfter(res.Header))
+		}
+		return nil, err
 	}
 
 	if res.StatusCode != 200 || resBody.Error != nil {
-		return nil, v.getError(res.StatusCode, resBody.Error, config.IsAzure)
+		err := v.getError(res.StatusCode, resBody.Error, config.IsAzure)
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(err, moduletools.RetryAfter(res.Header))
+		}
+		if isModelUnavailable(res.StatusCode, resBody.Error) {
+			return nil, &modelUnavailableError{err}
+		}
+		return nil, err
 	}
 
-	texts := make([]string, len(resBody.Data))
-	embeddings := make([][]float32, len(resBody.Data))
-	for i := range resBody.Data {
-		texts[i] = resBody.Data[i].Object
-		embeddings[i] = resBody.Data[i].Embedding
+	return &resBody, nil
+}
+
+// getBaseURL picks the base URL from the class settings first, then from the
+// environment and falls back to the public OpenAI API
+func (v *vectorizer) getBaseURL(config ent.VectorizationConfig) string {
+	if config.BaseURL != "" {
+		return config.BaseURL
 	}
+	if v.baseURL != "" {
+		return v.baseURL
+	}
+	return DefaultBaseURL
+}
 
-	return &ent.VectorizationResult{
-		Text:       texts,
-		Dimensions: len(resBody.Data[0].Embedding),
-		Vector:     embeddings,
-	}, nil
+// getHTTPClient returns the client for the proxy and TLS settings of the
+// class, which take precedence over the ones set in the environment
+func (v *vectorizer) getHTTPClient(config ent.VectorizationConfig) (*http.Client, error) {
+	options := v.httpOptions.Override(config.HTTPClientOptions)
+
+	v.httpClientsLock.Lock()
+	defer v.httpClientsLock.Unlock()
+
+	httpClient, ok := v.httpClients[options]
+	if !ok {
+		transport, err := moduletools.NewHTTPTransport(options)
+		if err != nil {
+			return nil, err
+		}
+		httpClient = &http.Client{Transport: transport}
+		v.httpClients[options] = httpClient
+	}
+	return httpClient, nil
+}
+
+// getRateLimiter returns the limiter shared by all requests against the same
+// endpoint with the same limits. Limits set in the class settings take
+// precedence over the ones set in the environment.
+func (v *vectorizer) getRateLimiter(endpoint string,
+	config ent.VectorizationConfig,
+) *rateLimiter {
+	requestsPerMinute := v.requestsPerMinute
+	if config.RequestsPerMinute > 0 {
+		requestsPerMinute = config.RequestsPerMinute
+	}
+	tokensPerMinute := v.tokensPerMinute
+	if config.TokensPerMinute > 0 {
+		tokensPerMinute = config.TokensPerMinute
+	}
+
+	key := fmt.Sprintf("%s/%d/%d", endpoint, requestsPerMinute, tokensPerMinute)
+
+	v.rateLimitersLock.Lock()
+	defer v.rateLimitersLock.Unlock()
+
+	limiter, ok := v.rateLimiters[key]
+	if !ok {
+		metrics := monitoring.GetMetrics()
+		limiter = newRateLimiter(requestsPerMinute, tokensPerMinute,
+			metrics.ModuleExternalRequestsQueued.WithLabelValues(moduleName),
+			metrics.ModuleExternalRequestWaitDurations.WithLabelValues(moduleName))
+		v.rateLimiters[key] = limiter
+	}
+	return limiter
+}
+
+// getBatcher returns the batcher collecting the texts which are sent to the
+// same endpoint with the same model and limits. Batchers of api keys which
+// haven't been used for a while are dropped.
+func (v *vectorizer) getBatcher(endpoint, apiKey, model string,
+	config ent.VectorizationConfig,
+) *batch.Batcher[string, embeddingData] {
+	key := batchKey{
+		endpoint:          endpoint,
+		apiKey:            apiKey,
+		authMode:          config.AuthMode,
+		className:         config.ClassName,
+		model:             model,
+		organization:      config.Organization,
+		project:           config.Project,
+		requestsPerMinute: config.RequestsPerMinute,
+		tokensPerMinute:   config.TokensPerMinute,
+		requestTimeout:    config.RequestTimeout,
+	}
+	if config.Dimensions != nil {
+		key.dimensions = *config.Dimensions
+	}
+
+	return v.batchers.Get(key, func() *batch.Batcher[string, embeddingData] {
+		batchSize := v.batchSize
+		if config.IsAzure && batchSize > azureMaxBatchSize {
+			batchSize = azureMaxBatchSize
+		}
+		// a single request must not exceed the tokens per minute, otherwise
+		// it would never pass the rate limiter without waiting
+		batchTokens := maxBatchTokens
+		tokensPerMinute := v.tokensPerMinute
+		if config.TokensPerMinute > 0 {
+			tokensPerMinute = config.TokensPerMinute
+		}
+		if tokensPerMinute > 0 && tokensPerMinute < batchTokens {
+			batchTokens = tokensPerMinute
+		}
+
+		b := batch.NewBatcher(batchSize, v.batchWindow,
+			func(ctx context.Context, input []string) ([]embeddingData, error) {
+				return v.embed(ctx, endpoint, apiKey, input, model, config)
+			})
+		return b.LimitTokens(batchTokens, func(text string) int {
+			return estimateTokens([]string{text})
+		})
+	})
 }
 
 func (v *vectorizer) getError(statusCode int, resBodyError *openAIApiError, isAzure bool) error {
@@ -159,11 +545,37 @@ func (v *vectorizer) getError(statusCode int, resBodyError *openAIApiError, isAz
 	return fmt.Errorf("connection to: %s failed with status: %d", endpoint, statusCode)
 }
 
-func (v *vectorizer) getEmbeddingsRequest(input []string, model string, isAzure bool) embeddingsRequest {
+// modelUnavailableError is returned if OpenAI doesn't serve the requested
+// model, e.g. because it was deprecated
+type modelUnavailableError struct {
+	err error
+}
+
+func (e *modelUnavailableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *modelUnavailableError) Unwrap() error {
+	return e.err
+}
+
+func isModelUnavailable(statusCode int, resBodyError *openAIApiError) bool {
+	if resBodyError == nil {
+		return false
+	}
+	if resBodyError.Code == "model_not_found" {
+		return true
+	}
+	message := strings.ToLower(resBodyError.Message)
+	return strings.Contains(message, "deprecated") ||
+		(statusCode == http.StatusNotFound && strings.Contains(message, "does not exist"))
+}
+
+func (v *vectorizer) getEmbeddingsRequest(input []string, model string, isAzure bool, dimensions *int64) embeddingsRequest {
 	if isAzure {
-		return embeddingsRequest{Input: input}
+		return embeddingsRequest{Input: input, Dimensions: dimensions}
 	}
-	return embeddingsRequest{Input: input, Model: model}
+	return embeddingsRequest{Input: input, Model: model, Dimensions: dimensions}
 }
 
 func (v *vectorizer) getApiKeyHeaderAndValue(apiKey string, isAzure bool) (string, string) {
@@ -173,36 +585,46 @@ func (v *vectorizer) getApiKeyHeaderAndValue(apiKey string, isAzure bool) (strin
 	return "Authorization", fmt.Sprintf("Bearer %s", apiKey)
 }
 
+// isAzureAD tells whether requests are authenticated with Azure AD tokens
+// instead of an api key
+func isAzureAD(config ent.VectorizationConfig) bool {
+	return config.IsAzure && config.AuthMode == ent.AuthModeAzureAD
+}
+
 func (v *vectorizer) getApiKey(ctx context.Context, isAzure bool) (string, error) {
-	var apiKey, envVar string
+	var apiKey, envVar, envApiKey string
 
 	if isAzure {
 		apiKey = "X-Azure-Api-Key"
 		envVar = "AZURE_APIKEY"
-		if len(v.azureApiKey) > 0 {
-			return v.azureApiKey, nil
-		}
+		envApiKey = v.azureApiKey
 	} else {
 		apiKey = "X-Openai-Api-Key"
 		envVar = "OPENAI_APIKEY"
-		if len(v.openAIApiKey) > 0 {
-			return v.openAIApiKey, nil
-		}
+		envApiKey = v.openAIApiKey
 	}
 
-	return v.getApiKeyFromContext(ctx, apiKey, envVar)
-}
-
-func (v *vectorizer) getApiKeyFromContext(ctx context.Context, apiKey, envVar string) (string, error) {
-	if apiValue := ctx.Value(apiKey); apiValue != nil {
-		if apiKeyHeader, ok := apiValue.([]string); ok && len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
-			return apiKeyHeader[0], nil
-		}
+	// a key passed with the request takes precedence over the one the
+	// server was started with
+	if apiKeyValue := moduletools.GetValueFromContext(ctx, apiKey); apiKeyValue != "" {
+		return apiKeyValue, nil
+	}
+	if envApiKey != "" {
+		return envApiKey, nil
 	}
 	return "", fmt.Errorf("no api key found neither in request header: %s nor in environment variable under %s", apiKey, envVar)
 }
 
+// legacyModels are addressed by a name which is composed of the model, the
+// document type and the model version
+var legacyModels = []string{"ada", "babbage", "curie", "davinci"}
+
 func (v *vectorizer) getModelString(docType, model, action, version string) string {
+	if !isLegacyModel(model) {
+		// v3 models and unknown models are addressed by their full name
+		return model
+	}
+
 	if version == "002" {
 		return v.getModel002String(model)
 	}
@@ -210,6 +632,15 @@ func (v *vectorizer) getModelString(docType, model, action, version string) stri
 	return v.getModel001String(docType, model, action)
 }
 
+func isLegacyModel(model string) bool {
+	for i := range legacyModels {
+		if model == legacyModels[i] {
+			return true
+		}
+	}
+	return false
+}
+
 func (v *vectorizer) getModel001String(docType, model, action string) string {
 	modelBaseString := "%s-search-%s-%s-001"
 	if action == "document" {
@@ -17,6 +17,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
 )
 
@@ -33,8 +35,8 @@ func TestClient(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
 
-		c := New("apiKey", "", nullLogger())
-		c.buildUrlFn = func(config ent.VectorizationConfig) (string, error) {
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
 			return server.URL, nil
 		}
 
@@ -53,11 +55,77 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("when dimensions are configured", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+
+		dimensions := int64(512)
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{
+				Model:      "text-embedding-3-small",
+				Dimensions: &dimensions,
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, "text-embedding-3-small", handler.lastRequest["model"])
+		assert.Equal(t, float64(512), handler.lastRequest["dimensions"])
+	})
+
+	t.Run("when organization and project are configured", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{
+				Model:        "ada",
+				Organization: "org-AbC",
+				Project:      "proj_XyZ",
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, "org-AbC", handler.lastHeader.Get("OpenAI-Organization"))
+		assert.Equal(t, "proj_XyZ", handler.lastHeader.Get("OpenAI-Project"))
+	})
+
+	t.Run("when a custom base URL is configured", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+
+		c := New("apiKey", "", "http://env-configured.example", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		var usedBaseURL string
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			usedBaseURL = baseURL
+			return server.URL, nil
+		}
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{Model: "ada", BaseURL: "http://class-configured.example"})
+		require.Nil(t, err)
+		assert.Equal(t, "http://class-configured.example", usedBaseURL)
+
+		_, err = c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{Model: "ada"})
+		require.Nil(t, err)
+		assert.Equal(t, "http://env-configured.example", usedBaseURL)
+	})
+
 	t.Run("when the context is expired", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
-		c := New("apiKey", "", nullLogger())
-		c.buildUrlFn = func(config ent.VectorizationConfig) (string, error) {
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
 			return server.URL, nil
 		}
 
@@ -70,14 +138,30 @@ func TestClient(t *testing.T) {
 		assert.Contains(t, err.Error(), "context deadline exceeded")
 	})
 
+	t.Run("when the request times out", func(t *testing.T) {
+		handler := &fakeHandler{t: t, delay: 100 * time.Millisecond}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{Model: "ada", RequestTimeout: 10 * time.Millisecond})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
 	t.Run("when the server returns an error", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{
 			t:           t,
 			serverError: errors.Errorf("nope, not gonna happen"),
 		})
 		defer server.Close()
-		c := New("apiKey", "", nullLogger())
-		c.buildUrlFn = func(config ent.VectorizationConfig) (string, error) {
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
 			return server.URL, nil
 		}
 
@@ -88,11 +172,73 @@ func TestClient(t *testing.T) {
 		assert.EqualError(t, err, "connection to: OpenAI API failed with status: 500 error: nope, not gonna happen")
 	})
 
+	t.Run("when the server returns a transient error", func(t *testing.T) {
+		handler := &fakeHandler{
+			t:                  t,
+			serverError:        errors.Errorf("rate limit reached"),
+			serverErrorStatus:  http.StatusTooManyRequests,
+			serverErrorRetries: 2,
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{
+			MaxRetries:   3,
+			InitialDelay: time.Millisecond,
+			MaxDelay:     time.Millisecond,
+		}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+
+		res, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{
+				Type:  "text",
+				Model: "ada",
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{0.1, 0.2, 0.3}}, res.Vector)
+		assert.Equal(t, 3, handler.calls)
+	})
+
+	t.Run("when texts are vectorized concurrently", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("apiKey", "", "", 0, 0, 3, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.batchWindow = time.Minute
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+
+		inputs := []string{"first text", "second text", "third text"}
+		results := make([]*ent.VectorizationResult, len(inputs))
+		errs := make([]error, len(inputs))
+		wg := sync.WaitGroup{}
+		for i := range inputs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], errs[i] = c.Vectorize(context.Background(), inputs[i],
+					ent.VectorizationConfig{Type: "text", Model: "ada"})
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range inputs {
+			require.Nil(t, errs[i])
+			assert.Equal(t, []string{inputs[i]}, results[i].Text)
+			assert.Equal(t, [][]float32{{0.1, 0.2, 0.3}}, results[i].Vector)
+		}
+		assert.Equal(t, 1, handler.calls)
+		assert.Len(t, handler.lastRequest["input"], 3)
+	})
+
 	t.Run("when OpenAI key is passed using X-Openai-Api-Key header", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
-		c := New("", "", nullLogger())
-		c.buildUrlFn = func(config ent.VectorizationConfig) (string, error) {
+		c := New("", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
 			return server.URL, nil
 		}
 
@@ -114,11 +260,94 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("when X-Openai-Api-Key header overrides the server key", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("server-key", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Openai-Api-Key", []string{"request-key"})
+
+		_, err := c.Vectorize(ctxWithValue, "This is my text",
+			ent.VectorizationConfig{
+				Type:  "text",
+				Model: "ada",
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, "Bearer request-key", handler.lastAuthorization)
+	})
+
+	t.Run("when Azure AD authentication is configured", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		tokenServer := httptest.NewServer(&fakeTokenHandler{t: t, token: "ad-token", expiresIn: 3600})
+		defer tokenServer.Close()
+
+		c := New("", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{
+			TenantID:      "tenant",
+			ClientID:      "client",
+			ClientSecret:  "secret",
+			AuthorityHost: tokenServer.URL,
+		}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{
+				ResourceName: "resource",
+				DeploymentID: "deployment",
+				IsAzure:      true,
+				AuthMode:     ent.AuthModeAzureAD,
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, "Bearer ad-token", handler.lastAuthorization)
+	})
+
+	t.Run("when the model is no longer available", func(t *testing.T) {
+		handler := &fakeHandler{t: t, unavailableModel: "text-search-ada-query-001"}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
+			return server.URL, nil
+		}
+		config := ent.VectorizationConfig{
+			Type:          "text",
+			Model:         "ada",
+			ModelVersion:  "001",
+			FallbackModel: "text-embedding-3-small",
+		}
+
+		res, err := c.VectorizeQuery(context.Background(), []string{"This is my text"}, config)
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{0.1, 0.2, 0.3}}, res.Vector)
+		assert.Equal(t, "text-embedding-3-small", handler.lastRequest["model"])
+		assert.Equal(t, 2, handler.calls)
+
+		// the unavailable model is not requested again
+		_, err = c.VectorizeQuery(context.Background(), []string{"This is my text"}, config)
+		require.Nil(t, err)
+		assert.Equal(t, 3, handler.calls)
+
+		config.FallbackModel = ""
+		_, err = c.VectorizeQuery(context.Background(), []string{"This is my text"}, config)
+		assert.EqualError(t, err, "connection to: OpenAI API failed with status: 404 "+
+			"error: The model `text-search-ada-query-001` does not exist")
+	})
+
 	t.Run("when OpenAI key is empty", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
-		c := New("", "", nullLogger())
-		c.buildUrlFn = func(config ent.VectorizationConfig) (string, error) {
+		c := New("", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
 			return server.URL, nil
 		}
 
@@ -136,8 +365,8 @@ func TestClient(t *testing.T) {
 	t.Run("when X-Openai-Api-Key header is passed but empty", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
-		c := New("", "", nullLogger())
-		c.buildUrlFn = func(config ent.VectorizationConfig) (string, error) {
+		c := New("", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
+		c.buildUrlFn = func(baseURL string, config ent.VectorizationConfig) (string, error) {
 			return server.URL, nil
 		}
 
@@ -160,12 +389,30 @@ func TestClient(t *testing.T) {
 type fakeHandler struct {
 	t           *testing.T
 	serverError error
+	// if set, the server error is only returned for the given number of
+	// calls and with the given status code
+	serverErrorStatus  int
+	serverErrorRetries int
+	calls              int
+	lastRequest        map[string]interface{}
+	lastAuthorization  string
+	lastHeader         http.Header
+	// delay holds back the response
+	delay time.Duration
+	// requests for this model fail as if the model was deprecated
+	unavailableModel string
 }
 
 func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.lastAuthorization = r.Header.Get("Authorization")
+	f.lastHeader = r.Header
+	f.calls++
+	if f.delay > 0 {
+		time.Sleep(f.delay)
+	}
 
-	if f.serverError != nil {
+	if f.serverError != nil && (f.serverErrorRetries == 0 || f.calls <= f.serverErrorRetries) {
 		embeddingError := map[string]interface{}{
 			"message": f.serverError.Error(),
 			"type":    "invalid_request_error",
@@ -176,7 +423,11 @@ func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		outBytes, err := json.Marshal(embedding)
 		require.Nil(f.t, err)
 
-		w.WriteHeader(http.StatusInternalServerError)
+		status := http.StatusInternalServerError
+		if f.serverErrorStatus != 0 {
+			status = f.serverErrorStatus
+		}
+		w.WriteHeader(status)
 		w.Write(outBytes)
 		return
 	}
@@ -187,19 +438,42 @@ func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	var b map[string]interface{}
 	require.Nil(f.t, json.Unmarshal(bodyBytes, &b))
+	f.lastRequest = b
+
+	if f.unavailableModel != "" && b["model"] == f.unavailableModel {
+		outBytes, err := json.Marshal(map[string]interface{}{
+			"error": map[string]interface{}{
+				"message": "The model `" + f.unavailableModel + "` does not exist",
+				"type":    "invalid_request_error",
+				"code":    "model_not_found",
+			},
+		})
+		require.Nil(f.t, err)
 
-	textInputArray := b["input"].([]interface{})
-	textInput := textInputArray[0].(string)
-	assert.Greater(f.t, len(textInput), 0)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write(outBytes)
+		return
+	}
 
-	embeddingData := map[string]interface{}{
-		"object":    textInput,
-		"index":     0,
-		"embedding": []float32{0.1, 0.2, 0.3},
+	textInputArray := b["input"].([]interface{})
+	data := make([]interface{}, len(textInputArray))
+	for i := range textInputArray {
+		textInput := textInputArray[i].(string)
+		assert.Greater(f.t, len(textInput), 0)
+
+		data[i] = map[string]interface{}{
+			"object":    textInput,
+			"index":     i,
+			"embedding": []float32{0.1, 0.2, 0.3},
+		}
 	}
 	embedding := map[string]interface{}{
 		"object": "list",
-		"data":   []interface{}{embeddingData},
+		"data":   data,
+		"usage": map[string]interface{}{
+			"prompt_tokens": 8 * len(data),
+			"total_tokens":  8 * len(data),
+		},
 	}
 
 	outBytes, err := json.Marshal(embedding)
@@ -213,6 +487,41 @@ func nullLogger() logrus.FieldLogger {
 	return l
 }
 
+func Test_buildUrl(t *testing.T) {
+	t.Run("OpenAI", func(t *testing.T) {
+		url, err := buildUrl(DefaultBaseURL, ent.VectorizationConfig{})
+		require.Nil(t, err)
+		assert.Equal(t, "https://api.openai.com/v1/embeddings", url)
+	})
+
+	t.Run("OpenAI-compatible endpoint", func(t *testing.T) {
+		url, err := buildUrl("http://localhost:8080", ent.VectorizationConfig{})
+		require.Nil(t, err)
+		assert.Equal(t, "http://localhost:8080/v1/embeddings", url)
+	})
+
+	t.Run("Azure", func(t *testing.T) {
+		url, err := buildUrl(DefaultBaseURL, ent.VectorizationConfig{
+			IsAzure:      true,
+			ResourceName: "resource",
+			DeploymentID: "deployment",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "https://resource.openai.azure.com/openai/deployments/deployment/embeddings?api-version=2022-12-01", url)
+	})
+
+	t.Run("Azure with api version", func(t *testing.T) {
+		url, err := buildUrl(DefaultBaseURL, ent.VectorizationConfig{
+			IsAzure:      true,
+			ResourceName: "resource",
+			DeploymentID: "deployment",
+			ApiVersion:   "2024-02-01",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "https://resource.openai.azure.com/openai/deployments/deployment/embeddings?api-version=2024-02-01", url)
+	})
+}
+
 func Test_getModelString(t *testing.T) {
 	t.Run("getModelStringDocument", func(t *testing.T) {
 		type args struct {
@@ -282,10 +591,34 @@ func Test_getModelString(t *testing.T) {
 				},
 				want: "code-search-babbage-code-001",
 			},
+			{
+				name: "Document type: text model: text-embedding-3-small vectorizationType: document",
+				args: args{
+					docType: "text",
+					model:   "text-embedding-3-small",
+				},
+				want: "text-embedding-3-small",
+			},
+			{
+				name: "Document type: text model: text-embedding-3-large vectorizationType: document",
+				args: args{
+					docType: "text",
+					model:   "text-embedding-3-large",
+				},
+				want: "text-embedding-3-large",
+			},
+			{
+				name: "Document type: text model: fine-tuned model vectorizationType: document",
+				args: args{
+					docType: "text",
+					model:   "ft:text-embedding-3-small:Org:Custom:abc",
+				},
+				want: "ft:text-embedding-3-small:Org:Custom:abc",
+			},
 		}
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				v := New("apiKey", "", nullLogger())
+				v := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
 				if got := v.getModelString(tt.args.docType, tt.args.model, "document", tt.args.version); got != tt.want {
 					t.Errorf("vectorizer.getModelString() = %v, want %v", got, tt.want)
 				}
@@ -355,7 +688,7 @@ func Test_getModelString(t *testing.T) {
 		}
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				v := New("apiKey", "", nullLogger())
+				v := New("apiKey", "", "", 0, 0, 0, moduletools.HTTPClientOptions{}, AzureADCredentials{}, moduletools.RetryPolicy{}, nullLogger())
 				if got := v.getModelString(tt.args.docType, tt.args.model, "query", tt.args.version); got != tt.want {
 					t.Errorf("vectorizer.getModelString() = %v, want %v", got, tt.want)
 				}
@@ -44,10 +44,27 @@ func (m *OpenAIModule) ValidateClass(ctx context.Context,
 	class *models.Class, cfg moduletools.ClassConfig,
 ) error {
 	settings := vectorizer.NewClassSettings(cfg)
-	return settings.Validate(class)
+	if err := settings.Validate(class); err != nil {
+		return err
+	}
+
+	if !settings.IsKnownModel() {
+		m.logger.WithField("action", "validate_class").
+			WithField("class", class.Class).
+			WithField("model", settings.Model()).
+			Warn("unknown OpenAI model name, allowed with allowUnknownModel setting")
+	}
+	return nil
 }
 
-var _ = modulecapabilities.ClassConfigurator(New())
+func (m *OpenAIModule) VectorDimensions(cfg moduletools.ClassConfig) (int, bool) {
+	return vectorizer.NewClassSettings(cfg).VectorDimensions()
+}
+
+var (
+	_ = modulecapabilities.ClassConfigurator(New())
+	_ = modulecapabilities.VectorDimensionsProvider(New())
+)
 
 // type ConfigValidator struct {
 // 	logger logrus.FieldLogger
@@ -11,8 +11,40 @@
 
 package ent
 
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// truncate settings for inputs longer than the model's context window
+const (
+	TruncateOff   = "off"
+	TruncateStart = "start"
+	TruncateEnd   = "end"
+)
+
+const (
+	AuthModeApiKey  = "apikey"
+	AuthModeAzureAD = "azuread"
+)
+
 type VectorizationConfig struct {
+	ClassName                               string
 	Type, Model, ModelVersion, ResourceName string
 	DeploymentID                            string `json:"deploymentId"`
 	IsAzure                                 bool
+	ApiVersion                              string
+	Dimensions                              *int64
+	BaseURL                                 string
+	RequestsPerMinute                       int
+	TokensPerMinute                         int
+	Truncate                                string
+	FallbackModel                           string
+	FallbackModelVersion                    string
+	AuthMode                                string
+	Organization                            string
+	Project                                 string
+	RequestTimeout                          time.Duration
+	HTTPClientOptions                       moduletools.HTTPClientOptions
 }
@@ -15,6 +15,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -23,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/additional"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/additional/projector"
+	"github.com/weaviate/weaviate/modules/text2vec-openai/additional/vectorizationinput"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/clients"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/vectorizer"
 )
@@ -48,6 +50,8 @@ type textVectorizer interface {
 		settings vectorizer.ClassSettings) error
 	Texts(ctx context.Context, input []string,
 		settings vectorizer.ClassSettings) ([]float32, error)
+	Input(className string, schema interface{},
+		settings vectorizer.ClassSettings) string
 	// TODO all of these should be moved out of here, gh-1470
 
 	MoveTo(source, target []float32, weight float32) ([]float32, error)
@@ -106,18 +110,77 @@ func (m *OpenAIModule) initVectorizer(ctx context.Context,
 ) error {
 	openAIApiKey := os.Getenv("OPENAI_APIKEY")
 	azureApiKey := os.Getenv("AZURE_APIKEY")
+	baseURL := os.Getenv("OPENAI_BASE_URL")
 
-	client := clients.New(openAIApiKey, azureApiKey, logger)
+	requestsPerMinute, err := intFromEnv("OPENAI_REQUESTS_PER_MINUTE")
+	if err != nil {
+		return err
+	}
+	tokensPerMinute, err := intFromEnv("OPENAI_TOKENS_PER_MINUTE")
+	if err != nil {
+		return err
+	}
+
+	batchSize, err := intFromEnv("OPENAI_BATCH_SIZE")
+	if err != nil {
+		return err
+	}
+	if batchSize == 0 {
+		batchSize = clients.DefaultBatchSize
+	}
 
-	m.vectorizer = vectorizer.New(client)
+	cacheSize, err := intFromEnv("OPENAI_EMBEDDING_CACHE_SIZE")
+	if err != nil {
+		return err
+	}
+
+	httpOptions, err := moduletools.HTTPClientOptionsFromEnv("OPENAI")
+	if err != nil {
+		return err
+	}
+
+	retryPolicy, err := moduletools.RetryPolicyFromEnv("OPENAI")
+	if err != nil {
+		return err
+	}
+
+	// the same variables as used by the Azure SDKs and injected by the
+	// workload identity webhook
+	azureAD := clients.AzureADCredentials{
+		TenantID:           os.Getenv("AZURE_TENANT_ID"),
+		ClientID:           os.Getenv("AZURE_CLIENT_ID"),
+		ClientSecret:       os.Getenv("AZURE_CLIENT_SECRET"),
+		FederatedTokenFile: os.Getenv("AZURE_FEDERATED_TOKEN_FILE"),
+		AuthorityHost:      os.Getenv("AZURE_AUTHORITY_HOST"),
+	}
+
+	client := clients.New(openAIApiKey, azureApiKey, baseURL,
+		requestsPerMinute, tokensPerMinute, batchSize, httpOptions, azureAD,
+		retryPolicy, logger)
+
+	m.vectorizer = vectorizer.New(client, cacheSize)
 	m.metaProvider = client
 
 	return nil
 }
 
+func intFromEnv(envVar string) (int, error) {
+	value := os.Getenv(envVar)
+	if value == "" {
+		return 0, nil
+	}
+
+	asInt, err := strconv.Atoi(value)
+	if err != nil || asInt < 0 {
+		return 0, errors.Errorf("%s must be a non-negative integer, got %q", envVar, value)
+	}
+	return asInt, nil
+}
+
 func (m *OpenAIModule) initAdditionalPropertiesProvider() error {
 	projector := projector.New()
-	m.additionalPropertiesProvider = additional.New(projector)
+	vectorizationInput := vectorizationinput.New(m.vectorizer)
+	m.additionalPropertiesProvider = additional.New(projector, vectorizationInput)
 	return nil
 }
 
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+)
+
+type cacheKey [sha256.Size]byte
+
+// embeddingCache holds the embeddings of the most recently vectorized texts,
+// so that re-importing unchanged objects does not call the API again. Once
+// the cache holds maxSize entries, the least recently used one is evicted.
+type embeddingCache struct {
+	sync.Mutex
+	maxSize int
+	entries map[cacheKey]*list.Element
+	// most recently used entries are at the front
+	recent *list.List
+
+	hits   prometheus.Counter
+	misses prometheus.Counter
+}
+
+type cacheEntry struct {
+	key    cacheKey
+	vector []float32
+}
+
+func newEmbeddingCache(maxSize int, hits, misses prometheus.Counter) *embeddingCache {
+	return &embeddingCache{
+		maxSize: maxSize,
+		entries: map[cacheKey]*list.Element{},
+		recent:  list.New(),
+		hits:    hits,
+		misses:  misses,
+	}
+}
+
+func (c *embeddingCache) get(key cacheKey) ([]float32, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses.Inc()
+		return nil, false
+	}
+
+	c.hits.Inc()
+	c.recent.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).vector, true
+}
+
+func (c *embeddingCache) put(key cacheKey, vector []float32) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*cacheEntry).vector = vector
+		c.recent.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.recent.PushFront(&cacheEntry{key: key, vector: vector})
+	for c.recent.Len() > c.maxSize {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// getCacheKey hashes the text together with every setting which leads to a
+// different embedding of the same text
+func getCacheKey(text string, config ent.VectorizationConfig) cacheKey {
+	dimensions := int64(0)
+	if config.Dimensions != nil {
+		dimensions = *config.Dimensions
+	}
+	// differences in whitespace do not change the meaning of the text
+	normalized := strings.Join(strings.Fields(text), " ")
+
+	return sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%s\x00%d\x00%s\x00%s\x00%s\x00%s\x00%s",
+		config.Type, config.Model, config.ModelVersion, dimensions, config.Truncate,
+		config.BaseURL, config.ResourceName, config.DeploymentID, normalized)))
+}
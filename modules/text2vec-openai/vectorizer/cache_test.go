@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+)
+
+func newTestCounter() prometheus.Counter {
+	return prometheus.NewCounter(prometheus.CounterOpts{Name: "test"})
+}
+
+func TestEmbeddingCache(t *testing.T) {
+	config := ent.VectorizationConfig{Type: "text", Model: "ada", ModelVersion: "002"}
+
+	t.Run("evicts the least recently used entry", func(t *testing.T) {
+		cache := newEmbeddingCache(2, newTestCounter(), newTestCounter())
+		first := getCacheKey("first", config)
+		second := getCacheKey("second", config)
+		third := getCacheKey("third", config)
+
+		cache.put(first, []float32{1})
+		cache.put(second, []float32{2})
+		// touch the first entry, so that the second one is the oldest
+		_, ok := cache.get(first)
+		require.True(t, ok)
+		cache.put(third, []float32{3})
+
+		_, ok = cache.get(second)
+		assert.False(t, ok)
+		vec, ok := cache.get(first)
+		assert.True(t, ok)
+		assert.Equal(t, []float32{1}, vec)
+		vec, ok = cache.get(third)
+		assert.True(t, ok)
+		assert.Equal(t, []float32{3}, vec)
+	})
+
+	t.Run("keys ignore whitespace but not the settings", func(t *testing.T) {
+		assert.Equal(t, getCacheKey("some  text\n", config),
+			getCacheKey("some text", config))
+
+		otherModel := config
+		otherModel.Model = "text-embedding-3-small"
+		assert.NotEqual(t, getCacheKey("some text", config),
+			getCacheKey("some text", otherModel))
+
+		dimensions := int64(512)
+		otherDimensions := otherModel
+		otherDimensions.Dimensions = &dimensions
+		assert.NotEqual(t, getCacheKey("some text", otherModel),
+			getCacheKey("some text", otherDimensions))
+	})
+
+	t.Run("unchanged objects are not vectorized twice", func(t *testing.T) {
+		client := &fakeClient{}
+		v := New(client, 10)
+		settings := &fakeSettings{openAIType: "text", openAIModel: "ada"}
+
+		for i := 0; i < 2; i++ {
+			object := &models.Object{
+				Class:      "Car",
+				Properties: map[string]interface{}{"brand": "best brand"},
+			}
+			err := v.Object(context.Background(), object, nil, settings)
+			require.Nil(t, err)
+			assert.Equal(t, []float32{0, 1, 2, 3}, []float32(object.Vector))
+		}
+		assert.Equal(t, 1, client.calls)
+	})
+}
@@ -12,24 +12,47 @@
 package vectorizer
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
 )
 
 const (
 	DefaultOpenAIDocumentType    = "text"
 	DefaultOpenAIModel           = "ada"
+	DefaultApiVersion            = "2022-12-01"
 	DefaultVectorizeClassName    = true
 	DefaultPropertyIndexed       = true
 	DefaultVectorizePropertyName = false
+	DefaultPropertyWeight        = 1
+	DefaultTruncate              = ent.TruncateOff
+	DefaultAuthMode              = ent.AuthModeApiKey
+	DefaultImportRequestTimeout  = 60 * time.Second
+	DefaultQueryRequestTimeout   = 30 * time.Second
 )
 
+// MaxPropertyWeight is the upper bound of the vectorizeWeight of a property,
+// so that a single property doesn't blow up the input
+const MaxPropertyWeight = 10
+
+const (
+	TextEmbedding3Small = "text-embedding-3-small"
+	TextEmbedding3Large = "text-embedding-3-large"
+)
+
+var availableTruncateValues = []string{ent.TruncateOff, ent.TruncateStart, ent.TruncateEnd}
+
+var availableAuthModes = []string{ent.AuthModeApiKey, ent.AuthModeAzureAD}
+
 var availableOpenAITypes = []string{"text", "code"}
 
 var availableOpenAIModels = []string{
@@ -37,6 +60,49 @@ var availableOpenAIModels = []string{
 	"babbage", // only suppports 001
 	"curie",   // only suppports 001
 	"davinci", // only suppports 001
+	TextEmbedding3Small,
+	TextEmbedding3Large,
+}
+
+// availableApiVersions are the Azure OpenAI API versions which serve the
+// embeddings endpoint
+var availableApiVersions = []string{
+	"2022-12-01",
+	"2023-03-15-preview",
+	"2023-05-15",
+	"2023-06-01-preview",
+	"2023-07-01-preview",
+	"2023-08-01-preview",
+	"2023-09-01-preview",
+	"2023-12-01-preview",
+	"2024-02-01",
+	"2024-02-15-preview",
+	"2024-03-01-preview",
+}
+
+// availableV3Models are addressed by their full model name and have no
+// separate model version or document type
+var availableV3Models = []string{
+	TextEmbedding3Small,
+	TextEmbedding3Large,
+}
+
+// availableV3ModelsDimensions lists the output dimensions the v3 models can
+// be shortened to, the last entry being the model's native size
+var availableV3ModelsDimensions = map[string][]int64{
+	TextEmbedding3Small: {512, 1536},
+	TextEmbedding3Large: {256, 1024, 3072},
+}
+
+var modelDimensions001 = map[string]int{
+	"ada":     1024,
+	"babbage": 2048,
+	"curie":   4096,
+	"davinci": 12288,
+}
+
+var modelDimensions002 = map[string]int{
+	"ada": 1536,
 }
 
 type classSettings struct {
@@ -84,8 +150,44 @@ func (cs *classSettings) VectorizePropertyName(propName string) bool {
 	return asBool
 }
 
+// PropertyWeight is the number of times the text of the property is repeated
+// in the vectorization input, e.g. to weight a title over the body
+func (cs *classSettings) PropertyWeight(propName string) int {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultPropertyWeight
+	}
+
+	val, ok := cs.cfg.Property(propName)["vectorizeWeight"]
+	if !ok || val == nil {
+		return DefaultPropertyWeight
+	}
+	return int(toInt64(val))
+}
+
 func (cs *classSettings) Model() string {
-	return cs.getProperty("model", DefaultOpenAIModel)
+	model := cs.getProperty("model", DefaultOpenAIModel)
+	if isKnownModel(model) {
+		return model
+	}
+	// names of unknown models, e.g. fine-tuned ones, may be case-sensitive
+	return cs.getRawProperty("model")
+}
+
+// AllowUnknownModel lets users configure models which are not (yet) known to
+// Weaviate, such as fine-tuned or newly released models
+func (cs *classSettings) AllowUnknownModel() bool {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return false
+	}
+
+	allow, ok := cs.cfg.Class()["allowUnknownModel"].(bool)
+	return ok && allow
+}
+
+func (cs *classSettings) IsKnownModel() bool {
+	return isKnownModel(cs.Model())
 }
 
 func (cs *classSettings) Type() string {
@@ -97,6 +199,144 @@ func (cs *classSettings) ModelVersion() string {
 	return cs.getProperty("modelVersion", defaultVersion)
 }
 
+func (cs *classSettings) Dimensions() *int64 {
+	return cs.getInt64Property("dimensions")
+}
+
+// VectorDimensions returns the number of dimensions of the vectors produced
+// by the configured model, it returns false for Azure deployments and unknown
+// models as their model can't be told from the settings
+func (cs *classSettings) VectorDimensions() (int, bool) {
+	if cs.IsAzure() {
+		return 0, false
+	}
+	if dimensions := cs.Dimensions(); dimensions != nil {
+		return int(*dimensions), true
+	}
+	return modelVectorDimensions(cs.Model(), cs.ModelVersion())
+}
+
+// FallbackModel is used in place of the configured model once OpenAI no
+// longer serves it, e.g. after the model was deprecated
+func (cs *classSettings) FallbackModel() string {
+	model := cs.getProperty("fallbackModel", "")
+	if model == "" || isKnownModel(model) {
+		return model
+	}
+	return cs.getRawProperty("fallbackModel")
+}
+
+func (cs *classSettings) FallbackModelVersion() string {
+	return PickDefaultModelVersion(cs.FallbackModel(), cs.Type())
+}
+
+func modelVectorDimensions(model, version string) (int, bool) {
+	if isV3Model(model) {
+		v3Dimensions := availableV3ModelsDimensions[model]
+		return int(v3Dimensions[len(v3Dimensions)-1]), true
+	}
+	if version == "002" {
+		return modelDimensions002[model], modelDimensions002[model] > 0
+	}
+	return modelDimensions001[model], modelDimensions001[model] > 0
+}
+
+func (cs *classSettings) BaseURL() string {
+	// unlike the other settings the base URL must not be lowercased, as paths
+	// of self-hosted endpoints may be case-sensitive
+	return cs.getRawProperty("baseURL")
+}
+
+// Organization is the OpenAI organization the requests are billed to
+func (cs *classSettings) Organization() string {
+	return cs.getRawProperty("organization")
+}
+
+func (cs *classSettings) Project() string {
+	return cs.getRawProperty("project")
+}
+
+// ImportRequestTimeout limits a single request to the API while importing
+// objects
+func (cs *classSettings) ImportRequestTimeout() time.Duration {
+	return cs.getRequestTimeout("import", DefaultImportRequestTimeout)
+}
+
+// QueryRequestTimeout limits a single request to the API while vectorizing
+// the input of a query
+func (cs *classSettings) QueryRequestTimeout() time.Duration {
+	return cs.getRequestTimeout("query", DefaultQueryRequestTimeout)
+}
+
+// getRequestTimeout reads the requestTimeout setting, which is either the
+// number of seconds for all requests or an object with separate "import" and
+// "query" values. Invalid values are returned as negative durations, so that
+// they get rejected on validation.
+func (cs *classSettings) getRequestTimeout(kind string, defaultValue time.Duration) time.Duration {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	value, ok := cs.cfg.Class()["requestTimeout"]
+	if !ok || value == nil {
+		return defaultValue
+	}
+
+	if asMap, ok := value.(map[string]interface{}); ok {
+		for key := range asMap {
+			if key != "import" && key != "query" {
+				return -1
+			}
+		}
+		if value, ok = asMap[kind]; !ok {
+			return defaultValue
+		}
+	}
+
+	var seconds float64
+	switch v := value.(type) {
+	case int:
+		seconds = float64(v)
+	case int64:
+		seconds = float64(v)
+	case float64:
+		seconds = v
+	case json.Number:
+		parsed, err := v.Float64()
+		if err != nil {
+			return -1
+		}
+		seconds = parsed
+	default:
+		return -1
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// HTTPClientOptions are the proxy and TLS settings of the class, which take
+// precedence over the ones set in the environment
+func (cs *classSettings) HTTPClientOptions() moduletools.HTTPClientOptions {
+	options := moduletools.HTTPClientOptions{
+		ProxyURL: cs.getRawProperty("proxyURL"),
+		CABundle: cs.getRawProperty("caBundle"),
+	}
+	if cs.cfg != nil {
+		if skipVerify, ok := cs.cfg.Class()["tlsInsecureSkipVerify"].(bool); ok {
+			options.InsecureSkipVerify = skipVerify
+		}
+	}
+	return options
+}
+
+func (cs *classSettings) RequestsPerMinute() int {
+	return cs.getIntProperty("requestsPerMinute")
+}
+
+func (cs *classSettings) TokensPerMinute() int {
+	return cs.getIntProperty("tokensPerMinute")
+}
+
 func (cs *classSettings) ResourceName() string {
 	return cs.getProperty("resourceName", "")
 }
@@ -109,6 +349,26 @@ func (cs *classSettings) IsAzure() bool {
 	return cs.ResourceName() != "" && cs.DeploymentID() != ""
 }
 
+func (cs *classSettings) ApiVersion() string {
+	return cs.getProperty("apiVersion", DefaultApiVersion)
+}
+
+func (cs *classSettings) Truncate() string {
+	return cs.getProperty("truncate", DefaultTruncate)
+}
+
+// AuthMode selects whether Azure OpenAI requests are authenticated with an
+// api key or with tokens issued by Azure AD
+func (cs *classSettings) AuthMode() string {
+	return cs.getProperty("authMode", DefaultAuthMode)
+}
+
+// VectorizeTemplate defines the text which is vectorized in place of the
+// concatenated class name and properties, e.g. "Title: {title}"
+func (cs *classSettings) VectorizeTemplate() string {
+	return cs.getRawProperty("vectorizeTemplate")
+}
+
 func (cs *classSettings) VectorizeClassName() bool {
 	if cs.cfg == nil {
 		// we would receive a nil-config on cross-class requests, such as Explore{}
@@ -140,7 +400,7 @@ func (cs *classSettings) Validate(class *models.Class) error {
 	}
 
 	model := cs.Model()
-	if !cs.validateOpenAISetting(model, availableOpenAIModels) {
+	if !isKnownModel(model) && !cs.AllowUnknownModel() {
 		return errors.Errorf("wrong OpenAI model name, available model names are: %v", availableOpenAIModels)
 	}
 
@@ -149,11 +409,67 @@ func (cs *classSettings) Validate(class *models.Class) error {
 		return err
 	}
 
+	if err := cs.validateDimensions(model, cs.Dimensions()); err != nil {
+		return err
+	}
+
 	err := cs.validateAzureConfig(cs.ResourceName(), cs.DeploymentID())
 	if err != nil {
 		return err
 	}
 
+	if err := cs.validateBaseURL(cs.BaseURL()); err != nil {
+		return err
+	}
+
+	if err := cs.validateApiVersion(cs.ApiVersion()); err != nil {
+		return err
+	}
+
+	if cs.ImportRequestTimeout() <= 0 || cs.QueryRequestTimeout() <= 0 {
+		return errors.New("requestTimeout must be a positive number of seconds or an object " +
+			"with positive \"import\" and \"query\" values")
+	}
+
+	if cs.IsAzure() && (cs.Organization() != "" || cs.Project() != "") {
+		return errors.New("organization and project can't be combined with resourceName and deploymentId")
+	}
+
+	if !cs.validateOpenAISetting(cs.AuthMode(), availableAuthModes) {
+		return errors.Errorf("wrong authMode setting, available values are: %v", availableAuthModes)
+	}
+
+	if cs.AuthMode() == ent.AuthModeAzureAD && !cs.IsAzure() {
+		return errors.New("authMode azureAD can only be used together with resourceName and deploymentId")
+	}
+
+	if err := cs.HTTPClientOptions().Validate(); err != nil {
+		return err
+	}
+
+	if !cs.validateOpenAISetting(cs.Truncate(), availableTruncateValues) {
+		return errors.Errorf("wrong truncate setting, available values are: %v", availableTruncateValues)
+	}
+
+	if err := cs.validateFallbackModel(model); err != nil {
+		return err
+	}
+
+	if cs.RequestsPerMinute() < 0 || cs.TokensPerMinute() < 0 {
+		return errors.New("requestsPerMinute and tokensPerMinute must not be negative")
+	}
+
+	for _, prop := range class.Properties {
+		if weight := cs.PropertyWeight(prop.Name); weight < 1 || weight > MaxPropertyWeight {
+			return errors.Errorf("vectorizeWeight of property %s must be an integer between 1 and %d",
+				prop.Name, MaxPropertyWeight)
+		}
+	}
+
+	if template := cs.VectorizeTemplate(); template != "" {
+		return cs.validateTemplate(class, template)
+	}
+
 	err = cs.validateIndexState(class, cs)
 	if err != nil {
 		return err
@@ -163,6 +479,11 @@ func (cs *classSettings) Validate(class *models.Class) error {
 }
 
 func (cs *classSettings) validateModelVersion(version, model, docType string) error {
+	if isV3Model(model) || !isKnownModel(model) {
+		// v3 models and unknown models are not versioned
+		return nil
+	}
+
 	if version == "001" {
 		// no restrictions
 		return nil
@@ -192,6 +513,70 @@ func (cs *classSettings) validateModelVersion(version, model, docType string) er
 	return nil
 }
 
+func (cs *classSettings) validateDimensions(model string, dimensions *int64) error {
+	if dimensions == nil {
+		return nil
+	}
+
+	if !isKnownModel(model) {
+		// we can't tell which dimensions an unknown model supports
+		if *dimensions <= 0 {
+			return errors.New("dimensions setting must be a positive number")
+		}
+		return nil
+	}
+
+	if !isV3Model(model) {
+		return errors.Errorf("dimensions setting can only be used with V3 embedding models: %v",
+			availableV3Models)
+	}
+
+	availableDimensions := availableV3ModelsDimensions[model]
+	for _, d := range availableDimensions {
+		if *dimensions == d {
+			return nil
+		}
+	}
+
+	return errors.Errorf("wrong dimensions setting for %s model, available dimensions are: %v",
+		model, availableDimensions)
+}
+
+func (cs *classSettings) validateFallbackModel(model string) error {
+	fallbackModel := cs.FallbackModel()
+	if fallbackModel == "" {
+		return nil
+	}
+
+	if !isKnownModel(fallbackModel) && !cs.AllowUnknownModel() {
+		return errors.Errorf("wrong fallbackModel name, available model names are: %v", availableOpenAIModels)
+	}
+	if fallbackModel == model {
+		return errors.New("fallbackModel must differ from model")
+	}
+	if cs.IsAzure() {
+		return errors.New("fallbackModel can't be combined with resourceName and deploymentId")
+	}
+	if err := cs.validateDimensions(fallbackModel, cs.Dimensions()); err != nil {
+		return errors.Wrap(err, "fallbackModel")
+	}
+
+	if cs.Dimensions() != nil {
+		// both models are asked for vectors of the configured dimensions
+		return nil
+	}
+
+	// the vectors of both models end up in the same index
+	dimensions, ok := cs.VectorDimensions()
+	fallbackDimensions, fallbackOk := modelVectorDimensions(fallbackModel, cs.FallbackModelVersion())
+	if ok && fallbackOk && fallbackDimensions != dimensions {
+		return errors.Errorf("fallbackModel %s produces vectors of %d dimensions, "+
+			"but model %s produces vectors of %d dimensions",
+			fallbackModel, fallbackDimensions, model, dimensions)
+	}
+	return nil
+}
+
 func (cs *classSettings) validateOpenAISetting(value string, availableValues []string) bool {
 	for i := range availableValues {
 		if value == availableValues[i] {
@@ -218,6 +603,78 @@ func (cs *classSettings) getProperty(name, defaultValue string) string {
 	return defaultValue
 }
 
+// getRawProperty returns the setting as is, for values which are
+// case-sensitive such as URLs and paths
+func (cs *classSettings) getRawProperty(name string) string {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return ""
+	}
+
+	if value, ok := cs.cfg.Class()[name].(string); ok {
+		return strings.TrimSpace(value)
+	}
+	return ""
+}
+
+func (cs *classSettings) getInt64Property(name string) *int64 {
+	if cs.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return nil
+	}
+
+	val, ok := cs.cfg.Class()[name]
+	if !ok || val == nil {
+		return nil
+	}
+
+	asInt64 := toInt64(val)
+	return &asInt64
+}
+
+// toInt64 converts the numbers of the module config, invalid values are
+// returned as -1 so that they get rejected on validation
+func toInt64(val interface{}) int64 {
+	switch v := val.(type) {
+	case int:
+		return int64(v)
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	case json.Number:
+		parsed, err := v.Int64()
+		if err != nil {
+			return -1
+		}
+		return parsed
+	default:
+		return -1
+	}
+}
+
+func (cs *classSettings) getIntProperty(name string) int {
+	if val := cs.getInt64Property(name); val != nil {
+		return int(*val)
+	}
+	return 0
+}
+
+func (cs *classSettings) validateTemplate(class *models.Class, template string) error {
+	props := templateProperties(template)
+	if len(props) == 0 {
+		return errors.New("vectorizeTemplate must reference at least one property, e.g. {title}")
+	}
+
+	for _, name := range props {
+		// nested properties are referenced by their dot path
+		if _, err := schema.GetPropertyByName(class, name); err != nil {
+			return errors.Errorf("vectorizeTemplate references unknown property %q", name)
+		}
+	}
+	return nil
+}
+
 func (cs *classSettings) validateIndexState(class *models.Class, settings ClassSettings) error {
 	if settings.VectorizeClassName() {
 		// if the user chooses to vectorize the classname, vector-building will
@@ -261,7 +718,46 @@ func (cs *classSettings) validateAzureConfig(resourceName string, deploymentId s
 	return nil
 }
 
+func (cs *classSettings) validateBaseURL(baseURL string) error {
+	if baseURL == "" {
+		return nil
+	}
+
+	if cs.IsAzure() {
+		return fmt.Errorf("baseURL cannot be combined with resourceName and deploymentId")
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return errors.Wrap(err, "invalid baseURL")
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid baseURL %q: must be an absolute http or https URL", baseURL)
+	}
+
+	return nil
+}
+
+func (cs *classSettings) validateApiVersion(apiVersion string) error {
+	if !cs.IsAzure() {
+		if _, ok := cs.cfg.Class()["apiVersion"]; ok {
+			return fmt.Errorf("apiVersion can only be set together with resourceName and deploymentId")
+		}
+		return nil
+	}
+
+	if !cs.validateOpenAISetting(apiVersion, availableApiVersions) {
+		return errors.Errorf("wrong Azure OpenAI apiVersion, available api versions are: %v",
+			availableApiVersions)
+	}
+	return nil
+}
+
 func PickDefaultModelVersion(model, docType string) string {
+	if isV3Model(model) || !isKnownModel(model) {
+		return ""
+	}
+
 	if model == "ada" && docType == "text" {
 		return "002"
 	}
@@ -269,3 +765,21 @@ func PickDefaultModelVersion(model, docType string) string {
 	// for all other combinations stick with "001"
 	return "001"
 }
+
+func isKnownModel(model string) bool {
+	for i := range availableOpenAIModels {
+		if model == availableOpenAIModels[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func isV3Model(model string) bool {
+	for i := range availableV3Models {
+		if model == availableV3Models[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,544 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "test",
+		Properties: []*models.Property{
+			{
+				DataType: []string{"text"},
+				Name:     "test",
+			},
+		},
+	}
+	tests := []struct {
+		name             string
+		cfg              moduletools.ClassConfig
+		wantModel        string
+		wantModelVersion string
+		wantDimensions   *int64
+		wantBaseURL      string
+		wantApiVersion   string
+		wantTruncate     string
+		// defaults are asserted if not set
+		wantImportRequestTimeout time.Duration
+		wantQueryRequestTimeout  time.Duration
+		wantErr                  error
+	}{
+		{
+			name: "default settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+		},
+		{
+			name: "text-embedding-3-small with default dimensions",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model": "text-embedding-3-small",
+				},
+			},
+			wantModel: "text-embedding-3-small",
+		},
+		{
+			name: "text-embedding-3-large with shortened dimensions",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model":      "text-embedding-3-large",
+					"dimensions": json.Number("1024"),
+				},
+			},
+			wantModel:      "text-embedding-3-large",
+			wantDimensions: int64Ptr(1024),
+		},
+		{
+			name: "text-embedding-3-small with wrong dimensions",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model":      "text-embedding-3-small",
+					"dimensions": 1024,
+				},
+			},
+			wantModel:      "text-embedding-3-small",
+			wantDimensions: int64Ptr(1024),
+			wantErr: errors.New("wrong dimensions setting for text-embedding-3-small model, " +
+				"available dimensions are: [512 1536]"),
+		},
+		{
+			name: "dimensions with a legacy model",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model":      "ada",
+					"dimensions": 512,
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantDimensions:   int64Ptr(512),
+			wantErr: errors.New("dimensions setting can only be used with V3 embedding models: " +
+				"[text-embedding-3-small text-embedding-3-large]"),
+		},
+		{
+			name: "custom baseURL",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"baseURL": "http://localhost:8080/Custom",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantBaseURL:      "http://localhost:8080/Custom",
+		},
+		{
+			name: "invalid baseURL",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"baseURL": "localhost:8080",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantBaseURL:      "localhost:8080",
+			wantErr:          errors.New(`invalid baseURL "localhost:8080": must be an absolute http or https URL`),
+		},
+		{
+			name: "baseURL combined with Azure",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"baseURL":      "http://localhost:8080",
+					"resourceName": "resource",
+					"deploymentId": "deployment",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantBaseURL:      "http://localhost:8080",
+			wantErr:          errors.New("baseURL cannot be combined with resourceName and deploymentId"),
+		},
+		{
+			name: "Azure with default api version",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"resourceName": "resource",
+					"deploymentId": "deployment",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantApiVersion:   "2022-12-01",
+		},
+		{
+			name: "Azure with newer api version",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"resourceName": "resource",
+					"deploymentId": "deployment",
+					"apiVersion":   "2024-02-01",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantApiVersion:   "2024-02-01",
+		},
+		{
+			name: "Azure with unsupported api version",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"resourceName": "resource",
+					"deploymentId": "deployment",
+					"apiVersion":   "2021-01-01",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantApiVersion:   "2021-01-01",
+			wantErr: errors.Errorf("wrong Azure OpenAI apiVersion, available api versions are: %v",
+				availableApiVersions),
+		},
+		{
+			name: "api version without Azure",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiVersion": "2024-02-01",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantApiVersion:   "2024-02-01",
+			wantErr:          errors.New("apiVersion can only be set together with resourceName and deploymentId"),
+		},
+		{
+			name: "negative rate limits",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"requestsPerMinute": -1,
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr:          errors.New("requestsPerMinute and tokensPerMinute must not be negative"),
+		},
+		{
+			name: "custom proxy",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"proxyURL": "http://Proxy.example:3128",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+		},
+		{
+			name: "invalid proxy",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"proxyURL": "ftp://proxy.example",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr:          errors.New(`invalid proxy URL: scheme must be one of http, https, socks5, got "ftp"`),
+		},
+		{
+			name: "organization combined with Azure",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"resourceName": "resource",
+					"deploymentId": "deployment",
+					"organization": "org-AbC",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr:          errors.New("organization and project can't be combined with resourceName and deploymentId"),
+		},
+		{
+			name: "request timeout for import and query",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"requestTimeout": map[string]interface{}{
+						"import": json.Number("120"),
+						"query":  0.5,
+					},
+				},
+			},
+			wantModel:                "ada",
+			wantModelVersion:         "002",
+			wantImportRequestTimeout: 120 * time.Second,
+			wantQueryRequestTimeout:  500 * time.Millisecond,
+		},
+		{
+			name: "single request timeout",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"requestTimeout": 10,
+				},
+			},
+			wantModel:                "ada",
+			wantModelVersion:         "002",
+			wantImportRequestTimeout: 10 * time.Second,
+			wantQueryRequestTimeout:  10 * time.Second,
+		},
+		{
+			name: "wrong request timeout",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"requestTimeout": map[string]interface{}{
+						"imports": 10,
+					},
+				},
+			},
+			wantModel:                "ada",
+			wantModelVersion:         "002",
+			wantImportRequestTimeout: -1,
+			wantQueryRequestTimeout:  -1,
+			wantErr: errors.New("requestTimeout must be a positive number of seconds or an object " +
+				"with positive \"import\" and \"query\" values"),
+		},
+		{
+			name: "unknown model",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model": "text-embedding-4",
+				},
+			},
+			wantModel: "text-embedding-4",
+			wantErr:   errors.New("wrong OpenAI model name, available model names are: [ada babbage curie davinci text-embedding-3-small text-embedding-3-large]"),
+		},
+		{
+			name: "unknown model allowed",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model":             "ft:text-embedding-3-small:Org:Custom:abc",
+					"allowUnknownModel": true,
+					"dimensions":        768,
+				},
+			},
+			wantModel:      "ft:text-embedding-3-small:Org:Custom:abc",
+			wantDimensions: int64Ptr(768),
+		},
+		{
+			name: "truncate at the start",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"truncate": "START",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantTruncate:     "start",
+		},
+		{
+			name: "wrong truncate setting",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"truncate": "middle",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantTruncate:     "middle",
+			wantErr:          errors.New("wrong truncate setting, available values are: [off start end]"),
+		},
+		{
+			name: "Azure AD authentication",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"resourceName": "weaviate",
+					"deploymentId": "ada",
+					"authMode":     "azureAD",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+		},
+		{
+			name: "Azure AD authentication without Azure",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"authMode": "azureAD",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr:          errors.New("authMode azureAD can only be used together with resourceName and deploymentId"),
+		},
+		{
+			name: "wrong authMode setting",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"authMode": "certificate",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr:          errors.New("wrong authMode setting, available values are: [apikey azuread]"),
+		},
+		{
+			name: "fallback model with the same dimensions",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"fallbackModel": "text-embedding-3-small",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+		},
+		{
+			name: "fallback model with other dimensions",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"fallbackModel": "text-embedding-3-large",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr: errors.New("fallbackModel text-embedding-3-large produces vectors of 3072 dimensions, " +
+				"but model ada produces vectors of 1536 dimensions"),
+		},
+		{
+			name: "fallback model without the configured dimensions",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model":         "text-embedding-3-small",
+					"dimensions":    512,
+					"fallbackModel": "text-embedding-3-large",
+				},
+			},
+			wantModel:      "text-embedding-3-small",
+			wantDimensions: int64Ptr(512),
+			wantErr: errors.New("fallbackModel: wrong dimensions setting for text-embedding-3-large model, " +
+				"available dimensions are: [256 1024 3072]"),
+		},
+		{
+			name: "unknown fallback model",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"fallbackModel": "text-embedding-4",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr:          errors.New("wrong fallbackModel name, available model names are: [ada babbage curie davinci text-embedding-3-small text-embedding-3-large]"),
+		},
+		{
+			name: "vectorize template",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"vectorizeTemplate": "Test: {test}",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+		},
+		{
+			name: "vectorize template with an unknown property",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"vectorizeTemplate": "Test: {test}, Title: {title}",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr:          errors.New(`vectorizeTemplate references unknown property "title"`),
+		},
+		{
+			name: "vectorize template without placeholders",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"vectorizeTemplate": "Test",
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr:          errors.New("vectorizeTemplate must reference at least one property, e.g. {title}"),
+		},
+		{
+			name: "property weight",
+			cfg: fakeClassConfig{
+				propertyConfig: map[string]map[string]interface{}{
+					"test": {"vectorizeWeight": float64(3)},
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+		},
+		{
+			name: "property weight out of range",
+			cfg: fakeClassConfig{
+				propertyConfig: map[string]map[string]interface{}{
+					"test": {"vectorizeWeight": json.Number("0")},
+				},
+			},
+			wantModel:        "ada",
+			wantModelVersion: "002",
+			wantErr:          errors.New("vectorizeWeight of property test must be an integer between 1 and 10"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := NewClassSettings(tt.cfg)
+			err := cs.Validate(class)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tt.wantModel, cs.Model())
+			assert.Equal(t, tt.wantModelVersion, cs.ModelVersion())
+			assert.Equal(t, tt.wantDimensions, cs.Dimensions())
+			assert.Equal(t, tt.wantBaseURL, cs.BaseURL())
+			if tt.wantApiVersion != "" {
+				assert.Equal(t, tt.wantApiVersion, cs.ApiVersion())
+			}
+			if tt.wantImportRequestTimeout != 0 {
+				assert.Equal(t, tt.wantImportRequestTimeout, cs.ImportRequestTimeout())
+				assert.Equal(t, tt.wantQueryRequestTimeout, cs.QueryRequestTimeout())
+			} else {
+				assert.Equal(t, DefaultImportRequestTimeout, cs.ImportRequestTimeout())
+				assert.Equal(t, DefaultQueryRequestTimeout, cs.QueryRequestTimeout())
+			}
+			if tt.wantTruncate != "" {
+				assert.Equal(t, tt.wantTruncate, cs.Truncate())
+			}
+		})
+	}
+}
+
+func int64Ptr(in int64) *int64 {
+	return &in
+}
+
+func Test_classSettings_VectorDimensions(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       map[string]interface{}
+		wantDims  int
+		wantKnown bool
+	}{
+		{
+			name:      "default model",
+			cfg:       map[string]interface{}{},
+			wantDims:  1536,
+			wantKnown: true,
+		},
+		{
+			name:      "legacy model",
+			cfg:       map[string]interface{}{"model": "babbage"},
+			wantDims:  2048,
+			wantKnown: true,
+		},
+		{
+			name:      "v3 model with native dimensions",
+			cfg:       map[string]interface{}{"model": "text-embedding-3-large"},
+			wantDims:  3072,
+			wantKnown: true,
+		},
+		{
+			name:      "v3 model with shortened dimensions",
+			cfg:       map[string]interface{}{"model": "text-embedding-3-large", "dimensions": 256},
+			wantDims:  256,
+			wantKnown: true,
+		},
+		{
+			name:      "Azure deployment",
+			cfg:       map[string]interface{}{"resourceName": "resource", "deploymentId": "deployment"},
+			wantKnown: false,
+		},
+		{
+			name:      "unknown model",
+			cfg:       map[string]interface{}{"model": "text-embedding-4", "allowUnknownModel": true},
+			wantKnown: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dims, ok := NewClassSettings(fakeClassConfig{classConfig: tt.cfg}).VectorDimensions()
+			assert.Equal(t, tt.wantKnown, ok)
+			assert.Equal(t, tt.wantDims, dims)
+		})
+	}
+}
@@ -13,18 +13,22 @@ package vectorizer
 
 import (
 	"context"
+	"time"
 
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
 )
 
 type fakeClient struct {
 	lastInput  []string
 	lastConfig ent.VectorizationConfig
+	calls      int
 }
 
 func (c *fakeClient) Vectorize(ctx context.Context,
 	text string, cfg ent.VectorizationConfig,
 ) (*ent.VectorizationResult, error) {
+	c.calls++
 	c.lastInput = []string{text}
 	c.lastConfig = cfg
 	return &ent.VectorizationResult{
@@ -47,15 +51,30 @@ func (c *fakeClient) VectorizeQuery(ctx context.Context,
 }
 
 type fakeSettings struct {
-	skippedProperty    string
-	vectorizeClassName bool
-	excludedProperty   string
-	openAIType         string
-	openAIModel        string
-	openAIModelVersion string
-	resourceName       string
-	deploymentID       string
-	isAzure            bool
+	skippedProperty      string
+	vectorizeClassName   bool
+	excludedProperty     string
+	propertyWeights      map[string]int
+	openAIType           string
+	openAIModel          string
+	openAIModelVersion   string
+	dimensions           *int64
+	baseURL              string
+	requestsPerMinute    int
+	tokensPerMinute      int
+	resourceName         string
+	deploymentID         string
+	isAzure              bool
+	apiVersion           string
+	truncate             string
+	vectorizeTemplate    string
+	fallbackModel        string
+	fallbackModelVersion string
+	authMode             string
+	organization         string
+	project              string
+	importRequestTimeout time.Duration
+	queryRequestTimeout  time.Duration
 }
 
 func (f *fakeSettings) PropertyIndexed(propName string) bool {
@@ -66,6 +85,13 @@ func (f *fakeSettings) VectorizePropertyName(propName string) bool {
 	return f.excludedProperty != propName
 }
 
+func (f *fakeSettings) PropertyWeight(propName string) int {
+	if weight, ok := f.propertyWeights[propName]; ok {
+		return weight
+	}
+	return 1
+}
+
 func (f *fakeSettings) VectorizeClassName() bool {
 	return f.vectorizeClassName
 }
@@ -82,6 +108,22 @@ func (f *fakeSettings) ModelVersion() string {
 	return f.openAIModelVersion
 }
 
+func (f *fakeSettings) Dimensions() *int64 {
+	return f.dimensions
+}
+
+func (f *fakeSettings) BaseURL() string {
+	return f.baseURL
+}
+
+func (f *fakeSettings) RequestsPerMinute() int {
+	return f.requestsPerMinute
+}
+
+func (f *fakeSettings) TokensPerMinute() int {
+	return f.tokensPerMinute
+}
+
 func (f *fakeSettings) ResourceName() string {
 	return f.resourceName
 }
@@ -93,3 +135,68 @@ func (f *fakeSettings) DeploymentID() string {
 func (f *fakeSettings) IsAzure() bool {
 	return f.isAzure
 }
+
+func (f *fakeSettings) ApiVersion() string {
+	return f.apiVersion
+}
+
+func (f *fakeSettings) Truncate() string {
+	return f.truncate
+}
+
+func (f *fakeSettings) VectorizeTemplate() string {
+	return f.vectorizeTemplate
+}
+
+func (f *fakeSettings) FallbackModel() string {
+	return f.fallbackModel
+}
+
+func (f *fakeSettings) FallbackModelVersion() string {
+	return f.fallbackModelVersion
+}
+
+func (f *fakeSettings) AuthMode() string {
+	return f.authMode
+}
+
+func (f *fakeSettings) Organization() string {
+	return f.organization
+}
+
+func (f *fakeSettings) Project() string {
+	return f.project
+}
+
+func (f *fakeSettings) ImportRequestTimeout() time.Duration {
+	return f.importRequestTimeout
+}
+
+func (f *fakeSettings) QueryRequestTimeout() time.Duration {
+	return f.queryRequestTimeout
+}
+
+func (f *fakeSettings) HTTPClientOptions() moduletools.HTTPClientOptions {
+	return moduletools.HTTPClientOptions{}
+}
+
+type fakeClassConfig struct {
+	classConfig    map[string]interface{}
+	propertyConfig map[string]map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return f.propertyConfig[propName]
+}
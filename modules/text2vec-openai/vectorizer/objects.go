@@ -16,21 +16,33 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/fatih/camelcase"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
 type Vectorizer struct {
 	client Client
+	cache  *embeddingCache
 }
 
-func New(client Client) *Vectorizer {
-	return &Vectorizer{
+// New creates a vectorizer which caches the embeddings of up to cacheSize
+// object texts, a cacheSize of 0 disables the cache
+func New(client Client, cacheSize int) *Vectorizer {
+	v := &Vectorizer{
 		client: client,
 	}
+	if cacheSize > 0 {
+		metrics := monitoring.GetMetrics()
+		v.cache = newEmbeddingCache(cacheSize,
+			metrics.ModuleEmbeddingCacheHits.WithLabelValues("text2vec-openai"),
+			metrics.ModuleEmbeddingCacheMisses.WithLabelValues("text2vec-openai"))
+	}
+	return v
 }
 
 type Client interface {
@@ -44,13 +56,29 @@ type Client interface {
 type ClassSettings interface {
 	PropertyIndexed(property string) bool
 	VectorizePropertyName(propertyName string) bool
+	PropertyWeight(propertyName string) int
 	VectorizeClassName() bool
 	Model() string
 	Type() string
 	ModelVersion() string
+	Dimensions() *int64
+	BaseURL() string
+	RequestsPerMinute() int
+	TokensPerMinute() int
 	ResourceName() string
 	DeploymentID() string
 	IsAzure() bool
+	ApiVersion() string
+	Truncate() string
+	VectorizeTemplate() string
+	FallbackModel() string
+	FallbackModelVersion() string
+	AuthMode() string
+	Organization() string
+	Project() string
+	ImportRequestTimeout() time.Duration
+	QueryRequestTimeout() time.Duration
+	HTTPClientOptions() moduletools.HTTPClientOptions
 }
 
 func sortStringKeys(schemaMap map[string]interface{}) []string {
@@ -79,23 +107,68 @@ func appendPropIfText(icheck ClassSettings, list *[]string, propName string,
 ) bool {
 	valueString, ok := value.(string)
 	if ok {
+		text := strings.ToLower(valueString)
 		if icheck.VectorizePropertyName(propName) {
 			// use prop and value
-			*list = append(*list, strings.ToLower(
-				fmt.Sprintf("%s %s", camelCaseToLower(propName), valueString)))
-		} else {
-			*list = append(*list, strings.ToLower(valueString))
+			text = strings.ToLower(
+				fmt.Sprintf("%s %s", camelCaseToLower(propName), valueString))
+		}
+		// repeating the text of a property emphasizes it in the vector
+		for i := 0; i < icheck.PropertyWeight(propName); i++ {
+			*list = append(*list, text)
 		}
 		return true
 	}
 	return false
 }
 
-func (v *Vectorizer) object(ctx context.Context, className string,
-	schema interface{}, objDiff *moduletools.ObjectDiff, icheck ClassSettings,
-) ([]float32, error) {
+// nestedTexts returns the texts contained in the given value in a stable
+// order, descending into the elements of arrays and the nested properties of
+// objects
+func nestedTexts(value interface{}) []string {
+	switch val := value.(type) {
+	case string:
+		return []string{val}
+	case []string:
+		return val
+	case []interface{}:
+		var texts []string
+		for _, elem := range val {
+			texts = append(texts, nestedTexts(elem)...)
+		}
+		return texts
+	case map[string]interface{}:
+		var texts []string
+		for _, key := range sortStringKeys(val) {
+			texts = append(texts, nestedTexts(val[key])...)
+		}
+		return texts
+	default:
+		return nil
+	}
+}
+
+// Input returns the text which is sent to OpenAI to vectorize an object of
+// the given class with the given properties
+func (v *Vectorizer) Input(className string, schema interface{},
+	icheck ClassSettings,
+) string {
+	text, _ := v.input(className, schema, nil, icheck)
+	return text
+}
+
+// input concatenates the class name and the indexed text properties and
+// tells whether the object needs to be vectorized, i.e. whether it has no
+// vector yet or one of the concatenated properties changed
+func (v *Vectorizer) input(className string, schema interface{},
+	objDiff *moduletools.ObjectDiff, icheck ClassSettings,
+) (string, bool) {
 	vectorize := objDiff == nil || objDiff.GetVec() == nil
 
+	if template := icheck.VectorizeTemplate(); template != "" {
+		return v.templateInput(template, className, schema, objDiff, vectorize)
+	}
+
 	var corpi []string
 	if icheck.VectorizeClassName() {
 		corpi = append(corpi, camelCaseToLower(className))
@@ -113,8 +186,10 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 				for _, elem := range val {
 					appended = appendPropIfText(icheck, &corpi, prop, elem) || appended
 				}
-			case []interface{}:
-				for _, elem := range val {
+			case []interface{}, map[string]interface{}:
+				// arrays as well as the nested text properties of object and
+				// object[] properties
+				for _, elem := range nestedTexts(val) {
 					appended = appendPropIfText(icheck, &corpi, prop, elem) || appended
 				}
 			default:
@@ -129,29 +204,81 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 		corpi = append(corpi, camelCaseToLower(className))
 	}
 
+	return strings.Join(corpi, " "), vectorize
+}
+
+// templateInput renders the vectorizeTemplate of the class, the object needs
+// to be vectorized if one of the properties referenced by the template changed
+func (v *Vectorizer) templateInput(template, className string, schema interface{},
+	objDiff *moduletools.ObjectDiff, vectorize bool,
+) (string, bool) {
+	schemamap, _ := schema.(map[string]interface{})
+	for _, prop := range templateProperties(template) {
+		vectorize = vectorize || (objDiff != nil && objDiff.IsChangedProp(templateRootProperty(prop)))
+	}
+
+	text := renderTemplate(template, schemamap)
+	if strings.TrimSpace(text) == "" {
+		// fall back to using the class name
+		return camelCaseToLower(className), vectorize
+	}
+	return text, vectorize
+}
+
+func (v *Vectorizer) object(ctx context.Context, className string,
+	schema interface{}, objDiff *moduletools.ObjectDiff, icheck ClassSettings,
+) ([]float32, error) {
+	text, vectorize := v.input(className, schema, objDiff, icheck)
+
 	// no property was changed, old vector can be used
 	if !vectorize {
 		return objDiff.GetVec(), nil
 	}
 
-	text := strings.Join(corpi, " ")
+	config := ent.VectorizationConfig{
+		ClassName:            className,
+		Type:                 icheck.Type(),
+		Model:                icheck.Model(),
+		ModelVersion:         icheck.ModelVersion(),
+		Dimensions:           icheck.Dimensions(),
+		BaseURL:              icheck.BaseURL(),
+		RequestsPerMinute:    icheck.RequestsPerMinute(),
+		TokensPerMinute:      icheck.TokensPerMinute(),
+		ResourceName:         icheck.ResourceName(),
+		DeploymentID:         icheck.DeploymentID(),
+		IsAzure:              icheck.IsAzure(),
+		ApiVersion:           icheck.ApiVersion(),
+		Truncate:             icheck.Truncate(),
+		FallbackModel:        icheck.FallbackModel(),
+		FallbackModelVersion: icheck.FallbackModelVersion(),
+		AuthMode:             icheck.AuthMode(),
+		Organization:         icheck.Organization(),
+		Project:              icheck.Project(),
+		RequestTimeout:       icheck.ImportRequestTimeout(),
+		HTTPClientOptions:    icheck.HTTPClientOptions(),
+	}
+
+	var key cacheKey
+	if v.cache != nil {
+		key = getCacheKey(text, config)
+		if vector, ok := v.cache.get(key); ok {
+			return vector, nil
+		}
+	}
 
-	res, err := v.client.Vectorize(ctx, text, ent.VectorizationConfig{
-		Type:         icheck.Type(),
-		Model:        icheck.Model(),
-		ModelVersion: icheck.ModelVersion(),
-		ResourceName: icheck.ResourceName(),
-		DeploymentID: icheck.DeploymentID(),
-		IsAzure:      icheck.IsAzure(),
-	})
+	res, err := v.client.Vectorize(ctx, text, config)
 	if err != nil {
 		return nil, err
 	}
 
+	vector := res.Vector[0]
 	if len(res.Vector) > 1 {
-		return v.CombineVectors(res.Vector), nil
+		vector = v.CombineVectors(res.Vector)
+	}
+	if v.cache != nil {
+		v.cache.put(key, vector)
 	}
-	return res.Vector[0], nil
+	return vector, nil
 }
 
 func camelCaseToLower(in string) string {
@@ -37,6 +37,7 @@ func TestVectorizingObjects(t *testing.T) {
 		openAIType          string
 		openAIModel         string
 		openAIModelVersion  string
+		propertyWeights     map[string]int
 	}
 
 	tests := []testCase{
@@ -162,6 +163,27 @@ func TestVectorizingObjects(t *testing.T) {
 			},
 			expectedClientCall: "car reviews a very great car reviews you should consider buying one",
 		},
+		{
+			name: "with object and object[] props",
+			input: &models.Object{
+				Class: "Car",
+				Properties: map[string]interface{}{
+					"dealer": map[string]interface{}{
+						"name":  "Best Cars",
+						"since": 1999,
+						"address": map[string]interface{}{
+							"city": "Berlin",
+						},
+					},
+					"owners": []interface{}{
+						map[string]interface{}{"name": "Jane"},
+						map[string]interface{}{"name": "John"},
+					},
+				},
+			},
+			noindex:            "owners",
+			expectedClientCall: "car dealer berlin dealer best cars",
+		},
 		{
 			name: "with compound class and prop names",
 			input: &models.Object{
@@ -174,13 +196,26 @@ func TestVectorizingObjects(t *testing.T) {
 			},
 			expectedClientCall: "super car brand of the car best brand review a very great car",
 		},
+		{
+			name: "with weighted props",
+			input: &models.Object{
+				Class: "Car",
+				Properties: map[string]interface{}{
+					"brand":  "Mercedes",
+					"review": "a very great car",
+				},
+			},
+			excludedProperty:   "review",
+			propertyWeights:    map[string]int{"brand": 3},
+			expectedClientCall: "car brand mercedes brand mercedes brand mercedes a very great car",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			client := &fakeClient{}
 
-			v := New(client)
+			v := New(client, 0)
 
 			ic := &fakeSettings{
 				excludedProperty:   test.excludedProperty,
@@ -189,6 +224,7 @@ func TestVectorizingObjects(t *testing.T) {
 				openAIType:         test.openAIType,
 				openAIModel:        test.openAIModel,
 				openAIModelVersion: test.openAIModelVersion,
+				propertyWeights:    test.propertyWeights,
 			}
 			err := v.Object(context.Background(), test.input, nil, ic)
 
@@ -344,7 +380,7 @@ func TestVectorizingObjectWithDiff(t *testing.T) {
 			}
 
 			client := &fakeClient{}
-			v := New(client)
+			v := New(client, 0)
 
 			err := v.Object(context.Background(), test.input, test.diff, ic)
 
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// templatePlaceholder matches the {propertyName} placeholders of a
+// vectorizeTemplate, braces around anything else are kept as they are.
+// Nested properties are referenced by their dot path, e.g. {address.city}
+var templatePlaceholder = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*)\}`)
+
+// templateProperties returns the names of the properties referenced by the
+// template in the order of their first appearance
+func templateProperties(template string) []string {
+	var props []string
+	seen := map[string]struct{}{}
+	for _, match := range templatePlaceholder.FindAllStringSubmatch(template, -1) {
+		if _, ok := seen[match[1]]; ok {
+			continue
+		}
+		seen[match[1]] = struct{}{}
+		props = append(props, match[1])
+	}
+	return props
+}
+
+// templateRootProperty returns the name of the class property a template
+// placeholder refers to, i.e. the root of a dot path to a nested property
+func templateRootProperty(name string) string {
+	return strings.Split(name, schema.NestedPropertySeparator)[0]
+}
+
+// renderTemplate replaces the placeholders of the template with the values of
+// the referenced properties. Missing properties are replaced with an empty
+// string, the elements of arrays are separated by commas.
+func renderTemplate(template string, properties map[string]interface{}) string {
+	return templatePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		path := strings.Split(placeholder[1:len(placeholder)-1], schema.NestedPropertySeparator)
+		value, ok := properties[path[0]]
+		if !ok || value == nil {
+			return ""
+		}
+		if len(path) > 1 {
+			value = schema.GetNestedPropertyValues(value, path[1:])
+		}
+
+		return renderTemplateValue(value)
+	})
+}
+
+func renderTemplateValue(value interface{}) string {
+	switch val := value.(type) {
+	case string:
+		return val
+	case []string:
+		return strings.Join(val, ", ")
+	case []interface{}:
+		elems := make([]string, len(val))
+		for i := range val {
+			elems[i] = renderTemplateValue(val[i])
+		}
+		return strings.Join(elems, ", ")
+	default:
+		return fmt.Sprint(val)
+	}
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestVectorizingObjectsWithTemplate(t *testing.T) {
+	tests := []struct {
+		name               string
+		template           string
+		properties         map[string]interface{}
+		expectedClientCall string
+	}{
+		{
+			name:     "string props",
+			template: "Title: {title}\nBody: {body}",
+			properties: map[string]interface{}{
+				"title":  "Best Car",
+				"body":   "A Very Great Car",
+				"review": "not part of the template",
+			},
+			expectedClientCall: "Title: Best Car\nBody: A Very Great Car",
+		},
+		{
+			name:     "array and non-string props",
+			template: "{brand} with {power} hp, tags: {tags}",
+			properties: map[string]interface{}{
+				"brand": "Mercedes",
+				"power": 300,
+				"tags":  []interface{}{"fast", "black"},
+			},
+			expectedClientCall: "Mercedes with 300 hp, tags: fast, black",
+		},
+		{
+			name:     "missing props and other braces",
+			template: "{brand} {color} {not a placeholder}",
+			properties: map[string]interface{}{
+				"brand": "Mercedes",
+			},
+			expectedClientCall: "Mercedes  {not a placeholder}",
+		},
+		{
+			name:     "nested props",
+			template: "{dealer.name} in {dealer.address.city}, owners: {owners.name}",
+			properties: map[string]interface{}{
+				"dealer": map[string]interface{}{
+					"name": "Best Cars",
+					"address": map[string]interface{}{
+						"city": "Berlin",
+					},
+				},
+				"owners": []interface{}{
+					map[string]interface{}{"name": "Jane"},
+					map[string]interface{}{"name": "John"},
+				},
+			},
+			expectedClientCall: "Best Cars in Berlin, owners: Jane, John",
+		},
+		{
+			name:               "falls back to the class name",
+			template:           "{brand}",
+			properties:         map[string]interface{}{},
+			expectedClientCall: "super car",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &fakeClient{}
+			v := New(client, 0)
+			ic := &fakeSettings{vectorizeClassName: true, vectorizeTemplate: test.template}
+			obj := &models.Object{Class: "SuperCar", Properties: test.properties}
+
+			err := v.Object(context.Background(), obj, nil, ic)
+
+			require.Nil(t, err)
+			assert.Equal(t, []string{test.expectedClientCall}, client.lastInput)
+		})
+	}
+
+	t.Run("only changes of the referenced props are vectorized", func(t *testing.T) {
+		ic := &fakeSettings{vectorizeTemplate: "{title}"}
+		properties := map[string]interface{}{"title": "Best Car", "review": "Great"}
+
+		client := &fakeClient{}
+		err := New(client, 0).Object(context.Background(),
+			&models.Object{Class: "Car", Properties: properties},
+			newObjectDiffWithVector().WithProp("review", "Good", "Great"), ic)
+		require.Nil(t, err)
+		assert.Empty(t, client.lastInput)
+
+		err = New(client, 0).Object(context.Background(),
+			&models.Object{Class: "Car", Properties: properties},
+			newObjectDiffWithVector().WithProp("title", "Good Car", "Best Car"), ic)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Best Car"}, client.lastInput)
+	})
+}
+
+func TestTemplateProperties(t *testing.T) {
+	assert.Equal(t, []string{"title", "body"},
+		templateProperties("{title}: {body} ({title})"))
+	assert.Equal(t, []string{"dealer.address.city"},
+		templateProperties("{dealer.address.city} {dealer.}"))
+	assert.Empty(t, templateProperties("no {place holders} here { }"))
+}
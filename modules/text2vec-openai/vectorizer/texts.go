@@ -22,12 +22,25 @@ func (v *Vectorizer) Texts(ctx context.Context, inputs []string,
 	settings ClassSettings,
 ) ([]float32, error) {
 	res, err := v.client.VectorizeQuery(ctx, inputs, ent.VectorizationConfig{
-		Type:         settings.Type(),
-		Model:        settings.Model(),
-		ModelVersion: settings.ModelVersion(),
-		ResourceName: settings.ResourceName(),
-		DeploymentID: settings.DeploymentID(),
-		IsAzure:      settings.IsAzure(),
+		Type:                 settings.Type(),
+		Model:                settings.Model(),
+		ModelVersion:         settings.ModelVersion(),
+		Dimensions:           settings.Dimensions(),
+		BaseURL:              settings.BaseURL(),
+		RequestsPerMinute:    settings.RequestsPerMinute(),
+		TokensPerMinute:      settings.TokensPerMinute(),
+		ResourceName:         settings.ResourceName(),
+		DeploymentID:         settings.DeploymentID(),
+		IsAzure:              settings.IsAzure(),
+		ApiVersion:           settings.ApiVersion(),
+		Truncate:             settings.Truncate(),
+		FallbackModel:        settings.FallbackModel(),
+		FallbackModelVersion: settings.FallbackModelVersion(),
+		AuthMode:             settings.AuthMode(),
+		Organization:         settings.Organization(),
+		Project:              settings.Project(),
+		RequestTimeout:       settings.QueryRequestTimeout(),
+		HTTPClientOptions:    settings.HTTPClientOptions(),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "remote client vectorize")
@@ -137,7 +137,7 @@ func TestVectorizingTexts(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			client := &fakeClient{}
 
-			v := New(client)
+			v := New(client, 0)
 
 			settings := &fakeSettings{
 				openAIType:         test.openAIType,
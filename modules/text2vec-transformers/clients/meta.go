@@ -101,5 +101,10 @@ func (v *vectorizer) metaInfo(endpoint string) (map[string]interface{}, error) {
 	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
 		return nil, errors.Wrap(err, "unmarshal meta response body")
 	}
+	if _, ok := resBody["backend"]; !ok {
+		// inference containers which don't report their backend predate the
+		// ONNX runtime support and always run on PyTorch
+		resBody["backend"] = "pytorch"
+	}
 	return resBody, nil
 }
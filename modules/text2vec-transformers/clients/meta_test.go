@@ -24,7 +24,7 @@ func TestGetMeta(t *testing.T) {
 	t.Run("when common server is providing meta", func(t *testing.T) {
 		server := httptest.NewServer(&testMetaHandler{t: t})
 		defer server.Close()
-		v := New(server.URL, server.URL, nullLogger())
+		v := New(server.URL, server.URL, 1, 0, nullLogger())
 		meta, err := v.MetaInfo()
 
 		assert.Nil(t, err)
@@ -37,6 +37,17 @@ func TestGetMeta(t *testing.T) {
 		ID2Label := extractChildMap(t, model, "id2label")
 		assert.NotNil(t, ID2Label["0"])
 		assert.NotNil(t, ID2Label["1"])
+		assert.Equal(t, "pytorch", meta["backend"])
+	})
+
+	t.Run("when server is reporting its backend", func(t *testing.T) {
+		server := httptest.NewServer(&testMetaHandler{t: t, backend: "onnx"})
+		defer server.Close()
+		v := New(server.URL, server.URL, 1, 0, nullLogger())
+		meta, err := v.MetaInfo()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "onnx", meta["backend"])
 	})
 
 	t.Run("when passage and query servers are providing meta", func(t *testing.T) {
@@ -44,7 +55,7 @@ func TestGetMeta(t *testing.T) {
 		serverQuery := httptest.NewServer(&testMetaHandler{t: t, modelType: "query"})
 		defer serverPassage.Close()
 		defer serverQuery.Close()
-		v := New(serverPassage.URL, serverQuery.URL, nullLogger())
+		v := New(serverPassage.URL, serverQuery.URL, 1, 0, nullLogger())
 		meta, err := v.MetaInfo()
 
 		assert.Nil(t, err)
@@ -75,7 +86,7 @@ func TestGetMeta(t *testing.T) {
 		serverQuery := httptest.NewServer(&testMetaHandler{t: t, modelType: "query", readyTime: rt})
 		defer serverPassage.Close()
 		defer serverQuery.Close()
-		v := New(serverPassage.URL, serverQuery.URL, nullLogger())
+		v := New(serverPassage.URL, serverQuery.URL, 1, 0, nullLogger())
 		meta, err := v.MetaInfo()
 
 		assert.NotNil(t, err)
@@ -90,6 +101,7 @@ type testMetaHandler struct {
 	// the test handler will report as not ready before the time has passed
 	readyTime time.Time
 	modelType string
+	backend   string
 }
 
 func (h *testMetaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -101,6 +113,11 @@ func (h *testMetaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if h.backend != "" {
+		w.Write([]byte(`{"backend": "` + h.backend + `", "model": {}}`))
+		return
+	}
+
 	w.Write([]byte(h.metaInfo()))
 }
 
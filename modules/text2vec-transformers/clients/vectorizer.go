@@ -18,31 +18,85 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/modules/text2vec-transformers/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/batch"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const (
+	moduleName = "text2vec-transformers"
+
+	// DefaultMaxBatchSize of 1 disables batching, since it requires an
+	// inference container which serves batch requests
+	DefaultMaxBatchSize = 1
+	// DefaultMaxConcurrentRequests of 0 doesn't limit the requests in flight
+	DefaultMaxConcurrentRequests = 0
+
+	// batchWindow is how long the texts of a batch import are collected
+	// before a batch which isn't full yet is sent
+	batchWindow = 10 * time.Millisecond
 )
 
 type vectorizer struct {
-	originPassage string
-	originQuery   string
-	httpClient    *http.Client
-	logger        logrus.FieldLogger
+	originPassage         string
+	originQuery           string
+	maxBatchSize          int
+	maxConcurrentRequests int
+	httpClient            *http.Client
+	logger                logrus.FieldLogger
+
+	limitersLock sync.Mutex
+	limiters     map[limiterKey]*concurrencyLimiter
+
+	batchers *batch.Batchers[batchKey, string, vecRequest]
+}
+
+type limiterKey struct {
+	origin string
+	limit  int
+}
+
+type batchKey struct {
+	poolingStrategy       string
+	inferenceBackend      string
+	maxBatchSize          int
+	maxConcurrentRequests int
 }
 
-func New(originPassage, originQuery string, logger logrus.FieldLogger) *vectorizer {
+func New(originPassage, originQuery string, maxBatchSize,
+	maxConcurrentRequests int, logger logrus.FieldLogger,
+) *vectorizer {
 	return &vectorizer{
-		originPassage: originPassage,
-		originQuery:   originQuery,
-		httpClient:    &http.Client{},
-		logger:        logger,
+		originPassage:         originPassage,
+		originQuery:           originQuery,
+		maxBatchSize:          maxBatchSize,
+		maxConcurrentRequests: maxConcurrentRequests,
+		httpClient:            &http.Client{},
+		logger:                logger,
+		limiters:              map[limiterKey]*concurrencyLimiter{},
+		batchers:              batch.NewBatchers[batchKey, string, vecRequest](batch.DefaultIdleTimeout),
 	}
 }
 
 func (v *vectorizer) VectorizeObject(ctx context.Context, input string,
 	config ent.VectorizationConfig,
 ) (*ent.VectorizationResult, error) {
+	if v.getMaxBatchSize(config) > 1 {
+		res, err := v.getBatcher(config).Vectorize(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return &ent.VectorizationResult{
+			Text:       res.Text,
+			Dimensions: res.Dims,
+			Vector:     res.Vector,
+		}, nil
+	}
 	return v.vectorize(ctx, input, config, v.urlPassage)
 }
 
@@ -59,12 +113,19 @@ func (v *vectorizer) vectorize(ctx context.Context, input string,
 		Text: input,
 		Config: vecRequestConfig{
 			PoolingStrategy: config.PoolingStrategy,
+			Backend:         config.InferenceBackend,
 		},
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "marshal body")
 	}
 
+	limiter := v.getLimiter(url(""), config)
+	if err := limiter.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer limiter.release()
+
 	req, err := http.NewRequestWithContext(ctx, "POST", url("/vectors"),
 		bytes.NewReader(body))
 	if err != nil {
@@ -99,6 +160,112 @@ func (v *vectorizer) vectorize(ctx context.Context, input string,
 	}, nil
 }
 
+// vectorizeBatch sends the texts of a batch import in a single request to
+// the passage inference container
+func (v *vectorizer) vectorizeBatch(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) ([]vecRequest, error) {
+	body, err := json.Marshal(vecBatchRequest{
+		Texts: input,
+		Config: vecRequestConfig{
+			PoolingStrategy: config.PoolingStrategy,
+			Backend:         config.InferenceBackend,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	limiter := v.getLimiter(v.urlPassage(""), config)
+	if err := limiter.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer limiter.release()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", v.urlPassage("/vectors/batch"),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody vecBatchResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode > 399 {
+		return nil, errors.Errorf("fail with status %d: %s", res.StatusCode,
+			resBody.Error)
+	}
+
+	return resBody.Vectors, nil
+}
+
+// getLimiter returns the limiter shared by all requests against the same
+// inference container with the same limit. A limit set in the class settings
+// takes precedence over the one set in the environment.
+func (v *vectorizer) getLimiter(origin string,
+	config ent.VectorizationConfig,
+) *concurrencyLimiter {
+	key := limiterKey{origin: origin, limit: v.getMaxConcurrentRequests(config)}
+
+	v.limitersLock.Lock()
+	defer v.limitersLock.Unlock()
+
+	limiter, ok := v.limiters[key]
+	if !ok {
+		metrics := monitoring.GetMetrics()
+		limiter = newConcurrencyLimiter(key.limit,
+			metrics.ModuleExternalRequestsQueued.WithLabelValues(moduleName),
+			metrics.ModuleExternalRequestWaitDurations.WithLabelValues(moduleName))
+		v.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// getBatcher returns the batcher collecting the texts which are vectorized
+// with the same settings
+func (v *vectorizer) getBatcher(config ent.VectorizationConfig) *batch.Batcher[string, vecRequest] {
+	key := batchKey{
+		poolingStrategy:       config.PoolingStrategy,
+		inferenceBackend:      config.InferenceBackend,
+		maxBatchSize:          v.getMaxBatchSize(config),
+		maxConcurrentRequests: config.MaxConcurrentRequests,
+	}
+
+	return v.batchers.Get(key, func() *batch.Batcher[string, vecRequest] {
+		return batch.NewBatcher(key.maxBatchSize, batchWindow,
+			func(ctx context.Context, input []string) ([]vecRequest, error) {
+				return v.vectorizeBatch(ctx, input, config)
+			})
+	})
+}
+
+func (v *vectorizer) getMaxBatchSize(config ent.VectorizationConfig) int {
+	if config.MaxBatchSize > 0 {
+		return config.MaxBatchSize
+	}
+	return v.maxBatchSize
+}
+
+func (v *vectorizer) getMaxConcurrentRequests(config ent.VectorizationConfig) int {
+	if config.MaxConcurrentRequests > 0 {
+		return config.MaxConcurrentRequests
+	}
+	return v.maxConcurrentRequests
+}
+
 func (v *vectorizer) urlPassage(path string) string {
 	return fmt.Sprintf("%s%s", v.originPassage, path)
 }
@@ -115,6 +282,18 @@ type vecRequest struct {
 	Config vecRequestConfig `json:"config"`
 }
 
+type vecBatchRequest struct {
+	Texts  []string         `json:"texts"`
+	Config vecRequestConfig `json:"config"`
+}
+
+type vecBatchResponse struct {
+	Vectors []vecRequest `json:"vectors"`
+	Error   string       `json:"error"`
+}
+
 type vecRequestConfig struct {
 	PoolingStrategy string `json:"pooling_strategy"`
+	// Backend is left empty to use the backend the container was started with
+	Backend string `json:"backend,omitempty"`
 }
@@ -18,6 +18,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -31,7 +32,7 @@ func TestClient(t *testing.T) {
 	t.Run("when all is fine", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
-		c := New(server.URL, server.URL, nullLogger())
+		c := New(server.URL, server.URL, 1, 0, nullLogger())
 		expected := &ent.VectorizationResult{
 			Text:       "This is my text",
 			Vector:     []float32{0.1, 0.2, 0.3},
@@ -49,7 +50,7 @@ func TestClient(t *testing.T) {
 	t.Run("when the context is expired", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
-		c := New(server.URL, server.URL, nullLogger())
+		c := New(server.URL, server.URL, 1, 0, nullLogger())
 		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
 		defer cancel()
 
@@ -65,13 +66,62 @@ func TestClient(t *testing.T) {
 			serverError: errors.Errorf("nope, not gonna happen"),
 		})
 		defer server.Close()
-		c := New(server.URL, server.URL, nullLogger())
+		c := New(server.URL, server.URL, 1, 0, nullLogger())
 		_, err := c.VectorizeObject(context.Background(), "This is my text",
 			ent.VectorizationConfig{})
 
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "nope, not gonna happen")
 	})
+
+	t.Run("when batching is enabled", func(t *testing.T) {
+		var lock sync.Mutex
+		var batches [][]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/vectors/batch", r.URL.String())
+
+			var b vecBatchRequest
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			assert.Equal(t, "cls", b.Config.PoolingStrategy)
+			lock.Lock()
+			batches = append(batches, b.Texts)
+			lock.Unlock()
+
+			res := vecBatchResponse{}
+			for _, text := range b.Texts {
+				res.Vectors = append(res.Vectors, vecRequest{
+					Text:   text,
+					Dims:   1,
+					Vector: []float32{float32(len(text))},
+				})
+			}
+			require.Nil(t, json.NewEncoder(w).Encode(res))
+		}))
+		defer server.Close()
+		c := New(server.URL, server.URL, 1, 0, nullLogger())
+
+		texts := []string{"a", "bb", "ccc", "dddd"}
+		results := make([]*ent.VectorizationResult, len(texts))
+		errs := make([]error, len(texts))
+		var wg sync.WaitGroup
+		for i := range texts {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], errs[i] = c.VectorizeObject(context.Background(), texts[i],
+					ent.VectorizationConfig{PoolingStrategy: "cls", MaxBatchSize: 4})
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range texts {
+			require.Nil(t, errs[i])
+			assert.Equal(t, texts[i], results[i].Text)
+			assert.Equal(t, []float32{float32(len(texts[i]))}, results[i].Vector)
+		}
+		require.Len(t, batches, 1)
+		assert.ElementsMatch(t, texts, batches[0])
+	})
 }
 
 type fakeHandler struct {
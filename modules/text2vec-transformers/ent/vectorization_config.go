@@ -12,5 +12,8 @@
 package ent
 
 type VectorizationConfig struct {
-	PoolingStrategy string
+	PoolingStrategy       string
+	InferenceBackend      string
+	MaxBatchSize          int
+	MaxConcurrentRequests int
 }
@@ -15,6 +15,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -126,7 +127,19 @@ func (m *TransformersModule) initVectorizer(ctx context.Context,
 		uriQuery = uriCommon
 	}
 
-	client := clients.New(uriPassage, uriQuery, logger)
+	maxBatchSize, err := intFromEnv("TRANSFORMERS_MAX_BATCH_SIZE",
+		clients.DefaultMaxBatchSize)
+	if err != nil {
+		return err
+	}
+	maxConcurrentRequests, err := intFromEnv("TRANSFORMERS_MAX_CONCURRENT_REQUESTS",
+		clients.DefaultMaxConcurrentRequests)
+	if err != nil {
+		return err
+	}
+
+	client := clients.New(uriPassage, uriQuery, maxBatchSize,
+		maxConcurrentRequests, logger)
 	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
 		return errors.Wrap(err, "init remote vectorizer")
 	}
@@ -175,3 +188,15 @@ var (
 	_ = modulecapabilities.Vectorizer(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
+
+func intFromEnv(name string, defaultValue int) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+	asInt, err := strconv.Atoi(v)
+	if err != nil || asInt < 0 {
+		return 0, errors.Errorf("%s must be a non-negative integer, got %q", name, v)
+	}
+	return asInt, nil
+}
@@ -12,6 +12,9 @@
 package vectorizer
 
 import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/moduletools"
 )
 
@@ -20,8 +23,15 @@ const (
 	DefaultVectorizeClassName    = true
 	DefaultVectorizePropertyName = false
 	DefaultPoolingStrategy       = "masked_mean"
+	// DefaultInferenceBackend leaves the choice of the backend to the
+	// inference container
+	DefaultInferenceBackend = ""
 )
 
+// the inference container can run the models either with PyTorch or with
+// the ONNX runtime, which is considerably faster on CPU-only deployments
+var availableInferenceBackends = []string{"pytorch", "onnx"}
+
 type classSettings struct {
 	cfg moduletools.ClassConfig
 }
@@ -104,3 +114,81 @@ func (ic *classSettings) PoolingStrategy() string {
 
 	return asString
 }
+
+func (ic *classSettings) InferenceBackend() string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultInferenceBackend
+	}
+
+	vcn, ok := ic.cfg.Class()["inferenceBackend"]
+	if !ok {
+		return DefaultInferenceBackend
+	}
+
+	asString, ok := vcn.(string)
+	if !ok {
+		return DefaultInferenceBackend
+	}
+
+	return asString
+}
+
+// MaxBatchSize overrides the maximum number of texts which are sent to the
+// inference container in a single request, 0 means the module default is used
+func (ic *classSettings) MaxBatchSize() int {
+	return ic.getIntProperty("maxBatchSize")
+}
+
+// MaxConcurrentRequests overrides the maximum number of requests in flight to
+// the inference container, 0 means the module default is used
+func (ic *classSettings) MaxConcurrentRequests() int {
+	return ic.getIntProperty("maxConcurrentRequests")
+}
+
+func (ic *classSettings) Validate() error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return nil
+	}
+
+	if ic.MaxBatchSize() < 0 || ic.MaxConcurrentRequests() < 0 {
+		return errors.New("maxBatchSize and maxConcurrentRequests must not be negative")
+	}
+
+	backend := ic.InferenceBackend()
+	if backend == DefaultInferenceBackend {
+		return nil
+	}
+	for _, available := range availableInferenceBackends {
+		if backend == available {
+			return nil
+		}
+	}
+	return errors.Errorf("wrong inferenceBackend, available backends are: %v",
+		availableInferenceBackends)
+}
+
+func (ic *classSettings) getIntProperty(name string) int {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return 0
+	}
+
+	switch v := ic.cfg.Class()[name].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case json.Number:
+		asInt, err := v.Int64()
+		if err != nil {
+			return -1
+		}
+		return int(asInt)
+	default:
+		return 0
+	}
+}
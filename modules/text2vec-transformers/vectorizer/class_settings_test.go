@@ -12,6 +12,7 @@
 package vectorizer
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -107,4 +108,76 @@ func TestClassSettings(t *testing.T) {
 		assert.False(t, ic.VectorizeClassName())
 		assert.Equal(t, ic.PoolingStrategy(), "cls")
 	})
+
+	t.Run("with an inference backend", func(t *testing.T) {
+		class := &models.Class{
+			Class: "MyClass",
+			ModuleConfig: map[string]interface{}{
+				"my-module": map[string]interface{}{
+					"inferenceBackend": "onnx",
+				},
+			},
+		}
+
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+		ic := NewClassSettings(cfg)
+
+		assert.Equal(t, "onnx", ic.InferenceBackend())
+		assert.Nil(t, ic.Validate())
+	})
+
+	t.Run("with batching and concurrency limits", func(t *testing.T) {
+		class := &models.Class{
+			Class: "MyClass",
+			ModuleConfig: map[string]interface{}{
+				"my-module": map[string]interface{}{
+					"maxBatchSize":          json.Number("32"),
+					"maxConcurrentRequests": float64(4),
+				},
+			},
+		}
+
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+		ic := NewClassSettings(cfg)
+
+		assert.Equal(t, 32, ic.MaxBatchSize())
+		assert.Equal(t, 4, ic.MaxConcurrentRequests())
+		assert.Nil(t, ic.Validate())
+	})
+
+	t.Run("with a negative batch size", func(t *testing.T) {
+		class := &models.Class{
+			Class: "MyClass",
+			ModuleConfig: map[string]interface{}{
+				"my-module": map[string]interface{}{
+					"maxBatchSize": -1,
+				},
+			},
+		}
+
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+		ic := NewClassSettings(cfg)
+
+		err := ic.Validate()
+		assert.NotNil(t, err)
+		assert.Equal(t, "maxBatchSize and maxConcurrentRequests must not be negative", err.Error())
+	})
+
+	t.Run("with an unknown inference backend", func(t *testing.T) {
+		class := &models.Class{
+			Class: "MyClass",
+			ModuleConfig: map[string]interface{}{
+				"my-module": map[string]interface{}{
+					"inferenceBackend": "tensorflow",
+				},
+			},
+		}
+
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+		ic := NewClassSettings(cfg)
+
+		err := ic.Validate()
+		assert.NotNil(t, err)
+		assert.Equal(t, "wrong inferenceBackend, available backends are: [pytorch onnx]", err.Error())
+	})
 }
@@ -45,6 +45,9 @@ type fakeSettings struct {
 	vectorizeClassName bool
 	excludedProperty   string
 	poolingStrategy    string
+	inferenceBackend   string
+	maxBatchSize       int
+	maxConcurrency     int
 }
 
 func (f *fakeSettings) PropertyIndexed(propName string) bool {
@@ -62,3 +65,15 @@ func (f *fakeSettings) VectorizeClassName() bool {
 func (f *fakeSettings) PoolingStrategy() string {
 	return f.poolingStrategy
 }
+
+func (f *fakeSettings) InferenceBackend() string {
+	return f.inferenceBackend
+}
+
+func (f *fakeSettings) MaxBatchSize() int {
+	return f.maxBatchSize
+}
+
+func (f *fakeSettings) MaxConcurrentRequests() int {
+	return f.maxConcurrency
+}
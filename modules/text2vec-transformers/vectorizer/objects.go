@@ -46,6 +46,9 @@ type ClassSettings interface {
 	VectorizeClassName() bool
 	VectorizePropertyName(propertyName string) bool
 	PoolingStrategy() string
+	InferenceBackend() string
+	MaxBatchSize() int
+	MaxConcurrentRequests() int
 }
 
 func sortStringKeys(schemaMap map[string]interface{}) []string {
@@ -131,7 +134,10 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 
 	text := strings.Join(corpi, " ")
 	res, err := v.client.VectorizeObject(ctx, text, ent.VectorizationConfig{
-		PoolingStrategy: icheck.PoolingStrategy(),
+		PoolingStrategy:       icheck.PoolingStrategy(),
+		InferenceBackend:      icheck.InferenceBackend(),
+		MaxBatchSize:          icheck.MaxBatchSize(),
+		MaxConcurrentRequests: icheck.MaxConcurrentRequests(),
 	})
 	if err != nil {
 		return nil, err
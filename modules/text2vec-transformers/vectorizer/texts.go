@@ -23,7 +23,9 @@ func (v *Vectorizer) Texts(ctx context.Context, inputs []string,
 	settings ClassSettings,
 ) ([]float32, error) {
 	res, err := v.client.VectorizeQuery(ctx, v.joinSentences(inputs), ent.VectorizationConfig{
-		PoolingStrategy: settings.PoolingStrategy(),
+		PoolingStrategy:       settings.PoolingStrategy(),
+		InferenceBackend:      settings.InferenceBackend(),
+		MaxConcurrentRequests: settings.MaxConcurrentRequests(),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "remote client vectorize")
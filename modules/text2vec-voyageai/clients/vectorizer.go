@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/text2vec-voyageai/ent"
+)
+
+// input types tell VoyageAI whether the text is going to be retrieved or
+// used to retrieve, which the models take into account when embedding it
+const (
+	inputTypeDocument = "document"
+	inputTypeQuery    = "query"
+)
+
+type embeddingsRequest struct {
+	Input      []string `json:"input"`
+	Model      string   `json:"model,omitempty"`
+	InputType  string   `json:"input_type,omitempty"`
+	Truncation bool     `json:"truncation"`
+}
+
+type embeddingsResponse struct {
+	Data   []embeddingData `json:"data,omitempty"`
+	Detail string          `json:"detail,omitempty"`
+}
+
+type embeddingData struct {
+	Embedding []float32 `json:"embedding"`
+	Index     int       `json:"index"`
+}
+
+type vectorizer struct {
+	apiKey      string
+	retryPolicy moduletools.RetryPolicy
+	httpClient  *http.Client
+	urlBuilder  *voyageaiUrlBuilder
+	logger      logrus.FieldLogger
+}
+
+func New(apiKey string, retryPolicy moduletools.RetryPolicy,
+	transport http.RoundTripper, logger logrus.FieldLogger,
+) *vectorizer {
+	return &vectorizer{
+		apiKey:      apiKey,
+		retryPolicy: retryPolicy,
+		httpClient:  &http.Client{Transport: transport},
+		urlBuilder:  newVoyageAIUrlBuilder(),
+		logger:      logger,
+	}
+}
+
+func (v *vectorizer) Vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, v.url(), v.getModel(config), inputTypeDocument, v.getTruncate(config))
+}
+
+func (v *vectorizer) VectorizeQuery(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, v.url(), v.getModel(config), inputTypeQuery, v.getTruncate(config))
+}
+
+func (v *vectorizer) vectorize(ctx context.Context, input []string,
+	url string, model string, inputType string, truncate bool,
+) (*ent.VectorizationResult, error) {
+	body, err := json.Marshal(embeddingsRequest{
+		Input:      input,
+		Model:      model,
+		InputType:  inputType,
+		Truncation: truncate,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	var resBody *embeddingsResponse
+	err = v.retryPolicy.Do(ctx, func() error {
+		resBody, err = v.sendRequest(ctx, url, body)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resBody.Data) == 0 || len(resBody.Data[0].Embedding) == 0 {
+		return nil, errors.Errorf("empty embeddings response")
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input,
+		Dimensions: len(resBody.Data[0].Embedding),
+		Vector:     resBody.Data[0].Embedding,
+	}, nil
+}
+
+func (v *vectorizer) sendRequest(ctx context.Context, url string,
+	body []byte,
+) (*embeddingsResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	apiKey, err := v.getApiKey(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "VoyageAI API Key")
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		err = errors.Wrap(err, "send POST request")
+		if ctx.Err() == nil {
+			return nil, moduletools.NewRetryableError(err, 0)
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+	var resBody embeddingsResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		err = errors.Wrap(err, "unmarshal response body")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(err, moduletools.RetryAfter(res.Header))
+		}
+		return nil, err
+	}
+
+	if res.StatusCode >= 500 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Detail, "connection to VoyageAI failed with status: %d error: %v")
+		return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+	} else if res.StatusCode > 200 {
+		errorMessage := getErrorMessage(res.StatusCode, resBody.Detail, "failed with status: %d error: %v")
+		if moduletools.IsRetryableStatusCode(res.StatusCode) {
+			return nil, moduletools.NewRetryableError(errors.Errorf(errorMessage), moduletools.RetryAfter(res.Header))
+		}
+		return nil, errors.Errorf(errorMessage)
+	}
+
+	return &resBody, nil
+}
+
+func getErrorMessage(statusCode int, resBodyError string, errorTemplate string) string {
+	if resBodyError != "" {
+		return fmt.Sprintf(errorTemplate, statusCode, resBodyError)
+	}
+	return fmt.Sprintf(errorTemplate, statusCode)
+}
+
+func (v *vectorizer) getApiKey(ctx context.Context) (string, error) {
+	if len(v.apiKey) > 0 {
+		return v.apiKey, nil
+	}
+	apiKey := ctx.Value("X-Voyageai-Api-Key")
+	if apiKeyHeader, ok := apiKey.([]string); ok &&
+		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
+		return apiKeyHeader[0], nil
+	}
+	return "", errors.New("no api key found " +
+		"neither in request header: X-VoyageAI-Api-Key " +
+		"nor in environment variable under VOYAGEAI_APIKEY")
+}
+
+func (v *vectorizer) url() string {
+	return v.urlBuilder.url()
+}
+
+func (v *vectorizer) getModel(config ent.VectorizationConfig) string {
+	return config.Model
+}
+
+func (v *vectorizer) getTruncate(config ent.VectorizationConfig) bool {
+	return config.Truncate
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "Test",
+		Properties: []*models.Property{
+			{
+				DataType: []string{"text"},
+				Name:     "test",
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		cfg          fakeClassConfig
+		wantModel    string
+		wantTruncate bool
+		wantErr      string
+	}{
+		{
+			name:         "default settings",
+			cfg:          fakeClassConfig{classConfig: map[string]interface{}{}},
+			wantModel:    "voyage-2",
+			wantTruncate: true,
+		},
+		{
+			name: "custom settings",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"model":    "voyage-code-2",
+				"truncate": false,
+			}},
+			wantModel:    "voyage-code-2",
+			wantTruncate: false,
+		},
+		{
+			name: "wrong model",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"model": "voyage-unknown",
+			}},
+			wantErr: "wrong VoyageAI model name, available model names are: " +
+				"[voyage-2 voyage-large-2 voyage-code-2 voyage-lite-02-instruct]",
+		},
+		{
+			name: "truncate is not a boolean",
+			cfg: fakeClassConfig{classConfig: map[string]interface{}{
+				"truncate": "END",
+			}},
+			wantErr: "wrong truncate setting, expected a boolean, got END",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := NewClassSettings(tt.cfg)
+			err := cs.Validate(class)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantModel, cs.Model())
+			assert.Equal(t, tt.wantTruncate, cs.Truncate())
+		})
+	}
+}
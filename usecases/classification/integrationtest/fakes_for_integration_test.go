@@ -372,7 +372,7 @@ func (f *fakeRemoteClient) PutFile(ctx context.Context, hostName, indexName,
 
 func (f *fakeRemoteClient) GetObject(ctx context.Context, hostName, indexName,
 	shardName string, id strfmt.UUID, props search.SelectProperties,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) (*storobj.Object, error) {
 	return nil, nil
 }
@@ -409,9 +409,10 @@ func (f *fakeRemoteClient) MergeObject(ctx context.Context, hostName, indexName,
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
-	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
+	shardName string, vector []float32, targetVector string, indexParams *searchparams.VectorIndex,
+	limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties,
+	class *models.Class,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
@@ -421,7 +422,7 @@ func (f *fakeRemoteClient) BatchPutObjects(ctx context.Context, hostName, indexN
 }
 
 func (f *fakeRemoteClient) MultiGetObjects(ctx context.Context, hostName, indexName,
-	shardName string, ids []strfmt.UUID,
+	shardName string, ids []strfmt.UUID, class *models.Class,
 ) ([]*storobj.Object, error) {
 	return nil, nil
 }
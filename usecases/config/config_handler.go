@@ -71,33 +71,35 @@ type Flags struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                                string         `json:"name" yaml:"name"`
-	Debug                               bool           `json:"debug" yaml:"debug"`
-	QueryDefaults                       QueryDefaults  `json:"query_defaults" yaml:"query_defaults"`
-	QueryMaximumResults                 int64          `json:"query_maximum_results" yaml:"query_maximum_results"`
-	Contextionary                       Contextionary  `json:"contextionary" yaml:"contextionary"`
-	Authentication                      Authentication `json:"authentication" yaml:"authentication"`
-	Authorization                       Authorization  `json:"authorization" yaml:"authorization"`
-	Origin                              string         `json:"origin" yaml:"origin"`
-	Persistence                         Persistence    `json:"persistence" yaml:"persistence"`
-	DefaultVectorizerModule             string         `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
-	DefaultVectorDistanceMetric         string         `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
-	EnableModules                       string         `json:"enable_modules" yaml:"enable_modules"`
-	ModulesPath                         string         `json:"modules_path" yaml:"modules_path"`
-	AutoSchema                          AutoSchema     `json:"auto_schema" yaml:"auto_schema"`
-	Cluster                             cluster.Config `json:"cluster" yaml:"cluster"`
-	Monitoring                          Monitoring     `json:"monitoring" yaml:"monitoring"`
-	GRPC                                GRPC           `json:"grpc" yaml:"grpc"`
-	Profiling                           Profiling      `json:"profiling" yaml:"profiling"`
-	ResourceUsage                       ResourceUsage  `json:"resource_usage" yaml:"resource_usage"`
-	MaxImportGoroutinesFactor           float64        `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
-	MaximumConcurrentGetRequests        int            `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
-	TrackVectorDimensions               bool           `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
-	ReindexVectorDimensionsAtStartup    bool           `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
-	RecountPropertiesAtStartup          bool           `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
-	ReindexSetToRoaringsetAtStartup     bool           `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
-	IndexMissingTextFilterableAtStartup bool           `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
-	DisableGraphQL                      bool           `json:"disable_graphql" yaml:"disable_graphql"`
+	Name                                string           `json:"name" yaml:"name"`
+	Debug                               bool             `json:"debug" yaml:"debug"`
+	QueryDefaults                       QueryDefaults    `json:"query_defaults" yaml:"query_defaults"`
+	QueryMaximumResults                 int64            `json:"query_maximum_results" yaml:"query_maximum_results"`
+	Contextionary                       Contextionary    `json:"contextionary" yaml:"contextionary"`
+	Authentication                      Authentication   `json:"authentication" yaml:"authentication"`
+	Authorization                       Authorization    `json:"authorization" yaml:"authorization"`
+	Origin                              string           `json:"origin" yaml:"origin"`
+	Persistence                         Persistence      `json:"persistence" yaml:"persistence"`
+	DefaultVectorizerModule             string           `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
+	DefaultVectorDistanceMetric         string           `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
+	EnableModules                       string           `json:"enable_modules" yaml:"enable_modules"`
+	ModulesPath                         string           `json:"modules_path" yaml:"modules_path"`
+	AutoSchema                          AutoSchema       `json:"auto_schema" yaml:"auto_schema"`
+	Cluster                             cluster.Config   `json:"cluster" yaml:"cluster"`
+	Monitoring                          Monitoring       `json:"monitoring" yaml:"monitoring"`
+	GRPC                                GRPC             `json:"grpc" yaml:"grpc"`
+	Profiling                           Profiling        `json:"profiling" yaml:"profiling"`
+	ResourceUsage                       ResourceUsage    `json:"resource_usage" yaml:"resource_usage"`
+	MaxImportGoroutinesFactor           float64          `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
+	MaximumConcurrentGetRequests        int              `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
+	TrackVectorDimensions               bool             `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
+	ReindexVectorDimensionsAtStartup    bool             `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
+	RecountPropertiesAtStartup          bool             `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
+	ReindexSetToRoaringsetAtStartup     bool             `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	IndexMissingTextFilterableAtStartup bool             `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
+	DisableGraphQL                      bool             `json:"disable_graphql" yaml:"disable_graphql"`
+	AsyncIndexing                       bool             `json:"async_indexing" yaml:"async_indexing"`
+	TombstoneCleanup                    TombstoneCleanup `json:"tombstone_cleanup" yaml:"tombstone_cleanup"`
 }
 
 type moduleProvider interface {
@@ -184,12 +186,35 @@ type Profiling struct {
 	MutexProfileFraction int `json:"mutexProfileFraction" yaml:"mutexProfileFraction"`
 }
 
+// TombstoneCleanup controls how the vector index removes deleted objects in
+// the background
+type TombstoneCleanup struct {
+	// Concurrency is the number of goroutines reassigning edges per shard
+	Concurrency int `json:"concurrency" yaml:"concurrency"`
+	// MaxPerCycle limits the tombstones removed in a single cycle, 0 means no
+	// limit
+	MaxPerCycle int `json:"max_per_cycle" yaml:"max_per_cycle"`
+	// IntervalSeconds overrides the cleanupIntervalSeconds of all classes if
+	// it is set
+	IntervalSeconds int `json:"interval_seconds" yaml:"interval_seconds"`
+}
+
 type Persistence struct {
-	DataPath                          string `json:"dataPath" yaml:"dataPath"`
-	FlushIdleMemtablesAfter           int    `json:"flushIdleMemtablesAfter" yaml:"flushIdleMemtablesAfter"`
-	MemtablesMaxSizeMB                int    `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
-	MemtablesMinActiveDurationSeconds int    `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
-	MemtablesMaxActiveDurationSeconds int    `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	DataPath                          string        `json:"dataPath" yaml:"dataPath"`
+	FlushIdleMemtablesAfter           int           `json:"flushIdleMemtablesAfter" yaml:"flushIdleMemtablesAfter"`
+	MemtablesMaxSizeMB                int           `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
+	MemtablesMinActiveDurationSeconds int           `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
+	MemtablesMaxActiveDurationSeconds int           `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	HNSWSnapshots                     HNSWSnapshots `json:"hnswSnapshots" yaml:"hnswSnapshots"`
+}
+
+// HNSWSnapshots controls whether the vector index periodically persists its
+// graph, so that startup only needs to replay the commit logs written since
+type HNSWSnapshots struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// MinDeltaSizePercentage is the size of the commit logs written since the
+	// last snapshot, relative to that snapshot, required for a new snapshot
+	MinDeltaSizePercentage int `json:"minDeltaSizePercentage" yaml:"minDeltaSizePercentage"`
 }
 
 func (p Persistence) Validate() error {
@@ -54,6 +54,10 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if enabled(os.Getenv("ASYNC_INDEXING")) {
+		config.AsyncIndexing = true
+	}
+
 	// Recount all property lengths at startup to support accurate BM25 scoring
 	if enabled(os.Getenv("RECOUNT_PROPERTIES_AT_STARTUP")) {
 		config.RecountPropertiesAtStartup = true
@@ -268,10 +272,42 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	if err := config.parseTombstoneCleanupConfig(); err != nil {
+		return err
+	}
+
 	config.DisableGraphQL = enabled(os.Getenv("DISABLE_GRAPHQL"))
 	return nil
 }
 
+func (c *Config) parseTombstoneCleanupConfig() error {
+	if err := parsePositiveInt(
+		"TOMBSTONE_DELETION_CONCURRENCY",
+		func(val int) { c.TombstoneCleanup.Concurrency = val },
+		DefaultTombstoneDeletionConcurrency,
+	); err != nil {
+		return err
+	}
+
+	if err := parseNonNegativeInt(
+		"TOMBSTONE_DELETION_MAX_PER_CYCLE",
+		func(val int) { c.TombstoneCleanup.MaxPerCycle = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parseNonNegativeInt(
+		"TOMBSTONE_DELETION_INTERVAL_SECONDS",
+		func(val int) { c.TombstoneCleanup.IntervalSeconds = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *Config) parseMemtableConfig() error {
 	// first parse old name for flush value
 	if err := parsePositiveInt(
@@ -315,6 +351,16 @@ func (c *Config) parseMemtableConfig() error {
 		return err
 	}
 
+	c.Persistence.HNSWSnapshots.Enabled = enabled(os.Getenv("PERSISTENCE_HNSW_SNAPSHOTS_ENABLED"))
+
+	if err := parseNonNegativeInt(
+		"PERSISTENCE_HNSW_SNAPSHOT_MIN_DELTA_SIZE_PERCENTAGE",
+		func(val int) { c.Persistence.HNSWSnapshots.MinDeltaSizePercentage = val },
+		DefaultPersistenceHNSWSnapshotMinDeltaSizePercentage,
+	); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -335,6 +381,23 @@ func parsePositiveInt(varName string, cb func(val int), defaultValue int) error
 	return nil
 }
 
+func parseNonNegativeInt(varName string, cb func(val int), defaultValue int) error {
+	if v := os.Getenv(varName); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse %s as int: %w", varName, err)
+		} else if asInt < 0 {
+			return fmt.Errorf("%s must not be negative", varName)
+		}
+
+		cb(asInt)
+	} else {
+		cb(defaultValue)
+	}
+
+	return nil
+}
+
 const DefaultQueryMaximumResults = int64(10000)
 
 const (
@@ -344,6 +407,9 @@ const (
 	DefaultPersistenceMemtablesMaxDuration    = 45
 	DefaultMaxConcurrentGetRequests           = 0
 	DefaultGRPCPort                           = 50051
+	DefaultTombstoneDeletionConcurrency       = 1
+
+	DefaultPersistenceHNSWSnapshotMinDeltaSizePercentage = 5
 )
 
 const VectorizerModuleNone = "none"
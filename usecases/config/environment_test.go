@@ -472,3 +472,63 @@ func TestEnvironmentPrometheusGroupClasses_NewName(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentTombstoneDeletionConcurrency(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int
+		expectedErr bool
+	}{
+		{"Valid", []string{"4"}, 4, false},
+		{"not given", []string{}, DefaultTombstoneDeletionConcurrency, false},
+		{"invalid factor", []string{"-1"}, -1, true},
+		{"zero factor", []string{"0"}, -1, true},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("TOMBSTONE_DELETION_CONCURRENCY", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.TombstoneCleanup.Concurrency)
+			}
+		})
+	}
+}
+
+func TestEnvironmentTombstoneDeletionMaxPerCycle(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int
+		expectedErr bool
+	}{
+		{"Valid", []string{"10000"}, 10000, false},
+		{"not given", []string{}, 0, false},
+		{"unlimited", []string{"0"}, 0, false},
+		{"invalid factor", []string{"-1"}, -1, true},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("TOMBSTONE_DELETION_MAX_PER_CYCLE", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.TombstoneCleanup.MaxPerCycle)
+			}
+		})
+	}
+}
@@ -31,12 +31,13 @@ type generativeClient interface {
 }
 
 type GenerateProvider struct {
+	moduleName                string
 	client                    generativeClient
 	maximumNumberOfGoroutines int
 }
 
-func New(client generativeClient) *GenerateProvider {
-	return &GenerateProvider{client, maximumNumberOfGoroutines}
+func New(moduleName string, client generativeClient) *GenerateProvider {
+	return &GenerateProvider{moduleName, client, maximumNumberOfGoroutines}
 }
 
 func (p *GenerateProvider) AdditionalPropertyDefaultValue() interface{} {
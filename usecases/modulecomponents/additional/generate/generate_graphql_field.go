@@ -18,6 +18,23 @@ import (
 )
 
 func (p *GenerateProvider) additionalGenerateField(classname string) *graphql.Field {
+	usage := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditionalGenerateUsage", classname),
+		Fields: graphql.Fields{
+			"model":            &graphql.Field{Type: graphql.String},
+			"promptTokens":     &graphql.Field{Type: graphql.Int},
+			"completionTokens": &graphql.Field{Type: graphql.Int},
+			"totalTokens":      &graphql.Field{Type: graphql.Int},
+			"estimatedCost":    &graphql.Field{Type: graphql.Float, Description: "Estimated cost in USD based on list prices"},
+		},
+	})
+	variables := graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sGenerateTemplateVariable", classname),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"value": &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	}))
 	return &graphql.Field{
 		Args: graphql.FieldConfigArgument{
 			"singleResult": &graphql.ArgumentConfig{
@@ -29,6 +46,18 @@ func (p *GenerateProvider) additionalGenerateField(classname string) *graphql.Fi
 							Description: "prompt",
 							Type:        graphql.String,
 						},
+						"template": &graphql.InputObjectFieldConfig{
+							Description: "Name of a prompt template of the class config used instead of the prompt",
+							Type:        graphql.String,
+						},
+						"variables": &graphql.InputObjectFieldConfig{
+							Description: "Values of the {variables} of the prompt",
+							Type:        variables,
+						},
+						"imageProperties": &graphql.InputObjectFieldConfig{
+							Description: "Blob properties passed as images to vision capable models",
+							Type:        graphql.NewList(graphql.String),
+						},
 					},
 				}),
 				DefaultValue: nil,
@@ -42,15 +71,47 @@ func (p *GenerateProvider) additionalGenerateField(classname string) *graphql.Fi
 							Description: "task",
 							Type:        graphql.String,
 						},
+						"template": &graphql.InputObjectFieldConfig{
+							Description: "Name of a prompt template of the class config used instead of the task",
+							Type:        graphql.String,
+						},
+						"variables": &graphql.InputObjectFieldConfig{
+							Description: "Values of the {variables} of the task",
+							Type:        variables,
+						},
 						"properties": &graphql.InputObjectFieldConfig{
 							Description:  "Properties used for the generation",
 							Type:         graphql.NewList(graphql.String),
 							DefaultValue: nil,
 						},
+						"imageProperties": &graphql.InputObjectFieldConfig{
+							Description: "Blob properties passed as images to vision capable models",
+							Type:        graphql.NewList(graphql.String),
+						},
+						"tokenBudget": &graphql.InputObjectFieldConfig{
+							Description: "Estimated number of tokens the properties of all objects are truncated to, 0 disables truncation",
+							Type:        graphql.Int,
+						},
 					},
 				}),
 				DefaultValue: nil,
 			},
+			"provider": &graphql.ArgumentConfig{
+				Description: "Generative module used instead of the one configured for the class",
+				Type:        graphql.String,
+			},
+			"model": &graphql.ArgumentConfig{
+				Description: "Model used instead of the one configured for the class",
+				Type:        graphql.String,
+			},
+			"temperature": &graphql.ArgumentConfig{
+				Description: "Temperature used instead of the one configured for the class",
+				Type:        graphql.Float,
+			},
+			"maxTokens": &graphql.ArgumentConfig{
+				Description: "Maximum number of tokens generated instead of the one configured for the class",
+				Type:        graphql.Int,
+			},
 		},
 		Type: graphql.NewObject(graphql.ObjectConfig{
 			Name: fmt.Sprintf("%sAdditionalGenerate", classname),
@@ -58,6 +119,13 @@ func (p *GenerateProvider) additionalGenerateField(classname string) *graphql.Fi
 				"singleResult":  &graphql.Field{Type: graphql.String},
 				"groupedResult": &graphql.Field{Type: graphql.String},
 				"error":         &graphql.Field{Type: graphql.String},
+				"metadata": &graphql.Field{Type: graphql.NewObject(graphql.ObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalGenerateMetadata", classname),
+					Fields: graphql.Fields{
+						"singleResult":  &graphql.Field{Type: usage},
+						"groupedResult": &graphql.Field{Type: usage},
+					},
+				})},
 			},
 		}),
 	}
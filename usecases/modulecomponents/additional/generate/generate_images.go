@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+// generativeImageClient is implemented by clients of vision capable models
+// which can be passed images, base64 encoded, along with the prompt
+type generativeImageClient interface {
+	GenerateSingleResultWithImages(ctx context.Context, textProperties map[string]string, images []string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
+	GenerateAllResultsWithImages(ctx context.Context, textProperties []map[string]string, images []string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
+}
+
+// ImageMediaType returns the media type of a base64 encoded image, images
+// which can't be detected are assumed to be JPEGs
+func ImageMediaType(image string) string {
+	// 512 bytes are enough to detect the content type and are encoded by
+	// the first 684 characters
+	if len(image) > 684 {
+		image = image[:684]
+	}
+	data, err := base64.StdEncoding.DecodeString(image)
+	if err != nil {
+		return "image/jpeg"
+	}
+	if mediaType := http.DetectContentType(data); strings.HasPrefix(mediaType, "image/") {
+		return mediaType
+	}
+	return "image/jpeg"
+}
+
+func (p *GenerateProvider) imageClient() (generativeImageClient, error) {
+	client, ok := p.client.(generativeImageClient)
+	if !ok {
+		return nil, errors.Errorf("module %s doesn't support images", p.moduleName)
+	}
+	return client, nil
+}
+
+// getImages returns the values of the blob properties of the search result
+// and removes them from its text properties
+func (p *GenerateProvider) getImages(result search.Result, imageProperties []string,
+	textProperties map[string]string,
+) []string {
+	if len(imageProperties) == 0 {
+		return nil
+	}
+	schema, _ := result.Object().Properties.(map[string]interface{})
+	var images []string
+	for _, property := range imageProperties {
+		delete(textProperties, property)
+		if image, ok := schema[property].(string); ok && image != "" {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+func (p *GenerateProvider) generateSingleResultWithImages(ctx context.Context, textProperties map[string]string,
+	images []string, prompt string, cfg moduletools.ClassConfig, stream StreamFn, i int,
+) (*generativemodels.GenerateResponse, error) {
+	client, err := p.imageClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GenerateSingleResultWithImages(ctx, textProperties, images, prompt, cfg)
+	if err != nil {
+		return nil, err
+	}
+	// answers about images aren't streamed, the whole answer is passed at once
+	if stream != nil && res.Result != nil {
+		if err := stream(i, false, *res.Result); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (p *GenerateProvider) generateAllResultsWithImages(ctx context.Context, textProperties []map[string]string,
+	images []string, task string, cfg moduletools.ClassConfig, stream StreamFn,
+) (*generativemodels.GenerateResponse, error) {
+	client, err := p.imageClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GenerateAllResultsWithImages(ctx, textProperties, images, task, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil && res.Result != nil {
+		if err := stream(0, true, *res.Result); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+func TestGenerateWithImages(t *testing.T) {
+	png := base64.StdEncoding.EncodeToString([]byte("\x89PNG\r\n\x1a\n some image"))
+	newResults := func() []search.Result {
+		return []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"name":  "shoe",
+					"photo": png,
+				},
+			},
+		}
+	}
+	limit := 1
+
+	t.Run("should pass images of single results", func(t *testing.T) {
+		client := &fakeImageClient{}
+		in := newResults()
+		prompt := "Describe this photo"
+		params := &Params{Prompt: &prompt, PromptImageProperties: []string{"photo"}}
+
+		_, err := New("generative-fake", client).AdditionalPropertyFn(context.Background(), in, params, &limit, nil, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{png}, client.images)
+		assert.Equal(t, map[string]string{"name": "shoe"}, client.textProperties[0])
+		answer := in[0].AdditionalProperties["generate"].(*generativemodels.GenerateResult)
+		require.Nil(t, answer.Error)
+		assert.Equal(t, "Describe this photo", *answer.SingleResult)
+	})
+
+	t.Run("should pass images of grouped results", func(t *testing.T) {
+		client := &fakeImageClient{}
+		in := newResults()
+		task := "Describe these photos"
+		params := &Params{Task: &task, TaskImageProperties: []string{"photo"}}
+
+		_, err := New("generative-fake", client).AdditionalPropertyFn(context.Background(), in, params, &limit, nil, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{png}, client.images)
+		assert.Equal(t, []map[string]string{{"name": "shoe"}}, client.textProperties)
+	})
+
+	t.Run("should fail with clients not supporting images", func(t *testing.T) {
+		in := newResults()
+		prompt := "Describe this photo"
+		params := &Params{Prompt: &prompt, PromptImageProperties: []string{"photo"}}
+
+		_, err := New("generative-fake", &fakeOpenAIClient{}).AdditionalPropertyFn(context.Background(), in, params, &limit, nil, nil)
+
+		require.Nil(t, err)
+		answer := in[0].AdditionalProperties["generate"].(*generativemodels.GenerateResult)
+		require.NotNil(t, answer.Error)
+		assert.Equal(t, "module generative-fake doesn't support images", answer.Error.Error())
+	})
+
+	t.Run("should detect media types", func(t *testing.T) {
+		assert.Equal(t, "image/png", ImageMediaType(png))
+		assert.Equal(t, "image/jpeg", ImageMediaType(base64.StdEncoding.EncodeToString([]byte("text"))))
+		assert.Equal(t, "image/jpeg", ImageMediaType("not base64"))
+	})
+}
+
+type fakeImageClient struct {
+	fakeOpenAIClient
+	images         []string
+	textProperties []map[string]string
+}
+
+func (c *fakeImageClient) GenerateSingleResultWithImages(ctx context.Context, textProperties map[string]string, images []string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	c.images = images
+	c.textProperties = []map[string]string{textProperties}
+	return c.getResult(textProperties, prompt), nil
+}
+
+func (c *fakeImageClient) GenerateAllResultsWithImages(ctx context.Context, textProperties []map[string]string, images []string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	c.images = images
+	c.textProperties = textProperties
+	return c.getResults(textProperties, task), nil
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// overrideNamesClient is implemented by clients whose class settings use
+// other names for the model, temperature and maxTokens a query can override
+type overrideNamesClient interface {
+	OverrideNames() map[string]string
+}
+
+// withQueryOverrides returns the class config with the model, temperature
+// and maxTokens set in the query taking precedence over the configured ones
+func (p *GenerateProvider) withQueryOverrides(cfg moduletools.ClassConfig,
+	params *Params,
+) moduletools.ClassConfig {
+	overrides := map[string]interface{}{}
+	if params.Model != nil {
+		overrides[p.overrideName("model")] = *params.Model
+	}
+	if params.Temperature != nil {
+		overrides[p.overrideName("temperature")] = *params.Temperature
+	}
+	if params.MaxTokens != nil {
+		overrides[p.overrideName("maxTokens")] = *params.MaxTokens
+	}
+	if len(overrides) == 0 {
+		return cfg
+	}
+	return &overridesClassConfig{cfg: cfg, overrides: overrides}
+}
+
+func (p *GenerateProvider) overrideName(name string) string {
+	if client, ok := p.client.(overrideNamesClient); ok {
+		if overrideName, ok := client.OverrideNames()[name]; ok {
+			return overrideName
+		}
+	}
+	return name
+}
+
+type overridesClassConfig struct {
+	cfg       moduletools.ClassConfig
+	overrides map[string]interface{}
+}
+
+func (c *overridesClassConfig) Tenant() string {
+	if c.cfg == nil {
+		return ""
+	}
+	return c.cfg.Tenant()
+}
+
+func (c *overridesClassConfig) Class() map[string]interface{} {
+	if c.cfg == nil {
+		return map[string]interface{}{}
+	}
+	return c.cfg.Class()
+}
+
+func (c *overridesClassConfig) Property(propName string) map[string]interface{} {
+	if c.cfg == nil {
+		return map[string]interface{}{}
+	}
+	return c.cfg.Property(propName)
+}
+
+func (c *overridesClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	settings := map[string]interface{}{}
+	if c.cfg != nil {
+		for name, value := range c.cfg.ClassByModuleName(moduleName) {
+			settings[name] = value
+		}
+	}
+	for name, value := range c.overrides {
+		settings[name] = value
+	}
+	return settings
+}
@@ -11,10 +11,30 @@
 
 package generate
 
+import "strings"
+
 type Params struct {
 	Prompt     *string
 	Task       *string
 	Properties []string
+	// prompt templates of the class config used instead of the prompt
+	// and task, the variables are substituted in both
+	PromptTemplate  *string
+	PromptVariables map[string]string
+	TaskTemplate    *string
+	TaskVariables   map[string]string
+	// blob properties passed as images to vision capable models
+	PromptImageProperties []string
+	TaskImageProperties   []string
+	// TokenBudget limits the estimated tokens of the properties passed to
+	// grouped tasks, 0 disables the limit
+	TokenBudget *int
+	// the generative module and its settings can be chosen per query,
+	// overriding the ones configured for the class
+	Provider    *string
+	Model       *string
+	Temperature *float64
+	MaxTokens   *int
 }
 
 func (n Params) GetPrompt() string {
@@ -28,3 +48,15 @@ func (n Params) GetTask() string {
 func (n Params) GetProperties() []string {
 	return n.Properties
 }
+
+// ModuleName returns the generative module chosen by the query, the
+// generative- prefix of the module name may be omitted
+func (n Params) ModuleName() string {
+	if n.Provider == nil || *n.Provider == "" {
+		return ""
+	}
+	if strings.HasPrefix(*n.Provider, "generative-") {
+		return *n.Provider
+	}
+	return "generative-" + *n.Provider
+}
@@ -13,6 +13,7 @@ package generate
 
 import (
 	"log"
+	"strconv"
 
 	"github.com/tailor-inc/graphql/language/ast"
 )
@@ -24,13 +25,28 @@ func (p *GenerateProvider) parseGenerateArguments(args []*ast.Argument) *Params
 		switch arg.Name.Value {
 		case "singleResult":
 			obj := arg.Value.(*ast.ObjectValue).Fields
-			out.Prompt = &obj[0].Value.(*ast.StringValue).Value
+			for _, field := range obj {
+				switch field.Name.Value {
+				case "prompt":
+					out.Prompt = &field.Value.(*ast.StringValue).Value
+				case "template":
+					out.PromptTemplate = &field.Value.(*ast.StringValue).Value
+				case "variables":
+					out.PromptVariables = parseTemplateVariables(field.Value)
+				case "imageProperties":
+					out.PromptImageProperties = parseStringList(field.Value)
+				}
+			}
 		case "groupedResult":
 			obj := arg.Value.(*ast.ObjectValue).Fields
 			for _, field := range obj {
 				switch field.Name.Value {
 				case "task":
 					out.Task = &field.Value.(*ast.StringValue).Value
+				case "template":
+					out.TaskTemplate = &field.Value.(*ast.StringValue).Value
+				case "variables":
+					out.TaskVariables = parseTemplateVariables(field.Value)
 				case "properties":
 					inp := field.Value.GetValue().([]ast.Value)
 					out.Properties = make([]string, len(inp))
@@ -38,9 +54,23 @@ func (p *GenerateProvider) parseGenerateArguments(args []*ast.Argument) *Params
 					for i, value := range inp {
 						out.Properties[i] = value.(*ast.StringValue).Value
 					}
+				case "imageProperties":
+					out.TaskImageProperties = parseStringList(field.Value)
+				case "tokenBudget":
+					asInt, _ := strconv.Atoi(field.Value.GetValue().(string))
+					out.TokenBudget = &asInt
 				}
 			}
-
+		case "provider":
+			out.Provider = &arg.Value.(*ast.StringValue).Value
+		case "model":
+			out.Model = &arg.Value.(*ast.StringValue).Value
+		case "temperature":
+			asFloat, _ := strconv.ParseFloat(arg.Value.GetValue().(string), 64)
+			out.Temperature = &asFloat
+		case "maxTokens":
+			asInt, _ := strconv.Atoi(arg.Value.GetValue().(string))
+			out.MaxTokens = &asInt
 		default:
 			// ignore what we don't recognize
 			log.Printf("Igonore not recognized value: %v", arg.Name.Value)
@@ -49,3 +79,37 @@ func (p *GenerateProvider) parseGenerateArguments(args []*ast.Argument) *Params
 
 	return out
 }
+
+func parseTemplateVariables(value ast.Value) map[string]string {
+	variables := map[string]string{}
+	list, ok := value.GetValue().([]ast.Value)
+	if !ok {
+		return variables
+	}
+	for _, item := range list {
+		obj, ok := item.(*ast.ObjectValue)
+		if !ok {
+			continue
+		}
+		var name, val string
+		for _, field := range obj.Fields {
+			switch field.Name.Value {
+			case "name":
+				name = field.Value.(*ast.StringValue).Value
+			case "value":
+				val = field.Value.(*ast.StringValue).Value
+			}
+		}
+		variables[name] = val
+	}
+	return variables
+}
+
+func parseStringList(value ast.Value) []string {
+	inp := value.GetValue().([]ast.Value)
+	out := make([]string, len(inp))
+	for i, value := range inp {
+		out[i] = value.(*ast.StringValue).Value
+	}
+	return out
+}
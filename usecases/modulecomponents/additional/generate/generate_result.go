@@ -27,20 +27,34 @@ func (p *GenerateProvider) generateResult(ctx context.Context, in []search.Resul
 	if len(in) == 0 {
 		return in, nil
 	}
-	prompt := params.Prompt
-	task := params.Task
+	prompt, err := p.resolvePrompt(cfg, params.Prompt, params.PromptTemplate, params.PromptVariables)
+	if err != nil {
+		return nil, err
+	}
+	task, err := p.resolvePrompt(cfg, params.Task, params.TaskTemplate, params.TaskVariables)
+	if err != nil {
+		return nil, err
+	}
 	properties := params.Properties
-	var err error
+	stream := p.streamFromContext(ctx)
+	cfg = p.withQueryOverrides(cfg, params)
 
 	if task != nil {
-		_, err = p.generateForAllSearchResults(ctx, in, *task, properties, cfg)
+		tokenBudget := defaultGroupedTaskTokenBudget
+		if params.TokenBudget != nil {
+			tokenBudget = *params.TokenBudget
+		}
+		_, err = p.generateForAllSearchResults(ctx, in, *task, properties, params.TaskImageProperties, tokenBudget, cfg, stream)
 	}
 	if prompt != nil {
-		prompt, err = validatePrompt(prompt)
-		if err != nil {
-			return nil, err
+		// prompts about images don't need to reference properties
+		if len(params.PromptImageProperties) == 0 {
+			prompt, err = validatePrompt(prompt)
+			if err != nil {
+				return nil, err
+			}
 		}
-		_, err = p.generatePerSearchResult(ctx, in, *prompt, cfg)
+		_, err = p.generatePerSearchResult(ctx, in, *prompt, params.PromptImageProperties, cfg, stream)
 	}
 
 	return in, err
@@ -58,30 +72,37 @@ func validatePrompt(prompt *string) (*string, error) {
 	return prompt, err
 }
 
-func (p *GenerateProvider) generatePerSearchResult(ctx context.Context, in []search.Result, prompt string, cfg moduletools.ClassConfig) ([]search.Result, error) {
+func (p *GenerateProvider) generatePerSearchResult(ctx context.Context, in []search.Result, prompt string, imageProperties []string, cfg moduletools.ClassConfig, stream StreamFn) ([]search.Result, error) {
 	var wg sync.WaitGroup
 	sem := make(chan struct{}, p.maximumNumberOfGoroutines)
 	for i, result := range in {
 		wg.Add(1)
 		textProperties := p.getTextProperties(result, nil)
-		go func(result search.Result, textProperties map[string]string, i int) {
+		images := p.getImages(result, imageProperties, textProperties)
+		go func(result search.Result, textProperties map[string]string, images []string, i int) {
 			sem <- struct{}{}
 			defer wg.Done()
 			defer func() { <-sem }()
-			generateResult, err := p.client.GenerateSingleResult(ctx, textProperties, prompt, cfg)
+			generateResult, err := p.generateSingleResult(ctx, textProperties, images, prompt, cfg, stream, i)
+			p.observeUsage(result.ClassName, generateResult)
 			p.setIndividualResult(in, i, generateResult, err)
-		}(result, textProperties, i)
+		}(result, textProperties, images, i)
 	}
 	wg.Wait()
 	return in, nil
 }
 
-func (p *GenerateProvider) generateForAllSearchResults(ctx context.Context, in []search.Result, task string, properties []string, cfg moduletools.ClassConfig) ([]search.Result, error) {
+func (p *GenerateProvider) generateForAllSearchResults(ctx context.Context, in []search.Result, task string, properties, imageProperties []string, tokenBudget int, cfg moduletools.ClassConfig, stream StreamFn) ([]search.Result, error) {
 	var propertiesForAllDocs []map[string]string
+	var images []string
 	for _, res := range in {
-		propertiesForAllDocs = append(propertiesForAllDocs, p.getTextProperties(res, properties))
+		textProperties := p.getTextProperties(res, properties)
+		images = append(images, p.getImages(res, imageProperties, textProperties)...)
+		propertiesForAllDocs = append(propertiesForAllDocs, textProperties)
 	}
-	generateResult, err := p.client.GenerateAllResults(ctx, propertiesForAllDocs, task, cfg)
+	propertiesForAllDocs = truncateToTokenBudget(propertiesForAllDocs, tokenBudget)
+	generateResult, err := p.generateAllResults(ctx, propertiesForAllDocs, images, task, cfg, stream)
+	p.observeUsage(in[0].ClassName, generateResult)
 	p.setCombinedResult(in, 0, generateResult, err)
 	return in, nil
 }
@@ -112,12 +133,17 @@ func (p *GenerateProvider) setCombinedResult(in []search.Result, i int, generate
 	}
 
 	var result *string
+	var metadata *generativemodels.GenerateResultMetadata
 	if generateResult != nil {
 		result = generateResult.Result
+		if generateResult.Usage != nil {
+			metadata = &generativemodels.GenerateResultMetadata{GroupedResult: generateResult.Usage}
+		}
 	}
 
 	ap["generate"] = &generativemodels.GenerateResult{
 		GroupedResult: result,
+		Metadata:      metadata,
 		Error:         err,
 	}
 
@@ -126,8 +152,10 @@ func (p *GenerateProvider) setCombinedResult(in []search.Result, i int, generate
 
 func (p *GenerateProvider) setIndividualResult(in []search.Result, i int, generateResult *generativemodels.GenerateResponse, err error) {
 	var result *string
+	var usage *generativemodels.GenerateUsage
 	if generateResult != nil {
 		result = generateResult.Result
+		usage = generateResult.Usage
 	}
 
 	ap := in[i].AdditionalProperties
@@ -136,14 +164,28 @@ func (p *GenerateProvider) setIndividualResult(in []search.Result, i int, genera
 	}
 
 	if ap["generate"] != nil {
+		grouped := ap["generate"].(*generativemodels.GenerateResult)
+		var metadata *generativemodels.GenerateResultMetadata
+		if grouped.Metadata != nil || usage != nil {
+			metadata = &generativemodels.GenerateResultMetadata{SingleResult: usage}
+			if grouped.Metadata != nil {
+				metadata.GroupedResult = grouped.Metadata.GroupedResult
+			}
+		}
 		ap["generate"] = &generativemodels.GenerateResult{
-			GroupedResult: ap["generate"].(*generativemodels.GenerateResult).GroupedResult,
+			GroupedResult: grouped.GroupedResult,
 			SingleResult:  result,
+			Metadata:      metadata,
 			Error:         err,
 		}
 	} else {
+		var metadata *generativemodels.GenerateResultMetadata
+		if usage != nil {
+			metadata = &generativemodels.GenerateResultMetadata{SingleResult: usage}
+		}
 		ap["generate"] = &generativemodels.GenerateResult{
 			SingleResult: result,
+			Metadata:     metadata,
 			Error:        err,
 		}
 	}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+// StreamFn receives the text of the generated answers while it is produced.
+// Text of a single result is passed with the index of the search result it
+// belongs to, text of the grouped result is passed with grouped set.
+type StreamFn func(index int, grouped bool, text string) error
+
+type generativeStreamClient interface {
+	GenerateSingleResultStream(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig, onChunk func(text string) error) (*generativemodels.GenerateResponse, error)
+	GenerateAllResultsStream(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig, onChunk func(text string) error) (*generativemodels.GenerateResponse, error)
+}
+
+type streamContextKey struct{}
+
+// WithStream returns a context which makes the generative modules that can
+// stream their answers pass them to fn while they are produced. The complete
+// answers are set on the search results as usual.
+func WithStream(ctx context.Context, fn StreamFn) context.Context {
+	return context.WithValue(ctx, streamContextKey{}, fn)
+}
+
+// streamFromContext returns the StreamFn of the context, calls of which are
+// serialized as single results are generated concurrently. It returns nil if
+// no stream was requested or the client can't stream.
+func (p *GenerateProvider) streamFromContext(ctx context.Context) StreamFn {
+	fn, ok := ctx.Value(streamContextKey{}).(StreamFn)
+	if !ok || fn == nil {
+		return nil
+	}
+	if _, ok := p.client.(generativeStreamClient); !ok {
+		return nil
+	}
+
+	var lock sync.Mutex
+	return func(index int, grouped bool, text string) error {
+		lock.Lock()
+		defer lock.Unlock()
+		return fn(index, grouped, text)
+	}
+}
+
+func (p *GenerateProvider) generateSingleResult(ctx context.Context, textProperties map[string]string,
+	images []string, prompt string, cfg moduletools.ClassConfig, stream StreamFn, i int,
+) (*generativemodels.GenerateResponse, error) {
+	if len(images) > 0 {
+		return p.generateSingleResultWithImages(ctx, textProperties, images, prompt, cfg, stream, i)
+	}
+	if stream == nil {
+		return p.client.GenerateSingleResult(ctx, textProperties, prompt, cfg)
+	}
+	return p.client.(generativeStreamClient).GenerateSingleResultStream(ctx, textProperties, prompt, cfg,
+		func(text string) error { return stream(i, false, text) })
+}
+
+func (p *GenerateProvider) generateAllResults(ctx context.Context, textProperties []map[string]string,
+	images []string, task string, cfg moduletools.ClassConfig, stream StreamFn,
+) (*generativemodels.GenerateResponse, error) {
+	if len(images) > 0 {
+		return p.generateAllResultsWithImages(ctx, textProperties, images, task, cfg, stream)
+	}
+	if stream == nil {
+		return p.client.GenerateAllResults(ctx, textProperties, task, cfg)
+	}
+	return p.client.(generativeStreamClient).GenerateAllResultsStream(ctx, textProperties, task, cfg,
+		func(text string) error { return stream(0, true, text) })
+}
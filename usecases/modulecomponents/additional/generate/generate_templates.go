@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// promptTemplatesSetting is the name of the class setting of the generative
+// modules holding named prompt templates, e.g.:
+//
+//	"promptTemplates": {"summarize": "Summarize {content} in {language}"}
+const promptTemplatesSetting = "promptTemplates"
+
+var templateVariableRegexp = regexp.MustCompile(`{([\w\s]*?)}`)
+
+// resolvePrompt returns the prompt or, if no prompt is set, the named template
+// of the class config with the given variables substituted. Placeholders
+// which aren't variables are left in place as they refer to properties
+func (p *GenerateProvider) resolvePrompt(cfg moduletools.ClassConfig,
+	prompt, template *string, variables map[string]string,
+) (*string, error) {
+	if prompt == nil && template != nil {
+		templates := promptTemplates(cfg, p.moduleName)
+		text, ok := templates[*template].(string)
+		if !ok {
+			return nil, errors.Errorf("prompt template %q not found in the class config", *template)
+		}
+		prompt = &text
+	}
+	if prompt == nil || len(variables) == 0 {
+		return prompt, nil
+	}
+	substituted := templateVariableRegexp.ReplaceAllStringFunc(*prompt, func(match string) string {
+		if value, ok := variables[strings.TrimSpace(match[1:len(match)-1])]; ok {
+			return value
+		}
+		return match
+	})
+	return &substituted, nil
+}
+
+func promptTemplates(cfg moduletools.ClassConfig, moduleName string) map[string]interface{} {
+	if cfg == nil {
+		return nil
+	}
+	templates, _ := cfg.ClassByModuleName(moduleName)[promptTemplatesSetting].(map[string]interface{})
+	return templates
+}
+
+// ValidatePromptTemplates validates the prompt templates of the class settings
+// of a generative module
+func ValidatePromptTemplates(moduleSettings map[string]interface{}) error {
+	value, ok := moduleSettings[promptTemplatesSetting]
+	if !ok || value == nil {
+		return nil
+	}
+	templates, ok := value.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("%s must be an object of template names to templates", promptTemplatesSetting)
+	}
+	for name, template := range templates {
+		text, ok := template.(string)
+		if !ok || strings.TrimSpace(text) == "" {
+			return errors.Errorf("%s: template %q must be a non-empty string", promptTemplatesSetting, name)
+		}
+	}
+	return nil
+}
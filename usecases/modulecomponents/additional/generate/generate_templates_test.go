@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromptTemplates(t *testing.T) {
+	provider := New("generative-fake", &fakeOpenAIClient{})
+	cfg := fakeClassConfig{classConfig: map[string]interface{}{
+		"promptTemplates": map[string]interface{}{
+			"summarize": "Summarize {content} in { language }",
+		},
+	}}
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("should resolve a template and substitute variables", func(t *testing.T) {
+		prompt, err := provider.resolvePrompt(cfg, nil, strPtr("summarize"),
+			map[string]string{"language": "German"})
+
+		require.Nil(t, err)
+		require.NotNil(t, prompt)
+		assert.Equal(t, "Summarize {content} in German", *prompt)
+	})
+
+	t.Run("should prefer the prompt over the template", func(t *testing.T) {
+		prompt, err := provider.resolvePrompt(cfg, strPtr("Translate {content} to {language}"),
+			strPtr("summarize"), map[string]string{"language": "French"})
+
+		require.Nil(t, err)
+		require.NotNil(t, prompt)
+		assert.Equal(t, "Translate {content} to French", *prompt)
+	})
+
+	t.Run("should return nothing without prompt and template", func(t *testing.T) {
+		prompt, err := provider.resolvePrompt(cfg, nil, nil, nil)
+
+		require.Nil(t, err)
+		assert.Nil(t, prompt)
+	})
+
+	t.Run("should fail on an unknown template", func(t *testing.T) {
+		_, err := provider.resolvePrompt(cfg, nil, strPtr("unknown"), nil)
+
+		require.NotNil(t, err)
+		assert.Equal(t, `prompt template "unknown" not found in the class config`, err.Error())
+	})
+
+	t.Run("should validate templates", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			settings map[string]interface{}
+			wantErr  string
+		}{
+			{
+				name:     "no templates",
+				settings: map[string]interface{}{},
+			},
+			{
+				name:     "valid templates",
+				settings: cfg.classConfig,
+			},
+			{
+				name:     "templates not an object",
+				settings: map[string]interface{}{"promptTemplates": "summarize"},
+				wantErr:  "promptTemplates must be an object of template names to templates",
+			},
+			{
+				name: "empty template",
+				settings: map[string]interface{}{"promptTemplates": map[string]interface{}{
+					"summarize": " ",
+				}},
+				wantErr: `promptTemplates: template "summarize" must be a non-empty string`,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := ValidatePromptTemplates(tt.settings)
+				if tt.wantErr != "" {
+					require.NotNil(t, err)
+					assert.Equal(t, tt.wantErr, err.Error())
+				} else {
+					assert.Nil(t, err)
+				}
+			})
+		}
+	})
+}
@@ -13,20 +13,23 @@ package generate
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/search"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
 func TestAdditionalAnswerProvider(t *testing.T) {
 	t.Run("should answer", func(t *testing.T) {
 		// given
 		openaiClient := &fakeOpenAIClient{}
-		answerProvider := New(openaiClient)
+		answerProvider := New("generative-fake", openaiClient)
 		in := []search.Result{
 			{
 				ID: "some-uuid",
@@ -56,6 +59,160 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 		assert.True(t, answerAdditionalOK)
 		assert.Equal(t, "this is a task", *answerAdditional.GroupedResult)
 	})
+
+	t.Run("should stream answers", func(t *testing.T) {
+		// given
+		answerProvider := New("generative-fake", &fakeStreamClient{})
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "content",
+				},
+			},
+			{
+				ID: "other-uuid",
+				Schema: map[string]interface{}{
+					"content": "other content",
+				},
+			},
+		}
+		task := "this is a task"
+		prompt := "summarize {content}"
+		fakeParams := &Params{
+			Task:   &task,
+			Prompt: &prompt,
+		}
+		limit := 2
+		type chunk struct {
+			index   int
+			grouped bool
+			text    string
+		}
+		var chunks []chunk
+		ctx := WithStream(context.Background(), func(index int, grouped bool, text string) error {
+			chunks = append(chunks, chunk{index, grouped, text})
+			return nil
+		})
+
+		// when
+		_, err := answerProvider.AdditionalPropertyFn(ctx, in, fakeParams, &limit, map[string]interface{}{}, nil)
+
+		// then
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []chunk{
+			{0, true, "this is a "}, {0, true, "task"},
+			{0, false, "summarize "}, {0, false, "{content}"},
+			{1, false, "summarize "}, {1, false, "{content}"},
+		}, chunks)
+		for i := range in {
+			answer := in[i].AdditionalProperties["generate"].(*generativemodels.GenerateResult)
+			assert.Equal(t, prompt, *answer.SingleResult)
+		}
+		grouped := in[0].AdditionalProperties["generate"].(*generativemodels.GenerateResult)
+		assert.Equal(t, task, *grouped.GroupedResult)
+	})
+
+	t.Run("should override class settings per query", func(t *testing.T) {
+		// given
+		client := &fakeOverridesClient{}
+		answerProvider := New("generative-fake", client)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "content",
+				},
+			},
+		}
+		task := "this is a task"
+		model := "some-model"
+		temperature := 0.5
+		maxTokens := 100
+		fakeParams := &Params{
+			Task:        &task,
+			Model:       &model,
+			Temperature: &temperature,
+			MaxTokens:   &maxTokens,
+		}
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{
+			"model": "class-model",
+			"topP":  0.9,
+		}}
+		limit := 1
+
+		// when
+		_, err := answerProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, map[string]interface{}{}, cfg)
+
+		// then
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"model":       "some-model",
+			"temperature": 0.5,
+			"tokenLimit":  100,
+			"topP":        0.9,
+		}, client.lastSettings)
+	})
+
+	t.Run("should report token usage and cost", func(t *testing.T) {
+		// given
+		answerProvider := New("generative-fake", &fakeUsageClient{})
+		in := []search.Result{
+			{
+				ID:        "some-uuid",
+				ClassName: "UsageClass",
+				Schema: map[string]interface{}{
+					"content": "content",
+				},
+			},
+		}
+		task := "this is a task"
+		prompt := "summarize {content}"
+		fakeParams := &Params{
+			Task:   &task,
+			Prompt: &prompt,
+		}
+		limit := 1
+		metrics := monitoring.GetMetrics()
+
+		// when
+		_, err := answerProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, map[string]interface{}{}, nil)
+
+		// then
+		require.Nil(t, err)
+		answer := in[0].AdditionalProperties["generate"].(*generativemodels.GenerateResult)
+		require.NotNil(t, answer.Metadata)
+		for _, usage := range []*generativemodels.GenerateUsage{answer.Metadata.SingleResult, answer.Metadata.GroupedResult} {
+			require.NotNil(t, usage)
+			assert.Equal(t, "gpt-4", usage.Model)
+			assert.Equal(t, 1030, usage.TotalTokens)
+			require.NotNil(t, usage.EstimatedCost)
+			assert.InDelta(t, (1000*30.0+30*60.0)/1e6, *usage.EstimatedCost, 1e-12)
+		}
+		assert.Equal(t, float64(2060), testutil.ToFloat64(
+			metrics.ModuleExternalTokensUsed.WithLabelValues("generative-fake", "UsageClass", "gpt-4")))
+		assert.InDelta(t, 2*(1000*30.0+30*60.0)/1e6, testutil.ToFloat64(
+			metrics.ModuleExternalEstimatedCost.WithLabelValues("generative-fake", "UsageClass", "gpt-4")), 1e-12)
+	})
+
+	t.Run("should keep class settings without overrides", func(t *testing.T) {
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"model": "class-model"}}
+
+		overridden := New("generative-fake", &fakeOpenAIClient{}).withQueryOverrides(cfg, &Params{})
+
+		assert.Equal(t, cfg, overridden)
+	})
+
+	t.Run("should resolve the module chosen per query", func(t *testing.T) {
+		openai := "openai"
+		cohere := "generative-cohere"
+		empty := ""
+
+		assert.Equal(t, "", Params{}.ModuleName())
+		assert.Equal(t, "", Params{Provider: &empty}.ModuleName())
+		assert.Equal(t, "generative-openai", Params{Provider: &openai}.ModuleName())
+		assert.Equal(t, "generative-cohere", Params{Provider: &cohere}.ModuleName())
+	})
 }
 
 type fakeOpenAIClient struct{}
@@ -85,3 +242,83 @@ func (c *fakeOpenAIClient) getResult(text map[string]string, task string) *gener
 		Result: &task,
 	}
 }
+
+type fakeStreamClient struct {
+	fakeOpenAIClient
+}
+
+func (c *fakeStreamClient) GenerateSingleResultStream(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig, onChunk func(text string) error) (*generativemodels.GenerateResponse, error) {
+	return c.stream(prompt, onChunk)
+}
+
+func (c *fakeStreamClient) GenerateAllResultsStream(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig, onChunk func(text string) error) (*generativemodels.GenerateResponse, error) {
+	return c.stream(task, onChunk)
+}
+
+// stream passes the text in two chunks, split after its last space
+func (c *fakeStreamClient) stream(text string, onChunk func(text string) error) (*generativemodels.GenerateResponse, error) {
+	split := strings.LastIndex(text, " ") + 1
+	for _, chunk := range []string{text[:split], text[split:]} {
+		if err := onChunk(chunk); err != nil {
+			return nil, err
+		}
+	}
+	return &generativemodels.GenerateResponse{Result: &text}, nil
+}
+
+// fakeUsageClient reports the usage of every answer without total tokens,
+// which are summed up by the provider
+type fakeUsageClient struct {
+	fakeOpenAIClient
+}
+
+func (c *fakeUsageClient) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	return c.withUsage(c.getResults(textProperties, task)), nil
+}
+
+func (c *fakeUsageClient) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	return c.withUsage(c.getResult(textProperties, prompt)), nil
+}
+
+func (c *fakeUsageClient) withUsage(res *generativemodels.GenerateResponse) *generativemodels.GenerateResponse {
+	res.Usage = &generativemodels.GenerateUsage{
+		Model:            "gpt-4",
+		PromptTokens:     1000,
+		CompletionTokens: 30,
+	}
+	return res
+}
+
+type fakeOverridesClient struct {
+	fakeOpenAIClient
+	lastSettings map[string]interface{}
+}
+
+func (c *fakeOverridesClient) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+	c.lastSettings = cfg.ClassByModuleName("generative-fake")
+	return c.getResults(textProperties, task), nil
+}
+
+func (c *fakeOverridesClient) OverrideNames() map[string]string {
+	return map[string]string{"maxTokens": "tokenLimit"}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// defaultGroupedTaskTokenBudget leaves room for the task and the answer in
+// the context window of the smallest models supported
+const defaultGroupedTaskTokenBudget = 3000
+
+// charactersPerToken is a rough estimate which holds for English texts
+// and the tokenizers of most models
+const charactersPerToken = 4
+
+// truncateToTokenBudget cuts the property values of the objects, so that all
+// of them together fit into the token budget. Every object is given an equal
+// share of the budget, the part of its share an object doesn't use is given
+// to the objects after it.
+func truncateToTokenBudget(docs []map[string]string, tokenBudget int) []map[string]string {
+	if tokenBudget <= 0 {
+		return docs
+	}
+
+	remaining := tokenBudget
+	truncated := make([]map[string]string, len(docs))
+	for i, doc := range docs {
+		share := remaining / (len(docs) - i)
+		used := 0
+
+		// the properties are truncated in a stable order, so that the same
+		// objects always result in the same prompt
+		names := make([]string, 0, len(doc))
+		for name := range doc {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		truncated[i] = make(map[string]string, len(doc))
+		for _, name := range names {
+			allowance := share - used - estimateTokens(name)
+			if allowance <= 0 {
+				continue
+			}
+			value := truncateToTokens(doc[name], allowance)
+			truncated[i][name] = value
+			used += estimateTokens(name) + estimateTokens(value)
+		}
+		remaining -= used
+	}
+	return truncated
+}
+
+func estimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + charactersPerToken - 1) / charactersPerToken
+}
+
+func truncateToTokens(text string, tokens int) string {
+	if estimateTokens(text) <= tokens {
+		return text
+	}
+	return string([]rune(text)[:tokens*charactersPerToken])
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateToTokenBudget(t *testing.T) {
+	t.Run("should keep objects which fit into the budget", func(t *testing.T) {
+		docs := []map[string]string{
+			{"title": "short title", "content": "short content"},
+			{"title": "other title"},
+		}
+
+		truncated := truncateToTokenBudget(docs, 100)
+
+		assert.Equal(t, docs, truncated)
+	})
+
+	t.Run("should not truncate without budget", func(t *testing.T) {
+		docs := []map[string]string{{"content": strings.Repeat("a", 1000)}}
+
+		truncated := truncateToTokenBudget(docs, 0)
+
+		assert.Equal(t, docs, truncated)
+	})
+
+	t.Run("should share the budget between objects", func(t *testing.T) {
+		docs := []map[string]string{
+			{"content": strings.Repeat("a", 400)},
+			{"content": strings.Repeat("b", 400)},
+		}
+
+		truncated := truncateToTokenBudget(docs, 42)
+
+		// 21 tokens per object, 2 of them for the property name
+		assert.Equal(t, strings.Repeat("a", 76), truncated[0]["content"])
+		assert.Equal(t, strings.Repeat("b", 76), truncated[1]["content"])
+	})
+
+	t.Run("should pass unused budget on to later objects", func(t *testing.T) {
+		docs := []map[string]string{
+			{"content": "tiny"},
+			{"content": strings.Repeat("b", 400)},
+		}
+
+		truncated := truncateToTokenBudget(docs, 42)
+
+		// the first object uses 3 of its 21 tokens
+		assert.Equal(t, "tiny", truncated[0]["content"])
+		assert.Equal(t, strings.Repeat("b", 148), truncated[1]["content"])
+	})
+
+	t.Run("should truncate at character boundaries", func(t *testing.T) {
+		docs := []map[string]string{{"c": strings.Repeat("ü", 100)}}
+
+		truncated := truncateToTokenBudget(docs, 3)
+
+		assert.Equal(t, strings.Repeat("ü", 8), truncated[0]["c"])
+	})
+}
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type modelPrice struct {
+	// USD per million tokens
+	prompt, completion float64
+}
+
+// modelPrices are the list prices of the models of the generative modules.
+// Models which are not listed, such as fine-tuned ones, are reported without
+// estimated cost.
+var modelPrices = map[string]modelPrice{
+	// OpenAI
+	"gpt-3.5-turbo":          {0.5, 1.5},
+	"gpt-3.5-turbo-16k":      {3, 4},
+	"gpt-3.5-turbo-instruct": {1.5, 2},
+	"gpt-4":                  {30, 60},
+	"gpt-4-32k":              {60, 120},
+	"gpt-4-1106-preview":     {10, 30},
+	"gpt-4-0125-preview":     {10, 30},
+	"gpt-4-turbo":            {10, 30},
+	"gpt-4o":                 {5, 15},
+	"gpt-4o-mini":            {0.15, 0.6},
+	// Anthropic, also on Amazon Bedrock
+	"claude-3-haiku-20240307":                   {0.25, 1.25},
+	"claude-3-sonnet-20240229":                  {3, 15},
+	"claude-3-opus-20240229":                    {15, 75},
+	"claude-3-5-sonnet-20240620":                {3, 15},
+	"anthropic.claude-3-haiku-20240307-v1:0":    {0.25, 1.25},
+	"anthropic.claude-3-sonnet-20240229-v1:0":   {3, 15},
+	"anthropic.claude-3-5-sonnet-20240620-v1:0": {3, 15},
+	"anthropic.claude-v2:1":                     {8, 24},
+	"anthropic.claude-instant-v1":               {0.8, 2.4},
+	// Amazon Bedrock
+	"amazon.titan-text-premier-v1:0": {0.5, 1.5},
+	"amazon.titan-text-express-v1":   {0.2, 0.6},
+	"amazon.titan-text-lite-v1":      {0.15, 0.2},
+	"meta.llama3-70b-instruct-v1:0":  {2.65, 3.5},
+	"meta.llama3-8b-instruct-v1:0":   {0.3, 0.6},
+	// Cohere
+	"command":        {1, 2},
+	"command-light":  {0.3, 0.6},
+	"command-r":      {0.5, 1.5},
+	"command-r-plus": {3, 15},
+	// Google
+	"gemini-1.0-pro":   {0.5, 1.5},
+	"gemini-1.5-pro":   {3.5, 10.5},
+	"gemini-1.5-flash": {0.35, 1.05},
+}
+
+// observeUsage sets the estimated cost of the tokens an answer consumed and
+// records them in the metrics of the module
+func (p *GenerateProvider) observeUsage(className string, generateResult *generativemodels.GenerateResponse) {
+	if generateResult == nil || generateResult.Usage == nil {
+		return
+	}
+	usage := generateResult.Usage
+	if usage.TotalTokens == 0 {
+		usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+	}
+
+	metrics := monitoring.GetMetrics()
+	metrics.ModuleExternalTokensUsed.
+		WithLabelValues(p.moduleName, className, usage.Model).Add(float64(usage.TotalTokens))
+
+	if price, ok := modelPrices[usage.Model]; ok {
+		cost := (float64(usage.PromptTokens)*price.prompt +
+			float64(usage.CompletionTokens)*price.completion) / 1e6
+		usage.EstimatedCost = &cost
+		metrics.ModuleExternalEstimatedCost.
+			WithLabelValues(p.moduleName, className, usage.Model).Add(cost)
+	}
+}
@@ -14,11 +14,37 @@ package models
 // GenerateResult used in generative OpenAI module to represent
 // the answer to a given question
 type GenerateResult struct {
-	SingleResult  *string `json:"singleResult,omitempty"`
-	GroupedResult *string `json:"groupedResult,omitempty"`
-	Error         error   `json:"error,omitempty"`
+	SingleResult  *string                 `json:"singleResult,omitempty"`
+	GroupedResult *string                 `json:"groupedResult,omitempty"`
+	Metadata      *GenerateResultMetadata `json:"metadata,omitempty"`
+	Error         error                   `json:"error,omitempty"`
+}
+
+// GenerateResultMetadata holds the usage of the models which generated the
+// single and the grouped result
+type GenerateResultMetadata struct {
+	SingleResult  *GenerateUsage `json:"singleResult,omitempty"`
+	GroupedResult *GenerateUsage `json:"groupedResult,omitempty"`
+}
+
+// GenerateUsage are the tokens a model consumed for an answer and, for
+// models with known list prices, their estimated cost in USD
+type GenerateUsage struct {
+	Model            string   `json:"model,omitempty"`
+	PromptTokens     int      `json:"promptTokens"`
+	CompletionTokens int      `json:"completionTokens"`
+	TotalTokens      int      `json:"totalTokens"`
+	EstimatedCost    *float64 `json:"estimatedCost,omitempty"`
 }
 
 type GenerateResponse struct {
 	Result *string
+	// Usage is only set by the clients of models reporting token counts
+	Usage *GenerateUsage
+}
+
+// RankResult used in reranker modules to represent the score of an object
+// for the query it was reranked against
+type RankResult struct {
+	Score *float64 `json:"score,omitempty"`
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	generativegenerate "github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/rank"
 )
 
 type generativeClient interface {
@@ -40,15 +41,25 @@ type AdditionalProperty interface {
 
 type GraphQLAdditionalArgumentsProvider struct {
 	generateProvider AdditionalProperty
+	rerankProvider   AdditionalProperty
 }
 
-func NewGenerativeProvider(client generativeClient) *GraphQLAdditionalArgumentsProvider {
-	return &GraphQLAdditionalArgumentsProvider{generativegenerate.New(client)}
+func NewGenerativeProvider(moduleName string, client generativeClient) *GraphQLAdditionalArgumentsProvider {
+	return &GraphQLAdditionalArgumentsProvider{generateProvider: generativegenerate.New(moduleName, client)}
+}
+
+func NewRankerProvider(client rank.ReRankerClient) *GraphQLAdditionalArgumentsProvider {
+	return &GraphQLAdditionalArgumentsProvider{rerankProvider: rank.New(client)}
 }
 
 func (p *GraphQLAdditionalArgumentsProvider) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
 	additionalProperties := map[string]modulecapabilities.AdditionalProperty{}
-	additionalProperties["generate"] = p.getGenerate()
+	if p.generateProvider != nil {
+		additionalProperties["generate"] = p.getGenerate()
+	}
+	if p.rerankProvider != nil {
+		additionalProperties["rerank"] = p.getReRanker()
+	}
 	return additionalProperties
 }
 
@@ -63,3 +74,15 @@ func (p *GraphQLAdditionalArgumentsProvider) getGenerate() modulecapabilities.Ad
 		},
 	}
 }
+
+func (p *GraphQLAdditionalArgumentsProvider) getReRanker() modulecapabilities.AdditionalProperty {
+	return modulecapabilities.AdditionalProperty{
+		GraphQLNames:           []string{"rerank"},
+		GraphQLFieldFunction:   p.rerankProvider.AdditionalFieldFn,
+		GraphQLExtractFunction: p.rerankProvider.ExtractAdditionalFn,
+		SearchFunctions: modulecapabilities.AdditionalSearch{
+			ExploreGet:  p.rerankProvider.AdditionalPropertyFn,
+			ExploreList: p.rerankProvider.AdditionalPropertyFn,
+		},
+	}
+}
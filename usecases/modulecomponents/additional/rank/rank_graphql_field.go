@@ -30,6 +30,14 @@ func (p *ReRankerProvider) additionalReRankerField(classname string) *graphql.Fi
 				Type:         graphql.String,
 				DefaultValue: nil,
 			},
+			"window": &graphql.ArgumentConfig{
+				Description: "Number of leading results which are reranked, 0 reranks all results",
+				Type:        graphql.Int,
+			},
+			"rerankWeight": &graphql.ArgumentConfig{
+				Description: "Weight of the reranker score blended with the original score, 1 uses the reranker score only",
+				Type:        graphql.Float,
+			},
 		},
 		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
 			Name: fmt.Sprintf("%sAdditionalReranker", classname),
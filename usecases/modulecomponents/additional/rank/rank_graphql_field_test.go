@@ -20,11 +20,11 @@ import (
 
 func Test_additionalCrossRankerField(t *testing.T) {
 	// given
-	reRankerProvider := &ReRankerCohereProvider{}
+	reRankerProvider := &ReRankerProvider{}
 	classname := "Class"
 
 	// when
-	reRanker := reRankerProvider.additionalReRankerCohereField(classname)
+	reRanker := reRankerProvider.additionalReRankerField(classname)
 
 	assert.NotNil(t, reRanker)
 	assert.Equal(t, "ClassAdditionalReranker", reRanker.Type.Name())
@@ -37,7 +37,9 @@ func Test_additionalCrossRankerField(t *testing.T) {
 	assert.NotNil(t, reRankerObject.Fields()["score"])
 
 	assert.NotNil(t, reRanker.Args)
-	assert.Equal(t, 2, len(reRanker.Args))
+	assert.Equal(t, 4, len(reRanker.Args))
 	assert.NotNil(t, reRanker.Args["query"])
 	assert.NotNil(t, reRanker.Args["property"])
+	assert.NotNil(t, reRanker.Args["window"])
+	assert.NotNil(t, reRanker.Args["rerankWeight"])
 }
@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+type Params struct {
+	Property *string
+	Query    *string
+	// Window is the number of leading results which are reranked, the
+	// others keep their order after them. 0 reranks all results
+	Window *int
+	// RerankWeight is the weight of the reranker score in the final score,
+	// which is blended with the original score of the search. 1 uses the
+	// reranker score only
+	RerankWeight *float64
+}
+
+func (n Params) GetQuery() string {
+	if n.Query != nil {
+		return *n.Query
+	}
+	return ""
+}
+
+func (n Params) GetProperty() string {
+	if n.Property != nil {
+		return *n.Property
+	}
+	return ""
+}
+
+func (n Params) GetWindow() int {
+	if n.Window != nil {
+		return *n.Window
+	}
+	return 0
+}
+
+func (n Params) GetRerankWeight() float64 {
+	if n.RerankWeight != nil {
+		return *n.RerankWeight
+	}
+	return 1
+}
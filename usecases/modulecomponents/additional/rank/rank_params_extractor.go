@@ -12,6 +12,8 @@
 package rank
 
 import (
+	"strconv"
+
 	"github.com/tailor-inc/graphql/language/ast"
 )
 
@@ -24,6 +26,12 @@ func (p *ReRankerProvider) parseReRankerArguments(args []*ast.Argument) *Params
 			out.Query = &arg.Value.(*ast.StringValue).Value
 		case "property":
 			out.Property = &arg.Value.(*ast.StringValue).Value
+		case "window":
+			asInt, _ := strconv.Atoi(arg.Value.GetValue().(string))
+			out.Window = &asInt
+		case "rerankWeight":
+			asFloat, _ := strconv.ParseFloat(arg.Value.GetValue().(string), 64)
+			out.RerankWeight = &asFloat
 		}
 	}
 
@@ -68,6 +68,21 @@ func Test_parseCrossRankerArguments(t *testing.T) {
 				Property: strPtr("sample property"),
 			},
 		},
+		{
+			name: "Should create with window and rerank weight",
+			args: args{
+				args: []*ast.Argument{
+					createStringArg("property", "sample property"),
+					createValueArg("window", &ast.IntValue{Kind: "IntValue", Value: "10"}),
+					createValueArg("rerankWeight", &ast.FloatValue{Kind: "FloatValue", Value: "0.7"}),
+				},
+			},
+			want: &Params{
+				Property:     strPtr("sample property"),
+				Window:       intPtr(10),
+				RerankWeight: floatPtr(0.7),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -101,3 +116,19 @@ func createStringArg(name, value string) *ast.Argument {
 func strPtr(s string) *string {
 	return &s
 }
+
+func createValueArg(name string, value ast.Value) *ast.Argument {
+	return ast.NewArgument(&ast.Argument{
+		Name:  ast.NewName(&ast.Name{Value: name}),
+		Kind:  "Kind",
+		Value: value,
+	})
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
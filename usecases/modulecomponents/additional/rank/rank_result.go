@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+	rerankmodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+func (p *ReRankerProvider) getScore(ctx context.Context, cfg moduletools.ClassConfig,
+	in []search.Result, params *Params,
+) ([]search.Result, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if params == nil {
+		return nil, fmt.Errorf("no params provided")
+	}
+
+	rankProperty := params.GetProperty()
+	query := params.GetQuery()
+
+	// check if user parameter values are valid
+	if len(rankProperty) == 0 {
+		return in, errors.New("no properties provided")
+	}
+	window := params.GetWindow()
+	if window < 0 {
+		return in, errors.New("window must not be negative")
+	}
+	weight := params.GetRerankWeight()
+	if weight < 0 || weight > 1 {
+		return in, errors.New("rerankWeight must be between 0 and 1")
+	}
+
+	// only the results within the window are reranked, the others keep
+	// their order after them
+	ranked := in
+	if window > 0 && window < len(in) {
+		ranked = in[:window]
+	}
+
+	// all results are ranked with a single call, clients split the documents
+	// into batches if needed
+	documents := make([]string, len(ranked))
+	for i := range ranked {
+		schema, _ := ranked[i].Object().Properties.(map[string]interface{})
+		if value, ok := schema[rankProperty].(string); ok {
+			documents[i] = value
+		}
+	}
+
+	result, err := p.client.Rank(ctx, query, documents, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error ranking: %w", err)
+	}
+	if len(result.DocumentScores) != len(ranked) {
+		return nil, fmt.Errorf("error ranking: got %d scores for %d documents",
+			len(result.DocumentScores), len(ranked))
+	}
+
+	scores := make([]float64, len(ranked))
+	for i := range result.DocumentScores {
+		scores[i] = result.DocumentScores[i].Score
+	}
+	if weight < 1 {
+		scores = blendScores(scores, originalScores(ranked), weight)
+	}
+
+	for i := range ranked {
+		ap := ranked[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
+		}
+		score := scores[i]
+		ap["rerank"] = []*rerankmodels.RankResult{
+			{
+				Score: &score,
+			},
+		}
+		ranked[i].AdditionalProperties = ap
+	}
+
+	// sort the list
+	sort.SliceStable(ranked, func(i, j int) bool {
+		apI := ranked[i].AdditionalProperties["rerank"].([]*rerankmodels.RankResult)
+		apJ := ranked[j].AdditionalProperties["rerank"].([]*rerankmodels.RankResult)
+
+		// Sort in descending order, based on Score values
+		return *apI[0].Score > *apJ[0].Score
+	})
+	return in, nil
+}
+
+// originalScores are the scores of the search, higher is better. Results of
+// keyword and hybrid searches have a score, results of vector searches a
+// distance. Results without either are scored by their position.
+func originalScores(in []search.Result) []float64 {
+	var hasScore, hasDist bool
+	for i := range in {
+		hasScore = hasScore || in[i].Score != 0
+		hasDist = hasDist || in[i].Dist != 0
+	}
+	scores := make([]float64, len(in))
+	for i := range in {
+		switch {
+		case hasScore:
+			scores[i] = float64(in[i].Score)
+		case hasDist:
+			scores[i] = -float64(in[i].Dist)
+		default:
+			scores[i] = -float64(i)
+		}
+	}
+	return scores
+}
+
+// blendScores combines the reranker and the original scores, both
+// normalized to [0, 1], weighting the reranker scores with weight
+func blendScores(rerankScores, originalScores []float64, weight float64) []float64 {
+	rerankScores = normalize(rerankScores)
+	originalScores = normalize(originalScores)
+	blended := make([]float64, len(rerankScores))
+	for i := range blended {
+		blended[i] = weight*rerankScores[i] + (1-weight)*originalScores[i]
+	}
+	return blended
+}
+
+// normalize min-max scales the scores to [0, 1], equal scores are all 1
+func normalize(scores []float64) []float64 {
+	if len(scores) == 0 {
+		return scores
+	}
+	min, max := scores[0], scores[0]
+	for _, score := range scores {
+		if score < min {
+			min = score
+		}
+		if score > max {
+			max = score
+		}
+	}
+	normalized := make([]float64, len(scores))
+	for i, score := range scores {
+		if max == min {
+			normalized[i] = 1
+		} else {
+			normalized[i] = (score - min) / (max - min)
+		}
+	}
+	return normalized
+}
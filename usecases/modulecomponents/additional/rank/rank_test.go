@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+	rerankmodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
+)
+
+func TestAdditionalAnswerProvider(t *testing.T) {
+	t.Run("should fail with empty content", func(t *testing.T) {
+		// given
+		rankClient := &fakeRankClient{}
+		rankProvider := New(rankClient)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+			},
+		}
+		fakeParams := &Params{}
+		limit := 1
+		argumentModuleParams := map[string]interface{}{}
+
+		// when
+		out, err := rankProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams, nil)
+
+		// then
+		require.NotNil(t, err)
+		require.NotEmpty(t, out)
+		assert.Error(t, err, "empty schema content")
+	})
+
+	t.Run("should fail with empty params", func(t *testing.T) {
+		// given
+		rankClient := &fakeRankClient{}
+		rankProvider := New(rankClient)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "content",
+				},
+			},
+		}
+		fakeParams := &Params{}
+		limit := 1
+		argumentModuleParams := map[string]interface{}{}
+
+		// when
+		out, err := rankProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams, nil)
+
+		// then
+		require.NotNil(t, err)
+		require.NotEmpty(t, out)
+		assert.Error(t, err, "empty params")
+	})
+
+	t.Run("should fail on client error", func(t *testing.T) {
+		rankClient := &fakeRankClient{}
+		rankProvider := New(rankClient)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "this is the content",
+				},
+			},
+		}
+		property := "content"
+		query := "unavailable"
+		fakeParams := &Params{Property: &property, Query: &query}
+		limit := 3
+		argumentModuleParams := map[string]interface{}{}
+
+		_, err := rankProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams, nil)
+		require.EqualError(t, err, "error ranking: unavailable")
+	})
+
+	t.Run("should rank", func(t *testing.T) {
+		rankClient := &fakeRankClient{}
+		rankProvider := New(rankClient)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "this is the content",
+				},
+			},
+		}
+		property := "content"
+		query := "this is the query"
+		fakeParams := &Params{Property: &property, Query: &query}
+		limit := 1
+		argumentModuleParams := map[string]interface{}{}
+
+		// when
+		out, err := rankProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams, nil)
+		// then
+		require.Nil(t, err)
+		require.NotEmpty(t, out)
+		assert.Equal(t, 1, len(in))
+		answer, answerOK := in[0].AdditionalProperties["rerank"]
+		assert.True(t, answerOK)
+		assert.NotNil(t, answer)
+		answerAdditional, answerAdditionalOK := answer.([]*rerankmodels.RankResult)
+		require.True(t, answerAdditionalOK)
+		assert.Equal(t, 0.15, *answerAdditional[0].Score)
+	})
+
+	t.Run("should rank all results with a single call and sort them", func(t *testing.T) {
+		rankClient := &fakeRankClient{}
+		rankProvider := New(rankClient)
+		in := []search.Result{
+			{ID: "first", Schema: map[string]interface{}{"content": "short"}},
+			{ID: "second", Schema: map[string]interface{}{"content": "the longest content"}},
+			{ID: "third", Schema: map[string]interface{}{"other": "no content"}},
+		}
+		property := "content"
+		query := "this is the query"
+		fakeParams := &Params{Property: &property, Query: &query}
+		limit := 3
+
+		out, err := rankProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, map[string]interface{}{}, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, 1, rankClient.calls)
+		assert.Equal(t, []string{"short", "the longest content", ""}, rankClient.documents)
+		require.Len(t, out, 3)
+		assert.Equal(t, "second", string(out[0].ID))
+		assert.Equal(t, "first", string(out[1].ID))
+		assert.Equal(t, "third", string(out[2].ID))
+	})
+
+	t.Run("should only rerank results within the window", func(t *testing.T) {
+		rankClient := &fakeRankClient{}
+		rankProvider := New(rankClient)
+		in := []search.Result{
+			{ID: "first", Schema: map[string]interface{}{"content": "short"}},
+			{ID: "second", Schema: map[string]interface{}{"content": "the longest content"}},
+			{ID: "third", Schema: map[string]interface{}{"content": "a very very long content"}},
+		}
+		property := "content"
+		window := 2
+		fakeParams := &Params{Property: &property, Window: &window}
+		limit := 3
+
+		out, err := rankProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, map[string]interface{}{}, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"short", "the longest content"}, rankClient.documents)
+		require.Len(t, out, 3)
+		assert.Equal(t, "second", string(out[0].ID))
+		assert.Equal(t, "first", string(out[1].ID))
+		assert.Equal(t, "third", string(out[2].ID))
+		assert.Nil(t, out[2].AdditionalProperties["rerank"])
+	})
+
+	t.Run("should blend reranker and original scores", func(t *testing.T) {
+		rankClient := &fakeRankClient{}
+		rankProvider := New(rankClient)
+		in := []search.Result{
+			{ID: "first", Score: 0.9, Schema: map[string]interface{}{"content": "short"}},
+			{ID: "second", Score: 0.1, Schema: map[string]interface{}{"content": "the longest content"}},
+		}
+		property := "content"
+		weight := 0.3
+		fakeParams := &Params{Property: &property, RerankWeight: &weight}
+		limit := 2
+
+		out, err := rankProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, map[string]interface{}{}, nil)
+
+		require.Nil(t, err)
+		require.Len(t, out, 2)
+		assert.Equal(t, "first", string(out[0].ID))
+		assert.Equal(t, "second", string(out[1].ID))
+		first := out[0].AdditionalProperties["rerank"].([]*rerankmodels.RankResult)
+		second := out[1].AdditionalProperties["rerank"].([]*rerankmodels.RankResult)
+		assert.InDelta(t, 0.7, *first[0].Score, 1e-9)
+		assert.InDelta(t, 0.3, *second[0].Score, 1e-9)
+	})
+
+	t.Run("should fail with invalid window and weight", func(t *testing.T) {
+		rankProvider := New(&fakeRankClient{})
+		in := []search.Result{{ID: "first", Schema: map[string]interface{}{"content": "short"}}}
+		property := "content"
+		window := -1
+		weight := 1.5
+		limit := 1
+
+		_, err := rankProvider.AdditionalPropertyFn(context.Background(), in,
+			&Params{Property: &property, Window: &window}, &limit, map[string]interface{}{}, nil)
+		require.EqualError(t, err, "window must not be negative")
+
+		_, err = rankProvider.AdditionalPropertyFn(context.Background(), in,
+			&Params{Property: &property, RerankWeight: &weight}, &limit, map[string]interface{}{}, nil)
+		require.EqualError(t, err, "rerankWeight must be between 0 and 1")
+	})
+}
+
+// fakeRankClient scores documents by their length
+type fakeRankClient struct {
+	calls     int
+	documents []string
+}
+
+func (c *fakeRankClient) Rank(ctx context.Context, query string, documents []string, cfg moduletools.ClassConfig) (*ent.RankResult, error) {
+	c.calls++
+	c.documents = documents
+	if query == "unavailable" {
+		return nil, errors.New("unavailable")
+	}
+	result := &ent.RankResult{Query: query}
+	for _, document := range documents {
+		score := 0.15
+		if len(documents) > 1 {
+			score = float64(len(document)) / 100
+		}
+		result.DocumentScores = append(result.DocumentScores, ent.DocumentScore{
+			Document: document,
+			Score:    score,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package batch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SendFunc sends the items of a batch in a single request. It has to return
+// one result per item in the order of the items.
+type SendFunc[In, Out any] func(ctx context.Context, input []In) ([]Out, error)
+
+// Batcher aggregates items which are vectorized concurrently, e.g. the
+// objects of a batch import, into a single request. A batch is sent as soon
+// as it holds maxItems items, when the next item would exceed the token
+// limit or once the window has passed since its first item was added. The
+// results are then fanned out to the callers waiting for them.
+type Batcher[In, Out any] struct {
+	sync.Mutex
+	maxItems  int
+	maxTokens int
+	tokens    func(In) int
+	window    time.Duration
+	send      SendFunc[In, Out]
+
+	pending *batch[In, Out]
+}
+
+type batch[In, Out any] struct {
+	items  []In
+	tokens int
+	timer  *time.Timer
+
+	// the request of a batch is cancelled once none of its callers is
+	// interested in the result anymore
+	ctx     context.Context
+	cancel  context.CancelFunc
+	waiting int
+
+	done    chan struct{}
+	results []Out
+	err     error
+}
+
+func NewBatcher[In, Out any](maxItems int, window time.Duration,
+	send SendFunc[In, Out],
+) *Batcher[In, Out] {
+	return &Batcher[In, Out]{
+		maxItems: maxItems,
+		window:   window,
+		send:     send,
+	}
+}
+
+// LimitTokens makes the batcher send a batch before the next item would
+// exceed maxTokens. It needs to be set before the batcher is used.
+func (b *Batcher[In, Out]) LimitTokens(maxTokens int, tokens func(In) int) *Batcher[In, Out] {
+	b.maxTokens = maxTokens
+	b.tokens = tokens
+	return b
+}
+
+// Vectorize adds the item to the pending batch and blocks until the batch
+// was sent or the context expires
+func (b *Batcher[In, Out]) Vectorize(ctx context.Context, item In) (*Out, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	tokens := 0
+	if b.maxTokens > 0 {
+		tokens = b.tokens(item)
+	}
+
+	b.Lock()
+	if b.pending != nil && b.maxTokens > 0 && b.pending.tokens+tokens > b.maxTokens {
+		b.flushLocked()
+	}
+	if b.pending == nil {
+		b.pending = b.newBatch()
+	}
+	current := b.pending
+	index := len(current.items)
+	current.items = append(current.items, item)
+	current.tokens += tokens
+	current.waiting++
+	if len(current.items) >= b.maxItems {
+		b.flushLocked()
+	}
+	b.Unlock()
+
+	select {
+	case <-current.done:
+		if current.err != nil {
+			return nil, current.err
+		}
+		return &current.results[index], nil
+	case <-ctx.Done():
+		b.giveUp(current)
+		return nil, ctx.Err()
+	}
+}
+
+func (b *Batcher[In, Out]) newBatch() *batch[In, Out] {
+	ctx, cancel := context.WithCancel(context.Background())
+	newBatch := &batch[In, Out]{
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	newBatch.timer = time.AfterFunc(b.window, func() {
+		b.Lock()
+		defer b.Unlock()
+
+		if b.pending == newBatch {
+			b.flushLocked()
+		}
+	})
+	return newBatch
+}
+
+// flushLocked sends the pending batch, the caller needs to hold the lock
+func (b *Batcher[In, Out]) flushLocked() {
+	current := b.pending
+	b.pending = nil
+	current.timer.Stop()
+
+	go func() {
+		defer current.cancel()
+
+		results, err := b.send(current.ctx, current.items)
+		if err == nil && len(results) != len(current.items) {
+			err = errors.Errorf("expected %d results, got %d",
+				len(current.items), len(results))
+		}
+		current.results, current.err = results, err
+		close(current.done)
+	}()
+}
+
+// giveUp is called by callers which stopped waiting for the result. A batch
+// which is still pending is dropped, a batch in flight is cancelled once
+// nobody is waiting for it anymore.
+func (b *Batcher[In, Out]) giveUp(current *batch[In, Out]) {
+	b.Lock()
+	defer b.Unlock()
+
+	current.waiting--
+	if current.waiting > 0 {
+		return
+	}
+
+	if b.pending == current {
+		b.pending = nil
+		current.timer.Stop()
+	}
+	current.cancel()
+}
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package batch
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSender struct {
+	sync.Mutex
+	batches [][]string
+	err     error
+}
+
+// send returns the length of every text as its result
+func (f *fakeSender) send(ctx context.Context, input []string) ([]int, error) {
+	f.Lock()
+	f.batches = append(f.batches, input)
+	f.Unlock()
+
+	if f.err != nil {
+		return nil, f.err
+	}
+	results := make([]int, len(input))
+	for i := range input {
+		results[i] = len(input[i])
+	}
+	return results, nil
+}
+
+func vectorizeConcurrently(b *Batcher[string, int], texts []string) ([]*int, []error) {
+	results := make([]*int, len(texts))
+	errs := make([]error, len(texts))
+	wg := sync.WaitGroup{}
+	for i := range texts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = b.Vectorize(context.Background(), texts[i])
+		}(i)
+	}
+	wg.Wait()
+	return results, errs
+}
+
+func TestBatcher(t *testing.T) {
+	t.Run("a full batch is sent in a single request", func(t *testing.T) {
+		sender := &fakeSender{}
+		b := NewBatcher(4, time.Minute, sender.send)
+
+		texts := []string{"a", "bb", "ccc", "dddd"}
+		results, errs := vectorizeConcurrently(b, texts)
+
+		for i := range texts {
+			require.Nil(t, errs[i])
+			assert.Equal(t, len(texts[i]), *results[i])
+		}
+		require.Len(t, sender.batches, 1)
+		assert.ElementsMatch(t, texts, sender.batches[0])
+	})
+
+	t.Run("an incomplete batch is sent after the window", func(t *testing.T) {
+		sender := &fakeSender{}
+		b := NewBatcher(100, time.Millisecond, sender.send)
+
+		res, err := b.Vectorize(context.Background(), "lonely text")
+
+		require.Nil(t, err)
+		assert.Equal(t, 11, *res)
+		assert.Len(t, sender.batches, 1)
+	})
+
+	t.Run("a batch is sent before it would exceed the tokens", func(t *testing.T) {
+		sender := &fakeSender{}
+		tokens := func(text string) int { return len(text) }
+		b := NewBatcher(100, time.Millisecond, sender.send).LimitTokens(4, tokens)
+
+		texts := []string{"aa", "bb", "cc"}
+		_, errs := vectorizeConcurrently(b, texts)
+
+		for i := range texts {
+			require.Nil(t, errs[i])
+		}
+		for _, batch := range sender.batches {
+			sum := 0
+			for _, text := range batch {
+				sum += tokens(text)
+			}
+			assert.LessOrEqual(t, sum, 4)
+		}
+		assert.GreaterOrEqual(t, len(sender.batches), 2)
+	})
+
+	t.Run("an error is returned to all callers of the batch", func(t *testing.T) {
+		sender := &fakeSender{err: errors.Errorf("nope")}
+		b := NewBatcher(2, time.Minute, sender.send)
+
+		_, errs := vectorizeConcurrently(b, []string{"one", "two"})
+
+		for i := range errs {
+			assert.EqualError(t, errs[i], "nope")
+		}
+	})
+
+	t.Run("a missing result is returned as an error", func(t *testing.T) {
+		b := NewBatcher(1, time.Minute,
+			func(ctx context.Context, input []string) ([]int, error) {
+				return nil, nil
+			})
+
+		_, err := b.Vectorize(context.Background(), "one")
+
+		assert.EqualError(t, err, "expected 1 results, got 0")
+	})
+
+	t.Run("a caller whose context expires stops waiting", func(t *testing.T) {
+		sender := &fakeSender{}
+		b := NewBatcher(100, time.Minute, sender.send)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		_, err := b.Vectorize(ctx, "some text")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+		assert.Nil(t, b.pending)
+		assert.Len(t, sender.batches, 0)
+	})
+}
+
+func TestBatchers(t *testing.T) {
+	now := time.Now()
+	batchers := NewBatchers[string, string, int](time.Minute)
+	batchers.now = func() time.Time { return now }
+
+	sender := &fakeSender{}
+	create := func() *Batcher[string, int] {
+		return NewBatcher(10, time.Millisecond, sender.send)
+	}
+
+	first := batchers.Get("first", create)
+	second := batchers.Get("second", create)
+
+	t.Run("a batcher is reused for the same key", func(t *testing.T) {
+		assert.Same(t, first, batchers.Get("first", create))
+		assert.NotSame(t, first, second)
+		assert.Equal(t, 2, batchers.Len())
+	})
+
+	t.Run("batchers which were used recently are kept", func(t *testing.T) {
+		now = now.Add(59 * time.Second)
+		assert.Same(t, first, batchers.Get("first", create))
+		assert.Equal(t, 2, batchers.Len())
+	})
+
+	t.Run("idle batchers are dropped", func(t *testing.T) {
+		now = now.Add(2 * time.Second)
+		assert.Same(t, first, batchers.Get("first", create))
+		assert.Equal(t, 1, batchers.Len())
+		assert.NotSame(t, second, batchers.Get("second", create))
+	})
+}
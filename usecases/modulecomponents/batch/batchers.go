@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package batch
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultIdleTimeout is the time after which a batcher which received no
+// items is dropped
+const DefaultIdleTimeout = 10 * time.Minute
+
+// Batchers holds a batcher per key, e.g. per endpoint, model and api key of
+// the requests. Batchers which weren't used for the idle timeout are dropped,
+// so that neither they nor the api keys captured by their send functions are
+// kept for the lifetime of the module.
+type Batchers[K comparable, In, Out any] struct {
+	sync.Mutex
+	idleTimeout time.Duration
+	batchers    map[K]*idleBatcher[In, Out]
+	lastEvicted time.Time
+	now         func() time.Time
+}
+
+type idleBatcher[In, Out any] struct {
+	*Batcher[In, Out]
+	lastUsed time.Time
+}
+
+func NewBatchers[K comparable, In, Out any](idleTimeout time.Duration) *Batchers[K, In, Out] {
+	return &Batchers[K, In, Out]{
+		idleTimeout: idleTimeout,
+		batchers:    map[K]*idleBatcher[In, Out]{},
+		now:         time.Now,
+	}
+}
+
+// Get returns the batcher of the key and creates it if there is none
+func (b *Batchers[K, In, Out]) Get(key K, create func() *Batcher[In, Out]) *Batcher[In, Out] {
+	b.Lock()
+	defer b.Unlock()
+
+	now := b.now()
+	b.evictIdleLocked(now)
+
+	batcher, ok := b.batchers[key]
+	if !ok {
+		batcher = &idleBatcher[In, Out]{Batcher: create()}
+		b.batchers[key] = batcher
+	}
+	batcher.lastUsed = now
+	return batcher.Batcher
+}
+
+// Len returns the number of batchers held
+func (b *Batchers[K, In, Out]) Len() int {
+	b.Lock()
+	defer b.Unlock()
+
+	return len(b.batchers)
+}
+
+// evictIdleLocked drops the batchers which weren't used for the idle
+// timeout. Callers which got such a batcher before keep using it, a pending
+// batch is still sent once its window has passed. The caller needs to hold
+// the lock.
+func (b *Batchers[K, In, Out]) evictIdleLocked(now time.Time) {
+	if b.idleTimeout <= 0 || now.Sub(b.lastEvicted) < b.idleTimeout {
+		return
+	}
+	b.lastEvicted = now
+
+	for key, batcher := range b.batchers {
+		if now.Sub(batcher.lastUsed) >= b.idleTimeout {
+			delete(b.batchers, key)
+		}
+	}
+}
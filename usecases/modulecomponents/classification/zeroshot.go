@@ -0,0 +1,301 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package classification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
+	libfilters "github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+const maximumCandidates = 10000
+
+type generativeClient interface {
+	Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*generativemodels.GenerateResponse, error)
+}
+
+// ZeroShotClassifier assigns each unclassified object one of the objects of
+// the reference target class by asking a generative model to pick the
+// matching label out of all candidates. It requires no training data.
+type ZeroShotClassifier struct {
+	moduleName string
+	client     generativeClient
+}
+
+func NewZeroShotClassifier(moduleName string, client generativeClient) modulecapabilities.Classifier {
+	return &ZeroShotClassifier{moduleName: moduleName, client: client}
+}
+
+func (c *ZeroShotClassifier) Name() string {
+	return fmt.Sprintf("%s-zeroshot", c.moduleName)
+}
+
+func (c *ZeroShotClassifier) ParseClassifierSettings(params *models.Classification) error {
+	return parseZeroShotSettings(params)
+}
+
+type candidate struct {
+	label  string
+	target search.Result
+}
+
+func (c *ZeroShotClassifier) ClassifyFn(params modulecapabilities.ClassifyParams) (modulecapabilities.ClassifyItemFn, error) {
+	if c.client == nil {
+		return nil, errors.Errorf("cannot use %s without a generative client", c.Name())
+	}
+
+	settings := params.Params.Settings.(*ParamsZeroShot) // safe assertion after parsing
+
+	candidates := map[string][]candidate{}
+	for _, propName := range params.Params.ClassifyProperties {
+		found, err := c.findCandidates(params, settings, propName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "target prop '%s'", propName)
+		}
+		candidates[propName] = found
+	}
+
+	return c.makeClassifyItem(params.ClassConfig, settings, candidates), nil
+}
+
+func (c *ZeroShotClassifier) findCandidates(params modulecapabilities.ClassifyParams,
+	settings *ParamsZeroShot, propName string,
+) ([]candidate, error) {
+	targetClass, err := c.targetClass(params.Schema, params.Params.Class, propName)
+	if err != nil {
+		return nil, err
+	}
+
+	labelProp, err := c.labelProperty(targetClass, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	res, err := params.VectorRepo.VectorClassSearch(ctx, modulecapabilities.VectorClassSearchParams{
+		Filters: params.Filters.Target(),
+		Pagination: &libfilters.Pagination{
+			Limit: maximumCandidates,
+		},
+		ClassName:  targetClass.Class,
+		Properties: []string{labelProp},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "search candidates")
+	}
+
+	candidates := make([]candidate, 0, len(res))
+	for _, target := range res {
+		schemaMap, ok := target.Schema.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		label, ok := schemaMap[labelProp].(string)
+		if !ok || strings.TrimSpace(label) == "" {
+			continue
+		}
+		candidates = append(candidates, candidate{label: strings.TrimSpace(label), target: target})
+	}
+
+	if len(candidates) == 0 {
+		return nil, errors.Errorf("no candidates with property '%s' found of class '%s'",
+			labelProp, targetClass.Class)
+	}
+
+	return candidates, nil
+}
+
+func (c *ZeroShotClassifier) targetClass(s schema.Schema, className, propName string) (*models.Class, error) {
+	prop, err := s.GetProperty(schema.ClassName(className), schema.PropertyName(propName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "get target prop '%s'", propName)
+	}
+
+	dataType, err := s.FindPropertyDataType(prop.DataType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "extract dataType of prop '%s'", propName)
+	}
+
+	if !dataType.IsReference() {
+		return nil, errors.Errorf("property '%s' must be of reference type (cref)", propName)
+	}
+
+	targetClasses := dataType.Classes()
+	if len(targetClasses) != 1 {
+		return nil, errors.Errorf("property '%s' has %d target classes, "+
+			"classification of type '%s' requires exactly one target class",
+			propName, len(targetClasses), c.Name())
+	}
+
+	class := s.FindClassByName(targetClasses[0])
+	if class == nil {
+		return nil, errors.Errorf("target class '%s' not found in schema", targetClasses[0])
+	}
+
+	return class, nil
+}
+
+func (c *ZeroShotClassifier) labelProperty(class *models.Class, settings *ParamsZeroShot) (string, error) {
+	for _, prop := range class.Properties {
+		if len(prop.DataType) != 1 || prop.DataType[0] != schema.DataTypeText.String() {
+			continue
+		}
+		if settings.LabelProperty == nil || *settings.LabelProperty == prop.Name {
+			return prop.Name, nil
+		}
+	}
+
+	if settings.LabelProperty != nil {
+		return "", errors.Errorf("labelProperty '%s' is not a text property of class '%s'",
+			*settings.LabelProperty, class.Class)
+	}
+	return "", errors.Errorf("class '%s' has no text property to use as label", class.Class)
+}
+
+func (c *ZeroShotClassifier) makeClassifyItem(cfg moduletools.ClassConfig, settings *ParamsZeroShot,
+	candidates map[string][]candidate,
+) modulecapabilities.ClassifyItemFn {
+	return func(item search.Result, itemIndex int, params models.Classification,
+		filters modulecapabilities.Filters, writer modulecapabilities.Writer,
+	) error {
+		schemaMap, ok := item.Schema.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("no or incorrect schema map present on source object '%s': %T",
+				item.ID, item.Schema)
+		}
+
+		// Limitation for now, basedOnProperty is always 0
+		basedOnName := params.BasedOnProperties[0]
+		text, ok := schemaMap[basedOnName].(string)
+		if !ok {
+			return errors.Errorf("property '%s' not present on %s or not of type text",
+				basedOnName, item.ID)
+		}
+
+		var classified []string
+		for _, propName := range params.ClassifyProperties {
+			winner, err := c.classifyProperty(cfg, settings, text, candidates[propName])
+			if err != nil {
+				return errors.Wrapf(err, "%s: prop '%s'", c.Name(), propName)
+			}
+
+			cref := crossref.NewLocalhost(winner.target.ClassName, winner.target.ID)
+			schemaMap[propName] = models.MultipleRef{
+				&models.SingleRef{
+					Beacon:         cref.SingleRef().Beacon,
+					Classification: &models.ReferenceMetaClassification{},
+				},
+			}
+			classified = append(classified, propName)
+		}
+
+		extendItemWithObjectMeta(&item, params, classified)
+		if err := writer.Store(item); err != nil {
+			return errors.Errorf("store %s/%s: %v", item.ClassName, item.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func (c *ZeroShotClassifier) classifyProperty(cfg moduletools.ClassConfig, settings *ParamsZeroShot,
+	text string, candidates []candidate,
+) (*candidate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	res, err := c.client.Generate(ctx, cfg, buildPrompt(*settings.Instruction, text, candidates))
+	if err != nil {
+		return nil, errors.Wrap(err, "generate")
+	}
+	if res == nil || res.Result == nil {
+		return nil, errors.New("generate: empty answer")
+	}
+
+	winner := matchCandidate(*res.Result, candidates)
+	if winner == nil {
+		return nil, errors.Errorf("answer %q does not match any candidate label", *res.Result)
+	}
+
+	return winner, nil
+}
+
+func buildPrompt(instruction, text string, candidates []candidate) string {
+	var b strings.Builder
+	b.WriteString(instruction)
+	b.WriteString("\n\nCandidate labels:\n")
+	for _, cand := range candidates {
+		b.WriteString("- ")
+		b.WriteString(cand.label)
+		b.WriteString("\n")
+	}
+	b.WriteString("\nText:\n")
+	b.WriteString(text)
+	b.WriteString("\n\nLabel:")
+	return b.String()
+}
+
+// matchCandidate maps the model's answer back to a candidate. An exact
+// (case-insensitive) match wins, otherwise the longest label contained in
+// the answer is used, so that answers such as "Label: Sports." still resolve.
+func matchCandidate(answer string, candidates []candidate) *candidate {
+	normalized := strings.ToLower(strings.Trim(strings.TrimSpace(answer), ".\"'`"))
+
+	for i := range candidates {
+		if strings.ToLower(candidates[i].label) == normalized {
+			return &candidates[i]
+		}
+	}
+
+	var winner *candidate
+	for i := range candidates {
+		label := strings.ToLower(candidates[i].label)
+		if !strings.Contains(normalized, label) {
+			continue
+		}
+		if winner == nil || len(label) > len(winner.label) {
+			winner = &candidates[i]
+		}
+	}
+
+	return winner
+}
+
+func extendItemWithObjectMeta(item *search.Result,
+	params models.Classification, classified []string,
+) {
+	// don't overwrite existing non-classification meta info
+	if item.AdditionalProperties == nil {
+		item.AdditionalProperties = models.AdditionalProperties{}
+	}
+
+	item.AdditionalProperties["classification"] = additional.Classification{
+		ID:               params.ID,
+		Scope:            params.ClassifyProperties,
+		ClassifiedFields: classified,
+		Completed:        strfmt.DateTime(time.Now()),
+	}
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package classification
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type ParamsZeroShot struct {
+	// LabelProperty is the text property of the reference target class which
+	// is presented to the model as the candidate label, defaults to the first
+	// text property of the target class
+	LabelProperty *string `json:"labelProperty"`
+	// Instruction replaces the default task description of the prompt
+	Instruction *string `json:"instruction"`
+}
+
+const defaultInstruction = "Classify the following text into exactly one of the candidate labels. " +
+	"Answer only with the chosen label and nothing else."
+
+func (params *ParamsZeroShot) SetDefaults() {
+	if params.Instruction == nil {
+		defaultParam := defaultInstruction
+		params.Instruction = &defaultParam
+	}
+}
+
+func parseZeroShotSettings(params *models.Classification) error {
+	raw := params.Settings
+	settings := &ParamsZeroShot{}
+	if raw == nil {
+		settings.SetDefaults()
+		params.Settings = settings
+		return nil
+	}
+
+	asMap, ok := raw.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("settings must be an object got %T", raw)
+	}
+
+	v, err := extractStringFromMap(asMap, "labelProperty")
+	if err != nil {
+		return err
+	}
+	settings.LabelProperty = v
+
+	v, err = extractStringFromMap(asMap, "instruction")
+	if err != nil {
+		return err
+	}
+	settings.Instruction = v
+
+	settings.SetDefaults()
+	params.Settings = settings
+
+	return nil
+}
+
+func extractStringFromMap(in map[string]interface{}, field string) (*string, error) {
+	unparsed, present := in[field]
+	if !present {
+		return nil, nil
+	}
+
+	parsed, ok := unparsed.(string)
+	if !ok {
+		return nil, errors.Errorf("settings.%s must be string, got %T",
+			field, unparsed)
+	}
+
+	if strings.TrimSpace(parsed) == "" {
+		return nil, errors.Errorf("settings.%s must not be empty", field)
+	}
+
+	return &parsed, nil
+}
@@ -0,0 +1,248 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package classification
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+const (
+	idSports   strfmt.UUID = "6b6b2a3e-2b4c-4c2a-9a0f-5a1f7f0a0001"
+	idPolitics strfmt.UUID = "6b6b2a3e-2b4c-4c2a-9a0f-5a1f7f0a0002"
+	idArticle  strfmt.UUID = "6b6b2a3e-2b4c-4c2a-9a0f-5a1f7f0a0003"
+)
+
+func TestZeroShotClassifier(t *testing.T) {
+	t.Run("name is derived from the module", func(t *testing.T) {
+		c := NewZeroShotClassifier("generative-openai", &fakeGenerativeClient{})
+		assert.Equal(t, "generative-openai-zeroshot", c.Name())
+	})
+
+	t.Run("classifies using the generated label", func(t *testing.T) {
+		client := &fakeGenerativeClient{answer: "Sports."}
+		c := NewZeroShotClassifier("generative-openai", client)
+		params := testClassification(nil)
+		require.Nil(t, c.ParseClassifierSettings(&params))
+
+		fn, err := c.ClassifyFn(modulecapabilities.ClassifyParams{
+			Schema:     testSchema(),
+			Params:     params,
+			Filters:    fakeFilters{},
+			VectorRepo: &fakeVectorRepo{},
+		})
+		require.Nil(t, err)
+
+		writer := &fakeWriter{}
+		item := search.Result{
+			ID:        idArticle,
+			ClassName: "Article",
+			Schema:    map[string]interface{}{"content": "the team won the match"},
+		}
+		require.Nil(t, fn(item, 0, params, fakeFilters{}, writer))
+
+		require.Len(t, writer.stored, 1)
+		ref := writer.stored[0].Schema.(map[string]interface{})["ofCategory"].(models.MultipleRef)
+		require.Len(t, ref, 1)
+		assert.Equal(t, strfmt.URI("weaviate://localhost/Category/"+idSports), ref[0].Beacon)
+		assert.Contains(t, client.prompt, "- Sports\n- Politics\n")
+		assert.Contains(t, client.prompt, "the team won the match")
+	})
+
+	t.Run("fails when the answer matches no candidate", func(t *testing.T) {
+		c := NewZeroShotClassifier("generative-openai", &fakeGenerativeClient{answer: "Weather"})
+		params := testClassification(nil)
+		require.Nil(t, c.ParseClassifierSettings(&params))
+
+		fn, err := c.ClassifyFn(modulecapabilities.ClassifyParams{
+			Schema:     testSchema(),
+			Params:     params,
+			Filters:    fakeFilters{},
+			VectorRepo: &fakeVectorRepo{},
+		})
+		require.Nil(t, err)
+
+		item := search.Result{
+			ID:        idArticle,
+			ClassName: "Article",
+			Schema:    map[string]interface{}{"content": "sunny all week"},
+		}
+		err = fn(item, 0, params, fakeFilters{}, &fakeWriter{})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "does not match any candidate label")
+	})
+
+	t.Run("unknown label property", func(t *testing.T) {
+		c := NewZeroShotClassifier("generative-openai", &fakeGenerativeClient{})
+		params := testClassification(map[string]interface{}{"labelProperty": "nope"})
+		require.Nil(t, c.ParseClassifierSettings(&params))
+
+		_, err := c.ClassifyFn(modulecapabilities.ClassifyParams{
+			Schema:     testSchema(),
+			Params:     params,
+			Filters:    fakeFilters{},
+			VectorRepo: &fakeVectorRepo{},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "labelProperty 'nope' is not a text property")
+	})
+}
+
+func TestParseZeroShotSettings(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		params := testClassification(nil)
+		require.Nil(t, parseZeroShotSettings(&params))
+		settings := params.Settings.(*ParamsZeroShot)
+		assert.Nil(t, settings.LabelProperty)
+		assert.Equal(t, defaultInstruction, *settings.Instruction)
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		params := testClassification(map[string]interface{}{
+			"labelProperty": "name",
+			"instruction":   "Pick a topic.",
+		})
+		require.Nil(t, parseZeroShotSettings(&params))
+		settings := params.Settings.(*ParamsZeroShot)
+		assert.Equal(t, "name", *settings.LabelProperty)
+		assert.Equal(t, "Pick a topic.", *settings.Instruction)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		params := testClassification(map[string]interface{}{"labelProperty": 7})
+		err := parseZeroShotSettings(&params)
+		require.NotNil(t, err)
+		assert.Equal(t, "settings.labelProperty must be string, got int", err.Error())
+	})
+}
+
+func TestMatchCandidate(t *testing.T) {
+	candidates := []candidate{{label: "Sports"}, {label: "Winter Sports"}, {label: "Politics"}}
+
+	tests := []struct {
+		answer   string
+		expected string
+	}{
+		{answer: "sports", expected: "Sports"},
+		{answer: " Politics. ", expected: "Politics"},
+		{answer: "Label: Winter Sports", expected: "Winter Sports"},
+		{answer: "Weather", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.answer, func(t *testing.T) {
+			winner := matchCandidate(test.answer, candidates)
+			if test.expected == "" {
+				assert.Nil(t, winner)
+				return
+			}
+			require.NotNil(t, winner)
+			assert.Equal(t, test.expected, winner.label)
+		})
+	}
+}
+
+func testClassification(settings interface{}) models.Classification {
+	return models.Classification{
+		ID:                 "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c",
+		Class:              "Article",
+		BasedOnProperties:  []string{"content"},
+		ClassifyProperties: []string{"ofCategory"},
+		Settings:           settings,
+	}
+}
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Category",
+					Properties: []*models.Property{
+						{Name: "size", DataType: schema.DataTypeInt.PropString()},
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				{
+					Class: "Article",
+					Properties: []*models.Property{
+						{Name: "content", DataType: schema.DataTypeText.PropString()},
+						{Name: "ofCategory", DataType: []string{"Category"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+type fakeGenerativeClient struct {
+	answer string
+	prompt string
+}
+
+func (c *fakeGenerativeClient) Generate(ctx context.Context, cfg moduletools.ClassConfig,
+	prompt string,
+) (*generativemodels.GenerateResponse, error) {
+	c.prompt = prompt
+	return &generativemodels.GenerateResponse{Result: &c.answer}, nil
+}
+
+type fakeVectorRepo struct{}
+
+func (r *fakeVectorRepo) VectorClassSearch(ctx context.Context,
+	params modulecapabilities.VectorClassSearchParams,
+) ([]search.Result, error) {
+	if params.ClassName != "Category" {
+		return nil, errors.New("unexpected class " + params.ClassName)
+	}
+	if strings.Join(params.Properties, ",") != "name" {
+		return nil, errors.New("unexpected properties " + strings.Join(params.Properties, ","))
+	}
+	return []search.Result{
+		{ID: idSports, ClassName: "Category", Schema: map[string]interface{}{"name": "Sports"}},
+		{ID: idPolitics, ClassName: "Category", Schema: map[string]interface{}{"name": "Politics"}},
+	}, nil
+}
+
+type fakeFilters struct{}
+
+func (f fakeFilters) Source() *filters.LocalFilter      { return nil }
+func (f fakeFilters) Target() *filters.LocalFilter      { return nil }
+func (f fakeFilters) TrainingSet() *filters.LocalFilter { return nil }
+
+type fakeWriter struct {
+	stored []search.Result
+}
+
+func (w *fakeWriter) Start() {}
+
+func (w *fakeWriter) Store(item search.Result) error {
+	w.stored = append(w.stored, item)
+	return nil
+}
+
+func (w *fakeWriter) Stop() modulecapabilities.WriterResults {
+	return nil
+}
@@ -0,0 +1,24 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ent
+
+// RankResult holds the scores of the documents ranked against the query,
+// in the order the documents were passed
+type RankResult struct {
+	Query          string
+	DocumentScores []DocumentScore
+}
+
+type DocumentScore struct {
+	Document string
+	Score    float64
+}
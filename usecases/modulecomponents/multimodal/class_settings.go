@@ -0,0 +1,280 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multimodal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// BaseClassSettings holds the settings shared by the multi2vec modules: which
+// properties hold texts and images and how their vectors are weighted
+type BaseClassSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewBaseClassSettings(cfg moduletools.ClassConfig) *BaseClassSettings {
+	return &BaseClassSettings{cfg: cfg}
+}
+
+func (ic *BaseClassSettings) ImageField(property string) bool {
+	return ic.field("imageFields", property)
+}
+
+func (ic *BaseClassSettings) ImageFields() []string {
+	return ic.fields("imageFields")
+}
+
+func (ic *BaseClassSettings) ImageFieldsWeights() ([]float32, error) {
+	return ic.getFieldsWeights("image")
+}
+
+func (ic *BaseClassSettings) TextField(property string) bool {
+	return ic.field("textFields", property)
+}
+
+func (ic *BaseClassSettings) TextFields() []string {
+	return ic.fields("textFields")
+}
+
+func (ic *BaseClassSettings) TextFieldsWeights() ([]float32, error) {
+	return ic.getFieldsWeights("text")
+}
+
+func (ic *BaseClassSettings) field(name, property string) bool {
+	fieldNames := ic.fields(name)
+	for i := range fieldNames {
+		if fieldNames[i] == property {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ic *BaseClassSettings) fields(name string) []string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return nil
+	}
+
+	fields, ok := ic.cfg.Class()[name]
+	if !ok {
+		return nil
+	}
+
+	fieldsArray, ok := fields.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	fieldNames := make([]string, 0, len(fieldsArray))
+	for _, value := range fieldsArray {
+		if fieldName, ok := value.(string); ok {
+			fieldNames = append(fieldNames, fieldName)
+		}
+	}
+
+	return fieldNames
+}
+
+// ValidateFields validates the imageFields and textFields settings and their
+// weights
+func (ic *BaseClassSettings) ValidateFields() error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	imageFields, imageFieldsOk := ic.cfg.Class()["imageFields"]
+	textFields, textFieldsOk := ic.cfg.Class()["textFields"]
+	if !imageFieldsOk && !textFieldsOk {
+		return errors.New("textFields or imageFields setting needs to be present")
+	}
+
+	if imageFieldsOk {
+		imageFieldsCount, err := ic.validateFields("image", imageFields)
+		if err != nil {
+			return err
+		}
+		err = ic.validateWeights("image", imageFieldsCount)
+		if err != nil {
+			return err
+		}
+	}
+
+	if textFieldsOk {
+		textFieldsCount, err := ic.validateFields("text", textFields)
+		if err != nil {
+			return err
+		}
+		err = ic.validateWeights("text", textFieldsCount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ic *BaseClassSettings) validateFields(name string, fields interface{}) (int, error) {
+	fieldsArray, ok := fields.([]interface{})
+	if !ok {
+		return 0, errors.Errorf("%sFields must be an array", name)
+	}
+
+	if len(fieldsArray) == 0 {
+		return 0, errors.Errorf("must contain at least one %s field name in %sFields", name, name)
+	}
+
+	for _, value := range fieldsArray {
+		v, ok := value.(string)
+		if !ok {
+			return 0, errors.Errorf("%sField must be a string", name)
+		}
+		if len(v) == 0 {
+			return 0, errors.Errorf("%sField values cannot be empty", name)
+		}
+	}
+
+	return len(fieldsArray), nil
+}
+
+func (ic *BaseClassSettings) validateWeights(name string, count int) error {
+	if weight, ok := ic.getModalityWeight(name); ok {
+		if _, err := GetNumber(weight); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	weights, ok := ic.getWeights(name)
+	if ok {
+		if len(weights) != count {
+			return errors.Errorf("weights.%sFields does not equal number of %sFields", name, name)
+		}
+		_, err := ic.getWeightsArray(weights)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getModalityWeight returns the weight of all fields of a kind, if the
+// weights are not given per field, e.g. "weights": {"imageFields": 0.7}
+func (ic *BaseClassSettings) getModalityWeight(name string) (interface{}, bool) {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return nil, false
+	}
+	weightsObject, ok := ic.cfg.Class()["weights"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	weight, ok := weightsObject[fmt.Sprintf("%sFields", name)]
+	if !ok {
+		return nil, false
+	}
+	if _, isArray := weight.([]interface{}); isArray {
+		return nil, false
+	}
+	return weight, true
+}
+
+func (ic *BaseClassSettings) getWeights(name string) ([]interface{}, bool) {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return nil, false
+	}
+	weights, ok := ic.cfg.Class()["weights"]
+	if ok {
+		weightsObject, ok := weights.(map[string]interface{})
+		if ok {
+			fieldWeights, ok := weightsObject[fmt.Sprintf("%sFields", name)]
+			if ok {
+				fieldWeightsArray, ok := fieldWeights.([]interface{})
+				if ok {
+					return fieldWeightsArray, ok
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func (ic *BaseClassSettings) getWeightsArray(weights []interface{}) ([]float32, error) {
+	weightsArray := make([]float32, len(weights))
+	for i := range weights {
+		weight, err := GetNumber(weights[i])
+		if err != nil {
+			return nil, err
+		}
+		weightsArray[i] = weight
+	}
+	return weightsArray, nil
+}
+
+// getFieldsWeights returns the weights in the order of the fields. A weight
+// given for all fields of a kind is split evenly between them.
+func (ic *BaseClassSettings) getFieldsWeights(name string) ([]float32, error) {
+	if weight, ok := ic.getModalityWeight(name); ok {
+		modalityWeight, err := GetNumber(weight)
+		if err != nil {
+			return nil, err
+		}
+		fieldNames := ic.fields(fmt.Sprintf("%sFields", name))
+		weights := make([]float32, len(fieldNames))
+		for i := range weights {
+			weights[i] = modalityWeight / float32(len(fieldNames))
+		}
+		return weights, nil
+	}
+
+	weights, ok := ic.getWeights(name)
+	if ok {
+		return ic.getWeightsArray(weights)
+	}
+	return nil, nil
+}
+
+// GetNumber converts a numeric setting as it is found in the class config
+func GetNumber(in interface{}) (float32, error) {
+	switch i := in.(type) {
+	case float64:
+		return float32(i), nil
+	case float32:
+		return i, nil
+	case int:
+		return float32(i), nil
+	case string:
+		num, err := strconv.ParseFloat(i, 64)
+		if err != nil {
+			return 0, err
+		}
+		return float32(num), err
+	case json.Number:
+		num, err := i.Float64()
+		if err != nil {
+			return 0, err
+		}
+		return float32(num), err
+	default:
+		return 0.0, errors.Errorf("Unrecognized weight entry type: %T", i)
+	}
+}
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multimodal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseClassSettings_FieldsWeights(t *testing.T) {
+	t.Run("with a weight for all fields of a kind", func(t *testing.T) {
+		ic := NewBaseClassSettings(newConfigBuilder().
+			addSetting("textFields", []interface{}{"textField1", "textField2"}).
+			addSetting("imageFields", []interface{}{"imageField1"}).
+			addSetting("weights", map[string]interface{}{"textFields": 0.3, "imageFields": 0.7}).
+			build())
+
+		textWeights, err := ic.TextFieldsWeights()
+		require.Nil(t, err)
+		assert.InDeltaSlice(t, []float32{0.15, 0.15}, textWeights, 1e-6)
+		imageWeights, err := ic.ImageFieldsWeights()
+		require.Nil(t, err)
+		assert.InDeltaSlice(t, []float32{0.7}, imageWeights, 1e-6)
+	})
+
+	t.Run("with weights per field", func(t *testing.T) {
+		ic := NewBaseClassSettings(newConfigBuilder().
+			addSetting("textFields", []interface{}{"textField1", "textField2"}).
+			addSetting("imageFields", []interface{}{"imageField1"}).
+			addWeights([]interface{}{1, 2}, []interface{}{3}).
+			build())
+
+		textWeights, err := ic.TextFieldsWeights()
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 2}, textWeights)
+		imageWeights, err := ic.ImageFieldsWeights()
+		require.Nil(t, err)
+		assert.Equal(t, []float32{3}, imageWeights)
+	})
+}
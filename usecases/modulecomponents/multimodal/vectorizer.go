@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multimodal
+
+import (
+	"github.com/weaviate/weaviate/entities/moduletools"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
+)
+
+// FieldsSettings tells which properties of an object are vectorized and how
+// the vectors of the properties are weighted
+type FieldsSettings interface {
+	ImageFields() []string
+	ImageFieldsWeights() ([]float32, error)
+	TextFields() []string
+	TextFieldsWeights() ([]float32, error)
+}
+
+// Inputs are the texts and images of an object which are vectorized
+type Inputs struct {
+	Texts  []string
+	Images []string
+
+	// weights of the texts followed by the images, nil if the class has no
+	// weights configured
+	weights []float32
+}
+
+// ObjectInputs collects the texts and images of an object. The properties
+// are visited in the order of the class settings, so that every vector is
+// combined with the weight of its property. vectorize is false if none of
+// the vectorized properties changed and the old vector can be used.
+func ObjectInputs(schema interface{}, objDiff *moduletools.ObjectDiff,
+	settings FieldsSettings,
+) (inputs *Inputs, vectorize bool, err error) {
+	textFieldsWeights, err := settings.TextFieldsWeights()
+	if err != nil {
+		return nil, false, err
+	}
+	imageFieldsWeights, err := settings.ImageFieldsWeights()
+	if err != nil {
+		return nil, false, err
+	}
+
+	vectorize = objDiff == nil || objDiff.GetVec() == nil
+	inputs = &Inputs{Texts: []string{}, Images: []string{}}
+	weights := []float32{}
+	if schema != nil {
+		props := schema.(map[string]interface{})
+		for i, prop := range settings.TextFields() {
+			valueString, ok := props[prop].(string)
+			if ok {
+				inputs.Texts = append(inputs.Texts, valueString)
+				weights = append(weights, fieldWeight(textFieldsWeights, i))
+				vectorize = vectorize || (objDiff != nil && objDiff.IsChangedProp(prop))
+			}
+		}
+		for i, prop := range settings.ImageFields() {
+			valueString, ok := props[prop].(string)
+			if ok {
+				inputs.Images = append(inputs.Images, valueString)
+				weights = append(weights, fieldWeight(imageFieldsWeights, i))
+				vectorize = vectorize || (objDiff != nil && objDiff.IsChangedProp(prop))
+			}
+		}
+	}
+
+	if textFieldsWeights != nil || imageFieldsWeights != nil {
+		inputs.weights = NormalizeWeights(weights)
+	}
+	return inputs, vectorize, nil
+}
+
+// CombineVectors combines the vectors of the texts and images into the
+// vector of the object
+func (in *Inputs) CombineVectors(textVectors, imageVectors [][]float32) []float32 {
+	vectors := make([][]float32, 0, len(textVectors)+len(imageVectors))
+	vectors = append(vectors, textVectors...)
+	vectors = append(vectors, imageVectors...)
+	return libvectorizer.CombineVectorsWithWeights(vectors, in.weights)
+}
+
+// NormalizeWeights scales the weights so that they sum up to 1
+func NormalizeWeights(weights []float32) []float32 {
+	if len(weights) > 0 {
+		var denominator float32
+		for i := range weights {
+			denominator += weights[i]
+		}
+		normalizer := 1 / denominator
+		normalized := make([]float32, len(weights))
+		for i := range weights {
+			normalized[i] = weights[i] * normalizer
+		}
+		return normalized
+	}
+	return nil
+}
+
+// fieldWeight returns the weight of the i-th field of a kind, fields of a
+// kind without weights are weighted with 1
+func fieldWeight(weights []float32, i int) float32 {
+	if i < len(weights) {
+		return weights[i]
+	}
+	return 1
+}
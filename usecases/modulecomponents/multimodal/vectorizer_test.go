@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multimodal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func TestObjectInputs(t *testing.T) {
+	props := map[string]interface{}{
+		"image1":      "image1",
+		"image2":      "image2",
+		"text":        "text",
+		"description": "non-vectorizable",
+	}
+
+	t.Run("collects the fields in the order of the settings", func(t *testing.T) {
+		settings := NewBaseClassSettings(newConfigBuilder().
+			addSetting("imageFields", []interface{}{"image2", "image1", "missing"}).
+			addSetting("textFields", []interface{}{"text"}).
+			build())
+
+		inputs, vectorize, err := ObjectInputs(props, nil, settings)
+
+		require.Nil(t, err)
+		assert.True(t, vectorize)
+		assert.Equal(t, []string{"text"}, inputs.Texts)
+		assert.Equal(t, []string{"image2", "image1"}, inputs.Images)
+		assert.Nil(t, inputs.weights)
+	})
+
+	t.Run("weights every vector with the weight of its field", func(t *testing.T) {
+		settings := NewBaseClassSettings(newConfigBuilder().
+			addSetting("imageFields", []interface{}{"image1", "image2"}).
+			addSetting("textFields", []interface{}{"text"}).
+			addWeights([]interface{}{2}, []interface{}{1, 5}).
+			build())
+
+		inputs, _, err := ObjectInputs(props, nil, settings)
+		require.Nil(t, err)
+		assert.InDeltaSlice(t, []float32{0.25, 0.125, 0.625}, inputs.weights, 1e-6)
+
+		vector := inputs.CombineVectors([][]float32{{8}}, [][]float32{{8}, {8}})
+		// (0.25 * 8 + 0.125 * 8 + 0.625 * 8) / 3
+		assert.InDeltaSlice(t, []float32{8.0 / 3}, vector, 1e-6)
+	})
+
+	t.Run("when no vectorized property changed", func(t *testing.T) {
+		settings := NewBaseClassSettings(newConfigBuilder().
+			addSetting("textFields", []interface{}{"text"}).
+			build())
+		diff := moduletools.NewObjectDiff([]float32{1, 2, 3}).
+			WithProp("description", "old", "non-vectorizable")
+
+		_, vectorize, err := ObjectInputs(props, diff, settings)
+
+		require.Nil(t, err)
+		assert.False(t, vectorize)
+	})
+
+	t.Run("when a vectorized property changed", func(t *testing.T) {
+		settings := NewBaseClassSettings(newConfigBuilder().
+			addSetting("textFields", []interface{}{"text"}).
+			build())
+		diff := moduletools.NewObjectDiff([]float32{1, 2, 3}).
+			WithProp("text", "old", "text")
+
+		_, vectorize, err := ObjectInputs(props, diff, settings)
+
+		require.Nil(t, err)
+		assert.True(t, vectorize)
+	})
+}
+
+func TestNormalizeWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []float32
+	}{
+		{
+			name:    "normalize example 1",
+			weights: []float32{200, 100, 0.1},
+		},
+		{
+			name:    "normalize example 2",
+			weights: []float32{300.22, 0.7, 17, 54},
+		},
+		{
+			name:    "normalize example 3",
+			weights: []float32{300, 0.02, 17},
+		},
+		{
+			name:    "normalize example 4",
+			weights: []float32{500, 0.02, 17.4, 180},
+		},
+		{
+			name:    "normalize example 5",
+			weights: []float32{500, 0.02, 17.4, 2, 4, 5, .88},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeWeights(tt.weights); !checkNormalization(got) {
+				t.Errorf("NormalizeWeights() = %v, want %v", got, 1.0)
+			}
+		})
+	}
+}
+
+func checkNormalization(weights []float32) bool {
+	var result float32
+	for i := range weights {
+		result += weights[i]
+	}
+	return result == 1.0
+}
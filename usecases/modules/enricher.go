@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// EnrichObject lets the enricher modules configured for the class write
+// their properties on the object. It returns the names of the properties
+// which were written.
+func (p *Provider) EnrichObject(ctx context.Context, object *models.Object,
+	class *models.Class,
+) ([]string, error) {
+	var enriched []string
+	for _, name := range p.classEnrichers(class) {
+		enricher := p.GetByName(name).(modulecapabilities.ObjectEnricher)
+		cfg := NewClassBasedModuleConfig(class, name, "")
+		if object.Properties == nil {
+			object.Properties = map[string]interface{}{}
+		}
+		if err := enricher.EnrichObject(ctx, object, cfg); err != nil {
+			return nil, errors.Wrapf(err, "enrich object with module '%s'", name)
+		}
+		for _, prop := range enricher.EnrichedProperties(cfg) {
+			enriched = append(enriched, prop.Name)
+		}
+	}
+	return enriched, nil
+}
+
+// setEnrichedProperties adds the properties written by the enricher modules
+// configured for the class, unless the class already defines them
+func (p *Provider) setEnrichedProperties(class *models.Class) {
+	for _, name := range p.classEnrichers(class) {
+		enricher := p.GetByName(name).(modulecapabilities.ObjectEnricher)
+		cfg := NewClassBasedModuleConfig(class, name, "")
+		for _, prop := range enricher.EnrichedProperties(cfg) {
+			if !classHasProperty(class, prop.Name) {
+				class.Properties = append(class.Properties, prop)
+			}
+		}
+	}
+}
+
+// classEnrichers returns the names of the enricher modules present in the
+// module config of the class in a stable order
+func (p *Provider) classEnrichers(class *models.Class) []string {
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var names []string
+	for name := range moduleConfig {
+		if _, ok := p.GetByName(name).(modulecapabilities.ObjectEnricher); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func classHasProperty(class *models.Class, name string) bool {
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func TestProvider_EnrichObject(t *testing.T) {
+	newClass := func() *models.Class {
+		return &models.Class{
+			Class:      "Article",
+			Vectorizer: "none",
+			ModuleConfig: map[string]interface{}{
+				"enricher": map[string]interface{}{},
+			},
+			Properties: []*models.Property{
+				{Name: "content", DataType: []string{"text"}},
+			},
+		}
+	}
+
+	t.Run("enriched properties are added to the class", func(t *testing.T) {
+		p := NewProvider()
+		p.Register(newDummyEnricherModule("enricher"))
+		class := newClass()
+
+		p.SetClassDefaults(class)
+
+		require.Len(t, class.Properties, 2)
+		assert.Equal(t, "upperContent", class.Properties[1].Name)
+	})
+
+	t.Run("properties the class defines are kept", func(t *testing.T) {
+		p := NewProvider()
+		p.Register(newDummyEnricherModule("enricher"))
+		class := newClass()
+		class.Properties = append(class.Properties,
+			&models.Property{Name: "upperContent", DataType: []string{"text"}, Description: "own"})
+
+		p.SetClassDefaults(class)
+
+		require.Len(t, class.Properties, 2)
+		assert.Equal(t, "own", class.Properties[1].Description)
+	})
+
+	t.Run("object is enriched", func(t *testing.T) {
+		p := NewProvider()
+		p.Register(newDummyEnricherModule("enricher"))
+		object := &models.Object{
+			Class:      "Article",
+			Properties: map[string]interface{}{"content": "some text"},
+		}
+
+		enriched, err := p.EnrichObject(context.Background(), object, newClass())
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"upperContent"}, enriched)
+		assert.Equal(t, "SOME TEXT", object.Properties.(map[string]interface{})["upperContent"])
+	})
+
+	t.Run("object is not enriched without module config", func(t *testing.T) {
+		p := NewProvider()
+		p.Register(newDummyEnricherModule("enricher"))
+		object := &models.Object{
+			Class:      "Article",
+			Properties: map[string]interface{}{"content": "some text"},
+		}
+		class := newClass()
+		class.ModuleConfig = nil
+
+		enriched, err := p.EnrichObject(context.Background(), object, class)
+
+		require.Nil(t, err)
+		assert.Empty(t, enriched)
+		assert.NotContains(t, object.Properties.(map[string]interface{}), "upperContent")
+	})
+}
+
+func newDummyEnricherModule(name string) dummyEnricherModule {
+	return dummyEnricherModule{dummyNonVectorizerModule{name: name}}
+}
+
+type dummyEnricherModule struct {
+	dummyNonVectorizerModule
+}
+
+func (m dummyEnricherModule) EnrichedProperties(cfg moduletools.ClassConfig) []*models.Property {
+	return []*models.Property{{Name: "upperContent", DataType: []string{"text"}}}
+}
+
+func (m dummyEnricherModule) EnrichObject(ctx context.Context, object *models.Object,
+	cfg moduletools.ClassConfig,
+) error {
+	properties := object.Properties.(map[string]interface{})
+	if content, ok := properties["content"].(string); ok {
+		properties["upperContent"] = strings.ToUpper(content)
+	}
+	return nil
+}
@@ -105,6 +105,18 @@ func (m dummyRef2VecModuleNoCapabilities) VectorizeObject(ctx context.Context,
 	return nil
 }
 
+type dummyIncrementalRef2VecModule struct {
+	dummyRef2VecModuleNoCapabilities
+}
+
+func (m dummyIncrementalRef2VecModule) UpdateObjectVector(ctx context.Context,
+	in *models.Object, change modulecapabilities.ReferenceChange,
+	cfg moduletools.ClassConfig, findRefVecsFn modulecapabilities.FindObjectFn,
+) (bool, error) {
+	in.Vector = []float32{float32(change.Count)}
+	return true, nil
+}
+
 func newDummyNonVectorizerModule(name string) dummyNonVectorizerModule {
 	return dummyNonVectorizerModule{name: name}
 }
@@ -18,11 +18,33 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 // SetClassDefaults sets the module-specific defaults for the class itself, but
 // also for each prop
 func (p *Provider) SetClassDefaults(class *models.Class) {
+	p.setEnrichedProperties(class)
+	p.setVectorizerConfigDefaults(class)
+
+	for name, vectorConfig := range class.VectorConfig {
+		view, err := schema.ClassForTargetVector(class, name)
+		if err != nil {
+			// invalid named vectors are rejected by the schema validation
+			continue
+		}
+
+		p.setVectorizerConfigDefaults(view)
+		if view.Vectorizer != "none" {
+			vectorConfig.Vectorizer = map[string]interface{}{
+				view.Vectorizer: view.ModuleConfig.(map[string]interface{})[view.Vectorizer],
+			}
+			class.VectorConfig[name] = vectorConfig
+		}
+	}
+}
+
+func (p *Provider) setVectorizerConfigDefaults(class *models.Class) {
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return
@@ -99,7 +121,9 @@ func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
 	userSpecified := make(map[string]interface{})
 
 	if prop.ModuleConfig != nil {
-		userSpecified = prop.ModuleConfig.(map[string]interface{})[class.Vectorizer].(map[string]interface{})
+		if asMap, ok := prop.ModuleConfig.(map[string]interface{})[class.Vectorizer].(map[string]interface{}); ok {
+			userSpecified = asMap
+		}
 	}
 
 	for key, value := range modDefaults {
@@ -117,6 +141,24 @@ func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
 }
 
 func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error {
+	if err := p.validateVectorizerClass(ctx, class); err != nil {
+		return err
+	}
+
+	for name := range class.VectorConfig {
+		view, err := schema.ClassForTargetVector(class, name)
+		if err != nil {
+			return err
+		}
+		if err := p.validateVectorizerClass(ctx, view); err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+	}
+
+	return nil
+}
+
+func (p *Provider) validateVectorizerClass(ctx context.Context, class *models.Class) error {
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return nil
@@ -131,7 +173,7 @@ func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error
 		cc, ok := mod.(modulecapabilities.ClassConfigurator)
 		if !ok {
 			// the module exists, but is not a class configurator, nothing to do for us
-			return nil
+			continue
 		}
 
 		cfg := NewClassBasedModuleConfig(class, key, "")
@@ -141,5 +183,39 @@ func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error
 		}
 	}
 
+	return p.validateVectorDimensions(class)
+}
+
+// validateVectorDimensions rejects vector index configs which can't hold the
+// vectors of the class's vectorizer, so that the mismatch surfaces when the
+// class is created rather than on import
+func (p *Provider) validateVectorDimensions(class *models.Class) error {
+	vdp, ok := p.GetByName(class.Vectorizer).(modulecapabilities.VectorDimensionsProvider)
+	if !ok {
+		return nil
+	}
+
+	dims, ok := vdp.VectorDimensions(NewClassBasedModuleConfig(class, class.Vectorizer, ""))
+	if !ok {
+		return nil
+	}
+
+	vectorIndexConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
+	if !ok {
+		parsed, err := hnsw.ParseAndValidateConfig(class.VectorIndexConfig)
+		if err != nil {
+			// invalid configs are rejected when the vector index config is parsed
+			return nil
+		}
+		vectorIndexConfig = parsed.(hnsw.UserConfig)
+	}
+
+	segments := vectorIndexConfig.PQ.Segments
+	if segments > 0 && dims%segments != 0 {
+		return errors.Errorf("module '%s': vectors of %d dimensions can't be split into "+
+			"the %d pq segments of the vectorIndexConfig, segments must be a divisor of the dimensions",
+			class.Vectorizer, dims, segments)
+	}
+
 	return nil
 }
@@ -221,6 +221,45 @@ func TestValidateClass(t *testing.T) {
 		require.NotNil(t, err)
 		assert.Equal(t, "module 'my-module': no can do!", err.Error())
 	})
+
+	t.Run("the vector dimensions are checked against the pq segments", func(t *testing.T) {
+		newClass := func(segments int) *models.Class {
+			return &models.Class{
+				Class: "Foo",
+				Properties: []*models.Property{{
+					Name:         "Foo",
+					DataType:     schema.DataTypeText.PropString(),
+					Tokenization: models.PropertyTokenizationWhitespace,
+				}},
+				Vectorizer: "my-module",
+				VectorIndexConfig: map[string]interface{}{
+					"pq": map[string]interface{}{
+						"segments": float64(segments),
+					},
+				},
+			}
+		}
+
+		p := NewProvider()
+		p.Register(&dummyModuleClassConfigurator{
+			dimensions: 1536,
+			dummyText2VecModuleNoCapabilities: dummyText2VecModuleNoCapabilities{
+				name: "my-module",
+			},
+		})
+
+		valid := newClass(96)
+		p.SetClassDefaults(valid)
+		assert.Nil(t, p.ValidateClass(ctx, valid))
+
+		invalid := newClass(100)
+		p.SetClassDefaults(invalid)
+		err := p.ValidateClass(ctx, invalid)
+		require.NotNil(t, err)
+		assert.Equal(t, "module 'my-module': vectors of 1536 dimensions can't be split into "+
+			"the 100 pq segments of the vectorIndexConfig, segments must be a divisor of the dimensions",
+			err.Error())
+	})
 }
 
 func TestSetSinglePropertyDefaults(t *testing.T) {
@@ -278,6 +317,7 @@ func TestSetSinglePropertyDefaults(t *testing.T) {
 type dummyModuleClassConfigurator struct {
 	dummyText2VecModuleNoCapabilities
 	validateError error
+	dimensions    int
 }
 
 func (d *dummyModuleClassConfigurator) ClassConfigDefaults() map[string]interface{} {
@@ -302,5 +342,9 @@ func (d *dummyModuleClassConfigurator) ValidateClass(ctx context.Context,
 	return d.validateError
 }
 
+func (d *dummyModuleClassConfigurator) VectorDimensions(cfg moduletools.ClassConfig) (int, bool) {
+	return d.dimensions, d.dimensions > 0
+}
+
 var _ = modulecapabilities.ClassConfigurator(
 	&dummyModuleClassConfigurator{})
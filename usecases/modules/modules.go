@@ -257,7 +257,7 @@ func (p *Provider) shouldIncludeClassArgument(class *models.Class, module string
 	moduleType modulecapabilities.ModuleType,
 ) bool {
 	if p.isVectorizerModule(moduleType) {
-		return class.Vectorizer == module
+		return class.Vectorizer == module || p.isTargetVectorizer(class, module)
 	}
 	if moduleConfig, ok := class.ModuleConfig.(map[string]interface{}); ok {
 		existsConfigForModule := moduleConfig[module] != nil
@@ -270,6 +270,17 @@ func (p *Provider) shouldIncludeClassArgument(class *models.Class, module string
 	return p.isOnlyOneModuleEnabledOfAGivenType(moduleType)
 }
 
+// isTargetVectorizer returns true if the module vectorizes any of the named
+// vectors of the class
+func (p *Provider) isTargetVectorizer(class *models.Class, module string) bool {
+	for _, cfg := range class.VectorConfig {
+		if vectorizer, _, err := schema.TargetVectorVectorizer(cfg); err == nil && vectorizer == module {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Provider) shouldCrossClassIncludeClassArgument(class *models.Class, module string,
 	moduleType modulecapabilities.ModuleType,
 ) bool {
@@ -465,6 +476,19 @@ func (p *Provider) ListObjectsAdditionalExtend(ctx context.Context,
 	return p.additionalExtend(ctx, in, moduleParams, nil, "ObjectList", nil)
 }
 
+// TextTransformer returns the text transformer a module provides for the
+// given argument, nil if no module provides one
+func (p *Provider) TextTransformer(argument string) modulecapabilities.TextTransform {
+	for _, module := range p.GetAll() {
+		if arg, ok := module.(modulecapabilities.TextTransformers); ok {
+			if transformer := arg.TextTransformers()[argument]; transformer != nil {
+				return transformer
+			}
+		}
+	}
+	return nil
+}
+
 // GetExploreAdditionalExtend extends graphql api get queries with additional properties
 func (p *Provider) GetExploreAdditionalExtend(ctx context.Context, in []search.Result,
 	moduleParams map[string]interface{}, searchVector []float32,
@@ -509,7 +533,14 @@ func (p *Provider) additionalExtend(ctx context.Context, in []search.Result,
 			}
 			cfg := NewClassBasedModuleConfig(class, "", "")
 			for name, value := range moduleParams {
-				additionalPropertyFn := p.getAdditionalPropertyFn(allAdditionalProperties[name], capability)
+				additionalProperty := allAdditionalProperties[name]
+				if withModule, ok := value.(modulecapabilities.AdditionalPropertyWithModule); ok && withModule.ModuleName() != "" {
+					additionalProperty, err = p.getModuleAdditionalProperty(withModule.ModuleName(), name)
+					if err != nil {
+						return nil, errors.Errorf("extend %s: %v", name, err)
+					}
+				}
+				additionalPropertyFn := p.getAdditionalPropertyFn(additionalProperty, capability)
 				if additionalPropertyFn != nil && value != nil {
 					searchValue := value
 					if searchVectorValue, ok := value.(modulecapabilities.AdditionalPropertyWithSearchVector); ok {
@@ -530,6 +561,21 @@ func (p *Provider) additionalExtend(ctx context.Context, in []search.Result,
 	return toBeExtended, nil
 }
 
+// getModuleAdditionalProperty returns the additional property of the given
+// module, regardless of whether the module is configured for the class
+func (p *Provider) getModuleAdditionalProperty(moduleName, name string) (modulecapabilities.AdditionalProperty, error) {
+	module := p.GetByName(moduleName)
+	if module == nil {
+		return modulecapabilities.AdditionalProperty{}, errors.Errorf("module %s is not enabled", moduleName)
+	}
+	if arg, ok := module.(modulecapabilities.AdditionalProperties); ok {
+		if additionalProperty, ok := arg.AdditionalProperties()[name]; ok {
+			return additionalProperty, nil
+		}
+	}
+	return modulecapabilities.AdditionalProperty{}, errors.Errorf("module %s doesn't provide %s", moduleName, name)
+}
+
 func (p *Provider) getClassFromSearchResult(in []search.Result) (*models.Class, error) {
 	if len(in) > 0 {
 		return p.getClass(in[0].ClassName)
@@ -605,10 +651,10 @@ func (p *Provider) RestApiAdditionalProperties(includeProp string, class *models
 // VectorFromSearchParam gets a vector for a given argument. This is used in
 // Get { Class() } for example
 func (p *Provider) VectorFromSearchParam(ctx context.Context,
-	className string, param string, params interface{},
+	className, targetVector string, param string, params interface{},
 	findVectorFn modulecapabilities.FindVectorFn, tenant string,
 ) ([]float32, error) {
-	class, err := p.getClass(className)
+	class, err := p.getTargetClass(className, targetVector)
 	if err != nil {
 		return nil, err
 	}
@@ -668,9 +714,9 @@ func (p *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 }
 
 func (p *Provider) VectorFromInput(ctx context.Context,
-	className string, input string,
+	className, targetVector string, input string,
 ) ([]float32, error) {
-	class, err := p.getClass(className)
+	class, err := p.getTargetClass(className, targetVector)
 	if err != nil {
 		return nil, err
 	}
@@ -723,6 +769,7 @@ func (p *Provider) GetClassificationFn(className, name string,
 			if c, ok := module.(modulecapabilities.ClassificationProvider); ok {
 				for _, classifier := range c.Classifiers() {
 					if classifier != nil && classifier.Name() == name {
+						params.ClassConfig = NewClassBasedModuleConfig(class, module.Name(), "")
 						return classifier.ClassifyFn(params)
 					}
 				}
@@ -756,6 +803,16 @@ func (p *Provider) getClass(className string) (*models.Class, error) {
 	return class, nil
 }
 
+// getTargetClass returns the class as seen by the vectorizer of the given
+// named vector, an empty target vector refers to the class vector
+func (p *Provider) getTargetClass(className, targetVector string) (*models.Class, error) {
+	class, err := p.getClass(className)
+	if err != nil || targetVector == "" {
+		return class, err
+	}
+	return schema.ClassForTargetVector(class, targetVector)
+}
+
 func (p *Provider) HasMultipleVectorizers() bool {
 	return p.hasMultipleVectorizers
 }
@@ -19,11 +19,13 @@ import (
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	enitiesSchema "github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
 	ubackup "github.com/weaviate/weaviate/usecases/backup"
 )
 
@@ -329,6 +331,34 @@ func TestModulesProvider(t *testing.T) {
 		assert.Nil(t, modMissing)
 	})
 
+	t.Run("should extend with the additional property of the module chosen per query", func(t *testing.T) {
+		// given
+		modulesProvider := NewProvider()
+		modulesProvider.SetSchemaGetter(getFakeSchemaGetter())
+		modulesProvider.Register(newGraphQLAdditionalModule("mod1").withExploreGetFn("generate"))
+		modulesProvider.Register(newGraphQLAdditionalModule("mod2").withExploreGetFn("generate"))
+		logger, _ := test.NewNullLogger()
+		require.Nil(t, modulesProvider.Init(context.Background(), nil, logger))
+		in := []search.Result{{ClassName: "ClassOne"}}
+		extend := func(moduleName string) ([]search.Result, error) {
+			moduleParams := map[string]interface{}{"generate": fakeParamsWithModule{moduleName}}
+			return modulesProvider.GetExploreAdditionalExtend(context.Background(), in, moduleParams, nil, nil)
+		}
+
+		// when
+		classModuleRes, classModuleErr := extend("")
+		chosenModuleRes, chosenModuleErr := extend("mod2")
+		_, unknownModuleErr := extend("mod3")
+
+		// then
+		require.Nil(t, classModuleErr)
+		assert.Equal(t, "mod1", classModuleRes[0].AdditionalProperties["generate"])
+		require.Nil(t, chosenModuleErr)
+		assert.Equal(t, "mod2", chosenModuleRes[0].AdditionalProperties["generate"])
+		require.NotNil(t, unknownModuleErr)
+		assert.EqualError(t, unknownModuleErr, "extend generate: module mod3 is not enabled")
+	})
+
 	t.Run("should provide backup backend", func(t *testing.T) {
 		module := &dummyBackupModuleWithAltNames{}
 		modulesProvider := NewProvider()
@@ -440,6 +470,34 @@ func (m *dummyAdditionalModule) withRestApiArg(argName string, values []string)
 	return m
 }
 
+// withExploreGetFn sets an additional property which is set to the name of
+// the module on the extended results
+func (m *dummyAdditionalModule) withExploreGetFn(argName string) *dummyAdditionalModule {
+	prop := m.additionalProperties[argName]
+	prop.SearchFunctions.ExploreGet = func(ctx context.Context, in []search.Result,
+		params interface{}, limit *int, argumentModuleParams map[string]interface{},
+		cfg moduletools.ClassConfig,
+	) ([]search.Result, error) {
+		out := make([]search.Result, len(in))
+		for i := range in {
+			out[i] = in[i]
+			out[i].AdditionalProperties = models.AdditionalProperties{argName: m.Name()}
+		}
+		return out, nil
+	}
+
+	m.additionalProperties[argName] = prop
+	return m
+}
+
+type fakeParamsWithModule struct {
+	moduleName string
+}
+
+func (p fakeParamsWithModule) ModuleName() string {
+	return p.moduleName
+}
+
 func (m *dummyAdditionalModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
 	return m.additionalProperties
 }
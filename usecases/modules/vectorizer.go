@@ -21,7 +21,10 @@ import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/multivector"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -84,13 +87,71 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
 	logger logrus.FieldLogger,
 ) error {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
-	if !ok {
+	if err := p.updateClassVector(ctx, object, class, objectDiff, findObjectFn, logger); err != nil {
+		return err
+	}
+
+	return p.updateTargetVectors(ctx, object, class, findObjectFn, logger)
+}
+
+// updateTargetVectors vectorizes each named vector of the class which is
+// vectorized by a module and was not provided by the user. Each vectorizer
+// sees the class as configured for its named vector.
+func (p *Provider) updateTargetVectors(ctx context.Context, object *models.Object, class *models.Class,
+	findObjectFn modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
+) error {
+	for name := range class.VectorConfig {
+		if len(object.Vectors[name]) > 0 {
+			continue
+		}
+
+		view, err := schema.ClassForTargetVector(class, name)
+		if err != nil {
+			return err
+		}
+		if view.Vectorizer == config.VectorizerModuleNone {
+			continue
+		}
+
+		// vectorize a copy, so that the class vector of the object is neither
+		// used as input nor overwritten. The object diff only holds the old
+		// class vector, which can't be reused for a named vector.
+		target := *object
+		target.Vector = nil
+		if err := p.updateClassVector(ctx, &target, view, nil, findObjectFn, logger); err != nil {
+			return fmt.Errorf("named vector %q: %w", name, err)
+		}
+
+		if len(target.Vector) > 0 {
+			if object.Vectors == nil {
+				object.Vectors = models.Vectors{}
+			}
+			object.Vectors[name] = models.Vector(target.Vector)
+		}
+	}
+
+	return nil
+}
+
+func (p *Provider) updateClassVector(ctx context.Context, object *models.Object, class *models.Class,
+	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
+	logger logrus.FieldLogger,
+) error {
+	var skip bool
+	switch vectorIndexConfig := class.VectorIndexConfig.(type) {
+	case hnsw.UserConfig:
+		skip = vectorIndexConfig.Skip
+	case multivector.UserConfig:
+		// multiple vectors can't be skipped
+	case flat.UserConfig, dynamic.UserConfig:
+		// the flat index reads the vectors of the objects, there is no way to
+		// skip them
+	default:
 		return fmt.Errorf(errorVectorIndexType, class.VectorIndexConfig)
 	}
 
 	if class.Vectorizer == config.VectorizerModuleNone {
-		if hnswConfig.Skip && len(object.Vector) > 0 {
+		if skip && len(object.Vector) > 0 {
 			logger.WithField("className", object.Class).
 				Warningf(warningSkipVectorProvided)
 		}
@@ -98,7 +159,7 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 		return nil
 	}
 
-	if hnswConfig.Skip {
+	if skip {
 		logger.WithField("className", object.Class).
 			WithField("vectorizer", class.Vectorizer).
 			Warningf(warningSkipVectorGenerated, class.Vectorizer)
@@ -140,6 +201,26 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	return nil
 }
 
+// UpdateReferenceVector applies a single reference change to the vector of
+// an object whose class is vectorized by a ref2vec module. It returns false if
+// the module can't apply the change incrementally.
+func (p *Provider) UpdateReferenceVector(ctx context.Context, object *models.Object,
+	class *models.Class, change modulecapabilities.ReferenceChange,
+	findObjectFn modulecapabilities.FindObjectFn,
+) (bool, error) {
+	refVectorizer, ok := p.GetByName(class.Vectorizer).(modulecapabilities.IncrementalReferenceVectorizer)
+	if !ok {
+		return false, nil
+	}
+
+	cfg := NewClassBasedModuleConfig(class, class.Vectorizer, "")
+	updated, err := refVectorizer.UpdateObjectVector(ctx, object, change, cfg, findObjectFn)
+	if err != nil {
+		return false, fmt.Errorf("update reference vector: %w", err)
+	}
+	return updated, nil
+}
+
 func (p *Provider) VectorizerName(className string) (string, error) {
 	name, _, err := p.getClassVectorizer(className)
 	if err != nil {
@@ -259,3 +259,45 @@ func TestProvider_UpdateVector(t *testing.T) {
 func newUUID() strfmt.UUID {
 	return strfmt.UUID(uuid.NewString())
 }
+
+func TestProvider_UpdateReferenceVector(t *testing.T) {
+	change := modulecapabilities.ReferenceChange{
+		Property: "toRef",
+		Beacon:   "weaviate://localhost/SomeClass/a1d4f4b1-0b58-4a4c-b1b0-f5d5e3bcb4c2",
+		Count:    2,
+	}
+
+	t.Run("with IncrementalReferenceVectorizer", func(t *testing.T) {
+		modName := "some-ref2vec"
+		mod := dummyIncrementalRef2VecModule{newDummyRef2VecModule(modName)}
+		class := &models.Class{Class: "SomeClass", Vectorizer: modName}
+		repo := &fakeObjectsRepo{}
+
+		p := NewProvider()
+		p.Register(mod)
+
+		obj := &models.Object{Class: class.Class, ID: newUUID()}
+		updated, err := p.UpdateReferenceVector(context.Background(), obj, class,
+			change, repo.Object)
+		assert.Nil(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, models.C11yVector{2}, obj.Vector)
+	})
+
+	t.Run("with ReferenceVectorizer only", func(t *testing.T) {
+		modName := "some-ref2vec"
+		mod := newDummyRef2VecModule(modName)
+		class := &models.Class{Class: "SomeClass", Vectorizer: modName}
+		repo := &fakeObjectsRepo{}
+
+		p := NewProvider()
+		p.Register(mod)
+
+		obj := &models.Object{Class: class.Class, ID: newUUID()}
+		updated, err := p.UpdateReferenceVector(context.Background(), obj, class,
+			change, repo.Object)
+		assert.Nil(t, err)
+		assert.False(t, updated)
+		assert.Nil(t, obj.Vector)
+	})
+}
@@ -33,6 +33,8 @@ type PrometheusMetrics struct {
 	LSMMemtableDurations               *prometheus.SummaryVec
 	VectorIndexTombstones              *prometheus.GaugeVec
 	VectorIndexTombstoneCleanupThreads *prometheus.GaugeVec
+	VectorIndexTombstoneCleanupPending *prometheus.GaugeVec
+	VectorIndexTombstoneCycleProgress  *prometheus.GaugeVec
 	VectorIndexTombstoneCleanedCount   *prometheus.CounterVec
 	VectorIndexOperations              *prometheus.GaugeVec
 	VectorIndexDurations               *prometheus.SummaryVec
@@ -56,6 +58,16 @@ type PrometheusMetrics struct {
 	BackupStoreDataTransferred         *prometheus.CounterVec
 	VectorDimensionsSum                *prometheus.GaugeVec
 
+	ModuleExternalRequestsQueued       *prometheus.GaugeVec
+	ModuleExternalRequestWaitDurations *prometheus.SummaryVec
+	ModuleEmbeddingCacheHits           *prometheus.CounterVec
+	ModuleEmbeddingCacheMisses         *prometheus.CounterVec
+	ModuleExternalRequestDurations     *prometheus.HistogramVec
+	ModuleExternalRequestErrors        *prometheus.CounterVec
+	ModuleExternalRequestRetries       *prometheus.CounterVec
+	ModuleExternalTokensUsed           *prometheus.CounterVec
+	ModuleExternalEstimatedCost        *prometheus.CounterVec
+
 	StartupProgress  *prometheus.GaugeVec
 	StartupDurations *prometheus.SummaryVec
 	StartupDiskIO    *prometheus.SummaryVec
@@ -169,6 +181,14 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_tombstone_cleanup_threads",
 			Help: "Number of threads in use to clean up tombstones",
 		}, []string{"class_name", "shard_name"}),
+		VectorIndexTombstoneCleanupPending: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_tombstone_cleanup_pending",
+			Help: "Number of tombstones the running cleanup cycle has yet to remove",
+		}, []string{"class_name", "shard_name"}),
+		VectorIndexTombstoneCycleProgress: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_tombstone_cycle_progress",
+			Help: "Ratio of nodes the running cleanup cycle has visited",
+		}, []string{"class_name", "shard_name"}),
 		VectorIndexTombstoneCleanedCount: promauto.NewCounterVec(prometheus.CounterOpts{
 			Name: "vector_index_tombstone_cleaned",
 			Help: "Total number of deleted objects that have been cleaned up",
@@ -246,6 +266,44 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "backup_store_data_transferred",
 			Help: "Total number of bytes transferred during a backup store",
 		}, []string{"backend_name", "class_name"}),
+
+		ModuleExternalRequestsQueued: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "module_external_requests_queued",
+			Help: "Number of requests to an external API currently held back by a module's rate limiter",
+		}, []string{"module"}),
+		ModuleExternalRequestWaitDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
+			Name: "module_external_request_wait_ms",
+			Help: "Time in ms a request to an external API was held back by a module's rate limiter",
+		}, []string{"module"}),
+		ModuleEmbeddingCacheHits: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "module_embedding_cache_hits",
+			Help: "Number of texts whose embedding was served from a module's embedding cache",
+		}, []string{"module"}),
+		ModuleEmbeddingCacheMisses: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "module_embedding_cache_misses",
+			Help: "Number of texts whose embedding was not found in a module's embedding cache",
+		}, []string{"module"}),
+		ModuleExternalRequestDurations: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "module_external_request_durations_ms",
+			Help:    "Duration in ms of a single request of a module to an external API",
+			Buckets: msBuckets,
+		}, []string{"module", "class_name", "model"}),
+		ModuleExternalRequestErrors: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "module_external_request_errors",
+			Help: "Number of failed requests of a module to an external API by status code",
+		}, []string{"module", "class_name", "model", "status_code"}),
+		ModuleExternalRequestRetries: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "module_external_request_retries",
+			Help: "Number of requests of a module to an external API which were retried",
+		}, []string{"module", "class_name", "model"}),
+		ModuleExternalTokensUsed: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "module_external_tokens_used",
+			Help: "Number of tokens consumed by the requests of a module to an external API",
+		}, []string{"module", "class_name", "model"}),
+		ModuleExternalEstimatedCost: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "module_external_estimated_cost_usd",
+			Help: "Estimated cost in USD of the requests of a module to an external API based on list prices",
+		}, []string{"module", "class_name", "model"}),
 	}
 }
 
@@ -25,6 +25,7 @@ type authorizer interface {
 
 type db interface {
 	GetNodeStatus(ctx context.Context, className string) ([]*models.NodeStatus, error)
+	GetIndexStats(ctx context.Context, className, shardName string) (*models.IndexStats, error)
 }
 
 type Manager struct {
@@ -48,3 +49,13 @@ func (m *Manager) GetNodeStatus(ctx context.Context,
 	}
 	return m.db.GetNodeStatus(ctx, className)
 }
+
+// GetIndexStats returns the stats of the vector index of a shard on this node
+func (m *Manager) GetIndexStats(ctx context.Context, principal *models.Principal,
+	className, shardName string,
+) (*models.IndexStats, error) {
+	if err := m.authorizer.Authorize(principal, "list", "nodes"); err != nil {
+		return nil, err
+	}
+	return m.db.GetIndexStats(ctx, className, shardName)
+}
@@ -33,6 +33,8 @@ type schemaManager interface {
 	) (*models.Class, error)
 	AddClassProperty(ctx context.Context, principal *models.Principal,
 		class string, property *models.Property) error
+	// ResolveAlias returns the class an alias points to or name itself
+	ResolveAlias(name string) string
 }
 
 // AddObject Class Instance to the connected DB.
@@ -93,6 +95,7 @@ func (m *Manager) checkIDOrAssignNew(ctx context.Context, class string, id strfm
 func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *models.Principal,
 	object *models.Object, repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
+	object.Class = m.schemaManager.ResolveAlias(object.Class)
 	id, err := m.checkIDOrAssignNew(ctx, object.Class, object.ID, repl, object.Tenant)
 	if err != nil {
 		return nil, err
@@ -119,6 +122,9 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, err
 	}
+	if _, err := m.modulesProvider.EnrichObject(ctx, object, class); err != nil {
+		return nil, err
+	}
 	err = m.modulesProvider.UpdateVector(ctx, object, class, nil, m.findObject, m.logger)
 	if err != nil {
 		return nil, err
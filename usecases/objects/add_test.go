@@ -52,6 +52,7 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 				},
 			},
 		},
+		Aliases: map[string]string{"FooAlias": "Foo"},
 	}
 
 	resetAutoSchema := func(autoSchemaEnabled bool) {
@@ -101,6 +102,25 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		assert.Equal(t, uuidDuringCreation, res.ID, "check that connector add ID and user response match")
 	})
 
+	t.Run("with an alias as class", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		object := &models.Object{
+			Vector: []float32{0.1, 0.2, 0.3},
+			Class:  "FooAlias",
+		}
+
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		res, err := manager.AddObject(ctx, nil, object, nil)
+		require.Nil(t, err)
+		stored := vectorRepo.Mock.Calls[0].Arguments.Get(0).(*models.Object)
+		assert.Equal(t, "Foo", stored.Class)
+		assert.Equal(t, "Foo", res.Class)
+	})
+
 	t.Run("with an explicit (correct) ID set", func(t *testing.T) {
 		reset()
 
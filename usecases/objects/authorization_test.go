@@ -233,6 +233,17 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:     "delete",
 			expectedResource: "batch/objects",
 		},
+
+		{
+			methodName: "RecomputeRefVectors",
+			additionalArgs: []interface{}{
+				&models.BatchRef2VecRecomputeMatch{},
+				&additional.ReplicationProperties{},
+				"",
+			},
+			expectedVerb:     "update",
+			expectedResource: "batch/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -15,6 +15,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -148,6 +149,9 @@ func (m *autoSchemaManager) getProperties(object *models.Object) []*models.Prope
 				DataType:    m.getDataTypes(dt),
 				Description: "This property was generated by Weaviate's auto-schema feature on " + now.Format(time.ANSIC),
 			}
+			if len(dt) == 1 && (dt[0] == schema.DataTypeObject || dt[0] == schema.DataTypeObjectArray) {
+				property.NestedProperties = m.determineNestedProperties(value)
+			}
 			properties = append(properties, property)
 		}
 	}
@@ -186,6 +190,9 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 		if v["input"] != nil {
 			return []schema.DataType{schema.DataTypePhoneNumber}
 		}
+		if len(v) > 0 {
+			return []schema.DataType{schema.DataTypeObject}
+		}
 		return fallbackDataType
 	case []interface{}:
 		if len(v) > 0 {
@@ -193,6 +200,9 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 			for i := range v {
 				switch arrayVal := v[i].(type) {
 				case map[string]interface{}:
+					if _, ok := arrayVal["beacon"]; !ok && len(arrayVal) > 0 {
+						return []schema.DataType{schema.DataTypeObjectArray}
+					}
 					if len(arrayVal) > 0 {
 						for k, v := range arrayVal {
 							if k == "beacon" {
@@ -240,3 +250,62 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 		return fallbackDataType
 	}
 }
+
+// determineNestedProperties determines the nested properties of an object or
+// object[] value. The nested properties of all objects of an array are merged.
+func (m *autoSchemaManager) determineNestedProperties(value interface{}) []*models.NestedProperty {
+	var objects []map[string]interface{}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		objects = append(objects, v)
+	case []interface{}:
+		for i := range v {
+			if asMap, ok := v[i].(map[string]interface{}); ok {
+				objects = append(objects, asMap)
+			}
+		}
+	}
+
+	var nestedProps []*models.NestedProperty
+	known := map[string]bool{}
+	for _, object := range objects {
+		for name, nestedValue := range object {
+			if known[name] || nestedValue == nil {
+				continue
+			}
+			known[name] = true
+
+			dt := m.determineType(nestedValue)
+			if len(dt) != 1 {
+				continue
+			}
+			switch dt[0] {
+			case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber:
+				// not supported for nested properties, keep their structure instead
+				dt = []schema.DataType{schema.DataTypeObject}
+			case schema.DataTypeString:
+				dt = []schema.DataType{schema.DataTypeText}
+			case schema.DataTypeStringArray:
+				dt = []schema.DataType{schema.DataTypeTextArray}
+			}
+			if schema.IsRefDataType(m.getDataTypes(dt)) {
+				// references are not supported for nested properties
+				continue
+			}
+
+			nestedProp := &models.NestedProperty{
+				Name:     name,
+				DataType: m.getDataTypes(dt),
+			}
+			if dt[0] == schema.DataTypeObject || dt[0] == schema.DataTypeObjectArray {
+				nestedProp.NestedProperties = m.determineNestedProperties(nestedValue)
+			}
+			nestedProps = append(nestedProps, nestedProp)
+		}
+	}
+
+	sort.Slice(nestedProps, func(i, j int) bool {
+		return nestedProps[i].Name < nestedProps[j].Name
+	})
+	return nestedProps
+}
@@ -281,6 +281,33 @@ func Test_autoSchemaManager_determineType(t *testing.T) {
 			},
 			want: []schema.DataType{schema.DataTypeStringArray},
 		},
+		{
+			name: "determine object",
+			fields: fields{
+				config: config.AutoSchema{
+					Enabled: true,
+				},
+			},
+			args: args{
+				value: map[string]interface{}{"city": "Berlin"},
+			},
+			want: []schema.DataType{schema.DataTypeObject},
+		},
+		{
+			name: "determine object array",
+			fields: fields{
+				config: config.AutoSchema{
+					Enabled: true,
+				},
+			},
+			args: args{
+				value: []interface{}{
+					map[string]interface{}{"city": "Berlin"},
+					map[string]interface{}{"city": "Paris"},
+				},
+			},
+			want: []schema.DataType{schema.DataTypeObjectArray},
+		},
 		{
 			name: "determine error type that is not recognized",
 			fields: fields{
@@ -396,6 +423,69 @@ func Test_autoSchemaManager_autoSchema_create(t *testing.T) {
 	assert.Equal(t, "number[]", getProperty((schemaAfter.Objects.Classes)[0].Properties, "numberArray").DataType[0])
 }
 
+func Test_autoSchemaManager_autoSchema_createNested(t *testing.T) {
+	// given
+	schemaManager := &fakeSchemaManager{}
+	logger, _ := test.NewNullLogger()
+	autoSchemaManager := &autoSchemaManager{
+		schemaManager: schemaManager,
+		vectorRepo:    &fakeVectorRepo{},
+		config: config.AutoSchema{
+			Enabled:       true,
+			DefaultString: schema.DataTypeText.String(),
+			DefaultNumber: "number",
+			DefaultDate:   "date",
+		},
+		logger: logger,
+	}
+	obj := &models.Object{
+		Class: "Publication",
+		Properties: map[string]interface{}{
+			"author": map[string]interface{}{
+				"name": "Jodie Sparrow",
+				"address": map[string]interface{}{
+					"city": "Berlin",
+				},
+			},
+			"reviews": []interface{}{
+				map[string]interface{}{"stars": json.Number("5")},
+				map[string]interface{}{"stars": json.Number("3"), "tags": []interface{}{"a", "b"}},
+			},
+		},
+	}
+	// when
+	err := autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, obj)
+	schemaAfter := schemaManager.GetSchemaResponse
+
+	// then
+	require.Nil(t, err)
+	require.NotNil(t, schemaAfter.Objects)
+	require.Equal(t, 1, len(schemaAfter.Objects.Classes))
+	properties := (schemaAfter.Objects.Classes)[0].Properties
+
+	author := getProperty(properties, "author")
+	require.NotNil(t, author)
+	assert.Equal(t, []string{"object"}, author.DataType)
+	assert.Equal(t, []*models.NestedProperty{
+		{
+			Name:     "address",
+			DataType: []string{"object"},
+			NestedProperties: []*models.NestedProperty{
+				{Name: "city", DataType: []string{"text"}},
+			},
+		},
+		{Name: "name", DataType: []string{"text"}},
+	}, author.NestedProperties)
+
+	reviews := getProperty(properties, "reviews")
+	require.NotNil(t, reviews)
+	assert.Equal(t, []string{"object[]"}, reviews.DataType)
+	assert.Equal(t, []*models.NestedProperty{
+		{Name: "stars", DataType: []string{"number"}},
+		{Name: "tags", DataType: []string{"text[]"}},
+	}, reviews.NestedProperties)
+}
+
 func Test_autoSchemaManager_autoSchema_update(t *testing.T) {
 	// given
 	vectorRepo := &fakeVectorRepo{}
@@ -120,6 +120,8 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 
 	ec := &errorcompounder.ErrorCompounder{}
 
+	concept.Class = b.schemaManager.ResolveAlias(concept.Class)
+
 	// Auto Schema
 	err := b.autoSchemaManager.autoSchema(ctx, principal, concept)
 	ec.Add(err)
@@ -169,7 +171,11 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 		ec.Add(err)
 
 		if err == nil {
-			// update vector only if we passed validation
+			// enrich and update vector only if we passed validation
+			_, err = b.modulesProvider.EnrichObject(ctx, object, class)
+			ec.Add(err)
+		}
+		if err == nil {
 			err = b.modulesProvider.UpdateVector(ctx, object, class, nil, b.findObject, b.logger)
 			ec.Add(err)
 		}
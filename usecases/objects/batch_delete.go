@@ -104,7 +104,7 @@ func (b *BatchManager) validateBatchDelete(ctx context.Context, principal *model
 		return nil, fmt.Errorf("failed to get schema: %s", err)
 	}
 
-	class := s.FindClassByName(schema.ClassName(match.Class))
+	class := s.FindClassByName(schema.ClassName(b.schemaManager.ResolveAlias(match.Class)))
 	if class == nil {
 		return nil, fmt.Errorf("class: %v doesn't exist", match.Class)
 	}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// RecomputeRefVectors calculates the vectors of the objects of a ref2vec
+// vectorized class which match the filter from all of their references again.
+// This repairs vectors which drifted through incremental updates, e.g. because
+// referenced objects were vectorized after they had been referenced.
+func (b *BatchManager) RecomputeRefVectors(ctx context.Context, principal *models.Principal,
+	match *models.BatchRef2VecRecomputeMatch, repl *additional.ReplicationProperties,
+	tenant string,
+) (*BatchRef2VecRecomputeResult, error) {
+	err := b.authorizer.Authorize(principal, "update", "batch/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	class, filter, err := b.validateRefVectorsMatch(principal, match)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("validate: %v", err)
+	}
+
+	limit := b.config.Config.QueryMaximumResults
+	res, qerr := b.vectorRepo.Query(ctx, &QueryInput{
+		Class:   class.Class,
+		Limit:   int(limit),
+		Filters: filter,
+		Tenant:  tenant,
+	})
+	if qerr != nil {
+		if qerr.UnprocessableEntity() {
+			return nil, NewErrMultiTenancy(qerr)
+		}
+		return nil, fmt.Errorf("find objects: %w", qerr)
+	}
+
+	result := &BatchRef2VecRecomputeResult{
+		Matches: int64(len(res)),
+		Limit:   limit,
+	}
+	for i := range res {
+		if err := b.recomputeRefVector(ctx, class, res[i].Object(), repl); err != nil {
+			b.logger.WithField("action", "batch_recompute_ref_vectors").
+				WithField("class", class.Class).
+				WithField("id", res[i].ID).
+				WithError(err).
+				Warn("failed to recompute ref2vec vector")
+			result.Failed++
+			continue
+		}
+		result.Successful++
+	}
+
+	return result, nil
+}
+
+func (b *BatchManager) recomputeRefVector(ctx context.Context, class *models.Class,
+	obj *models.Object, repl *additional.ReplicationProperties,
+) error {
+	if err := b.modulesProvider.UpdateVector(
+		ctx, obj, class, nil, b.findObject, b.logger); err != nil {
+		return fmt.Errorf("calculate ref vector: %w", err)
+	}
+
+	if err := b.vectorRepo.PutObject(ctx, obj, obj.Vector, repl); err != nil {
+		return fmt.Errorf("put object: %w", err)
+	}
+	return nil
+}
+
+func (b *BatchManager) validateRefVectorsMatch(principal *models.Principal,
+	match *models.BatchRef2VecRecomputeMatch,
+) (*models.Class, *filters.LocalFilter, error) {
+	if match == nil {
+		return nil, nil, errors.New("empty match clause")
+	}
+
+	if len(match.Class) == 0 {
+		return nil, nil, errors.New("empty match.class clause")
+	}
+
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get schema: %s", err)
+	}
+
+	class := s.FindClassByName(schema.ClassName(match.Class))
+	if class == nil {
+		return nil, nil, fmt.Errorf("class: %v doesn't exist", match.Class)
+	}
+
+	if !b.modulesProvider.UsingRef2Vec(class.Class) {
+		return nil, nil, fmt.Errorf("class: %v is not vectorized by a ref2vec module", class.Class)
+	}
+
+	// without a where clause all objects of the class are recomputed
+	if match.Where == nil {
+		return class, nil, nil
+	}
+
+	filter, err := filterext.Parse(match.Where, class.Class)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse where filter: %s", err)
+	}
+
+	err = filters.ValidateFilters(s, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid where filter: %s", err)
+	}
+
+	return class, filter, nil
+}
@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchRecomputeRefVectors(t *testing.T) {
+	ctx := context.Background()
+
+	newManager := func(usingRef2Vec bool) (*BatchManager, *fakeVectorRepo, *fakeModulesProvider) {
+		vectorRepo := &fakeVectorRepo{}
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.QueryMaximumResults = 100
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: articleSchemaForTest(),
+		}
+		logger, _ := test.NewNullLogger()
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UsingRef2Vec", mock.Anything).Return(usingRef2Vec)
+		manager := NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, cfg, logger, &fakeAuthorizer{}, nil)
+		return manager, vectorRepo, modulesProvider
+	}
+
+	t.Run("with invalid input", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			match         *models.BatchRef2VecRecomputeMatch
+			usingRef2Vec  bool
+			expectedError string
+		}{
+			{
+				name:          "empty match",
+				usingRef2Vec:  true,
+				expectedError: "validate: empty match clause",
+			},
+			{
+				name:          "empty class",
+				match:         &models.BatchRef2VecRecomputeMatch{},
+				usingRef2Vec:  true,
+				expectedError: "validate: empty match.class clause",
+			},
+			{
+				name:          "nonexistent class",
+				match:         &models.BatchRef2VecRecomputeMatch{Class: "SomeClass"},
+				usingRef2Vec:  true,
+				expectedError: "validate: class: SomeClass doesn't exist",
+			},
+			{
+				name:          "class not using ref2vec",
+				match:         &models.BatchRef2VecRecomputeMatch{Class: "Paragraph"},
+				expectedError: "validate: class: Paragraph is not vectorized by a ref2vec module",
+			},
+			{
+				name: "invalid where filter",
+				match: &models.BatchRef2VecRecomputeMatch{
+					Class: "Article",
+					Where: &models.WhereFilter{
+						Path:      []string{"some"},
+						Operator:  "Equal",
+						ValueText: ptString("value"),
+					},
+				},
+				usingRef2Vec: true,
+				expectedError: "validate: invalid where filter: no such prop with name 'some' found in class 'Article' " +
+					"in the schema. Check your schema files for which properties in this class are available",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				manager, _, _ := newManager(tt.usingRef2Vec)
+				_, err := manager.RecomputeRefVectors(ctx, nil, tt.match, nil, "")
+				require.NotNil(t, err)
+				assert.Equal(t, tt.expectedError, err.Error())
+				assert.True(t, errors.As(err, &ErrInvalidUserInput{}))
+			})
+		}
+	})
+
+	t.Run("recomputes all matching objects", func(t *testing.T) {
+		manager, vectorRepo, modulesProvider := newManager(true)
+
+		found := []search.Result{
+			{ID: strfmt.UUID("e1a60252-c38c-496d-8e54-306e1cedc5c4"), ClassName: "Article"},
+			{ID: strfmt.UUID("9ae8c3c5-5a64-4a8b-a4b7-bbc6f3e52cc2"), ClassName: "Article"},
+			{ID: strfmt.UUID("494a2fe5-3e4c-4e9a-a47e-afcd9814f5ea"), ClassName: "Article"},
+		}
+		vectorRepo.On("Query", &QueryInput{Class: "Article", Limit: 100}).
+			Return(found, (*Error)(nil))
+		modulesProvider.On("UpdateVector", mock.MatchedBy(func(obj *models.Object) bool {
+			return obj.ID == found[1].ID
+		}), mock.Anything).Return(nil, errors.New("not found"))
+		modulesProvider.On("UpdateVector", mock.Anything, mock.Anything).
+			Return([]float32{1, 2, 3}, nil)
+		vectorRepo.On("PutObject", mock.Anything, []float32{1, 2, 3}).Return(nil)
+
+		res, err := manager.RecomputeRefVectors(ctx, nil,
+			&models.BatchRef2VecRecomputeMatch{Class: "Article"}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, &BatchRef2VecRecomputeResult{
+			Matches:    3,
+			Limit:      100,
+			Successful: 2,
+			Failed:     1,
+		}, res)
+		vectorRepo.AssertNumberOfCalls(t, "PutObject", 2)
+	})
+}
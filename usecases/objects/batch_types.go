@@ -84,6 +84,15 @@ type BatchDeleteResult struct {
 	Objects BatchSimpleObjects
 }
 
+// BatchRef2VecRecomputeResult contains the outcome of recomputing the
+// vectors of ref2vec vectorized objects in batch
+type BatchRef2VecRecomputeResult struct {
+	Matches    int64
+	Limit      int64
+	Successful int64
+	Failed     int64
+}
+
 type BatchDeleteResponse struct {
 	Match  *models.BatchDeleteMatch
 	DryRun bool
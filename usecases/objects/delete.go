@@ -36,6 +36,7 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	if err != nil {
 		return err
 	}
+	class = m.schemaManager.ResolveAlias(class)
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
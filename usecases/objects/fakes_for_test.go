@@ -66,6 +66,13 @@ func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schem
 	return f.GetSchemaResponse, f.GetschemaErr
 }
 
+func (f *fakeSchemaManager) ResolveAlias(name string) string {
+	if class, ok := f.GetSchemaResponse.Aliases[name]; ok {
+		return class
+	}
+	return name
+}
+
 func (f *fakeSchemaManager) ShardOwner(class, shard string) (string, error) { return "", nil }
 func (f *fakeSchemaManager) TenantShard(class, tenant string) string        { return tenant }
 func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string { return "" }
@@ -336,6 +343,24 @@ func (p *fakeModulesProvider) UpdateVector(ctx context.Context, object *models.O
 	}
 }
 
+func (p *fakeModulesProvider) EnrichObject(ctx context.Context, object *models.Object,
+	class *models.Class,
+) ([]string, error) {
+	return nil, nil
+}
+
+func (p *fakeModulesProvider) UpdateReferenceVector(ctx context.Context, object *models.Object,
+	class *models.Class, change modulecapabilities.ReferenceChange,
+	findObjFn modulecapabilities.FindObjectFn,
+) (bool, error) {
+	args := p.Called(object, change)
+	if vec, ok := args.Get(0).([]float32); ok {
+		object.Vector = vec
+		return true, args.Error(1)
+	}
+	return false, args.Error(1)
+}
+
 func (p *fakeModulesProvider) VectorizerName(className string) (string, error) {
 	args := p.Called(className)
 	return args.String(0), args.Error(1)
@@ -38,6 +38,7 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, err
 	}
+	class = m.schemaManager.ResolveAlias(class)
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
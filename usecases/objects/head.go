@@ -31,6 +31,7 @@ func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, c
 	if err := m.authorizer.Authorize(principal, "head", path); err != nil {
 		return false, &Error{path, StatusForbidden, err}
 	}
+	class = m.schemaManager.ResolveAlias(class)
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
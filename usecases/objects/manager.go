@@ -120,7 +120,10 @@ type ModulesProvider interface {
 	UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
 		objectDiff *moduletools.ObjectDiff, repo modulecapabilities.FindObjectFn,
 		logger logrus.FieldLogger) error
+	UpdateReferenceVector(ctx context.Context, object *models.Object, class *models.Class,
+		change modulecapabilities.ReferenceChange, repo modulecapabilities.FindObjectFn) (bool, error)
 	VectorizerName(className string) (string, error)
+	EnrichObject(ctx context.Context, object *models.Object, class *models.Class) ([]string, error)
 }
 
 // NewManager creates a new manager
@@ -31,6 +31,7 @@ type MergeDocument struct {
 	PrimitiveSchema      map[string]interface{}      `json:"primitiveSchema"`
 	References           BatchReferences             `json:"references"`
 	Vector               []float32                   `json:"vector"`
+	Vectors              models.Vectors              `json:"vectors"`
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
@@ -47,6 +48,8 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
+	cls = m.schemaManager.ResolveAlias(cls)
+	updates.Class = cls
 
 	m.metrics.MergeObjectInc()
 	defer m.metrics.MergeObjectDec()
@@ -92,17 +95,25 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 ) *Error {
 	cls, id := updates.Class, updates.ID
 	primitive, refs := m.splitPrimitiveAndRefs(updates.Properties.(map[string]interface{}), cls, id)
-	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
-		primitive, principal, obj.Vector, updates.Vector)
+	objWithVec, enriched, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
+		primitive, principal, obj.Vector, updates.Vector, updates.Vectors)
 	if err != nil {
 		return &Error{"merge and vectorize", StatusInternalServerError, err}
 	}
+	// enriched properties are derived from the merged object, so they
+	// are written even if the patch doesn't contain them
+	for _, name := range enriched {
+		if value, ok := objWithVec.Properties.(map[string]interface{})[name]; ok {
+			primitive[name] = value
+		}
+	}
 	mergeDoc := MergeDocument{
 		Class:              cls,
 		ID:                 id,
 		PrimitiveSchema:    primitive,
 		References:         refs,
 		Vector:             objWithVec.Vector,
+		Vectors:            objWithVec.Vectors,
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
 	}
@@ -133,8 +144,8 @@ func (m *Manager) validateInputs(updates *models.Object) error {
 
 func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className string,
 	old interface{}, new map[string]interface{},
-	principal *models.Principal, oldVec, newVec []float32,
-) (*models.Object, error) {
+	principal *models.Principal, oldVec, newVec []float32, newVectors models.Vectors,
+) (*models.Object, []string, error) {
 	var merged map[string]interface{}
 	var vector []float32
 	var objDiff *moduletools.ObjectDiff
@@ -145,7 +156,7 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	} else {
 		oldMap, ok := old.(map[string]interface{})
 		if !ok {
-			return nil, fmt.Errorf("expected previous schema to be map, but got %#v", old)
+			return nil, nil, fmt.Errorf("expected previous schema to be map, but got %#v", old)
 		}
 
 		objDiff = moduletools.NewObjectDiff(oldVec)
@@ -160,7 +171,7 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 		} else {
 			vectorizerName, err := m.modulesProvider.VectorizerName(className)
 			if err != nil {
-				return nil, fmt.Errorf("find vectorizer name: %w", err)
+				return nil, nil, fmt.Errorf("find vectorizer name: %w", err)
 			}
 			if vectorizerName == config.VectorizerModuleNone {
 				vector = oldVec
@@ -169,17 +180,22 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	}
 
 	// Note: vector could be a nil vector in case a vectorizer is configured,
-	// then the vectorizer will set it
-	obj := &models.Object{Class: className, Properties: merged, Vector: vector}
+	// then the vectorizer will set it. Named vectors which are not part of the
+	// patch are revectorized, the ones without a vectorizer are kept as is.
+	obj := &models.Object{Class: className, Properties: merged, Vector: vector, Vectors: newVectors}
 	class, err := m.schemaManager.GetClass(ctx, principal, className)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	enriched, err := m.modulesProvider.EnrichObject(ctx, obj, class)
+	if err != nil {
+		return nil, nil, err
 	}
 	if err := m.modulesProvider.UpdateVector(ctx, obj, class, objDiff, m.findObject, m.logger); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return obj, nil
+	return obj, enriched, nil
 }
 
 func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
@@ -70,6 +70,7 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
 		return nil, &Error{path, StatusForbidden, err}
 	}
+	params.Class = m.schemaManager.ResolveAlias(params.Class)
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, &Error{"cannot lock", StatusInternalServerError, err}
@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
@@ -33,6 +34,8 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 	m.metrics.AddReferenceInc()
 	defer m.metrics.AddReferenceDec()
 
+	input.Class = m.schemaManager.ResolveAlias(input.Class)
+
 	deprecatedEndpoint := input.Class == ""
 	if deprecatedEndpoint { // for backward compatibility only
 		objectRes, err := m.getObjectFromRepo(ctx, "", input.ID,
@@ -101,7 +104,10 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 		return &Error{"add reference to repo", StatusInternalServerError, err}
 	}
 
-	if err := m.updateRefVector(ctx, principal, input.Class, input.ID); err != nil {
+	change := &modulecapabilities.ReferenceChange{
+		Property: input.Property, Beacon: input.Ref.Beacon, Count: 1,
+	}
+	if err := m.updateRefVector(ctx, principal, input.Class, input.ID, change); err != nil {
 		return &Error{"update ref vector", StatusInternalServerError, err}
 	}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 )
 
 // DeleteReferenceInput represents required inputs to delete a reference from an existing object.
@@ -39,6 +40,8 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 	m.metrics.DeleteReferenceInc()
 	defer m.metrics.DeleteReferenceDec()
 
+	input.Class = m.schemaManager.ResolveAlias(input.Class)
+
 	deprecatedEndpoint := input.Class == ""
 	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID,
 		additional.Properties{}, nil, tenant)
@@ -76,11 +79,11 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 
 	obj := res.Object()
 	obj.Tenant = tenant
-	ok, errmsg := removeReference(obj, input.Property, &input.Reference)
+	removed, errmsg := removeReference(obj, input.Property, &input.Reference)
 	if errmsg != "" {
 		return &Error{errmsg, StatusInternalServerError, nil}
 	}
-	if !ok {
+	if removed == 0 {
 		return nil
 	}
 	obj.LastUpdateTimeUnix = m.timeSource.Now()
@@ -90,7 +93,10 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 		return &Error{"repo.putobject", StatusInternalServerError, err}
 	}
 
-	if err := m.updateRefVector(ctx, principal, input.Class, input.ID); err != nil {
+	change := &modulecapabilities.ReferenceChange{
+		Property: input.Property, Beacon: input.Reference.Beacon, Count: -removed,
+	}
+	if err := m.updateRefVector(ctx, principal, input.Class, input.ID, change); err != nil {
 		return &Error{"update ref vector", StatusInternalServerError, err}
 	}
 
@@ -114,16 +120,16 @@ func (req *DeleteReferenceInput) validate(
 }
 
 // removeReference removes ref from object obj with property prop.
-// It returns ok (removal took place) and an error message
-func removeReference(obj *models.Object, prop string, ref *models.SingleRef) (ok bool, errmsg string) {
+// It returns the number of removed references and an error message
+func removeReference(obj *models.Object, prop string, ref *models.SingleRef) (removed int, errmsg string) {
 	properties := obj.Properties.(map[string]interface{})
 	if properties == nil || properties[prop] == nil {
-		return false, ""
+		return 0, ""
 	}
 
 	refs, ok := properties[prop].(models.MultipleRef)
 	if !ok {
-		return false, "source list is not well formed"
+		return 0, "source list is not well formed"
 	}
 
 	newrefs := make(models.MultipleRef, 0, len(refs))
@@ -133,5 +139,5 @@ func removeReference(obj *models.Object, prop string, ref *models.SingleRef) (ok
 		}
 	}
 	properties[prop] = newrefs
-	return len(refs) != len(newrefs), ""
+	return len(refs) - len(newrefs), ""
 }
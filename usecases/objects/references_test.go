@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
@@ -626,6 +627,8 @@ func Test_ReferenceAdd_Ref2Vec(t *testing.T) {
 	m.repo.On("Object", "Paragraph", ref1.ID, search.SelectProperties{}, additional.Properties{}).Return(ref1, nil)
 	m.repo.On("AddReference", source, target).Return(nil)
 	m.modulesProvider.On("UsingRef2Vec", mock.Anything).Return(true)
+	m.modulesProvider.On("UpdateReferenceVector", mock.Anything, mock.Anything).
+		Return(nil, nil)
 	m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
 		Return(ref1.Vector, nil)
 	m.repo.On("PutObject", mock.Anything, ref1.Vector).Return(nil)
@@ -634,6 +637,52 @@ func Test_ReferenceAdd_Ref2Vec(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ReferenceAdd_Ref2Vec_Incremental(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	m := newFakeGetManager(articleSchemaForTest())
+
+	req := AddReferenceInput{
+		Class:    "Article",
+		ID:       strfmt.UUID("e1a60252-c38c-496d-8e54-306e1cedc5c4"),
+		Property: "hasParagraphs",
+		Ref: models.SingleRef{
+			Beacon: strfmt.URI("weaviate://localhost/Paragraph/494a2fe5-3e4c-4e9a-a47e-afcd9814f5ea"),
+		},
+	}
+
+	source := crossref.NewSource(schema.ClassName(req.Class), schema.PropertyName(req.Property), req.ID)
+	target := crossref.New("localhost", "Paragraph", "494a2fe5-3e4c-4e9a-a47e-afcd9814f5ea")
+
+	parent := &search.Result{
+		ID:        strfmt.UUID("e1a60252-c38c-496d-8e54-306e1cedc5c4"),
+		ClassName: "Article",
+		Schema:    map[string]interface{}{},
+		Vector:    []float32{1, 2, 3},
+	}
+	expectedVector := []float32{2, 3, 4}
+	expectedChange := modulecapabilities.ReferenceChange{
+		Property: req.Property, Beacon: req.Ref.Beacon, Count: 1,
+	}
+
+	m.repo.On("Exists", "Article", parent.ID).Return(true, nil)
+	m.repo.On("Exists", "Paragraph", target.TargetID).Return(true, nil)
+	m.repo.On("Object", "Article", parent.ID, search.SelectProperties{}, additional.Properties{}).Return(parent, nil)
+	m.repo.On("AddReference", source, target).Return(nil)
+	m.modulesProvider.On("UsingRef2Vec", mock.Anything).Return(true)
+	m.modulesProvider.On("UpdateReferenceVector", mock.Anything, expectedChange).
+		Return(expectedVector, nil)
+	m.repo.On("PutObject", mock.Anything, expectedVector).Return(nil)
+
+	err := m.Manager.AddObjectReference(ctx, nil, &req, nil, "")
+	assert.Nil(t, err)
+	m.modulesProvider.AssertNotCalled(t, "UpdateVector", mock.Anything, mock.Anything)
+	m.repo.AssertExpectations(t)
+}
+
 func Test_ReferenceDelete_Ref2Vec(t *testing.T) {
 	t.Parallel()
 
@@ -673,6 +722,51 @@ func Test_ReferenceDelete_Ref2Vec(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ReferenceDelete_Ref2Vec_Incremental(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	m := newFakeGetManager(articleSchemaForTest())
+
+	beacon := strfmt.URI("weaviate://localhost/Paragraph/494a2fe5-3e4c-4e9a-a47e-afcd9814f5ea")
+	req := DeleteReferenceInput{
+		Class:     "Article",
+		ID:        strfmt.UUID("e1a60252-c38c-496d-8e54-306e1cedc5c4"),
+		Property:  "hasParagraphs",
+		Reference: models.SingleRef{Beacon: beacon},
+	}
+
+	parent := &search.Result{
+		ID:        strfmt.UUID("e1a60252-c38c-496d-8e54-306e1cedc5c4"),
+		ClassName: "Article",
+		Schema: map[string]interface{}{
+			"hasParagraphs": models.MultipleRef{
+				{Beacon: beacon},
+				{Beacon: "weaviate://localhost/Paragraph/b3a2ba4c-1fa8-4bba-8a3f-bd1e55c3a8a4"},
+				{Beacon: beacon},
+			},
+		},
+		Vector: []float32{2, 3, 4},
+	}
+	expectedVector := []float32{1, 2, 3}
+	expectedChange := modulecapabilities.ReferenceChange{
+		Property: req.Property, Beacon: beacon, Count: -2,
+	}
+
+	m.repo.On("Object", req.Class, req.ID, search.SelectProperties{}, additional.Properties{}).Return(parent, nil)
+	m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil)
+	m.modulesProvider.On("UsingRef2Vec", mock.Anything).Return(true)
+	m.modulesProvider.On("UpdateReferenceVector", mock.Anything, expectedChange).
+		Return(expectedVector, nil)
+
+	err := m.Manager.DeleteObjectReference(ctx, nil, &req, nil, "")
+	assert.Nil(t, err)
+	m.modulesProvider.AssertExpectations(t)
+	m.repo.AssertCalled(t, "PutObject", mock.Anything, expectedVector)
+}
+
 func articleSchemaForTest() schema.Schema {
 	return schema.Schema{
 		Objects: &models.Schema{
@@ -44,6 +44,8 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	m.metrics.UpdateReferenceInc()
 	defer m.metrics.UpdateReferenceDec()
 
+	input.Class = m.schemaManager.ResolveAlias(input.Class)
+
 	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, nil, tenant)
 	if err != nil {
 		errnf := ErrNotFound{}
@@ -144,5 +146,9 @@ func validateReferenceSchema(class, property string, sch schema.Schema) error {
 		return fmt.Errorf("property '%s' is a primitive datatype, not a reference-type", property)
 	}
 
+	if dt.IsNested() {
+		return fmt.Errorf("property '%s' is an object datatype, not a reference-type", property)
+	}
+
 	return nil
 }
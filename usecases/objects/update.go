@@ -35,6 +35,8 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, err
 	}
+	class = m.schemaManager.ResolveAlias(class)
+	updates.Class = m.schemaManager.ResolveAlias(updates.Class)
 
 	m.metrics.UpdateObjectInc()
 	defer m.metrics.UpdateObjectDec()
@@ -85,6 +87,9 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if _, err := m.modulesProvider.EnrichObject(ctx, updates, class); err != nil {
+		return nil, NewErrInternal("update object: %v", err)
+	}
 	err = m.modulesProvider.UpdateVector(ctx, updates, class, nil, m.findObject, m.logger)
 	if err != nil {
 		return nil, NewErrInternal("update object: %v", err)
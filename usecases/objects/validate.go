@@ -27,6 +27,7 @@ func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principa
 	if err != nil {
 		return err
 	}
+	obj.Class = m.schemaManager.ResolveAlias(obj.Class)
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
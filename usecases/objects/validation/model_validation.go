@@ -84,9 +84,24 @@ func (v *Validator) Object(ctx context.Context, class *models.Class,
 		return err
 	}
 
+	if err := validateVectors(class, incoming.Vectors); err != nil {
+		return err
+	}
+
 	return v.properties(ctx, class, incoming, existing)
 }
 
+// validateVectors makes sure that named vectors are only set for the named
+// vectors configured in the class
+func validateVectors(class *models.Class, vectors models.Vectors) error {
+	for name := range vectors {
+		if _, ok := class.VectorConfig[name]; !ok {
+			return fmt.Errorf("class %s does not have named vector %q", class.Class, name)
+		}
+	}
+	return nil
+}
+
 func validateClass(class string) error {
 	// If the given class is empty, return an error
 	if class == "" {
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// nestedVal validates the value of an object or object[] property against
+// the nested properties describing it. The path is the dot path of the value,
+// e.g. address.city, and is used in error messages only.
+func nestedVal(path string, val interface{}, dataType schema.DataType,
+	nestedProps []*models.NestedProperty,
+) (interface{}, error) {
+	switch dataType {
+	case schema.DataTypeObject:
+		return objectVal(path, val, nestedProps)
+	case schema.DataTypeObjectArray:
+		typed, ok := val.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("not an object array, but %T", val)
+		}
+
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			obj, err := objectVal(fmt.Sprintf("%s[%d]", path, i), typed[i], nestedProps)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = obj
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unrecognized nested data type '%s'", dataType)
+	}
+}
+
+func objectVal(path string, val interface{},
+	nestedProps []*models.NestedProperty,
+) (map[string]interface{}, error) {
+	typed, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not an object, but %T", val)
+	}
+
+	out := make(map[string]interface{}, len(typed))
+	for name, value := range typed {
+		if value == nil {
+			continue // nil values are removed and filtered out
+		}
+
+		var nestedProp *models.NestedProperty
+		for _, np := range nestedProps {
+			if np.Name == name {
+				nestedProp = np
+				break
+			}
+		}
+		if nestedProp == nil {
+			return nil, fmt.Errorf("no such nested property with name '%s' found in '%s'", name, path)
+		}
+
+		data, err := nestedPropertyVal(path+schema.NestedPropertySeparator+name, value, nestedProp)
+		if err != nil {
+			return nil, err
+		}
+		out[name] = data
+	}
+
+	return out, nil
+}
+
+func nestedPropertyVal(path string, val interface{},
+	nestedProp *models.NestedProperty,
+) (interface{}, error) {
+	var (
+		data interface{}
+		err  error
+	)
+
+	dataType := schema.DataType(nestedProp.DataType[0])
+	switch dataType {
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		return nestedVal(path, val, dataType, nestedProp.NestedProperties)
+	case schema.DataTypeText:
+		data, err = stringVal(val)
+	case schema.DataTypeUUID:
+		var asStr string
+		if asStr, err = stringVal(val); err == nil {
+			data, err = uuid.Parse(asStr)
+		}
+	case schema.DataTypeInt:
+		data, err = intVal(val)
+	case schema.DataTypeNumber:
+		data, err = numberVal(val)
+	case schema.DataTypeBoolean:
+		data, err = boolVal(val)
+	case schema.DataTypeDate:
+		data, err = dateVal(val)
+	case schema.DataTypeBlob:
+		data, err = blobVal(val)
+	case schema.DataTypeTextArray:
+		data, err = stringArrayVal(val, "text")
+	case schema.DataTypeIntArray:
+		data, err = intArrayVal(val)
+	case schema.DataTypeNumberArray:
+		data, err = numberArrayVal(val)
+	case schema.DataTypeBooleanArray:
+		data, err = boolArrayVal(val)
+	case schema.DataTypeDateArray:
+		data, err = dateArrayVal(val)
+	case schema.DataTypeUUIDArray:
+		// kept as is, so that the values of nested properties are the same
+		// before and after being stored
+		if _, err = ParseUUIDArray(val); err == nil {
+			data = val
+		}
+	default:
+		return nil, fmt.Errorf("unrecognized data type '%s' of nested property '%s'", dataType, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s nested property '%s': %s", dataType, path, err)
+	}
+
+	return data, nil
+}
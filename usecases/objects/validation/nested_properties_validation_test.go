@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestNestedPropertiesValidation(t *testing.T) {
+	nestedProps := []*models.NestedProperty{
+		{Name: "city", DataType: schema.DataTypeText.PropString()},
+		{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+		{Name: "since", DataType: schema.DataTypeDate.PropString()},
+		{
+			Name:     "pets",
+			DataType: schema.DataTypeObjectArray.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "kind", DataType: schema.DataTypeText.PropString()},
+				{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+			},
+		},
+	}
+
+	t.Run("valid object", func(t *testing.T) {
+		val := map[string]interface{}{
+			"city":  "Berlin",
+			"zip":   json.Number("10115"),
+			"since": "2020-01-01T00:00:00Z",
+			"pets": []interface{}{
+				map[string]interface{}{"kind": "cat", "tags": []interface{}{"black"}},
+				map[string]interface{}{"kind": "dog", "tags": nil},
+			},
+		}
+
+		res, err := nestedVal("address", val, schema.DataTypeObject, nestedProps)
+		require.Nil(t, err)
+
+		expected := map[string]interface{}{
+			"city":  "Berlin",
+			"zip":   int64(10115),
+			"since": time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			"pets": []interface{}{
+				map[string]interface{}{"kind": "cat", "tags": []interface{}{"black"}},
+				map[string]interface{}{"kind": "dog"},
+			},
+		}
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("valid object array", func(t *testing.T) {
+		val := []interface{}{
+			map[string]interface{}{"city": "Berlin"},
+			map[string]interface{}{"city": "Paris"},
+		}
+
+		res, err := nestedVal("addresses", val, schema.DataTypeObjectArray, nestedProps)
+		require.Nil(t, err)
+		assert.Equal(t, val, res)
+	})
+
+	tests := []struct {
+		name        string
+		val         interface{}
+		dataType    schema.DataType
+		expectedErr string
+	}{
+		{
+			name:        "not an object",
+			val:         "Berlin",
+			dataType:    schema.DataTypeObject,
+			expectedErr: "not an object, but string",
+		},
+		{
+			name:        "not an object array",
+			val:         map[string]interface{}{"city": "Berlin"},
+			dataType:    schema.DataTypeObjectArray,
+			expectedErr: "not an object array",
+		},
+		{
+			name:        "unknown nested property",
+			val:         map[string]interface{}{"street": "Main St"},
+			dataType:    schema.DataTypeObject,
+			expectedErr: "no such nested property with name 'street' found in 'address'",
+		},
+		{
+			name:        "wrong type of nested property",
+			val:         map[string]interface{}{"zip": "10115"},
+			dataType:    schema.DataTypeObject,
+			expectedErr: "invalid int nested property 'address.zip'",
+		},
+		{
+			name: "wrong type of deeply nested property",
+			val: map[string]interface{}{
+				"pets": []interface{}{
+					map[string]interface{}{"kind": "cat"},
+					map[string]interface{}{"kind": 7},
+				},
+			},
+			dataType:    schema.DataTypeObject,
+			expectedErr: "invalid text nested property 'address.pets[1].kind'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := nestedVal("address", test.val, test.dataType, nestedProps)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
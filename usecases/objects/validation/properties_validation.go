@@ -82,9 +82,22 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 			return err
 		}
 
-		data, err := v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
-		if err != nil {
-			return err
+		var data interface{}
+		if _, ok := schema.AsNested(dataType.PropString()); ok {
+			prop, err := schema.GetPropertyByName(class, propertyKeyLowerCase)
+			if err != nil {
+				return err
+			}
+			data, err = nestedVal(propertyKeyLowerCase, propertyValue, *dataType, prop.NestedProperties)
+			if err != nil {
+				return fmt.Errorf("invalid %s property '%s' on class '%s': %s",
+					*dataType, propertyKeyLowerCase, className, err)
+			}
+		} else {
+			data, err = v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
+			if err != nil {
+				return err
+			}
 		}
 
 		returnSchema[propertyKeyLowerCase] = data
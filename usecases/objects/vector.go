@@ -18,11 +18,16 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/search"
 )
 
+// updateRefVector recalculates the vector of an object whose class is
+// vectorized by a ref2vec module. If the object changed by a single reference,
+// the module may apply the change to the current vector instead of finding all
+// referenced objects again.
 func (m *Manager) updateRefVector(ctx context.Context, principal *models.Principal,
-	className string, id strfmt.UUID,
+	className string, id strfmt.UUID, change *modulecapabilities.ReferenceChange,
 ) error {
 	if m.modulesProvider.UsingRef2Vec(className) {
 		parent, err := m.vectorRepo.Object(ctx, className, id,
@@ -38,10 +43,22 @@ func (m *Manager) updateRefVector(ctx context.Context, principal *models.Princip
 		if err != nil {
 			return err
 		}
-		if err := m.modulesProvider.UpdateVector(
-			ctx, obj, class, nil, m.findObject, m.logger); err != nil {
-			return fmt.Errorf("calculate ref vector for '%s/%s': %w",
-				className, id, err)
+		updated := false
+		if change != nil {
+			updated, err = m.modulesProvider.UpdateReferenceVector(
+				ctx, obj, class, *change, m.findObject)
+			if err != nil {
+				return fmt.Errorf("update ref vector for '%s/%s': %w",
+					className, id, err)
+			}
+		}
+
+		if !updated {
+			if err := m.modulesProvider.UpdateVector(
+				ctx, obj, class, nil, m.findObject, m.logger); err != nil {
+				return fmt.Errorf("calculate ref vector for '%s/%s': %w",
+					className, id, err)
+			}
 		}
 
 		if err := m.vectorRepo.PutObject(ctx, obj, obj.Vector, nil); err != nil {
@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package revectorization
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeAuthorizer struct{}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+type fakeSchemaManager struct {
+	sync.Mutex
+	class *models.Class
+}
+
+func (f *fakeSchemaManager) GetClass(ctx context.Context, principal *models.Principal,
+	name string,
+) (*models.Class, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.class == nil || f.class.Class != name {
+		return nil, nil
+	}
+	return f.class, nil
+}
+
+func (f *fakeSchemaManager) ClassWithModuleConfig(ctx context.Context, principal *models.Principal,
+	className string, moduleConfig interface{},
+) (*models.Class, error) {
+	class, _ := f.GetClass(ctx, principal, className)
+	if class == nil {
+		return nil, fmt.Errorf("class %s not found", className)
+	}
+
+	updated := *class
+	updated.ModuleConfig = moduleConfig
+	return &updated, nil
+}
+
+func (f *fakeSchemaManager) UpdateClassModuleConfig(ctx context.Context, principal *models.Principal,
+	className string, moduleConfig interface{},
+) error {
+	f.Lock()
+	defer f.Unlock()
+
+	updated := *f.class
+	updated.ModuleConfig = moduleConfig
+	f.class = &updated
+	return nil
+}
+
+func (f *fakeSchemaManager) moduleConfig() interface{} {
+	f.Lock()
+	defer f.Unlock()
+
+	return f.class.ModuleConfig
+}
+
+type fakeRepo struct {
+	sync.Mutex
+	db map[string]models.Revectorization
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{db: map[string]models.Revectorization{}}
+}
+
+func (f *fakeRepo) Put(ctx context.Context, revectorization models.Revectorization) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.db[revectorization.Class] = revectorization
+	return nil
+}
+
+func (f *fakeRepo) Get(ctx context.Context, className string) (*models.Revectorization, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	r, ok := f.db[className]
+	if !ok {
+		return nil, nil
+	}
+	return &r, nil
+}
+
+// fakeVectorRepo holds the objects of a single class sorted by id
+type fakeVectorRepo struct {
+	sync.Mutex
+	objects  []*models.Object
+	vectors  map[strfmt.UUID][]float32
+	begun    int
+	finished int
+	// putErr is returned when putting the vector of this object
+	putErr strfmt.UUID
+}
+
+func newFakeVectorRepo(objects ...*models.Object) *fakeVectorRepo {
+	sort.Slice(objects, func(a, b int) bool {
+		return objects[a].ID < objects[b].ID
+	})
+	return &fakeVectorRepo{
+		objects: objects,
+		vectors: map[strfmt.UUID][]float32{},
+	}
+}
+
+func (f *fakeVectorRepo) Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var out search.Results
+	for _, obj := range f.objects {
+		if string(obj.ID) <= q.Cursor.After {
+			continue
+		}
+		if len(out) == q.Limit {
+			break
+		}
+		out = append(out, search.Result{
+			ID:        obj.ID,
+			ClassName: obj.Class,
+			Schema:    obj.Properties,
+			Vector:    obj.Vector,
+		})
+	}
+	return out, nil
+}
+
+func (f *fakeVectorRepo) Object(ctx context.Context, class string, id strfmt.UUID,
+	props search.SelectProperties, addl additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
+) (*search.Result, error) {
+	return nil, nil
+}
+
+func (f *fakeVectorRepo) BeginRevectorize(ctx context.Context, class string) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.begun++
+	return nil
+}
+
+func (f *fakeVectorRepo) PutRevectorized(ctx context.Context, class string,
+	id strfmt.UUID, vector []float32,
+) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if id == f.putErr {
+		return fmt.Errorf("disk full")
+	}
+	f.vectors[id] = vector
+	return nil
+}
+
+func (f *fakeVectorRepo) FinishRevectorize(ctx context.Context, class string) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.finished++
+	return nil
+}
+
+// fakeModulesProvider vectorizes each object to the length of its id and the
+// model of the module config of the class
+type fakeModulesProvider struct{}
+
+func (f *fakeModulesProvider) UpdateVector(ctx context.Context, object *models.Object,
+	class *models.Class, objectDiff *moduletools.ObjectDiff,
+	repo modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
+) error {
+	if object.Vector != nil {
+		return fmt.Errorf("object %s was not cleared before vectorizing", object.ID)
+	}
+
+	model := float32(0)
+	if cfg, ok := class.ModuleConfig.(map[string]interface{}); ok {
+		model, _ = cfg["model"].(float32)
+	}
+	object.Vector = []float32{float32(len(object.ID)), model}
+	return nil
+}
@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package revectorization
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+// Revectorizer re-runs the vectorizer of a class over all of its objects,
+// optionally with a different module config. The new vectors are collected
+// in a separate vector index, which replaces the current one once all
+// objects are done. The class does not accept writes in the meantime.
+//
+// Only a single revectorization can run per class. Its progress is
+// persisted, so that an interrupted or failed revectorization can be resumed
+// by scheduling it again.
+type Revectorizer struct {
+	schemaManager   schemaManager
+	repo            Repo
+	vectorRepo      vectorRepo
+	authorizer      authorizer
+	modulesProvider ModulesProvider
+	logger          logrus.FieldLogger
+
+	sync.Mutex
+	running map[string]struct{}
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaManager interface {
+	GetClass(ctx context.Context, principal *models.Principal,
+		name string) (*models.Class, error)
+	ClassWithModuleConfig(ctx context.Context, principal *models.Principal,
+		className string, moduleConfig interface{}) (*models.Class, error)
+	UpdateClassModuleConfig(ctx context.Context, principal *models.Principal,
+		className string, moduleConfig interface{}) error
+}
+
+type ModulesProvider interface {
+	UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
+		objectDiff *moduletools.ObjectDiff, repo modulecapabilities.FindObjectFn,
+		logger logrus.FieldLogger) error
+}
+
+// Repo to manage revectorization state, there is at most one revectorization
+// per class
+type Repo interface {
+	Put(ctx context.Context, revectorization models.Revectorization) error
+	Get(ctx context.Context, className string) (*models.Revectorization, error)
+}
+
+type vectorRepo interface {
+	Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error)
+	Object(ctx context.Context, class string, id strfmt.UUID,
+		props search.SelectProperties, addl additional.Properties,
+		repl *additional.ReplicationProperties, tenant string) (*search.Result, error)
+	BeginRevectorize(ctx context.Context, class string) error
+	PutRevectorized(ctx context.Context, class string, id strfmt.UUID,
+		vector []float32) error
+	FinishRevectorize(ctx context.Context, class string) error
+}
+
+func New(sm schemaManager, repo Repo, vr vectorRepo, authorizer authorizer,
+	logger logrus.FieldLogger, modulesProvider ModulesProvider,
+) *Revectorizer {
+	return &Revectorizer{
+		schemaManager:   sm,
+		repo:            repo,
+		vectorRepo:      vr,
+		authorizer:      authorizer,
+		modulesProvider: modulesProvider,
+		logger:          logger,
+		running:         map[string]struct{}{},
+	}
+}
+
+// Schedule starts the revectorization of a class in the background. If a
+// previous revectorization of the class did not complete, it is resumed with
+// its module config after the last object it revectorized.
+func (r *Revectorizer) Schedule(ctx context.Context, principal *models.Principal,
+	className string, params models.Revectorization,
+) (*models.Revectorization, error) {
+	err := r.authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	if params.ObjectsPerSecond < 0 {
+		return nil, fmt.Errorf("objectsPerSecond must not be negative, got %d",
+			params.ObjectsPerSecond)
+	}
+
+	class, err := r.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return nil, err
+	}
+	if class == nil {
+		return nil, schemaUC.ErrNotFound
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.running[className]; ok {
+		return nil, fmt.Errorf("class %s is already being revectorized", className)
+	}
+
+	previous, err := r.repo.Get(ctx, className)
+	if err != nil {
+		return nil, fmt.Errorf("revectorization: get: %w", err)
+	}
+
+	job := models.Revectorization{
+		Class:            className,
+		ModuleConfig:     params.ModuleConfig,
+		ObjectsPerSecond: params.ObjectsPerSecond,
+		Status:           models.RevectorizationStatusRunning,
+		Meta: &models.RevectorizationMeta{
+			Started: strfmt.DateTime(time.Now()),
+		},
+	}
+	if job.ModuleConfig == nil {
+		job.ModuleConfig = class.ModuleConfig
+	}
+
+	if previous != nil && previous.Status != models.RevectorizationStatusCompleted {
+		// the objects which were revectorized so far must not end up with
+		// vectors of a different module config
+		job.ModuleConfig = previous.ModuleConfig
+		job.After = previous.After
+		if previous.Meta != nil {
+			job.Meta.Started = previous.Meta.Started
+			job.Meta.Count = previous.Meta.Count
+		}
+	}
+
+	target, err := r.schemaManager.ClassWithModuleConfig(ctx, principal,
+		className, job.ModuleConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(target.VectorConfig) > 0 {
+		return nil, fmt.Errorf("class %s has named vectors, "+
+			"which are not supported by revectorization", className)
+	}
+	if target.Vectorizer == config.VectorizerModuleNone {
+		return nil, fmt.Errorf("class %s has no vectorizer", className)
+	}
+
+	if err := r.repo.Put(ctx, job); err != nil {
+		return nil, fmt.Errorf("revectorization: put: %w", err)
+	}
+
+	r.running[className] = struct{}{}
+	go r.run(principal, job, target)
+
+	return &job, nil
+}
+
+// Get returns the state of the latest revectorization of a class or nil if
+// it was never revectorized
+func (r *Revectorizer) Get(ctx context.Context, principal *models.Principal,
+	className string,
+) (*models.Revectorization, error) {
+	err := r.authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := r.repo.Get(ctx, className)
+	if err != nil || job == nil {
+		return job, err
+	}
+
+	r.Lock()
+	_, ok := r.running[className]
+	r.Unlock()
+
+	// the process was restarted while the revectorization was running
+	if job.Status == models.RevectorizationStatusRunning && !ok {
+		job.Status = models.RevectorizationStatusInterrupted
+	}
+
+	return job, nil
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package revectorization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// batchSize is the number of objects which are read at once and after which
+// the progress is persisted
+const batchSize = 100
+
+func (r *Revectorizer) run(principal *models.Principal,
+	job models.Revectorization, class *models.Class,
+) {
+	ctx := context.Background()
+
+	r.logger.WithField("action", "revectorize_start").
+		WithField("class", job.Class).
+		WithField("after", job.After).
+		Info("revectorization started")
+
+	if err := r.revectorize(ctx, principal, &job, class); err != nil {
+		r.logger.WithError(err).WithField("action", "revectorize_failed").
+			WithField("class", job.Class).
+			Error("revectorization failed")
+
+		job.Status = models.RevectorizationStatusFailed
+		job.Error = err.Error()
+	} else {
+		r.logger.WithField("action", "revectorize_complete").
+			WithField("class", job.Class).
+			WithField("count", job.Meta.Count).
+			Info("revectorization completed")
+
+		job.Status = models.RevectorizationStatusCompleted
+		job.Meta.Completed = strfmt.DateTime(time.Now())
+	}
+
+	// the final state is stored under lock, so that the revectorization can
+	// neither be reported as interrupted nor be scheduled again in between
+	r.Lock()
+	defer r.Unlock()
+
+	delete(r.running, job.Class)
+	if err := r.repo.Put(ctx, job); err != nil {
+		r.logger.WithError(err).WithField("action", "revectorize_done").
+			WithField("class", job.Class).
+			Error("could not store revectorization state")
+	}
+}
+
+func (r *Revectorizer) revectorize(ctx context.Context, principal *models.Principal,
+	job *models.Revectorization, class *models.Class,
+) error {
+	if err := r.vectorRepo.BeginRevectorize(ctx, job.Class); err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+
+	var interval time.Duration
+	if job.ObjectsPerSecond > 0 {
+		interval = time.Second / time.Duration(job.ObjectsPerSecond)
+	}
+	next := time.Now()
+
+	for {
+		res, qerr := r.vectorRepo.Query(ctx, &objects.QueryInput{
+			Class:  job.Class,
+			Limit:  batchSize,
+			Cursor: &filters.Cursor{After: string(job.After), Limit: batchSize},
+		})
+		if qerr != nil {
+			return fmt.Errorf("read objects after %q: %w", job.After, qerr)
+		}
+		if len(res) == 0 {
+			break
+		}
+
+		for _, item := range res {
+			if interval > 0 {
+				time.Sleep(time.Until(next))
+				next = time.Now().Add(interval)
+			}
+
+			obj := item.ObjectWithVector(false)
+			if err := r.modulesProvider.UpdateVector(ctx, obj, class, nil,
+				r.findObject, r.logger); err != nil {
+				return fmt.Errorf("vectorize object %s: %w", obj.ID, err)
+			}
+			if err := r.vectorRepo.PutRevectorized(ctx, job.Class, obj.ID,
+				obj.Vector); err != nil {
+				return fmt.Errorf("put vector of object %s: %w", obj.ID, err)
+			}
+		}
+
+		job.After = res[len(res)-1].ID
+		job.Meta.Count += int64(len(res))
+		if err := r.repo.Put(ctx, *job); err != nil {
+			return fmt.Errorf("store progress: %w", err)
+		}
+	}
+
+	// the module config is updated first, so that a failure in between leaves
+	// a revectorization behind which can be resumed and finished
+	if err := r.schemaManager.UpdateClassModuleConfig(ctx, principal,
+		job.Class, job.ModuleConfig); err != nil {
+		return fmt.Errorf("update module config: %w", err)
+	}
+
+	if err := r.vectorRepo.FinishRevectorize(ctx, job.Class); err != nil {
+		return fmt.Errorf("finish: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Revectorizer) findObject(ctx context.Context, class string,
+	id strfmt.UUID, props search.SelectProperties, addl additional.Properties,
+	tenant string,
+) (*search.Result, error) {
+	return r.vectorRepo.Object(ctx, class, id, props, addl, nil, tenant)
+}
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package revectorization
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	testhelper "github.com/weaviate/weaviate/test/helper"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+func newNullLogger() *logrus.Logger {
+	log, _ := test.NewNullLogger()
+	return log
+}
+
+func testClass() *models.Class {
+	return &models.Class{
+		Class:        "Article",
+		Vectorizer:   "text2vec-contextionary",
+		ModuleConfig: map[string]interface{}{"model": float32(1)},
+	}
+}
+
+func testObjects(count int) []*models.Object {
+	objects := make([]*models.Object, count)
+	for i := range objects {
+		objects[i] = &models.Object{
+			Class:  "Article",
+			ID:     strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i)),
+			Vector: []float32{-1, -1},
+		}
+	}
+	return objects
+}
+
+func Test_Revectorizer_InvalidInput(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("with a negative rate", func(t *testing.T) {
+		sm := &fakeSchemaManager{class: testClass()}
+		r := New(sm, newFakeRepo(), newFakeVectorRepo(), &fakeAuthorizer{},
+			newNullLogger(), &fakeModulesProvider{})
+		_, err := r.Schedule(ctx, nil, "Article",
+			models.Revectorization{ObjectsPerSecond: -1})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a class that does not exist", func(t *testing.T) {
+		sm := &fakeSchemaManager{class: testClass()}
+		r := New(sm, newFakeRepo(), newFakeVectorRepo(), &fakeAuthorizer{},
+			newNullLogger(), &fakeModulesProvider{})
+		_, err := r.Schedule(ctx, nil, "Unknown", models.Revectorization{})
+		assert.Equal(t, schemaUC.ErrNotFound, err)
+	})
+
+	t.Run("with a class without vectorizer", func(t *testing.T) {
+		class := testClass()
+		class.Vectorizer = "none"
+		sm := &fakeSchemaManager{class: class}
+		r := New(sm, newFakeRepo(), newFakeVectorRepo(), &fakeAuthorizer{},
+			newNullLogger(), &fakeModulesProvider{})
+		_, err := r.Schedule(ctx, nil, "Article", models.Revectorization{})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a class with named vectors", func(t *testing.T) {
+		class := testClass()
+		class.VectorConfig = map[string]models.VectorConfig{"title": {}}
+		sm := &fakeSchemaManager{class: class}
+		r := New(sm, newFakeRepo(), newFakeVectorRepo(), &fakeAuthorizer{},
+			newNullLogger(), &fakeModulesProvider{})
+		_, err := r.Schedule(ctx, nil, "Article", models.Revectorization{})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_Revectorizer(t *testing.T) {
+	ctx := context.Background()
+	sm := &fakeSchemaManager{class: testClass()}
+	repo := newFakeRepo()
+	objects := testObjects(250)
+	vectorRepo := newFakeVectorRepo(objects...)
+	r := New(sm, repo, vectorRepo, &fakeAuthorizer{}, newNullLogger(),
+		&fakeModulesProvider{})
+
+	t.Run("before any revectorization", func(t *testing.T) {
+		res, err := r.Get(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("fail in the second batch", func(t *testing.T) {
+		vectorRepo.putErr = objects[150].ID
+
+		res, err := r.Schedule(ctx, nil, "Article", models.Revectorization{
+			ModuleConfig: map[string]interface{}{"model": float32(2)},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, models.RevectorizationStatusRunning, res.Status)
+
+		waitForStatusToNoLongerBeRunning(t, r, "Article")
+
+		res, err = r.Get(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, models.RevectorizationStatusFailed, res.Status)
+		assert.Contains(t, res.Error, "disk full")
+		assert.Equal(t, objects[99].ID, res.After)
+		assert.Equal(t, int64(100), res.Meta.Count)
+		assert.Equal(t, 0, vectorRepo.finished)
+		assert.Equal(t, float32(1), sm.moduleConfig().(map[string]interface{})["model"])
+	})
+
+	t.Run("resume with the previous module config", func(t *testing.T) {
+		vectorRepo.putErr = ""
+
+		res, err := r.Schedule(ctx, nil, "Article", models.Revectorization{
+			ModuleConfig: map[string]interface{}{"model": float32(3)},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, objects[99].ID, res.After)
+
+		waitForStatusToNoLongerBeRunning(t, r, "Article")
+
+		res, err = r.Get(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, models.RevectorizationStatusCompleted, res.Status)
+		assert.Equal(t, objects[249].ID, res.After)
+		assert.Equal(t, int64(250), res.Meta.Count)
+		assert.Equal(t, 2, vectorRepo.begun)
+		assert.Equal(t, 1, vectorRepo.finished)
+		assert.Equal(t, float32(2), sm.moduleConfig().(map[string]interface{})["model"])
+
+		require.Len(t, vectorRepo.vectors, 250)
+		for _, obj := range objects {
+			assert.Equal(t, []float32{36, 2}, vectorRepo.vectors[obj.ID])
+		}
+	})
+}
+
+func Test_Revectorizer_RateLimit(t *testing.T) {
+	sm := &fakeSchemaManager{class: testClass()}
+	vectorRepo := newFakeVectorRepo(testObjects(10)...)
+	r := New(sm, newFakeRepo(), vectorRepo, &fakeAuthorizer{}, newNullLogger(),
+		&fakeModulesProvider{})
+
+	before := time.Now()
+	_, err := r.Schedule(context.Background(), nil, "Article",
+		models.Revectorization{ObjectsPerSecond: 50})
+	require.Nil(t, err)
+
+	waitForStatusToNoLongerBeRunning(t, r, "Article")
+	assert.GreaterOrEqual(t, time.Since(before), 9*20*time.Millisecond)
+	assert.Len(t, vectorRepo.vectors, 10)
+}
+
+func Test_Revectorizer_Interrupted(t *testing.T) {
+	sm := &fakeSchemaManager{class: testClass()}
+	repo := newFakeRepo()
+	repo.Put(context.Background(), models.Revectorization{
+		Class:  "Article",
+		Status: models.RevectorizationStatusRunning,
+	})
+	r := New(sm, repo, newFakeVectorRepo(), &fakeAuthorizer{}, newNullLogger(),
+		&fakeModulesProvider{})
+
+	res, err := r.Get(context.Background(), nil, "Article")
+	require.Nil(t, err)
+	assert.Equal(t, models.RevectorizationStatusInterrupted, res.Status)
+}
+
+func waitForStatusToNoLongerBeRunning(t *testing.T, r *Revectorizer, className string) {
+	testhelper.AssertEventuallyEqualWithFrequencyAndTimeout(t, true, func() interface{} {
+		res, err := r.Get(context.Background(), nil, className)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		return res.Status != models.RevectorizationStatusRunning
+	}, 10*time.Millisecond, 20*time.Second, "wait until status in no longer running")
+}
@@ -31,6 +31,9 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/multivector"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -236,16 +239,16 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 		class.Vectorizer = m.config.DefaultVectorizerModule
 	}
 
-	if class.VectorIndexType == "" {
-		class.VectorIndexType = "hnsw"
-	}
+	class.VectorIndexType, class.VectorIndexConfig = m.vectorIndexDefaults(
+		class.VectorIndexType, class.VectorIndexConfig)
 
-	if m.config.DefaultVectorDistanceMetric != "" {
-		if class.VectorIndexConfig == nil {
-			class.VectorIndexConfig = map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
-		} else if class.VectorIndexConfig.(map[string]interface{})["distance"] == nil {
-			class.VectorIndexConfig.(map[string]interface{})["distance"] = m.config.DefaultVectorDistanceMetric
+	for name, cfg := range class.VectorConfig {
+		if cfg.Vectorizer == nil {
+			cfg.Vectorizer = map[string]interface{}{config.VectorizerModuleNone: map[string]interface{}{}}
 		}
+		cfg.VectorIndexType, cfg.VectorIndexConfig = m.vectorIndexDefaults(
+			cfg.VectorIndexType, cfg.VectorIndexConfig)
+		class.VectorConfig[name] = cfg
 	}
 
 	setInvertedConfigDefaults(class)
@@ -256,9 +259,59 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 	m.moduleConfig.SetClassDefaults(class)
 }
 
+// vectorIndexDefaults returns the index type and config with the defaults
+// applied, it is used for the class vector as well as for named vectors
+func (m *Manager) vectorIndexDefaults(indexType string,
+	indexConfig interface{},
+) (string, interface{}) {
+	if indexType == "" {
+		indexType = "hnsw"
+	}
+
+	// the default distance metric only applies to index types comparing single
+	// vectors, the distance of other index types is determined by the way they
+	// represent objects
+	if m.config.DefaultVectorDistanceMetric != "" &&
+		(indexType == "hnsw" || indexType == flat.IndexType ||
+			indexType == dynamic.IndexType) {
+		if indexConfig == nil {
+			indexConfig = map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
+		} else if asMap, ok := indexConfig.(map[string]interface{}); ok && asMap["distance"] == nil {
+			asMap["distance"] = m.config.DefaultVectorDistanceMetric
+		}
+	}
+
+	return indexType, indexConfig
+}
+
 func setPropertyDefaults(prop *models.Property) {
 	setPropertyDefaultTokenization(prop)
 	setPropertyDefaultIndexing(prop)
+	setNestedPropertiesDefaults(prop.NestedProperties)
+}
+
+// setNestedPropertiesDefaults applies the defaults of properties to the
+// nested properties on all levels
+func setNestedPropertiesDefaults(nestedProps []*models.NestedProperty) {
+	for _, np := range nestedProps {
+		if np == nil {
+			continue
+		}
+
+		prop := &models.Property{
+			DataType:        np.DataType,
+			Tokenization:    np.Tokenization,
+			IndexFilterable: np.IndexFilterable,
+			IndexSearchable: np.IndexSearchable,
+		}
+		setPropertyDefaultTokenization(prop)
+		setPropertyDefaultIndexing(prop)
+		np.Tokenization = prop.Tokenization
+		np.IndexFilterable = prop.IndexFilterable
+		np.IndexSearchable = prop.IndexSearchable
+
+		setNestedPropertiesDefaults(np.NestedProperties)
+	}
 }
 
 func setPropertyDefaultTokenization(prop *models.Property) {
@@ -300,6 +353,11 @@ func setPropertyDefaultIndexing(prop *models.Property) {
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			prop.IndexSearchable = &vTrue
 		default:
+			if schema.IsNestedDataType(prop.DataType) {
+				// objects pass the searchable index on to their nested text properties
+				prop.IndexSearchable = &vTrue
+				break
+			}
 			vFalse := false
 			prop.IndexSearchable = &vFalse
 		}
@@ -427,10 +485,18 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if err := m.validatePropertyStemmer(property, propertyDataType); err != nil {
+		return err
+	}
+
 	if err := m.validatePropertyIndexing(property); err != nil {
 		return err
 	}
 
+	if err := m.validateNestedProperties(property, propertyDataType); err != nil {
+		return fmt.Errorf("property '%s': %w", property.Name, err)
+	}
+
 	// all is fine!
 	return nil
 }
@@ -438,22 +504,52 @@ func (m *Manager) validateProperty(
 func (m *Manager) parseVectorIndexConfig(ctx context.Context,
 	class *models.Class,
 ) error {
-	if class.VectorIndexType != "hnsw" {
-		return errors.Errorf(
-			"parse vector index config: unsupported vector index type: %q",
-			class.VectorIndexType)
-	}
-
-	parsed, err := m.hnswConfigParser(class.VectorIndexConfig)
+	parsed, err := m.parseGivenVectorIndexConfig(class.VectorIndexType,
+		class.VectorIndexConfig)
 	if err != nil {
-		return errors.Wrap(err, "parse vector index config")
+		return err
 	}
-
 	class.VectorIndexConfig = parsed
 
+	for name, cfg := range class.VectorConfig {
+		parsed, err := m.parseGivenVectorIndexConfig(cfg.VectorIndexType,
+			cfg.VectorIndexConfig)
+		if err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+		cfg.VectorIndexConfig = parsed
+		class.VectorConfig[name] = cfg
+	}
+
 	return nil
 }
 
+func (m *Manager) parseGivenVectorIndexConfig(indexType string,
+	indexConfig interface{},
+) (schema.VectorIndexConfig, error) {
+	var parsed schema.VectorIndexConfig
+	var err error
+	switch indexType {
+	case "hnsw":
+		parsed, err = m.hnswConfigParser(indexConfig)
+	case multivector.IndexType:
+		parsed, err = multivector.ParseAndValidateConfig(indexConfig)
+	case flat.IndexType:
+		parsed, err = flat.ParseAndValidateConfig(indexConfig)
+	case dynamic.IndexType:
+		parsed, err = dynamic.ParseAndValidateConfig(indexConfig)
+	default:
+		return nil, errors.Errorf(
+			"parse vector index config: unsupported vector index type: %q",
+			indexType)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "parse vector index config")
+	}
+
+	return parsed, nil
+}
+
 func (m *Manager) parseShardingConfig(ctx context.Context, class *models.Class) (err error) {
 	// multiTenancyConfig and shardingConfig are mutually exclusive
 	cfg := sharding.Config{} // cfg is empty in case of MT
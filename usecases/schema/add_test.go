@@ -13,6 +13,7 @@ package schema
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -23,6 +24,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/multivector"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -129,6 +133,81 @@ func TestAddClass(t *testing.T) {
 		require.Equal(t, expectedStopwordConfig, mgr.schemaCache.ObjectSchema.Classes[0].InvertedIndexConfig.Stopwords)
 	})
 
+	t.Run("with named vectors", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class: "NewClass",
+				VectorConfig: map[string]models.VectorConfig{
+					"title": {
+						Vectorizer: map[string]interface{}{
+							"text2vec-contextionary": map[string]interface{}{},
+						},
+					},
+					"custom": {
+						VectorIndexType: "flat",
+					},
+				},
+			})
+		require.Nil(t, err)
+
+		require.NotEmpty(t, mgr.schemaCache.ObjectSchema.Classes)
+		vectorConfig := mgr.schemaCache.ObjectSchema.Classes[0].VectorConfig
+		require.Len(t, vectorConfig, 2)
+		assert.Equal(t, "hnsw", vectorConfig["title"].VectorIndexType)
+		assert.Equal(t, map[string]interface{}{"text2vec-contextionary": map[string]interface{}{}},
+			vectorConfig["title"].Vectorizer)
+		assert.Equal(t, "flat", vectorConfig["custom"].VectorIndexType)
+		assert.Equal(t, map[string]interface{}{"none": map[string]interface{}{}},
+			vectorConfig["custom"].Vectorizer)
+	})
+
+	t.Run("with invalid named vectors", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			vectorConfig map[string]models.VectorConfig
+			expectedErr  string
+		}{
+			{
+				name:         "invalid name",
+				vectorConfig: map[string]models.VectorConfig{"1title": {}},
+				expectedErr:  "named vector \"1title\"",
+			},
+			{
+				name: "unknown vectorizer",
+				vectorConfig: map[string]models.VectorConfig{"title": {
+					Vectorizer: map[string]interface{}{"unknown-module": map[string]interface{}{}},
+				}},
+				expectedErr: "invalid vectorizer \"unknown-module\"",
+			},
+			{
+				name: "multiple vectorizers",
+				vectorConfig: map[string]models.VectorConfig{"title": {
+					Vectorizer: map[string]interface{}{
+						"model1": map[string]interface{}{},
+						"model2": map[string]interface{}{},
+					},
+				}},
+				expectedErr: "named vector \"title\"",
+			},
+			{
+				name:         "unsupported index type",
+				vectorConfig: map[string]models.VectorConfig{"title": {VectorIndexType: "dynamic"}},
+				expectedErr:  "unsupported vectorIndexType \"dynamic\"",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				err := newSchemaManager().AddClass(context.Background(),
+					nil, &models.Class{Class: "NewClass", VectorConfig: test.vectorConfig})
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			})
+		}
+	})
+
 	t.Run("with tokenizations", func(t *testing.T) {
 		type testCase struct {
 			propName       string
@@ -357,6 +436,224 @@ func TestAddClass(t *testing.T) {
 		require.Equal(t, expected, mgr.schemaCache.ObjectSchema.Classes[0].VectorIndexConfig)
 	})
 
+	t.Run("with multivector index", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		expected := multivector.UserConfig{
+			Distance:        multivector.DistanceMaxSim,
+			TokenDimensions: 96,
+			Muvera:          multivector.NewDefaultUserConfig().Muvera,
+		}
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class:           "NewClass",
+				VectorIndexType: multivector.IndexType,
+				VectorIndexConfig: map[string]interface{}{
+					"tokenDimensions": json.Number("96"),
+				},
+			})
+		require.Nil(t, err)
+
+		require.NotNil(t, mgr.schemaCache.ObjectSchema)
+		require.NotEmpty(t, mgr.schemaCache.ObjectSchema.Classes)
+		require.Equal(t, "NewClass", mgr.schemaCache.ObjectSchema.Classes[0].Class)
+		require.Equal(t, expected, mgr.schemaCache.ObjectSchema.Classes[0].VectorIndexConfig)
+	})
+
+	t.Run("with flat index", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		expected := flat.UserConfig{
+			Distance: "dot",
+			BQ: flat.BQConfig{
+				Enabled:      true,
+				RescoreLimit: flat.DefaultBQRescoreLimit,
+			},
+		}
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class:           "NewClass",
+				VectorIndexType: flat.IndexType,
+				VectorIndexConfig: map[string]interface{}{
+					"distance": "dot",
+					"bq": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			})
+		require.Nil(t, err)
+
+		require.NotNil(t, mgr.schemaCache.ObjectSchema)
+		require.NotEmpty(t, mgr.schemaCache.ObjectSchema.Classes)
+		require.Equal(t, "NewClass", mgr.schemaCache.ObjectSchema.Classes[0].Class)
+		require.Equal(t, expected, mgr.schemaCache.ObjectSchema.Classes[0].VectorIndexConfig)
+	})
+
+	t.Run("with dynamic index", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class:           "NewClass",
+				VectorIndexType: dynamic.IndexType,
+				VectorIndexConfig: map[string]interface{}{
+					"distance":  "dot",
+					"threshold": json.Number("2000"),
+				},
+			})
+		require.Nil(t, err)
+
+		require.NotNil(t, mgr.schemaCache.ObjectSchema)
+		require.NotEmpty(t, mgr.schemaCache.ObjectSchema.Classes)
+		parsed, ok := mgr.schemaCache.ObjectSchema.Classes[0].VectorIndexConfig.(dynamic.UserConfig)
+		require.True(t, ok)
+		assert.Equal(t, 2000, parsed.Threshold)
+		assert.Equal(t, "dot", parsed.HNSW.Distance)
+		assert.Equal(t, "dot", parsed.Flat.Distance)
+	})
+
+	t.Run("with unsupported vector index type", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class:           "NewClass",
+				VectorIndexType: "ivf",
+			})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unrecognized or unsupported vectorIndexType \"ivf\"")
+	})
+
+	t.Run("with nested properties", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class: "NewClass",
+				Properties: []*models.Property{
+					{
+						Name:     "address",
+						DataType: schema.DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "city", DataType: schema.DataTypeText.PropString()},
+							{
+								Name:     "geo",
+								DataType: schema.DataTypeObjectArray.PropString(),
+								NestedProperties: []*models.NestedProperty{
+									{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+								},
+							},
+						},
+					},
+				},
+			})
+		require.Nil(t, err)
+
+		class := mgr.getClassByName("NewClass")
+		require.NotNil(t, class)
+		address := class.Properties[0]
+		require.NotNil(t, address.IndexFilterable)
+		assert.True(t, *address.IndexFilterable)
+		city := address.NestedProperties[0]
+		assert.Equal(t, models.PropertyTokenizationWord, city.Tokenization)
+		require.NotNil(t, city.IndexSearchable)
+		assert.True(t, *city.IndexSearchable)
+		zip := address.NestedProperties[1].NestedProperties[0]
+		assert.Empty(t, zip.Tokenization)
+		require.NotNil(t, zip.IndexSearchable)
+		assert.False(t, *zip.IndexSearchable)
+	})
+
+	t.Run("with invalid nested properties", func(t *testing.T) {
+		vTrue := true
+		tests := []struct {
+			name        string
+			prop        *models.Property
+			expectedErr string
+		}{
+			{
+				name: "object without nested properties",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+				},
+				expectedErr: "require at least one nested property",
+			},
+			{
+				name: "nested properties on a primitive property",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeText.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "city", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				expectedErr: "only allowed for object and object[] data types",
+			},
+			{
+				name: "duplicate nested property",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "city", DataType: schema.DataTypeText.PropString()},
+						{Name: "city", DataType: schema.DataTypeInt.PropString()},
+					},
+				},
+				expectedErr: "conflict for nested property \"city\"",
+			},
+			{
+				name: "unsupported nested data type",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+					},
+				},
+				expectedErr: "not supported for nested properties",
+			},
+			{
+				name: "tokenization of a nested int",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{
+							Name:         "zip",
+							DataType:     schema.DataTypeInt.PropString(),
+							Tokenization: models.PropertyTokenizationWord,
+						},
+					},
+				},
+				expectedErr: "nested property 'zip': Tokenization is not allowed",
+			},
+			{
+				name: "null state index of an object",
+				prop: &models.Property{
+					Name:           "address",
+					DataType:       schema.DataTypeObject.PropString(),
+					IndexNullState: &vTrue,
+					NestedProperties: []*models.NestedProperty{
+						{Name: "city", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				expectedErr: "`indexNullState` is not allowed for object data type",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				err := newSchemaManager().AddClass(context.Background(),
+					nil, &models.Class{Class: "NewClass", Properties: []*models.Property{test.prop}})
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			})
+		}
+	})
+
 	t.Run("with two identical prop names", func(t *testing.T) {
 		mgr := newSchemaManager()
 
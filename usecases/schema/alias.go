@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+// aliasesPath is the main path used for authorization
+const aliasesPath = "schema/aliases"
+
+// GetAliases returns all aliases sorted by name
+func (m *Manager) GetAliases(ctx context.Context, principal *models.Principal) ([]*models.Alias, error) {
+	if err := m.Authorizer.Authorize(principal, "list", aliasesPath); err != nil {
+		return nil, err
+	}
+
+	var aliases []*models.Alias
+	m.schemaCache.RLockGuard(func() error {
+		aliases = make([]*models.Alias, 0, len(m.schemaCache.Aliases))
+		for alias, class := range m.schemaCache.Aliases {
+			aliases = append(aliases, &models.Alias{Alias: alias, Class: class})
+		}
+		return nil
+	})
+	sort.Slice(aliases, func(i, j int) bool {
+		return aliases[i].Alias < aliases[j].Alias
+	})
+	return aliases, nil
+}
+
+// ResolveAlias returns the class an alias points to.
+// If name is not an alias it is returned unchanged.
+func (m *Manager) ResolveAlias(name string) string {
+	m.schemaCache.RLock()
+	defer m.schemaCache.RUnlock()
+	if class, ok := m.schemaCache.Aliases[schema.UppercaseClassName(name)]; ok {
+		return class
+	}
+	return name
+}
+
+// AddAlias creates a new alias pointing to an existing class
+func (m *Manager) AddAlias(ctx context.Context, principal *models.Principal,
+	alias *models.Alias,
+) error {
+	if err := m.Authorizer.Authorize(principal, "create", aliasesPath); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	req, err := m.validateAlias(alias)
+	if err != nil {
+		return err
+	}
+	if err := m.validateClassNameUniqueness(req.Alias); err != nil {
+		return uco.NewErrInvalidUserInput("alias name: %v", err)
+	}
+
+	return m.setAlias(ctx, addAlias, req)
+}
+
+// UpdateAlias points an existing alias to another class
+func (m *Manager) UpdateAlias(ctx context.Context, principal *models.Principal,
+	alias *models.Alias,
+) error {
+	if err := m.Authorizer.Authorize(principal, "update", aliasesPath); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	req, err := m.validateAlias(alias)
+	if err != nil {
+		return err
+	}
+	if !m.aliasExists(req.Alias) {
+		return fmt.Errorf("alias %q: %w", req.Alias, ErrNotFound)
+	}
+
+	return m.setAlias(ctx, updateAlias, req)
+}
+
+// DeleteAlias removes an alias, the class it points to is left untouched
+func (m *Manager) DeleteAlias(ctx context.Context, principal *models.Principal,
+	alias string,
+) error {
+	if err := m.Authorizer.Authorize(principal, "delete", aliasesPath); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	req := DeleteAliasPayload{Alias: schema.UppercaseClassName(alias)}
+	if !m.aliasExists(req.Alias) {
+		return fmt.Errorf("alias %q: %w", req.Alias, ErrNotFound)
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, deleteAlias, req, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.onDeleteAlias(ctx, req)
+}
+
+func (m *Manager) setAlias(ctx context.Context, txType cluster.TransactionType, req AliasPayload) error {
+	tx, err := m.cluster.BeginTransaction(ctx, txType, req, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.onSetAlias(ctx, req)
+}
+
+// validateAlias checks the alias name and resolves the target class
+func (m *Manager) validateAlias(alias *models.Alias) (AliasPayload, error) {
+	if alias == nil || alias.Alias == "" || alias.Class == "" {
+		return AliasPayload{}, uco.NewErrInvalidUserInput("alias and class must be set")
+	}
+	name := schema.UppercaseClassName(alias.Alias)
+	if _, err := schema.ValidateClassName(name); err != nil {
+		return AliasPayload{}, uco.NewErrInvalidUserInput("alias name: %v", err)
+	}
+	cls := m.getClassByName(schema.UppercaseClassName(alias.Class))
+	if cls == nil {
+		return AliasPayload{}, uco.NewErrInvalidUserInput("class %q does not exist", alias.Class)
+	}
+	return AliasPayload{Alias: name, Class: cls.Class}, nil
+}
+
+func (m *Manager) aliasExists(name string) bool {
+	m.schemaCache.RLock()
+	defer m.schemaCache.RUnlock()
+	_, ok := m.schemaCache.Aliases[name]
+	return ok
+}
+
+func (m *Manager) onSetAlias(ctx context.Context, req AliasPayload) error {
+	return m.updateAliases(ctx, func(aliases map[string]string) {
+		aliases[req.Alias] = req.Class
+	})
+}
+
+func (m *Manager) onDeleteAlias(ctx context.Context, req DeleteAliasPayload) error {
+	return m.updateAliases(ctx, func(aliases map[string]string) {
+		delete(aliases, req.Alias)
+	})
+}
+
+// deleteClassAliases removes all aliases pointing to class
+func (m *Manager) deleteClassAliases(ctx context.Context, class string) error {
+	if !m.hasAliasFor(class) {
+		return nil
+	}
+	return m.updateAliases(ctx, func(aliases map[string]string) {
+		for alias, target := range aliases {
+			if strings.EqualFold(target, class) {
+				delete(aliases, alias)
+			}
+		}
+	})
+}
+
+func (m *Manager) hasAliasFor(class string) bool {
+	m.schemaCache.RLock()
+	defer m.schemaCache.RUnlock()
+	for _, target := range m.schemaCache.Aliases {
+		if strings.EqualFold(target, class) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateAliases applies mutate to a copy of the current aliases, persists the
+// result and then swaps it into the cache. Readers holding the previous map
+// are therefore never affected.
+func (m *Manager) updateAliases(ctx context.Context, mutate func(map[string]string)) error {
+	var aliases map[string]string
+	m.schemaCache.RLockGuard(func() error {
+		aliases = make(map[string]string, len(m.schemaCache.Aliases)+1)
+		for alias, class := range m.schemaCache.Aliases {
+			aliases[alias] = class
+		}
+		return nil
+	})
+	mutate(aliases)
+
+	m.logger.
+		WithField("action", "schema.update_aliases").
+		WithField("n", len(aliases)).Debug("persist schema updates")
+
+	if err := m.repo.SaveAliases(ctx, aliases); err != nil {
+		return err
+	}
+	m.schemaCache.LockGuard(func() {
+		m.schemaCache.Aliases = aliases
+	})
+	m.triggerSchemaUpdateCallbacks()
+
+	return nil
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAliases(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(t *testing.T) *Manager {
+		sm := newSchemaManager()
+		for _, name := range []string{"Movie", "Book"} {
+			err := sm.AddClass(ctx, nil, &models.Class{Class: name})
+			require.Nil(t, err)
+		}
+		return sm
+	}
+
+	t.Run("add", func(t *testing.T) {
+		sm := newManager(t)
+		require.Nil(t, sm.AddAlias(ctx, nil, &models.Alias{Alias: "film", Class: "Movie"}))
+
+		aliases, err := sm.GetAliases(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []*models.Alias{{Alias: "Film", Class: "Movie"}}, aliases)
+		assert.Equal(t, "Movie", sm.ResolveAlias("Film"))
+		assert.Equal(t, "Movie", sm.ResolveAlias("film"))
+		assert.Equal(t, "Book", sm.ResolveAlias("Book"))
+		assert.Equal(t, map[string]string{"Film": "Movie"}, sm.GetSchemaSkipAuth().Aliases)
+	})
+
+	t.Run("add invalid", func(t *testing.T) {
+		sm := newManager(t)
+		require.Nil(t, sm.AddAlias(ctx, nil, &models.Alias{Alias: "Film", Class: "Movie"}))
+
+		tests := []struct {
+			name   string
+			alias  *models.Alias
+			errMsg string
+		}{
+			{"MissingClass", &models.Alias{Alias: "Film"}, "must be set"},
+			{"UnknownClass", &models.Alias{Alias: "Show", Class: "Show2"}, "does not exist"},
+			{"InvalidName", &models.Alias{Alias: "Fi-lm", Class: "Movie"}, "alias name"},
+			{"ClassName", &models.Alias{Alias: "book", Class: "Movie"}, "already exists"},
+			{"ExistingAlias", &models.Alias{Alias: "Film", Class: "Book"}, "already exists"},
+		}
+		for _, test := range tests {
+			err := sm.AddAlias(ctx, nil, test.alias)
+			assert.ErrorContains(t, err, test.errMsg, test.name)
+		}
+	})
+
+	t.Run("class name must not collide with alias", func(t *testing.T) {
+		sm := newManager(t)
+		require.Nil(t, sm.AddAlias(ctx, nil, &models.Alias{Alias: "Film", Class: "Movie"}))
+		err := sm.AddClass(ctx, nil, &models.Class{Class: "Film"})
+		assert.ErrorContains(t, err, "already exists as an alias")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		sm := newManager(t)
+		require.Nil(t, sm.AddAlias(ctx, nil, &models.Alias{Alias: "Film", Class: "Movie"}))
+		require.Nil(t, sm.UpdateAlias(ctx, nil, &models.Alias{Alias: "Film", Class: "Book"}))
+		assert.Equal(t, "Book", sm.ResolveAlias("Film"))
+
+		err := sm.UpdateAlias(ctx, nil, &models.Alias{Alias: "Show", Class: "Book"})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		sm := newManager(t)
+		require.Nil(t, sm.AddAlias(ctx, nil, &models.Alias{Alias: "Film", Class: "Movie"}))
+		require.Nil(t, sm.DeleteAlias(ctx, nil, "Film"))
+		assert.Equal(t, "Film", sm.ResolveAlias("Film"))
+		assert.ErrorIs(t, sm.DeleteAlias(ctx, nil, "Film"), ErrNotFound)
+
+		// the class the alias pointed to is left untouched
+		assert.NotNil(t, sm.getClassByName("Movie"))
+	})
+
+	t.Run("delete class drops its aliases", func(t *testing.T) {
+		sm := newManager(t)
+		require.Nil(t, sm.AddAlias(ctx, nil, &models.Alias{Alias: "Film", Class: "Movie"}))
+		require.Nil(t, sm.AddAlias(ctx, nil, &models.Alias{Alias: "Novel", Class: "Book"}))
+		require.Nil(t, sm.DeleteClass(ctx, nil, "Movie"))
+
+		aliases, err := sm.GetAliases(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []*models.Alias{{Alias: "Novel", Class: "Book"}}, aliases)
+	})
+}
@@ -66,6 +66,24 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
+		{
+			methodName:       "ClassWithModuleConfig",
+			additionalArgs:   []interface{}{"somename", map[string]interface{}{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "UpdateClassModuleConfig",
+			additionalArgs:   []interface{}{"somename", map[string]interface{}{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "UpdateClassSynonyms",
+			additionalArgs:   []interface{}{"somename", &models.SynonymConfig{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
 		{
 			methodName:       "DeleteClass",
 			additionalArgs:   []interface{}{"somename"},
@@ -84,6 +102,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
+		{
+			methodName:       "RenameClassProperty",
+			additionalArgs:   []interface{}{"somename", "someprop", "othername"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
 		{
 			methodName:       "UpdateShardStatus",
 			additionalArgs:   []interface{}{"className", "shardName", "targetStatus"},
@@ -108,6 +132,29 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "get",
 			expectedResource: tenantsPath,
 		},
+		{
+			methodName:       "GetAliases",
+			expectedVerb:     "list",
+			expectedResource: aliasesPath,
+		},
+		{
+			methodName:       "AddAlias",
+			additionalArgs:   []interface{}{&models.Alias{Alias: "A1", Class: "C1"}},
+			expectedVerb:     "create",
+			expectedResource: aliasesPath,
+		},
+		{
+			methodName:       "UpdateAlias",
+			additionalArgs:   []interface{}{&models.Alias{Alias: "A1", Class: "C1"}},
+			expectedVerb:     "update",
+			expectedResource: aliasesPath,
+		},
+		{
+			methodName:       "DeleteAlias",
+			additionalArgs:   []interface{}{"A1"},
+			expectedVerb:     "delete",
+			expectedResource: aliasesPath,
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -123,7 +170,8 @@ func Test_Schema_Authorization(t *testing.T) {
 				"TryLock", "RLocker", "TryRLock", // introduced by sync.Mutex in go 1.18
 				"Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes",
 				"CopyShardingState", "TxManager", "RestoreClass",
-				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas":
+				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas",
+				"ResolveAlias":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
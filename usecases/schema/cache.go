@@ -24,6 +24,10 @@ import (
 type State struct {
 	ObjectSchema  *models.Schema `json:"object"`
 	ShardingState map[string]*sharding.State
+
+	// Aliases maps alternative names to existing class names. The map is
+	// never mutated in place, changes replace it as a whole.
+	Aliases map[string]string `json:"aliases,omitempty"`
 }
 
 // NewState returns a new state with room for nClasses classes
@@ -78,6 +78,10 @@ func (m *Manager) deleteClassApplyChanges(ctx context.Context, className string)
 	}
 
 	m.schemaCache.deleteClassState(className)
+	if err := m.deleteClassAliases(ctx, className); err != nil {
+		m.logger.WithField("action", "delete_class").
+			WithField("class", className).Errorf("aliases: %v", err)
+	}
 
 	m.logger.WithField("action", "delete_class").WithField("class", className).Debug("")
 	m.triggerSchemaUpdateCallbacks()
@@ -13,12 +13,26 @@ package schema
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	uco "github.com/weaviate/weaviate/usecases/objects"
 )
 
-// DeleteClassProperty from existing Schema
+// DeleteClassProperty from existing Schema.
+//
+// The inverted indexes of the property are dropped right away, the values
+// stored in the objects are removed by a background job of each shard and are
+// hidden until then. Vectors are left untouched: objects whose vector was
+// built from the property keep that vector until they are vectorized again,
+// either by an update or by revectorizing the class. Properties named in the
+// module config of the class or of a named vector can't be deleted.
 func (m *Manager) DeleteClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property string,
 ) error {
@@ -27,7 +41,285 @@ func (m *Manager) DeleteClassProperty(ctx context.Context, principal *models.Pri
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/weaviate/weaviate/issues/973 for details.")
-	// return m.deleteClassProperty(ctx, class, property, kind.Action)
+	return m.deleteClassProperty(ctx, class, property)
+}
+
+// RenameClassProperty of an existing class.
+//
+// The inverted indexes are renamed right away, the values stored in the
+// objects are moved to the new name by a background job of each shard.
+// Like for deleted properties, vectors are left untouched. Vectorizers which
+// include property names only pick up the new name once an object is
+// vectorized again. Like deleting, renaming a property named in a module
+// config is rejected.
+func (m *Manager) RenameClassProperty(ctx context.Context, principal *models.Principal,
+	class string, property string, newName string,
+) error {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	return m.renameClassProperty(ctx, class, property, newName)
+}
+
+func (m *Manager) deleteClassProperty(ctx context.Context,
+	className string, propName string,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	class, prop, err := m.getClassProperty(className, propName)
+	if err != nil {
+		return err
+	}
+	if err := validatePropertyNotInModuleConfig(class, prop); err != nil {
+		return err
+	}
+
+	pl := DeletePropertyPayload{
+		ClassName:    class.Class,
+		PropertyName: prop.Name,
+		ChangedAt:    time.Now().UnixMilli(),
+	}
+	tx, err := m.cluster.BeginTransaction(ctx, DeleteProperty, pl, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally, see
+		// addClassProperty for details
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.deleteClassPropertyApplyChanges(ctx, pl.ClassName, pl.PropertyName, pl.ChangedAt)
+}
+
+func (m *Manager) renameClassProperty(ctx context.Context,
+	className string, propName string, newName string,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	class, prop, err := m.getClassProperty(className, propName)
+	if err != nil {
+		return err
+	}
+	newName = schema.LowercaseFirstLetter(newName)
+	if err := m.validatePropertyRename(class, prop, newName); err != nil {
+		return err
+	}
+
+	pl := RenamePropertyPayload{
+		ClassName:    class.Class,
+		PropertyName: prop.Name,
+		NewName:      newName,
+		ChangedAt:    time.Now().UnixMilli(),
+	}
+	tx, err := m.cluster.BeginTransaction(ctx, RenameProperty, pl, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally, see
+		// addClassProperty for details
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.renameClassPropertyApplyChanges(ctx, pl.ClassName, pl.PropertyName,
+		pl.NewName, pl.ChangedAt)
+}
+
+func (m *Manager) getClassProperty(className, propName string,
+) (*models.Class, *models.Property, error) {
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, nil, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	// only top level properties can be changed, nested ones are part of the
+	// data type of their parent
+	for _, prop := range class.Properties {
+		if prop.Name == propName {
+			return class, prop, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("property %q: %w", propName, ErrNotFound)
+}
+
+func (m *Manager) validatePropertyRename(class *models.Class,
+	prop *models.Property, newName string,
+) error {
+	if _, err := schema.ValidatePropertyName(newName); err != nil {
+		return uco.NewErrInvalidUserInput("%v", err)
+	}
+	if err := schema.ValidateReservedPropertyName(newName); err != nil {
+		return uco.NewErrInvalidUserInput("%v", err)
+	}
+	for _, p := range class.Properties {
+		if p != prop && strings.EqualFold(p.Name, newName) {
+			return uco.NewErrInvalidUserInput("class %q: property %q already exists",
+				class.Class, newName)
+		}
+	}
+	if dt, ok := schema.AsPrimitive(prop.DataType); ok && dt == schema.DataTypeGeoCoordinates {
+		// the geo index is persisted under the name of the property
+		return uco.NewErrInvalidUserInput("property %q: renaming %s properties is not supported",
+			prop.Name, dt)
+	}
+	return validatePropertyNotInModuleConfig(class, prop)
+}
+
+// modulePropertySettings are the module settings which name properties of the
+// class, such as the properties a vectorizer reads or the references
+// ref2vec-centroid follows
+var modulePropertySettings = []string{
+	"properties", "sourceProperties", "referenceProperties",
+	"textFields", "imageFields", "entityProperty",
+}
+
+// templatePlaceholder matches the {property} placeholders of a
+// vectorizeTemplate, nested properties are referenced by their dot path
+var templatePlaceholder = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*)\}`)
+
+// validatePropertyNotInModuleConfig rejects changing a property which is
+// named in the module config of the class or of one of its named vectors,
+// including the placeholders of a vectorizeTemplate. The module would keep
+// reading the old name, the config needs to be changed first.
+func validatePropertyNotInModuleConfig(class *models.Class, prop *models.Property) error {
+	moduleConfigs := map[string]interface{}{}
+	if mc, ok := class.ModuleConfig.(map[string]interface{}); ok {
+		for module, cfg := range mc {
+			moduleConfigs[fmt.Sprintf("module %q", module)] = cfg
+		}
+	}
+	for targetVector, cfg := range class.VectorConfig {
+		module, settings, err := schema.TargetVectorVectorizer(cfg)
+		if err != nil {
+			continue
+		}
+		moduleConfigs[fmt.Sprintf("module %q of named vector %q", module, targetVector)] = settings
+	}
+
+	for owner, cfg := range moduleConfigs {
+		settings, ok := cfg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range modulePropertySettings {
+			if namesProperty(settings[key], prop.Name) {
+				return uco.NewErrInvalidUserInput("property %q is used by setting %q of %s, "+
+					"remove it from the module config first", prop.Name, key, owner)
+			}
+		}
+		if templateNamesProperty(settings["vectorizeTemplate"], prop.Name) {
+			return uco.NewErrInvalidUserInput("property %q is used by the vectorizeTemplate of %s, "+
+				"remove it from the template first", prop.Name, owner)
+		}
+	}
+	return nil
+}
+
+// templateNamesProperty checks if a placeholder of the template refers to the
+// property or to one of its nested properties
+func templateNamesProperty(setting interface{}, propName string) bool {
+	template, ok := setting.(string)
+	if !ok {
+		return false
+	}
+	for _, match := range templatePlaceholder.FindAllStringSubmatch(template, -1) {
+		if strings.Split(match[1], schema.NestedPropertySeparator)[0] == propName {
+			return true
+		}
+	}
+	return false
+}
+
+func namesProperty(setting interface{}, propName string) bool {
+	switch typed := setting.(type) {
+	case string:
+		return typed == propName
+	case []string:
+		for _, name := range typed {
+			if name == propName {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, name := range typed {
+			if asString, ok := name.(string); ok && asString == propName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *Manager) deleteClassPropertyApplyChanges(ctx context.Context,
+	className string, propName string, changedAt int64,
+) error {
+	class, err := schema.GetClassByName(m.schemaCache.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+
+	// the index needs the definition of the property to find its buckets
+	if err := m.migrator.DropProperty(ctx, className, propName, changedAt); err != nil {
+		return fmt.Errorf("drop property %q: %w", propName, err)
+	}
+
+	props := make([]*models.Property, 0, len(class.Properties))
+	for _, p := range class.Properties {
+		if p.Name != propName {
+			props = append(props, p)
+		}
+	}
+	m.schemaCache.LockGuard(func() { class.Properties = props })
+
+	return m.saveClassPropertyChanges(ctx, class, "schema.delete_property")
+}
+
+func (m *Manager) renameClassPropertyApplyChanges(ctx context.Context,
+	className string, propName string, newName string, changedAt int64,
+) error {
+	class, err := schema.GetClassByName(m.schemaCache.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+
+	if err := m.migrator.RenameProperty(ctx, className, propName, newName, changedAt); err != nil {
+		return fmt.Errorf("rename property %q: %w", propName, err)
+	}
+
+	props := make([]*models.Property, len(class.Properties))
+	for i, p := range class.Properties {
+		if p.Name == propName {
+			renamed := *p
+			renamed.Name = newName
+			p = &renamed
+		}
+		props[i] = p
+	}
+	m.schemaCache.LockGuard(func() { class.Properties = props })
+
+	return m.saveClassPropertyChanges(ctx, class, "schema.rename_property")
+}
+
+func (m *Manager) saveClassPropertyChanges(ctx context.Context,
+	class *models.Class, action string,
+) error {
+	metadata, err := json.Marshal(&class)
+	if err != nil {
+		return fmt.Errorf("marshal class %s: %w", class.Class, err)
+	}
+	m.logger.
+		WithField("action", action).
+		Debug("saving updated schema to configuration store")
+	err = m.repo.UpdateClass(ctx, ClassPayload{Name: class.Class, Metadata: metadata})
+	if err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks()
+	return nil
 }
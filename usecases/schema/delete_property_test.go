@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidatePropertyNotInModuleConfig(t *testing.T) {
+	prop := &models.Property{Name: "description", DataType: schema.DataTypeText.PropString()}
+
+	tests := []struct {
+		name    string
+		class   *models.Class
+		wantErr string
+	}{
+		{
+			name:  "no module config",
+			class: &models.Class{Class: "Article"},
+		},
+		{
+			name: "other properties in the module config",
+			class: &models.Class{
+				Class: "Article",
+				ModuleConfig: map[string]interface{}{
+					"multi2vec-clip": map[string]interface{}{
+						"textFields": []interface{}{"title"},
+					},
+				},
+			},
+		},
+		{
+			name: "vectorizer properties",
+			class: &models.Class{
+				Class: "Article",
+				ModuleConfig: map[string]interface{}{
+					"multi2vec-clip": map[string]interface{}{
+						"textFields":  []interface{}{"title", "description"},
+						"imageFields": []interface{}{"image"},
+					},
+				},
+			},
+			wantErr: `property "description" is used by setting "textFields" of module "multi2vec-clip"`,
+		},
+		{
+			name: "ref2vec reference properties",
+			class: &models.Class{
+				Class: "Article",
+				ModuleConfig: map[string]interface{}{
+					"ref2vec-centroid": map[string]interface{}{
+						"referenceProperties": []string{"description"},
+					},
+				},
+			},
+			wantErr: `setting "referenceProperties" of module "ref2vec-centroid"`,
+		},
+		{
+			name: "source properties of a named vector",
+			class: &models.Class{
+				Class: "Article",
+				VectorConfig: map[string]models.VectorConfig{
+					"summary": {
+						Vectorizer: map[string]interface{}{
+							"text2vec-openai": map[string]interface{}{
+								"sourceProperties": []interface{}{"description"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: `setting "sourceProperties" of module "text2vec-openai" of named vector "summary"`,
+		},
+		{
+			name: "other properties in the vectorize template",
+			class: &models.Class{
+				Class: "Article",
+				ModuleConfig: map[string]interface{}{
+					"text2vec-openai": map[string]interface{}{
+						"vectorizeTemplate": "{title}: {descriptionShort} {description_}",
+					},
+				},
+			},
+		},
+		{
+			name: "vectorize template of the class",
+			class: &models.Class{
+				Class: "Article",
+				ModuleConfig: map[string]interface{}{
+					"text2vec-openai": map[string]interface{}{
+						"vectorizeTemplate": "{title}: {description}",
+					},
+				},
+			},
+			wantErr: `property "description" is used by the vectorizeTemplate of module "text2vec-openai"`,
+		},
+		{
+			name: "nested property in the vectorize template of a named vector",
+			class: &models.Class{
+				Class: "Article",
+				VectorConfig: map[string]models.VectorConfig{
+					"summary": {
+						Vectorizer: map[string]interface{}{
+							"text2vec-openai": map[string]interface{}{
+								"vectorizeTemplate": "{title} written by {description.author}",
+							},
+						},
+					},
+				},
+			},
+			wantErr: `vectorizeTemplate of module "text2vec-openai" of named vector "summary"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validatePropertyNotInModuleConfig(test.class, prop)
+			if test.wantErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
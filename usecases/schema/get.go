@@ -29,6 +29,7 @@ func (m *Manager) GetSchema(principal *models.Principal) (schema.Schema, error)
 
 	return schema.Schema{
 		Objects: m.schemaCache.ObjectSchema,
+		Aliases: m.schemaCache.Aliases,
 	}, nil
 }
 
@@ -38,12 +39,14 @@ func (m *Manager) GetSchema(principal *models.Principal) (schema.Schema, error)
 func (m *Manager) GetSchemaSkipAuth() schema.Schema {
 	return schema.Schema{
 		Objects: m.schemaCache.ObjectSchema,
+		Aliases: m.schemaCache.Aliases,
 	}
 }
 
 func (m *Manager) getSchema() schema.Schema {
 	return schema.Schema{
 		Objects: m.schemaCache.ObjectSchema,
+		Aliases: m.schemaCache.Aliases,
 	}
 }
 
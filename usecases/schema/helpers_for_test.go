@@ -57,6 +57,10 @@ func (f *fakeRepo) DeleteShards(_ context.Context, class string, _ []string) err
 	return nil
 }
 
+func (f *fakeRepo) SaveAliases(ctx context.Context, aliases map[string]string) error {
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -26,6 +26,10 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddClassCommit(ctx, tx)
 	case AddProperty:
 		return m.handleAddPropertyCommit(ctx, tx)
+	case DeleteProperty:
+		return m.handleDeletePropertyCommit(ctx, tx)
+	case RenameProperty:
+		return m.handleRenamePropertyCommit(ctx, tx)
 	case DeleteClass:
 		return m.handleDeleteClassCommit(ctx, tx)
 	case UpdateClass:
@@ -34,6 +38,10 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddTenantsCommit(ctx, tx)
 	case deleteTenants:
 		return m.handleDeleteTenantsCommit(ctx, tx)
+	case addAlias, updateAlias:
+		return m.handleAliasCommit(ctx, tx)
+	case deleteAlias:
+		return m.handleDeleteAliasCommit(ctx, tx)
 	default:
 		return errors.Errorf("unrecognized commit type %q", tx.Type)
 	}
@@ -107,6 +115,37 @@ func (m *Manager) handleAddPropertyCommit(ctx context.Context,
 	return m.addClassPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
 }
 
+func (m *Manager) handleDeletePropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(DeletePropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeletePropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.deleteClassPropertyApplyChanges(ctx, pl.ClassName, pl.PropertyName, pl.ChangedAt)
+}
+
+func (m *Manager) handleRenamePropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(RenamePropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be RenamePropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.renameClassPropertyApplyChanges(ctx, pl.ClassName, pl.PropertyName,
+		pl.NewName, pl.ChangedAt)
+}
+
 func (m *Manager) handleDeleteClassCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
@@ -190,3 +229,33 @@ func (m *Manager) handleDeleteTenantsCommit(ctx context.Context,
 
 	return m.onDeleteTenants(ctx, cls, req)
 }
+
+func (m *Manager) handleAliasCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(AliasPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be AliasPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.onSetAlias(ctx, req)
+}
+
+func (m *Manager) handleDeleteAliasCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(DeleteAliasPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeleteAliasPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.onDeleteAlias(ctx, req)
+}
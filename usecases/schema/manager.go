@@ -102,6 +102,9 @@ type SchemaStore interface {
 	// DeleteShards deletes shards from a class
 	// If the class or a shard does not exist then nothing is done and a nil error is returned
 	DeleteShards(ctx context.Context, class string, shards []string) error
+
+	// SaveAliases replaces all class aliases
+	SaveAliases(ctx context.Context, aliases map[string]string) error
 }
 
 // KeyValuePair is used to serialize shards updates
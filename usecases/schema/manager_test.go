@@ -71,7 +71,11 @@ func (n *NilMigrator) UpdatePropertyAddDataType(ctx context.Context, className s
 	return nil
 }
 
-func (n *NilMigrator) DropProperty(ctx context.Context, className string, propName string) error {
+func (n *NilMigrator) DropProperty(ctx context.Context, className string, propName string, changedAt int64) error {
+	return nil
+}
+
+func (n *NilMigrator) RenameProperty(ctx context.Context, className string, propName string, newName string, changedAt int64) error {
 	return nil
 }
 
@@ -83,6 +87,10 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) UpdateVectorIndexConfigs(ctx context.Context, className string, updated map[string]schema.VectorIndexConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateInvertedIndexConfigUpdate(ctx context.Context, old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
@@ -123,6 +131,7 @@ var schemaTests = []struct {
 	{name: "AddInvalidPropertyDuringCreation", fn: testAddInvalidPropertyDuringCreation},
 	{name: "AddInvalidPropertyWithEmptyDataTypeDuringCreation", fn: testAddInvalidPropertyWithEmptyDataTypeDuringCreation},
 	{name: "DropProperty", fn: testDropProperty},
+	{name: "RenameProperty", fn: testRenameProperty},
 }
 
 func testAddObjectClass(t *testing.T, lsm *Manager) {
@@ -475,11 +484,6 @@ func testAddInvalidPropertyWithEmptyDataTypeDuringCreation(t *testing.T, lsm *Ma
 }
 
 func testDropProperty(t *testing.T, lsm *Manager) {
-	// TODO: https://github.com/weaviate/weaviate/issues/973
-	// Remove skip
-
-	t.Skip()
-
 	t.Parallel()
 
 	var properties []*models.Property = []*models.Property{
@@ -497,11 +501,63 @@ func testDropProperty(t *testing.T, lsm *Manager) {
 	assert.Len(t, objectClasses[0].Properties, 1)
 
 	// Now drop the property
-	lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	err = lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	require.Nil(t, err)
 
 	objectClasses = testGetClasses(lsm)
 	require.Len(t, objectClasses, 1)
 	assert.Len(t, objectClasses[0].Properties, 0)
+
+	err = lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func testRenameProperty(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddClass(context.Background(), nil, &models.Class{
+		Class: "Car",
+		Properties: []*models.Property{
+			{Name: "color", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+			{Name: "brand", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+			{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+		},
+	})
+	require.Nil(t, err)
+
+	t.Run("rename to a new name", func(t *testing.T) {
+		err := lsm.RenameClassProperty(context.Background(), nil, "Car", "color", "Paint")
+		require.Nil(t, err)
+
+		objectClasses := testGetClasses(lsm)
+		require.Len(t, objectClasses, 1)
+		require.Len(t, objectClasses[0].Properties, 3)
+		assert.Equal(t, "paint", objectClasses[0].Properties[0].Name)
+		assert.Equal(t, []string{"text"}, objectClasses[0].Properties[0].DataType)
+	})
+
+	t.Run("rename onto an existing name", func(t *testing.T) {
+		err := lsm.RenameClassProperty(context.Background(), nil, "Car", "paint", "Brand")
+		assert.ErrorContains(t, err, "already exists")
+	})
+
+	t.Run("rename to an invalid name", func(t *testing.T) {
+		err := lsm.RenameClassProperty(context.Background(), nil, "Car", "paint", "_id")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("rename a geo property", func(t *testing.T) {
+		err := lsm.RenameClassProperty(context.Background(), nil, "Car", "location", "position")
+		assert.ErrorContains(t, err, "not supported")
+	})
+
+	t.Run("rename a property which doesn't exist", func(t *testing.T) {
+		err := lsm.RenameClassProperty(context.Background(), nil, "Car", "color", "tint")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		err = lsm.RenameClassProperty(context.Background(), nil, "Bike", "color", "tint")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
 }
 
 // This grant parent test setups up the temporary directory needed for the tests.
@@ -34,6 +34,10 @@ type Migrator interface {
 		prop *models.Property) error
 	UpdateProperty(ctx context.Context, className string,
 		propName string, newName *string) error
+	DropProperty(ctx context.Context, className string,
+		propName string, changedAt int64) error
+	RenameProperty(ctx context.Context, className string,
+		propName string, newName string, changedAt int64) error
 
 	NewTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
 	DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
@@ -42,6 +46,8 @@ type Migrator interface {
 		old, updated schema.VectorIndexConfig) error
 	UpdateVectorIndexConfig(ctx context.Context, className string,
 		updated schema.VectorIndexConfig) error
+	UpdateVectorIndexConfigs(ctx context.Context, className string,
+		updated map[string]schema.VectorIndexConfig) error
 	ValidateInvertedIndexConfigUpdate(ctx context.Context,
 		old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
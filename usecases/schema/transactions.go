@@ -26,10 +26,18 @@ const (
 	AddClass    cluster.TransactionType = "add_class"
 	AddProperty cluster.TransactionType = "add_property"
 
+	DeleteProperty cluster.TransactionType = "delete_property"
+	RenameProperty cluster.TransactionType = "rename_property"
+
 	// tenant types
 	addTenants    cluster.TransactionType = "add_tenants"
 	deleteTenants cluster.TransactionType = "delete_tenants"
 
+	// alias types
+	addAlias    cluster.TransactionType = "add_alias"
+	updateAlias cluster.TransactionType = "update_alias"
+	deleteAlias cluster.TransactionType = "delete_alias"
+
 	DeleteClass cluster.TransactionType = "delete_class"
 	UpdateClass cluster.TransactionType = "update_class"
 
@@ -49,6 +57,26 @@ type AddPropertyPayload struct {
 	Property  *models.Property `json:"property"`
 }
 
+// DeletePropertyPayload removes a top level property from a class
+type DeletePropertyPayload struct {
+	ClassName    string `json:"className"`
+	PropertyName string `json:"propertyName"`
+	// ChangedAt is the time of the change in unix milliseconds. It is taken
+	// once by the node opening the transaction, so that the shards of every
+	// node change the same objects regardless of their clocks.
+	ChangedAt int64 `json:"changedAt"`
+}
+
+// RenamePropertyPayload renames a top level property of a class
+type RenamePropertyPayload struct {
+	ClassName    string `json:"className"`
+	PropertyName string `json:"propertyName"`
+	NewName      string `json:"newName"`
+	// ChangedAt is the time of the change in unix milliseconds, see
+	// DeletePropertyPayload
+	ChangedAt int64 `json:"changedAt"`
+}
+
 // Tenant represents properties of a specific tenant (physical shard)
 type Tenant struct {
 	Name  string   `json:"name"`
@@ -67,6 +95,17 @@ type DeleteTenantsPayload struct {
 	Tenants []string `json:"tenants"`
 }
 
+// AliasPayload points an alias to a class
+type AliasPayload struct {
+	Alias string `json:"alias"`
+	Class string `json:"class_name"`
+}
+
+// DeleteAliasPayload removes an alias
+type DeleteAliasPayload struct {
+	Alias string `json:"alias"`
+}
+
 type DeleteClassPayload struct {
 	ClassName string `json:"className"`
 }
@@ -93,6 +132,10 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 		return unmarshalRawJson[AddClassPayload](payload)
 	case AddProperty:
 		return unmarshalRawJson[AddPropertyPayload](payload)
+	case DeleteProperty:
+		return unmarshalRawJson[DeletePropertyPayload](payload)
+	case RenameProperty:
+		return unmarshalRawJson[RenamePropertyPayload](payload)
 	case DeleteClass:
 		return unmarshalRawJson[DeleteClassPayload](payload)
 	case UpdateClass:
@@ -103,6 +146,10 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 		return unmarshalRawJson[AddTenantsPayload](payload)
 	case deleteTenants:
 		return unmarshalRawJson[DeleteTenantsPayload](payload)
+	case addAlias, updateAlias:
+		return unmarshalRawJson[AliasPayload](payload)
+	case deleteAlias:
+		return unmarshalRawJson[DeleteAliasPayload](payload)
 	default:
 		return nil, errors.Errorf("unrecognized schema transaction type %q", txType)
 
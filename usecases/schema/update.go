@@ -65,6 +65,14 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "vector index config")
 	}
 
+	for name, cfg := range updated.VectorConfig {
+		if err := m.migrator.ValidateVectorIndexConfigUpdate(ctx,
+			initial.VectorConfig[name].VectorIndexConfig.(schema.VectorIndexConfig),
+			cfg.VectorIndexConfig.(schema.VectorIndexConfig)); err != nil {
+			return errors.Wrapf(err, "vector index config of named vector %q", name)
+		}
+	}
+
 	if err := m.migrator.ValidateInvertedIndexConfigUpdate(ctx,
 		initial.InvertedIndexConfig, updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
@@ -145,6 +153,16 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 		return errors.Wrap(err, "vector index config")
 	}
 
+	if len(updated.VectorConfig) > 0 {
+		configs := make(map[string]schema.VectorIndexConfig, len(updated.VectorConfig))
+		for name, cfg := range updated.VectorConfig {
+			configs[name] = cfg.VectorIndexConfig.(schema.VectorIndexConfig)
+		}
+		if err := m.migrator.UpdateVectorIndexConfigs(ctx, className, configs); err != nil {
+			return errors.Wrap(err, "named vector index configs")
+		}
+	}
+
 	if err := m.migrator.UpdateInvertedIndexConfig(ctx, className,
 		updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
@@ -221,6 +239,39 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 		return errors.Errorf("module config is immutable")
 	}
 
+	if err := validateImmutableTargetVectors(initial, updated); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateImmutableTargetVectors makes sure that no named vectors are added
+// or removed and that their vectorizers and index types are not changed, only
+// the vector index configs of existing named vectors can be updated
+func validateImmutableTargetVectors(initial, updated *models.Class) error {
+	if len(initial.VectorConfig) != len(updated.VectorConfig) {
+		return errors.Errorf("named vectors cannot be added or removed")
+	}
+
+	for name, initialCfg := range initial.VectorConfig {
+		updatedCfg, ok := updated.VectorConfig[name]
+		if !ok {
+			return errors.Errorf("named vectors cannot be added or removed: "+
+				"missing named vector %q", name)
+		}
+
+		if !reflect.DeepEqual(initialCfg.Vectorizer, updatedCfg.Vectorizer) {
+			return errors.Errorf("vectorizer of named vector %q is immutable", name)
+		}
+
+		if initialCfg.VectorIndexType != updatedCfg.VectorIndexType {
+			return errors.Errorf("vector index type of named vector %q is immutable: "+
+				"attempted change from %q to %q", name,
+				initialCfg.VectorIndexType, updatedCfg.VectorIndexType)
+		}
+	}
+
 	return nil
 }
 
@@ -253,3 +304,71 @@ func (m *Manager) UpdateShardStatus(ctx context.Context, principal *models.Princ
 
 	return m.migrator.UpdateShardStatus(ctx, className, shardName, targetStatus)
 }
+
+// ClassWithModuleConfig returns a copy of the class which uses the given
+// module config, with defaults set and validated by the modules. It allows to
+// vectorize objects with a module config before it is applied to the class.
+func (m *Manager) ClassWithModuleConfig(ctx context.Context, principal *models.Principal,
+	className string, moduleConfig interface{},
+) (*models.Class, error) {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.classWithModuleConfig(ctx, className, moduleConfig)
+}
+
+func (m *Manager) classWithModuleConfig(ctx context.Context,
+	className string, moduleConfig interface{},
+) (*models.Class, error) {
+	m.schemaCache.RLock()
+	initial := m.getClassByName(className)
+	m.schemaCache.RUnlock()
+
+	if initial == nil {
+		return nil, ErrNotFound
+	}
+
+	updated := *initial
+	updated.ModuleConfig = moduleConfig
+	m.moduleConfig.SetClassDefaults(&updated)
+	if err := m.moduleConfig.ValidateClass(ctx, &updated); err != nil {
+		return nil, errors.Wrap(err, "module config")
+	}
+
+	return &updated, nil
+}
+
+// UpdateClassModuleConfig replaces the module config of a class, which is
+// immutable through UpdateClass. Vectors created with the previous config
+// can't be compared to vectors created with the new one, so this is only
+// meant to be called once all objects of the class have been revectorized.
+func (m *Manager) UpdateClassModuleConfig(ctx context.Context, principal *models.Principal,
+	className string, moduleConfig interface{},
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	updated, err := m.classWithModuleConfig(ctx, className, moduleConfig)
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, updated, nil}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.updateClassApplyChanges(ctx, className, updated, nil)
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// UpdateClassSynonyms replaces the synonym dictionary of a class. Synonyms
+// are only used to expand the terms of keyword queries, so they can be
+// changed at any time without reindexing.
+func (m *Manager) UpdateClassSynonyms(ctx context.Context, principal *models.Principal,
+	className string, synonyms *models.SynonymConfig,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	m.schemaCache.RLock()
+	initial := m.getClassByName(className)
+	m.schemaCache.RUnlock()
+
+	if initial == nil {
+		return ErrNotFound
+	}
+
+	updated := *initial
+	invertedConfig := models.InvertedIndexConfig{}
+	if initial.InvertedIndexConfig != nil {
+		invertedConfig = *initial.InvertedIndexConfig
+	}
+	invertedConfig.Synonyms = synonyms
+	updated.InvertedIndexConfig = &invertedConfig
+
+	if err := m.migrator.ValidateInvertedIndexConfigUpdate(ctx,
+		initial.InvertedIndexConfig, updated.InvertedIndexConfig); err != nil {
+		return errors.Wrap(err, "inverted index config")
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, &updated, nil}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.updateClassApplyChanges(ctx, className, &updated, nil)
+}
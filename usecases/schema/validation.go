@@ -17,8 +17,12 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stemmer"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/multivector"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -34,6 +38,11 @@ func (m *Manager) validateClassNameUniqueness(className string) error {
 			return fmt.Errorf("class name %q already exists", className)
 		}
 	}
+	for alias := range m.schemaCache.Aliases {
+		if strings.EqualFold(className, alias) {
+			return fmt.Errorf("class name %q already exists as an alias", className)
+		}
+	}
 
 	return nil
 }
@@ -58,7 +67,9 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			switch tokenization {
 			case models.PropertyTokenizationField, models.PropertyTokenizationWord,
-				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase:
+				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+				models.PropertyTokenizationTrigram, models.PropertyTokenizationGse,
+				models.PropertyTokenizationKagomeJa:
 				return nil
 			}
 		default:
@@ -73,9 +84,38 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 	if tokenization == "" {
 		return nil
 	}
+	if propertyDataType.IsNested() {
+		return fmt.Errorf("Tokenization is not allowed for object data type")
+	}
 	return fmt.Errorf("Tokenization is not allowed for reference data type")
 }
 
+func (m *Manager) validatePropertyStemmer(property *models.Property, propertyDataType schema.PropertyDataType) error {
+	if property.Stemmer == "" {
+		return nil
+	}
+
+	if propertyDataType.IsNested() {
+		return fmt.Errorf("Stemmer is not allowed for object data type")
+	}
+	if !propertyDataType.IsPrimitive() {
+		return fmt.Errorf("Stemmer is not allowed for reference data type")
+	}
+	switch dt := propertyDataType.AsPrimitive(); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return fmt.Errorf("Stemmer is not allowed for data type '%s'", dt)
+	}
+
+	switch property.Tokenization {
+	case models.PropertyTokenizationField, models.PropertyTokenizationTrigram,
+		models.PropertyTokenizationGse, models.PropertyTokenizationKagomeJa:
+		return fmt.Errorf("Stemmer is not allowed for tokenization '%s'", property.Tokenization)
+	}
+
+	return stemmer.Validate(property.Stemmer)
+}
+
 func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 	if prop.IndexInverted != nil {
 		if prop.IndexFilterable != nil || prop.IndexSearchable != nil {
@@ -92,13 +132,173 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			// true or false allowed
 		default:
-			if *prop.IndexSearchable {
+			if *prop.IndexSearchable && !schema.IsNestedDataType(prop.DataType) {
 				return fmt.Errorf("`indexSearchable` is allowed only for text/text[] data types. " +
 					"For other data types set false or leave empty")
 			}
 		}
 	}
 
+	if prop.IndexPositions != nil && *prop.IndexPositions {
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeString, schema.DataTypeStringArray,
+			schema.DataTypeText, schema.DataTypeTextArray:
+			// positions complement the searchable index
+			if (prop.IndexSearchable != nil && !*prop.IndexSearchable) ||
+				(prop.IndexInverted != nil && !*prop.IndexInverted) {
+				return fmt.Errorf("`indexPositions` requires `indexSearchable` to be enabled")
+			}
+		default:
+			return fmt.Errorf("`indexPositions` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
+
+	if prop.IndexReversedTerms != nil && *prop.IndexReversedTerms {
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeString, schema.DataTypeStringArray,
+			schema.DataTypeText, schema.DataTypeTextArray:
+			// reversed terms complement the filterable index
+			if (prop.IndexFilterable != nil && !*prop.IndexFilterable) ||
+				(prop.IndexInverted != nil && !*prop.IndexInverted) {
+				return fmt.Errorf("`indexReversedTerms` requires `indexFilterable` to be enabled")
+			}
+		default:
+			return fmt.Errorf("`indexReversedTerms` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
+
+	if prop.IndexNullState != nil && *prop.IndexNullState {
+		if dataType, ok := schema.AsNested(prop.DataType); ok {
+			return fmt.Errorf("`indexNullState` is not allowed for %s data type. "+
+				"Set false or leave empty", dataType)
+		}
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeBlob:
+			return fmt.Errorf("`indexNullState` is not allowed for %s data type. "+
+				"Set false or leave empty", dataType)
+		}
+		// the null state complements the inverted index
+		if (prop.IndexInverted != nil && !*prop.IndexInverted) ||
+			(prop.IndexFilterable != nil && !*prop.IndexFilterable &&
+				prop.IndexSearchable != nil && !*prop.IndexSearchable) {
+			return fmt.Errorf("`indexNullState` requires `indexFilterable` or `indexSearchable` to be enabled")
+		}
+	}
+
+	if prop.IndexPropertyLength != nil && *prop.IndexPropertyLength {
+		if dataType, ok := schema.AsNested(prop.DataType); ok {
+			return fmt.Errorf("`indexPropertyLength` is not allowed for %s data type. "+
+				"Set false or leave empty", dataType)
+		}
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeBlob,
+			schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate:
+			return fmt.Errorf("`indexPropertyLength` is not allowed for %s data type. "+
+				"Set false or leave empty", dataType)
+		}
+		// the property length complements the inverted index
+		if (prop.IndexInverted != nil && !*prop.IndexInverted) ||
+			(prop.IndexFilterable != nil && !*prop.IndexFilterable &&
+				prop.IndexSearchable != nil && !*prop.IndexSearchable) {
+			return fmt.Errorf("`indexPropertyLength` requires `indexFilterable` or `indexSearchable` to be enabled")
+		}
+	}
+
+	if prop.IndexRangeFilters != nil && *prop.IndexRangeFilters {
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeDate:
+			// true or false allowed
+		default:
+			return fmt.Errorf("`indexRangeFilters` is allowed only for int/number/date data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
+
+	return nil
+}
+
+// validateNestedProperties makes sure that exactly the properties of data
+// type object and object[] describe their structure with nested properties,
+// which are validated on all levels
+func (m *Manager) validateNestedProperties(prop *models.Property,
+	propertyDataType schema.PropertyDataType,
+) error {
+	if !propertyDataType.IsNested() {
+		if len(prop.NestedProperties) > 0 {
+			return fmt.Errorf("nestedProperties are only allowed for object and object[] data types")
+		}
+		return nil
+	}
+
+	return m.validateNestedPropertyList(prop.NestedProperties)
+}
+
+func (m *Manager) validateNestedPropertyList(nestedProps []*models.NestedProperty) error {
+	if len(nestedProps) == 0 {
+		return fmt.Errorf("object and object[] data types require at least one nested property")
+	}
+
+	existingNames := map[string]bool{}
+	for _, np := range nestedProps {
+		if np == nil {
+			return fmt.Errorf("nested property must not be empty")
+		}
+		if _, err := schema.ValidatePropertyName(np.Name); err != nil {
+			return err
+		}
+		if existingNames[strings.ToLower(np.Name)] {
+			return fmt.Errorf("conflict for nested property %q: provided multiple times", np.Name)
+		}
+		existingNames[strings.ToLower(np.Name)] = true
+
+		if err := m.validateNestedProperty(np); err != nil {
+			return fmt.Errorf("nested property '%s': %w", np.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) validateNestedProperty(np *models.NestedProperty) error {
+	if len(np.DataType) != 1 {
+		return fmt.Errorf("invalid dataType: nested properties require exactly one data type")
+	}
+
+	dataType := schema.DataType(np.DataType[0])
+	switch dataType {
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		if np.Tokenization != "" {
+			return fmt.Errorf("Tokenization is not allowed for object data type")
+		}
+		return m.validateNestedPropertyList(np.NestedProperties)
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		switch np.Tokenization {
+		case "", models.PropertyTokenizationField, models.PropertyTokenizationWord,
+			models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+			models.PropertyTokenizationTrigram, models.PropertyTokenizationGse,
+			models.PropertyTokenizationKagomeJa:
+		default:
+			return fmt.Errorf("Tokenization '%s' is not allowed for data type '%s'", np.Tokenization, dataType)
+		}
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate,
+		schema.DataTypeUUID, schema.DataTypeBlob, schema.DataTypeIntArray, schema.DataTypeNumberArray,
+		schema.DataTypeBooleanArray, schema.DataTypeDateArray, schema.DataTypeUUIDArray:
+		if np.Tokenization != "" {
+			return fmt.Errorf("Tokenization is not allowed for data type '%s'", dataType)
+		}
+		if np.IndexSearchable != nil && *np.IndexSearchable {
+			return fmt.Errorf("`indexSearchable` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty")
+		}
+	default:
+		return fmt.Errorf("invalid dataType: data type '%s' is not supported for nested properties", dataType)
+	}
+
+	if len(np.NestedProperties) > 0 {
+		return fmt.Errorf("nestedProperties are only allowed for object and object[] data types")
+	}
 	return nil
 }
 
@@ -111,9 +311,41 @@ func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Clas
 		return err
 	}
 
+	for name, cfg := range class.VectorConfig {
+		if err := m.validateTargetVector(name, cfg); err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+	}
+
 	return nil
 }
 
+func (m *Manager) validateTargetVector(name string, cfg models.VectorConfig) error {
+	if err := schema.ValidateTargetVectorName(name); err != nil {
+		return err
+	}
+
+	vectorizer, _, err := schema.TargetVectorVectorizer(cfg)
+	if err != nil {
+		return err
+	}
+	if vectorizer != config.VectorizerModuleNone {
+		if err := m.vectorizerValidator.ValidateVectorizer(vectorizer); err != nil {
+			return errors.Wrap(err, "vectorizer")
+		}
+	}
+
+	// named vectors are kept in their own indexes next to the class vector,
+	// these only support the single vector index types
+	switch cfg.VectorIndexType {
+	case "hnsw", flat.IndexType:
+		return nil
+	default:
+		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
+			cfg.VectorIndexType)
+	}
+}
+
 func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) error {
 	if class.Vectorizer == config.VectorizerModuleNone {
 		return nil
@@ -128,7 +360,7 @@ func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) e
 
 func (m *Manager) validateVectorIndex(ctx context.Context, class *models.Class) error {
 	switch class.VectorIndexType {
-	case "hnsw":
+	case "hnsw", multivector.IndexType, flat.IndexType, dynamic.IndexType:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
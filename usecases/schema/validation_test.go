@@ -432,6 +432,74 @@ func Test_Validation_PropertyTokenization(t *testing.T) {
 	})
 }
 
+func Test_Validation_PropertyStemmer(t *testing.T) {
+	m := newSchemaManager()
+
+	testCases := []struct {
+		name           string
+		property       *models.Property
+		dataType       schema.DataType
+		expectedErrMsg string
+	}{
+		{
+			name:     "no stemmer",
+			property: &models.Property{Tokenization: models.PropertyTokenizationWord},
+			dataType: schema.DataTypeInt,
+		},
+		{
+			name:     "en stemmer on text",
+			property: &models.Property{Tokenization: models.PropertyTokenizationWord, Stemmer: "en"},
+			dataType: schema.DataTypeText,
+		},
+		{
+			name:     "de stemmer on text[]",
+			property: &models.Property{Tokenization: models.PropertyTokenizationLowercase, Stemmer: "de"},
+			dataType: schema.DataTypeTextArray,
+		},
+		{
+			name:           "unknown stemmer",
+			property:       &models.Property{Tokenization: models.PropertyTokenizationWord, Stemmer: "xx"},
+			dataType:       schema.DataTypeText,
+			expectedErrMsg: "stemmer \"xx\" does not exist, supported are [en de]",
+		},
+		{
+			name:           "field tokenization",
+			property:       &models.Property{Tokenization: models.PropertyTokenizationField, Stemmer: "en"},
+			dataType:       schema.DataTypeText,
+			expectedErrMsg: "Stemmer is not allowed for tokenization 'field'",
+		},
+		{
+			name:           "trigram tokenization",
+			property:       &models.Property{Tokenization: models.PropertyTokenizationTrigram, Stemmer: "en"},
+			dataType:       schema.DataTypeText,
+			expectedErrMsg: "Stemmer is not allowed for tokenization 'trigram'",
+		},
+		{
+			name:           "kagome_ja tokenization",
+			property:       &models.Property{Tokenization: models.PropertyTokenizationKagomeJa, Stemmer: "en"},
+			dataType:       schema.DataTypeText,
+			expectedErrMsg: "Stemmer is not allowed for tokenization 'kagome_ja'",
+		},
+		{
+			name:           "int property",
+			property:       &models.Property{Stemmer: "en"},
+			dataType:       schema.DataTypeInt,
+			expectedErrMsg: "Stemmer is not allowed for data type 'int'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := m.validatePropertyStemmer(tc.property, newFakePropertyDataType(tc.dataType))
+			if tc.expectedErrMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			}
+		})
+	}
+}
+
 func Test_Validation_PropertyIndexing(t *testing.T) {
 	t.Run("validates indexInverted / indexFilterable / indexSearchable combinations", func(t *testing.T) {
 		vFalse := false
@@ -527,6 +595,324 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("validates indexPositions", func(t *testing.T) {
+		vFalse := false
+		vTrue := true
+
+		testCases := []struct {
+			name            string
+			dataType        schema.DataType
+			indexSearchable *bool
+			indexPositions  *bool
+			expectedErrMsg  string
+		}{
+			{
+				name:           "text with positions",
+				dataType:       schema.DataTypeText,
+				indexPositions: &vTrue,
+			},
+			{
+				name:            "text[] with searchable and positions",
+				dataType:        schema.DataTypeTextArray,
+				indexSearchable: &vTrue,
+				indexPositions:  &vTrue,
+			},
+			{
+				name:            "text without searchable",
+				dataType:        schema.DataTypeText,
+				indexSearchable: &vFalse,
+				indexPositions:  &vTrue,
+				expectedErrMsg:  "`indexPositions` requires `indexSearchable` to be enabled",
+			},
+			{
+				name:           "int with positions",
+				dataType:       schema.DataTypeInt,
+				indexPositions: &vTrue,
+				expectedErrMsg: "`indexPositions` is allowed only for text/text[] data types. " +
+					"For other data types set false or leave empty",
+			},
+			{
+				name:           "int without positions",
+				dataType:       schema.DataTypeInt,
+				indexPositions: &vFalse,
+			},
+		}
+
+		mgr := newSchemaManager()
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := mgr.validatePropertyIndexing(&models.Property{
+					Name:            "prop",
+					DataType:        tc.dataType.PropString(),
+					IndexSearchable: tc.indexSearchable,
+					IndexPositions:  tc.indexPositions,
+				})
+
+				if tc.expectedErrMsg != "" {
+					assert.EqualError(t, err, tc.expectedErrMsg)
+				} else {
+					assert.Nil(t, err)
+				}
+			})
+		}
+	})
+
+	t.Run("validates indexReversedTerms", func(t *testing.T) {
+		vFalse := false
+		vTrue := true
+
+		testCases := []struct {
+			name               string
+			dataType           schema.DataType
+			indexFilterable    *bool
+			indexReversedTerms *bool
+			expectedErrMsg     string
+		}{
+			{
+				name:               "text with reversed terms",
+				dataType:           schema.DataTypeText,
+				indexReversedTerms: &vTrue,
+			},
+			{
+				name:               "text[] with filterable and reversed terms",
+				dataType:           schema.DataTypeTextArray,
+				indexFilterable:    &vTrue,
+				indexReversedTerms: &vTrue,
+			},
+			{
+				name:               "text without filterable",
+				dataType:           schema.DataTypeText,
+				indexFilterable:    &vFalse,
+				indexReversedTerms: &vTrue,
+				expectedErrMsg:     "`indexReversedTerms` requires `indexFilterable` to be enabled",
+			},
+			{
+				name:               "int with reversed terms",
+				dataType:           schema.DataTypeInt,
+				indexReversedTerms: &vTrue,
+				expectedErrMsg: "`indexReversedTerms` is allowed only for text/text[] data types. " +
+					"For other data types set false or leave empty",
+			},
+		}
+
+		mgr := newSchemaManager()
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := mgr.validatePropertyIndexing(&models.Property{
+					Name:               "prop",
+					DataType:           tc.dataType.PropString(),
+					IndexFilterable:    tc.indexFilterable,
+					IndexReversedTerms: tc.indexReversedTerms,
+				})
+
+				if tc.expectedErrMsg != "" {
+					assert.EqualError(t, err, tc.expectedErrMsg)
+				} else {
+					assert.Nil(t, err)
+				}
+			})
+		}
+	})
+
+	t.Run("validates indexRangeFilters", func(t *testing.T) {
+		vFalse := false
+		vTrue := true
+
+		testCases := []struct {
+			name              string
+			dataType          schema.DataType
+			indexFilterable   *bool
+			indexRangeFilters *bool
+			expectedErrMsg    string
+		}{
+			{
+				name:              "int with range filters",
+				dataType:          schema.DataTypeInt,
+				indexRangeFilters: &vTrue,
+			},
+			{
+				name:              "number with range filters only",
+				dataType:          schema.DataTypeNumber,
+				indexFilterable:   &vFalse,
+				indexRangeFilters: &vTrue,
+			},
+			{
+				name:              "date with range filters",
+				dataType:          schema.DataTypeDate,
+				indexRangeFilters: &vTrue,
+			},
+			{
+				name:              "text without range filters",
+				dataType:          schema.DataTypeText,
+				indexRangeFilters: &vFalse,
+			},
+			{
+				name:              "text with range filters",
+				dataType:          schema.DataTypeText,
+				indexRangeFilters: &vTrue,
+				expectedErrMsg: "`indexRangeFilters` is allowed only for int/number/date data types. " +
+					"For other data types set false or leave empty",
+			},
+			{
+				name:              "int[] with range filters",
+				dataType:          schema.DataTypeIntArray,
+				indexRangeFilters: &vTrue,
+				expectedErrMsg: "`indexRangeFilters` is allowed only for int/number/date data types. " +
+					"For other data types set false or leave empty",
+			},
+		}
+
+		mgr := newSchemaManager()
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := mgr.validatePropertyIndexing(&models.Property{
+					Name:              "prop",
+					DataType:          tc.dataType.PropString(),
+					IndexFilterable:   tc.indexFilterable,
+					IndexRangeFilters: tc.indexRangeFilters,
+				})
+
+				if tc.expectedErrMsg != "" {
+					assert.EqualError(t, err, tc.expectedErrMsg)
+				} else {
+					assert.Nil(t, err)
+				}
+			})
+		}
+	})
+
+	t.Run("validates indexNullState", func(t *testing.T) {
+		vFalse := false
+		vTrue := true
+
+		testCases := []struct {
+			name            string
+			dataType        schema.DataType
+			indexFilterable *bool
+			indexSearchable *bool
+			indexNullState  *bool
+			expectedErrMsg  string
+		}{
+			{
+				name:           "int with null state",
+				dataType:       schema.DataTypeInt,
+				indexNullState: &vTrue,
+			},
+			{
+				name:            "text with null state and searchable index only",
+				dataType:        schema.DataTypeText,
+				indexFilterable: &vFalse,
+				indexSearchable: &vTrue,
+				indexNullState:  &vTrue,
+			},
+			{
+				name:            "text without inverted index and without null state",
+				dataType:        schema.DataTypeText,
+				indexFilterable: &vFalse,
+				indexSearchable: &vFalse,
+				indexNullState:  &vFalse,
+			},
+			{
+				name:            "text without inverted index and with null state",
+				dataType:        schema.DataTypeText,
+				indexFilterable: &vFalse,
+				indexSearchable: &vFalse,
+				indexNullState:  &vTrue,
+				expectedErrMsg:  "`indexNullState` requires `indexFilterable` or `indexSearchable` to be enabled",
+			},
+			{
+				name:           "geoCoordinates with null state",
+				dataType:       schema.DataTypeGeoCoordinates,
+				indexNullState: &vTrue,
+				expectedErrMsg: "`indexNullState` is not allowed for geoCoordinates data type. " +
+					"Set false or leave empty",
+			},
+		}
+
+		mgr := newSchemaManager()
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := mgr.validatePropertyIndexing(&models.Property{
+					Name:            "prop",
+					DataType:        tc.dataType.PropString(),
+					IndexFilterable: tc.indexFilterable,
+					IndexSearchable: tc.indexSearchable,
+					IndexNullState:  tc.indexNullState,
+				})
+
+				if tc.expectedErrMsg != "" {
+					assert.EqualError(t, err, tc.expectedErrMsg)
+				} else {
+					assert.Nil(t, err)
+				}
+			})
+		}
+	})
+
+	t.Run("validates indexPropertyLength", func(t *testing.T) {
+		vFalse := false
+		vTrue := true
+
+		testCases := []struct {
+			name                string
+			dataType            schema.DataType
+			indexFilterable     *bool
+			indexSearchable     *bool
+			indexPropertyLength *bool
+			expectedErrMsg      string
+		}{
+			{
+				name:                "text with property length",
+				dataType:            schema.DataTypeText,
+				indexPropertyLength: &vTrue,
+			},
+			{
+				name:                "int[] with property length",
+				dataType:            schema.DataTypeIntArray,
+				indexPropertyLength: &vTrue,
+			},
+			{
+				name:                "int without property length",
+				dataType:            schema.DataTypeInt,
+				indexPropertyLength: &vFalse,
+			},
+			{
+				name:                "int with property length",
+				dataType:            schema.DataTypeInt,
+				indexPropertyLength: &vTrue,
+				expectedErrMsg: "`indexPropertyLength` is not allowed for int data type. " +
+					"Set false or leave empty",
+			},
+			{
+				name:                "text without inverted index and with property length",
+				dataType:            schema.DataTypeText,
+				indexFilterable:     &vFalse,
+				indexSearchable:     &vFalse,
+				indexPropertyLength: &vTrue,
+				expectedErrMsg:      "`indexPropertyLength` requires `indexFilterable` or `indexSearchable` to be enabled",
+			},
+		}
+
+		mgr := newSchemaManager()
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := mgr.validatePropertyIndexing(&models.Property{
+					Name:                "prop",
+					DataType:            tc.dataType.PropString(),
+					IndexFilterable:     tc.indexFilterable,
+					IndexSearchable:     tc.indexSearchable,
+					IndexPropertyLength: tc.indexPropertyLength,
+				})
+
+				if tc.expectedErrMsg != "" {
+					assert.EqualError(t, err, tc.expectedErrMsg)
+				} else {
+					assert.Nil(t, err)
+				}
+			})
+		}
+	})
 }
 
 type fakePropertyDataType struct {
@@ -556,6 +942,14 @@ func (pdt *fakePropertyDataType) IsReference() bool {
 	return !pdt.IsPrimitive()
 }
 
+func (pdt *fakePropertyDataType) IsNested() bool {
+	return false
+}
+
+func (pdt *fakePropertyDataType) AsNested() schema.DataType {
+	return ""
+}
+
 func (pdt *fakePropertyDataType) Classes() []schema.ClassName {
 	if pdt.IsPrimitive() {
 		return nil
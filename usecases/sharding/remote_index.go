@@ -21,6 +21,8 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -37,6 +39,7 @@ type RemoteIndex struct {
 type shardingStateGetter interface {
 	// ShardOwner returns id of owner node
 	ShardOwner(class, shard string) (string, error)
+	GetSchemaSkipAuth() schema.Schema
 }
 
 func NewRemoteIndex(className string,
@@ -51,6 +54,13 @@ func NewRemoteIndex(className string,
 	}
 }
 
+// getClass returns the class of the index, the objects received from the
+// remote shards are typed by the data types declared in it
+func (ri *RemoteIndex) getClass() *models.Class {
+	sch := ri.stateGetter.GetSchemaSkipAuth()
+	return sch.GetClass(schema.ClassName(ri.class))
+}
+
 type nodeResolver interface {
 	NodeHostname(nodeName string) (string, bool)
 }
@@ -64,7 +74,7 @@ type RemoteIndexClient interface {
 		refs objects.BatchReferences) []error
 	GetObject(ctx context.Context, hostname, indexName, shardName string,
 		id strfmt.UUID, props search.SelectProperties,
-		additional additional.Properties) (*storobj.Object, error)
+		additional additional.Properties, class *models.Class) (*storobj.Object, error)
 	Exists(ctx context.Context, hostname, indexName, shardName string,
 		id strfmt.UUID) (bool, error)
 	DeleteObject(ctx context.Context, hostname, indexName, shardName string,
@@ -72,12 +82,13 @@ type RemoteIndexClient interface {
 	MergeObject(ctx context.Context, hostname, indexName, shardName string,
 		mergeDoc objects.MergeDocument) error
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
-		ids []strfmt.UUID) ([]*storobj.Object, error)
+		ids []strfmt.UUID, class *models.Class) ([]*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
-		searchVector []float32, limit int, filters *filters.LocalFilter,
+		searchVector []float32, targetVector string, indexParams *searchparams.VectorIndex,
+		limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
-		additional additional.Properties,
+		additional additional.Properties, class *models.Class,
 	) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, hostname, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
@@ -217,7 +228,8 @@ func (ri *RemoteIndex) GetObject(ctx context.Context, shardName string,
 		return nil, errors.Errorf("resolve node name %q to host", owner)
 	}
 
-	return ri.client.GetObject(ctx, host, ri.class, shardName, id, props, additional)
+	return ri.client.GetObject(ctx, host, ri.class, shardName, id, props,
+		additional, ri.getClass())
 }
 
 func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
@@ -233,11 +245,12 @@ func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 		return nil, errors.Errorf("resolve node name %q to host", owner)
 	}
 
-	return ri.client.MultiGetObjects(ctx, host, ri.class, shardName, ids)
+	return ri.client.MultiGetObjects(ctx, host, ri.class, shardName, ids, ri.getClass())
 }
 
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
-	searchVector []float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, indexParams *searchparams.VectorIndex,
+	limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	additional additional.Properties, replEnabled bool,
@@ -252,8 +265,8 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
 		return nil, nil, errors.Errorf("resolve node name %q to host", owner)
 	}
 
-	objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shardName, searchVector, limit,
-		filters, keywordRanking, sort, cursor, groupBy, additional)
+	objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shardName, searchVector, targetVector, indexParams, limit,
+		filters, keywordRanking, sort, cursor, groupBy, additional, ri.getClass())
 	if replEnabled {
 		storobj.AddOwnership(objs, owner, shardName)
 	}
@@ -57,7 +57,7 @@ type explorerMetrics interface {
 type ModulesProvider interface {
 	ValidateSearchParam(name string, value interface{}, className string) error
 	CrossClassValidateSearchParam(name string, value interface{}) error
-	VectorFromSearchParam(ctx context.Context, className string, param string,
+	VectorFromSearchParam(ctx context.Context, className, targetVector string, param string,
 		params interface{}, findVectorFn modulecapabilities.FindVectorFn, tenant string) ([]float32, error)
 	CrossClassVectorFromSearchParam(ctx context.Context, param string,
 		params interface{}, findVectorFn modulecapabilities.FindVectorFn) ([]float32, error)
@@ -67,7 +67,8 @@ type ModulesProvider interface {
 	ListExploreAdditionalExtend(ctx context.Context, in []search.Result,
 		moduleParams map[string]interface{},
 		argumentModuleParams map[string]interface{}) ([]search.Result, error)
-	VectorFromInput(ctx context.Context, className string, input string) ([]float32, error)
+	VectorFromInput(ctx context.Context, className, targetVector string, input string) ([]float32, error)
+	TextTransformer(argument string) modulecapabilities.TextTransform
 }
 
 type objectsSearcher interface {
@@ -90,8 +91,8 @@ type objectsSearcher interface {
 
 type hybridSearcher interface {
 	SparseObjectSearch(ctx context.Context, params dto.GetParams) ([]*storobj.Object, []float32, error)
-	DenseObjectSearch(context.Context, string, []float32, int, int,
-		*filters.LocalFilter, additional.Properties, string) ([]*storobj.Object, []float32, error)
+	DenseObjectSearch(context.Context, string, []float32, string, *searchparams.VectorIndex,
+		int, int, *filters.LocalFilter, additional.Properties, string) ([]*storobj.Object, []float32, error)
 	ResolveReferences(ctx context.Context, objs search.Results, props search.SelectProperties,
 		groupBy *searchparams.GroupBy, additional additional.Properties, tenant string) (search.Results, error)
 }
@@ -137,6 +138,18 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if err := e.validateTargetVector(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'targetVectors' parameter")
+	}
+
+	if err := e.validateKeywordProperties(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'properties' parameter")
+	}
+
+	if err := e.validateGroupBy(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'groupBy' parameter")
+	}
+
 	if params.KeywordRanking != nil {
 		return e.getClassKeywordBased(ctx, params)
 	}
@@ -157,6 +170,11 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("keyword search (bm25) must have query set")
 	}
 
+	argumentModuleParams, err := e.autocorrectKeywordRanking(&params)
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class: %v", err)
+	}
+
 	if len(params.AdditionalProperties.ModuleParams) > 0 {
 		// if a module-specific additional prop is set, assume it needs the vector
 		// present for backward-compatibility. This could be improved by actually
@@ -173,6 +191,10 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
 
+	if params.GroupBy != nil {
+		res = groupSearchResults(res, params.GroupBy)
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -184,7 +206,7 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 
 	if e.modulesProvider != nil {
 		res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res,
-			params.AdditionalProperties.ModuleParams, nil, params.ModuleParams)
+			params.AdditionalProperties.ModuleParams, nil, argumentModuleParams)
 		if err != nil {
 			return nil, errors.Errorf("explorer: get class: extend: %v", err)
 		}
@@ -196,13 +218,6 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]interface{}, error) {
-	searchVector, err := e.vectorFromParams(ctx, params)
-	if err != nil {
-		return nil, errors.Errorf("explorer: get class: vectorize params: %v", err)
-	}
-
-	params.SearchVector = searchVector
-
 	if len(params.AdditionalProperties.ModuleParams) > 0 || params.Group != nil {
 		// if a module-specific additional prop is set, assume it needs the vector
 		// present for backward-compatibility. This could be improved by actually
@@ -211,9 +226,29 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 		params.AdditionalProperties.Vector = true
 	}
 
-	res, err := e.searcher.VectorSearch(ctx, params)
-	if err != nil {
-		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
+	var (
+		res          []search.Result
+		searchVector []float32
+		err          error
+	)
+	if len(params.TargetVectors) > 0 {
+		res, searchVector, err = e.multiTargetVectorSearch(ctx, params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: get class: multi target vector search: %v", err)
+		}
+		params.SearchVector = searchVector
+	} else {
+		searchVector, err = e.vectorFromParams(ctx, params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: get class: vectorize params: %v", err)
+		}
+
+		params.SearchVector = searchVector
+
+		res, err = e.searcher.VectorSearch(ctx, params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: get class: vector search: %v", err)
+		}
 	}
 
 	if params.Pagination.Autocut > 0 {
@@ -268,8 +303,15 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		if hybridSearchLimit <= 0 {
 			hybridSearchLimit = hybrid.DefaultLimit
 		}
+		if len(params.TargetVectors) > 0 {
+			vectors, err := e.hybridTargetVectors(ctx, params, vec)
+			if err != nil {
+				return nil, nil, err
+			}
+			return e.multiTargetDenseSearch(ctx, params, vectors, hybridSearchLimit)
+		}
 		res, dists, err := e.searcher.DenseObjectSearch(ctx,
-			params.ClassName, vec, 0, hybridSearchLimit, params.Filters,
+			params.ClassName, vec, params.TargetVector, params.VectorIndexParams, 0, hybridSearchLimit, params.Filters,
 			params.AdditionalProperties, params.Tenant)
 		if err != nil {
 			return nil, nil, err
@@ -291,6 +333,7 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		HybridSearch: params.HybridSearch,
 		Keyword:      params.KeywordRanking,
 		Class:        params.ClassName,
+		TargetVector: hybridTargetVector(params),
 		Autocut:      params.Pagination.Autocut,
 	}, e.logger, sparseSearch, denseSearch,
 		postProcess, e.modulesProvider)
@@ -345,11 +388,19 @@ func (e *Explorer) getClassList(ctx context.Context,
 	}
 	var res []search.Result
 	var err error
+	argumentModuleParams := params.ModuleParams
 	if params.HybridSearch != nil {
+		argumentModuleParams, err = e.autocorrectHybridSearch(&params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: list class: %v", err)
+		}
 		res, err = e.Hybrid(ctx, params)
 		if err != nil {
 			return nil, err
 		}
+		if params.GroupBy != nil {
+			res = groupSearchResults(res, params.GroupBy)
+		}
 	} else {
 		res, err = e.searcher.Search(ctx, params)
 		if err != nil {
@@ -372,7 +423,7 @@ func (e *Explorer) getClassList(ctx context.Context,
 
 	if e.modulesProvider != nil {
 		res, err = e.modulesProvider.ListExploreAdditionalExtend(ctx, res,
-			params.AdditionalProperties.ModuleParams, params.ModuleParams)
+			params.AdditionalProperties.ModuleParams, argumentModuleParams)
 		if err != nil {
 			return nil, errors.Errorf("explorer: list class: extend: %v", err)
 		}
@@ -628,7 +679,7 @@ func (e *Explorer) vectorFromParams(ctx context.Context,
 	params dto.GetParams,
 ) ([]float32, error) {
 	return e.nearParamsVector.vectorFromParams(ctx, params.NearVector,
-		params.NearObject, params.ModuleParams, params.ClassName, params.Tenant)
+		params.NearObject, params.ModuleParams, params.ClassName, params.TargetVector, params.Tenant)
 }
 
 func (e *Explorer) vectorFromExploreParams(ctx context.Context,
@@ -689,12 +740,12 @@ func (e *Explorer) checkCertaintyCompatibility(className string) error {
 	if class == nil {
 		return errors.Errorf("failed to get class: %s", className)
 	}
-	hnswConfig, err := typeAssertVectorIndex(class)
+	distance, err := vectorIndexDistance(class)
 	if err != nil {
 		return err
 	}
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if distance != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(distance)
 	}
 
 	return nil
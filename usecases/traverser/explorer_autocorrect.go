@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+// autocorrectKeywordRanking replaces the bm25 query with its autocorrected
+// version if requested. The returned argument module params contain the
+// original bm25 params, so that modules like text-spellcheck can report the
+// original and the corrected query
+func (e *Explorer) autocorrectKeywordRanking(params *dto.GetParams) (map[string]interface{}, error) {
+	if !params.KeywordRanking.Autocorrect {
+		return params.ModuleParams, nil
+	}
+
+	corrected, err := e.autocorrect("bm25", params.KeywordRanking.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	original := *params.KeywordRanking
+	keywordRanking := original
+	keywordRanking.Query = corrected
+	params.KeywordRanking = &keywordRanking
+	return withArgumentModuleParam(params.ModuleParams, "bm25", &original), nil
+}
+
+// autocorrectHybridSearch replaces the hybrid query with its autocorrected
+// version if requested, see autocorrectKeywordRanking
+func (e *Explorer) autocorrectHybridSearch(params *dto.GetParams) (map[string]interface{}, error) {
+	if !params.HybridSearch.Autocorrect || params.HybridSearch.Query == "" {
+		return params.ModuleParams, nil
+	}
+
+	corrected, err := e.autocorrect("hybrid", params.HybridSearch.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	original := *params.HybridSearch
+	hybridSearch := original
+	hybridSearch.Query = corrected
+	params.HybridSearch = &hybridSearch
+	return withArgumentModuleParam(params.ModuleParams, "hybrid", &original), nil
+}
+
+func (e *Explorer) autocorrect(argument, query string) (string, error) {
+	if e.modulesProvider == nil {
+		return "", errors.Errorf("%s: autocorrect requires a text transformer module", argument)
+	}
+	transformer := e.modulesProvider.TextTransformer(argument)
+	if transformer == nil {
+		return "", errors.Errorf("%s: autocorrect requires a text transformer module", argument)
+	}
+
+	corrected, err := transformer.Transform([]string{query})
+	if err != nil {
+		return "", errors.Wrapf(err, "%s: autocorrect", argument)
+	}
+	if len(corrected) != 1 {
+		return "", errors.Errorf("%s: autocorrect: expected 1 text, got %d", argument, len(corrected))
+	}
+	return corrected[0], nil
+}
+
+func withArgumentModuleParam(moduleParams map[string]interface{},
+	name string, value interface{},
+) map[string]interface{} {
+	out := make(map[string]interface{}, len(moduleParams)+1)
+	for key, param := range moduleParams {
+		out[key] = param
+	}
+	out[name] = value
+	return out
+}
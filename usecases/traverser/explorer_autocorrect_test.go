@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_Autocorrect(t *testing.T) {
+	t.Run("bm25 query is autocorrected", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:        "bm25",
+				Query:       "SEARCH QUERY",
+				Autocorrect: true,
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, &fakeModulesProvider{
+			textTransformers: map[string]modulecapabilities.TextTransform{
+				"bm25": &fakeLowercaseTransformer{},
+			},
+		}, nil)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass"},
+			}}},
+		})
+
+		expectedParams := params
+		expectedParams.KeywordRanking = &searchparams.KeywordRanking{
+			Type:        "bm25",
+			Query:       "search query",
+			Autocorrect: true,
+		}
+		searcher.
+			On("Search", expectedParams).
+			Return([]search.Result{}, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+	})
+
+	t.Run("argument module params contain the original query", func(t *testing.T) {
+		explorer := NewExplorer(nil, nil, &fakeModulesProvider{
+			textTransformers: map[string]modulecapabilities.TextTransform{
+				"hybrid": &fakeLowercaseTransformer{},
+			},
+		}, nil)
+		params := dto.GetParams{
+			HybridSearch: &searchparams.HybridSearch{Query: "SEARCH QUERY", Autocorrect: true},
+		}
+
+		argumentModuleParams, err := explorer.autocorrectHybridSearch(&params)
+
+		require.Nil(t, err)
+		assert.Equal(t, "search query", params.HybridSearch.Query)
+		original, ok := argumentModuleParams["hybrid"].(*searchparams.HybridSearch)
+		require.True(t, ok)
+		assert.Equal(t, "SEARCH QUERY", original.Query)
+	})
+
+	t.Run("query is kept without autocorrect", func(t *testing.T) {
+		explorer := NewExplorer(nil, nil, &fakeModulesProvider{}, nil)
+		params := dto.GetParams{
+			KeywordRanking: &searchparams.KeywordRanking{Query: "SEARCH QUERY"},
+		}
+
+		argumentModuleParams, err := explorer.autocorrectKeywordRanking(&params)
+
+		require.Nil(t, err)
+		assert.Equal(t, "SEARCH QUERY", params.KeywordRanking.Query)
+		assert.Nil(t, argumentModuleParams)
+	})
+
+	t.Run("autocorrect fails without text transformer", func(t *testing.T) {
+		explorer := NewExplorer(nil, nil, &fakeModulesProvider{}, nil)
+		params := dto.GetParams{
+			KeywordRanking: &searchparams.KeywordRanking{Query: "SEARCH QUERY", Autocorrect: true},
+		}
+
+		_, err := explorer.autocorrectKeywordRanking(&params)
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "requires a text transformer module")
+	})
+}
+
+type fakeLowercaseTransformer struct{}
+
+func (t *fakeLowercaseTransformer) Transform(in []string) ([]string, error) {
+	out := make([]string, len(in))
+	for i := range in {
+		out[i] = strings.ToLower(in[i])
+	}
+	return out, nil
+}
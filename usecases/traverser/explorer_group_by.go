@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// groupSearchResults collapses ranked results by the value of the groupBy
+// property. It follows the semantics of the grouper used for vector search
+// in the storage layer: results are consumed in order, an object can belong
+// to several groups if the property holds multiple values and the head of
+// each group is its highest ranked hit.
+func groupSearchResults(res []search.Result, groupBy *searchparams.GroupBy) []search.Result {
+	groupsOrdered := []string{}
+	groups := map[string][]int{}
+
+RESULTS_LOOP:
+	for i := range res {
+		for _, val := range groupByValues(res[i].Schema, groupBy.Property) {
+			current, groupExists := groups[val]
+			if len(current) >= groupBy.ObjectsPerGroup {
+				continue
+			}
+
+			if !groupExists && len(groups) >= groupBy.Groups {
+				continue RESULTS_LOOP
+			}
+
+			groups[val] = append(current, i)
+			if !groupExists {
+				groupsOrdered = append(groupsOrdered, val)
+			}
+		}
+	}
+
+	out := make([]search.Result, len(groupsOrdered))
+	for i, val := range groupsOrdered {
+		positions := groups[val]
+		hits := make([]map[string]interface{}, len(positions))
+		for j, pos := range positions {
+			hits[j] = groupHit(res[pos])
+		}
+
+		// an object can head more than one group, so the additional
+		// properties must not be shared between the heads
+		head := res[positions[0]]
+		addl := models.AdditionalProperties{}
+		for k, v := range head.AdditionalProperties {
+			addl[k] = v
+		}
+		addl["group"] = &additional.Group{
+			ID: i,
+			GroupedBy: &additional.GroupedBy{
+				Value: val,
+				Path:  []string{groupBy.Property},
+			},
+			Count:       len(hits),
+			Hits:        hits,
+			MinDistance: res[positions[0]].Dist,
+			MaxDistance: res[positions[len(positions)-1]].Dist,
+		}
+		head.AdditionalProperties = addl
+		out[i] = head
+	}
+
+	return out
+}
+
+func groupHit(res search.Result) map[string]interface{} {
+	hit := map[string]interface{}{}
+	if props, ok := res.Schema.(map[string]interface{}); ok {
+		for k, v := range props {
+			hit[k] = v
+		}
+	}
+	hit["_additional"] = &additional.GroupHitAdditional{
+		ID:       res.ID.String(),
+		Vector:   res.Vector,
+		Distance: res.Dist,
+		Score:    res.Score,
+	}
+	return hit
+}
+
+// groupByValues returns the values an object is grouped by. Objects which
+// don't have the property set are grouped under the empty value.
+func groupByValues(props models.PropertySchema, property string) []string {
+	schemaMap, ok := props.(map[string]interface{})
+	if !ok {
+		return []string{""}
+	}
+
+	var values []string
+	switch v := schemaMap[property].(type) {
+	case nil:
+	case models.MultipleRef:
+		for _, ref := range v {
+			values = append(values, ref.Beacon.String())
+		}
+	case []interface{}:
+		for _, elem := range v {
+			values = append(values, groupByValue(elem))
+		}
+	case []string:
+		values = append(values, v...)
+	case []float64:
+		for _, elem := range v {
+			values = append(values, fmt.Sprint(elem))
+		}
+	case []bool:
+		for _, elem := range v {
+			values = append(values, fmt.Sprint(elem))
+		}
+	default:
+		values = append(values, groupByValue(v))
+	}
+
+	if len(values) == 0 {
+		return []string{""}
+	}
+	return values
+}
+
+func groupByValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case search.LocalRef:
+		// references which were already resolved are grouped by the beacon
+		// they were resolved from
+		if id, ok := v.Fields["id"]; ok {
+			return crossref.NewLocalhost(v.Class, strfmt.UUID(fmt.Sprint(id))).String()
+		}
+		return v.Class
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	testLogger "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func groupByTestResult(id strfmt.UUID, score float32, document interface{}) search.Result {
+	props := map[string]interface{}{"text": string(id)}
+	if document != nil {
+		props["document"] = document
+	}
+	return search.Result{ID: id, Score: score, Schema: props}
+}
+
+func Test_GroupSearchResults(t *testing.T) {
+	res := []search.Result{
+		groupByTestResult("A", 0.9, "doc1"),
+		groupByTestResult("B", 0.8, "doc2"),
+		groupByTestResult("C", 0.7, "doc1"),
+		groupByTestResult("D", 0.6, "doc1"),
+		groupByTestResult("E", 0.5, "doc3"),
+		groupByTestResult("F", 0.4, "doc2"),
+	}
+
+	grouped := groupSearchResults(res, &searchparams.GroupBy{
+		Property:        "document",
+		Groups:          2,
+		ObjectsPerGroup: 2,
+	})
+	require.Len(t, grouped, 2)
+
+	expected := []struct {
+		headID strfmt.UUID
+		value  string
+		hitIDs []string
+		scores []float32
+	}{
+		{headID: "A", value: "doc1", hitIDs: []string{"A", "C"}, scores: []float32{0.9, 0.7}},
+		{headID: "B", value: "doc2", hitIDs: []string{"B", "F"}, scores: []float32{0.8, 0.4}},
+	}
+	for i, exp := range expected {
+		assert.Equal(t, exp.headID, grouped[i].ID)
+		group, ok := grouped[i].AdditionalProperties["group"].(*additional.Group)
+		require.True(t, ok)
+		assert.Equal(t, i, group.ID)
+		assert.Equal(t, exp.value, group.GroupedBy.Value)
+		assert.Equal(t, []string{"document"}, group.GroupedBy.Path)
+		assert.Equal(t, len(exp.hitIDs), group.Count)
+		require.Len(t, group.Hits, len(exp.hitIDs))
+		for j, hit := range group.Hits {
+			addl := hit["_additional"].(*additional.GroupHitAdditional)
+			assert.Equal(t, exp.hitIDs[j], addl.ID)
+			assert.Equal(t, exp.scores[j], addl.Score)
+			assert.Equal(t, exp.hitIDs[j], hit["text"])
+		}
+	}
+
+	t.Run("multiple values and missing values", func(t *testing.T) {
+		res := []search.Result{
+			groupByTestResult("A", 0.9, []interface{}{"red", "blue"}),
+			groupByTestResult("B", 0.8, nil),
+			groupByTestResult("C", 0.7, []interface{}{"blue"}),
+		}
+
+		grouped := groupSearchResults(res, &searchparams.GroupBy{
+			Property:        "document",
+			Groups:          3,
+			ObjectsPerGroup: 3,
+		})
+		require.Len(t, grouped, 3)
+
+		values := make([]string, len(grouped))
+		counts := make([]int, len(grouped))
+		for i := range grouped {
+			group := grouped[i].AdditionalProperties["group"].(*additional.Group)
+			values[i] = group.GroupedBy.Value
+			counts[i] = group.Count
+		}
+		assert.Equal(t, []string{"red", "blue", ""}, values)
+		assert.Equal(t, []int{1, 2, 1}, counts)
+		// the object heading two groups must not share its group
+		assert.Equal(t, strfmt.UUID("A"), grouped[0].ID)
+		assert.Equal(t, strfmt.UUID("A"), grouped[1].ID)
+		assert.NotSame(t, grouped[0].AdditionalProperties["group"],
+			grouped[1].AdditionalProperties["group"])
+	})
+}
+
+func Test_Explorer_GroupByKeywordSearch(t *testing.T) {
+	params := dto.GetParams{
+		ClassName:      "BestClass",
+		KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "foo"},
+		Pagination:     &filters.Pagination{Limit: 100},
+		GroupBy: &searchparams.GroupBy{
+			Property:        "document",
+			Groups:          2,
+			ObjectsPerGroup: 1,
+		},
+	}
+
+	searcher := &fakeVectorSearcher{}
+	log, _ := testLogger.NewNullLogger()
+	explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+	explorer.SetSchemaGetter(&fakeSchemaGetter{
+		schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+			{
+				Class: "BestClass",
+				Properties: []*models.Property{
+					{Name: "text", DataType: schema.DataTypeText.PropString()},
+					{Name: "document", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		}}},
+	})
+	searcher.On("Search", params).Return([]search.Result{
+		groupByTestResult("A", 0.9, "doc1"),
+		groupByTestResult("B", 0.8, "doc1"),
+		groupByTestResult("C", 0.7, "doc2"),
+	}, nil)
+
+	res, err := explorer.GetClass(context.Background(), params)
+	require.Nil(t, err)
+	require.Len(t, res, 2)
+
+	values := []string{}
+	for _, obj := range res {
+		addl := obj.(map[string]interface{})["_additional"].(map[string]interface{})
+		values = append(values, addl["group"].(*additional.Group).GroupedBy.Value)
+	}
+	assert.Equal(t, []string{"doc1", "doc2"}, values)
+}
+
+func Test_Explorer_ValidateGroupBy(t *testing.T) {
+	explorer := &Explorer{schemaGetter: &fakeSchemaGetter{
+		schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+			{
+				Class: "BestClass",
+				Properties: []*models.Property{
+					{Name: "document", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		}}},
+	}}
+	bm25 := &searchparams.KeywordRanking{Type: "bm25", Query: "foo"}
+
+	tests := []struct {
+		name        string
+		params      dto.GetParams
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			params: dto.GetParams{
+				ClassName: "BestClass", KeywordRanking: bm25,
+				GroupBy: &searchparams.GroupBy{Property: "document", Groups: 1, ObjectsPerGroup: 1},
+			},
+		},
+		{
+			name: "no groups",
+			params: dto.GetParams{
+				ClassName: "BestClass", KeywordRanking: bm25,
+				GroupBy: &searchparams.GroupBy{Property: "document", ObjectsPerGroup: 1},
+			},
+			expectedErr: "groups must be greater than 0",
+		},
+		{
+			name: "no objects per group",
+			params: dto.GetParams{
+				ClassName: "BestClass", KeywordRanking: bm25,
+				GroupBy: &searchparams.GroupBy{Property: "document", Groups: 1},
+			},
+			expectedErr: "objectsPerGroup must be greater than 0",
+		},
+		{
+			name: "unknown property",
+			params: dto.GetParams{
+				ClassName: "BestClass", KeywordRanking: bm25,
+				GroupBy: &searchparams.GroupBy{Property: "unknown", Groups: 1, ObjectsPerGroup: 1},
+			},
+			expectedErr: "unrecognized property",
+		},
+		{
+			name: "without search",
+			params: dto.GetParams{
+				ClassName: "BestClass",
+				GroupBy:   &searchparams.GroupBy{Property: "document", Groups: 1, ObjectsPerGroup: 1},
+			},
+			expectedErr: "groupBy can only be set with a vector, hybrid or bm25 search",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := explorer.validateGroupBy(test.params)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
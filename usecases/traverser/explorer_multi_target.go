@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
+)
+
+// multiTargetVectorSearch performs a vector search over several named vectors
+// and returns the results ordered by their joined distance. The returned
+// search vector is the one of the first target vector.
+func (e *Explorer) multiTargetVectorSearch(ctx context.Context,
+	params dto.GetParams,
+) ([]search.Result, []float32, error) {
+	vectors := make(map[string][]float32, len(params.TargetVectors))
+	for _, targetVector := range params.TargetVectors {
+		targetParams := params
+		targetParams.TargetVector = targetVector
+		vector, err := e.vectorFromParams(ctx, targetParams)
+		if err != nil {
+			return nil, nil, fmt.Errorf("vectorize params of target vector %q: %w", targetVector, err)
+		}
+		vectors[targetVector] = vector
+	}
+
+	limit := params.Pagination.Offset + params.Pagination.Limit
+	if params.Pagination.Limit <= 0 {
+		limit = hybrid.DefaultLimit
+	}
+
+	objs, dists, err := e.multiTargetDenseSearch(ctx, params, vectors, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if maxDist, ok := multiTargetMaxDistance(params); ok {
+		cut := sort.Search(len(dists), func(i int) bool { return dists[i] > maxDist })
+		objs, dists = objs[:cut], dists[:cut]
+	}
+	if params.Pagination.Offset >= len(objs) {
+		return []search.Result{}, vectors[params.TargetVectors[0]], nil
+	}
+	objs, dists = objs[params.Pagination.Offset:], dists[params.Pagination.Offset:]
+
+	res, err := e.searcher.ResolveReferences(ctx,
+		storobj.SearchResultsWithDists(objs, params.AdditionalProperties, dists),
+		params.Properties, nil, params.AdditionalProperties, params.Tenant)
+	if err != nil {
+		return nil, nil, fmt.Errorf("resolve references: %w", err)
+	}
+
+	return res, vectors[params.TargetVectors[0]], nil
+}
+
+// hybridTargetVector returns the target vector the hybrid searcher vectorizes
+// the query for, with multiple target vectors this is the first of them
+func hybridTargetVector(params dto.GetParams) string {
+	if len(params.TargetVectors) > 0 {
+		return params.TargetVectors[0]
+	}
+	return params.TargetVector
+}
+
+// hybridTargetVectors returns the search vector of each target vector of a
+// hybrid search. The given vector was determined by the hybrid searcher for
+// the first target vector, the query is vectorized for all others unless the
+// user provided the vector.
+func (e *Explorer) hybridTargetVectors(ctx context.Context, params dto.GetParams,
+	vector []float32,
+) (map[string][]float32, error) {
+	vectors := make(map[string][]float32, len(params.TargetVectors))
+	vectors[params.TargetVectors[0]] = vector
+	for _, targetVector := range params.TargetVectors[1:] {
+		if len(params.HybridSearch.Vector) > 0 || e.modulesProvider == nil {
+			vectors[targetVector] = vector
+			continue
+		}
+		targetVec, err := e.modulesProvider.VectorFromInput(ctx, params.ClassName,
+			targetVector, params.HybridSearch.Query)
+		if err != nil {
+			return nil, fmt.Errorf("vectorize query for target vector %q: %w", targetVector, err)
+		}
+		vectors[targetVector] = targetVec
+	}
+	return vectors, nil
+}
+
+// multiTargetDenseSearch searches each target vector with its own search
+// vector and joins the distances of every object found. Objects which were
+// not among the results of all target vectors are searched again restricted
+// to their ids, so that their joined distance takes every target vector into
+// account. Objects without a distance to every target vector are dropped
+// unless the distances are joined by their minimum.
+func (e *Explorer) multiTargetDenseSearch(ctx context.Context, params dto.GetParams,
+	vectors map[string][]float32, limit int,
+) ([]*storobj.Object, []float32, error) {
+	objects := map[strfmt.UUID]*storobj.Object{}
+	dists := make(map[string]map[strfmt.UUID]float32, len(params.TargetVectors))
+
+	for _, targetVector := range params.TargetVectors {
+		res, resDists, err := e.searcher.DenseObjectSearch(ctx, params.ClassName,
+			vectors[targetVector], targetVector, params.VectorIndexParams, 0, limit,
+			params.Filters, params.AdditionalProperties, params.Tenant)
+		if err != nil {
+			return nil, nil, fmt.Errorf("search target vector %q: %w", targetVector, err)
+		}
+
+		dists[targetVector] = make(map[strfmt.UUID]float32, len(res))
+		for i, obj := range res {
+			objects[obj.ID()] = obj
+			dists[targetVector][obj.ID()] = resDists[i]
+		}
+	}
+
+	for _, targetVector := range params.TargetVectors {
+		var missing []strfmt.UUID
+		for id := range objects {
+			if _, ok := dists[targetVector][id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		res, resDists, err := e.searcher.DenseObjectSearch(ctx, params.ClassName,
+			vectors[targetVector], targetVector, params.VectorIndexParams, 0, len(missing),
+			filtersWithIDs(params.ClassName, params.Filters, missing),
+			params.AdditionalProperties, params.Tenant)
+		if err != nil {
+			return nil, nil, fmt.Errorf("search missing objects of target vector %q: %w",
+				targetVector, err)
+		}
+		for i, obj := range res {
+			dists[targetVector][obj.ID()] = resDists[i]
+		}
+	}
+
+	type joined struct {
+		obj  *storobj.Object
+		dist float32
+	}
+	found := make([]joined, 0, len(objects))
+	for id, obj := range objects {
+		dist, ok := joinTargetDistances(params.TargetCombination, params.TargetVectors, dists, id)
+		if ok {
+			found = append(found, joined{obj: obj, dist: dist})
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].dist == found[j].dist {
+			return found[i].obj.ID() < found[j].obj.ID()
+		}
+		return found[i].dist < found[j].dist
+	})
+	if len(found) > limit {
+		found = found[:limit]
+	}
+
+	objs := make([]*storobj.Object, len(found))
+	outDists := make([]float32, len(found))
+	for i := range found {
+		objs[i] = found[i].obj
+		outDists[i] = found[i].dist
+	}
+	return objs, outDists, nil
+}
+
+// joinTargetDistances joins the distances of an object to each of the target
+// vectors. It returns false if the object can't be scored by the combination.
+func joinTargetDistances(combination *dto.TargetCombination, targetVectors []string,
+	dists map[string]map[strfmt.UUID]float32, id strfmt.UUID,
+) (float32, bool) {
+	combinationType := dto.TargetCombinationMinimum
+	if combination != nil {
+		combinationType = combination.Type
+	}
+
+	if combinationType == dto.TargetCombinationMinimum {
+		var (
+			minDist float32
+			found   bool
+		)
+		for _, targetVector := range targetVectors {
+			dist, ok := dists[targetVector][id]
+			if ok && (!found || dist < minDist) {
+				minDist, found = dist, true
+			}
+		}
+		return minDist, found
+	}
+
+	var joined float32
+	for i, targetVector := range targetVectors {
+		dist, ok := dists[targetVector][id]
+		if !ok {
+			return 0, false
+		}
+		switch combinationType {
+		case dto.TargetCombinationManualWeights:
+			joined += combination.Weights[i] * dist
+		default:
+			joined += dist
+		}
+	}
+	if combinationType == dto.TargetCombinationAverage {
+		joined /= float32(len(targetVectors))
+	}
+	return joined, true
+}
+
+// multiTargetMaxDistance returns the distance or certainty threshold of the
+// search, it is applied to the joined distances
+func multiTargetMaxDistance(params dto.GetParams) (float32, bool) {
+	if certainty := ExtractCertaintyFromParams(params); certainty != 0 {
+		return float32(additional.CertaintyToDist(certainty)), true
+	}
+	if dist, withDistance := ExtractDistanceFromParams(params); withDistance {
+		return float32(dist), true
+	}
+	return 0, false
+}
+
+// filtersWithIDs restricts the given filters to objects with one of the ids
+func filtersWithIDs(className string, where *filters.LocalFilter,
+	ids []strfmt.UUID,
+) *filters.LocalFilter {
+	idClauses := make([]filters.Clause, len(ids))
+	for i, id := range ids {
+		idClauses[i] = filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(className),
+				Property: filters.InternalPropID,
+			},
+			Value: &filters.Value{
+				Value: id.String(),
+				Type:  schema.DataTypeText,
+			},
+		}
+	}
+
+	root := &filters.Clause{Operator: filters.OperatorOr, Operands: idClauses}
+	if where != nil && where.Root != nil {
+		root = &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{*where.Root, *root},
+		}
+	}
+	return &filters.LocalFilter{Root: root}
+}
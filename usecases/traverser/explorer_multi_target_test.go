@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	testLogger "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func Test_Explorer_MultiTargetVectorSearch(t *testing.T) {
+	// with a limit of 2 "title" finds A and B and "body" finds C and B, the
+	// missing distances of A and C are found by searching their ids
+	dists := map[string]map[strfmt.UUID]float32{
+		"title": {"A": 0.1, "B": 0.3, "C": 0.5},
+		"body":  {"A": 0.9, "B": 0.3, "C": 0.2},
+	}
+
+	tests := []struct {
+		name          string
+		combination   *dto.TargetCombination
+		expectedIDs   []strfmt.UUID
+		expectedDists []float32
+	}{
+		{
+			name:          "minimum",
+			combination:   nil,
+			expectedIDs:   []strfmt.UUID{"A", "C"},
+			expectedDists: []float32{0.1, 0.2},
+		},
+		{
+			name:          "sum",
+			combination:   &dto.TargetCombination{Type: dto.TargetCombinationSum},
+			expectedIDs:   []strfmt.UUID{"B", "C"},
+			expectedDists: []float32{0.6, 0.7},
+		},
+		{
+			name:          "average",
+			combination:   &dto.TargetCombination{Type: dto.TargetCombinationAverage},
+			expectedIDs:   []strfmt.UUID{"B", "C"},
+			expectedDists: []float32{0.3, 0.35},
+		},
+		{
+			name: "manual weights",
+			combination: &dto.TargetCombination{
+				Type:    dto.TargetCombinationManualWeights,
+				Weights: []float32{1, 0.1},
+			},
+			expectedIDs:   []strfmt.UUID{"A", "B"},
+			expectedDists: []float32{0.19, 0.33},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			log, _ := testLogger.NewNullLogger()
+			searcher := &fakeMultiTargetSearcher{
+				fakeVectorSearcher: &fakeVectorSearcher{},
+				dists:              dists,
+			}
+			explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+
+			res, searchVector, err := explorer.multiTargetVectorSearch(context.Background(),
+				dto.GetParams{
+					ClassName:         "ClassOne",
+					Pagination:        &filters.Pagination{Limit: 2},
+					NearVector:        &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+					TargetVectors:     []string{"title", "body"},
+					TargetCombination: test.combination,
+				})
+			require.Nil(t, err)
+			assert.Equal(t, []float32{1, 2, 3}, searchVector)
+
+			require.Len(t, res, len(test.expectedIDs))
+			for i := range res {
+				assert.Equal(t, test.expectedIDs[i], res[i].ID)
+				assert.InDelta(t, test.expectedDists[i], res[i].Dist, 1e-6)
+			}
+		})
+	}
+
+	t.Run("with a distance threshold", func(t *testing.T) {
+		log, _ := testLogger.NewNullLogger()
+		searcher := &fakeMultiTargetSearcher{
+			fakeVectorSearcher: &fakeVectorSearcher{},
+			dists:              dists,
+		}
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+
+		res, _, err := explorer.multiTargetVectorSearch(context.Background(),
+			dto.GetParams{
+				ClassName:  "ClassOne",
+				Pagination: &filters.Pagination{Limit: 3},
+				NearVector: &searchparams.NearVector{
+					Vector:       []float32{1, 2, 3},
+					Distance:     0.75,
+					WithDistance: true,
+				},
+				TargetVectors:     []string{"title", "body"},
+				TargetCombination: &dto.TargetCombination{Type: dto.TargetCombinationSum},
+			})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, strfmt.UUID("B"), res[0].ID)
+		assert.Equal(t, strfmt.UUID("C"), res[1].ID)
+	})
+
+	t.Run("with an offset", func(t *testing.T) {
+		log, _ := testLogger.NewNullLogger()
+		searcher := &fakeMultiTargetSearcher{
+			fakeVectorSearcher: &fakeVectorSearcher{},
+			dists:              dists,
+		}
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+
+		res, _, err := explorer.multiTargetVectorSearch(context.Background(),
+			dto.GetParams{
+				ClassName:         "ClassOne",
+				Pagination:        &filters.Pagination{Offset: 1, Limit: 2},
+				NearVector:        &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+				TargetVectors:     []string{"title", "body"},
+				TargetCombination: &dto.TargetCombination{Type: dto.TargetCombinationSum},
+			})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, strfmt.UUID("C"), res[0].ID)
+		assert.Equal(t, strfmt.UUID("A"), res[1].ID)
+	})
+}
+
+func Test_Explorer_ValidateMultiTargetVectors(t *testing.T) {
+	log, _ := testLogger.NewNullLogger()
+	explorer := NewExplorer(&fakeVectorSearcher{}, log, getFakeModulesProvider(), nil)
+	explorer.SetSchemaGetter(&fakeSchemaGetter{
+		schema: schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "ClassOne",
+					VectorConfig: map[string]models.VectorConfig{
+						"title": {VectorIndexType: "hnsw"},
+						"body":  {VectorIndexType: "hnsw"},
+					},
+				},
+			},
+		}},
+	})
+
+	t.Run("with existing target vectors", func(t *testing.T) {
+		err := explorer.validateTargetVector(dto.GetParams{
+			ClassName:     "ClassOne",
+			NearVector:    &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+			TargetVectors: []string{"title", "body"},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("with a non-existent target vector", func(t *testing.T) {
+		err := explorer.validateTargetVector(dto.GetParams{
+			ClassName:     "ClassOne",
+			NearVector:    &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+			TargetVectors: []string{"title", "summary"},
+		})
+		assert.EqualError(t, err, "class ClassOne does not have named vector \"summary\"")
+	})
+
+	t.Run("with missing manual weights", func(t *testing.T) {
+		err := explorer.validateTargetVector(dto.GetParams{
+			ClassName:         "ClassOne",
+			NearVector:        &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+			TargetVectors:     []string{"title", "body"},
+			TargetCombination: &dto.TargetCombination{Type: dto.TargetCombinationManualWeights},
+		})
+		assert.EqualError(t, err, "manualWeights combination method requires one weight per "+
+			"target vector, got 0 weights for 2 target vectors")
+	})
+
+	t.Run("with group by", func(t *testing.T) {
+		err := explorer.validateTargetVector(dto.GetParams{
+			ClassName:     "ClassOne",
+			NearVector:    &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+			TargetVectors: []string{"title", "body"},
+			GroupBy:       &searchparams.GroupBy{Property: "prop"},
+		})
+		assert.EqualError(t, err, "groupBy is not supported with multiple target vectors")
+	})
+}
+
+// fakeMultiTargetSearcher returns the objects of a target vector ordered by
+// their distance, restricted to the ids of an id filter
+type fakeMultiTargetSearcher struct {
+	*fakeVectorSearcher
+	dists map[string]map[strfmt.UUID]float32
+}
+
+func (f *fakeMultiTargetSearcher) DenseObjectSearch(ctx context.Context, className string,
+	vector []float32, targetVector string, indexParams *searchparams.VectorIndex,
+	offset, limit int, where *filters.LocalFilter, addl additional.Properties, tenant string,
+) ([]*storobj.Object, []float32, error) {
+	allowed := map[strfmt.UUID]bool{}
+	if where != nil {
+		for _, clause := range where.Root.Operands {
+			allowed[strfmt.UUID(clause.Value.Value.(string))] = true
+		}
+	}
+
+	var ids []strfmt.UUID
+	for id := range f.dists[targetVector] {
+		if where == nil || allowed[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return f.dists[targetVector][ids[i]] < f.dists[targetVector][ids[j]]
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	objs := make([]*storobj.Object, len(ids))
+	dists := make([]float32, len(ids))
+	for i, id := range ids {
+		objs[i] = storobj.FromObject(&models.Object{Class: className, ID: id}, nil)
+		dists[i] = f.dists[targetVector][id]
+	}
+	return objs, dists, nil
+}
+
+func (f *fakeMultiTargetSearcher) ResolveReferences(ctx context.Context, objs search.Results,
+	props search.SelectProperties, groupBy *searchparams.GroupBy,
+	additional additional.Properties, tenant string,
+) (search.Results, error) {
+	return objs, nil
+}
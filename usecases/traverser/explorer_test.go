@@ -2747,14 +2747,19 @@ func ptFloat32(in float32) *float32 {
 
 type fakeModulesProvider struct {
 	customC11yModule *fakeText2vecContextionaryModule
+	textTransformers map[string]modulecapabilities.TextTransform
 }
 
-func (p *fakeModulesProvider) VectorFromInput(ctx context.Context, className string, input string) ([]float32, error) {
+func (p *fakeModulesProvider) TextTransformer(argument string) modulecapabilities.TextTransform {
+	return p.textTransformers[argument]
+}
+
+func (p *fakeModulesProvider) VectorFromInput(ctx context.Context, className, targetVector string, input string) ([]float32, error) {
 	panic("not implemented")
 }
 
 func (p *fakeModulesProvider) VectorFromSearchParam(ctx context.Context, className,
-	param string, params interface{},
+	targetVector, param string, params interface{},
 	findVectorFn modulecapabilities.FindVectorFn, tenant string,
 ) ([]float32, error) {
 	txt2vec := p.getFakeT2Vec()
@@ -2871,7 +2876,7 @@ func getFakeModulesProviderWithCustomExtenders(
 	customPathBuilder *fakePathBuilder,
 ) ModulesProvider {
 	return &fakeModulesProvider{
-		newFakeText2vecContextionaryModuleWithCustomExtender(customExtender, customProjector, customPathBuilder),
+		customC11yModule: newFakeText2vecContextionaryModuleWithCustomExtender(customExtender, customProjector, customPathBuilder),
 	}
 }
 
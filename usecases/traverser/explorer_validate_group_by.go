@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func (e *Explorer) validateGroupBy(params dto.GetParams) error {
+	groupBy := params.GroupBy
+	if groupBy == nil {
+		return nil
+	}
+
+	if groupBy.Property == "" {
+		return fmt.Errorf("path must contain exactly one property")
+	}
+	if groupBy.Groups <= 0 {
+		return fmt.Errorf("groups must be greater than 0, got %d", groupBy.Groups)
+	}
+	if groupBy.ObjectsPerGroup <= 0 {
+		return fmt.Errorf("objectsPerGroup must be greater than 0, got %d",
+			groupBy.ObjectsPerGroup)
+	}
+
+	if params.NearVector == nil && params.NearObject == nil && len(params.ModuleParams) == 0 &&
+		params.HybridSearch == nil && params.KeywordRanking == nil {
+		return fmt.Errorf("groupBy can only be set with a vector, hybrid or bm25 search")
+	}
+
+	if e.schemaGetter == nil {
+		return nil
+	}
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	if class := sch.FindClassByName(schema.ClassName(params.ClassName)); class != nil {
+		if _, err := schema.GetPropertyByName(class, groupBy.Property); err != nil {
+			return fmt.Errorf("unrecognized property %q", groupBy.Property)
+		}
+	}
+	return nil
+}
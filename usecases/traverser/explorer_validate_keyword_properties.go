@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// validateKeywordProperties checks the properties of bm25 and hybrid
+// searches, which may carry a boost like title^3
+func (e *Explorer) validateKeywordProperties(params dto.GetParams) error {
+	var properties []string
+	if params.KeywordRanking != nil {
+		properties = append(properties, params.KeywordRanking.Properties...)
+	}
+	if params.HybridSearch != nil {
+		properties = append(properties, params.HybridSearch.Properties...)
+	}
+
+	for _, property := range properties {
+		if _, _, err := searchparams.ParsePropertyBoost(property); err != nil {
+			return err
+		}
+	}
+	return nil
+}
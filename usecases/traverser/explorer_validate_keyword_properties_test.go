@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	testLogger "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_WithPropertyBoosts(t *testing.T) {
+	newExplorer := func() *Explorer {
+		log, _ := testLogger.NewNullLogger()
+		return NewExplorer(&fakeVectorSearcher{}, log, getFakeModulesProvider(), nil)
+	}
+
+	t.Run("bm25 with an invalid boost", func(t *testing.T) {
+		_, err := newExplorer().GetClass(context.Background(), dto.GetParams{
+			ClassName: "ClassOne",
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Query:      "climate",
+				Properties: []string{"title^abc", "body"},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid 'properties' parameter: "+
+			"property \"title^abc\" has an invalid boost")
+	})
+
+	t.Run("hybrid with a negative boost", func(t *testing.T) {
+		_, err := newExplorer().GetClass(context.Background(), dto.GetParams{
+			ClassName: "ClassOne",
+			HybridSearch: &searchparams.HybridSearch{
+				Query:      "climate",
+				Properties: []string{"title^-2"},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, "invalid 'properties' parameter: "+
+			"property \"title^-2\" has an invalid boost, it must be greater than 0", err.Error())
+	})
+}
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func (e *Explorer) validateTargetVector(params dto.GetParams) error {
+	targetVectors := params.TargetVectors
+	if params.TargetVector != "" {
+		if len(targetVectors) > 0 {
+			return fmt.Errorf("a single target vector and multiple target vectors cannot be set together")
+		}
+		targetVectors = []string{params.TargetVector}
+	}
+	if len(targetVectors) == 0 {
+		if params.TargetCombination != nil {
+			return fmt.Errorf("a target combination requires multiple target vectors")
+		}
+		return nil
+	}
+
+	if params.NearVector == nil && params.NearObject == nil &&
+		len(params.ModuleParams) == 0 && params.HybridSearch == nil {
+		return fmt.Errorf("target vector can only be set with a vector or hybrid search")
+	}
+
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(params.ClassName))
+	if class == nil {
+		return fmt.Errorf("class %q does not exist in schema", params.ClassName)
+	}
+	for _, targetVector := range targetVectors {
+		if _, ok := class.VectorConfig[targetVector]; !ok {
+			return fmt.Errorf("class %s does not have named vector %q",
+				params.ClassName, targetVector)
+		}
+	}
+
+	if len(params.TargetVectors) > 0 {
+		return validateMultiTargetParams(params)
+	}
+
+	return nil
+}
+
+func validateMultiTargetParams(params dto.GetParams) error {
+	if params.GroupBy != nil {
+		return fmt.Errorf("groupBy is not supported with multiple target vectors")
+	}
+	if params.HybridSearch != nil && params.HybridSearch.SubSearches != nil {
+		return fmt.Errorf("hybrid operands are not supported with multiple target vectors")
+	}
+
+	combination := params.TargetCombination
+	if combination != nil && combination.Type == dto.TargetCombinationManualWeights &&
+		len(combination.Weights) != len(params.TargetVectors) {
+		return fmt.Errorf("manualWeights combination method requires one weight per "+
+			"target vector, got %d weights for %d target vectors",
+			len(combination.Weights), len(params.TargetVectors))
+	}
+
+	return nil
+}
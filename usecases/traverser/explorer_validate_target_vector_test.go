@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	testLogger "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_WithTargetVector(t *testing.T) {
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		search := &fakeVectorSearcher{}
+		sg := &fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "ClassOne",
+						VectorConfig: map[string]models.VectorConfig{
+							"title": {VectorIndexType: "hnsw"},
+						},
+					},
+				},
+			}},
+		}
+		log, _ := testLogger.NewNullLogger()
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything)
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(sg)
+		return explorer, search
+	}
+
+	t.Run("with an existing target vector", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		searcher.
+			On("VectorSearch", mock.MatchedBy(func(params dto.GetParams) bool {
+				return params.TargetVector == "title"
+			})).
+			Return([]search.Result{{ID: "id1"}}, nil)
+
+		res, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:    "ClassOne",
+			NearVector:   &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+			TargetVector: "title",
+		})
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		require.Len(t, res, 1)
+	})
+
+	t.Run("with a non-existent target vector", func(t *testing.T) {
+		explorer, _ := newExplorer()
+
+		_, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:    "ClassOne",
+			NearVector:   &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+			TargetVector: "body",
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, "invalid 'targetVectors' parameter: "+
+			"class ClassOne does not have named vector \"body\"", err.Error())
+	})
+
+	t.Run("without a vector search", func(t *testing.T) {
+		explorer, _ := newExplorer()
+
+		_, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:    "ClassOne",
+			TargetVector: "title",
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, "invalid 'targetVectors' parameter: "+
+			"target vector can only be set with a vector or hybrid search", err.Error())
+	})
+}
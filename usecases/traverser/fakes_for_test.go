@@ -140,7 +140,8 @@ func (f *fakeVectorSearcher) SparseObjectSearch(ctx context.Context,
 }
 
 func (f *fakeVectorSearcher) DenseObjectSearch(context.Context, string,
-	[]float32, int, int, *filters.LocalFilter, additional.Properties, string,
+	[]float32, string, *searchparams.VectorIndex, int, int, *filters.LocalFilter,
+	additional.Properties, string,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
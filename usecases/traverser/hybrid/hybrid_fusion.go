@@ -34,13 +34,14 @@ func FusionRanked(weights []float64, results [][]*Result) []*Result {
 			previousResult, ok := mapResults[docId]
 			if ok {
 				tempResult.AdditionalProperties["explainScore"] = fmt.Sprintf(
-					"%v\n(hybrid) Document %v contributed %v to the score",
-					previousResult.AdditionalProperties["explainScore"], tempResult.ID, score)
+					"%v\n(hybrid) Document %v contributed %v to the score (weight %v / (60 + rank %v))",
+					previousResult.AdditionalProperties["explainScore"], tempResult.ID, score,
+					weights[resultSetIndex], i+1)
 				score += float64(previousResult.Score)
 			} else {
 				tempResult.AdditionalProperties["explainScore"] = fmt.Sprintf(
-					"%v\n(hybrid) Document %v contributed %v to the score",
-					tempResult.ExplainScore, tempResult.ID, score)
+					"%v\n(hybrid) Document %v contributed %v to the score (weight %v / (60 + rank %v))",
+					tempResult.ExplainScore, tempResult.ID, score, weights[resultSetIndex], i+1)
 			}
 			tempResult.AdditionalProperties["rank_score"] = score
 			tempResult.AdditionalProperties["score"] = score
@@ -56,7 +57,9 @@ func FusionRanked(weights []float64, results [][]*Result) []*Result {
 		i      = 0
 	)
 	for _, res := range mapResults {
-		res.ExplainScore = res.AdditionalProperties["explainScore"].(string)
+		res.ExplainScore = fmt.Sprintf("%v\n(hybrid) ranked fusion score %v is the sum of the contributions",
+			res.AdditionalProperties["explainScore"], res.Score)
+		res.AdditionalProperties["explainScore"] = res.ExplainScore
 		concat[i] = res
 		i++
 	}
@@ -139,6 +142,9 @@ func FusionRelativeScore(weights []float64, results [][]*Result) []*Result {
 
 	concat := make([]*Result, 0, len(mapResults))
 	for _, res := range mapResults {
+		res.ExplainScore += fmt.Sprintf("\n(hybrid) relative score fusion score %v is the sum of "+
+			"weight * (original score - min) / (max - min) with weights %v, min %v and max %v",
+			res.Score, weights, minimum, maximum)
 		concat = append(concat, res)
 	}
 
@@ -30,9 +30,10 @@ const DefaultLimit = 100
 
 type Params struct {
 	*searchparams.HybridSearch
-	Keyword *searchparams.KeywordRanking
-	Class   string
-	Autocut int
+	Keyword      *searchparams.KeywordRanking
+	Class        string
+	TargetVector string
+	Autocut      int
 }
 
 // Result facilitates the pairing of a search result with its internal doc id.
@@ -74,7 +75,7 @@ type postProcFunc func(hybridResults Results) (postProcResults []search.Result,
 
 type modulesProvider interface {
 	VectorFromInput(ctx context.Context,
-		className string, input string) ([]float32, error)
+		className, targetVector string, input string) ([]float32, error)
 }
 
 type Searcher struct {
@@ -100,15 +101,20 @@ func NewSearcher(params *Params, logger logrus.FieldLogger,
 	}
 }
 
-// Search executes sparse and dense searches and combines the result sets using Reciprocal Rank Fusion
+// Search executes sparse and dense searches and combines the result sets using
+// either ranked fusion or relative score fusion. A search with a vector but
+// without a query only runs the dense search.
 func (s *Searcher) Search(ctx context.Context) (Results, error) {
 	var (
 		found   [][]*Result
 		weights []float64
 	)
 
-	if s.params.Query != "" {
+	if s.params.Query != "" || (len(s.params.Vector) > 0 && !s.hasSubSearches()) {
 		alpha := s.params.Alpha
+		if s.params.Query == "" {
+			alpha = 1
+		}
 
 		if alpha < 1 {
 			res, err := s.sparseSearch()
@@ -185,6 +191,11 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 	return fused, nil
 }
 
+func (s *Searcher) hasSubSearches() bool {
+	subSearches, ok := s.params.SubSearches.([]searchparams.WeightedSearchResult)
+	return ok && len(subSearches) > 0
+}
+
 func (s *Searcher) sparseSearch() ([]*Result, error) {
 	res, dists, err := s.sparseSearchFunc()
 	if err != nil {
@@ -255,6 +266,7 @@ func (s *Searcher) sparseSubSearch(
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
+		sr.SecondarySortValue = sr.Score
 		sr.ExplainScore = "(bm25)" + sr.ExplainScore
 		out[i] = &Result{obj.DocID(), &sr}
 	}
@@ -270,7 +282,7 @@ func (s *Searcher) nearTextSubSearch(ctx context.Context,
 		return nil, 0, nil
 	}
 
-	vector, err := s.vectorFromModuleInput(ctx, s.params.Class, sp.Values[0])
+	vector, err := s.vectorFromModuleInput(ctx, s.params.Class, s.params.TargetVector, sp.Values[0])
 	if err != nil {
 		return nil, 0, err
 	}
@@ -283,6 +295,7 @@ func (s *Searcher) nearTextSubSearch(ctx context.Context,
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
+		sr.SecondarySortValue = 1 - sr.Dist
 		sr.ExplainScore = fmt.Sprintf("(vector) %v %v ",
 			truncateVectorString(10, vector), res[i].ExplainScore())
 		out[i] = &Result{obj.DocID(), &sr}
@@ -304,6 +317,7 @@ func (s *Searcher) nearVectorSubSearch(
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
+		sr.SecondarySortValue = 1 - sr.Dist
 		sr.ExplainScore = fmt.Sprintf("(vector) %v %v ",
 			truncateVectorString(10, sp.Vector), res[i].ExplainScore())
 		out[i] = &Result{obj.DocID(), &sr}
@@ -322,7 +336,7 @@ func (s *Searcher) decideSearchVector(ctx context.Context) ([]float32, error) {
 		vector = s.params.Vector
 	} else {
 		if s.modulesProvider != nil {
-			vector, err = s.vectorFromModuleInput(ctx, s.params.Class, s.params.Query)
+			vector, err = s.vectorFromModuleInput(ctx, s.params.Class, s.params.TargetVector, s.params.Query)
 			if err != nil {
 				return nil, err
 			}
@@ -332,8 +346,8 @@ func (s *Searcher) decideSearchVector(ctx context.Context) ([]float32, error) {
 	return vector, nil
 }
 
-func (s *Searcher) vectorFromModuleInput(ctx context.Context, class, input string) ([]float32, error) {
-	vector, err := s.modulesProvider.VectorFromInput(ctx, class, input)
+func (s *Searcher) vectorFromModuleInput(ctx context.Context, class, targetVector, input string) ([]float32, error) {
+	vector, err := s.modulesProvider.VectorFromInput(ctx, class, targetVector, input)
 	if err != nil {
 		return nil, fmt.Errorf("get vector input from modules provider: %w", err)
 	}
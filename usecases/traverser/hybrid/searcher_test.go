@@ -13,12 +13,15 @@ package hybrid
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/get"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -144,6 +147,65 @@ func TestSearcher(t *testing.T) {
 				assert.Equal(t, res[0].Result.Dist, float32(0.008))
 			},
 		},
+		{
+			name: "with vector but without query",
+			f: func(t *testing.T) {
+				params := &Params{
+					HybridSearch: &searchparams.HybridSearch{
+						Type:   "hybrid",
+						Alpha:  0.5,
+						Vector: []float32{1, 2, 3},
+					},
+					Class: class,
+				}
+				sparse := func() ([]*storobj.Object, []float32, error) {
+					return nil, nil, fmt.Errorf("sparse search must not run without a query")
+				}
+				dense := func(vec []float32) ([]*storobj.Object, []float32, error) {
+					assert.Equal(t, []float32{1, 2, 3}, vec)
+					return []*storobj.Object{
+						{
+							Object: models.Object{
+								Class: class,
+								ID:    "1889a225-3b28-477d-b8fc-5f6071bb4731",
+							},
+						},
+					}, []float32{0.008}, nil
+				}
+				// the provider has no expectations, vectorizing would fail
+				provider := &fakeModuleProvider{}
+				s := NewSearcher(params, logger, sparse, dense, nil, provider)
+				res, err := s.Search(ctx)
+				require.Nil(t, err)
+				require.Len(t, res, 1)
+				assert.Contains(t, res[0].Result.ExplainScore, "(vector)")
+				assert.Equal(t, float32(0.008), res[0].Result.Dist)
+			},
+		},
+		{
+			name: "with vector and query without vectorizer",
+			f: func(t *testing.T) {
+				params := &Params{
+					HybridSearch: &searchparams.HybridSearch{
+						Type:   "hybrid",
+						Alpha:  0.5,
+						Query:  "some query",
+						Vector: []float32{1, 2, 3},
+					},
+					Class: class,
+				}
+				sparse := func() ([]*storobj.Object, []float32, error) { return nil, nil, nil }
+				dense := func(vec []float32) ([]*storobj.Object, []float32, error) {
+					assert.Equal(t, []float32{1, 2, 3}, vec)
+					return nil, nil, nil
+				}
+				provider := &fakeModuleProvider{}
+				s := NewSearcher(params, logger, sparse, dense, nil, provider)
+				_, err := s.Search(ctx)
+				require.Nil(t, err)
+				provider.AssertNotCalled(t, "VectorFromInput")
+			},
+		},
 		{
 			name: "combined hybrid search",
 			f: func(t *testing.T) {
@@ -435,6 +497,67 @@ func TestSearcher(t *testing.T) {
 				assert.Equal(t, res[1].Result.Dist, float32(0.008))
 			},
 		},
+		{
+			name: "with relative score fusion of subsearches",
+			f: func(t *testing.T) {
+				params := &Params{
+					HybridSearch: &searchparams.HybridSearch{
+						Type:            "hybrid",
+						FusionAlgorithm: get.HybridRelativeScoreFusion,
+						SubSearches: []searchparams.WeightedSearchResult{
+							{
+								Type: "sparseSearch",
+								SearchParams: searchparams.KeywordRanking{
+									Type:  "bm25",
+									Query: "some query",
+								},
+								Weight: 0.25,
+							},
+							{
+								Type: "nearVector",
+								SearchParams: searchparams.NearVector{
+									Vector: []float32{1, 2, 3},
+								},
+								Weight: 0.75,
+							},
+						},
+					},
+					Class: class,
+				}
+				object := func(id string, score float32) *storobj.Object {
+					return &storobj.Object{
+						Object: models.Object{
+							Class:      class,
+							ID:         strfmt.UUID(id),
+							Additional: map[string]interface{}{"score": score},
+						},
+					}
+				}
+				idA := "1889a225-3b28-477d-b8fc-5f6071bb4731"
+				idB := "79a636c2-3314-442e-a4d1-e94d7c0afc3a"
+				idC := "a4bdf2c4-6b6f-4b3e-9c73-3a3cb2a0ef51"
+				sparse := func() ([]*storobj.Object, []float32, error) {
+					return []*storobj.Object{object(idA, 4), object(idB, 2)}, []float32{0, 0}, nil
+				}
+				dense := func([]float32) ([]*storobj.Object, []float32, error) {
+					return []*storobj.Object{object(idB, 0), object(idC, 0), object(idA, 0)},
+						[]float32{0.1, 0.3, 0.5}, nil
+				}
+				s := NewSearcher(params, logger, sparse, dense, nil, nil)
+				res, err := s.Search(ctx)
+				require.Nil(t, err)
+				require.Len(t, res, 3)
+
+				// scores are normalized per result set before they are weighted,
+				// the best keyword match and the closest vector match score 1
+				assert.Equal(t, strfmt.UUID(idB), res[0].ID)
+				assert.InDelta(t, 0.75, res[0].Score, 1e-6)
+				assert.Equal(t, strfmt.UUID(idC), res[1].ID)
+				assert.InDelta(t, 0.375, res[1].Score, 1e-6)
+				assert.Equal(t, strfmt.UUID(idA), res[2].ID)
+				assert.InDelta(t, 0.25, res[2].Score, 1e-6)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -447,7 +570,7 @@ type fakeModuleProvider struct {
 }
 
 func (f *fakeModuleProvider) VectorFromInput(ctx context.Context,
-	className string, input string,
+	className, targetVector string, input string,
 ) ([]float32, error) {
 	args := f.Called(ctx, className, input)
 	return args.Get(0).([]float32), nil
@@ -45,7 +45,7 @@ func newNearParamsVector(modulesProvider ModulesProvider, search nearParamsSearc
 
 func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	nearVector *searchparams.NearVector, nearObject *searchparams.NearObject,
-	moduleParams map[string]interface{}, className string, tenant string,
+	moduleParams map[string]interface{}, className, targetVector, tenant string,
 ) ([]float32, error) {
 	err := v.validateNearParams(nearVector, nearObject, moduleParams, className)
 	if err != nil {
@@ -54,7 +54,7 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 
 	if len(moduleParams) == 1 {
 		for name, value := range moduleParams {
-			return v.vectorFromModules(ctx, className, name, value, tenant)
+			return v.vectorFromModules(ctx, className, targetVector, name, value, tenant)
 		}
 	}
 
@@ -63,7 +63,7 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	}
 
 	if nearObject != nil {
-		vector, err := v.vectorFromNearObjectParams(ctx, className, nearObject, tenant)
+		vector, err := v.vectorFromNearObjectParams(ctx, className, targetVector, nearObject, tenant)
 		if err != nil {
 			return nil, errors.Errorf("nearObject params: %v", err)
 		}
@@ -143,11 +143,11 @@ func (v *nearParamsVector) validateNearParams(nearVector *searchparams.NearVecto
 }
 
 func (v *nearParamsVector) vectorFromModules(ctx context.Context,
-	className, paramName string, paramValue interface{}, tenant string,
+	className, targetVector, paramName string, paramValue interface{}, tenant string,
 ) ([]float32, error) {
 	if v.modulesProvider != nil {
 		vector, err := v.modulesProvider.VectorFromSearchParam(ctx,
-			className, paramName, paramValue, v.findVector, tenant,
+			className, targetVector, paramName, paramValue, v.findVector, tenant,
 		)
 		if err != nil {
 			return nil, errors.Errorf("vectorize params: %v", err)
@@ -180,6 +180,22 @@ func (v *nearParamsVector) classFindVector(ctx context.Context, className string
 	return res.Vector, nil
 }
 
+// classFindTargetVector returns the named vector of an object, it is used
+// when a vector search targets a named vector of the class
+func (v *nearParamsVector) classFindTargetVector(ctx context.Context, className,
+	targetVector string, id strfmt.UUID, tenant string,
+) ([]float32, error) {
+	res, err := v.search.Object(ctx, className, id, search.SelectProperties{},
+		additional.Properties{Vector: true}, nil, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || len(res.Vectors[targetVector]) == 0 {
+		return nil, errors.Errorf("vector for target vector %q not found", targetVector)
+	}
+	return res.Vectors[targetVector], nil
+}
+
 func (v *nearParamsVector) crossClassFindVector(ctx context.Context, id strfmt.UUID) ([]float32, error) {
 	res, err := v.search.ObjectsByID(ctx, id, search.SelectProperties{}, additional.Properties{}, "")
 	if err != nil {
@@ -202,11 +218,11 @@ func (v *nearParamsVector) crossClassFindVector(ctx context.Context, id strfmt.U
 func (v *nearParamsVector) crossClassVectorFromNearObjectParams(ctx context.Context,
 	params *searchparams.NearObject,
 ) ([]float32, error) {
-	return v.vectorFromNearObjectParams(ctx, "", params, "")
+	return v.vectorFromNearObjectParams(ctx, "", "", params, "")
 }
 
 func (v *nearParamsVector) vectorFromNearObjectParams(ctx context.Context,
-	className string, params *searchparams.NearObject, tenant string,
+	className, targetVector string, params *searchparams.NearObject, tenant string,
 ) ([]float32, error) {
 	if len(params.ID) == 0 && len(params.Beacon) == 0 {
 		return nil, errors.New("empty id and beacon")
@@ -228,6 +244,10 @@ func (v *nearParamsVector) vectorFromNearObjectParams(ctx context.Context,
 		}
 	}
 
+	if targetVector != "" {
+		return v.classFindTargetVector(ctx, targetClassName, targetVector, id, tenant)
+	}
+
 	return v.findVector(ctx, targetClassName, id, tenant)
 }
 
@@ -50,7 +50,7 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 			return nil, err
 		}
 		searchVector, err := t.nearParamsVector.vectorFromParams(ctx,
-			params.NearVector, params.NearObject, params.ModuleParams, className, params.Tenant)
+			params.NearVector, params.NearObject, params.ModuleParams, className, "", params.Tenant)
 		if err != nil {
 			return nil, err
 		}
@@ -66,7 +66,7 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 
 	if params.Hybrid != nil && params.Hybrid.Vector == nil && params.Hybrid.Query != "" {
 		vec, err := t.nearParamsVector.modulesProvider.
-			VectorFromInput(ctx, params.ClassName.String(), params.Hybrid.Query)
+			VectorFromInput(ctx, params.ClassName.String(), "", params.Hybrid.Query)
 		if err != nil {
 			return nil, err
 		}